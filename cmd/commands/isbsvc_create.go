@@ -69,7 +69,10 @@ func NewISBSvcCreateCommand() *cobra.Command {
 			ctx := logging.WithLogger(context.Background(), logger)
 			switch v1alpha1.ISBSvcType(isbSvcType) {
 			case v1alpha1.ISBSvcTypeRedis:
-				rsClient := redisclient.NewInClusterRedisClient()
+				rsClient, err := redisclient.NewInClusterRedisClient()
+				if err != nil {
+					return fmt.Errorf("failed to get an in-cluster redis client, %w", err)
+				}
 				defer rsClient.Close()
 				isbsClient = isbsvc.NewISBRedisSvc(rsClient)
 			case v1alpha1.ISBSvcTypeJetStream:
@@ -84,6 +87,8 @@ func NewISBSvcCreateCommand() *cobra.Command {
 					return err
 				}
 				opts = append(opts, isbsvc.WithConfig(isbSvcConfig.JetStream.StreamConfig))
+			case v1alpha1.ISBSvcTypeDisk:
+				isbsClient = isbsvc.NewISBDiskSvc(v1alpha1.PathISBSvcDiskMount)
 			default:
 				cmd.HelpFunc()(cmd, args)
 				return fmt.Errorf("unsupported isb service type %q", isbSvcType)
@@ -54,7 +54,10 @@ func NewISBSvcDeleteCommand() *cobra.Command {
 			ctx := logging.WithLogger(context.Background(), logger)
 			switch v1alpha1.ISBSvcType(isbSvcType) {
 			case v1alpha1.ISBSvcTypeRedis:
-				rsClient := redisclient.NewInClusterRedisClient()
+				rsClient, err := redisclient.NewInClusterRedisClient()
+				if err != nil {
+					return fmt.Errorf("failed to get an in-cluster redis client, %w", err)
+				}
 				defer rsClient.Close()
 
 				isbsClient = isbsvc.NewISBRedisSvc(rsClient)
@@ -70,6 +73,8 @@ func NewISBSvcDeleteCommand() *cobra.Command {
 					logger.Errorw("Failed to get a ISB Service client.", zap.Error(err))
 					return err
 				}
+			case v1alpha1.ISBSvcTypeDisk:
+				isbsClient = isbsvc.NewISBDiskSvc(v1alpha1.PathISBSvcDiskMount)
 			default:
 				cmd.HelpFunc()(cmd, args)
 				return fmt.Errorf("unsupported isb service type %q", isbSvcType)
@@ -57,7 +57,10 @@ func NewISBSvcValidateCommand() *cobra.Command {
 			ctx := logging.WithLogger(context.Background(), logger)
 			switch v1alpha1.ISBSvcType(isbSvcType) {
 			case v1alpha1.ISBSvcTypeRedis:
-				rsClient := redisclient.NewInClusterRedisClient()
+				rsClient, err := redisclient.NewInClusterRedisClient()
+				if err != nil {
+					return fmt.Errorf("failed to get an in-cluster redis client, %w", err)
+				}
 				defer rsClient.Close()
 
 				isbsClient = isbsvc.NewISBRedisSvc(rsClient)
@@ -72,6 +75,8 @@ func NewISBSvcValidateCommand() *cobra.Command {
 					logger.Errorw("Failed to get an ISB Service client.", zap.Error(err))
 					return err
 				}
+			case v1alpha1.ISBSvcTypeDisk:
+				isbsClient = isbsvc.NewISBDiskSvc(v1alpha1.PathISBSvcDiskMount)
 			default:
 				cmd.HelpFunc()(cmd, args)
 				return fmt.Errorf("unsupported isb service type")
@@ -8,7 +8,7 @@ go install -mod=vendor ./vendor/sigs.k8s.io/controller-tools/cmd/controller-gen
 
 header "Generating CRDs"
 # maxDescLen=0 avoids `kubectl apply` failing due to annotations being too long
-$(go env GOPATH)/bin/controller-gen crd:crdVersions=v1,maxDescLen=0 paths=./pkg/apis/... output:dir=config/base/crds/full
+$(go env GOPATH)/bin/controller-gen crd:crdVersions=v1,maxDescLen=0,allowDangerousTypes=true paths=./pkg/apis/... output:dir=config/base/crds/full
 
 cp config/base/crds/full/numaflow.numaproj.io*.yaml config/base/crds/minimal/
 
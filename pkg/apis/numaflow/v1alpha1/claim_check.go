@@ -0,0 +1,61 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+// ClaimCheck stores the payloads larger than a threshold out of the Inter-Step Buffers, either in an S3 compatible
+// object store or in a shared volume, and the messages in the buffers only carry the references to them.
+// The payloads are loaded back transparently when the messages are read, and deleted once the messages are
+// acknowledged by the vertex reading them, e.g. once the sink has written them. Only one of the stores can be specified.
+type ClaimCheck struct {
+	// ThresholdBytes is the size above which a payload is stored out of the buffer, defaults to 512Ki.
+	// +optional
+	ThresholdBytes *uint64 `json:"thresholdBytes,omitempty" protobuf:"varint,1,opt,name=thresholdBytes"`
+	// S3 stores the payloads in an S3 compatible object store.
+	// +optional
+	S3 *ClaimCheckS3 `json:"s3,omitempty" protobuf:"bytes,2,opt,name=s3"`
+	// PersistentVolumeClaim stores the payloads in an existing claim, which is mounted to all the vertex pods of
+	// the pipeline, so its access mode needs to be ReadWriteMany.
+	// +optional
+	PersistentVolumeClaim *corev1.PersistentVolumeClaimVolumeSource `json:"persistentVolumeClaim,omitempty" protobuf:"bytes,3,opt,name=persistentVolumeClaim"`
+}
+
+// ClaimCheckS3 is an S3 compatible object store. The credentials are resolved with the default AWS credential chain,
+// e.g. the AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY environment variables or the IRSA web identity token.
+type ClaimCheckS3 struct {
+	// Bucket is the name of the bucket.
+	Bucket string `json:"bucket" protobuf:"bytes,1,opt,name=bucket"`
+	// Region of the bucket.
+	Region string `json:"region" protobuf:"bytes,2,opt,name=region"`
+	// EndpointURL is the custom endpoint URL of the object store, e.g. a MinIO server.
+	// The path-style URLs are used if it's specified.
+	// +optional
+	EndpointURL string `json:"endpointUrl,omitempty" protobuf:"bytes,3,opt,name=endpointUrl"`
+	// Prefix is the key prefix of the stored payloads.
+	// +optional
+	Prefix string `json:"prefix,omitempty" protobuf:"bytes,4,opt,name=prefix"`
+}
+
+func (c *ClaimCheck) GetThresholdBytes() int {
+	if c == nil || c.ThresholdBytes == nil {
+		return DefaultClaimCheckThresholdBytes
+	}
+	return int(*c.ThresholdBytes)
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+type CompressionType string
+
+const (
+	CompressionTypeNone   CompressionType = "none"
+	CompressionTypeSnappy CompressionType = "snappy"
+	CompressionTypeZstd   CompressionType = "zstd"
+	CompressionTypeGzip   CompressionType = "gzip"
+)
+
+// Compression defines how the payloads of the messages written to an Inter-Step Buffer are compressed.
+// The codec is recorded in the header of each message, so the readers decompress the payloads transparently,
+// and the messages written without compression can always be read.
+type Compression struct {
+	// Type is the codec to compress the payloads, defaults to "none".
+	// +kubebuilder:validation:Enum=none;snappy;zstd;gzip
+	// +optional
+	Type CompressionType `json:"type,omitempty" protobuf:"bytes,1,opt,name=type,casttype=CompressionType"`
+	// MinPayloadBytes is the minimal size of a payload to be compressed, smaller payloads are written uncompressed.
+	// Defaults to 256.
+	// +optional
+	MinPayloadBytes *uint32 `json:"minPayloadBytes,omitempty" protobuf:"varint,2,opt,name=minPayloadBytes"`
+}
+
+func (c *Compression) GetType() CompressionType {
+	if c == nil || c.Type == "" {
+		return CompressionTypeNone
+	}
+	return c.Type
+}
+
+func (c *Compression) GetMinPayloadBytes() int {
+	if c == nil || c.MinPayloadBytes == nil {
+		return DefaultCompressionMinPayloadBytes
+	}
+	return int(*c.MinPayloadBytes)
+}
@@ -41,6 +41,7 @@ const (
 	KeyReplica             = "numaflow.numaproj.io/replica"
 	KeySideInputName       = "numaflow.numaproj.io/side-input-name"
 	KeyPauseTimestamp      = "numaflow.numaproj.io/pause-timestamp"
+	KeyPausing             = "numaflow.numaproj.io/pausing" // set on the pods of the vertices which close their windows when the pipeline is paused
 	KeyDefaultContainer    = "kubectl.kubernetes.io/default-container"
 
 	// ID key in the header of sources like http
@@ -48,6 +49,25 @@ const (
 	KeyMetaEventTime   = "X-Numaflow-Event-Time"
 	KeyMetaCallbackURL = "X-Numaflow-Callback-Url"
 
+	// Keys in the header of the messages written to a dead-letter buffer
+	KeyMetaDeadLetterError     = "X-Numaflow-Dlq-Error"
+	KeyMetaDeadLetterVertex    = "X-Numaflow-Dlq-Vertex"
+	KeyMetaDeadLetterPartition = "X-Numaflow-Dlq-Partition"
+	KeyMetaDeadLetterRetries   = "X-Numaflow-Dlq-Retries"
+	KeyMetaDeadLetterTimestamp = "X-Numaflow-Dlq-Timestamp"
+
+	// Keys in the header of the late messages forwarded by a reduce vertex, the window start and end times are
+	// comma separated unix milliseconds since a message can belong to multiple windows (e.g., sliding windows)
+	KeyMetaLateWindowStart = "X-Numaflow-Late-Window-Start"
+	KeyMetaLateWindowEnd   = "X-Numaflow-Late-Window-End"
+	KeyMetaLateWatermark   = "X-Numaflow-Late-Watermark"
+
+	// Key in the header of the messages of which the payloads are stored out of the inter-step buffer
+	KeyMetaClaimCheck = "X-Numaflow-Claim-Check"
+
+	// Key in the header of the messages being written to a priority lane, it is removed before writing
+	KeyMetaPriorityLane = "X-Numaflow-Priority-Lane"
+
 	DefaultISBSvcName = "default"
 
 	DefaultRedisSentinelMasterName = "mymaster"
@@ -131,10 +151,19 @@ const (
 	EnvISBSvcRedisPassword              = "NUMAFLOW_ISBSVC_REDIS_PASSWORD"
 	EnvISBSvcRedisSentinelPassword      = "NUMAFLOW_ISBSVC_REDIS_SENTINEL_PASSWORD"
 	EnvISBSvcRedisClusterMaxRedirects   = "NUMAFLOW_ISBSVC_REDIS_CLUSTER_MAX_REDIRECTS"
+	EnvISBSvcRedisCluster               = "NUMAFLOW_ISBSVC_REDIS_CLUSTER"
+	EnvISBSvcRedisSentinelUser          = "NUMAFLOW_ISBSVC_REDIS_SENTINEL_USER"
+	EnvISBSvcRedisTLSEnabled            = "NUMAFLOW_ISBSVC_REDIS_TLS_ENABLED"
+	EnvISBSvcRedisTLSInsecureSkipVerify = "NUMAFLOW_ISBSVC_REDIS_TLS_INSECURE_SKIP_VERIFY"
+	EnvISBSvcRedisTLSCACert             = "NUMAFLOW_ISBSVC_REDIS_TLS_CA_CERT"
+	EnvISBSvcRedisTLSCert               = "NUMAFLOW_ISBSVC_REDIS_TLS_CERT"
+	EnvISBSvcRedisTLSKey                = "NUMAFLOW_ISBSVC_REDIS_TLS_KEY"
 	EnvISBSvcJetStreamUser              = "NUMAFLOW_ISBSVC_JETSTREAM_USER"
 	EnvISBSvcJetStreamPassword          = "NUMAFLOW_ISBSVC_JETSTREAM_PASSWORD"
 	EnvISBSvcJetStreamURL               = "NUMAFLOW_ISBSVC_JETSTREAM_URL"
 	EnvISBSvcJetStreamTLSEnabled        = "NUMAFLOW_ISBSVC_JETSTREAM_TLS_ENABLED"
+	EnvISBSvcDiskMaxSegmentBytes        = "NUMAFLOW_ISBSVC_DISK_MAX_SEGMENT_BYTES"
+	EnvISBSvcDiskSyncInterval           = "NUMAFLOW_ISBSVC_DISK_SYNC_INTERVAL"
 	EnvISBSvcConfig                     = "NUMAFLOW_ISBSVC_CONFIG"
 	EnvLeaderElectionDisabled           = "NUMAFLOW_LEADER_ELECTION_DISABLED"
 	EnvLeaderElectionLeaseDuration      = "NUMAFLOW_LEADER_ELECTION_LEASE_DURATION"
@@ -181,12 +210,24 @@ const (
 
 	PathSideInputsMount = "/var/numaflow/side-inputs"
 
+	// Volume mount path of the pod annotations, for the vertices which close their windows when the pipeline is paused
+	PathPodInfoMount = "/var/numaflow/podinfo"
+
 	// ISB
 	DefaultBufferLength     = 30000
 	DefaultBufferUsageLimit = 0.8
 	DefaultReadBatchSize    = 500
 	DefaultReadTimeout      = 1 * time.Second
 
+	DefaultCompressionMinPayloadBytes = 256 // Default minimal size of a payload to be compressed
+
+	// Volume mount path and default options of the claim check
+	PathClaimCheckMount             = "/var/numaflow/claim-check"
+	DefaultClaimCheckThresholdBytes = 512 * 1024 // Default size above which a payload is stored out of the buffer
+
+	// Priority lanes
+	MaxPriorityLanes = 10 // Maximum number of the priority lanes of a buffer
+
 	// Auto scaling
 	DefaultLookbackSeconds          = 120 // Default lookback seconds for calculating avg rate and pending
 	DefaultCooldownSeconds          = 90  // Default cooldown seconds after a scaling operation
@@ -202,6 +243,30 @@ const (
 	DefaultPBQReadTimeout       = 1 * time.Second // Default read timeout for pbq
 	DefaultPBQReadBatchSize     = 100             // Default read batch size for pbq
 
+	// DefaultGlobalWindowFlushTimeout is the max time spent on closing the global windows when a reduce vertex shuts down
+	DefaultGlobalWindowFlushTimeout = 20 * time.Second
+
+	// DefaultHTTPSourceAckTimeout is the default time the HTTP source waits for the messages to be written when ackAfterWrite is enabled
+	DefaultHTTPSourceAckTimeout = 30 * time.Second
+
+	// Default file sink options
+	DefaultFileSinkMaxFileBytes = 64 * 1024 * 1024 // Default size of the files to roll
+	DefaultFileSinkRollInterval = 1 * time.Minute  // Default max age of the files to roll
+
+	// Default HTTP sink options
+	DefaultHTTPSinkTimeout          = 30 * time.Second // Default timeout of a request
+	DefaultHTTPSinkConcurrency      = 8                // Default max number of concurrent requests
+	DefaultHTTPSinkBatchMaxMessages = 100              // Default max number of messages in a batch request
+
+	// Default JetStream source options
+	DefaultJetStreamSourceAckWait = 30 * time.Second // Default ack wait of the consumer, the same as the server
+
+	// Volume mount path and default options of the disk ISB Service
+	PathISBSvcDiskMount              = "/var/numaflow/isbsvc"
+	DefaultISBSvcDiskMaxSegmentBytes = 64 * 1024 * 1024 // Default size of the segment files to roll
+	DefaultISBSvcDiskSyncInterval    = 1 * time.Second  // Default interval of syncing the written segments to the disk
+	DefaultISBSvcDiskConsumerTimeout = 60 * time.Second // Default time after which the in-flight messages of an inactive reader are redelivered
+
 	// PVC mount path for PBQ
 	PathPBQMount = "/var/numaflow/pbq"
 
@@ -280,6 +345,13 @@ const (
 	// to minimize the chances of data loss or failed deliveries in transient failure scenarios.
 	DefaultOnFailureRetryStrategy = OnFailureRetry
 
+	// Dead Letter
+
+	// DefaultDeadLetterMaxRetries is the default number of retries before the messages are written to the dead-letter buffer.
+	DefaultDeadLetterMaxRetries = 3
+	// DefaultDeadLetterRetryInterval is the default interval between two retries before dead-lettering.
+	DefaultDeadLetterRetryInterval = 1 * time.Second
+
 	// Defeault values for readiness and liveness probes
 	NumaContainerReadyzInitialDelaySeconds = 5
 	NumaContainerReadyzPeriodSeconds       = 10
@@ -0,0 +1,65 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const deadLetterBufferSuffix = "-dlq"
+
+// DeadLetter configures the dead-letter buffer of a vertex. Messages which still fail after the retry budget is
+// exhausted are written to the dead-letter buffer together with the error metadata, instead of being retried forever.
+// It applies to map udf and sink vertices only.
+type DeadLetter struct {
+	// MaxRetries is the number of retries of a failed map udf batch before the messages are dead-lettered, defaults to 3.
+	// For sink vertices, the retry budget is defined by the retry strategy of the sink.
+	// +optional
+	MaxRetries *uint32 `json:"maxRetries,omitempty" protobuf:"varint,1,opt,name=maxRetries"`
+	// RetryInterval is the interval between two retries of a failed map udf batch, defaults to 1s.
+	// +optional
+	RetryInterval *metav1.Duration `json:"retryInterval,omitempty" protobuf:"bytes,2,opt,name=retryInterval"`
+}
+
+func (dl DeadLetter) GetMaxRetries() int {
+	if dl.MaxRetries == nil {
+		return DefaultDeadLetterMaxRetries
+	}
+	return int(*dl.MaxRetries)
+}
+
+func (dl DeadLetter) GetRetryInterval() time.Duration {
+	if dl.RetryInterval == nil {
+		return DefaultDeadLetterRetryInterval
+	}
+	return dl.RetryInterval.Duration
+}
+
+// GenerateDeadLetterBufferName returns the name of the dead-letter buffer of a vertex.
+func GenerateDeadLetterBufferName(namespace, pipelineName, vertex string) string {
+	return fmt.Sprintf("%s-%s-%s%s", namespace, pipelineName, vertex, deadLetterBufferSuffix)
+}
+
+// IsDeadLetterBuffer tells if the given buffer name is a dead-letter buffer.
+// It is unambiguous because the names of the regular buffers always end with the partition index.
+func IsDeadLetterBuffer(buffer string) bool {
+	return strings.HasSuffix(buffer, deadLetterBufferSuffix)
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestDeadLetter_Getters(t *testing.T) {
+	dl := DeadLetter{}
+	assert.Equal(t, DefaultDeadLetterMaxRetries, dl.GetMaxRetries())
+	assert.Equal(t, DefaultDeadLetterRetryInterval, dl.GetRetryInterval())
+	dl = DeadLetter{MaxRetries: ptr.To[uint32](0), RetryInterval: &metav1.Duration{Duration: 5 * time.Second}}
+	assert.Equal(t, 0, dl.GetMaxRetries())
+	assert.Equal(t, 5*time.Second, dl.GetRetryInterval())
+}
+
+func TestDeadLetterBufferName(t *testing.T) {
+	assert.Equal(t, "ns-pl-v-dlq", GenerateDeadLetterBufferName("ns", "pl", "v"))
+	assert.True(t, IsDeadLetterBuffer("ns-pl-v-dlq"))
+	assert.False(t, IsDeadLetterBuffer("ns-pl-v-0"))
+
+	av := AbstractVertex{Name: "v", UDF: &UDF{}}
+	assert.Equal(t, "", av.DeadLetterBufferName("ns", "pl"))
+	av.DeadLetter = &DeadLetter{}
+	assert.Equal(t, "ns-pl-v-dlq", av.DeadLetterBufferName("ns", "pl"))
+	av.UDF.GroupBy = &GroupBy{}
+	assert.Equal(t, "", av.DeadLetterBufferName("ns", "pl"))
+}
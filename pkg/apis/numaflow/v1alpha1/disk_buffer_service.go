@@ -0,0 +1,76 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	apiresource "k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DiskBufferService is an ISB Service backed by segmented log files on a volume, it does not require any external broker.
+type DiskBufferService struct {
+	// Storage of the buffers and the watermark buckets, it is mounted to all the pods of the pipelines using
+	// this ISB Service, so it needs to be accessible from all of them.
+	DiskStorage `json:",inline" protobuf:"bytes,1,opt,name=storage"`
+	// Max size of a segment file, a new segment file is started once it is reached, defaults to 64Mi.
+	// +optional
+	MaxSegmentSize *apiresource.Quantity `json:"maxSegmentSize,omitempty" protobuf:"bytes,2,opt,name=maxSegmentSize"`
+	// Interval of syncing the written segments to the disk, defaults to 1s.
+	// Messages written after the last sync might be lost if the node crashes.
+	// +optional
+	SyncInterval *metav1.Duration `json:"syncInterval,omitempty" protobuf:"bytes,3,opt,name=syncInterval"`
+}
+
+func (d DiskBufferService) GetMaxSegmentBytes() int64 {
+	if d.MaxSegmentSize != nil {
+		return d.MaxSegmentSize.Value()
+	}
+	return DefaultISBSvcDiskMaxSegmentBytes
+}
+
+func (d DiskBufferService) GetSyncInterval() metav1.Duration {
+	if d.SyncInterval != nil {
+		return *d.SyncInterval
+	}
+	return metav1.Duration{Duration: DefaultISBSvcDiskSyncInterval}
+}
+
+// DiskStorage is the volume to store the buffers and the watermark buckets, only one of them can be specified.
+type DiskStorage struct {
+	// HostPath is a directory on the node, it is only suitable for single node clusters.
+	// +optional
+	HostPath *corev1.HostPathVolumeSource `json:"hostPath,omitempty" protobuf:"bytes,1,opt,name=hostPath"`
+	// PersistentVolumeClaim is an existing claim, its access mode needs to be ReadWriteMany if the pods of the
+	// pipelines can be scheduled on different nodes.
+	// +optional
+	PersistentVolumeClaim *corev1.PersistentVolumeClaimVolumeSource `json:"persistentVolumeClaim,omitempty" protobuf:"bytes,2,opt,name=persistentVolumeClaim"`
+}
+
+// GetVolumeSource returns the volume source of the storage.
+func (ds DiskStorage) GetVolumeSource() corev1.VolumeSource {
+	return corev1.VolumeSource{HostPath: ds.HostPath, PersistentVolumeClaim: ds.PersistentVolumeClaim}
+}
+
+type DiskConfig struct {
+	// Storage of the buffers and the watermark buckets
+	DiskStorage `json:",inline" protobuf:"bytes,1,opt,name=storage"`
+	// Max size in bytes of a segment file
+	MaxSegmentBytes int64 `json:"maxSegmentBytes,omitempty" protobuf:"varint,2,opt,name=maxSegmentBytes"`
+	// Interval of syncing the written segments to the disk
+	SyncInterval metav1.Duration `json:"syncInterval,omitempty" protobuf:"bytes,3,opt,name=syncInterval"`
+}
@@ -0,0 +1,37 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestDiskBufferService_Getters(t *testing.T) {
+	d := DiskBufferService{}
+	assert.Equal(t, int64(DefaultISBSvcDiskMaxSegmentBytes), d.GetMaxSegmentBytes())
+	assert.Equal(t, DefaultISBSvcDiskSyncInterval, d.GetSyncInterval().Duration)
+	q := resource.MustParse("1Mi")
+	d.MaxSegmentSize = &q
+	d.SyncInterval = &metav1.Duration{Duration: 5 * time.Second}
+	assert.Equal(t, int64(1024*1024), d.GetMaxSegmentBytes())
+	assert.Equal(t, 5*time.Second, d.GetSyncInterval().Duration)
+}
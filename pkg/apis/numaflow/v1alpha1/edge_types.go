@@ -30,6 +30,48 @@ type Edge struct {
 	// +kubebuilder:validation:Enum=retryUntilSuccess;discardLatest
 	// +optional
 	OnFull *BufferFullWritingStrategy `json:"onFull,omitempty" protobuf:"bytes,4,opt,name=onFull"`
+	// Compression of the message payloads written to the inter step buffer, it overrides the pipeline level settings.
+	// +optional
+	Compression *Compression `json:"compression,omitempty" protobuf:"bytes,5,opt,name=compression"`
+	// Priority assigns the messages forwarded through the edge to the priority lanes of the to vertex buffers,
+	// only supported by the JetStream ISB Service.
+	// +optional
+	Priority *EdgePriority `json:"priority,omitempty" protobuf:"bytes,6,opt,name=priority"`
+}
+
+// EdgePriority assigns the messages to the priority lanes of the inter step buffers, the messages in the higher
+// priority lanes are read before the ones in the lower priority lanes. Lane 0 has the lowest priority, it is where
+// the messages without a priority go, including the ones forwarded through the edges without priority settings.
+// The lanes are not supported by the edges to a reduce vertex, because reading the messages out of order could
+// make the ones in the lower priority lanes late.
+type EdgePriority struct {
+	// Lanes is the number of the priority lanes, it needs to be the same for all the edges to a vertex.
+	Lanes int32 `json:"lanes" protobuf:"varint,1,opt,name=lanes"`
+	// Header is the name of the message header of which the value is the lane of a message, e.g. "x-priority".
+	// A value which is not a number is treated as 0, and a number greater than the highest lane as the highest lane.
+	// +optional
+	Header string `json:"header,omitempty" protobuf:"bytes,2,opt,name=header"`
+	// Tags maps the message tags to the lanes, a message with multiple tags goes to the highest lane of them.
+	// The tags take precedence over the header.
+	// +optional
+	Tags map[string]int32 `json:"tags,omitempty" protobuf:"bytes,3,rep,name=tags"`
+	// Weights are the minimal shares of a read batch of the lanes from lane 0, relative to each other, which
+	// prevent the lower priority lanes from starving. The rest of a batch is filled from the highest priority lanes
+	// first. Defaults to 1 for every lane.
+	// +optional
+	Weights []int32 `json:"weights,omitempty" protobuf:"varint,4,rep,name=weights"`
+}
+
+// GetWeights returns the weights of all the lanes.
+func (ep EdgePriority) GetWeights() []int32 {
+	if len(ep.Weights) == int(ep.Lanes) {
+		return ep.Weights
+	}
+	weights := make([]int32, ep.Lanes)
+	for i := range weights {
+		weights[i] = 1
+	}
+	return weights
 }
 
 // CombinedEdge is a combination of Edge and some other properties such as vertex type, partitions, limits.
@@ -72,7 +114,14 @@ func (ce CombinedEdge) GetToVertexPartitionCount() int {
 
 type ForwardConditions struct {
 	// Tags used to specify tags for conditional forwarding
-	Tags *TagConditions `json:"tags" protobuf:"bytes,1,opt,name=tags"`
+	// +optional
+	Tags *TagConditions `json:"tags,omitempty" protobuf:"bytes,1,opt,name=tags"`
+	// Expression is a boolean expression evaluated against the "payload" (string), "keys" ([]string) and
+	// "headers" (map[string]string) of a message, the message is forwarded through the edge if it returns true.
+	// For example, `json(payload).amount > 100 && headers["x-region"] == "us"`.
+	// If both Tags and Expression are specified, a message needs to satisfy both of them.
+	// +optional
+	Expression string `json:"expression,omitempty" protobuf:"bytes,2,opt,name=expression"`
 }
 
 type LogicOperator string
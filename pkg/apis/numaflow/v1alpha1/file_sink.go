@@ -0,0 +1,102 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// FileEncoding is the encoding of the files written by the file sink.
+// +kubebuilder:validation:Enum=jsonl;csv;parquet
+type FileEncoding string
+
+const (
+	// FileEncodingJSONL writes a JSON object per line.
+	FileEncodingJSONL FileEncoding = "jsonl"
+	// FileEncodingCSV writes a CSV record per line, without a header.
+	FileEncodingCSV FileEncoding = "csv"
+	// FileEncodingParquet writes Parquet files with one row group.
+	FileEncodingParquet FileEncoding = "parquet"
+)
+
+// FileSink writes the messages to files in a local path or an S3 compatible object store.
+// The messages are staged and written to the files when they roll, they are acked after the files are committed.
+type FileSink struct {
+	// Path is the directory to write the files to, or the key prefix of the files if S3 is specified.
+	Path string `json:"path" protobuf:"bytes,1,opt,name=path"`
+	// S3 writes the files to an S3 compatible object store instead of the local path.
+	// +optional
+	S3 *FileSinkS3 `json:"s3,omitempty" protobuf:"bytes,2,opt,name=s3"`
+	// Encoding of the files, jsonl, csv or parquet, defaults to jsonl.
+	// +kubebuilder:default=jsonl
+	// +optional
+	Encoding FileEncoding `json:"encoding,omitempty" protobuf:"bytes,3,opt,name=encoding,casttype=FileEncoding"`
+	// MaxFileBytes rolls the files once one of them reaches the size, defaults to 64Mi.
+	// +optional
+	MaxFileBytes int64 `json:"maxFileBytes,omitempty" protobuf:"varint,4,opt,name=maxFileBytes"`
+	// RollInterval rolls the files once the oldest of them reaches the age, defaults to 1m.
+	// The messages are acked after the files roll, so it needs to be less than the ack wait of the inter-step buffer.
+	// +optional
+	RollInterval *metav1.Duration `json:"rollInterval,omitempty" protobuf:"bytes,5,opt,name=rollInterval"`
+	// EventTimeLayout partitions the directories by the event time of the messages in UTC, formatted with the Go
+	// time layout, e.g. "2006/01/02/15" for hourly directories.
+	// +optional
+	EventTimeLayout string `json:"eventTimeLayout,omitempty" protobuf:"bytes,6,opt,name=eventTimeLayout"`
+	// PartitionByKeys partitions the directories by the keys of the messages, after the event time directories.
+	// +optional
+	PartitionByKeys bool `json:"partitionByKeys,omitempty" protobuf:"varint,7,opt,name=partitionByKeys"`
+	// VolumeName is the name of a volume of the vertex, which is mounted at the path in the main container.
+	// It's required to write to a local path which survives the restarts, e.g. a persistent volume claim.
+	// +optional
+	VolumeName string `json:"volumeName,omitempty" protobuf:"bytes,8,opt,name=volumeName"`
+}
+
+// FileSinkS3 is an S3 compatible object store. The credentials are resolved with the default AWS credential chain,
+// e.g. the AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY environment variables or the IRSA web identity token.
+type FileSinkS3 struct {
+	// Bucket is the name of the bucket.
+	Bucket string `json:"bucket" protobuf:"bytes,1,opt,name=bucket"`
+	// Region of the bucket.
+	Region string `json:"region" protobuf:"bytes,2,opt,name=region"`
+	// EndpointURL is the custom endpoint URL of the object store, e.g. a MinIO server.
+	// The path-style URLs are used if it's specified.
+	// +optional
+	EndpointURL string `json:"endpointUrl,omitempty" protobuf:"bytes,3,opt,name=endpointUrl"`
+}
+
+func (f FileSink) GetEncoding() FileEncoding {
+	if f.Encoding == "" {
+		return FileEncodingJSONL
+	}
+	return f.Encoding
+}
+
+func (f FileSink) GetMaxFileBytes() int64 {
+	if f.MaxFileBytes > 0 {
+		return f.MaxFileBytes
+	}
+	return DefaultFileSinkMaxFileBytes
+}
+
+func (f FileSink) GetRollInterval() time.Duration {
+	if f.RollInterval != nil && f.RollInterval.Duration > 0 {
+		return f.RollInterval.Duration
+	}
+	return DefaultFileSinkRollInterval
+}
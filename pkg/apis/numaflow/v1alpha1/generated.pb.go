@@ -20,6 +20,7 @@ limitations under the License.
 package v1alpha1
 
 import (
+	encoding_binary "encoding/binary"
 	fmt "fmt"
 
 	io "io"
@@ -302,10 +303,66 @@ func (m *BufferServiceConfig) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_BufferServiceConfig proto.InternalMessageInfo
 
+func (m *ClaimCheck) Reset()      { *m = ClaimCheck{} }
+func (*ClaimCheck) ProtoMessage() {}
+func (*ClaimCheck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{9}
+}
+func (m *ClaimCheck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClaimCheck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ClaimCheck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClaimCheck.Merge(m, src)
+}
+func (m *ClaimCheck) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClaimCheck) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClaimCheck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClaimCheck proto.InternalMessageInfo
+
+func (m *ClaimCheckS3) Reset()      { *m = ClaimCheckS3{} }
+func (*ClaimCheckS3) ProtoMessage() {}
+func (*ClaimCheckS3) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{10}
+}
+func (m *ClaimCheckS3) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClaimCheckS3) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ClaimCheckS3) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClaimCheckS3.Merge(m, src)
+}
+func (m *ClaimCheckS3) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClaimCheckS3) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClaimCheckS3.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClaimCheckS3 proto.InternalMessageInfo
+
 func (m *CombinedEdge) Reset()      { *m = CombinedEdge{} }
 func (*CombinedEdge) ProtoMessage() {}
 func (*CombinedEdge) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{9}
+	return fileDescriptor_9d0d1b17d3865563, []int{11}
 }
 func (m *CombinedEdge) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -330,10 +387,38 @@ func (m *CombinedEdge) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CombinedEdge proto.InternalMessageInfo
 
+func (m *Compression) Reset()      { *m = Compression{} }
+func (*Compression) ProtoMessage() {}
+func (*Compression) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{12}
+}
+func (m *Compression) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Compression) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *Compression) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Compression.Merge(m, src)
+}
+func (m *Compression) XXX_Size() int {
+	return m.Size()
+}
+func (m *Compression) XXX_DiscardUnknown() {
+	xxx_messageInfo_Compression.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Compression proto.InternalMessageInfo
+
 func (m *Container) Reset()      { *m = Container{} }
 func (*Container) ProtoMessage() {}
 func (*Container) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{10}
+	return fileDescriptor_9d0d1b17d3865563, []int{13}
 }
 func (m *Container) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -361,7 +446,7 @@ var xxx_messageInfo_Container proto.InternalMessageInfo
 func (m *ContainerTemplate) Reset()      { *m = ContainerTemplate{} }
 func (*ContainerTemplate) ProtoMessage() {}
 func (*ContainerTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{11}
+	return fileDescriptor_9d0d1b17d3865563, []int{14}
 }
 func (m *ContainerTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -386,10 +471,38 @@ func (m *ContainerTemplate) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ContainerTemplate proto.InternalMessageInfo
 
+func (m *CountWindow) Reset()      { *m = CountWindow{} }
+func (*CountWindow) ProtoMessage() {}
+func (*CountWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{15}
+}
+func (m *CountWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CountWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *CountWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CountWindow.Merge(m, src)
+}
+func (m *CountWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *CountWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_CountWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CountWindow proto.InternalMessageInfo
+
 func (m *DaemonTemplate) Reset()      { *m = DaemonTemplate{} }
 func (*DaemonTemplate) ProtoMessage() {}
 func (*DaemonTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{12}
+	return fileDescriptor_9d0d1b17d3865563, []int{16}
 }
 func (m *DaemonTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -414,10 +527,122 @@ func (m *DaemonTemplate) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DaemonTemplate proto.InternalMessageInfo
 
+func (m *DeadLetter) Reset()      { *m = DeadLetter{} }
+func (*DeadLetter) ProtoMessage() {}
+func (*DeadLetter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{17}
+}
+func (m *DeadLetter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeadLetter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *DeadLetter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeadLetter.Merge(m, src)
+}
+func (m *DeadLetter) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeadLetter) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeadLetter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeadLetter proto.InternalMessageInfo
+
+func (m *DiskBufferService) Reset()      { *m = DiskBufferService{} }
+func (*DiskBufferService) ProtoMessage() {}
+func (*DiskBufferService) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{18}
+}
+func (m *DiskBufferService) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DiskBufferService) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *DiskBufferService) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiskBufferService.Merge(m, src)
+}
+func (m *DiskBufferService) XXX_Size() int {
+	return m.Size()
+}
+func (m *DiskBufferService) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiskBufferService.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DiskBufferService proto.InternalMessageInfo
+
+func (m *DiskConfig) Reset()      { *m = DiskConfig{} }
+func (*DiskConfig) ProtoMessage() {}
+func (*DiskConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{19}
+}
+func (m *DiskConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DiskConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *DiskConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiskConfig.Merge(m, src)
+}
+func (m *DiskConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *DiskConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiskConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DiskConfig proto.InternalMessageInfo
+
+func (m *DiskStorage) Reset()      { *m = DiskStorage{} }
+func (*DiskStorage) ProtoMessage() {}
+func (*DiskStorage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{20}
+}
+func (m *DiskStorage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DiskStorage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *DiskStorage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiskStorage.Merge(m, src)
+}
+func (m *DiskStorage) XXX_Size() int {
+	return m.Size()
+}
+func (m *DiskStorage) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiskStorage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DiskStorage proto.InternalMessageInfo
+
 func (m *Edge) Reset()      { *m = Edge{} }
 func (*Edge) ProtoMessage() {}
 func (*Edge) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{13}
+	return fileDescriptor_9d0d1b17d3865563, []int{21}
 }
 func (m *Edge) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -442,10 +667,94 @@ func (m *Edge) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Edge proto.InternalMessageInfo
 
+func (m *EdgePriority) Reset()      { *m = EdgePriority{} }
+func (*EdgePriority) ProtoMessage() {}
+func (*EdgePriority) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{22}
+}
+func (m *EdgePriority) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EdgePriority) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *EdgePriority) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EdgePriority.Merge(m, src)
+}
+func (m *EdgePriority) XXX_Size() int {
+	return m.Size()
+}
+func (m *EdgePriority) XXX_DiscardUnknown() {
+	xxx_messageInfo_EdgePriority.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EdgePriority proto.InternalMessageInfo
+
+func (m *FileSink) Reset()      { *m = FileSink{} }
+func (*FileSink) ProtoMessage() {}
+func (*FileSink) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{23}
+}
+func (m *FileSink) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FileSink) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *FileSink) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FileSink.Merge(m, src)
+}
+func (m *FileSink) XXX_Size() int {
+	return m.Size()
+}
+func (m *FileSink) XXX_DiscardUnknown() {
+	xxx_messageInfo_FileSink.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FileSink proto.InternalMessageInfo
+
+func (m *FileSinkS3) Reset()      { *m = FileSinkS3{} }
+func (*FileSinkS3) ProtoMessage() {}
+func (*FileSinkS3) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{24}
+}
+func (m *FileSinkS3) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FileSinkS3) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *FileSinkS3) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FileSinkS3.Merge(m, src)
+}
+func (m *FileSinkS3) XXX_Size() int {
+	return m.Size()
+}
+func (m *FileSinkS3) XXX_DiscardUnknown() {
+	xxx_messageInfo_FileSinkS3.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FileSinkS3 proto.InternalMessageInfo
+
 func (m *FixedWindow) Reset()      { *m = FixedWindow{} }
 func (*FixedWindow) ProtoMessage() {}
 func (*FixedWindow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{14}
+	return fileDescriptor_9d0d1b17d3865563, []int{25}
 }
 func (m *FixedWindow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -473,7 +782,7 @@ var xxx_messageInfo_FixedWindow proto.InternalMessageInfo
 func (m *ForwardConditions) Reset()      { *m = ForwardConditions{} }
 func (*ForwardConditions) ProtoMessage() {}
 func (*ForwardConditions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{15}
+	return fileDescriptor_9d0d1b17d3865563, []int{26}
 }
 func (m *ForwardConditions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -501,7 +810,7 @@ var xxx_messageInfo_ForwardConditions proto.InternalMessageInfo
 func (m *Function) Reset()      { *m = Function{} }
 func (*Function) ProtoMessage() {}
 func (*Function) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{16}
+	return fileDescriptor_9d0d1b17d3865563, []int{27}
 }
 func (m *Function) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -529,7 +838,7 @@ var xxx_messageInfo_Function proto.InternalMessageInfo
 func (m *GSSAPI) Reset()      { *m = GSSAPI{} }
 func (*GSSAPI) ProtoMessage() {}
 func (*GSSAPI) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{17}
+	return fileDescriptor_9d0d1b17d3865563, []int{28}
 }
 func (m *GSSAPI) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -554,10 +863,66 @@ func (m *GSSAPI) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GSSAPI proto.InternalMessageInfo
 
+func (m *GeneratorOutOfOrder) Reset()      { *m = GeneratorOutOfOrder{} }
+func (*GeneratorOutOfOrder) ProtoMessage() {}
+func (*GeneratorOutOfOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{29}
+}
+func (m *GeneratorOutOfOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GeneratorOutOfOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *GeneratorOutOfOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GeneratorOutOfOrder.Merge(m, src)
+}
+func (m *GeneratorOutOfOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *GeneratorOutOfOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_GeneratorOutOfOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GeneratorOutOfOrder proto.InternalMessageInfo
+
+func (m *GeneratorReplay) Reset()      { *m = GeneratorReplay{} }
+func (*GeneratorReplay) ProtoMessage() {}
+func (*GeneratorReplay) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{30}
+}
+func (m *GeneratorReplay) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GeneratorReplay) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *GeneratorReplay) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GeneratorReplay.Merge(m, src)
+}
+func (m *GeneratorReplay) XXX_Size() int {
+	return m.Size()
+}
+func (m *GeneratorReplay) XXX_DiscardUnknown() {
+	xxx_messageInfo_GeneratorReplay.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GeneratorReplay proto.InternalMessageInfo
+
 func (m *GeneratorSource) Reset()      { *m = GeneratorSource{} }
 func (*GeneratorSource) ProtoMessage() {}
 func (*GeneratorSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{18}
+	return fileDescriptor_9d0d1b17d3865563, []int{31}
 }
 func (m *GeneratorSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -585,7 +950,7 @@ var xxx_messageInfo_GeneratorSource proto.InternalMessageInfo
 func (m *GetDaemonDeploymentReq) Reset()      { *m = GetDaemonDeploymentReq{} }
 func (*GetDaemonDeploymentReq) ProtoMessage() {}
 func (*GetDaemonDeploymentReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{19}
+	return fileDescriptor_9d0d1b17d3865563, []int{32}
 }
 func (m *GetDaemonDeploymentReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -613,7 +978,7 @@ var xxx_messageInfo_GetDaemonDeploymentReq proto.InternalMessageInfo
 func (m *GetJetStreamServiceSpecReq) Reset()      { *m = GetJetStreamServiceSpecReq{} }
 func (*GetJetStreamServiceSpecReq) ProtoMessage() {}
 func (*GetJetStreamServiceSpecReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{20}
+	return fileDescriptor_9d0d1b17d3865563, []int{33}
 }
 func (m *GetJetStreamServiceSpecReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -641,7 +1006,7 @@ var xxx_messageInfo_GetJetStreamServiceSpecReq proto.InternalMessageInfo
 func (m *GetJetStreamStatefulSetSpecReq) Reset()      { *m = GetJetStreamStatefulSetSpecReq{} }
 func (*GetJetStreamStatefulSetSpecReq) ProtoMessage() {}
 func (*GetJetStreamStatefulSetSpecReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{21}
+	return fileDescriptor_9d0d1b17d3865563, []int{34}
 }
 func (m *GetJetStreamStatefulSetSpecReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -669,7 +1034,7 @@ var xxx_messageInfo_GetJetStreamStatefulSetSpecReq proto.InternalMessageInfo
 func (m *GetMonoVertexDaemonDeploymentReq) Reset()      { *m = GetMonoVertexDaemonDeploymentReq{} }
 func (*GetMonoVertexDaemonDeploymentReq) ProtoMessage() {}
 func (*GetMonoVertexDaemonDeploymentReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{22}
+	return fileDescriptor_9d0d1b17d3865563, []int{35}
 }
 func (m *GetMonoVertexDaemonDeploymentReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -697,7 +1062,7 @@ var xxx_messageInfo_GetMonoVertexDaemonDeploymentReq proto.InternalMessageInfo
 func (m *GetMonoVertexPodSpecReq) Reset()      { *m = GetMonoVertexPodSpecReq{} }
 func (*GetMonoVertexPodSpecReq) ProtoMessage() {}
 func (*GetMonoVertexPodSpecReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{23}
+	return fileDescriptor_9d0d1b17d3865563, []int{36}
 }
 func (m *GetMonoVertexPodSpecReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -725,7 +1090,7 @@ var xxx_messageInfo_GetMonoVertexPodSpecReq proto.InternalMessageInfo
 func (m *GetRedisServiceSpecReq) Reset()      { *m = GetRedisServiceSpecReq{} }
 func (*GetRedisServiceSpecReq) ProtoMessage() {}
 func (*GetRedisServiceSpecReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{24}
+	return fileDescriptor_9d0d1b17d3865563, []int{37}
 }
 func (m *GetRedisServiceSpecReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -753,7 +1118,7 @@ var xxx_messageInfo_GetRedisServiceSpecReq proto.InternalMessageInfo
 func (m *GetRedisStatefulSetSpecReq) Reset()      { *m = GetRedisStatefulSetSpecReq{} }
 func (*GetRedisStatefulSetSpecReq) ProtoMessage() {}
 func (*GetRedisStatefulSetSpecReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{25}
+	return fileDescriptor_9d0d1b17d3865563, []int{38}
 }
 func (m *GetRedisStatefulSetSpecReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -781,7 +1146,7 @@ var xxx_messageInfo_GetRedisStatefulSetSpecReq proto.InternalMessageInfo
 func (m *GetServingPipelineResourceReq) Reset()      { *m = GetServingPipelineResourceReq{} }
 func (*GetServingPipelineResourceReq) ProtoMessage() {}
 func (*GetServingPipelineResourceReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{26}
+	return fileDescriptor_9d0d1b17d3865563, []int{39}
 }
 func (m *GetServingPipelineResourceReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -809,7 +1174,7 @@ var xxx_messageInfo_GetServingPipelineResourceReq proto.InternalMessageInfo
 func (m *GetSideInputDeploymentReq) Reset()      { *m = GetSideInputDeploymentReq{} }
 func (*GetSideInputDeploymentReq) ProtoMessage() {}
 func (*GetSideInputDeploymentReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{27}
+	return fileDescriptor_9d0d1b17d3865563, []int{40}
 }
 func (m *GetSideInputDeploymentReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -837,7 +1202,7 @@ var xxx_messageInfo_GetSideInputDeploymentReq proto.InternalMessageInfo
 func (m *GetVertexPodSpecReq) Reset()      { *m = GetVertexPodSpecReq{} }
 func (*GetVertexPodSpecReq) ProtoMessage() {}
 func (*GetVertexPodSpecReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{28}
+	return fileDescriptor_9d0d1b17d3865563, []int{41}
 }
 func (m *GetVertexPodSpecReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -862,10 +1227,38 @@ func (m *GetVertexPodSpecReq) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GetVertexPodSpecReq proto.InternalMessageInfo
 
+func (m *GlobalWindow) Reset()      { *m = GlobalWindow{} }
+func (*GlobalWindow) ProtoMessage() {}
+func (*GlobalWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{42}
+}
+func (m *GlobalWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GlobalWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *GlobalWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GlobalWindow.Merge(m, src)
+}
+func (m *GlobalWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *GlobalWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_GlobalWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GlobalWindow proto.InternalMessageInfo
+
 func (m *GroupBy) Reset()      { *m = GroupBy{} }
 func (*GroupBy) ProtoMessage() {}
 func (*GroupBy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{29}
+	return fileDescriptor_9d0d1b17d3865563, []int{43}
 }
 func (m *GroupBy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -890,10 +1283,94 @@ func (m *GroupBy) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GroupBy proto.InternalMessageInfo
 
+func (m *HTTPBatch) Reset()      { *m = HTTPBatch{} }
+func (*HTTPBatch) ProtoMessage() {}
+func (*HTTPBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{44}
+}
+func (m *HTTPBatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HTTPBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HTTPBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HTTPBatch.Merge(m, src)
+}
+func (m *HTTPBatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *HTTPBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_HTTPBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HTTPBatch proto.InternalMessageInfo
+
+func (m *HTTPSink) Reset()      { *m = HTTPSink{} }
+func (*HTTPSink) ProtoMessage() {}
+func (*HTTPSink) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{45}
+}
+func (m *HTTPSink) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HTTPSink) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HTTPSink) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HTTPSink.Merge(m, src)
+}
+func (m *HTTPSink) XXX_Size() int {
+	return m.Size()
+}
+func (m *HTTPSink) XXX_DiscardUnknown() {
+	xxx_messageInfo_HTTPSink.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HTTPSink proto.InternalMessageInfo
+
+func (m *HTTPSinkBatch) Reset()      { *m = HTTPSinkBatch{} }
+func (*HTTPSinkBatch) ProtoMessage() {}
+func (*HTTPSinkBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{46}
+}
+func (m *HTTPSinkBatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HTTPSinkBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HTTPSinkBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HTTPSinkBatch.Merge(m, src)
+}
+func (m *HTTPSinkBatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *HTTPSinkBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_HTTPSinkBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HTTPSinkBatch proto.InternalMessageInfo
+
 func (m *HTTPSource) Reset()      { *m = HTTPSource{} }
 func (*HTTPSource) ProtoMessage() {}
 func (*HTTPSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{30}
+	return fileDescriptor_9d0d1b17d3865563, []int{47}
 }
 func (m *HTTPSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -921,7 +1398,7 @@ var xxx_messageInfo_HTTPSource proto.InternalMessageInfo
 func (m *IdleSource) Reset()      { *m = IdleSource{} }
 func (*IdleSource) ProtoMessage() {}
 func (*IdleSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{31}
+	return fileDescriptor_9d0d1b17d3865563, []int{48}
 }
 func (m *IdleSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -946,10 +1423,38 @@ func (m *IdleSource) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_IdleSource proto.InternalMessageInfo
 
+func (m *InterStepBuffer) Reset()      { *m = InterStepBuffer{} }
+func (*InterStepBuffer) ProtoMessage() {}
+func (*InterStepBuffer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{49}
+}
+func (m *InterStepBuffer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InterStepBuffer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *InterStepBuffer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InterStepBuffer.Merge(m, src)
+}
+func (m *InterStepBuffer) XXX_Size() int {
+	return m.Size()
+}
+func (m *InterStepBuffer) XXX_DiscardUnknown() {
+	xxx_messageInfo_InterStepBuffer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InterStepBuffer proto.InternalMessageInfo
+
 func (m *InterStepBufferService) Reset()      { *m = InterStepBufferService{} }
 func (*InterStepBufferService) ProtoMessage() {}
 func (*InterStepBufferService) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{32}
+	return fileDescriptor_9d0d1b17d3865563, []int{50}
 }
 func (m *InterStepBufferService) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -977,7 +1482,7 @@ var xxx_messageInfo_InterStepBufferService proto.InternalMessageInfo
 func (m *InterStepBufferServiceList) Reset()      { *m = InterStepBufferServiceList{} }
 func (*InterStepBufferServiceList) ProtoMessage() {}
 func (*InterStepBufferServiceList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{33}
+	return fileDescriptor_9d0d1b17d3865563, []int{51}
 }
 func (m *InterStepBufferServiceList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1005,7 +1510,7 @@ var xxx_messageInfo_InterStepBufferServiceList proto.InternalMessageInfo
 func (m *InterStepBufferServiceSpec) Reset()      { *m = InterStepBufferServiceSpec{} }
 func (*InterStepBufferServiceSpec) ProtoMessage() {}
 func (*InterStepBufferServiceSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{34}
+	return fileDescriptor_9d0d1b17d3865563, []int{52}
 }
 func (m *InterStepBufferServiceSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1033,7 +1538,7 @@ var xxx_messageInfo_InterStepBufferServiceSpec proto.InternalMessageInfo
 func (m *InterStepBufferServiceStatus) Reset()      { *m = InterStepBufferServiceStatus{} }
 func (*InterStepBufferServiceStatus) ProtoMessage() {}
 func (*InterStepBufferServiceStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{35}
+	return fileDescriptor_9d0d1b17d3865563, []int{53}
 }
 func (m *InterStepBufferServiceStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1061,7 +1566,7 @@ var xxx_messageInfo_InterStepBufferServiceStatus proto.InternalMessageInfo
 func (m *JetStreamBufferService) Reset()      { *m = JetStreamBufferService{} }
 func (*JetStreamBufferService) ProtoMessage() {}
 func (*JetStreamBufferService) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{36}
+	return fileDescriptor_9d0d1b17d3865563, []int{54}
 }
 func (m *JetStreamBufferService) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1089,7 +1594,7 @@ var xxx_messageInfo_JetStreamBufferService proto.InternalMessageInfo
 func (m *JetStreamConfig) Reset()      { *m = JetStreamConfig{} }
 func (*JetStreamConfig) ProtoMessage() {}
 func (*JetStreamConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{37}
+	return fileDescriptor_9d0d1b17d3865563, []int{55}
 }
 func (m *JetStreamConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1117,7 +1622,7 @@ var xxx_messageInfo_JetStreamConfig proto.InternalMessageInfo
 func (m *JetStreamSource) Reset()      { *m = JetStreamSource{} }
 func (*JetStreamSource) ProtoMessage() {}
 func (*JetStreamSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{38}
+	return fileDescriptor_9d0d1b17d3865563, []int{56}
 }
 func (m *JetStreamSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1145,7 +1650,7 @@ var xxx_messageInfo_JetStreamSource proto.InternalMessageInfo
 func (m *JobTemplate) Reset()      { *m = JobTemplate{} }
 func (*JobTemplate) ProtoMessage() {}
 func (*JobTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{39}
+	return fileDescriptor_9d0d1b17d3865563, []int{57}
 }
 func (m *JobTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1173,7 +1678,7 @@ var xxx_messageInfo_JobTemplate proto.InternalMessageInfo
 func (m *KafkaSink) Reset()      { *m = KafkaSink{} }
 func (*KafkaSink) ProtoMessage() {}
 func (*KafkaSink) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{40}
+	return fileDescriptor_9d0d1b17d3865563, []int{58}
 }
 func (m *KafkaSink) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1201,7 +1706,7 @@ var xxx_messageInfo_KafkaSink proto.InternalMessageInfo
 func (m *KafkaSource) Reset()      { *m = KafkaSource{} }
 func (*KafkaSource) ProtoMessage() {}
 func (*KafkaSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{41}
+	return fileDescriptor_9d0d1b17d3865563, []int{59}
 }
 func (m *KafkaSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1226,10 +1731,38 @@ func (m *KafkaSource) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_KafkaSource proto.InternalMessageInfo
 
+func (m *KafkaStartPosition) Reset()      { *m = KafkaStartPosition{} }
+func (*KafkaStartPosition) ProtoMessage() {}
+func (*KafkaStartPosition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{60}
+}
+func (m *KafkaStartPosition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KafkaStartPosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *KafkaStartPosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KafkaStartPosition.Merge(m, src)
+}
+func (m *KafkaStartPosition) XXX_Size() int {
+	return m.Size()
+}
+func (m *KafkaStartPosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_KafkaStartPosition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KafkaStartPosition proto.InternalMessageInfo
+
 func (m *Lifecycle) Reset()      { *m = Lifecycle{} }
 func (*Lifecycle) ProtoMessage() {}
 func (*Lifecycle) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{42}
+	return fileDescriptor_9d0d1b17d3865563, []int{61}
 }
 func (m *Lifecycle) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1257,7 +1790,7 @@ var xxx_messageInfo_Lifecycle proto.InternalMessageInfo
 func (m *Log) Reset()      { *m = Log{} }
 func (*Log) ProtoMessage() {}
 func (*Log) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{43}
+	return fileDescriptor_9d0d1b17d3865563, []int{62}
 }
 func (m *Log) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1282,10 +1815,38 @@ func (m *Log) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Log proto.InternalMessageInfo
 
+func (m *LogSampling) Reset()      { *m = LogSampling{} }
+func (*LogSampling) ProtoMessage() {}
+func (*LogSampling) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d0d1b17d3865563, []int{63}
+}
+func (m *LogSampling) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LogSampling) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *LogSampling) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogSampling.Merge(m, src)
+}
+func (m *LogSampling) XXX_Size() int {
+	return m.Size()
+}
+func (m *LogSampling) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogSampling.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogSampling proto.InternalMessageInfo
+
 func (m *Metadata) Reset()      { *m = Metadata{} }
 func (*Metadata) ProtoMessage() {}
 func (*Metadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{44}
+	return fileDescriptor_9d0d1b17d3865563, []int{64}
 }
 func (m *Metadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1313,7 +1874,7 @@ var xxx_messageInfo_Metadata proto.InternalMessageInfo
 func (m *MonoVertex) Reset()      { *m = MonoVertex{} }
 func (*MonoVertex) ProtoMessage() {}
 func (*MonoVertex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{45}
+	return fileDescriptor_9d0d1b17d3865563, []int{65}
 }
 func (m *MonoVertex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1341,7 +1902,7 @@ var xxx_messageInfo_MonoVertex proto.InternalMessageInfo
 func (m *MonoVertexLifecycle) Reset()      { *m = MonoVertexLifecycle{} }
 func (*MonoVertexLifecycle) ProtoMessage() {}
 func (*MonoVertexLifecycle) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{46}
+	return fileDescriptor_9d0d1b17d3865563, []int{66}
 }
 func (m *MonoVertexLifecycle) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1369,7 +1930,7 @@ var xxx_messageInfo_MonoVertexLifecycle proto.InternalMessageInfo
 func (m *MonoVertexLimits) Reset()      { *m = MonoVertexLimits{} }
 func (*MonoVertexLimits) ProtoMessage() {}
 func (*MonoVertexLimits) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{47}
+	return fileDescriptor_9d0d1b17d3865563, []int{67}
 }
 func (m *MonoVertexLimits) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1397,7 +1958,7 @@ var xxx_messageInfo_MonoVertexLimits proto.InternalMessageInfo
 func (m *MonoVertexList) Reset()      { *m = MonoVertexList{} }
 func (*MonoVertexList) ProtoMessage() {}
 func (*MonoVertexList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{48}
+	return fileDescriptor_9d0d1b17d3865563, []int{68}
 }
 func (m *MonoVertexList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1425,7 +1986,7 @@ var xxx_messageInfo_MonoVertexList proto.InternalMessageInfo
 func (m *MonoVertexSpec) Reset()      { *m = MonoVertexSpec{} }
 func (*MonoVertexSpec) ProtoMessage() {}
 func (*MonoVertexSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{49}
+	return fileDescriptor_9d0d1b17d3865563, []int{69}
 }
 func (m *MonoVertexSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1453,7 +2014,7 @@ var xxx_messageInfo_MonoVertexSpec proto.InternalMessageInfo
 func (m *MonoVertexStatus) Reset()      { *m = MonoVertexStatus{} }
 func (*MonoVertexStatus) ProtoMessage() {}
 func (*MonoVertexStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{50}
+	return fileDescriptor_9d0d1b17d3865563, []int{70}
 }
 func (m *MonoVertexStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1481,7 +2042,7 @@ var xxx_messageInfo_MonoVertexStatus proto.InternalMessageInfo
 func (m *NativeRedis) Reset()      { *m = NativeRedis{} }
 func (*NativeRedis) ProtoMessage() {}
 func (*NativeRedis) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{51}
+	return fileDescriptor_9d0d1b17d3865563, []int{71}
 }
 func (m *NativeRedis) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1509,7 +2070,7 @@ var xxx_messageInfo_NativeRedis proto.InternalMessageInfo
 func (m *NatsAuth) Reset()      { *m = NatsAuth{} }
 func (*NatsAuth) ProtoMessage() {}
 func (*NatsAuth) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{52}
+	return fileDescriptor_9d0d1b17d3865563, []int{72}
 }
 func (m *NatsAuth) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1537,7 +2098,7 @@ var xxx_messageInfo_NatsAuth proto.InternalMessageInfo
 func (m *NatsSource) Reset()      { *m = NatsSource{} }
 func (*NatsSource) ProtoMessage() {}
 func (*NatsSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{53}
+	return fileDescriptor_9d0d1b17d3865563, []int{73}
 }
 func (m *NatsSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1565,7 +2126,7 @@ var xxx_messageInfo_NatsSource proto.InternalMessageInfo
 func (m *NoStore) Reset()      { *m = NoStore{} }
 func (*NoStore) ProtoMessage() {}
 func (*NoStore) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{54}
+	return fileDescriptor_9d0d1b17d3865563, []int{74}
 }
 func (m *NoStore) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1593,7 +2154,7 @@ var xxx_messageInfo_NoStore proto.InternalMessageInfo
 func (m *PBQStorage) Reset()      { *m = PBQStorage{} }
 func (*PBQStorage) ProtoMessage() {}
 func (*PBQStorage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{55}
+	return fileDescriptor_9d0d1b17d3865563, []int{75}
 }
 func (m *PBQStorage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1621,7 +2182,7 @@ var xxx_messageInfo_PBQStorage proto.InternalMessageInfo
 func (m *PersistenceStrategy) Reset()      { *m = PersistenceStrategy{} }
 func (*PersistenceStrategy) ProtoMessage() {}
 func (*PersistenceStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{56}
+	return fileDescriptor_9d0d1b17d3865563, []int{76}
 }
 func (m *PersistenceStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1649,7 +2210,7 @@ var xxx_messageInfo_PersistenceStrategy proto.InternalMessageInfo
 func (m *Pipeline) Reset()      { *m = Pipeline{} }
 func (*Pipeline) ProtoMessage() {}
 func (*Pipeline) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{57}
+	return fileDescriptor_9d0d1b17d3865563, []int{77}
 }
 func (m *Pipeline) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1677,7 +2238,7 @@ var xxx_messageInfo_Pipeline proto.InternalMessageInfo
 func (m *PipelineLimits) Reset()      { *m = PipelineLimits{} }
 func (*PipelineLimits) ProtoMessage() {}
 func (*PipelineLimits) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{58}
+	return fileDescriptor_9d0d1b17d3865563, []int{78}
 }
 func (m *PipelineLimits) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1705,7 +2266,7 @@ var xxx_messageInfo_PipelineLimits proto.InternalMessageInfo
 func (m *PipelineList) Reset()      { *m = PipelineList{} }
 func (*PipelineList) ProtoMessage() {}
 func (*PipelineList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{59}
+	return fileDescriptor_9d0d1b17d3865563, []int{79}
 }
 func (m *PipelineList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1733,7 +2294,7 @@ var xxx_messageInfo_PipelineList proto.InternalMessageInfo
 func (m *PipelineSpec) Reset()      { *m = PipelineSpec{} }
 func (*PipelineSpec) ProtoMessage() {}
 func (*PipelineSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{60}
+	return fileDescriptor_9d0d1b17d3865563, []int{80}
 }
 func (m *PipelineSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1761,7 +2322,7 @@ var xxx_messageInfo_PipelineSpec proto.InternalMessageInfo
 func (m *PipelineStatus) Reset()      { *m = PipelineStatus{} }
 func (*PipelineStatus) ProtoMessage() {}
 func (*PipelineStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{61}
+	return fileDescriptor_9d0d1b17d3865563, []int{81}
 }
 func (m *PipelineStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1789,7 +2350,7 @@ var xxx_messageInfo_PipelineStatus proto.InternalMessageInfo
 func (m *Probe) Reset()      { *m = Probe{} }
 func (*Probe) ProtoMessage() {}
 func (*Probe) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{62}
+	return fileDescriptor_9d0d1b17d3865563, []int{82}
 }
 func (m *Probe) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1817,7 +2378,7 @@ var xxx_messageInfo_Probe proto.InternalMessageInfo
 func (m *PulsarAuth) Reset()      { *m = PulsarAuth{} }
 func (*PulsarAuth) ProtoMessage() {}
 func (*PulsarAuth) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{63}
+	return fileDescriptor_9d0d1b17d3865563, []int{83}
 }
 func (m *PulsarAuth) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1845,7 +2406,7 @@ var xxx_messageInfo_PulsarAuth proto.InternalMessageInfo
 func (m *PulsarSource) Reset()      { *m = PulsarSource{} }
 func (*PulsarSource) ProtoMessage() {}
 func (*PulsarSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{64}
+	return fileDescriptor_9d0d1b17d3865563, []int{84}
 }
 func (m *PulsarSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1873,7 +2434,7 @@ var xxx_messageInfo_PulsarSource proto.InternalMessageInfo
 func (m *RedisBufferService) Reset()      { *m = RedisBufferService{} }
 func (*RedisBufferService) ProtoMessage() {}
 func (*RedisBufferService) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{65}
+	return fileDescriptor_9d0d1b17d3865563, []int{85}
 }
 func (m *RedisBufferService) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1901,7 +2462,7 @@ var xxx_messageInfo_RedisBufferService proto.InternalMessageInfo
 func (m *RedisConfig) Reset()      { *m = RedisConfig{} }
 func (*RedisConfig) ProtoMessage() {}
 func (*RedisConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{66}
+	return fileDescriptor_9d0d1b17d3865563, []int{86}
 }
 func (m *RedisConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1929,7 +2490,7 @@ var xxx_messageInfo_RedisConfig proto.InternalMessageInfo
 func (m *RedisSettings) Reset()      { *m = RedisSettings{} }
 func (*RedisSettings) ProtoMessage() {}
 func (*RedisSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{67}
+	return fileDescriptor_9d0d1b17d3865563, []int{87}
 }
 func (m *RedisSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1957,7 +2518,7 @@ var xxx_messageInfo_RedisSettings proto.InternalMessageInfo
 func (m *RetryStrategy) Reset()      { *m = RetryStrategy{} }
 func (*RetryStrategy) ProtoMessage() {}
 func (*RetryStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{68}
+	return fileDescriptor_9d0d1b17d3865563, []int{88}
 }
 func (m *RetryStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1985,7 +2546,7 @@ var xxx_messageInfo_RetryStrategy proto.InternalMessageInfo
 func (m *RollingUpdateStrategy) Reset()      { *m = RollingUpdateStrategy{} }
 func (*RollingUpdateStrategy) ProtoMessage() {}
 func (*RollingUpdateStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{69}
+	return fileDescriptor_9d0d1b17d3865563, []int{89}
 }
 func (m *RollingUpdateStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2013,7 +2574,7 @@ var xxx_messageInfo_RollingUpdateStrategy proto.InternalMessageInfo
 func (m *SASL) Reset()      { *m = SASL{} }
 func (*SASL) ProtoMessage() {}
 func (*SASL) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{70}
+	return fileDescriptor_9d0d1b17d3865563, []int{90}
 }
 func (m *SASL) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2041,7 +2602,7 @@ var xxx_messageInfo_SASL proto.InternalMessageInfo
 func (m *SASLOAuth) Reset()      { *m = SASLOAuth{} }
 func (*SASLOAuth) ProtoMessage() {}
 func (*SASLOAuth) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{71}
+	return fileDescriptor_9d0d1b17d3865563, []int{91}
 }
 func (m *SASLOAuth) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2069,7 +2630,7 @@ var xxx_messageInfo_SASLOAuth proto.InternalMessageInfo
 func (m *SASLPlain) Reset()      { *m = SASLPlain{} }
 func (*SASLPlain) ProtoMessage() {}
 func (*SASLPlain) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{72}
+	return fileDescriptor_9d0d1b17d3865563, []int{92}
 }
 func (m *SASLPlain) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2097,7 +2658,7 @@ var xxx_messageInfo_SASLPlain proto.InternalMessageInfo
 func (m *Scale) Reset()      { *m = Scale{} }
 func (*Scale) ProtoMessage() {}
 func (*Scale) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{73}
+	return fileDescriptor_9d0d1b17d3865563, []int{93}
 }
 func (m *Scale) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2125,7 +2686,7 @@ var xxx_messageInfo_Scale proto.InternalMessageInfo
 func (m *ServeSink) Reset()      { *m = ServeSink{} }
 func (*ServeSink) ProtoMessage() {}
 func (*ServeSink) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{74}
+	return fileDescriptor_9d0d1b17d3865563, []int{94}
 }
 func (m *ServeSink) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2153,7 +2714,7 @@ var xxx_messageInfo_ServeSink proto.InternalMessageInfo
 func (m *ServingPipeline) Reset()      { *m = ServingPipeline{} }
 func (*ServingPipeline) ProtoMessage() {}
 func (*ServingPipeline) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{75}
+	return fileDescriptor_9d0d1b17d3865563, []int{95}
 }
 func (m *ServingPipeline) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2181,7 +2742,7 @@ var xxx_messageInfo_ServingPipeline proto.InternalMessageInfo
 func (m *ServingPipelineList) Reset()      { *m = ServingPipelineList{} }
 func (*ServingPipelineList) ProtoMessage() {}
 func (*ServingPipelineList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{76}
+	return fileDescriptor_9d0d1b17d3865563, []int{96}
 }
 func (m *ServingPipelineList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2209,7 +2770,7 @@ var xxx_messageInfo_ServingPipelineList proto.InternalMessageInfo
 func (m *ServingPipelineSpec) Reset()      { *m = ServingPipelineSpec{} }
 func (*ServingPipelineSpec) ProtoMessage() {}
 func (*ServingPipelineSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{77}
+	return fileDescriptor_9d0d1b17d3865563, []int{97}
 }
 func (m *ServingPipelineSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2237,7 +2798,7 @@ var xxx_messageInfo_ServingPipelineSpec proto.InternalMessageInfo
 func (m *ServingPipelineStatus) Reset()      { *m = ServingPipelineStatus{} }
 func (*ServingPipelineStatus) ProtoMessage() {}
 func (*ServingPipelineStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{78}
+	return fileDescriptor_9d0d1b17d3865563, []int{98}
 }
 func (m *ServingPipelineStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2265,7 +2826,7 @@ var xxx_messageInfo_ServingPipelineStatus proto.InternalMessageInfo
 func (m *ServingSource) Reset()      { *m = ServingSource{} }
 func (*ServingSource) ProtoMessage() {}
 func (*ServingSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{79}
+	return fileDescriptor_9d0d1b17d3865563, []int{99}
 }
 func (m *ServingSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2293,7 +2854,7 @@ var xxx_messageInfo_ServingSource proto.InternalMessageInfo
 func (m *ServingSpec) Reset()      { *m = ServingSpec{} }
 func (*ServingSpec) ProtoMessage() {}
 func (*ServingSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{80}
+	return fileDescriptor_9d0d1b17d3865563, []int{100}
 }
 func (m *ServingSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2321,7 +2882,7 @@ var xxx_messageInfo_ServingSpec proto.InternalMessageInfo
 func (m *ServingStore) Reset()      { *m = ServingStore{} }
 func (*ServingStore) ProtoMessage() {}
 func (*ServingStore) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{81}
+	return fileDescriptor_9d0d1b17d3865563, []int{101}
 }
 func (m *ServingStore) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2349,7 +2910,7 @@ var xxx_messageInfo_ServingStore proto.InternalMessageInfo
 func (m *SessionWindow) Reset()      { *m = SessionWindow{} }
 func (*SessionWindow) ProtoMessage() {}
 func (*SessionWindow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{82}
+	return fileDescriptor_9d0d1b17d3865563, []int{102}
 }
 func (m *SessionWindow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2377,7 +2938,7 @@ var xxx_messageInfo_SessionWindow proto.InternalMessageInfo
 func (m *SideInput) Reset()      { *m = SideInput{} }
 func (*SideInput) ProtoMessage() {}
 func (*SideInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{83}
+	return fileDescriptor_9d0d1b17d3865563, []int{103}
 }
 func (m *SideInput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2405,7 +2966,7 @@ var xxx_messageInfo_SideInput proto.InternalMessageInfo
 func (m *SideInputTrigger) Reset()      { *m = SideInputTrigger{} }
 func (*SideInputTrigger) ProtoMessage() {}
 func (*SideInputTrigger) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{84}
+	return fileDescriptor_9d0d1b17d3865563, []int{104}
 }
 func (m *SideInputTrigger) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2433,7 +2994,7 @@ var xxx_messageInfo_SideInputTrigger proto.InternalMessageInfo
 func (m *SideInputsManagerTemplate) Reset()      { *m = SideInputsManagerTemplate{} }
 func (*SideInputsManagerTemplate) ProtoMessage() {}
 func (*SideInputsManagerTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{85}
+	return fileDescriptor_9d0d1b17d3865563, []int{105}
 }
 func (m *SideInputsManagerTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2461,7 +3022,7 @@ var xxx_messageInfo_SideInputsManagerTemplate proto.InternalMessageInfo
 func (m *Sink) Reset()      { *m = Sink{} }
 func (*Sink) ProtoMessage() {}
 func (*Sink) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{86}
+	return fileDescriptor_9d0d1b17d3865563, []int{106}
 }
 func (m *Sink) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2489,7 +3050,7 @@ var xxx_messageInfo_Sink proto.InternalMessageInfo
 func (m *SlidingWindow) Reset()      { *m = SlidingWindow{} }
 func (*SlidingWindow) ProtoMessage() {}
 func (*SlidingWindow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{87}
+	return fileDescriptor_9d0d1b17d3865563, []int{107}
 }
 func (m *SlidingWindow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2517,7 +3078,7 @@ var xxx_messageInfo_SlidingWindow proto.InternalMessageInfo
 func (m *Source) Reset()      { *m = Source{} }
 func (*Source) ProtoMessage() {}
 func (*Source) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{88}
+	return fileDescriptor_9d0d1b17d3865563, []int{108}
 }
 func (m *Source) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2545,7 +3106,7 @@ var xxx_messageInfo_Source proto.InternalMessageInfo
 func (m *SqsSink) Reset()      { *m = SqsSink{} }
 func (*SqsSink) ProtoMessage() {}
 func (*SqsSink) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{89}
+	return fileDescriptor_9d0d1b17d3865563, []int{109}
 }
 func (m *SqsSink) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2573,7 +3134,7 @@ var xxx_messageInfo_SqsSink proto.InternalMessageInfo
 func (m *SqsSource) Reset()      { *m = SqsSource{} }
 func (*SqsSource) ProtoMessage() {}
 func (*SqsSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{90}
+	return fileDescriptor_9d0d1b17d3865563, []int{110}
 }
 func (m *SqsSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2601,7 +3162,7 @@ var xxx_messageInfo_SqsSource proto.InternalMessageInfo
 func (m *Status) Reset()      { *m = Status{} }
 func (*Status) ProtoMessage() {}
 func (*Status) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{91}
+	return fileDescriptor_9d0d1b17d3865563, []int{111}
 }
 func (m *Status) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2629,7 +3190,7 @@ var xxx_messageInfo_Status proto.InternalMessageInfo
 func (m *TLS) Reset()      { *m = TLS{} }
 func (*TLS) ProtoMessage() {}
 func (*TLS) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{92}
+	return fileDescriptor_9d0d1b17d3865563, []int{112}
 }
 func (m *TLS) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2657,7 +3218,7 @@ var xxx_messageInfo_TLS proto.InternalMessageInfo
 func (m *TagConditions) Reset()      { *m = TagConditions{} }
 func (*TagConditions) ProtoMessage() {}
 func (*TagConditions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{93}
+	return fileDescriptor_9d0d1b17d3865563, []int{113}
 }
 func (m *TagConditions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2685,7 +3246,7 @@ var xxx_messageInfo_TagConditions proto.InternalMessageInfo
 func (m *Templates) Reset()      { *m = Templates{} }
 func (*Templates) ProtoMessage() {}
 func (*Templates) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{94}
+	return fileDescriptor_9d0d1b17d3865563, []int{114}
 }
 func (m *Templates) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2713,7 +3274,7 @@ var xxx_messageInfo_Templates proto.InternalMessageInfo
 func (m *Transformer) Reset()      { *m = Transformer{} }
 func (*Transformer) ProtoMessage() {}
 func (*Transformer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{95}
+	return fileDescriptor_9d0d1b17d3865563, []int{115}
 }
 func (m *Transformer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2741,7 +3302,7 @@ var xxx_messageInfo_Transformer proto.InternalMessageInfo
 func (m *UDF) Reset()      { *m = UDF{} }
 func (*UDF) ProtoMessage() {}
 func (*UDF) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{96}
+	return fileDescriptor_9d0d1b17d3865563, []int{116}
 }
 func (m *UDF) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2769,7 +3330,7 @@ var xxx_messageInfo_UDF proto.InternalMessageInfo
 func (m *UDSink) Reset()      { *m = UDSink{} }
 func (*UDSink) ProtoMessage() {}
 func (*UDSink) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{97}
+	return fileDescriptor_9d0d1b17d3865563, []int{117}
 }
 func (m *UDSink) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2797,7 +3358,7 @@ var xxx_messageInfo_UDSink proto.InternalMessageInfo
 func (m *UDSource) Reset()      { *m = UDSource{} }
 func (*UDSource) ProtoMessage() {}
 func (*UDSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{98}
+	return fileDescriptor_9d0d1b17d3865563, []int{118}
 }
 func (m *UDSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2825,7 +3386,7 @@ var xxx_messageInfo_UDSource proto.InternalMessageInfo
 func (m *UDTransformer) Reset()      { *m = UDTransformer{} }
 func (*UDTransformer) ProtoMessage() {}
 func (*UDTransformer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{99}
+	return fileDescriptor_9d0d1b17d3865563, []int{119}
 }
 func (m *UDTransformer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2853,7 +3414,7 @@ var xxx_messageInfo_UDTransformer proto.InternalMessageInfo
 func (m *UpdateStrategy) Reset()      { *m = UpdateStrategy{} }
 func (*UpdateStrategy) ProtoMessage() {}
 func (*UpdateStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{100}
+	return fileDescriptor_9d0d1b17d3865563, []int{120}
 }
 func (m *UpdateStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2881,7 +3442,7 @@ var xxx_messageInfo_UpdateStrategy proto.InternalMessageInfo
 func (m *Vertex) Reset()      { *m = Vertex{} }
 func (*Vertex) ProtoMessage() {}
 func (*Vertex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{101}
+	return fileDescriptor_9d0d1b17d3865563, []int{121}
 }
 func (m *Vertex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2909,7 +3470,7 @@ var xxx_messageInfo_Vertex proto.InternalMessageInfo
 func (m *VertexInstance) Reset()      { *m = VertexInstance{} }
 func (*VertexInstance) ProtoMessage() {}
 func (*VertexInstance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{102}
+	return fileDescriptor_9d0d1b17d3865563, []int{122}
 }
 func (m *VertexInstance) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2937,7 +3498,7 @@ var xxx_messageInfo_VertexInstance proto.InternalMessageInfo
 func (m *VertexLifecycle) Reset()      { *m = VertexLifecycle{} }
 func (*VertexLifecycle) ProtoMessage() {}
 func (*VertexLifecycle) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{103}
+	return fileDescriptor_9d0d1b17d3865563, []int{123}
 }
 func (m *VertexLifecycle) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2965,7 +3526,7 @@ var xxx_messageInfo_VertexLifecycle proto.InternalMessageInfo
 func (m *VertexLimits) Reset()      { *m = VertexLimits{} }
 func (*VertexLimits) ProtoMessage() {}
 func (*VertexLimits) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{104}
+	return fileDescriptor_9d0d1b17d3865563, []int{124}
 }
 func (m *VertexLimits) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2993,7 +3554,7 @@ var xxx_messageInfo_VertexLimits proto.InternalMessageInfo
 func (m *VertexList) Reset()      { *m = VertexList{} }
 func (*VertexList) ProtoMessage() {}
 func (*VertexList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{105}
+	return fileDescriptor_9d0d1b17d3865563, []int{125}
 }
 func (m *VertexList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3021,7 +3582,7 @@ var xxx_messageInfo_VertexList proto.InternalMessageInfo
 func (m *VertexSpec) Reset()      { *m = VertexSpec{} }
 func (*VertexSpec) ProtoMessage() {}
 func (*VertexSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{106}
+	return fileDescriptor_9d0d1b17d3865563, []int{126}
 }
 func (m *VertexSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3049,7 +3610,7 @@ var xxx_messageInfo_VertexSpec proto.InternalMessageInfo
 func (m *VertexStatus) Reset()      { *m = VertexStatus{} }
 func (*VertexStatus) ProtoMessage() {}
 func (*VertexStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{107}
+	return fileDescriptor_9d0d1b17d3865563, []int{127}
 }
 func (m *VertexStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3077,7 +3638,7 @@ var xxx_messageInfo_VertexStatus proto.InternalMessageInfo
 func (m *VertexTemplate) Reset()      { *m = VertexTemplate{} }
 func (*VertexTemplate) ProtoMessage() {}
 func (*VertexTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{108}
+	return fileDescriptor_9d0d1b17d3865563, []int{128}
 }
 func (m *VertexTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3105,7 +3666,7 @@ var xxx_messageInfo_VertexTemplate proto.InternalMessageInfo
 func (m *Watermark) Reset()      { *m = Watermark{} }
 func (*Watermark) ProtoMessage() {}
 func (*Watermark) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{109}
+	return fileDescriptor_9d0d1b17d3865563, []int{129}
 }
 func (m *Watermark) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3133,7 +3694,7 @@ var xxx_messageInfo_Watermark proto.InternalMessageInfo
 func (m *Window) Reset()      { *m = Window{} }
 func (*Window) ProtoMessage() {}
 func (*Window) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d0d1b17d3865563, []int{110}
+	return fileDescriptor_9d0d1b17d3865563, []int{130}
 }
 func (m *Window) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3169,16 +3730,30 @@ func init() {
 	proto.RegisterType((*BasicAuth)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.BasicAuth")
 	proto.RegisterType((*Blackhole)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.Blackhole")
 	proto.RegisterType((*BufferServiceConfig)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.BufferServiceConfig")
+	proto.RegisterType((*ClaimCheck)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.ClaimCheck")
+	proto.RegisterType((*ClaimCheckS3)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.ClaimCheckS3")
 	proto.RegisterType((*CombinedEdge)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.CombinedEdge")
+	proto.RegisterType((*Compression)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.Compression")
 	proto.RegisterType((*Container)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.Container")
 	proto.RegisterType((*ContainerTemplate)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.ContainerTemplate")
+	proto.RegisterType((*CountWindow)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.CountWindow")
 	proto.RegisterType((*DaemonTemplate)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.DaemonTemplate")
+	proto.RegisterType((*DeadLetter)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.DeadLetter")
+	proto.RegisterType((*DiskBufferService)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.DiskBufferService")
+	proto.RegisterType((*DiskConfig)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.DiskConfig")
+	proto.RegisterType((*DiskStorage)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.DiskStorage")
 	proto.RegisterType((*Edge)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.Edge")
+	proto.RegisterType((*EdgePriority)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.EdgePriority")
+	proto.RegisterMapType((map[string]int32)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.EdgePriority.TagsEntry")
+	proto.RegisterType((*FileSink)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.FileSink")
+	proto.RegisterType((*FileSinkS3)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.FileSinkS3")
 	proto.RegisterType((*FixedWindow)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.FixedWindow")
 	proto.RegisterType((*ForwardConditions)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.ForwardConditions")
 	proto.RegisterType((*Function)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.Function")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.Function.KwargsEntry")
 	proto.RegisterType((*GSSAPI)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.GSSAPI")
+	proto.RegisterType((*GeneratorOutOfOrder)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.GeneratorOutOfOrder")
+	proto.RegisterType((*GeneratorReplay)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.GeneratorReplay")
 	proto.RegisterType((*GeneratorSource)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.GeneratorSource")
 	proto.RegisterType((*GetDaemonDeploymentReq)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.GetDaemonDeploymentReq")
 	proto.RegisterType((*GetJetStreamServiceSpecReq)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.GetJetStreamServiceSpecReq")
@@ -3194,9 +3769,15 @@ func init() {
 	proto.RegisterType((*GetServingPipelineResourceReq)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.GetServingPipelineResourceReq")
 	proto.RegisterType((*GetSideInputDeploymentReq)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.GetSideInputDeploymentReq")
 	proto.RegisterType((*GetVertexPodSpecReq)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.GetVertexPodSpecReq")
+	proto.RegisterType((*GlobalWindow)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.GlobalWindow")
 	proto.RegisterType((*GroupBy)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.GroupBy")
+	proto.RegisterType((*HTTPBatch)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.HTTPBatch")
+	proto.RegisterType((*HTTPSink)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.HTTPSink")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.HTTPSink.HeadersEntry")
+	proto.RegisterType((*HTTPSinkBatch)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.HTTPSinkBatch")
 	proto.RegisterType((*HTTPSource)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.HTTPSource")
 	proto.RegisterType((*IdleSource)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.IdleSource")
+	proto.RegisterType((*InterStepBuffer)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.InterStepBuffer")
 	proto.RegisterType((*InterStepBufferService)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.InterStepBufferService")
 	proto.RegisterType((*InterStepBufferServiceList)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.InterStepBufferServiceList")
 	proto.RegisterType((*InterStepBufferServiceSpec)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.InterStepBufferServiceSpec")
@@ -3207,8 +3788,10 @@ func init() {
 	proto.RegisterType((*JobTemplate)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.JobTemplate")
 	proto.RegisterType((*KafkaSink)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.KafkaSink")
 	proto.RegisterType((*KafkaSource)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.KafkaSource")
+	proto.RegisterType((*KafkaStartPosition)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.KafkaStartPosition")
 	proto.RegisterType((*Lifecycle)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.Lifecycle")
 	proto.RegisterType((*Log)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.Log")
+	proto.RegisterType((*LogSampling)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.LogSampling")
 	proto.RegisterType((*Metadata)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.Metadata")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.Metadata.AnnotationsEntry")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.numaproj.numaflow.pkg.apis.numaflow.v1alpha1.Metadata.LabelsEntry")
@@ -3286,565 +3869,700 @@ func init() {
 }
 
 var fileDescriptor_9d0d1b17d3865563 = []byte{
-	// 8921 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x5b, 0x6c, 0x24, 0x59,
-	0x96, 0x50, 0xe7, 0xcb, 0x99, 0x79, 0xd2, 0x8f, 0xaa, 0x5b, 0x8f, 0x76, 0xd5, 0x74, 0x97, 0x6b,
-	0xa2, 0xb7, 0x7b, 0x6a, 0xd9, 0x59, 0x9b, 0xf6, 0x4e, 0xf7, 0xf4, 0xcc, 0xec, 0x4c, 0xb7, 0xd3,
-	0x2e, 0x57, 0xb9, 0xcb, 0xae, 0x72, 0x9f, 0xb4, 0xab, 0x7a, 0xa6, 0x99, 0x69, 0xc2, 0x11, 0xd7,
-	0xe9, 0x68, 0x47, 0x46, 0x64, 0x45, 0x44, 0xba, 0xca, 0xbd, 0x8c, 0x66, 0x77, 0x06, 0xe8, 0x41,
-	0x80, 0x40, 0xfb, 0xc3, 0x4a, 0x68, 0x41, 0x48, 0x48, 0xfb, 0xb1, 0x5a, 0x3e, 0x56, 0x0c, 0x42,
-	0x7c, 0x00, 0x0b, 0xd2, 0x32, 0xc0, 0x02, 0x23, 0xb4, 0x12, 0x83, 0x00, 0x8b, 0x31, 0xf0, 0x01,
-	0x12, 0x68, 0x97, 0x15, 0xb0, 0x14, 0x88, 0x45, 0xf7, 0x15, 0xaf, 0x8c, 0x74, 0xdb, 0x19, 0x69,
-	0x77, 0xf5, 0x6c, 0xff, 0x45, 0xdc, 0xf3, 0xba, 0x71, 0xe3, 0x3e, 0xce, 0x3d, 0xe7, 0xdc, 0x73,
-	0xe1, 0x56, 0xdb, 0x0a, 0x76, 0x7a, 0x5b, 0xb3, 0x86, 0xdb, 0x99, 0x73, 0x7a, 0x1d, 0xbd, 0xeb,
-	0xb9, 0xef, 0xf1, 0x87, 0x6d, 0xdb, 0x7d, 0x34, 0xd7, 0xdd, 0x6d, 0xcf, 0xe9, 0x5d, 0xcb, 0x8f,
-	0x4a, 0xf6, 0x5e, 0xd6, 0xed, 0xee, 0x8e, 0xfe, 0xf2, 0x5c, 0x9b, 0x3a, 0xd4, 0xd3, 0x03, 0x6a,
-	0xce, 0x76, 0x3d, 0x37, 0x70, 0xc9, 0xe7, 0x23, 0x46, 0xb3, 0x8a, 0xd1, 0xac, 0x22, 0x9b, 0xed,
-	0xee, 0xb6, 0x67, 0x19, 0xa3, 0xa8, 0x44, 0x31, 0xba, 0xfa, 0xd3, 0xb1, 0x1a, 0xb4, 0xdd, 0xb6,
-	0x3b, 0xc7, 0xf9, 0x6d, 0xf5, 0xb6, 0xf9, 0x1b, 0x7f, 0xe1, 0x4f, 0x42, 0xce, 0x55, 0x6d, 0xf7,
-	0x35, 0x7f, 0xd6, 0x72, 0x59, 0xb5, 0xe6, 0x0c, 0xd7, 0xa3, 0x73, 0x7b, 0x7d, 0x75, 0xb9, 0xfa,
-	0xb9, 0x08, 0xa7, 0xa3, 0x1b, 0x3b, 0x96, 0x43, 0xbd, 0x7d, 0xf5, 0x2d, 0x73, 0x1e, 0xf5, 0xdd,
-	0x9e, 0x67, 0xd0, 0x13, 0x51, 0xf9, 0x73, 0x1d, 0x1a, 0xe8, 0x59, 0xb2, 0xe6, 0x06, 0x51, 0x79,
-	0x3d, 0x27, 0xb0, 0x3a, 0xfd, 0x62, 0x5e, 0xfd, 0x30, 0x02, 0xdf, 0xd8, 0xa1, 0x1d, 0xbd, 0x8f,
-	0xee, 0x67, 0x06, 0xd1, 0xf5, 0x02, 0xcb, 0x9e, 0xb3, 0x9c, 0xc0, 0x0f, 0xbc, 0x34, 0x91, 0xf6,
-	0x1b, 0x00, 0x17, 0x16, 0xb6, 0xfc, 0xc0, 0xd3, 0x8d, 0x60, 0xdd, 0x35, 0x37, 0x68, 0xa7, 0x6b,
-	0xeb, 0x01, 0x25, 0xbb, 0x50, 0x63, 0x1f, 0x64, 0xea, 0x81, 0x3e, 0x5d, 0xb8, 0x5e, 0xb8, 0xd1,
-	0x98, 0x5f, 0x98, 0x1d, 0xf2, 0x07, 0xce, 0xae, 0x49, 0x46, 0xcd, 0xf1, 0xc3, 0x83, 0x99, 0x9a,
-	0x7a, 0xc3, 0x50, 0x00, 0xf9, 0xa5, 0x02, 0x8c, 0x3b, 0xae, 0x49, 0x5b, 0xd4, 0xa6, 0x46, 0xe0,
-	0x7a, 0xd3, 0xc5, 0xeb, 0xa5, 0x1b, 0x8d, 0xf9, 0x6f, 0x0c, 0x2d, 0x31, 0xe3, 0x8b, 0x66, 0xef,
-	0xc6, 0x04, 0xdc, 0x74, 0x02, 0x6f, 0xbf, 0x79, 0xf1, 0xfb, 0x07, 0x33, 0xcf, 0x1c, 0x1e, 0xcc,
-	0x8c, 0xc7, 0x41, 0x98, 0xa8, 0x09, 0xd9, 0x84, 0x46, 0xe0, 0xda, 0xac, 0xc9, 0x2c, 0xd7, 0xf1,
-	0xa7, 0x4b, 0xbc, 0x62, 0xd7, 0x66, 0x45, 0x53, 0x33, 0xf1, 0xb3, 0xac, 0x8f, 0xcd, 0xee, 0xbd,
-	0x3c, 0xbb, 0x11, 0xa2, 0x35, 0x2f, 0x48, 0xc6, 0x8d, 0xa8, 0xcc, 0xc7, 0x38, 0x1f, 0x42, 0x61,
-	0xca, 0xa7, 0x46, 0xcf, 0xb3, 0x82, 0xfd, 0x45, 0xd7, 0x09, 0xe8, 0xe3, 0x60, 0xba, 0xcc, 0x5b,
-	0xf9, 0xa5, 0x2c, 0xd6, 0xeb, 0xae, 0xd9, 0x4a, 0x62, 0x37, 0x2f, 0x1c, 0x1e, 0xcc, 0x4c, 0xa5,
-	0x0a, 0x31, 0xcd, 0x93, 0x38, 0x70, 0xce, 0xea, 0xe8, 0x6d, 0xba, 0xde, 0xb3, 0xed, 0x16, 0x35,
-	0x3c, 0x1a, 0xf8, 0xd3, 0x15, 0xfe, 0x09, 0x37, 0xb2, 0xe4, 0xac, 0xba, 0x86, 0x6e, 0xdf, 0xdb,
-	0x7a, 0x8f, 0x1a, 0x01, 0xd2, 0x6d, 0xea, 0x51, 0xc7, 0xa0, 0xcd, 0x69, 0xf9, 0x31, 0xe7, 0x56,
-	0x52, 0x9c, 0xb0, 0x8f, 0x37, 0xb9, 0x05, 0xe7, 0xbb, 0x9e, 0xe5, 0xf2, 0x2a, 0xd8, 0xba, 0xef,
-	0xdf, 0xd5, 0x3b, 0x74, 0x7a, 0xec, 0x7a, 0xe1, 0x46, 0xbd, 0x79, 0x45, 0xb2, 0x39, 0xbf, 0x9e,
-	0x46, 0xc0, 0x7e, 0x1a, 0x72, 0x03, 0x6a, 0xaa, 0x70, 0xba, 0x7a, 0xbd, 0x70, 0xa3, 0x22, 0xfa,
-	0x8e, 0xa2, 0xc5, 0x10, 0x4a, 0x96, 0xa1, 0xa6, 0x6f, 0x6f, 0x5b, 0x0e, 0xc3, 0xac, 0xf1, 0x26,
-	0x7c, 0x2e, 0xeb, 0xd3, 0x16, 0x24, 0x8e, 0xe0, 0xa3, 0xde, 0x30, 0xa4, 0x25, 0x6f, 0x02, 0xf1,
-	0xa9, 0xb7, 0x67, 0x19, 0x74, 0xc1, 0x30, 0xdc, 0x9e, 0x13, 0xf0, 0xba, 0xd7, 0x79, 0xdd, 0xaf,
-	0xca, 0xba, 0x93, 0x56, 0x1f, 0x06, 0x66, 0x50, 0x91, 0x37, 0xe0, 0x9c, 0x1c, 0xab, 0x51, 0x2b,
-	0x00, 0xe7, 0x74, 0x91, 0x35, 0x24, 0xa6, 0x60, 0xd8, 0x87, 0x4d, 0x4c, 0x78, 0x4e, 0xef, 0x05,
-	0x6e, 0x87, 0xb1, 0x4c, 0x0a, 0xdd, 0x70, 0x77, 0xa9, 0x33, 0xdd, 0xb8, 0x5e, 0xb8, 0x51, 0x6b,
-	0x5e, 0x3f, 0x3c, 0x98, 0x79, 0x6e, 0xe1, 0x08, 0x3c, 0x3c, 0x92, 0x0b, 0xb9, 0x07, 0x75, 0xd3,
-	0xf1, 0xd7, 0x5d, 0xdb, 0x32, 0xf6, 0xa7, 0xc7, 0x79, 0x05, 0x5f, 0x96, 0x9f, 0x5a, 0x5f, 0xba,
-	0xdb, 0x12, 0x80, 0x27, 0x07, 0x33, 0xcf, 0xf5, 0x4f, 0xa9, 0xb3, 0x21, 0x1c, 0x23, 0x1e, 0x64,
-	0x8d, 0x33, 0x5c, 0x74, 0x9d, 0x6d, 0xab, 0x3d, 0x3d, 0xc1, 0xff, 0xc6, 0xf5, 0x01, 0x1d, 0x7a,
-	0xe9, 0x6e, 0x4b, 0xe0, 0x35, 0x27, 0xa4, 0x38, 0xf1, 0x8a, 0x11, 0x07, 0x62, 0xc2, 0xa4, 0x9a,
-	0x8c, 0x17, 0x6d, 0xdd, 0xea, 0xf8, 0xd3, 0x93, 0xbc, 0xf3, 0xfe, 0xc4, 0x00, 0x9e, 0x18, 0x47,
-	0x6e, 0x5e, 0x96, 0x9f, 0x32, 0x99, 0x28, 0xf6, 0x31, 0xc5, 0xf3, 0xea, 0xeb, 0x70, 0xbe, 0x6f,
-	0x6e, 0x20, 0xe7, 0xa0, 0xb4, 0x4b, 0xf7, 0xf9, 0xd4, 0x57, 0x47, 0xf6, 0x48, 0x2e, 0x42, 0x65,
-	0x4f, 0xb7, 0x7b, 0x74, 0xba, 0xc8, 0xcb, 0xc4, 0xcb, 0x17, 0x8b, 0xaf, 0x15, 0xb4, 0xff, 0x58,
-	0x86, 0x71, 0x35, 0xe3, 0xb4, 0x2c, 0x67, 0x97, 0x3c, 0x80, 0x92, 0xed, 0xb6, 0xe5, 0xbc, 0xf9,
-	0xb3, 0x43, 0xcf, 0x62, 0xab, 0x6e, 0xbb, 0x59, 0x3d, 0x3c, 0x98, 0x29, 0xad, 0xba, 0x6d, 0x64,
-	0x1c, 0x89, 0x01, 0x95, 0x5d, 0x7d, 0x7b, 0x57, 0xe7, 0x75, 0x68, 0xcc, 0x37, 0x87, 0x66, 0x7d,
-	0x87, 0x71, 0x61, 0x75, 0x6d, 0xd6, 0x0f, 0x0f, 0x66, 0x2a, 0xfc, 0x15, 0x05, 0x6f, 0xe2, 0x42,
-	0x7d, 0xcb, 0xd6, 0x8d, 0xdd, 0x1d, 0xd7, 0xa6, 0xd3, 0xa5, 0x9c, 0x82, 0x9a, 0x8a, 0x93, 0xf8,
-	0xcd, 0xe1, 0x2b, 0x46, 0x32, 0x88, 0x01, 0x63, 0x3d, 0xd3, 0xb7, 0x9c, 0x5d, 0x39, 0x07, 0xbe,
-	0x3e, 0xb4, 0xb4, 0xcd, 0x25, 0xfe, 0x4d, 0x70, 0x78, 0x30, 0x33, 0x26, 0x9e, 0x51, 0xb2, 0x66,
-	0x4d, 0xc7, 0x46, 0x2a, 0x9d, 0xae, 0xe4, 0xfc, 0x22, 0x36, 0x90, 0x68, 0xd4, 0x74, 0xfc, 0x15,
-	0x05, 0x6f, 0xf2, 0x0e, 0x94, 0xfc, 0x87, 0x3e, 0x9f, 0xf1, 0x1a, 0xf3, 0x6f, 0x0c, 0x2f, 0xe2,
-	0xa1, 0xcf, 0x05, 0xf0, 0x9f, 0xdf, 0x7a, 0xe8, 0x23, 0xe3, 0xaa, 0xfd, 0xfe, 0x38, 0x4c, 0xaa,
-	0x6e, 0x76, 0x9f, 0x7a, 0x01, 0x7d, 0x4c, 0xae, 0x43, 0xd9, 0x61, 0x93, 0x0b, 0xef, 0xa6, 0xcd,
-	0x71, 0xd9, 0xe1, 0xcb, 0x7c, 0x52, 0xe1, 0x10, 0xd6, 0xb6, 0xa2, 0xb3, 0xcb, 0x2e, 0x33, 0x7c,
-	0xdb, 0xb6, 0x38, 0x1b, 0xd1, 0xb6, 0xe2, 0x19, 0x25, 0x6b, 0xf2, 0x0e, 0x94, 0xf9, 0xef, 0x13,
-	0x9d, 0xe5, 0xcb, 0xc3, 0x8b, 0x60, 0x1f, 0x5d, 0x63, 0x5f, 0xc0, 0x7f, 0x1d, 0x67, 0xca, 0x06,
-	0x53, 0xcf, 0xdc, 0x96, 0x5d, 0xe3, 0x67, 0x73, 0x74, 0x8d, 0x65, 0xd1, 0x9e, 0x9b, 0x4b, 0xcb,
-	0xc8, 0x38, 0x92, 0xbf, 0x50, 0x80, 0xf3, 0x86, 0xeb, 0x04, 0x3a, 0xd3, 0x94, 0x94, 0x9a, 0x20,
-	0xbb, 0xc7, 0x9b, 0x43, 0xcb, 0x59, 0x4c, 0x73, 0x6c, 0x5e, 0x62, 0xab, 0x5e, 0x5f, 0x31, 0xf6,
-	0xcb, 0x26, 0x7f, 0xb9, 0x00, 0x97, 0xd8, 0x6a, 0xd4, 0x87, 0x2c, 0x7b, 0xd4, 0x28, 0x6b, 0x75,
-	0xe5, 0xf0, 0x60, 0xe6, 0xd2, 0x4a, 0x96, 0x30, 0xcc, 0xae, 0x03, 0xab, 0xdd, 0x05, 0xbd, 0x5f,
-	0xb1, 0xe2, 0xeb, 0x73, 0x63, 0x7e, 0x75, 0x94, 0xca, 0x5a, 0xf3, 0x53, 0xb2, 0x2b, 0x67, 0xe9,
-	0xa6, 0x98, 0x55, 0x0b, 0x72, 0x13, 0xaa, 0x7b, 0xae, 0xdd, 0xeb, 0x50, 0x7f, 0xba, 0xc6, 0x17,
-	0x89, 0xab, 0x59, 0x8b, 0xc4, 0x7d, 0x8e, 0xd2, 0x9c, 0x92, 0xec, 0xab, 0xe2, 0xdd, 0x47, 0x45,
-	0x4b, 0x2c, 0x18, 0xb3, 0xad, 0x8e, 0x15, 0xf8, 0x7c, 0xe9, 0x6f, 0xcc, 0xdf, 0x1c, 0xfa, 0xb3,
-	0xc4, 0x10, 0x5d, 0xe5, 0xcc, 0xc4, 0xa8, 0x11, 0xcf, 0x28, 0x05, 0xf0, 0x19, 0xc9, 0xd0, 0x6d,
-	0xa1, 0x1a, 0x34, 0xe6, 0xbf, 0x32, 0xfc, 0xb0, 0x61, 0x5c, 0x9a, 0x13, 0xf2, 0x9b, 0x2a, 0xfc,
-	0x15, 0x05, 0x6f, 0xf2, 0x75, 0x98, 0x4c, 0xfc, 0x4d, 0x7f, 0xba, 0xc1, 0x5b, 0xe7, 0xf9, 0xac,
-	0xd6, 0x09, 0xb1, 0xa2, 0xb5, 0x33, 0xd1, 0x43, 0x7c, 0x4c, 0x31, 0x23, 0x77, 0xa0, 0xe6, 0x5b,
-	0x26, 0x35, 0x74, 0xcf, 0x9f, 0x1e, 0x3f, 0x0e, 0xe3, 0x73, 0x92, 0x71, 0xad, 0x25, 0xc9, 0x30,
-	0x64, 0x40, 0x66, 0x01, 0xba, 0xba, 0x17, 0x58, 0x42, 0xd5, 0x9e, 0xe0, 0x6a, 0xdf, 0xe4, 0xe1,
-	0xc1, 0x0c, 0xac, 0x87, 0xa5, 0x18, 0xc3, 0x60, 0xf8, 0x8c, 0x76, 0xc5, 0xe9, 0xf6, 0x02, 0xa1,
-	0x1a, 0xd4, 0x05, 0x7e, 0x2b, 0x2c, 0xc5, 0x18, 0x06, 0xf9, 0xb5, 0x02, 0x7c, 0x2a, 0x7a, 0xed,
-	0x1f, 0x64, 0x53, 0x23, 0x1f, 0x64, 0x33, 0x87, 0x07, 0x33, 0x9f, 0x6a, 0x0d, 0x16, 0x89, 0x47,
-	0xd5, 0x87, 0x7c, 0x50, 0x80, 0xc9, 0x5e, 0xd7, 0xd4, 0x03, 0xda, 0x0a, 0xd8, 0x9e, 0xad, 0xbd,
-	0x3f, 0x7d, 0x8e, 0x57, 0xf1, 0xd6, 0xf0, 0xb3, 0x60, 0x82, 0x5d, 0xf4, 0x9b, 0x93, 0xe5, 0x98,
-	0x12, 0xab, 0xbd, 0x07, 0xe7, 0x17, 0x0c, 0xa3, 0xd7, 0xe9, 0xd9, 0x7a, 0xe0, 0x7a, 0x0f, 0x2c,
-	0xc7, 0x74, 0x1f, 0x91, 0x4d, 0xa8, 0x32, 0xa5, 0xd5, 0xed, 0x05, 0x52, 0xd3, 0x99, 0x8d, 0xfd,
-	0xfa, 0x70, 0x07, 0x1a, 0xd5, 0x86, 0x6d, 0xf7, 0x58, 0x67, 0x58, 0xea, 0xc9, 0x6d, 0x52, 0x83,
-	0x8d, 0xc0, 0x0d, 0xc1, 0x02, 0x15, 0x2f, 0xed, 0x01, 0x4c, 0x2c, 0xf4, 0x82, 0x1d, 0xd7, 0xb3,
-	0xde, 0xe7, 0x68, 0x64, 0x19, 0x2a, 0x01, 0x57, 0x7a, 0x85, 0x94, 0x17, 0xb3, 0x3a, 0x98, 0xd8,
-	0x80, 0xdc, 0xa1, 0xfb, 0x4a, 0x8b, 0x13, 0x8b, 0xb3, 0x50, 0x82, 0x05, 0xb9, 0xf6, 0x27, 0x0b,
-	0x50, 0x6d, 0xea, 0xc6, 0xae, 0xbb, 0xbd, 0x4d, 0xde, 0x86, 0x9a, 0xe5, 0x04, 0xd4, 0xdb, 0xd3,
-	0xed, 0x21, 0x2b, 0xcf, 0xf7, 0x11, 0x2b, 0x92, 0x07, 0x86, 0xdc, 0xc8, 0x0c, 0x54, 0xfc, 0x80,
-	0x76, 0x7d, 0xbe, 0xde, 0x4e, 0x48, 0x1d, 0x81, 0x15, 0xa0, 0x28, 0xd7, 0xfe, 0x5a, 0x01, 0xea,
-	0x4d, 0xdd, 0xb7, 0x0c, 0xf6, 0x95, 0x64, 0x11, 0xca, 0x3d, 0x9f, 0x7a, 0x27, 0xfb, 0x36, 0xbe,
-	0x44, 0x6e, 0xfa, 0xd4, 0x43, 0x4e, 0x4c, 0xee, 0x41, 0xad, 0xab, 0xfb, 0xfe, 0x23, 0xd7, 0x33,
-	0xe5, 0x32, 0x7f, 0x4c, 0x46, 0x62, 0x53, 0x25, 0x49, 0x31, 0x64, 0xa2, 0x35, 0x20, 0xd2, 0xd4,
-	0xb4, 0xdf, 0x2b, 0xc0, 0x85, 0x66, 0x6f, 0x7b, 0x9b, 0x7a, 0x72, 0x0f, 0x21, 0xb5, 0x73, 0x0a,
-	0x15, 0x8f, 0x9a, 0x96, 0x2f, 0xeb, 0xbe, 0x34, 0x74, 0xa7, 0x44, 0xc6, 0x45, 0x6e, 0x06, 0x78,
-	0x7b, 0xf1, 0x02, 0x14, 0xdc, 0x49, 0x0f, 0xea, 0xef, 0xd1, 0xc0, 0x0f, 0x3c, 0xaa, 0x77, 0xe4,
-	0xd7, 0xdd, 0x1e, 0x5a, 0xd4, 0x9b, 0x34, 0x68, 0x71, 0x4e, 0xf1, 0xbd, 0x47, 0x58, 0x88, 0x91,
-	0x24, 0xed, 0x37, 0x2a, 0x30, 0xbe, 0xe8, 0x76, 0xb6, 0x2c, 0x87, 0x9a, 0x37, 0xcd, 0x36, 0x25,
-	0xef, 0x42, 0x99, 0x9a, 0x6d, 0x2a, 0xbf, 0x76, 0x78, 0x25, 0x87, 0x31, 0x8b, 0x54, 0x35, 0xf6,
-	0x86, 0x9c, 0x31, 0x59, 0x85, 0xc9, 0x6d, 0xcf, 0xed, 0x88, 0x75, 0x63, 0x63, 0xbf, 0x2b, 0x77,
-	0x1a, 0xcd, 0x9f, 0x50, 0x83, 0x74, 0x39, 0x01, 0x7d, 0x72, 0x30, 0x03, 0xd1, 0x1b, 0xa6, 0x68,
-	0xc9, 0xdb, 0x30, 0x1d, 0x95, 0x84, 0x13, 0xe8, 0x22, 0xdb, 0xfc, 0x71, 0x3d, 0xad, 0xd2, 0x7c,
-	0xee, 0xf0, 0x60, 0x66, 0x7a, 0x79, 0x00, 0x0e, 0x0e, 0xa4, 0x66, 0xd3, 0xd2, 0xb9, 0x08, 0x28,
-	0x16, 0x35, 0xa9, 0x9e, 0x8d, 0x68, 0xb5, 0xe4, 0xbb, 0xe4, 0xe5, 0x94, 0x08, 0xec, 0x13, 0x4a,
-	0x96, 0x61, 0x3c, 0x70, 0x63, 0xed, 0x55, 0xe1, 0xed, 0xa5, 0x29, 0xb3, 0xce, 0x86, 0x3b, 0xb0,
-	0xb5, 0x12, 0x74, 0x04, 0xe1, 0xb2, 0x7a, 0x4f, 0xb5, 0xd4, 0x18, 0x6f, 0xa9, 0xab, 0x87, 0x07,
-	0x33, 0x97, 0x37, 0x32, 0x31, 0x70, 0x00, 0x25, 0xf9, 0x85, 0x02, 0x4c, 0x2a, 0x90, 0x6c, 0xa3,
-	0xea, 0x28, 0xdb, 0x88, 0xb0, 0x1e, 0xb1, 0x91, 0x10, 0x80, 0x29, 0x81, 0xda, 0xf7, 0xaa, 0x50,
-	0x0f, 0x97, 0x15, 0xf2, 0x02, 0x54, 0xb8, 0xc1, 0x46, 0xee, 0x16, 0x42, 0x7d, 0x81, 0xdb, 0x75,
-	0x50, 0xc0, 0xc8, 0x8b, 0x50, 0x35, 0xdc, 0x4e, 0x47, 0x77, 0x4c, 0x6e, 0x84, 0xab, 0x8b, 0x49,
-	0x7a, 0x51, 0x14, 0xa1, 0x82, 0x91, 0xe7, 0xa0, 0xac, 0x7b, 0x6d, 0x61, 0x0f, 0xab, 0x8b, 0xf9,
-	0x68, 0xc1, 0x6b, 0xfb, 0xc8, 0x4b, 0xc9, 0x17, 0xa0, 0x44, 0x9d, 0xbd, 0xe9, 0xf2, 0x60, 0x3d,
-	0xec, 0xa6, 0xb3, 0x77, 0x5f, 0xf7, 0x9a, 0x0d, 0x59, 0x87, 0xd2, 0x4d, 0x67, 0x0f, 0x19, 0x0d,
-	0x59, 0x85, 0x2a, 0x75, 0xf6, 0xd8, 0xbf, 0x97, 0x86, 0xaa, 0x4f, 0x0f, 0x20, 0x67, 0x28, 0x72,
-	0x4b, 0x12, 0x6a, 0x73, 0xb2, 0x18, 0x15, 0x0b, 0xf2, 0x55, 0x18, 0x17, 0x8a, 0xdd, 0x1a, 0xfb,
-	0x27, 0x6c, 0x63, 0xc6, 0x58, 0xce, 0x0c, 0xd6, 0x0c, 0x39, 0x5e, 0x64, 0x18, 0x8c, 0x15, 0xfa,
-	0x98, 0x60, 0x45, 0xbe, 0x0a, 0x75, 0x65, 0x47, 0x50, 0x7f, 0x36, 0xd3, 0xa6, 0xa6, 0x8c, 0x0f,
-	0x48, 0x1f, 0xf6, 0x2c, 0x8f, 0x76, 0xa8, 0x13, 0xf8, 0xcd, 0xf3, 0xca, 0xca, 0xa2, 0xa0, 0x3e,
-	0x46, 0xdc, 0xc8, 0x56, 0xbf, 0x71, 0x50, 0x58, 0xb6, 0x5e, 0x18, 0x30, 0xab, 0x0f, 0x61, 0x19,
-	0xfc, 0x06, 0x4c, 0x85, 0xd6, 0x3b, 0x69, 0x00, 0x12, 0xb6, 0xae, 0xcf, 0x31, 0xf2, 0x95, 0x24,
-	0xe8, 0xc9, 0xc1, 0xcc, 0xf3, 0x19, 0x26, 0xa0, 0x08, 0x01, 0xd3, 0xcc, 0xc8, 0xfb, 0x30, 0xe9,
-	0x51, 0xdd, 0xb4, 0x1c, 0xea, 0xfb, 0xeb, 0x9e, 0xbb, 0x95, 0x5f, 0xcb, 0xe5, 0x5c, 0x44, 0xb7,
-	0xc7, 0x04, 0x67, 0x4c, 0x49, 0x22, 0x8f, 0x60, 0xc2, 0xb6, 0xf6, 0x68, 0x24, 0xba, 0x31, 0x12,
-	0xd1, 0xe7, 0x0f, 0x0f, 0x66, 0x26, 0x56, 0xe3, 0x8c, 0x31, 0x29, 0x87, 0x69, 0x2a, 0x5d, 0xd7,
-	0x0b, 0x94, 0x2a, 0xfc, 0xe9, 0x23, 0x55, 0xe1, 0x75, 0xd7, 0x0b, 0xa2, 0x41, 0xc8, 0xde, 0x7c,
-	0x14, 0xe4, 0xda, 0xdf, 0xac, 0x40, 0xff, 0x86, 0x31, 0xd9, 0xe3, 0x0a, 0xa3, 0xee, 0x71, 0xe9,
-	0xde, 0x20, 0xd6, 0x9e, 0xd7, 0x24, 0xd9, 0x08, 0x7a, 0x44, 0x46, 0xaf, 0x2e, 0x8d, 0xba, 0x57,
-	0x3f, 0x35, 0x13, 0x4f, 0x7f, 0xf7, 0x1f, 0xfb, 0xe8, 0xba, 0x7f, 0xf5, 0x6c, 0xba, 0xbf, 0xf6,
-	0xdd, 0x32, 0x4c, 0x2e, 0xe9, 0xb4, 0xe3, 0x3a, 0x1f, 0x6a, 0x33, 0x28, 0x3c, 0x15, 0x36, 0x83,
-	0x1b, 0x50, 0xf3, 0x68, 0xd7, 0xb6, 0x0c, 0x5d, 0xa8, 0xeb, 0xd2, 0xcb, 0x80, 0xb2, 0x0c, 0x43,
-	0xe8, 0x00, 0x5b, 0x51, 0xe9, 0xa9, 0xb4, 0x15, 0x95, 0x3f, 0x7a, 0x5b, 0x91, 0xf6, 0x0b, 0x45,
-	0xe0, 0xaa, 0x2d, 0xb9, 0x0e, 0x65, 0xa6, 0xb6, 0xa5, 0x2d, 0x94, 0x7c, 0xb4, 0x70, 0x08, 0xb9,
-	0x0a, 0xc5, 0xc0, 0x95, 0xd3, 0x0d, 0x48, 0x78, 0x71, 0xc3, 0xc5, 0x62, 0xe0, 0x92, 0xf7, 0x01,
-	0x0c, 0xd7, 0x31, 0x2d, 0xe5, 0x7c, 0xcb, 0xf7, 0x61, 0xcb, 0xae, 0xf7, 0x48, 0xf7, 0xcc, 0xc5,
-	0x90, 0xa3, 0xb0, 0x16, 0x44, 0xef, 0x18, 0x93, 0x46, 0x5e, 0x87, 0x31, 0xd7, 0x59, 0xee, 0xd9,
-	0x36, 0x6f, 0xd0, 0x7a, 0xf3, 0x33, 0x87, 0x07, 0x33, 0x63, 0xf7, 0x78, 0xc9, 0x93, 0x83, 0x99,
-	0x2b, 0x62, 0x47, 0xc4, 0xde, 0x1e, 0x78, 0x56, 0x60, 0x39, 0xed, 0x70, 0xf3, 0x2c, 0xc9, 0xb4,
-	0x5f, 0x2c, 0x40, 0x63, 0xd9, 0x7a, 0x4c, 0x4d, 0xb9, 0x5f, 0x46, 0x18, 0xb3, 0xa9, 0xd3, 0x0e,
-	0x76, 0x86, 0xdc, 0x71, 0x0a, 0x1b, 0x12, 0xe7, 0x80, 0x92, 0x13, 0x99, 0x83, 0xba, 0xd8, 0xaf,
-	0x58, 0x4e, 0x9b, 0xb7, 0x61, 0x2d, 0x9a, 0xe9, 0x5b, 0x0a, 0x80, 0x11, 0x8e, 0xb6, 0x0f, 0xe7,
-	0xfb, 0x9a, 0x81, 0x98, 0x50, 0x0e, 0xf4, 0xb6, 0x5a, 0x54, 0x96, 0x87, 0x6e, 0xe0, 0x0d, 0xbd,
-	0x1d, 0x6b, 0x5c, 0xae, 0x15, 0x6e, 0xe8, 0x4c, 0x2b, 0x64, 0xdc, 0xb5, 0xff, 0x5b, 0x80, 0xda,
-	0x72, 0xcf, 0x31, 0xf8, 0xa6, 0xfe, 0xc3, 0x2d, 0xd7, 0x4a, 0xc5, 0x2c, 0x66, 0xaa, 0x98, 0x3d,
-	0x18, 0xdb, 0x7d, 0x14, 0xaa, 0xa0, 0x8d, 0xf9, 0xb5, 0xe1, 0x7b, 0x85, 0xac, 0xd2, 0xec, 0x1d,
-	0xce, 0x4f, 0xb8, 0x86, 0x27, 0x65, 0x85, 0xc6, 0xee, 0x3c, 0xe0, 0x42, 0xa5, 0xb0, 0xab, 0x5f,
-	0x80, 0x46, 0x0c, 0xed, 0x44, 0x5e, 0xa2, 0xbf, 0x55, 0x86, 0xb1, 0x5b, 0xad, 0xd6, 0xc2, 0xfa,
-	0x0a, 0x79, 0x05, 0x1a, 0xd2, 0x6b, 0x78, 0x37, 0x6a, 0x83, 0xd0, 0x69, 0xdc, 0x8a, 0x40, 0x18,
-	0xc7, 0x63, 0x0a, 0xbc, 0x47, 0x75, 0xbb, 0x23, 0x07, 0x4b, 0xa8, 0x3b, 0x20, 0x2b, 0x44, 0x01,
-	0x23, 0x3a, 0x4c, 0xf6, 0x7c, 0xea, 0xb1, 0x26, 0x14, 0xfb, 0x7d, 0x39, 0x6c, 0x8e, 0x69, 0x11,
-	0xe0, 0x0b, 0xcc, 0x66, 0x82, 0x01, 0xa6, 0x18, 0x92, 0xd7, 0xa0, 0xa6, 0xf7, 0x82, 0x1d, 0xbe,
-	0xe5, 0x12, 0x63, 0xe3, 0x39, 0xee, 0x54, 0x95, 0x65, 0x4f, 0x0e, 0x66, 0xc6, 0xef, 0x60, 0xf3,
-	0x15, 0xf5, 0x8e, 0x21, 0x36, 0xab, 0x9c, 0xb2, 0x31, 0xc8, 0xca, 0x55, 0x4e, 0x5c, 0xb9, 0xf5,
-	0x04, 0x03, 0x4c, 0x31, 0x24, 0xef, 0xc0, 0xf8, 0x2e, 0xdd, 0x0f, 0xf4, 0x2d, 0x29, 0x60, 0xec,
-	0x24, 0x02, 0xce, 0x31, 0xa5, 0xff, 0x4e, 0x8c, 0x1c, 0x13, 0xcc, 0x88, 0x0f, 0x17, 0x77, 0xa9,
-	0xb7, 0x45, 0x3d, 0x57, 0xda, 0x2b, 0xa4, 0x90, 0xea, 0x49, 0x84, 0x4c, 0x1f, 0x1e, 0xcc, 0x5c,
-	0xbc, 0x93, 0xc1, 0x06, 0x33, 0x99, 0x6b, 0xff, 0xbb, 0x08, 0x53, 0xb7, 0x44, 0xd8, 0x86, 0xeb,
-	0x09, 0xcd, 0x83, 0x5c, 0x81, 0x92, 0xd7, 0xed, 0xf1, 0x9e, 0x53, 0x12, 0x6e, 0x0d, 0x5c, 0xdf,
-	0x44, 0x56, 0x46, 0xde, 0x86, 0x9a, 0x29, 0xa7, 0x0c, 0x69, 0x2e, 0x19, 0xca, 0xb4, 0xa5, 0xde,
-	0x30, 0xe4, 0xc6, 0xf6, 0x86, 0x1d, 0xbf, 0xdd, 0xb2, 0xde, 0xa7, 0xd2, 0x82, 0xc0, 0xf7, 0x86,
-	0x6b, 0xa2, 0x08, 0x15, 0x8c, 0xad, 0xaa, 0xbb, 0x74, 0x5f, 0xec, 0x9f, 0xcb, 0xd1, 0xaa, 0x7a,
-	0x47, 0x96, 0x61, 0x08, 0x25, 0x33, 0x6a, 0xb0, 0xb0, 0x5e, 0x50, 0x16, 0xb6, 0x9f, 0xfb, 0xac,
-	0x40, 0x8e, 0x1b, 0x36, 0x65, 0xbe, 0x67, 0x05, 0x01, 0xf5, 0xe4, 0x6f, 0x1c, 0x6a, 0xca, 0x7c,
-	0x93, 0x73, 0x40, 0xc9, 0x89, 0xfc, 0x14, 0xd4, 0x39, 0xf3, 0xa6, 0xed, 0x6e, 0xf1, 0x1f, 0x57,
-	0x17, 0x56, 0xa0, 0xfb, 0xaa, 0x10, 0x23, 0xb8, 0xf6, 0x07, 0x45, 0xb8, 0x7c, 0x8b, 0x06, 0x42,
-	0xab, 0x59, 0xa2, 0x5d, 0xdb, 0xdd, 0x67, 0xfa, 0x34, 0xd2, 0x87, 0xe4, 0x0d, 0x00, 0xcb, 0xdf,
-	0x6a, 0xed, 0x19, 0x7c, 0x1c, 0x88, 0x31, 0x7c, 0x5d, 0x0e, 0x49, 0x58, 0x69, 0x35, 0x25, 0xe4,
-	0x49, 0xe2, 0x0d, 0x63, 0x34, 0xd1, 0x86, 0xbc, 0x78, 0xc4, 0x86, 0xbc, 0x05, 0xd0, 0x8d, 0xb4,
-	0xf2, 0x12, 0xc7, 0xfc, 0x19, 0x25, 0xe6, 0x24, 0x0a, 0x79, 0x8c, 0x4d, 0x1e, 0x3d, 0xd9, 0x81,
-	0x73, 0x26, 0xdd, 0xd6, 0x7b, 0x76, 0x10, 0xee, 0x24, 0xe4, 0x20, 0x3e, 0xfe, 0x66, 0x24, 0x0c,
-	0x29, 0x59, 0x4a, 0x71, 0xc2, 0x3e, 0xde, 0xda, 0xdf, 0x29, 0xc1, 0xd5, 0x5b, 0x34, 0x08, 0x6d,
-	0x74, 0x72, 0x76, 0x6c, 0x75, 0xa9, 0xc1, 0xfe, 0xc2, 0x07, 0x05, 0x18, 0xb3, 0xf5, 0x2d, 0x6a,
-	0xb3, 0xd5, 0x8b, 0x7d, 0xcd, 0xbb, 0x43, 0x2f, 0x04, 0x83, 0xa5, 0xcc, 0xae, 0x72, 0x09, 0xa9,
-	0xa5, 0x41, 0x14, 0xa2, 0x14, 0xcf, 0x26, 0x75, 0xc3, 0xee, 0xf9, 0x81, 0xd8, 0xd9, 0x49, 0x7d,
-	0x32, 0x9c, 0xd4, 0x17, 0x23, 0x10, 0xc6, 0xf1, 0xc8, 0x3c, 0x80, 0x61, 0x5b, 0xd4, 0x09, 0x38,
-	0x95, 0x18, 0x57, 0x44, 0xfd, 0xdf, 0xc5, 0x10, 0x82, 0x31, 0x2c, 0x26, 0xaa, 0xe3, 0x3a, 0x56,
-	0xe0, 0x0a, 0x51, 0xe5, 0xa4, 0xa8, 0xb5, 0x08, 0x84, 0x71, 0x3c, 0x4e, 0x46, 0x03, 0xcf, 0x32,
-	0x7c, 0x4e, 0x56, 0x49, 0x91, 0x45, 0x20, 0x8c, 0xe3, 0xb1, 0x35, 0x2f, 0xf6, 0xfd, 0x27, 0x5a,
-	0xf3, 0x7e, 0xb5, 0x0e, 0xd7, 0x12, 0xcd, 0x1a, 0xe8, 0x01, 0xdd, 0xee, 0xd9, 0x2d, 0x1a, 0xa8,
-	0x1f, 0x38, 0xe4, 0x5a, 0xf8, 0x67, 0xa3, 0xff, 0x2e, 0x82, 0xc5, 0x8c, 0xd1, 0xfc, 0xf7, 0xbe,
-	0x0a, 0x1e, 0xeb, 0xdf, 0xcf, 0x41, 0xdd, 0xd1, 0x03, 0x9f, 0x0f, 0x5c, 0x39, 0x46, 0x43, 0x35,
-	0xec, 0xae, 0x02, 0x60, 0x84, 0x43, 0xd6, 0xe1, 0xa2, 0x6c, 0xe2, 0x9b, 0x8f, 0xd9, 0x9e, 0x9f,
-	0x7a, 0x82, 0x56, 0x2e, 0xa7, 0x92, 0xf6, 0xe2, 0x5a, 0x06, 0x0e, 0x66, 0x52, 0x92, 0x35, 0xb8,
-	0x60, 0x88, 0x00, 0x1a, 0x6a, 0xbb, 0xba, 0xa9, 0x18, 0x0a, 0x93, 0x68, 0xb8, 0x35, 0x5a, 0xec,
-	0x47, 0xc1, 0x2c, 0xba, 0x74, 0x6f, 0x1e, 0x1b, 0xaa, 0x37, 0x57, 0x87, 0xe9, 0xcd, 0xb5, 0xe1,
-	0x7a, 0x73, 0xfd, 0x78, 0xbd, 0x99, 0xb5, 0x3c, 0x8f, 0xd5, 0xf0, 0x98, 0x7a, 0x22, 0x56, 0xd8,
-	0x58, 0x7c, 0x56, 0xd8, 0xf2, 0xad, 0x0c, 0x1c, 0xcc, 0xa4, 0x24, 0x5b, 0x70, 0x55, 0x94, 0xdf,
-	0x74, 0x0c, 0x6f, 0xbf, 0xcb, 0x16, 0x9e, 0x18, 0xdf, 0x46, 0xc2, 0x26, 0x7d, 0xb5, 0x35, 0x10,
-	0x13, 0x8f, 0xe0, 0x42, 0xbe, 0x04, 0x13, 0xe2, 0x2f, 0xad, 0xe9, 0x5d, 0xce, 0x56, 0x44, 0x6b,
-	0x5d, 0x92, 0x6c, 0x27, 0x16, 0xe3, 0x40, 0x4c, 0xe2, 0x92, 0x05, 0x98, 0xea, 0xee, 0x19, 0xec,
-	0x71, 0x65, 0xfb, 0x2e, 0xa5, 0x26, 0x35, 0xb9, 0x73, 0xb5, 0xde, 0x7c, 0x56, 0x59, 0x77, 0xd6,
-	0x93, 0x60, 0x4c, 0xe3, 0x93, 0xd7, 0x60, 0xdc, 0x0f, 0x74, 0x2f, 0x90, 0x86, 0xe0, 0xe9, 0x49,
-	0x11, 0xcd, 0xa6, 0xec, 0xa4, 0xad, 0x18, 0x0c, 0x13, 0x98, 0x99, 0xeb, 0xc5, 0xd4, 0xe9, 0xad,
-	0x17, 0x79, 0x66, 0xab, 0x7f, 0x54, 0x84, 0xeb, 0xb7, 0x68, 0xb0, 0xe6, 0x3a, 0xd2, 0x8c, 0x9e,
-	0xb5, 0xec, 0x1f, 0xcb, 0x8a, 0x9e, 0x5c, 0xb4, 0x8b, 0x23, 0x5d, 0xb4, 0x4b, 0x23, 0x5a, 0xb4,
-	0xcb, 0xa7, 0xb8, 0x68, 0xff, 0xdd, 0x22, 0x3c, 0x9b, 0x68, 0xc9, 0x75, 0xd7, 0x54, 0x13, 0xfe,
-	0x27, 0x0d, 0x78, 0x8c, 0x06, 0x7c, 0x22, 0xf4, 0x4e, 0xee, 0x08, 0x4d, 0x69, 0x3c, 0xdf, 0x49,
-	0x6b, 0x3c, 0xef, 0xe4, 0x59, 0xf9, 0x32, 0x24, 0x1c, 0x6b, 0xc5, 0x7b, 0x13, 0x88, 0x27, 0xdd,
-	0xb6, 0x91, 0x39, 0x5b, 0x2a, 0x3d, 0x61, 0xb8, 0x2c, 0xf6, 0x61, 0x60, 0x06, 0x15, 0x69, 0xc1,
-	0x25, 0x9f, 0x3a, 0x81, 0xe5, 0x50, 0x3b, 0xc9, 0x4e, 0x68, 0x43, 0xcf, 0x4b, 0x76, 0x97, 0x5a,
-	0x59, 0x48, 0x98, 0x4d, 0x9b, 0x67, 0x1e, 0xf8, 0x2d, 0xe0, 0x2a, 0xa7, 0x68, 0x9a, 0x91, 0x69,
-	0x2c, 0x1f, 0xa4, 0x35, 0x96, 0x77, 0xf3, 0xff, 0xb7, 0xe1, 0xb4, 0x95, 0x79, 0x00, 0xfe, 0x17,
-	0xe2, 0xea, 0x4a, 0xb8, 0x48, 0x63, 0x08, 0xc1, 0x18, 0x16, 0x5b, 0x80, 0x54, 0x3b, 0xc7, 0x35,
-	0x95, 0x70, 0x01, 0x6a, 0xc5, 0x81, 0x98, 0xc4, 0x1d, 0xa8, 0xed, 0x54, 0x86, 0xd6, 0x76, 0xde,
-	0x04, 0x92, 0x30, 0x3c, 0x0a, 0x7e, 0x63, 0xc9, 0x68, 0xed, 0x95, 0x3e, 0x0c, 0xcc, 0xa0, 0x1a,
-	0xd0, 0x95, 0xab, 0xa3, 0xed, 0xca, 0xb5, 0xe1, 0xbb, 0x32, 0x79, 0x17, 0xae, 0x70, 0x51, 0xb2,
-	0x7d, 0x92, 0x8c, 0x85, 0xde, 0xf3, 0x69, 0xc9, 0xf8, 0x0a, 0x0e, 0x42, 0xc4, 0xc1, 0x3c, 0xd8,
-	0xff, 0x31, 0x3c, 0x6a, 0x32, 0xe1, 0xba, 0x3d, 0x58, 0x27, 0x5a, 0xcc, 0xc0, 0xc1, 0x4c, 0x4a,
-	0xd6, 0xc5, 0x02, 0xd6, 0x0d, 0xf5, 0x2d, 0x9b, 0x9a, 0x32, 0x5a, 0x3d, 0xec, 0x62, 0x1b, 0xab,
-	0x2d, 0x09, 0xc1, 0x18, 0x56, 0x96, 0x9a, 0x32, 0x7e, 0x42, 0x35, 0xe5, 0x16, 0xb7, 0xd2, 0x6f,
-	0x27, 0xb4, 0x21, 0xa9, 0xeb, 0x84, 0xe7, 0x0f, 0x16, 0xd3, 0x08, 0xd8, 0x4f, 0xc3, 0xb5, 0x44,
-	0xc3, 0xb3, 0xba, 0x81, 0x9f, 0xe4, 0x35, 0x99, 0xd2, 0x12, 0x33, 0x70, 0x30, 0x93, 0x92, 0xe9,
-	0xe7, 0x3b, 0x54, 0xb7, 0x83, 0x9d, 0x24, 0xc3, 0xa9, 0xa4, 0x7e, 0x7e, 0xbb, 0x1f, 0x05, 0xb3,
-	0xe8, 0x32, 0x17, 0xa4, 0x73, 0x4f, 0xa7, 0x5a, 0xf5, 0xcf, 0x4a, 0xf0, 0xfc, 0x2d, 0x2a, 0x0e,
-	0x20, 0x38, 0xed, 0x75, 0xab, 0x4b, 0x6d, 0xcb, 0xa1, 0xb1, 0x1a, 0x91, 0x3f, 0x5d, 0x80, 0x71,
-	0x61, 0x17, 0x91, 0x47, 0x07, 0xf2, 0xba, 0x87, 0x32, 0xc2, 0x95, 0x22, 0x65, 0x55, 0x58, 0x63,
-	0xe4, 0x4e, 0x28, 0x21, 0xf7, 0x13, 0x8b, 0xcc, 0x71, 0x74, 0x93, 0x6f, 0x97, 0xe0, 0x0a, 0xfb,
-	0x9f, 0x2a, 0x72, 0xf1, 0x13, 0xb3, 0xd8, 0x47, 0xf0, 0x13, 0x7e, 0xa5, 0x02, 0x17, 0x6e, 0xd1,
-	0xa0, 0x4f, 0xbb, 0xfe, 0x43, 0xda, 0xfc, 0x6b, 0x70, 0x21, 0x8a, 0xa4, 0x6d, 0x05, 0xae, 0x27,
-	0x74, 0xb3, 0x94, 0xf5, 0xa3, 0xd5, 0x8f, 0x82, 0x59, 0x74, 0xe4, 0xab, 0xf0, 0xac, 0x2f, 0xa6,
-	0x2b, 0x61, 0x6f, 0x17, 0xc6, 0xa1, 0xd8, 0x69, 0xb6, 0x19, 0xc9, 0xf2, 0xd9, 0x56, 0x36, 0x1a,
-	0x0e, 0xa2, 0x27, 0xdf, 0x82, 0xf1, 0xae, 0x9c, 0x02, 0xd9, 0x3f, 0xcb, 0x1d, 0x14, 0xb6, 0x1e,
-	0x63, 0x16, 0xcd, 0x71, 0xf1, 0x52, 0x4c, 0x08, 0xcc, 0xec, 0xa9, 0xb5, 0x53, 0xec, 0xa9, 0xff,
-	0xbd, 0x08, 0xd5, 0x5b, 0x9e, 0xdb, 0xeb, 0x36, 0xf7, 0x49, 0x1b, 0xc6, 0x1e, 0x71, 0x67, 0xa8,
-	0x9c, 0xe1, 0x87, 0x3f, 0x8d, 0x22, 0x7c, 0xaa, 0x91, 0x8a, 0x2b, 0xde, 0x51, 0xb2, 0x67, 0x9d,
-	0x78, 0x97, 0xee, 0x53, 0x53, 0xfa, 0x44, 0xc3, 0x4e, 0x7c, 0x87, 0x15, 0xa2, 0x80, 0x91, 0x0e,
-	0x4c, 0xe9, 0xb6, 0xed, 0x3e, 0xa2, 0xe6, 0xaa, 0x1e, 0xf0, 0x38, 0x06, 0xe9, 0x2b, 0x3b, 0xa9,
-	0x9b, 0x81, 0x07, 0xa7, 0x2c, 0x24, 0x59, 0x61, 0x9a, 0x37, 0x79, 0x0f, 0xaa, 0x7e, 0xe0, 0x7a,
-	0x4a, 0x79, 0x6e, 0xcc, 0x2f, 0x0e, 0xff, 0xd3, 0x9b, 0x6f, 0xb5, 0x04, 0x2b, 0xe1, 0x83, 0x91,
-	0x2f, 0xa8, 0x04, 0x68, 0xbf, 0x5c, 0x00, 0xb8, 0xbd, 0xb1, 0xb1, 0x2e, 0xdd, 0x45, 0x26, 0x94,
-	0xf5, 0x5e, 0xe8, 0x78, 0x1e, 0xde, 0xc1, 0x9b, 0x08, 0xcc, 0x96, 0x3e, 0xd9, 0x5e, 0xb0, 0x83,
-	0x9c, 0x3b, 0xf9, 0x49, 0xa8, 0xca, 0x0d, 0x8f, 0x6c, 0xf6, 0x30, 0x3e, 0x46, 0xae, 0xc4, 0xa8,
-	0xe0, 0xda, 0xaf, 0x17, 0x01, 0x56, 0x4c, 0x9b, 0xb6, 0xd4, 0x01, 0xa2, 0x7a, 0xb0, 0xe3, 0x51,
-	0x7f, 0xc7, 0xb5, 0xcd, 0x21, 0xbd, 0xe3, 0xdc, 0x87, 0xb3, 0xa1, 0x98, 0x60, 0xc4, 0x8f, 0x98,
-	0x30, 0xee, 0x07, 0xb4, 0xab, 0x62, 0xb5, 0x87, 0x74, 0x8a, 0x9d, 0x13, 0x76, 0xae, 0x88, 0x0f,
-	0x26, 0xb8, 0x12, 0x1d, 0x1a, 0x96, 0x63, 0x88, 0x01, 0xd2, 0xdc, 0x1f, 0xb2, 0x23, 0x4d, 0xb1,
-	0x1d, 0xe4, 0x4a, 0xc4, 0x06, 0xe3, 0x3c, 0xb5, 0xdf, 0x29, 0xc2, 0x65, 0x2e, 0x8f, 0x55, 0x23,
-	0xa1, 0xe2, 0x90, 0x3f, 0xde, 0x77, 0x5c, 0xfb, 0x8f, 0x1e, 0x4f, 0xb4, 0x38, 0xed, 0xbb, 0x46,
-	0x03, 0x3d, 0xd2, 0xcf, 0xa3, 0xb2, 0xd8, 0x19, 0xed, 0x1e, 0x94, 0x7d, 0x36, 0x5f, 0x89, 0xd6,
-	0x6b, 0x0d, 0xdd, 0x85, 0xb2, 0x3f, 0x80, 0xcf, 0x5e, 0x61, 0x14, 0x00, 0x9f, 0xb5, 0xb8, 0x38,
-	0xf2, 0x4d, 0x18, 0xf3, 0x03, 0x3d, 0xe8, 0xa9, 0xa1, 0xb9, 0x39, 0x6a, 0xc1, 0x9c, 0x79, 0x34,
-	0x8f, 0x88, 0x77, 0x94, 0x42, 0xb5, 0xdf, 0x29, 0xc0, 0xd5, 0x6c, 0xc2, 0x55, 0xcb, 0x0f, 0xc8,
-	0x1f, 0xeb, 0x6b, 0xf6, 0x63, 0xfe, 0x71, 0x46, 0xcd, 0x1b, 0x3d, 0x3c, 0x0f, 0xa3, 0x4a, 0x62,
-	0x4d, 0x1e, 0x40, 0xc5, 0x0a, 0x68, 0x47, 0xd9, 0x0b, 0xee, 0x8d, 0xf8, 0xd3, 0x63, 0x4b, 0x3b,
-	0x93, 0x82, 0x42, 0x98, 0xf6, 0xdd, 0xe2, 0xa0, 0x4f, 0xe6, 0xcb, 0x87, 0x9d, 0x8c, 0xfa, 0xbf,
-	0x93, 0x2f, 0xea, 0x3f, 0x59, 0xa1, 0xfe, 0xe0, 0xff, 0x3f, 0xd1, 0x1f, 0xfc, 0x7f, 0x2f, 0x7f,
-	0xf0, 0x7f, 0xaa, 0x19, 0x06, 0x9e, 0x01, 0xf8, 0x61, 0x09, 0x9e, 0x3b, 0xaa, 0xdb, 0xb0, 0xf5,
-	0x4c, 0xf6, 0xce, 0xbc, 0xeb, 0xd9, 0xd1, 0xfd, 0x90, 0xcc, 0x43, 0xa5, 0xbb, 0xa3, 0xfb, 0x4a,
-	0x29, 0x7b, 0x2e, 0x0c, 0x1b, 0x65, 0x85, 0x4f, 0xd8, 0xa4, 0xc1, 0x95, 0x39, 0xfe, 0x8a, 0x02,
-	0x95, 0x4d, 0xc7, 0x1d, 0xea, 0xfb, 0x91, 0x8d, 0x27, 0x9c, 0x8e, 0xd7, 0x44, 0x31, 0x2a, 0x38,
-	0x09, 0x60, 0x4c, 0xb8, 0x0c, 0xe4, 0xca, 0x34, 0xda, 0x9d, 0x57, 0xf8, 0x51, 0x72, 0xcf, 0x25,
-	0x65, 0x91, 0x59, 0x28, 0x07, 0x51, 0xd8, 0xbe, 0x32, 0xb5, 0x94, 0x33, 0xf4, 0x53, 0x8e, 0x47,
-	0xde, 0x04, 0xe2, 0x6e, 0x71, 0x27, 0x89, 0x29, 0xe3, 0x21, 0x2c, 0xd7, 0xe1, 0x0a, 0x59, 0x29,
-	0x32, 0xd4, 0xdc, 0xeb, 0xc3, 0xc0, 0x0c, 0x2a, 0xed, 0x5f, 0xd4, 0xe0, 0x72, 0x76, 0x7f, 0x60,
-	0xed, 0xb6, 0x47, 0x3d, 0x9f, 0xf1, 0x2e, 0x24, 0xdb, 0xed, 0xbe, 0x28, 0x46, 0x05, 0xff, 0x58,
-	0x07, 0x10, 0xfe, 0x4a, 0x01, 0xae, 0x78, 0xd2, 0xe7, 0x77, 0x16, 0x41, 0x84, 0xcf, 0x0b, 0xf3,
-	0xd4, 0x00, 0x81, 0x38, 0xb8, 0x2e, 0xe4, 0xaf, 0x17, 0x60, 0xba, 0x93, 0xb2, 0x5b, 0x9d, 0xe2,
-	0x79, 0x5d, 0x7e, 0x2e, 0x66, 0x6d, 0x80, 0x3c, 0x1c, 0x58, 0x13, 0xf2, 0x2d, 0x68, 0x74, 0x59,
-	0xbf, 0xf0, 0x03, 0xea, 0x18, 0x2a, 0xe0, 0x77, 0xf8, 0x91, 0xb4, 0x1e, 0xf1, 0x0a, 0xcf, 0xeb,
-	0x71, 0xfd, 0x20, 0x06, 0xc0, 0xb8, 0xc4, 0xa7, 0xfc, 0x80, 0xee, 0x0d, 0xa8, 0xf9, 0x34, 0x08,
-	0x2c, 0xa7, 0x2d, 0xf6, 0x1b, 0x75, 0x31, 0x56, 0x5a, 0xb2, 0x0c, 0x43, 0x28, 0xf9, 0x29, 0xa8,
-	0x73, 0x17, 0xe2, 0x82, 0xd7, 0xf6, 0xa7, 0xeb, 0x3c, 0xfc, 0x6f, 0x42, 0x04, 0x34, 0xca, 0x42,
-	0x8c, 0xe0, 0xe4, 0x73, 0x30, 0xbe, 0xc5, 0x87, 0xaf, 0x34, 0x1d, 0x09, 0x9b, 0x25, 0xd7, 0xd6,
-	0x9a, 0xb1, 0x72, 0x4c, 0x60, 0x91, 0x79, 0x00, 0x1a, 0xfa, 0x59, 0xd3, 0xf6, 0xc9, 0xc8, 0x03,
-	0x8b, 0x31, 0x2c, 0xf2, 0x3c, 0x94, 0x02, 0xdb, 0xe7, 0x36, 0xc9, 0x5a, 0xb4, 0x05, 0xdd, 0x58,
-	0x6d, 0x21, 0x2b, 0xd7, 0xfe, 0xa0, 0x00, 0x53, 0xa9, 0xe3, 0x65, 0x8c, 0xa4, 0xe7, 0xd9, 0x72,
-	0x1a, 0x09, 0x49, 0x36, 0x71, 0x15, 0x59, 0x39, 0x79, 0x57, 0xaa, 0xe5, 0xc5, 0x9c, 0x09, 0x76,
-	0xee, 0xea, 0x81, 0xcf, 0xf4, 0xf0, 0x3e, 0x8d, 0x9c, 0xbb, 0x6d, 0xa3, 0xfa, 0xc8, 0x75, 0x20,
-	0xe6, 0xb6, 0x8d, 0x60, 0x98, 0xc0, 0x4c, 0x19, 0x70, 0xcb, 0xc7, 0x31, 0xe0, 0x6a, 0xbf, 0x58,
-	0x8c, 0xb5, 0x80, 0xd4, 0xec, 0x3f, 0xa4, 0x05, 0x5e, 0x62, 0x0b, 0x68, 0xb8, 0xb8, 0xd7, 0xe3,
-	0xeb, 0x1f, 0x5f, 0x8c, 0x25, 0x94, 0x3c, 0x10, 0x6d, 0x5f, 0xca, 0x99, 0x04, 0x60, 0x63, 0xb5,
-	0x25, 0xa2, 0xe5, 0xd4, 0x5f, 0x0b, 0x7f, 0x41, 0xf9, 0x94, 0x7e, 0x81, 0xf6, 0x4f, 0x4a, 0xd0,
-	0x78, 0xd3, 0xdd, 0xfa, 0x98, 0x44, 0xc4, 0x67, 0x2f, 0x53, 0xc5, 0x8f, 0x70, 0x99, 0xda, 0x84,
-	0x67, 0x83, 0xc0, 0x6e, 0x51, 0xc3, 0x75, 0x4c, 0x7f, 0x61, 0x3b, 0xa0, 0xde, 0xb2, 0xe5, 0x58,
-	0xfe, 0x0e, 0x35, 0xa5, 0x7b, 0xf0, 0x53, 0x87, 0x07, 0x33, 0xcf, 0x6e, 0x6c, 0xac, 0x66, 0xa1,
-	0xe0, 0x20, 0x5a, 0x3e, 0x6d, 0x88, 0xb3, 0xc0, 0xfc, 0xac, 0x9c, 0x8c, 0xa1, 0x12, 0xd3, 0x46,
-	0xac, 0x1c, 0x13, 0x58, 0xda, 0xbf, 0x2b, 0x42, 0x3d, 0x4c, 0x9d, 0x42, 0x5e, 0x84, 0xea, 0x96,
-	0xe7, 0xee, 0x52, 0x4f, 0x78, 0x62, 0xe5, 0x59, 0xb9, 0xa6, 0x28, 0x42, 0x05, 0x23, 0x2f, 0x40,
-	0x25, 0x70, 0xbb, 0x96, 0x91, 0x36, 0xa8, 0x6d, 0xb0, 0x42, 0x14, 0x30, 0x3e, 0x10, 0x78, 0x98,
-	0x28, 0xff, 0xaa, 0x5a, 0x6c, 0x20, 0xf0, 0x52, 0x94, 0x50, 0x35, 0x10, 0xca, 0x23, 0x1f, 0x08,
-	0x2f, 0x85, 0x2a, 0x60, 0x25, 0x39, 0x12, 0x53, 0x4a, 0xdb, 0x3b, 0x50, 0xf6, 0x75, 0xdf, 0x96,
-	0xcb, 0x5b, 0x8e, 0x5c, 0x1f, 0x0b, 0xad, 0x55, 0x99, 0xeb, 0x63, 0xa1, 0xb5, 0x8a, 0x9c, 0xa9,
-	0xf6, 0xeb, 0x25, 0x68, 0x88, 0xf6, 0x15, 0xb3, 0xc7, 0x28, 0x5b, 0xf8, 0x75, 0x1e, 0x42, 0xe3,
-	0xf7, 0x3a, 0xd4, 0xe3, 0xe6, 0x28, 0x39, 0x19, 0xc6, 0xfd, 0x42, 0x11, 0x30, 0x0c, 0xa3, 0x89,
-	0x8a, 0x7e, 0xbc, 0x9b, 0x9e, 0x2d, 0x15, 0x3c, 0xfd, 0x8f, 0xd4, 0x71, 0x65, 0x64, 0x6c, 0xb8,
-	0x54, 0xdc, 0x89, 0xc1, 0x30, 0x81, 0xa9, 0xfd, 0x6e, 0x11, 0xea, 0xab, 0xd6, 0x36, 0x35, 0xf6,
-	0x0d, 0x9b, 0x92, 0x6f, 0xc0, 0x55, 0x93, 0xda, 0x94, 0xad, 0x98, 0xb7, 0x3c, 0xdd, 0xa0, 0xeb,
-	0xd4, 0xb3, 0x78, 0xfa, 0x32, 0x36, 0x06, 0x65, 0xc0, 0xf2, 0xb5, 0xc3, 0x83, 0x99, 0xab, 0x4b,
-	0x03, 0xb1, 0xf0, 0x08, 0x0e, 0x64, 0x05, 0xc6, 0x4d, 0xea, 0x5b, 0x1e, 0x35, 0xd7, 0x63, 0x1b,
-	0xa2, 0x17, 0x55, 0x3d, 0x97, 0x62, 0xb0, 0x27, 0x07, 0x33, 0x13, 0xca, 0x10, 0x2a, 0x76, 0x46,
-	0x09, 0x52, 0x36, 0xb5, 0x74, 0xf5, 0x9e, 0x4f, 0x33, 0xea, 0x59, 0xe2, 0xf5, 0xe4, 0x53, 0xcb,
-	0x7a, 0x36, 0x0a, 0x0e, 0xa2, 0x25, 0x5b, 0x30, 0xcd, 0xeb, 0x9f, 0xc5, 0xb7, 0xcc, 0xf9, 0xbe,
-	0x74, 0x78, 0x30, 0xa3, 0x2d, 0xd1, 0xae, 0x47, 0x0d, 0x3d, 0xa0, 0xe6, 0xd2, 0x00, 0x6c, 0x1c,
-	0xc8, 0x47, 0xab, 0x40, 0x69, 0xd5, 0x6d, 0x6b, 0xdf, 0x2d, 0x41, 0x98, 0x4f, 0x8f, 0xfc, 0x99,
-	0x02, 0x34, 0x74, 0xc7, 0x71, 0x03, 0x99, 0xab, 0x4e, 0x44, 0x87, 0x60, 0xee, 0xb4, 0x7d, 0xb3,
-	0x0b, 0x11, 0x53, 0x11, 0x58, 0x10, 0x06, 0x3b, 0xc4, 0x20, 0x18, 0x97, 0x4d, 0x7a, 0xa9, 0x58,
-	0x87, 0xb5, 0xfc, 0xb5, 0x38, 0x46, 0x64, 0xc3, 0xd5, 0xaf, 0xc0, 0xb9, 0x74, 0x65, 0x4f, 0xe2,
-	0xaa, 0xcc, 0x15, 0x34, 0x52, 0x04, 0x88, 0xe2, 0x9d, 0xce, 0xc0, 0x20, 0x67, 0x25, 0x0c, 0x72,
-	0xc3, 0xa7, 0x04, 0x89, 0x2a, 0x3d, 0xd0, 0x08, 0xf7, 0x30, 0x65, 0x84, 0x5b, 0x19, 0x85, 0xb0,
-	0xa3, 0x0d, 0x6f, 0x5b, 0x70, 0x21, 0xc2, 0x8d, 0x66, 0x97, 0x3b, 0xa9, 0xd1, 0x2f, 0xf4, 0xca,
-	0xcf, 0x0c, 0x18, 0xfd, 0x53, 0xb1, 0x00, 0xb4, 0xfe, 0xf1, 0xaf, 0xfd, 0x8d, 0x02, 0x9c, 0x8b,
-	0x0b, 0xe1, 0x39, 0x05, 0x3e, 0x0f, 0x13, 0x1e, 0xd5, 0xcd, 0xa6, 0x1e, 0x18, 0x3b, 0xfc, 0xa8,
-	0x43, 0x81, 0x9f, 0x4d, 0xe0, 0xa7, 0x1f, 0x31, 0x0e, 0xc0, 0x24, 0x1e, 0xd1, 0xa1, 0xc1, 0x0a,
-	0x64, 0x3e, 0x93, 0x21, 0xad, 0xcc, 0x7c, 0x83, 0x87, 0x11, 0x1b, 0x8c, 0xf3, 0xd4, 0x7e, 0x58,
-	0x80, 0xc9, 0x78, 0x85, 0x4f, 0xdd, 0x02, 0xb9, 0x93, 0xb4, 0x40, 0x2e, 0x8e, 0xe0, 0xbf, 0x0f,
-	0xb0, 0x3a, 0x7e, 0xbb, 0x11, 0xff, 0x34, 0x6e, 0x69, 0x8c, 0x1b, 0x57, 0x0a, 0x47, 0x1a, 0x57,
-	0x3e, 0xfe, 0x49, 0xce, 0x06, 0xed, 0x0a, 0xca, 0x4f, 0xf1, 0xae, 0xe0, 0xa3, 0xcc, 0x94, 0x16,
-	0xcb, 0xf6, 0x35, 0x96, 0x23, 0xdb, 0x57, 0x27, 0xcc, 0xf6, 0x55, 0x1d, 0xd9, 0xc4, 0x76, 0x9c,
-	0x8c, 0x5f, 0xb5, 0x33, 0xcd, 0xf8, 0x55, 0x3f, 0xad, 0x8c, 0x5f, 0x90, 0x37, 0xe3, 0xd7, 0x77,
-	0x0a, 0x30, 0x69, 0x26, 0x4e, 0x8c, 0xcb, 0x5c, 0x0d, 0xc3, 0x2f, 0x67, 0xc9, 0x03, 0xe8, 0xe2,
-	0xc8, 0x60, 0xb2, 0x0c, 0x53, 0x22, 0xb3, 0xf2, 0x6c, 0x8d, 0x7f, 0x24, 0x79, 0xb6, 0xc8, 0x37,
-	0xa1, 0x6e, 0xab, 0xb5, 0x4e, 0xe6, 0x4f, 0x5d, 0x1d, 0x49, 0x97, 0x94, 0x3c, 0xa3, 0x53, 0x29,
-	0x61, 0x11, 0x46, 0x12, 0xb5, 0xff, 0x55, 0x8d, 0x2f, 0x88, 0x67, 0xed, 0xe3, 0x78, 0x35, 0xe9,
-	0xe3, 0xb8, 0x9e, 0xf6, 0x71, 0xf4, 0xad, 0xe6, 0xd2, 0xcf, 0xf1, 0xd9, 0xd8, 0x3a, 0x51, 0xe2,
-	0x49, 0xb7, 0xc2, 0x2e, 0x97, 0xb1, 0x56, 0x2c, 0xc0, 0x94, 0x54, 0x02, 0x14, 0x90, 0x4f, 0xb2,
-	0x13, 0x51, 0x94, 0xe1, 0x52, 0x12, 0x8c, 0x69, 0x7c, 0x26, 0xd0, 0x57, 0x99, 0xaa, 0xc5, 0x8e,
-	0x2d, 0xea, 0xe3, 0x2a, 0x8b, 0x74, 0x88, 0xc1, 0x76, 0x77, 0x1e, 0xd5, 0x7d, 0xe9, 0xa9, 0x88,
-	0xed, 0xee, 0x90, 0x97, 0xa2, 0x84, 0xc6, 0xdd, 0x35, 0xd5, 0x0f, 0x71, 0xd7, 0xe8, 0xd0, 0xb0,
-	0x75, 0x3f, 0x10, 0x9d, 0xc9, 0x94, 0xb3, 0xc9, 0x1f, 0x39, 0xde, 0xba, 0xcf, 0x74, 0x89, 0x48,
-	0x81, 0x5f, 0x8d, 0xd8, 0x60, 0x9c, 0x27, 0x31, 0x61, 0x9c, 0xbd, 0xf2, 0x99, 0xc5, 0x5c, 0x08,
-	0x64, 0x36, 0xc4, 0x93, 0xc8, 0x08, 0xb7, 0x8e, 0xab, 0x31, 0x3e, 0x98, 0xe0, 0x3a, 0xc0, 0xa3,
-	0x03, 0xc3, 0x78, 0x74, 0xc8, 0x97, 0x84, 0xe2, 0xb6, 0x1f, 0xfe, 0xd6, 0x06, 0xff, 0xad, 0x61,
-	0x84, 0x32, 0xc6, 0x81, 0x98, 0xc4, 0x65, 0xbd, 0xa2, 0x27, 0x9b, 0x41, 0x91, 0x8f, 0x27, 0x7b,
-	0xc5, 0x66, 0x12, 0x8c, 0x69, 0x7c, 0xb2, 0x0e, 0x17, 0xc3, 0xa2, 0x78, 0x35, 0x26, 0x38, 0x9f,
-	0x30, 0x64, 0x74, 0x33, 0x03, 0x07, 0x33, 0x29, 0xf9, 0x19, 0xac, 0x9e, 0xe7, 0x51, 0x27, 0xb8,
-	0xad, 0xfb, 0x3b, 0x32, 0xf6, 0x34, 0x3a, 0x83, 0x15, 0x81, 0x30, 0x8e, 0x47, 0xe6, 0x01, 0x04,
-	0x3b, 0x4e, 0x35, 0x95, 0x0c, 0xef, 0xde, 0x0c, 0x21, 0x18, 0xc3, 0xd2, 0xbe, 0x53, 0x87, 0xc6,
-	0x5d, 0x3d, 0xb0, 0xf6, 0x28, 0x77, 0xbf, 0x9e, 0x8e, 0x0f, 0xec, 0xaf, 0x14, 0xe0, 0x72, 0x32,
-	0x66, 0xfa, 0x14, 0x1d, 0x61, 0x3c, 0x67, 0x17, 0x66, 0x4a, 0xc3, 0x01, 0xb5, 0xe0, 0x2e, 0xb1,
-	0xbe, 0x10, 0xec, 0xd3, 0x76, 0x89, 0xb5, 0x06, 0x09, 0xc4, 0xc1, 0x75, 0xf9, 0xb8, 0xb8, 0xc4,
-	0x9e, 0xee, 0x84, 0xb6, 0x29, 0x87, 0x5d, 0xf5, 0xa9, 0x71, 0xd8, 0xd5, 0x9e, 0x0a, 0xad, 0xbf,
-	0x1b, 0x73, 0xd8, 0xd5, 0x73, 0x06, 0x8e, 0xc9, 0x63, 0x46, 0x82, 0xdb, 0x20, 0xc7, 0x1f, 0xcf,
-	0x10, 0xa2, 0x1c, 0x29, 0x4c, 0x59, 0xde, 0xd2, 0x7d, 0xcb, 0x90, 0x6a, 0x47, 0x8e, 0x14, 0xe4,
-	0x2a, 0xd9, 0xa6, 0x88, 0x2f, 0xe1, 0xaf, 0x28, 0x78, 0x47, 0xb9, 0x45, 0x8b, 0xb9, 0x72, 0x8b,
-	0x92, 0x45, 0x28, 0x3b, 0xbb, 0x74, 0xff, 0x64, 0xb9, 0x36, 0xf8, 0x26, 0xf0, 0xee, 0x1d, 0xba,
-	0x8f, 0x9c, 0x58, 0xfb, 0x5e, 0x11, 0x80, 0x7d, 0xfe, 0xf1, 0x5c, 0x67, 0x3f, 0x09, 0x55, 0xbf,
-	0xc7, 0x0d, 0x43, 0x52, 0x61, 0x8a, 0xa2, 0xed, 0x44, 0x31, 0x2a, 0x38, 0x79, 0x01, 0x2a, 0x0f,
-	0x7b, 0xb4, 0xa7, 0xe2, 0x40, 0xc2, 0x7d, 0xc3, 0x5b, 0xac, 0x10, 0x05, 0xec, 0xf4, 0xcc, 0xdb,
-	0xca, 0xc5, 0x56, 0x39, 0x2d, 0x17, 0x5b, 0x1d, 0xaa, 0x77, 0x5d, 0x1e, 0xbc, 0xab, 0xfd, 0x97,
-	0x22, 0x40, 0x14, 0x1c, 0x49, 0x7e, 0xb9, 0x00, 0x97, 0xc2, 0x01, 0x17, 0x88, 0xed, 0x1f, 0xcf,
-	0xfa, 0x9f, 0xdb, 0xdd, 0x96, 0x35, 0xd8, 0xf9, 0x0c, 0xb4, 0x9e, 0x25, 0x0e, 0xb3, 0x6b, 0x41,
-	0x10, 0x6a, 0xb4, 0xd3, 0x0d, 0xf6, 0x97, 0x2c, 0x4f, 0xf6, 0xc0, 0xcc, 0x18, 0xdc, 0x9b, 0x12,
-	0x47, 0x90, 0x4a, 0x1b, 0x05, 0x1f, 0x44, 0x0a, 0x82, 0x21, 0x1f, 0xb2, 0x03, 0x35, 0xc7, 0x7d,
-	0xd7, 0x67, 0xcd, 0x21, 0xbb, 0xe3, 0xf0, 0x89, 0xe8, 0x65, 0xb3, 0x0a, 0xb7, 0x8b, 0x7c, 0xc1,
-	0xaa, 0x23, 0x1b, 0xfb, 0x97, 0x8a, 0x70, 0x21, 0xa3, 0x1d, 0xc8, 0x1b, 0x70, 0x4e, 0xc6, 0xa1,
-	0x46, 0xd7, 0x5f, 0x14, 0xa2, 0xeb, 0x2f, 0x5a, 0x29, 0x18, 0xf6, 0x61, 0x93, 0x77, 0x01, 0x74,
-	0xc3, 0xa0, 0xbe, 0xbf, 0xe6, 0x9a, 0x6a, 0x3f, 0xf0, 0x3a, 0x53, 0x5f, 0x16, 0xc2, 0xd2, 0x27,
-	0x07, 0x33, 0x3f, 0x9d, 0x15, 0x5a, 0x9e, 0x6a, 0xe7, 0x88, 0x00, 0x63, 0x2c, 0xc9, 0x37, 0x00,
-	0x84, 0x0d, 0x20, 0xcc, 0x66, 0xf2, 0x21, 0x86, 0xb3, 0x59, 0x95, 0x2c, 0x6f, 0xf6, 0xad, 0x9e,
-	0xee, 0x04, 0x56, 0xb0, 0x2f, 0x92, 0x47, 0xdd, 0x0f, 0xb9, 0x60, 0x8c, 0xa3, 0xf6, 0x9b, 0x45,
-	0xa8, 0x29, 0xd7, 0xc3, 0x19, 0xd8, 0x82, 0xdb, 0x09, 0x5b, 0xf0, 0x88, 0x82, 0xc9, 0xb3, 0x2c,
-	0xc1, 0x6e, 0xca, 0x12, 0x7c, 0x2b, 0xbf, 0xa8, 0xa3, 0xed, 0xc0, 0xbf, 0x56, 0x84, 0x49, 0x85,
-	0x9a, 0xd7, 0x42, 0xfb, 0x65, 0x98, 0x12, 0x41, 0x20, 0x6b, 0xfa, 0x63, 0x91, 0x47, 0x8b, 0x37,
-	0x58, 0x59, 0xc4, 0x6f, 0x37, 0x93, 0x20, 0x4c, 0xe3, 0xb2, 0x6e, 0x2d, 0x8a, 0x36, 0xd9, 0x26,
-	0x4c, 0xb8, 0x8d, 0xc5, 0x7e, 0x93, 0x77, 0xeb, 0x66, 0x0a, 0x86, 0x7d, 0xd8, 0x69, 0x13, 0x71,
-	0xf9, 0x14, 0x4c, 0xc4, 0xbf, 0x5d, 0x80, 0xf1, 0xa8, 0xbd, 0x4e, 0xdd, 0x40, 0xbc, 0x9d, 0x34,
-	0x10, 0x2f, 0xe4, 0xee, 0x0e, 0x03, 0xcc, 0xc3, 0x7f, 0xbe, 0x0a, 0x89, 0x33, 0x0d, 0x64, 0x0b,
-	0xae, 0x5a, 0x99, 0x91, 0x99, 0xb1, 0xd9, 0x26, 0x4c, 0xba, 0xb0, 0x32, 0x10, 0x13, 0x8f, 0xe0,
-	0x42, 0x7a, 0x50, 0xdb, 0xa3, 0x5e, 0x60, 0x19, 0x54, 0x7d, 0xdf, 0xad, 0xdc, 0x2a, 0x99, 0x34,
-	0x82, 0x87, 0x6d, 0x7a, 0x5f, 0x0a, 0xc0, 0x50, 0x14, 0xd9, 0x82, 0x0a, 0x35, 0xdb, 0x54, 0x65,
-	0x36, 0xcb, 0x99, 0x69, 0x3a, 0x6c, 0x4f, 0xf6, 0xe6, 0xa3, 0x60, 0x4d, 0xfc, 0xb8, 0xa1, 0xa9,
-	0x9c, 0x53, 0xc1, 0x3a, 0xa6, 0x79, 0x89, 0xec, 0x86, 0xd6, 0xd6, 0xca, 0x88, 0x26, 0x8f, 0x23,
-	0x6c, 0xad, 0x3e, 0xd4, 0x1f, 0xe9, 0x01, 0xf5, 0x3a, 0xba, 0xb7, 0x2b, 0x77, 0x1b, 0xc3, 0x7f,
-	0xe1, 0x03, 0xc5, 0x29, 0xfa, 0xc2, 0xb0, 0x08, 0x23, 0x39, 0xc4, 0x85, 0x7a, 0x20, 0xd5, 0x67,
-	0x65, 0x52, 0x1e, 0x5e, 0xa8, 0x52, 0xc4, 0x7d, 0x79, 0xb6, 0x41, 0xbd, 0x62, 0x24, 0x83, 0xec,
-	0x25, 0xae, 0x40, 0x10, 0x17, 0x5f, 0xe4, 0xb8, 0xda, 0x46, 0xb1, 0x8a, 0x96, 0x9b, 0xec, 0xab,
-	0x14, 0xb4, 0xff, 0x51, 0x89, 0xa6, 0xe5, 0xb3, 0xb6, 0x13, 0x7e, 0x2e, 0x69, 0x27, 0xbc, 0x96,
-	0xb6, 0x13, 0xa6, 0x7c, 0xfe, 0x27, 0x8f, 0x86, 0x4e, 0x99, 0xd7, 0xca, 0xa7, 0x60, 0x5e, 0x7b,
-	0x19, 0x1a, 0x7b, 0x7c, 0x26, 0x10, 0x69, 0xd2, 0x2a, 0x7c, 0x19, 0xe1, 0x33, 0xfb, 0xfd, 0xa8,
-	0x18, 0xe3, 0x38, 0x8c, 0x44, 0x5e, 0x5b, 0x15, 0x66, 0x26, 0x97, 0x24, 0xad, 0xa8, 0x18, 0xe3,
-	0x38, 0x3c, 0x90, 0xd2, 0x72, 0x76, 0x05, 0x41, 0x95, 0x13, 0x88, 0x40, 0x4a, 0x55, 0x88, 0x11,
-	0x9c, 0xdc, 0x80, 0x5a, 0xcf, 0xdc, 0x16, 0xb8, 0x35, 0x8e, 0xcb, 0x35, 0xcc, 0xcd, 0xa5, 0x65,
-	0x99, 0xb6, 0x4d, 0x41, 0x59, 0x4d, 0x3a, 0x7a, 0x57, 0x01, 0xf8, 0xde, 0x50, 0xd6, 0x64, 0x2d,
-	0x2a, 0xc6, 0x38, 0x0e, 0xf9, 0x22, 0x4c, 0x7a, 0xd4, 0xec, 0x19, 0x34, 0xa4, 0x02, 0x4e, 0x25,
-	0xf3, 0xd9, 0xc6, 0x21, 0x98, 0xc2, 0x1c, 0x60, 0x24, 0x6c, 0x0c, 0x65, 0x24, 0xfc, 0x0a, 0x4c,
-	0x9a, 0x9e, 0x6e, 0x39, 0xd4, 0xbc, 0xe7, 0xf0, 0xc0, 0x0e, 0x19, 0xce, 0x19, 0x1a, 0xe8, 0x97,
-	0x12, 0x50, 0x4c, 0x61, 0x6b, 0xff, 0xb4, 0x08, 0x15, 0x91, 0x65, 0x77, 0x05, 0x2e, 0x58, 0x8e,
-	0x15, 0x58, 0xba, 0xbd, 0x44, 0x6d, 0x7d, 0x3f, 0x1e, 0xe0, 0x52, 0x69, 0x3e, 0xcb, 0x36, 0xda,
-	0x2b, 0xfd, 0x60, 0xcc, 0xa2, 0x61, 0x8d, 0x23, 0x2f, 0xbf, 0x50, 0x5c, 0x84, 0x1d, 0x4d, 0xa4,
-	0x78, 0x4f, 0x40, 0x30, 0x85, 0xc9, 0x94, 0xa1, 0x6e, 0x5f, 0xe4, 0x4a, 0x45, 0x28, 0x43, 0xc9,
-	0x60, 0x92, 0x24, 0x1e, 0x57, 0xd2, 0x7b, 0x5c, 0x21, 0x0e, 0x0f, 0x4d, 0xc9, 0x20, 0x38, 0xa1,
-	0xa4, 0xa7, 0x60, 0xd8, 0x87, 0xcd, 0x38, 0x6c, 0xeb, 0x96, 0xdd, 0xf3, 0x68, 0xc4, 0xa1, 0x12,
-	0x71, 0x58, 0x4e, 0xc1, 0xb0, 0x0f, 0x5b, 0xdb, 0x00, 0x58, 0xef, 0xd9, 0xbe, 0xce, 0x33, 0x2a,
-	0x8d, 0xec, 0x9e, 0x8f, 0xdf, 0x2f, 0xc2, 0xb8, 0x60, 0x2b, 0x37, 0xd2, 0xf3, 0x00, 0x32, 0x71,
-	0x93, 0x69, 0x7a, 0x52, 0x37, 0x88, 0x26, 0xb8, 0x10, 0x82, 0x31, 0xac, 0xe3, 0x85, 0x94, 0xbd,
-	0x06, 0xe3, 0x2a, 0x44, 0x8c, 0xab, 0x1d, 0xa9, 0xf0, 0xda, 0xc5, 0x18, 0x0c, 0x13, 0x98, 0x64,
-	0x89, 0xb5, 0xfe, 0x96, 0x48, 0x14, 0x60, 0xb9, 0x0e, 0xa7, 0x16, 0x19, 0x35, 0xc2, 0xa3, 0x95,
-	0xad, 0x14, 0x1c, 0xfb, 0x28, 0xc8, 0x67, 0xa1, 0xd6, 0xd1, 0x1f, 0x6f, 0x3a, 0xba, 0xb1, 0x2b,
-	0xa7, 0x90, 0x50, 0xaf, 0x58, 0x93, 0xe5, 0x18, 0x62, 0x10, 0x5d, 0xee, 0xc3, 0xc7, 0xf2, 0x1e,
-	0x3e, 0x0c, 0x7f, 0x59, 0xdf, 0x4e, 0xfc, 0xbf, 0x15, 0x80, 0xf4, 0x9f, 0xeb, 0x21, 0x3b, 0x30,
-	0xe6, 0x70, 0xe3, 0x72, 0xee, 0xab, 0x42, 0x62, 0x36, 0x6a, 0xb1, 0xea, 0xcb, 0x02, 0xc9, 0x9f,
-	0x38, 0x50, 0xa3, 0x8f, 0x03, 0xea, 0x39, 0xe1, 0x39, 0xbf, 0xd1, 0x5c, 0x4b, 0x22, 0x36, 0xdb,
-	0x92, 0x33, 0x86, 0x32, 0xb4, 0xdf, 0x2b, 0x42, 0x23, 0x86, 0xf7, 0x61, 0x36, 0x1b, 0x9e, 0x3a,
-	0x46, 0xd8, 0x74, 0x37, 0x3d, 0x5b, 0xf6, 0xad, 0x58, 0xea, 0x18, 0x09, 0xc2, 0x55, 0x8c, 0xe3,
-	0xb1, 0x0e, 0xdc, 0xd1, 0xfd, 0x20, 0xd1, 0xcb, 0xc2, 0x0e, 0xbc, 0x16, 0x42, 0x30, 0x86, 0x45,
-	0xae, 0xcb, 0x8b, 0x65, 0xca, 0xc9, 0x04, 0xbb, 0x03, 0x6e, 0x8d, 0xa9, 0x8c, 0xe0, 0xd6, 0x18,
-	0xd2, 0x86, 0x73, 0xaa, 0xd6, 0x0a, 0x7a, 0xb2, 0xf4, 0xab, 0x62, 0xe6, 0x49, 0xb1, 0xc0, 0x3e,
-	0xa6, 0xda, 0xf7, 0x0a, 0x30, 0x91, 0xb0, 0x28, 0x8a, 0xd4, 0xb8, 0xea, 0x54, 0x5a, 0x22, 0x35,
-	0x6e, 0xec, 0x30, 0xd9, 0x4b, 0x30, 0x26, 0x1a, 0x28, 0x1d, 0x6c, 0x2e, 0x9a, 0x10, 0x25, 0x94,
-	0xa9, 0x0a, 0xd2, 0x67, 0x91, 0x56, 0x15, 0xa4, 0x53, 0x03, 0x15, 0x5c, 0xb8, 0x02, 0x45, 0xed,
-	0x64, 0x4b, 0xc7, 0x5c, 0x81, 0xa2, 0x1c, 0x43, 0x0c, 0xed, 0xef, 0xf1, 0x7a, 0x07, 0xde, 0x7e,
-	0x68, 0x2a, 0x69, 0x43, 0x55, 0x06, 0x18, 0xcb, 0xa1, 0xf1, 0x46, 0x0e, 0x33, 0x27, 0xe7, 0x23,
-	0x43, 0x64, 0x75, 0x63, 0xf7, 0xde, 0xf6, 0x36, 0x2a, 0xee, 0xe4, 0x26, 0xd4, 0x5d, 0x47, 0x4e,
-	0xc9, 0xf2, 0xf3, 0x3f, 0xc3, 0x54, 0x81, 0x7b, 0xaa, 0xf0, 0xc9, 0xc1, 0xcc, 0xe5, 0xf0, 0x25,
-	0x51, 0x49, 0x8c, 0x28, 0xb5, 0x3f, 0x55, 0x80, 0x4b, 0xe8, 0xda, 0xb6, 0xe5, 0xb4, 0x93, 0xae,
-	0x6c, 0x62, 0xc3, 0xa4, 0x98, 0x69, 0xf6, 0x74, 0xcb, 0xd6, 0xb7, 0x6c, 0xfa, 0xa1, 0xa6, 0x8e,
-	0x5e, 0x60, 0xd9, 0xb3, 0xe2, 0x5a, 0xe2, 0xd9, 0x15, 0x27, 0xb8, 0xe7, 0xb5, 0x02, 0xcf, 0x72,
-	0xda, 0x62, 0xd9, 0x5b, 0x4b, 0xf0, 0xc2, 0x14, 0x6f, 0xed, 0xdf, 0x96, 0x81, 0x07, 0xaf, 0x92,
-	0xcf, 0x43, 0xbd, 0x43, 0x8d, 0x1d, 0xdd, 0xb1, 0x7c, 0x95, 0x64, 0xfc, 0x0a, 0xfb, 0xae, 0x35,
-	0x55, 0xf8, 0x84, 0xfd, 0x8a, 0x85, 0xd6, 0x2a, 0x3f, 0x47, 0x16, 0xe1, 0x12, 0x03, 0xc6, 0xda,
-	0xbe, 0xaf, 0x77, 0xad, 0xdc, 0x31, 0x43, 0x22, 0xa9, 0xb3, 0x98, 0x8e, 0xc4, 0x33, 0x4a, 0xd6,
-	0xc4, 0x80, 0x4a, 0xd7, 0xd6, 0x2d, 0x27, 0xf7, 0x35, 0x9a, 0xec, 0x0b, 0xd6, 0x19, 0x27, 0xb1,
-	0xde, 0xf1, 0x47, 0x14, 0xbc, 0x49, 0x0f, 0x1a, 0xbe, 0xe1, 0xe9, 0x1d, 0x7f, 0x47, 0x9f, 0x7f,
-	0xe5, 0xd5, 0xdc, 0xbb, 0xb9, 0x48, 0x94, 0x50, 0x2e, 0x17, 0x71, 0x61, 0xad, 0x75, 0x7b, 0x61,
-	0xfe, 0x95, 0x57, 0x31, 0x2e, 0x27, 0x2e, 0xf6, 0x95, 0x97, 0xe7, 0xf3, 0x5f, 0xab, 0x99, 0x2d,
-	0xf6, 0x95, 0x97, 0xe7, 0x31, 0x2e, 0x87, 0x35, 0xa9, 0x1b, 0x5b, 0xc6, 0xf2, 0x09, 0xbc, 0x17,
-	0xb9, 0x05, 0xf8, 0x23, 0x0a, 0xde, 0xda, 0xff, 0x2c, 0x40, 0x3d, 0x84, 0xb3, 0x89, 0x52, 0xa4,
-	0xab, 0x5c, 0x59, 0x3a, 0x99, 0x6e, 0xc2, 0x27, 0xca, 0x45, 0x49, 0x8a, 0x21, 0x13, 0xf2, 0x0e,
-	0x8c, 0x8b, 0x67, 0x99, 0x3e, 0xba, 0x78, 0xe2, 0x1c, 0xd5, 0x8b, 0x31, 0x72, 0x4c, 0x30, 0x23,
-	0x5f, 0x82, 0x09, 0xae, 0x07, 0xdd, 0x74, 0xcc, 0xae, 0x6b, 0xc9, 0xdb, 0x9e, 0x62, 0x99, 0xba,
-	0x36, 0xe2, 0x40, 0x4c, 0xe2, 0x86, 0x1f, 0xce, 0xff, 0x04, 0xd9, 0x04, 0x60, 0x2b, 0x85, 0xac,
-	0xe5, 0x89, 0x3e, 0x9d, 0x1b, 0x47, 0x37, 0x43, 0x62, 0x8c, 0x31, 0xca, 0xc8, 0x02, 0x5e, 0x1c,
-	0x75, 0x16, 0xf0, 0x39, 0xa8, 0xef, 0xe8, 0x8e, 0xe9, 0xef, 0xe8, 0xbb, 0x54, 0x9e, 0xa8, 0x08,
-	0x77, 0xee, 0xb7, 0x15, 0x00, 0x23, 0x1c, 0xed, 0x1f, 0x8c, 0x81, 0x08, 0xa3, 0x62, 0x53, 0xba,
-	0x69, 0xf9, 0xe2, 0xdc, 0x53, 0x81, 0x53, 0x86, 0x53, 0xfa, 0x92, 0x2c, 0xc7, 0x10, 0x83, 0x5c,
-	0x81, 0x52, 0xc7, 0x72, 0xa4, 0xc2, 0xce, 0xfd, 0x1e, 0x6b, 0x96, 0x83, 0xac, 0x8c, 0x83, 0xf4,
-	0xc7, 0x52, 0x21, 0x17, 0x20, 0xfd, 0x31, 0xb2, 0x32, 0xf2, 0x65, 0x98, 0xb2, 0x5d, 0x77, 0x97,
-	0x4d, 0xce, 0xf1, 0xc8, 0xf0, 0x09, 0x61, 0x89, 0x5c, 0x4d, 0x82, 0x30, 0x8d, 0x4b, 0x36, 0xe1,
-	0xd9, 0xf7, 0xa9, 0xe7, 0xca, 0xd5, 0xa8, 0x65, 0x53, 0xda, 0x55, 0x6c, 0x84, 0x1a, 0xc8, 0x03,
-	0xd7, 0xbf, 0x96, 0x8d, 0x82, 0x83, 0x68, 0xf9, 0x51, 0x1b, 0xdd, 0x6b, 0xd3, 0x60, 0xdd, 0x73,
-	0x99, 0xaa, 0x6f, 0x39, 0x6d, 0xc5, 0x76, 0x2c, 0x62, 0xbb, 0x91, 0x8d, 0x82, 0x83, 0x68, 0xc9,
-	0xdb, 0x30, 0x2d, 0x40, 0x42, 0x29, 0x5c, 0x10, 0x93, 0xb8, 0x65, 0xab, 0xbb, 0xbd, 0x27, 0x84,
-	0x7b, 0x79, 0x63, 0x00, 0x0e, 0x0e, 0xa4, 0x26, 0x6f, 0xc2, 0x39, 0x15, 0x5c, 0xb0, 0x4e, 0xbd,
-	0x56, 0x18, 0x5a, 0x37, 0xa1, 0x4e, 0x18, 0xa8, 0x08, 0x7b, 0x4c, 0x61, 0x61, 0x1f, 0x1d, 0x41,
-	0xb8, 0xcc, 0xe3, 0xe7, 0x36, 0xbb, 0x8b, 0xae, 0x6b, 0x9b, 0xee, 0x23, 0x47, 0x7d, 0xbb, 0xd8,
-	0xdf, 0xf2, 0x78, 0x82, 0x56, 0x26, 0x06, 0x0e, 0xa0, 0x64, 0x5f, 0xce, 0x21, 0x4b, 0xee, 0x23,
-	0x27, 0xcd, 0x15, 0xa2, 0x2f, 0x6f, 0x0d, 0xc0, 0xc1, 0x81, 0xd4, 0x64, 0x19, 0x48, 0xfa, 0x0b,
-	0x36, 0xbb, 0x32, 0xe2, 0xe5, 0xb2, 0xc8, 0x57, 0x97, 0x86, 0x62, 0x06, 0x05, 0x59, 0x85, 0x8b,
-	0xe9, 0x52, 0x26, 0x4e, 0x06, 0xbf, 0xf0, 0x4c, 0xf5, 0x98, 0x01, 0xc7, 0x4c, 0x2a, 0xad, 0x01,
-	0xf5, 0xf0, 0x76, 0x64, 0xed, 0xdf, 0x14, 0x61, 0x2a, 0x95, 0xf3, 0xeb, 0x0c, 0x3c, 0x21, 0x4e,
-	0xc2, 0x13, 0xb2, 0x9a, 0xeb, 0x96, 0xe7, 0x58, 0xcd, 0x07, 0x3a, 0x44, 0xf6, 0x52, 0x0e, 0x91,
-	0xbb, 0x23, 0x93, 0x78, 0xb4, 0x5f, 0xe4, 0xb0, 0x00, 0x17, 0x52, 0x14, 0x67, 0x60, 0xee, 0xef,
-	0x24, 0xcd, 0xfd, 0xb7, 0x47, 0xf5, 0xb1, 0x03, 0xac, 0xfe, 0xff, 0xa7, 0xff, 0x23, 0x5b, 0xc2,
-	0x0b, 0x55, 0x95, 0xe9, 0x95, 0x72, 0x6f, 0x28, 0x55, 0xfe, 0x26, 0xf6, 0x7f, 0x93, 0xe9, 0x6a,
-	0x9c, 0x36, 0x2a, 0x29, 0xc4, 0x87, 0x9a, 0xca, 0xa1, 0x34, 0x5a, 0x1f, 0x5b, 0xd8, 0xd8, 0x61,
-	0x5a, 0xbc, 0x50, 0x90, 0xf6, 0x97, 0x4a, 0x70, 0x29, 0xb3, 0x53, 0x9c, 0x9d, 0xa9, 0xf5, 0x4b,
-	0x49, 0x53, 0xeb, 0x8b, 0x69, 0x53, 0xeb, 0xc5, 0x54, 0xfd, 0x9e, 0x62, 0x8b, 0xeb, 0x08, 0xad,
-	0x88, 0xda, 0x14, 0x4c, 0x24, 0xf2, 0x7e, 0x69, 0xbf, 0x5b, 0x81, 0x46, 0xac, 0x27, 0x3d, 0x75,
-	0xf9, 0x96, 0xc8, 0x17, 0x61, 0xb2, 0xe3, 0xb7, 0x57, 0x96, 0x6e, 0x53, 0xdd, 0xa4, 0x9e, 0x3a,
-	0x66, 0x5a, 0x97, 0x7b, 0xad, 0x04, 0x04, 0x53, 0x98, 0x64, 0x15, 0x2e, 0x79, 0xf4, 0x61, 0x8f,
-	0xfa, 0x41, 0xd2, 0x16, 0x29, 0x55, 0x16, 0xb9, 0xdc, 0xa4, 0x10, 0x7c, 0xcc, 0x26, 0x62, 0x53,
-	0x88, 0x88, 0x4d, 0xa8, 0xe4, 0x1c, 0x47, 0xaa, 0xbd, 0x79, 0x80, 0x82, 0xc8, 0xce, 0x14, 0x2b,
-	0x41, 0x21, 0x65, 0xc0, 0xd1, 0x85, 0xb1, 0x8f, 0xf0, 0xe8, 0x42, 0x3c, 0x5e, 0xb2, 0x7a, 0x64,
-	0xbc, 0xe4, 0x53, 0x1d, 0x1e, 0xa6, 0x7d, 0x0b, 0x12, 0x0d, 0x4e, 0x5c, 0xa8, 0x87, 0x1f, 0x9b,
-	0x3b, 0x66, 0x2b, 0x3a, 0x3e, 0xc0, 0x1d, 0x16, 0xe1, 0x2b, 0x46, 0x32, 0xb4, 0x6d, 0x36, 0x0a,
-	0x7d, 0xdf, 0x72, 0x9d, 0xd3, 0xbd, 0x90, 0xfa, 0x5f, 0x15, 0xa1, 0x1e, 0xba, 0xc1, 0x8e, 0x71,
-	0x71, 0x55, 0xa2, 0x21, 0x8a, 0xa7, 0xdf, 0x10, 0xf1, 0xc3, 0x30, 0xa5, 0x1c, 0x87, 0x61, 0xba,
-	0x50, 0x0d, 0x3c, 0xab, 0xdd, 0x96, 0x46, 0xc3, 0x3c, 0xa7, 0x61, 0xc2, 0xe6, 0xda, 0x10, 0x0c,
-	0x65, 0xcb, 0x8a, 0x17, 0x54, 0x62, 0xb4, 0xf7, 0xe0, 0x5c, 0x1a, 0x93, 0x5b, 0xd4, 0x8c, 0x1d,
-	0x6a, 0xf6, 0x6c, 0xd5, 0xc6, 0x91, 0x45, 0x4d, 0x96, 0x63, 0x88, 0xc1, 0x06, 0x13, 0xfb, 0x4d,
-	0xef, 0xbb, 0x8e, 0x5a, 0xa3, 0xf8, 0x60, 0xda, 0x90, 0x65, 0x18, 0x42, 0xb5, 0xff, 0x5c, 0x82,
-	0x2b, 0x91, 0x33, 0x73, 0x4d, 0x77, 0xf4, 0x76, 0x32, 0x50, 0xf5, 0x93, 0xac, 0x0c, 0x23, 0xb9,
-	0x7d, 0xb0, 0xf4, 0x14, 0xdc, 0x3e, 0xf8, 0xff, 0x8a, 0xc0, 0x0f, 0xd7, 0x91, 0x6f, 0xc1, 0xb8,
-	0x6a, 0x4f, 0xf6, 0x2e, 0x7f, 0xe7, 0xcd, 0xdc, 0xbf, 0x93, 0x9f, 0xe1, 0x0b, 0xfd, 0x3c, 0xf1,
-	0x52, 0x4c, 0x08, 0x24, 0x2e, 0xd4, 0xb6, 0x75, 0xdb, 0x66, 0x9b, 0xf7, 0xdc, 0x8a, 0x63, 0x42,
-	0x38, 0xef, 0xe6, 0xcb, 0x92, 0x35, 0x86, 0x42, 0xc8, 0x77, 0x0a, 0x30, 0xe1, 0xc5, 0xad, 0xb7,
-	0xf2, 0x87, 0xe4, 0x09, 0xdd, 0x8d, 0x71, 0x8b, 0x1f, 0xa7, 0x88, 0x9b, 0x88, 0x93, 0x32, 0xb5,
-	0xff, 0x54, 0x80, 0x89, 0x96, 0x6d, 0x99, 0x96, 0xd3, 0x3e, 0xc5, 0xcb, 0x0f, 0xef, 0x41, 0xc5,
-	0xb7, 0x2d, 0x93, 0x0e, 0x79, 0xd6, 0x56, 0x5c, 0xcd, 0xcf, 0x18, 0xa0, 0xe0, 0x93, 0xbc, 0x4d,
-	0xb1, 0x74, 0x8c, 0xdb, 0x14, 0x7f, 0xb3, 0x06, 0xf2, 0x98, 0x28, 0xe9, 0x41, 0xbd, 0xad, 0x2e,
-	0x69, 0x93, 0xdf, 0x78, 0x3b, 0x47, 0x82, 0xff, 0xc4, 0x75, 0x6f, 0x62, 0xee, 0x0f, 0x0b, 0x31,
-	0x92, 0x44, 0x28, 0x54, 0x78, 0x32, 0x86, 0xdc, 0xde, 0xae, 0x58, 0xda, 0x0d, 0xd1, 0x32, 0xbc,
-	0x00, 0x05, 0x77, 0xa2, 0x43, 0x79, 0x27, 0x08, 0xba, 0xb2, 0x33, 0x0d, 0xef, 0x3b, 0x8c, 0x72,
-	0x92, 0x0a, 0x6d, 0x96, 0xbd, 0x23, 0x67, 0xcd, 0x44, 0x38, 0x7a, 0x78, 0x93, 0xfc, 0x62, 0xae,
-	0x30, 0xe1, 0xb8, 0x08, 0xf6, 0x8e, 0x9c, 0x35, 0xf9, 0x39, 0x68, 0x04, 0x9e, 0xee, 0xf8, 0xdb,
-	0xae, 0xd7, 0xa1, 0x9e, 0xd4, 0x40, 0x87, 0x1f, 0x19, 0x9b, 0x4b, 0x1b, 0x11, 0x37, 0xe1, 0x72,
-	0x4f, 0x14, 0x61, 0x5c, 0x1a, 0xd9, 0x85, 0x5a, 0xcf, 0x14, 0x15, 0x93, 0xfa, 0xe7, 0x42, 0x0e,
-	0xc9, 0xf1, 0x20, 0x60, 0xf5, 0x86, 0xa1, 0x00, 0xd6, 0x1b, 0xa3, 0xbc, 0x89, 0xd5, 0x9c, 0xbd,
-	0x31, 0x95, 0xd3, 0x69, 0x70, 0xc2, 0x44, 0xd2, 0x89, 0x36, 0xe6, 0xb5, 0x9c, 0x8d, 0x9b, 0xd8,
-	0x60, 0xc9, 0x2c, 0xb7, 0xe9, 0x6d, 0xb9, 0x05, 0x63, 0x5d, 0xee, 0x8c, 0x96, 0xe7, 0x13, 0x6e,
-	0xe6, 0xf4, 0x69, 0xc7, 0x4f, 0x7f, 0x8b, 0x12, 0x94, 0x02, 0xc8, 0xd7, 0xa1, 0xe4, 0x3f, 0xf4,
-	0xe5, 0x25, 0xe6, 0x39, 0x9c, 0x0e, 0x0f, 0x55, 0xdf, 0xe4, 0x06, 0xe1, 0xd6, 0x43, 0x1f, 0x19,
-	0x5f, 0xed, 0x1f, 0x16, 0xa0, 0xca, 0x60, 0x6c, 0xcd, 0x98, 0x83, 0xba, 0xfe, 0xc8, 0x47, 0xda,
-	0x8e, 0x4e, 0x5f, 0x85, 0xb3, 0xd0, 0xc2, 0x83, 0x96, 0x00, 0x60, 0x84, 0xc3, 0x08, 0x78, 0x08,
-	0x3f, 0xf7, 0x0e, 0x17, 0x93, 0x04, 0x6f, 0x29, 0x00, 0x46, 0x38, 0xe4, 0x3e, 0x5c, 0xe6, 0x2f,
-	0xf7, 0x1e, 0x39, 0xd4, 0x5b, 0x78, 0xd0, 0x5a, 0x30, 0x0c, 0xb7, 0xc7, 0xdd, 0x1b, 0xa5, 0x44,
-	0x48, 0xd5, 0xe5, 0xb7, 0x32, 0xb1, 0x70, 0x00, 0xb5, 0xf6, 0xdb, 0x65, 0xa8, 0x87, 0x5f, 0xf8,
-	0xf1, 0xfd, 0x0e, 0xb2, 0x08, 0xe7, 0xf7, 0x2c, 0xdf, 0x12, 0x56, 0xe6, 0x78, 0xb4, 0x6e, 0x45,
-	0xa8, 0x48, 0xf7, 0xd3, 0x40, 0xec, 0xc7, 0x27, 0x2b, 0x70, 0xa1, 0xa3, 0x3f, 0xbe, 0xdb, 0xeb,
-	0x6c, 0x51, 0xef, 0xde, 0xb6, 0x34, 0x79, 0xf8, 0x32, 0x42, 0x86, 0x07, 0x08, 0xad, 0xf5, 0x83,
-	0x31, 0x8b, 0x86, 0x7c, 0x19, 0xa6, 0x1e, 0xe9, 0x16, 0xdf, 0x49, 0xc7, 0x0d, 0xf2, 0x15, 0xe1,
-	0x2e, 0x78, 0x90, 0x04, 0x61, 0x1a, 0x97, 0xbc, 0x0c, 0x0d, 0x2a, 0x1d, 0x3c, 0x9b, 0x9e, 0xad,
-	0x4e, 0x97, 0x1e, 0x1e, 0xcc, 0x34, 0x94, 0xdf, 0x87, 0x47, 0x1c, 0xc4, 0x70, 0xc8, 0x17, 0x61,
-	0x52, 0x0f, 0x02, 0xcf, 0xda, 0xea, 0x05, 0xbc, 0xa9, 0x45, 0x6c, 0xa1, 0xb4, 0x17, 0x2c, 0x24,
-	0x20, 0x98, 0xc2, 0x24, 0xf7, 0xe0, 0x92, 0xb4, 0xeb, 0x24, 0x11, 0x65, 0x2a, 0x3f, 0xae, 0xce,
-	0xad, 0x65, 0x21, 0x60, 0x36, 0x9d, 0xd6, 0x01, 0x69, 0x97, 0x22, 0x46, 0xe2, 0x3e, 0x68, 0x91,
-	0xe0, 0x66, 0xee, 0x78, 0xcb, 0x7e, 0x78, 0x31, 0x71, 0xec, 0x3e, 0xba, 0xcc, 0x8b, 0x9f, 0xb5,
-	0x7f, 0x5d, 0x84, 0xd2, 0xc6, 0x6a, 0x4b, 0xdc, 0x31, 0xc3, 0x6f, 0x98, 0xa7, 0xad, 0x5d, 0xab,
-	0x7b, 0x9f, 0x7a, 0xd6, 0xf6, 0xbe, 0x74, 0x09, 0xc5, 0xee, 0x98, 0x49, 0x63, 0x60, 0x06, 0x15,
-	0xf7, 0xf8, 0xe9, 0x8b, 0xd4, 0xcb, 0xe1, 0xf1, 0x5b, 0x88, 0xc8, 0x31, 0xc1, 0x8c, 0x6c, 0x02,
-	0x18, 0x11, 0xeb, 0xd2, 0x89, 0xdd, 0x74, 0x31, 0xc6, 0x31, 0x46, 0x04, 0xa1, 0xbe, 0xcb, 0x50,
-	0x39, 0xd7, 0xf2, 0x49, 0xb8, 0xf2, 0x05, 0xe2, 0x8e, 0xa2, 0xc5, 0x88, 0x8d, 0xe6, 0xc0, 0x44,
-	0xe2, 0x92, 0x68, 0xf2, 0x05, 0xa8, 0xb9, 0xdd, 0x98, 0xd6, 0x54, 0xe7, 0x87, 0x22, 0x6b, 0xf7,
-	0x64, 0xd9, 0x93, 0x83, 0x99, 0x89, 0x55, 0xb7, 0x6d, 0x19, 0xaa, 0x00, 0x43, 0x74, 0xa2, 0xc1,
-	0x18, 0x4f, 0xbf, 0xa3, 0xae, 0x88, 0xe6, 0xd3, 0x36, 0xbf, 0xc5, 0xd5, 0x47, 0x09, 0xd1, 0x7e,
-	0xbe, 0x0c, 0x51, 0xe0, 0x2c, 0xf1, 0x61, 0x4c, 0x1c, 0xfd, 0x97, 0x0a, 0xda, 0xa9, 0x66, 0x19,
-	0x90, 0xa2, 0x48, 0x1b, 0x4a, 0xef, 0xb9, 0x5b, 0xb9, 0xf5, 0xb3, 0x58, 0x0e, 0x41, 0x31, 0x76,
-	0x63, 0x05, 0xc8, 0x24, 0x90, 0xbf, 0x5a, 0x80, 0xf3, 0x7e, 0x7a, 0x87, 0x2b, 0xbb, 0x03, 0xe6,
-	0xdf, 0xca, 0xa7, 0xf7, 0xcc, 0xf2, 0xf4, 0xea, 0x20, 0x30, 0xf6, 0xd7, 0x85, 0xb5, 0xbf, 0x88,
-	0x68, 0x95, 0xdd, 0x69, 0xf8, 0xf6, 0x17, 0x51, 0xb2, 0xc9, 0xf6, 0x4f, 0x96, 0xa1, 0x14, 0xa5,
-	0x7d, 0xbb, 0x08, 0x8d, 0x98, 0x52, 0x96, 0xfb, 0xe6, 0xf1, 0xc7, 0xa9, 0x9b, 0xc7, 0xd7, 0x87,
-	0x0f, 0xf0, 0x8e, 0x6a, 0x75, 0xda, 0x97, 0x8f, 0xff, 0xe3, 0x22, 0x94, 0x36, 0x97, 0x96, 0xcf,
-	0xdc, 0x48, 0x47, 0x76, 0xa0, 0xba, 0xd5, 0xb3, 0xec, 0xc0, 0x72, 0x72, 0x67, 0x39, 0x55, 0x17,
-	0xb5, 0xcb, 0x08, 0x27, 0xc1, 0x15, 0x15, 0x7b, 0xd2, 0x86, 0x6a, 0x5b, 0x5c, 0x33, 0x91, 0xfb,
-	0xd8, 0x9b, 0xbc, 0xae, 0x42, 0x08, 0x92, 0x2f, 0xa8, 0xb8, 0x6b, 0xfb, 0x30, 0xb6, 0xb9, 0x24,
-	0x77, 0xf7, 0x67, 0x6c, 0xf2, 0xfc, 0x39, 0x08, 0x95, 0xfd, 0xb3, 0x17, 0xfe, 0x5f, 0x0b, 0x90,
-	0xdc, 0xdf, 0x9c, 0x7d, 0x6f, 0xda, 0x4d, 0xf7, 0xa6, 0xa5, 0x51, 0x0c, 0xbe, 0xec, 0x0e, 0xa5,
-	0xfd, 0xcb, 0x02, 0xa4, 0xf2, 0xb5, 0x90, 0x57, 0x65, 0xc6, 0xf2, 0xe4, 0xf9, 0x22, 0x95, 0xb1,
-	0x9c, 0x24, 0xb1, 0x63, 0x99, 0xcb, 0x3f, 0x28, 0xc0, 0x84, 0x17, 0x0f, 0x9b, 0x93, 0xd5, 0x1f,
-	0xde, 0x5b, 0x9c, 0x19, 0x84, 0x27, 0xcf, 0xc0, 0xc5, 0x41, 0x98, 0x94, 0xab, 0xfd, 0xfd, 0x22,
-	0x8c, 0x9d, 0x59, 0x8a, 0x3a, 0x9a, 0x70, 0xc6, 0x2f, 0xe6, 0x9c, 0xed, 0x07, 0xfa, 0xe0, 0x3b,
-	0x29, 0x1f, 0xfc, 0xcd, 0xbc, 0x82, 0x8e, 0x76, 0xbd, 0xff, 0xf3, 0x02, 0xc8, 0xb5, 0x66, 0xc5,
-	0xf1, 0x03, 0xdd, 0x31, 0x28, 0x31, 0xc2, 0x85, 0x2d, 0xaf, 0x43, 0x56, 0x9e, 0x0f, 0x13, 0xba,
-	0x0c, 0x7f, 0x56, 0x0b, 0x19, 0xf9, 0x2c, 0xd4, 0x76, 0x5c, 0x3f, 0x70, 0xa2, 0xdd, 0x51, 0x68,
-	0x19, 0xbf, 0x2d, 0xcb, 0x31, 0xc4, 0x48, 0x07, 0xb1, 0x56, 0x06, 0x07, 0xb1, 0x6a, 0x5f, 0x83,
-	0xa9, 0x74, 0x9e, 0xbd, 0x5b, 0x99, 0x79, 0xf6, 0x5e, 0x18, 0x90, 0x67, 0xaf, 0x31, 0x38, 0xc7,
-	0xde, 0xaf, 0x16, 0x61, 0xfc, 0xe3, 0x92, 0x5f, 0x2f, 0xeb, 0x80, 0x68, 0x29, 0xe7, 0x01, 0xd1,
-	0xf2, 0x49, 0x0e, 0x88, 0x6a, 0x3f, 0x28, 0x00, 0x9c, 0x59, 0x72, 0x3f, 0x33, 0x19, 0xcc, 0x91,
-	0xbb, 0xcf, 0x66, 0xc7, 0x70, 0xfc, 0xed, 0x31, 0xf5, 0x49, 0xdc, 0x33, 0xfe, 0x41, 0x01, 0x26,
-	0xf5, 0xc4, 0x59, 0xc8, 0xdc, 0xba, 0x78, 0xea, 0x68, 0x65, 0x78, 0x94, 0x27, 0x59, 0x8e, 0x29,
-	0xb1, 0xe4, 0xb5, 0xe8, 0x22, 0xae, 0x98, 0xc1, 0xa1, 0xef, 0x06, 0x2d, 0x71, 0x78, 0x23, 0x8e,
-	0xf9, 0x21, 0x67, 0x4f, 0x4b, 0x23, 0x39, 0x7b, 0x1a, 0xf7, 0x12, 0x97, 0x8f, 0xf4, 0x12, 0xef,
-	0x41, 0x7d, 0xdb, 0x73, 0x3b, 0xfc, 0x78, 0xe7, 0x74, 0x85, 0xff, 0xca, 0x9b, 0x39, 0x16, 0xe1,
-	0xce, 0x96, 0xe5, 0x50, 0x93, 0x1f, 0x1d, 0x0d, 0x8d, 0x2f, 0xcb, 0x8a, 0x3f, 0x46, 0xa2, 0xb8,
-	0xbb, 0xd0, 0x15, 0x52, 0xc7, 0x46, 0x29, 0x35, 0x9c, 0xa7, 0x36, 0x04, 0x77, 0x54, 0x62, 0x92,
-	0x47, 0x3a, 0xab, 0x67, 0x74, 0xa4, 0x73, 0x3f, 0x7e, 0x52, 0xb6, 0x96, 0xd3, 0x92, 0x7a, 0xb2,
-	0x74, 0x6c, 0x7f, 0xae, 0xaa, 0xe6, 0xce, 0xa7, 0xee, 0xba, 0x99, 0x4f, 0xd2, 0xb0, 0xb5, 0x69,
-	0x5f, 0x8e, 0xb4, 0xda, 0x19, 0xe6, 0x48, 0xab, 0x8f, 0x26, 0x47, 0x1a, 0xe4, 0xcb, 0x91, 0xd6,
-	0x18, 0x51, 0x8e, 0xb4, 0xf1, 0x51, 0xe5, 0x48, 0x9b, 0x18, 0x2a, 0x47, 0xda, 0xe4, 0xb1, 0x72,
-	0xa4, 0x1d, 0x94, 0x20, 0x65, 0x63, 0xf8, 0x24, 0x6c, 0xe0, 0xc7, 0x2a, 0x6c, 0xe0, 0xbb, 0x45,
-	0x88, 0xd6, 0x80, 0x13, 0x9e, 0x03, 0x78, 0x9b, 0x1f, 0xc5, 0xe4, 0xc7, 0x7a, 0x87, 0x54, 0x4d,
-	0xc7, 0xe5, 0xb1, 0x4d, 0xce, 0x03, 0x43, 0x6e, 0xc4, 0x07, 0xb0, 0xc2, 0x9b, 0x12, 0x73, 0x3b,
-	0x60, 0xa3, 0x4b, 0x17, 0x85, 0xed, 0x37, 0x7a, 0xc7, 0x98, 0x18, 0xed, 0xb7, 0x4a, 0x20, 0xaf,
-	0xd4, 0x24, 0x14, 0x2a, 0xdb, 0xd6, 0x63, 0x6a, 0xe6, 0x0e, 0xb5, 0x5d, 0x66, 0x5c, 0xe4, 0xbd,
-	0x9d, 0xdc, 0xc3, 0xcc, 0x0b, 0x50, 0x70, 0xe7, 0xae, 0x43, 0x11, 0x31, 0x20, 0xdb, 0x2f, 0x87,
-	0xeb, 0x30, 0x1e, 0x79, 0x20, 0x5d, 0x87, 0xa2, 0x08, 0x95, 0x0c, 0xe1, 0xa9, 0xe4, 0xc1, 0x63,
-	0xb9, 0x03, 0x24, 0x12, 0x41, 0x68, 0xca, 0x53, 0xe9, 0x8b, 0x24, 0x89, 0x52, 0x06, 0xf9, 0x26,
-	0x34, 0x74, 0xc3, 0xe8, 0x75, 0x7a, 0x36, 0xb7, 0x74, 0xe7, 0x4d, 0x25, 0xb8, 0x10, 0xf1, 0x92,
-	0x62, 0xf9, 0xc6, 0x26, 0x56, 0x8c, 0x71, 0x79, 0xcd, 0xaf, 0x7f, 0xff, 0x47, 0xd7, 0x9e, 0xf9,
-	0xc1, 0x8f, 0xae, 0x3d, 0xf3, 0xc3, 0x1f, 0x5d, 0x7b, 0xe6, 0xe7, 0x0f, 0xaf, 0x15, 0xbe, 0x7f,
-	0x78, 0xad, 0xf0, 0x83, 0xc3, 0x6b, 0x85, 0x1f, 0x1e, 0x5e, 0x2b, 0xfc, 0xfb, 0xc3, 0x6b, 0x85,
-	0xbf, 0xf8, 0x1f, 0xae, 0x3d, 0xf3, 0xb5, 0xcf, 0x47, 0xd5, 0x99, 0x53, 0xd5, 0x99, 0x53, 0xc2,
-	0xe7, 0xba, 0xbb, 0xed, 0x39, 0x56, 0x9d, 0xa8, 0x44, 0x55, 0xe7, 0xff, 0x07, 0x00, 0x00, 0xff,
-	0xff, 0x72, 0x2d, 0x30, 0x6e, 0x24, 0xb2, 0x00, 0x00,
+	// 11082 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x6b, 0x6c, 0x24, 0xc9,
+	0x79, 0x98, 0xe6, 0xc9, 0x99, 0x8f, 0xaf, 0xdd, 0xda, 0x7b, 0xcc, 0xad, 0xee, 0x96, 0xeb, 0x96,
+	0x25, 0x9f, 0x12, 0x8b, 0x9b, 0xdb, 0xd3, 0x49, 0xa7, 0x93, 0xf5, 0xe0, 0x90, 0xcb, 0x3d, 0xde,
+	0x92, 0x4b, 0xea, 0x1b, 0x72, 0x57, 0xd2, 0x45, 0xba, 0x34, 0x67, 0x8a, 0xc3, 0x3e, 0xf6, 0x74,
+	0xcf, 0x76, 0xf7, 0x70, 0x97, 0x67, 0x0b, 0xb2, 0xa5, 0x24, 0x52, 0x1e, 0x80, 0x83, 0x04, 0x81,
+	0x0c, 0x04, 0xb1, 0xe1, 0x20, 0xb1, 0x61, 0x18, 0x32, 0x92, 0x20, 0xca, 0x8f, 0x00, 0x4a, 0xe2,
+	0x00, 0x71, 0x14, 0xe7, 0x25, 0x04, 0x46, 0xa2, 0x3c, 0x40, 0x44, 0x0c, 0xf2, 0x23, 0x0e, 0x1c,
+	0xd8, 0x01, 0x92, 0x00, 0xeb, 0x20, 0x0e, 0xea, 0xd1, 0xd5, 0x55, 0x3d, 0x3d, 0x7b, 0xe4, 0xf4,
+	0xec, 0xde, 0x9e, 0x73, 0xbf, 0xc8, 0xf9, 0xbe, 0xaf, 0xbe, 0xaf, 0xba, 0xba, 0xba, 0xea, 0xab,
+	0xef, 0x55, 0x70, 0xbd, 0xeb, 0x44, 0xfb, 0x83, 0xdd, 0xc5, 0xb6, 0xdf, 0xbb, 0xe2, 0x0d, 0x7a,
+	0x76, 0x3f, 0xf0, 0xdf, 0xe4, 0xff, 0xec, 0xb9, 0xfe, 0xdd, 0x2b, 0xfd, 0x83, 0xee, 0x15, 0xbb,
+	0xef, 0x84, 0x09, 0xe4, 0xf0, 0x05, 0xdb, 0xed, 0xef, 0xdb, 0x2f, 0x5c, 0xe9, 0x52, 0x8f, 0x06,
+	0x76, 0x44, 0x3b, 0x8b, 0xfd, 0xc0, 0x8f, 0x7c, 0xf2, 0xf1, 0x84, 0xd1, 0x62, 0xcc, 0x68, 0x31,
+	0x6e, 0xb6, 0xd8, 0x3f, 0xe8, 0x2e, 0x32, 0x46, 0x09, 0x24, 0x66, 0x74, 0xf1, 0x23, 0x5a, 0x0f,
+	0xba, 0x7e, 0xd7, 0xbf, 0xc2, 0xf9, 0xed, 0x0e, 0xf6, 0xf8, 0x2f, 0xfe, 0x83, 0xff, 0x27, 0xe4,
+	0x5c, 0xb4, 0x0e, 0x5e, 0x0e, 0x17, 0x1d, 0x9f, 0x75, 0xeb, 0x4a, 0xdb, 0x0f, 0xe8, 0x95, 0xc3,
+	0xa1, 0xbe, 0x5c, 0xfc, 0x68, 0x42, 0xd3, 0xb3, 0xdb, 0xfb, 0x8e, 0x47, 0x83, 0xa3, 0xf8, 0x59,
+	0xae, 0x04, 0x34, 0xf4, 0x07, 0x41, 0x9b, 0x9e, 0xa9, 0x55, 0x78, 0xa5, 0x47, 0x23, 0x3b, 0x4b,
+	0xd6, 0x95, 0x51, 0xad, 0x82, 0x81, 0x17, 0x39, 0xbd, 0x61, 0x31, 0x1f, 0x7b, 0xbb, 0x06, 0x61,
+	0x7b, 0x9f, 0xf6, 0xec, 0xa1, 0x76, 0x2f, 0x8e, 0x6a, 0x37, 0x88, 0x1c, 0xf7, 0x8a, 0xe3, 0x45,
+	0x61, 0x14, 0xa4, 0x1b, 0x59, 0xbf, 0x0e, 0x70, 0x61, 0x69, 0x37, 0x8c, 0x02, 0xbb, 0x1d, 0x6d,
+	0xf9, 0x9d, 0x6d, 0xda, 0xeb, 0xbb, 0x76, 0x44, 0xc9, 0x01, 0xd4, 0xd8, 0x03, 0x75, 0xec, 0xc8,
+	0x6e, 0x14, 0x2e, 0x17, 0x9e, 0x9f, 0xbe, 0xba, 0xb4, 0x38, 0xe6, 0x0b, 0x5c, 0xdc, 0x90, 0x8c,
+	0x9a, 0x33, 0x27, 0xc7, 0x0b, 0xb5, 0xf8, 0x17, 0x2a, 0x01, 0xe4, 0xe7, 0x0a, 0x30, 0xe3, 0xf9,
+	0x1d, 0xda, 0xa2, 0x2e, 0x6d, 0x47, 0x7e, 0xd0, 0x28, 0x5e, 0x2e, 0x3d, 0x3f, 0x7d, 0xf5, 0xcb,
+	0x63, 0x4b, 0xcc, 0x78, 0xa2, 0xc5, 0x9b, 0x9a, 0x80, 0x6b, 0x5e, 0x14, 0x1c, 0x35, 0x9f, 0xf8,
+	0xde, 0xf1, 0xc2, 0xfb, 0x4e, 0x8e, 0x17, 0x66, 0x74, 0x14, 0x1a, 0x3d, 0x21, 0x3b, 0x30, 0x1d,
+	0xf9, 0x2e, 0x1b, 0x32, 0xc7, 0xf7, 0xc2, 0x46, 0x89, 0x77, 0xec, 0xd2, 0xa2, 0x18, 0x6a, 0x26,
+	0x7e, 0x91, 0xcd, 0xb1, 0xc5, 0xc3, 0x17, 0x16, 0xb7, 0x15, 0x59, 0xf3, 0x82, 0x64, 0x3c, 0x9d,
+	0xc0, 0x42, 0xd4, 0xf9, 0x10, 0x0a, 0xf3, 0x21, 0x6d, 0x0f, 0x02, 0x27, 0x3a, 0x5a, 0xf6, 0xbd,
+	0x88, 0xde, 0x8b, 0x1a, 0x65, 0x3e, 0xca, 0x1f, 0xca, 0x62, 0xbd, 0xe5, 0x77, 0x5a, 0x26, 0x75,
+	0xf3, 0xc2, 0xc9, 0xf1, 0xc2, 0x7c, 0x0a, 0x88, 0x69, 0x9e, 0xc4, 0x83, 0x73, 0x4e, 0xcf, 0xee,
+	0xd2, 0xad, 0x81, 0xeb, 0xb6, 0x68, 0x3b, 0xa0, 0x51, 0xd8, 0xa8, 0xf0, 0x47, 0x78, 0x3e, 0x4b,
+	0xce, 0xba, 0xdf, 0xb6, 0xdd, 0xcd, 0xdd, 0x37, 0x69, 0x3b, 0x42, 0xba, 0x47, 0x03, 0xea, 0xb5,
+	0x69, 0xb3, 0x21, 0x1f, 0xe6, 0xdc, 0x5a, 0x8a, 0x13, 0x0e, 0xf1, 0x26, 0xd7, 0xe1, 0x7c, 0x3f,
+	0x70, 0x7c, 0xde, 0x05, 0xd7, 0x0e, 0xc3, 0x9b, 0x76, 0x8f, 0x36, 0xaa, 0x97, 0x0b, 0xcf, 0xd7,
+	0x9b, 0xcf, 0x48, 0x36, 0xe7, 0xb7, 0xd2, 0x04, 0x38, 0xdc, 0x86, 0x3c, 0x0f, 0xb5, 0x18, 0xd8,
+	0x98, 0xba, 0x5c, 0x78, 0xbe, 0x22, 0xe6, 0x4e, 0xdc, 0x16, 0x15, 0x96, 0xac, 0x42, 0xcd, 0xde,
+	0xdb, 0x73, 0x3c, 0x46, 0x59, 0xe3, 0x43, 0xf8, 0x6c, 0xd6, 0xa3, 0x2d, 0x49, 0x1a, 0xc1, 0x27,
+	0xfe, 0x85, 0xaa, 0x2d, 0x79, 0x0d, 0x48, 0x48, 0x83, 0x43, 0xa7, 0x4d, 0x97, 0xda, 0x6d, 0x7f,
+	0xe0, 0x45, 0xbc, 0xef, 0x75, 0xde, 0xf7, 0x8b, 0xb2, 0xef, 0xa4, 0x35, 0x44, 0x81, 0x19, 0xad,
+	0xc8, 0x67, 0xe1, 0x9c, 0xfc, 0x56, 0x93, 0x51, 0x00, 0xce, 0xe9, 0x09, 0x36, 0x90, 0x98, 0xc2,
+	0xe1, 0x10, 0x35, 0xe9, 0xc0, 0xb3, 0xf6, 0x20, 0xf2, 0x7b, 0x8c, 0xa5, 0x29, 0x74, 0xdb, 0x3f,
+	0xa0, 0x5e, 0x63, 0xfa, 0x72, 0xe1, 0xf9, 0x5a, 0xf3, 0xf2, 0xc9, 0xf1, 0xc2, 0xb3, 0x4b, 0x0f,
+	0xa0, 0xc3, 0x07, 0x72, 0x21, 0x9b, 0x50, 0xef, 0x78, 0xe1, 0x96, 0xef, 0x3a, 0xed, 0xa3, 0xc6,
+	0x0c, 0xef, 0xe0, 0x0b, 0xf2, 0x51, 0xeb, 0x2b, 0x37, 0x5b, 0x02, 0x71, 0xff, 0x78, 0xe1, 0xd9,
+	0xe1, 0x25, 0x75, 0x51, 0xe1, 0x31, 0xe1, 0x41, 0x36, 0x38, 0xc3, 0x65, 0xdf, 0xdb, 0x73, 0xba,
+	0x8d, 0x59, 0xfe, 0x36, 0x2e, 0x8f, 0x98, 0xd0, 0x2b, 0x37, 0x5b, 0x82, 0xae, 0x39, 0x2b, 0xc5,
+	0x89, 0x9f, 0x98, 0x70, 0x20, 0x1d, 0x98, 0x8b, 0x17, 0xe3, 0x65, 0xd7, 0x76, 0x7a, 0x61, 0x63,
+	0x8e, 0x4f, 0xde, 0x1f, 0x1d, 0xc1, 0x13, 0x75, 0xe2, 0xe6, 0x53, 0xf2, 0x51, 0xe6, 0x0c, 0x70,
+	0x88, 0x29, 0x9e, 0x17, 0x3f, 0x03, 0xe7, 0x87, 0xd6, 0x06, 0x72, 0x0e, 0x4a, 0x07, 0xf4, 0x88,
+	0x2f, 0x7d, 0x75, 0x64, 0xff, 0x92, 0x27, 0xa0, 0x72, 0x68, 0xbb, 0x03, 0xda, 0x28, 0x72, 0x98,
+	0xf8, 0xf1, 0x4a, 0xf1, 0xe5, 0x82, 0xf5, 0x4b, 0x55, 0x98, 0x89, 0x57, 0x9c, 0x96, 0xe3, 0x1d,
+	0x90, 0xdb, 0x50, 0x72, 0xfd, 0xae, 0x5c, 0x37, 0x7f, 0x62, 0xec, 0x55, 0x6c, 0xdd, 0xef, 0x36,
+	0xa7, 0x4e, 0x8e, 0x17, 0x4a, 0xeb, 0x7e, 0x17, 0x19, 0x47, 0xd2, 0x86, 0xca, 0x81, 0xbd, 0x77,
+	0x60, 0xf3, 0x3e, 0x4c, 0x5f, 0x6d, 0x8e, 0xcd, 0xfa, 0x06, 0xe3, 0xc2, 0xfa, 0xda, 0xac, 0x9f,
+	0x1c, 0x2f, 0x54, 0xf8, 0x4f, 0x14, 0xbc, 0x89, 0x0f, 0xf5, 0x5d, 0xd7, 0x6e, 0x1f, 0xec, 0xfb,
+	0x2e, 0x6d, 0x94, 0x72, 0x0a, 0x6a, 0xc6, 0x9c, 0xc4, 0x6b, 0x56, 0x3f, 0x31, 0x91, 0x41, 0xda,
+	0x50, 0x1d, 0x74, 0x42, 0xc7, 0x3b, 0x90, 0x6b, 0xe0, 0x67, 0xc6, 0x96, 0xb6, 0xb3, 0xc2, 0x9f,
+	0x09, 0x4e, 0x8e, 0x17, 0xaa, 0xe2, 0x7f, 0x94, 0xac, 0xd9, 0xd0, 0xb1, 0x2f, 0x95, 0x36, 0x2a,
+	0x39, 0x9f, 0x88, 0x7d, 0x48, 0x34, 0x19, 0x3a, 0xfe, 0x13, 0x05, 0x6f, 0xf2, 0x3a, 0x94, 0xc2,
+	0x3b, 0x21, 0x5f, 0xf1, 0xa6, 0xaf, 0x7e, 0x76, 0x7c, 0x11, 0x77, 0x42, 0x2e, 0x80, 0xbf, 0xfc,
+	0xd6, 0x9d, 0x10, 0x19, 0x57, 0xf2, 0x06, 0x94, 0xf7, 0x1c, 0x97, 0x36, 0xa6, 0x72, 0x6e, 0xc7,
+	0xab, 0x8e, 0x2b, 0xfa, 0x5f, 0x3b, 0x39, 0x5e, 0x28, 0xb3, 0x5f, 0xc8, 0x19, 0x33, 0x01, 0xfb,
+	0x51, 0xd4, 0x6f, 0xd4, 0x72, 0x0a, 0x78, 0x75, 0x7b, 0x7b, 0x2b, 0x11, 0xc0, 0x7e, 0x21, 0x67,
+	0x6c, 0xfd, 0xce, 0x2c, 0xcc, 0xc5, 0x1f, 0xca, 0x2d, 0x1a, 0x44, 0xf4, 0x1e, 0xb9, 0x0c, 0x65,
+	0x8f, 0x2d, 0x8f, 0xfc, 0x43, 0x6b, 0xce, 0xc8, 0x4f, 0xb6, 0xcc, 0x97, 0x45, 0x8e, 0x61, 0xb3,
+	0x43, 0x7c, 0xae, 0x8d, 0x62, 0xce, 0xd9, 0xd1, 0xe2, 0x6c, 0xc4, 0xec, 0x10, 0xff, 0xa3, 0x64,
+	0x4d, 0x5e, 0x87, 0x32, 0x9f, 0x80, 0x62, 0xba, 0x7f, 0x6a, 0x7c, 0x11, 0xea, 0xb1, 0xd9, 0x7f,
+	0x58, 0x0e, 0xe5, 0x72, 0x30, 0xe8, 0xec, 0x35, 0xca, 0x39, 0x97, 0x83, 0x9d, 0x95, 0x55, 0x31,
+	0x23, 0x76, 0x56, 0x56, 0x91, 0x71, 0x24, 0x3f, 0x5b, 0x80, 0xf3, 0x6d, 0xdf, 0x8b, 0x6c, 0xa6,
+	0xeb, 0xc5, 0x8a, 0x8e, 0x9c, 0xe0, 0xaf, 0x8d, 0x2d, 0x67, 0x39, 0xcd, 0xb1, 0xf9, 0x24, 0xdb,
+	0xb7, 0x87, 0xc0, 0x38, 0x2c, 0x9b, 0xfc, 0x95, 0x02, 0x3c, 0xc9, 0xf6, 0xd3, 0x21, 0xe2, 0x46,
+	0x75, 0xe2, 0xbd, 0x7a, 0xe6, 0xe4, 0x78, 0xe1, 0xc9, 0xb5, 0x2c, 0x61, 0x98, 0xdd, 0x07, 0xd6,
+	0xbb, 0x0b, 0xf6, 0xb0, 0x6a, 0x28, 0xbf, 0xa8, 0xf5, 0x49, 0xaa, 0x9b, 0xcd, 0xf7, 0xcb, 0xa9,
+	0x9c, 0xa5, 0x5d, 0x63, 0x56, 0x2f, 0xc8, 0x35, 0x98, 0x3a, 0xf4, 0xdd, 0x41, 0x8f, 0x86, 0x8d,
+	0x1a, 0xdf, 0xe6, 0x2e, 0x66, 0x6d, 0x73, 0xb7, 0x38, 0x49, 0x73, 0x5e, 0xb2, 0x9f, 0x12, 0xbf,
+	0x43, 0x8c, 0xdb, 0x12, 0x07, 0xaa, 0xae, 0xd3, 0x73, 0xa2, 0x90, 0x2b, 0x2f, 0xd3, 0x57, 0xaf,
+	0x8d, 0xfd, 0x58, 0xe2, 0x13, 0x5d, 0xe7, 0xcc, 0xc4, 0x57, 0x23, 0xfe, 0x47, 0x29, 0x80, 0xaf,
+	0xa9, 0x6d, 0xdb, 0x15, 0xca, 0xcd, 0xf4, 0xd5, 0x4f, 0x8f, 0xff, 0xd9, 0x30, 0x2e, 0xcd, 0x59,
+	0xf9, 0x4c, 0x15, 0xfe, 0x13, 0x05, 0x6f, 0xf2, 0x25, 0x98, 0x33, 0xde, 0x66, 0xd8, 0x98, 0xe6,
+	0xa3, 0xf3, 0x5c, 0xd6, 0xe8, 0x28, 0xaa, 0x64, 0xf7, 0x37, 0x66, 0x48, 0x88, 0x29, 0x66, 0xe4,
+	0x06, 0xd4, 0x42, 0xa7, 0x43, 0xdb, 0x76, 0x10, 0x36, 0x66, 0x4e, 0xc3, 0xf8, 0x9c, 0x64, 0x5c,
+	0x6b, 0xc9, 0x66, 0xa8, 0x18, 0x90, 0x45, 0x80, 0xbe, 0x1d, 0x44, 0x8e, 0x38, 0x2c, 0xcc, 0x72,
+	0xc5, 0x75, 0xee, 0xe4, 0x78, 0x01, 0xb6, 0x14, 0x14, 0x35, 0x0a, 0x46, 0xcf, 0xda, 0xae, 0x79,
+	0xfd, 0x41, 0x24, 0x94, 0x9b, 0xba, 0xa0, 0x6f, 0x29, 0x28, 0x6a, 0x14, 0xe4, 0xdb, 0x05, 0x78,
+	0x7f, 0xf2, 0x73, 0xf8, 0x23, 0x9b, 0x9f, 0xf8, 0x47, 0xb6, 0x70, 0x72, 0xbc, 0xf0, 0xfe, 0xd6,
+	0x68, 0x91, 0xf8, 0xa0, 0xfe, 0x90, 0x6f, 0x14, 0x60, 0x6e, 0xd0, 0xef, 0xd8, 0x11, 0x6d, 0x45,
+	0xec, 0xd4, 0xd9, 0x3d, 0x6a, 0x9c, 0xe3, 0x5d, 0xbc, 0x3e, 0xfe, 0x2a, 0x68, 0xb0, 0x4b, 0x5e,
+	0xb3, 0x09, 0xc7, 0x94, 0x58, 0x12, 0x02, 0x74, 0xa8, 0xdd, 0x59, 0xa7, 0x51, 0x44, 0x83, 0xc6,
+	0x79, 0xde, 0x89, 0xe5, 0xb1, 0x3b, 0xb1, 0xa2, 0x58, 0x89, 0xd7, 0x95, 0xfc, 0x46, 0x4d, 0x8c,
+	0xf5, 0x26, 0x9c, 0x5f, 0x6a, 0xb7, 0x07, 0xbd, 0x81, 0x6b, 0x47, 0x7e, 0x70, 0xdb, 0xf1, 0x3a,
+	0xfe, 0x5d, 0xb2, 0x03, 0x53, 0x4c, 0xd7, 0xf7, 0x07, 0x91, 0x54, 0x10, 0x17, 0xb5, 0xf9, 0xa6,
+	0x0e, 0xee, 0x89, 0x74, 0x76, 0x4a, 0x66, 0x33, 0x70, 0x65, 0x20, 0x4f, 0x97, 0xd3, 0xec, 0xb3,
+	0xdf, 0x16, 0x2c, 0x30, 0xe6, 0x65, 0xdd, 0x86, 0xd9, 0xa5, 0x41, 0xb4, 0xef, 0x07, 0xce, 0x5b,
+	0x9c, 0x8c, 0xac, 0x42, 0x25, 0xe2, 0x67, 0x05, 0x21, 0xe5, 0x83, 0x59, 0xb3, 0x5a, 0x9c, 0xdb,
+	0x6e, 0xd0, 0xa3, 0x58, 0xf9, 0x15, 0x3a, 0x8d, 0x38, 0x3b, 0x88, 0xe6, 0xd6, 0xb7, 0x8a, 0x30,
+	0xd5, 0xb4, 0xdb, 0x07, 0xfe, 0xde, 0x1e, 0xf9, 0x3c, 0xd4, 0x1c, 0x2f, 0xa2, 0xc1, 0xa1, 0xed,
+	0x8e, 0xd9, 0x79, 0x7e, 0xfc, 0x5a, 0x93, 0x3c, 0x50, 0x71, 0x23, 0x0b, 0x50, 0x09, 0x23, 0xda,
+	0x0f, 0xf9, 0x26, 0x3f, 0x2b, 0x55, 0x2b, 0x06, 0x40, 0x01, 0x27, 0x6b, 0x50, 0x6a, 0xdb, 0xfd,
+	0x46, 0x69, 0x2c, 0xa9, 0x7c, 0xdb, 0x5c, 0xb6, 0xfb, 0xc8, 0x78, 0x10, 0x0b, 0xaa, 0x7b, 0x36,
+	0xb7, 0x33, 0xb0, 0x2d, 0xb9, 0x20, 0x96, 0xb6, 0x55, 0x0e, 0x41, 0x89, 0x61, 0x34, 0x6f, 0x3a,
+	0x7c, 0xae, 0x54, 0x12, 0x9a, 0xd7, 0x38, 0x04, 0x25, 0xc6, 0xfa, 0xc5, 0x02, 0xd4, 0x9b, 0x76,
+	0xe8, 0xb4, 0xd9, 0xc0, 0x93, 0x65, 0x28, 0x0f, 0x42, 0x1a, 0x9c, 0x6d, 0xb8, 0xb9, 0xaa, 0xb0,
+	0x13, 0xd2, 0x00, 0x79, 0x63, 0xb2, 0x09, 0xb5, 0xbe, 0x1d, 0x86, 0x77, 0xfd, 0xa0, 0xd3, 0x28,
+	0x9e, 0x85, 0x91, 0x38, 0x1e, 0xcb, 0xa6, 0xa8, 0x98, 0x58, 0xd3, 0x90, 0xe8, 0xdc, 0xd6, 0xbf,
+	0x29, 0xc2, 0x85, 0xe6, 0x60, 0x6f, 0x8f, 0x06, 0xf2, 0x34, 0x28, 0xcf, 0x59, 0x14, 0x2a, 0x01,
+	0xed, 0x38, 0xa1, 0xec, 0xfb, 0xca, 0xd8, 0xdf, 0x05, 0x32, 0x2e, 0xf2, 0x58, 0xc7, 0x5f, 0x21,
+	0x07, 0xa0, 0xe0, 0x4e, 0x06, 0x50, 0x7f, 0x93, 0x32, 0x2b, 0x14, 0xb5, 0x7b, 0xf2, 0xe9, 0x5e,
+	0x1d, 0x5b, 0xd4, 0x6b, 0x34, 0x6a, 0x71, 0x4e, 0xfa, 0x29, 0x52, 0x01, 0x31, 0x91, 0x44, 0x6c,
+	0x28, 0x77, 0x9c, 0x30, 0xd6, 0xed, 0x72, 0x7c, 0xf4, 0x4e, 0x78, 0x20, 0x85, 0xf1, 0xd7, 0xc6,
+	0x7e, 0x23, 0x67, 0x6d, 0xfd, 0xcd, 0x22, 0x00, 0x3f, 0x4d, 0x2e, 0xef, 0xd3, 0xf6, 0x01, 0x79,
+	0x05, 0xe6, 0xa2, 0xfd, 0x80, 0x86, 0xfb, 0xbe, 0xdb, 0x69, 0x1e, 0x45, 0x54, 0x0c, 0x6c, 0xb9,
+	0x49, 0xd8, 0x42, 0xb5, 0x6d, 0x60, 0x30, 0x45, 0x49, 0xbe, 0x04, 0xc5, 0xf0, 0xc5, 0x46, 0x31,
+	0xe7, 0xd6, 0x9d, 0x74, 0xa6, 0xf5, 0x62, 0xb3, 0x7a, 0x72, 0xbc, 0x50, 0x6c, 0xbd, 0x88, 0xc5,
+	0xf0, 0x45, 0xf2, 0xa7, 0x0b, 0xf0, 0x64, 0x9f, 0x06, 0xa1, 0x13, 0x46, 0xd4, 0x8b, 0x84, 0xf2,
+	0xc0, 0x89, 0xe5, 0xf0, 0xbc, 0x94, 0x79, 0xb4, 0xce, 0x6a, 0x20, 0xfe, 0x95, 0x3a, 0x35, 0xd7,
+	0xc5, 0x32, 0xc9, 0x30, 0x5b, 0x9c, 0xf5, 0xdd, 0x02, 0xcc, 0xe8, 0xbd, 0x24, 0x1f, 0x82, 0xea,
+	0xee, 0xa0, 0x7d, 0x40, 0x23, 0x79, 0x16, 0x98, 0x93, 0x2b, 0x7b, 0xb5, 0xc9, 0xa1, 0x28, 0xb1,
+	0x8c, 0x2e, 0xa0, 0x5d, 0xc7, 0xf7, 0x1a, 0x45, 0x93, 0x0e, 0x39, 0x14, 0x25, 0x96, 0xbc, 0x04,
+	0xd3, 0xd4, 0xeb, 0xf4, 0x7d, 0xc7, 0x8b, 0x76, 0x02, 0x97, 0x3f, 0x5e, 0x3d, 0xb1, 0xcc, 0x5d,
+	0x8b, 0x51, 0xb8, 0x8e, 0x3a, 0x1d, 0x63, 0xdf, 0x0f, 0xe8, 0x9e, 0x73, 0xaf, 0x51, 0x36, 0xd9,
+	0x6f, 0x71, 0x28, 0x4a, 0xac, 0xf5, 0xeb, 0x15, 0x98, 0x59, 0xf6, 0x7b, 0xbb, 0x8e, 0x47, 0x3b,
+	0xd7, 0x3a, 0x5d, 0x7e, 0x7a, 0xa2, 0x9d, 0x2e, 0x6d, 0x14, 0x72, 0x1e, 0x21, 0x18, 0xb3, 0xe4,
+	0x20, 0xc4, 0x7e, 0x21, 0x67, 0x4c, 0xd6, 0x61, 0x6e, 0x2f, 0xf0, 0x7b, 0x42, 0x2b, 0xdb, 0x3e,
+	0xea, 0x4b, 0x4b, 0x44, 0xf3, 0x47, 0xe3, 0x2d, 0x70, 0xd5, 0xc0, 0xde, 0x3f, 0x5e, 0x80, 0xe4,
+	0x17, 0xa6, 0xda, 0x92, 0xcf, 0x43, 0x23, 0x81, 0x28, 0xf5, 0x64, 0x99, 0x19, 0x87, 0xf8, 0x58,
+	0x55, 0x9a, 0xcf, 0x9e, 0x1c, 0x2f, 0x34, 0x56, 0x47, 0xd0, 0xe0, 0xc8, 0xd6, 0x6c, 0xd3, 0x3f,
+	0x97, 0x20, 0x85, 0xca, 0xd8, 0x28, 0xe7, 0x9c, 0xd0, 0x86, 0x2e, 0xca, 0xad, 0x68, 0xab, 0x29,
+	0x11, 0x38, 0x24, 0x94, 0xac, 0xc2, 0x4c, 0xe4, 0x6b, 0xe3, 0x55, 0xe1, 0xe3, 0x65, 0xc5, 0x66,
+	0xdf, 0x6d, 0x7f, 0xe4, 0x68, 0x19, 0xed, 0x08, 0xc2, 0x53, 0x91, 0x9f, 0xf5, 0xac, 0xfc, 0x54,
+	0x53, 0x69, 0x5e, 0x3c, 0x39, 0x5e, 0x78, 0x6a, 0x3b, 0x93, 0x02, 0x47, 0xb4, 0x24, 0x3f, 0x53,
+	0x80, 0xb9, 0xc8, 0xd7, 0xbb, 0xdb, 0x98, 0x9a, 0xe4, 0x18, 0x89, 0xb5, 0xc6, 0x10, 0x80, 0x29,
+	0x81, 0xd6, 0xcf, 0x14, 0x60, 0x7a, 0xd9, 0xef, 0xf5, 0x03, 0x1a, 0x86, 0xec, 0x93, 0x79, 0x11,
+	0xca, 0xd1, 0x51, 0x5f, 0x4c, 0xe1, 0x7a, 0x73, 0x21, 0x9e, 0x83, 0x72, 0x7c, 0xe6, 0x35, 0x52,
+	0x3e, 0x48, 0x9c, 0x98, 0x7c, 0x0a, 0xe6, 0x7b, 0x8e, 0xb7, 0x65, 0x1f, 0xb9, 0xbe, 0x2d, 0x57,
+	0x3b, 0xb1, 0x87, 0x73, 0x13, 0xf5, 0x86, 0x89, 0xc2, 0x34, 0xad, 0xf5, 0x9d, 0x29, 0xa8, 0x2b,
+	0xc5, 0x91, 0x7c, 0x00, 0x2a, 0xdc, 0xa8, 0x2c, 0xbb, 0xa0, 0x4e, 0x04, 0xdc, 0xf6, 0x8c, 0x02,
+	0x47, 0x3e, 0x08, 0x53, 0x6d, 0xbf, 0xd7, 0xb3, 0xbd, 0x0e, 0x77, 0x14, 0xd4, 0x85, 0x46, 0xb4,
+	0x2c, 0x40, 0x18, 0xe3, 0xc8, 0xb3, 0x50, 0xb6, 0x83, 0xae, 0xb0, 0xd9, 0xd7, 0xc5, 0x92, 0xbd,
+	0x14, 0x74, 0x43, 0xe4, 0x50, 0xf2, 0x09, 0x28, 0x51, 0xef, 0xb0, 0x51, 0x1e, 0x7d, 0xd2, 0xba,
+	0xe6, 0x1d, 0xde, 0xb2, 0x83, 0xe6, 0xb4, 0xec, 0x43, 0xe9, 0x9a, 0x77, 0x88, 0xac, 0x0d, 0x59,
+	0x87, 0x29, 0xea, 0x1d, 0xb2, 0xf9, 0x27, 0x8d, 0xe9, 0x3f, 0x32, 0xa2, 0x39, 0x23, 0x91, 0x0b,
+	0xa4, 0x3a, 0xaf, 0x49, 0x30, 0xc6, 0x2c, 0xc8, 0x17, 0x60, 0x46, 0x1c, 0xdd, 0x36, 0xd8, 0xbc,
+	0x60, 0xc6, 0x23, 0xc6, 0x72, 0x61, 0xf4, 0xd9, 0x8f, 0xd3, 0x25, 0xce, 0x0b, 0x0d, 0x18, 0xa2,
+	0xc1, 0x8a, 0x7c, 0x01, 0xea, 0xb1, 0xad, 0x33, 0x9e, 0x5d, 0x99, 0x76, 0xff, 0xd8, 0x40, 0x8a,
+	0xf4, 0xce, 0xc0, 0x09, 0x68, 0x8f, 0x7a, 0x51, 0xd8, 0x3c, 0x1f, 0x5b, 0x82, 0x63, 0x6c, 0x88,
+	0x09, 0x37, 0xb2, 0x3b, 0xec, 0xc0, 0x10, 0x66, 0xa3, 0x0f, 0x8c, 0xd0, 0x57, 0xc6, 0xf0, 0x5e,
+	0x7c, 0x19, 0xe6, 0x95, 0x87, 0x41, 0x1a, 0xa9, 0x85, 0x3d, 0xfe, 0xa3, 0xac, 0xf9, 0x9a, 0x89,
+	0xba, 0x7f, 0xbc, 0xf0, 0x5c, 0x86, 0x99, 0x3a, 0x21, 0xc0, 0x34, 0x33, 0xf2, 0x16, 0x33, 0x2f,
+	0xdb, 0x1d, 0xc7, 0xa3, 0x61, 0xb8, 0x15, 0xf8, 0xbb, 0xf9, 0xcf, 0xb1, 0x9c, 0x8b, 0xf8, 0xf4,
+	0xd0, 0xe0, 0x8c, 0x29, 0x49, 0xe4, 0x2e, 0xcc, 0xba, 0xce, 0x21, 0x4d, 0x44, 0x4f, 0x4f, 0x44,
+	0xf4, 0xf9, 0x93, 0xe3, 0x85, 0xd9, 0x75, 0x9d, 0x31, 0x9a, 0x72, 0xd8, 0xb1, 0xa0, 0xef, 0x07,
+	0x51, 0x7c, 0xd8, 0xfd, 0x91, 0x07, 0x1e, 0x76, 0xb7, 0xfc, 0x20, 0x4a, 0x3e, 0x42, 0xf6, 0x2b,
+	0x44, 0xd1, 0xdc, 0xfa, 0x3b, 0x15, 0x18, 0x36, 0x09, 0x99, 0x33, 0xae, 0x30, 0xe9, 0x19, 0x97,
+	0x9e, 0x0d, 0x62, 0xff, 0x7b, 0x59, 0x36, 0x9b, 0xc0, 0x8c, 0xc8, 0x98, 0xd5, 0xa5, 0x49, 0xcf,
+	0xea, 0xc7, 0x66, 0xe1, 0x19, 0x9e, 0xfe, 0xd5, 0x77, 0x6e, 0xfa, 0x4f, 0x3d, 0x9a, 0xe9, 0x6f,
+	0xfd, 0x19, 0xbe, 0xe5, 0x0d, 0xbc, 0x48, 0x9e, 0xc6, 0x3f, 0x00, 0x15, 0xee, 0x10, 0xe3, 0x93,
+	0x75, 0x36, 0x99, 0xeb, 0x62, 0xf3, 0x16, 0x38, 0xfd, 0xc8, 0x5e, 0x9c, 0xe0, 0x91, 0xfd, 0x9b,
+	0x65, 0x98, 0x5b, 0xb1, 0x69, 0xcf, 0xf7, 0xde, 0xd6, 0x42, 0x59, 0x78, 0x2c, 0x2c, 0x94, 0xcf,
+	0x43, 0x2d, 0xa0, 0x7d, 0xd7, 0x69, 0xdb, 0x62, 0x8f, 0x97, 0x5e, 0x59, 0x94, 0x30, 0x54, 0xd8,
+	0x11, 0x96, 0xe9, 0xd2, 0x63, 0x69, 0x99, 0x2e, 0xbf, 0xf3, 0x96, 0x69, 0xeb, 0x6f, 0x14, 0x40,
+	0x33, 0x22, 0x31, 0xbb, 0x60, 0xcf, 0xbe, 0x87, 0x34, 0x0a, 0x1c, 0xb9, 0x8e, 0xce, 0x0a, 0x43,
+	0xd3, 0x86, 0x82, 0xa2, 0x46, 0x41, 0xba, 0x30, 0x1b, 0xd0, 0x28, 0x38, 0x8a, 0x0d, 0x2b, 0x63,
+	0x4e, 0x53, 0xfe, 0xf9, 0xa0, 0xce, 0x08, 0x4d, 0xbe, 0xd6, 0xbf, 0x2f, 0xc2, 0x79, 0x76, 0xee,
+	0x35, 0xac, 0x08, 0x24, 0x84, 0xa9, 0x30, 0xf2, 0x03, 0xbb, 0x4b, 0x73, 0x5b, 0x10, 0x18, 0xf3,
+	0x96, 0xe0, 0x95, 0x1c, 0xd6, 0x34, 0x20, 0xc6, 0x92, 0xc8, 0x9b, 0x30, 0xd7, 0xb3, 0xef, 0xb5,
+	0x68, 0x97, 0x6d, 0x1e, 0x2d, 0xe7, 0x2d, 0x7a, 0x9a, 0x87, 0x5e, 0x8c, 0xf7, 0x93, 0xc5, 0xcf,
+	0x0d, 0x6c, 0x2f, 0x62, 0x01, 0x01, 0x7c, 0xb9, 0xda, 0x30, 0x38, 0x61, 0x8a, 0x33, 0xe9, 0xc0,
+	0x4c, 0x78, 0xe4, 0xb5, 0xd5, 0xf0, 0x8e, 0x67, 0x85, 0x3a, 0xc7, 0xd4, 0xb5, 0x96, 0xc6, 0x07,
+	0x0d, 0xae, 0xd6, 0xaf, 0x15, 0x01, 0x12, 0x23, 0xc3, 0x3b, 0x33, 0xaa, 0x4b, 0x30, 0x9f, 0x3c,
+	0x7b, 0xa2, 0xcd, 0x97, 0x9a, 0x4f, 0xc7, 0xbb, 0xec, 0x86, 0x89, 0xc6, 0x34, 0x3d, 0xd9, 0x9f,
+	0xc8, 0x60, 0x29, 0xfd, 0xf6, 0x01, 0x03, 0xf6, 0xdb, 0x05, 0xd0, 0x9f, 0x82, 0x20, 0xd4, 0xf6,
+	0xfd, 0x30, 0xda, 0xb2, 0xa3, 0xfd, 0x07, 0x29, 0x1f, 0xaf, 0x4a, 0x1a, 0xc3, 0x82, 0xc1, 0x57,
+	0xb2, 0x18, 0x83, 0x8a, 0xcf, 0x03, 0x0c, 0x26, 0xc5, 0x47, 0x6b, 0x30, 0xf9, 0x6f, 0x25, 0xe0,
+	0xd6, 0x00, 0xe6, 0x32, 0x65, 0x27, 0xdd, 0xb4, 0xcb, 0x94, 0x6f, 0xee, 0x1c, 0x43, 0x2e, 0x42,
+	0x31, 0xf2, 0xa5, 0x76, 0x04, 0x12, 0x5f, 0xdc, 0xf6, 0xb1, 0x18, 0xf9, 0xe4, 0x2d, 0x80, 0xb6,
+	0xef, 0x75, 0x9c, 0x38, 0x9e, 0x29, 0xdf, 0xda, 0xb7, 0xea, 0x07, 0x77, 0xed, 0xa0, 0xb3, 0xac,
+	0x38, 0x8a, 0x65, 0x2a, 0xf9, 0x8d, 0x9a, 0x34, 0xf2, 0x19, 0xa8, 0xfa, 0xde, 0xea, 0xc0, 0x75,
+	0xa5, 0x6d, 0xe5, 0xc7, 0x98, 0x5d, 0x65, 0x93, 0x43, 0xee, 0x1f, 0x2f, 0x3c, 0x23, 0x16, 0x15,
+	0xf6, 0xeb, 0x76, 0xe0, 0x44, 0x8e, 0xd7, 0x55, 0xd6, 0x7c, 0xd9, 0x8c, 0xdc, 0x85, 0xe9, 0x76,
+	0x72, 0x08, 0x6d, 0x54, 0x72, 0x7e, 0x16, 0xda, 0x81, 0xb6, 0x39, 0xcf, 0x3e, 0x09, 0x0d, 0x80,
+	0xba, 0x24, 0xe2, 0x6b, 0xf1, 0x48, 0xd5, 0x9c, 0xc7, 0x74, 0xf6, 0x12, 0xe3, 0x40, 0xa6, 0x51,
+	0x61, 0x4d, 0x6c, 0x2d, 0x98, 0xd1, 0x09, 0x99, 0xa2, 0xe2, 0xda, 0x9e, 0xdc, 0x0d, 0x2a, 0x89,
+	0xa2, 0xb2, 0xce, 0x80, 0x28, 0x70, 0xcc, 0x78, 0xb5, 0x4f, 0xed, 0x0e, 0x0d, 0xd2, 0xb6, 0xb1,
+	0x57, 0x39, 0x14, 0x25, 0x96, 0xdc, 0x81, 0x72, 0x64, 0x77, 0xe3, 0x70, 0xb6, 0xcd, 0x89, 0x3c,
+	0xca, 0xe2, 0xb6, 0xdd, 0x0d, 0x45, 0x60, 0x9d, 0x9a, 0x93, 0x0c, 0x84, 0x5c, 0x14, 0x3b, 0xb4,
+	0xdf, 0xa5, 0x4e, 0x77, 0x9f, 0xdb, 0x82, 0x4a, 0xcf, 0x57, 0x84, 0x4e, 0x74, 0x5b, 0x80, 0x30,
+	0xc6, 0x5d, 0xfc, 0x38, 0xd4, 0x15, 0x9f, 0xb7, 0x0b, 0xc2, 0xa9, 0xe8, 0x41, 0x38, 0xbf, 0x58,
+	0x86, 0x5a, 0x1c, 0xd9, 0xc0, 0x3e, 0x91, 0x7e, 0xbc, 0x08, 0x68, 0x9f, 0x08, 0xff, 0xb4, 0x39,
+	0x86, 0xbc, 0xae, 0x99, 0x59, 0x97, 0x73, 0x87, 0x52, 0xa4, 0x8c, 0xac, 0x3f, 0x01, 0x35, 0xea,
+	0xb5, 0xfd, 0x8e, 0xe3, 0x75, 0xa5, 0xdd, 0xf1, 0x72, 0xec, 0x34, 0xbc, 0x26, 0xe1, 0xf7, 0x8f,
+	0x17, 0x66, 0x58, 0xeb, 0xf8, 0x37, 0xaa, 0x16, 0xe4, 0x65, 0x98, 0xe9, 0xd9, 0xf7, 0x18, 0x52,
+	0xac, 0xbf, 0x65, 0xbe, 0xfe, 0xaa, 0xf5, 0x70, 0x43, 0xc3, 0xa1, 0x41, 0xc9, 0xb6, 0xa9, 0xc0,
+	0x77, 0x5d, 0xb5, 0xf2, 0x56, 0xc6, 0xdf, 0xa6, 0x50, 0xe3, 0x83, 0x06, 0x57, 0xb6, 0x45, 0xd0,
+	0x43, 0xea, 0x45, 0x4c, 0x9f, 0x5d, 0xb7, 0x8f, 0x98, 0x56, 0x2c, 0x42, 0xfc, 0xd4, 0x16, 0x71,
+	0xcd, 0x44, 0x63, 0x9a, 0x9e, 0xb1, 0x50, 0x5e, 0xd0, 0xe6, 0xd1, 0x0d, 0x7a, 0x24, 0xcc, 0x13,
+	0xb5, 0x84, 0xc5, 0x96, 0x89, 0xc6, 0x34, 0x3d, 0xb9, 0x0a, 0x20, 0x6c, 0x1d, 0x3c, 0xba, 0xae,
+	0xc6, 0x3b, 0x40, 0x64, 0x6b, 0xb8, 0xa5, 0x30, 0xa8, 0x51, 0x59, 0x7f, 0xa9, 0x00, 0x90, 0xbc,
+	0xb2, 0xc7, 0xc4, 0xe2, 0x6c, 0xfd, 0xc5, 0x02, 0x4c, 0xaf, 0x3a, 0xf7, 0x68, 0x47, 0x1e, 0x49,
+	0x10, 0xaa, 0x2e, 0xf5, 0xba, 0x6a, 0x0f, 0x3b, 0xeb, 0xfb, 0x13, 0x9e, 0x7a, 0xce, 0x01, 0x25,
+	0x27, 0x72, 0x05, 0xea, 0xc2, 0x1b, 0xc2, 0xa6, 0x64, 0x91, 0x0f, 0xb5, 0x3a, 0x6d, 0xb7, 0x62,
+	0x04, 0x26, 0x34, 0xd6, 0xb7, 0x0b, 0x70, 0x7e, 0x68, 0x71, 0x27, 0x1d, 0xb9, 0x6e, 0x88, 0x8e,
+	0xad, 0x8e, 0xfd, 0xdd, 0x6c, 0xdb, 0x5d, 0x6d, 0xcb, 0xa8, 0xa5, 0x96, 0x8a, 0xab, 0x00, 0xf4,
+	0x9e, 0x5a, 0xe4, 0x8b, 0xe6, 0xab, 0xbd, 0xa6, 0x30, 0xa8, 0x51, 0x59, 0xff, 0xa7, 0x00, 0xb5,
+	0xd5, 0x81, 0xd7, 0x66, 0x1c, 0x4f, 0x11, 0x54, 0x14, 0xdb, 0x06, 0x8b, 0x99, 0xb6, 0xc1, 0x01,
+	0x54, 0x0f, 0xee, 0x2a, 0xdb, 0xe1, 0xf4, 0xd5, 0x8d, 0xf1, 0x17, 0x08, 0xd9, 0xa5, 0xc5, 0x1b,
+	0x9c, 0x9f, 0x58, 0x1e, 0xd5, 0xfc, 0xb9, 0x71, 0x9b, 0x0b, 0x95, 0xc2, 0x2e, 0x7e, 0x02, 0xa6,
+	0x35, 0xb2, 0x33, 0x85, 0x20, 0xfe, 0xdd, 0x32, 0x54, 0xaf, 0xb7, 0x5a, 0x4b, 0x5b, 0x6b, 0x6c,
+	0x16, 0xca, 0x90, 0xd4, 0x9b, 0xc9, 0x18, 0xa8, 0x59, 0xd8, 0x4a, 0x50, 0xa8, 0xd3, 0xb1, 0xfd,
+	0x25, 0xa0, 0xb6, 0xdb, 0x93, 0xe3, 0xad, 0xf6, 0x17, 0x64, 0x40, 0x14, 0x38, 0x62, 0xc3, 0x1c,
+	0x73, 0x53, 0xb2, 0x21, 0x14, 0x2e, 0xc8, 0x46, 0xe9, 0x2c, 0x4e, 0x4a, 0xae, 0x6a, 0xef, 0x18,
+	0x0c, 0x30, 0xc5, 0x90, 0xbc, 0x0c, 0x35, 0x7b, 0x10, 0xed, 0x73, 0x7b, 0xbd, 0xd0, 0x12, 0x9e,
+	0xe5, 0x11, 0xbb, 0x12, 0xc6, 0xd6, 0xcd, 0x1b, 0xd8, 0x7c, 0x29, 0xfe, 0x8d, 0x8a, 0x9a, 0x75,
+	0x2e, 0x76, 0x7b, 0xca, 0xce, 0x55, 0xce, 0xdc, 0xb9, 0x2d, 0x83, 0x01, 0xa6, 0x18, 0x92, 0xd7,
+	0x61, 0xe6, 0x80, 0x1e, 0x45, 0xf6, 0xae, 0x14, 0x50, 0x3d, 0x8b, 0x00, 0xbe, 0xae, 0xde, 0xd0,
+	0x9a, 0xa3, 0xc1, 0x8c, 0x84, 0xf0, 0xc4, 0x01, 0x0d, 0x76, 0x69, 0xe0, 0x4b, 0x17, 0xaa, 0x14,
+	0x32, 0x75, 0x16, 0x21, 0x8d, 0x93, 0xe3, 0x85, 0x27, 0x6e, 0x64, 0xb0, 0xc1, 0x4c, 0xe6, 0xd6,
+	0x5f, 0x2e, 0xc0, 0x85, 0xeb, 0x22, 0x27, 0xc0, 0x0f, 0x36, 0x07, 0xd1, 0xe6, 0xde, 0x66, 0xc0,
+	0x34, 0x84, 0x0f, 0xc3, 0x54, 0x9f, 0x06, 0x6d, 0x2a, 0x2d, 0x23, 0x95, 0xc4, 0x8e, 0xb4, 0x25,
+	0xc0, 0x18, 0xe3, 0x49, 0x0b, 0x2a, 0x1d, 0xea, 0xda, 0x47, 0x63, 0x1e, 0x3a, 0xd5, 0x4c, 0x5b,
+	0x61, 0x4c, 0x50, 0xf0, 0xb2, 0xbe, 0x5b, 0x84, 0x79, 0xd5, 0x2f, 0x66, 0x60, 0xb0, 0x8f, 0x4e,
+	0xb1, 0xab, 0x9b, 0x9b, 0x42, 0xf1, 0x34, 0x9b, 0x02, 0xe3, 0xea, 0xfa, 0xbe, 0x88, 0x2c, 0xa8,
+	0x25, 0x5c, 0xd7, 0x7d, 0xbf, 0x8f, 0x1c, 0x43, 0x7e, 0x1c, 0x6a, 0x07, 0xf4, 0x68, 0xd5, 0xa1,
+	0x6e, 0x47, 0x4e, 0x49, 0x15, 0x03, 0x74, 0x43, 0xc2, 0x51, 0x51, 0x90, 0x4f, 0xc3, 0x9c, 0xda,
+	0xee, 0x44, 0x1b, 0xe1, 0x76, 0x52, 0x91, 0x2a, 0xd7, 0x0c, 0x2c, 0xa6, 0xa8, 0xc9, 0x0a, 0x9c,
+	0x0b, 0xe8, 0xdd, 0xc0, 0x89, 0xa8, 0x22, 0xe4, 0xf3, 0xac, 0x96, 0x44, 0xe2, 0x63, 0x0a, 0x8f,
+	0x43, 0x2d, 0xac, 0x9f, 0xad, 0x68, 0xe3, 0x27, 0xce, 0x1c, 0xe4, 0x19, 0x28, 0x05, 0xfd, 0x01,
+	0x1f, 0xbe, 0x92, 0x08, 0x89, 0xc0, 0xad, 0x1d, 0x64, 0x30, 0x16, 0xd8, 0xd1, 0x91, 0x2f, 0x64,
+	0xcc, 0xd7, 0xc8, 0x15, 0xd9, 0xf8, 0x17, 0x2a, 0x6e, 0x4c, 0xef, 0xeb, 0x85, 0x5d, 0x7e, 0x3e,
+	0x17, 0x6e, 0x45, 0xae, 0xf7, 0x6d, 0x08, 0x10, 0xc6, 0x38, 0x66, 0x5a, 0x3a, 0xa0, 0x47, 0xc2,
+	0xa9, 0x56, 0x4e, 0x4c, 0x4b, 0x37, 0x24, 0x0c, 0x15, 0x96, 0x45, 0x8a, 0x88, 0x45, 0xb0, 0xc2,
+	0x7d, 0xea, 0x3c, 0xcc, 0xe0, 0x16, 0x03, 0xc8, 0xf5, 0x90, 0xed, 0x9f, 0x32, 0x74, 0xa3, 0x3a,
+	0xfe, 0xfe, 0x69, 0x86, 0x7a, 0x90, 0x3f, 0x0a, 0x75, 0xce, 0xbc, 0xe9, 0xfa, 0xbb, 0xfc, 0x83,
+	0xac, 0x8b, 0x80, 0x83, 0x5b, 0x31, 0x10, 0x13, 0x3c, 0x7b, 0x96, 0x28, 0x36, 0x2e, 0x09, 0xc5,
+	0x84, 0x3f, 0x8b, 0xb2, 0x01, 0x29, 0x2c, 0x71, 0x99, 0x66, 0xc1, 0xe6, 0x76, 0xa3, 0x9e, 0x33,
+	0x1c, 0x22, 0xf5, 0xad, 0x88, 0x87, 0x10, 0xff, 0xa3, 0x94, 0x41, 0x7e, 0x0a, 0xc0, 0x57, 0x5f,
+	0x78, 0x03, 0x72, 0x9a, 0x14, 0x33, 0x56, 0x0d, 0x71, 0xf8, 0x4b, 0x7e, 0xa3, 0x26, 0xcf, 0xfa,
+	0x83, 0x22, 0x3c, 0x75, 0x9d, 0x46, 0xc2, 0xe0, 0xb9, 0x42, 0xfb, 0xae, 0x7f, 0xc4, 0x6c, 0x06,
+	0x48, 0xef, 0x90, 0xcf, 0x02, 0x38, 0xe1, 0x6e, 0xeb, 0xb0, 0xbd, 0x9d, 0x78, 0x1f, 0x63, 0x8d,
+	0x19, 0xd6, 0x5a, 0x4d, 0x89, 0xb9, 0x6f, 0xfc, 0x42, 0xad, 0x4d, 0xe2, 0x37, 0x2c, 0x3e, 0xc0,
+	0x6f, 0xd8, 0x02, 0xe8, 0x27, 0xce, 0x03, 0xa1, 0x9e, 0xbd, 0x18, 0x8b, 0x39, 0x8b, 0xdf, 0x40,
+	0x63, 0x93, 0xc7, 0x9c, 0xef, 0xc1, 0xb9, 0x0e, 0xdd, 0xb3, 0x07, 0x6e, 0xa4, 0x1c, 0x1e, 0x8d,
+	0xca, 0x68, 0xb3, 0x45, 0xa6, 0xcf, 0x44, 0xad, 0x09, 0x2b, 0x29, 0x4e, 0x38, 0xc4, 0xdb, 0xfa,
+	0x7b, 0x25, 0xb8, 0x78, 0x9d, 0x46, 0x2a, 0x48, 0x46, 0xea, 0x02, 0xad, 0x3e, 0x6d, 0xb3, 0xb7,
+	0xf0, 0x8d, 0x02, 0x54, 0x5d, 0x7b, 0x97, 0xba, 0x4c, 0xbf, 0x63, 0x4f, 0xf3, 0x46, 0x8e, 0xb9,
+	0x31, 0x4a, 0xca, 0xe2, 0x3a, 0x97, 0x90, 0x52, 0x84, 0x04, 0x10, 0xa5, 0x78, 0xa6, 0xc2, 0xb4,
+	0xdd, 0x41, 0x18, 0x09, 0x07, 0x94, 0x34, 0x35, 0x2b, 0x15, 0x66, 0x39, 0x41, 0xa1, 0x4e, 0xc7,
+	0x56, 0xff, 0xb6, 0xeb, 0x50, 0x2f, 0xe2, 0xad, 0xc4, 0x6a, 0xa3, 0x56, 0xff, 0x65, 0x85, 0x41,
+	0x8d, 0x8a, 0x89, 0xea, 0xf9, 0x9e, 0x13, 0xf9, 0x42, 0x54, 0xd9, 0x14, 0xb5, 0x91, 0xa0, 0x50,
+	0xa7, 0xe3, 0xcd, 0x98, 0xed, 0xb5, 0x1d, 0xf2, 0x66, 0x95, 0x54, 0xb3, 0x04, 0x85, 0x3a, 0x1d,
+	0xd3, 0xf0, 0xb4, 0xe7, 0x3f, 0x93, 0x86, 0xf7, 0xab, 0x75, 0xb8, 0x64, 0x0c, 0x6b, 0x64, 0x47,
+	0x74, 0x6f, 0xe0, 0xb6, 0x68, 0x14, 0xbf, 0xc0, 0x31, 0x35, 0xbf, 0x3f, 0x97, 0xbc, 0x77, 0x91,
+	0x77, 0xd7, 0x9e, 0xcc, 0x7b, 0x1f, 0xea, 0xe0, 0xa9, 0xde, 0xfd, 0x15, 0xa8, 0x7b, 0x76, 0x14,
+	0xf2, 0x0f, 0x57, 0x7e, 0xa3, 0xea, 0xa4, 0x72, 0x33, 0x46, 0x60, 0x42, 0x43, 0xb6, 0xe0, 0x09,
+	0x39, 0xc4, 0xd7, 0xee, 0x31, 0xd7, 0x24, 0x0d, 0x44, 0x5b, 0xa9, 0x3c, 0xca, 0xb6, 0x4f, 0x6c,
+	0x64, 0xd0, 0x60, 0x66, 0x4b, 0xb2, 0x01, 0x17, 0xda, 0x22, 0x17, 0x89, 0xb2, 0x38, 0x85, 0x98,
+	0xa1, 0xd8, 0xc6, 0x95, 0xd7, 0x64, 0x79, 0x98, 0x04, 0xb3, 0xda, 0xa5, 0x67, 0x73, 0x75, 0xac,
+	0xd9, 0x3c, 0x35, 0xce, 0x6c, 0xae, 0x8d, 0x37, 0x9b, 0xeb, 0xa7, 0x9b, 0xcd, 0x6c, 0xe4, 0xd9,
+	0x3c, 0xa2, 0x01, 0x53, 0xc6, 0x85, 0x3e, 0xa9, 0xa5, 0xba, 0xa9, 0x91, 0x6f, 0x65, 0xd0, 0x60,
+	0x66, 0x4b, 0xb2, 0x0b, 0x17, 0x05, 0xfc, 0x9a, 0xd7, 0x0e, 0x8e, 0xfa, 0x6c, 0x3b, 0xd6, 0xf8,
+	0x4e, 0x1b, 0xe1, 0x3b, 0x17, 0x5b, 0x23, 0x29, 0xf1, 0x01, 0x5c, 0xc8, 0x27, 0x61, 0x56, 0xbc,
+	0xa5, 0x0d, 0xbb, 0xcf, 0xd9, 0x8a, 0xc4, 0xb7, 0x27, 0x25, 0xdb, 0xd9, 0x65, 0x1d, 0x89, 0x26,
+	0x2d, 0x37, 0x5c, 0x1c, 0xb6, 0xd9, 0xbf, 0x6b, 0x7b, 0x37, 0x29, 0xed, 0xd0, 0x4e, 0x63, 0xd6,
+	0xb4, 0x7d, 0x6c, 0x99, 0x68, 0x4c, 0xd3, 0x33, 0xf3, 0x4e, 0x18, 0xd9, 0x41, 0x24, 0xe3, 0x55,
+	0x1a, 0x73, 0x22, 0x31, 0x50, 0x99, 0xbb, 0x35, 0x1c, 0x1a, 0x94, 0x99, 0xfb, 0xc5, 0xfc, 0xc3,
+	0xdb, 0x2f, 0xf2, 0xac, 0x56, 0xff, 0xa4, 0x08, 0x97, 0xaf, 0xd3, 0x68, 0xc3, 0xf7, 0x64, 0xc4,
+	0x51, 0xd6, 0xb6, 0x7f, 0xaa, 0x60, 0x1f, 0x73, 0xd3, 0x2e, 0x4e, 0x74, 0xd3, 0x2e, 0x4d, 0x68,
+	0xd3, 0x2e, 0x3f, 0xc4, 0x4d, 0xfb, 0xef, 0x17, 0xe1, 0x69, 0x63, 0x24, 0x59, 0x32, 0xb0, 0x5c,
+	0xf0, 0xdf, 0x1b, 0xc0, 0x53, 0x0c, 0xe0, 0x7d, 0xa1, 0x77, 0xf2, 0x48, 0xe4, 0x94, 0xc6, 0xf3,
+	0xf5, 0xb4, 0xc6, 0xf3, 0x7a, 0x9e, 0x9d, 0x2f, 0x43, 0xc2, 0xa9, 0x76, 0xbc, 0xd7, 0x80, 0x04,
+	0x32, 0x6e, 0x3a, 0x89, 0xba, 0x91, 0x4a, 0x8f, 0xca, 0x3c, 0xc6, 0x21, 0x0a, 0xcc, 0x68, 0x45,
+	0x5a, 0xf0, 0x64, 0x48, 0xbd, 0xc8, 0xf1, 0xa8, 0x6b, 0xb2, 0x13, 0xda, 0xd0, 0x73, 0x92, 0xdd,
+	0x93, 0xad, 0x2c, 0x22, 0xcc, 0x6e, 0x9b, 0x67, 0x1d, 0xf8, 0xe7, 0xc0, 0x55, 0x4e, 0x31, 0x34,
+	0x13, 0xd3, 0x58, 0xbe, 0x91, 0xd6, 0x58, 0xde, 0xc8, 0xff, 0xde, 0xc6, 0xd3, 0x56, 0xae, 0x02,
+	0xf0, 0xb7, 0xa0, 0xab, 0x2b, 0x6a, 0x93, 0x46, 0x85, 0x41, 0x8d, 0x8a, 0x6d, 0x40, 0xf1, 0x38,
+	0xeb, 0x9a, 0x8a, 0xda, 0x80, 0x5a, 0x3a, 0x12, 0x4d, 0xda, 0x91, 0xda, 0x4e, 0x65, 0x6c, 0x6d,
+	0xe7, 0x35, 0x20, 0x46, 0x4c, 0x82, 0xe0, 0x57, 0x35, 0x13, 0xdf, 0xd7, 0x86, 0x28, 0x30, 0xa3,
+	0xd5, 0x88, 0xa9, 0x3c, 0x35, 0xd9, 0xa9, 0x5c, 0x1b, 0x7f, 0x2a, 0x93, 0x37, 0xe0, 0x19, 0x2e,
+	0x4a, 0x8e, 0x8f, 0xc9, 0x58, 0xe8, 0x3d, 0x3f, 0x22, 0x19, 0x3f, 0x83, 0xa3, 0x08, 0x71, 0x34,
+	0x0f, 0xf6, 0x7e, 0xda, 0x01, 0xed, 0x30, 0xe1, 0xb6, 0x3b, 0x5a, 0x27, 0x5a, 0xce, 0xa0, 0xc1,
+	0xcc, 0x96, 0x6c, 0x8a, 0x45, 0x6c, 0x1a, 0xda, 0xbb, 0x2e, 0xed, 0xc8, 0xc4, 0x7f, 0x35, 0xc5,
+	0xb6, 0xd7, 0x5b, 0x12, 0x83, 0x1a, 0x55, 0x96, 0x9a, 0x32, 0x73, 0x46, 0x35, 0xe5, 0x3a, 0x0f,
+	0xe0, 0xd9, 0x33, 0xb4, 0xa1, 0xc6, 0xac, 0x59, 0xca, 0x61, 0x39, 0x4d, 0x80, 0xc3, 0x6d, 0xb8,
+	0x96, 0xd8, 0x0e, 0x9c, 0x7e, 0x14, 0x9a, 0xbc, 0xe6, 0x52, 0x5a, 0x62, 0x06, 0x0d, 0x66, 0xb6,
+	0x64, 0xfa, 0xf9, 0x3e, 0xb5, 0xdd, 0x68, 0xdf, 0x64, 0x38, 0x6f, 0xea, 0xe7, 0xaf, 0x0e, 0x93,
+	0x60, 0x56, 0xbb, 0xcc, 0x0d, 0xe9, 0xdc, 0xe3, 0xa9, 0x56, 0xfd, 0x8b, 0x12, 0x3c, 0x77, 0x9d,
+	0x8a, 0x5a, 0x0e, 0x5e, 0x77, 0xcb, 0xe9, 0x53, 0xd7, 0xf1, 0xa8, 0xd6, 0x23, 0x16, 0xae, 0x30,
+	0x23, 0xec, 0x22, 0xe2, 0x21, 0x73, 0x47, 0x8e, 0x65, 0xe4, 0x0b, 0x25, 0xca, 0xaa, 0xb0, 0xc6,
+	0x08, 0x28, 0x1a, 0x72, 0xdf, 0xb3, 0xc8, 0x9c, 0x46, 0x37, 0xf9, 0x5a, 0x09, 0x9e, 0x61, 0xef,
+	0x33, 0x4e, 0xa1, 0x7c, 0xcf, 0x2c, 0xf6, 0x0e, 0xbc, 0x84, 0x5f, 0xae, 0x30, 0x17, 0x48, 0x34,
+	0xa4, 0x5d, 0xff, 0x7f, 0x3a, 0xfc, 0x1b, 0x70, 0x21, 0x49, 0xe9, 0x65, 0x91, 0x55, 0x42, 0x37,
+	0x4b, 0x59, 0x3f, 0x5a, 0xc3, 0x24, 0x98, 0xd5, 0x8e, 0x7c, 0x01, 0x9e, 0x0e, 0xc5, 0x72, 0x25,
+	0xbc, 0x10, 0xc2, 0x38, 0xa4, 0x15, 0x06, 0x8a, 0xd3, 0x4c, 0x9e, 0x6e, 0x65, 0x93, 0xe1, 0xa8,
+	0xf6, 0xe4, 0xab, 0x30, 0xd3, 0x97, 0x4b, 0x20, 0x7b, 0x67, 0xb9, 0xf3, 0x67, 0xb6, 0x34, 0x66,
+	0xc9, 0x1a, 0xa7, 0x43, 0xd1, 0x10, 0x98, 0x39, 0x53, 0x6b, 0x0f, 0x71, 0xa6, 0x7e, 0x02, 0x66,
+	0xae, 0xbb, 0xfe, 0xae, 0xed, 0xca, 0x48, 0x81, 0x0f, 0xc3, 0x54, 0x14, 0x38, 0xdd, 0xae, 0xcc,
+	0x3a, 0xad, 0x27, 0x4e, 0xba, 0x6d, 0x01, 0xc6, 0x18, 0x6f, 0xfd, 0x4a, 0x09, 0xa6, 0xae, 0x07,
+	0xfe, 0xa0, 0xdf, 0x3c, 0x22, 0x5d, 0xa8, 0xde, 0xe5, 0x0c, 0x1a, 0x85, 0x9c, 0x15, 0x35, 0x44,
+	0x3f, 0x12, 0xed, 0x58, 0xfc, 0x46, 0xc9, 0x9e, 0xcd, 0xff, 0x03, 0x7a, 0x44, 0x3b, 0x32, 0xe2,
+	0x40, 0xcd, 0xff, 0x1b, 0x0c, 0x88, 0x02, 0x47, 0x7a, 0x30, 0x6f, 0xbb, 0xae, 0x7f, 0x97, 0x76,
+	0xd6, 0xed, 0x88, 0x47, 0x6a, 0x8f, 0x19, 0x31, 0xc8, 0xc3, 0xef, 0x97, 0x4c, 0x56, 0x98, 0xe6,
+	0x4d, 0xde, 0x4c, 0xa2, 0x2a, 0xcb, 0x39, 0xa3, 0x7f, 0xb6, 0x9a, 0x9f, 0x8b, 0x83, 0x2a, 0xb9,
+	0x53, 0x6b, 0x28, 0x98, 0xf2, 0x25, 0x98, 0x76, 0xed, 0x88, 0xae, 0xd8, 0x91, 0xbd, 0x6d, 0x77,
+	0x1b, 0x15, 0xf3, 0x78, 0xb3, 0x9e, 0xa0, 0x50, 0xa7, 0xb3, 0x0e, 0xa1, 0xce, 0x8a, 0xa6, 0x34,
+	0xed, 0xa8, 0xbd, 0xcf, 0xde, 0xb1, 0xd3, 0x11, 0x7e, 0xc4, 0xd4, 0x3b, 0x5e, 0x5b, 0xe1, 0x60,
+	0x8c, 0xf1, 0x19, 0x9e, 0xc7, 0xe2, 0x59, 0x3c, 0x8f, 0xd6, 0x77, 0xaa, 0x50, 0x8b, 0x6b, 0xb7,
+	0x90, 0xe7, 0xa0, 0x34, 0x08, 0x5c, 0x29, 0x53, 0xad, 0x12, 0x2c, 0x78, 0x85, 0xc1, 0x59, 0x4c,
+	0x4c, 0x8f, 0x46, 0xfb, 0x7e, 0x27, 0x1d, 0x13, 0xb3, 0xc1, 0xa1, 0x28, 0xb1, 0xe4, 0x08, 0xa6,
+	0x44, 0xcc, 0x59, 0x1c, 0x4b, 0x71, 0x33, 0x77, 0x59, 0x99, 0x45, 0x11, 0xcc, 0x26, 0x4f, 0x66,
+	0x6a, 0x38, 0x24, 0x14, 0x63, 0x79, 0xa4, 0x0b, 0x95, 0x5d, 0x36, 0x84, 0x8d, 0x72, 0xce, 0x68,
+	0x95, 0x58, 0x30, 0x7f, 0x21, 0xc2, 0xe1, 0xc8, 0xff, 0x45, 0xc1, 0x9f, 0x17, 0x4c, 0x8a, 0xd3,
+	0xc0, 0x73, 0x97, 0x17, 0x52, 0x09, 0xe5, 0xb2, 0x60, 0x52, 0xfc, 0x13, 0x13, 0x19, 0xe4, 0x4d,
+	0x38, 0xbf, 0x4b, 0xed, 0x80, 0x06, 0x3c, 0x51, 0x7f, 0x9c, 0x58, 0x04, 0x1e, 0x37, 0xdf, 0x4c,
+	0xf3, 0xc0, 0x61, 0xb6, 0xac, 0x78, 0x4d, 0xe4, 0xc6, 0xd9, 0x63, 0xe3, 0x17, 0xaf, 0xd9, 0x5e,
+	0x6f, 0x09, 0x97, 0xf3, 0xf6, 0x7a, 0x0b, 0x19, 0x47, 0x3d, 0xa9, 0xa2, 0x36, 0xb9, 0xa4, 0x0a,
+	0x6e, 0x6d, 0xf7, 0xbd, 0xf6, 0x20, 0x08, 0xa8, 0xd7, 0x3e, 0x4a, 0xdb, 0xb2, 0x97, 0x13, 0x14,
+	0xea, 0x74, 0x17, 0x5f, 0x81, 0x19, 0x7d, 0x5a, 0x9d, 0x49, 0x2b, 0xff, 0x53, 0x05, 0x98, 0x35,
+	0xe6, 0x08, 0xeb, 0x44, 0xcf, 0xbe, 0xb7, 0x41, 0xc3, 0xd0, 0xee, 0xaa, 0x90, 0xcd, 0xc4, 0xa0,
+	0x9e, 0xa0, 0x50, 0xa7, 0x23, 0x9f, 0x82, 0xea, 0x9e, 0x1f, 0xf4, 0xec, 0x48, 0x7e, 0x54, 0x1f,
+	0x8c, 0x3f, 0xaa, 0x55, 0x0e, 0xbd, 0xcf, 0x4e, 0x35, 0xba, 0x1c, 0x01, 0x46, 0xd9, 0xc8, 0xfa,
+	0x85, 0x12, 0x00, 0xc7, 0x0b, 0x77, 0x7f, 0x07, 0xca, 0x2c, 0x36, 0x26, 0x77, 0xb0, 0x96, 0x51,
+	0x56, 0x42, 0xc6, 0x4a, 0xb1, 0x09, 0xc9, 0xb9, 0xb3, 0xf5, 0x49, 0x9a, 0x66, 0xe4, 0x2a, 0xaf,
+	0x3e, 0x48, 0x79, 0x66, 0xc0, 0x18, 0xcf, 0xca, 0xc5, 0x88, 0x0f, 0x32, 0x6f, 0x51, 0x31, 0xb5,
+	0x3a, 0x66, 0x7c, 0x8c, 0x9f, 0x84, 0x59, 0xbb, 0x7d, 0xb0, 0xb4, 0x17, 0xd1, 0x80, 0x45, 0x11,
+	0x8b, 0x55, 0xbe, 0x96, 0x58, 0x57, 0x96, 0x74, 0x24, 0x9a, 0xb4, 0xe4, 0xcb, 0x00, 0x76, 0xfb,
+	0x40, 0xce, 0xa9, 0x31, 0xc3, 0x27, 0xb9, 0x0f, 0x7c, 0x49, 0x71, 0x41, 0x8d, 0xa3, 0xf5, 0xb7,
+	0x8b, 0x00, 0x6b, 0x1d, 0x57, 0x06, 0x81, 0x93, 0xd7, 0xa1, 0xae, 0xb2, 0xff, 0xc7, 0x0c, 0xf6,
+	0xe3, 0x8b, 0x84, 0x2a, 0x29, 0x80, 0x09, 0x3f, 0x9e, 0xb3, 0x10, 0xd1, 0x7e, 0xce, 0x94, 0x10,
+	0x91, 0xb3, 0xa0, 0xf1, 0x41, 0x83, 0x2b, 0xb1, 0x61, 0xda, 0xf1, 0xda, 0x42, 0x99, 0x69, 0x1e,
+	0x8d, 0xb9, 0x73, 0xf3, 0xd8, 0xeb, 0xb5, 0x84, 0x0d, 0xea, 0x3c, 0xad, 0x3f, 0x5b, 0x80, 0x79,
+	0x2e, 0x8f, 0x75, 0x43, 0x1c, 0x47, 0xd3, 0x81, 0xe0, 0x85, 0x47, 0x15, 0x08, 0x6e, 0xfd, 0x6e,
+	0x11, 0x9e, 0x4a, 0x75, 0x26, 0xce, 0x82, 0xf9, 0x13, 0x43, 0x25, 0x53, 0xff, 0xd8, 0xe9, 0xc6,
+	0x41, 0x54, 0xdc, 0x64, 0x75, 0x51, 0x13, 0xc3, 0x4e, 0x02, 0xd3, 0xea, 0xa4, 0x0e, 0xa0, 0x1c,
+	0x32, 0x45, 0x57, 0xbc, 0xca, 0xd6, 0xd8, 0x8f, 0x9b, 0xfd, 0x00, 0x5c, 0xed, 0x55, 0xf1, 0x4f,
+	0xec, 0x17, 0x72, 0x71, 0xe4, 0x2b, 0x50, 0x0d, 0x23, 0x3b, 0x1a, 0xc4, 0x8a, 0xd9, 0xce, 0xa4,
+	0x05, 0x73, 0xe6, 0x89, 0x0a, 0x21, 0x7e, 0xa3, 0x14, 0x6a, 0xfd, 0x6e, 0x01, 0x2e, 0x66, 0x37,
+	0x5c, 0x77, 0xc2, 0x88, 0xfc, 0xf1, 0xa1, 0x61, 0x3f, 0xe5, 0xf4, 0x63, 0xad, 0xf9, 0xa0, 0xab,
+	0x68, 0xae, 0x18, 0xa2, 0x0d, 0x79, 0x04, 0x15, 0x27, 0xa2, 0xbd, 0xd8, 0xd0, 0xbc, 0x39, 0xe1,
+	0x47, 0xd7, 0xce, 0x84, 0x4c, 0x0a, 0x0a, 0x61, 0xd6, 0xff, 0x28, 0x8e, 0x7a, 0x64, 0x7e, 0xee,
+	0x70, 0xcd, 0x7a, 0x2d, 0x37, 0xf2, 0xd5, 0x6b, 0x31, 0x3b, 0x34, 0x5c, 0xb6, 0xe5, 0xa7, 0x86,
+	0xcb, 0xb6, 0x6c, 0xe6, 0x2f, 0xdb, 0x92, 0x1a, 0x86, 0x91, 0xd5, 0x5b, 0xf6, 0x8d, 0xea, 0x2d,
+	0xaf, 0xe5, 0x4a, 0x81, 0x32, 0x65, 0xa6, 0x8b, 0xb8, 0xfc, 0xa0, 0x04, 0xcf, 0x3e, 0x68, 0x82,
+	0xb2, 0x73, 0x93, 0xfc, 0x0e, 0xf2, 0x9e, 0x9b, 0x1e, 0x3c, 0xe3, 0xc9, 0x55, 0xa8, 0xf4, 0xf7,
+	0xed, 0x30, 0xb6, 0x1b, 0x3c, 0xab, 0x12, 0xb0, 0x19, 0xf0, 0x3e, 0x5b, 0x2b, 0xb9, 0xbd, 0x81,
+	0xff, 0x44, 0x41, 0xca, 0xf6, 0xe1, 0x9e, 0xd0, 0x23, 0xa4, 0x0d, 0x41, 0xed, 0xc3, 0x52, 0xbd,
+	0xc0, 0x18, 0x4f, 0x22, 0xa8, 0x0a, 0xaf, 0x76, 0xa3, 0xfc, 0x10, 0x8c, 0x83, 0xea, 0xa1, 0xc4,
+	0x6f, 0x94, 0xb2, 0xc8, 0xa2, 0x2c, 0x2e, 0x51, 0x31, 0x3c, 0x0b, 0xe5, 0x0c, 0x13, 0x0a, 0xa7,
+	0x63, 0xbe, 0x04, 0x7f, 0x97, 0xfb, 0xf1, 0x3b, 0x32, 0xd4, 0x8c, 0xad, 0xf4, 0x55, 0x1e, 0xbc,
+	0x18, 0xb7, 0x26, 0x9b, 0x43, 0x14, 0x98, 0xd1, 0xca, 0xfa, 0x57, 0x35, 0x78, 0x2a, 0x7b, 0xe6,
+	0xb1, 0x71, 0x3b, 0xa4, 0x81, 0xda, 0x45, 0xb4, 0x71, 0xbb, 0x25, 0xc0, 0x18, 0xe3, 0xdf, 0xd5,
+	0xe9, 0xaf, 0xbf, 0x5c, 0x60, 0x9e, 0x0f, 0x11, 0x96, 0xf2, 0x28, 0x52, 0x60, 0x9f, 0x13, 0x1e,
+	0x94, 0x11, 0x02, 0x71, 0x74, 0x5f, 0xc8, 0x5f, 0x2f, 0x40, 0xa3, 0x97, 0x72, 0xad, 0x3c, 0xc4,
+	0xda, 0x96, 0xbc, 0xca, 0xcd, 0xc6, 0x08, 0x79, 0x38, 0xb2, 0x27, 0xe4, 0xab, 0x30, 0xad, 0xf2,
+	0xf4, 0xda, 0x71, 0xea, 0xfc, 0xf8, 0x5f, 0xd2, 0x56, 0xc2, 0x4b, 0xd5, 0xb6, 0xe3, 0x9a, 0x88,
+	0x86, 0x40, 0x5d, 0xe2, 0x63, 0x5e, 0xcc, 0xf2, 0x79, 0xa8, 0x85, 0x34, 0x62, 0x49, 0x7c, 0xa1,
+	0x1e, 0x03, 0xdb, 0x92, 0x30, 0x54, 0x58, 0x16, 0x5a, 0xcb, 0xa3, 0x5c, 0x58, 0x2a, 0x44, 0xa3,
+	0xce, 0xf3, 0x31, 0x66, 0x45, 0x5a, 0x8a, 0x04, 0x62, 0x82, 0x27, 0x1f, 0x85, 0x99, 0x5d, 0xfe,
+	0xf9, 0x4a, 0xef, 0x86, 0x70, 0xab, 0x71, 0x25, 0xb5, 0xa9, 0xc1, 0xd1, 0xa0, 0xe2, 0x09, 0x25,
+	0x2a, 0x14, 0x28, 0xed, 0x42, 0x4b, 0x82, 0x84, 0x50, 0xa3, 0x22, 0xcf, 0x89, 0x73, 0xef, 0x0c,
+	0x27, 0x56, 0xf6, 0x8f, 0xf8, 0xf4, 0x6a, 0xfd, 0x41, 0x01, 0xe6, 0x53, 0x25, 0xc8, 0xde, 0xce,
+	0x64, 0xf2, 0x86, 0x3c, 0x8f, 0x15, 0x73, 0x96, 0xd7, 0x65, 0x51, 0x70, 0xdc, 0x40, 0x90, 0x3e,
+	0x8a, 0xf1, 0xc8, 0xa2, 0xa4, 0x3f, 0x72, 0x1f, 0xd0, 0x22, 0x8b, 0x12, 0x1c, 0x1a, 0x94, 0x29,
+	0x1f, 0x63, 0xf9, 0x34, 0x3e, 0x46, 0xeb, 0xbb, 0x55, 0x6d, 0x04, 0xe4, 0x81, 0xe6, 0xed, 0x8d,
+	0x46, 0x9a, 0x1a, 0x51, 0xd7, 0xf7, 0x3f, 0x06, 0x45, 0x89, 0x8d, 0x6d, 0x0e, 0xa5, 0x89, 0xdb,
+	0x1c, 0xe2, 0x57, 0x50, 0x7e, 0x58, 0xaf, 0xe0, 0x15, 0x98, 0xdb, 0x73, 0x5c, 0xa6, 0x43, 0x0c,
+	0xb8, 0x02, 0x2f, 0xca, 0xed, 0xd7, 0x45, 0x72, 0xc9, 0xaa, 0x81, 0xc1, 0x14, 0x25, 0xd9, 0x81,
+	0xd9, 0x0e, 0x75, 0x9d, 0x43, 0x1a, 0x08, 0x23, 0xbe, 0xb4, 0x8f, 0x5f, 0x89, 0x4f, 0xae, 0x2b,
+	0x3a, 0xf2, 0xfe, 0xf1, 0x42, 0xb2, 0xa3, 0x19, 0x18, 0x34, 0xb9, 0x90, 0xdb, 0xf2, 0xfb, 0xe2,
+	0x89, 0x04, 0x62, 0x71, 0xf8, 0x23, 0xa7, 0x53, 0x90, 0x59, 0x0b, 0xed, 0x5b, 0x64, 0x3f, 0x31,
+	0xe1, 0xc5, 0xe3, 0x18, 0xd8, 0x8f, 0x16, 0xbd, 0x33, 0xe0, 0x6b, 0x60, 0x8d, 0x07, 0xe4, 0x27,
+	0x71, 0x0c, 0x3a, 0x12, 0x4d, 0x5a, 0xd6, 0xb8, 0x67, 0xdf, 0x5b, 0x6a, 0x1f, 0x6c, 0x51, 0x8f,
+	0xe7, 0x49, 0xd6, 0xf9, 0xc6, 0xae, 0x1a, 0x6f, 0xe8, 0x48, 0x34, 0x69, 0x99, 0xe9, 0xc8, 0x6e,
+	0x1f, 0xdc, 0xb6, 0x9d, 0xa8, 0x01, 0x67, 0xd1, 0xf8, 0x4d, 0xd3, 0xd1, 0x92, 0x60, 0x81, 0x31,
+	0x2f, 0x16, 0x7a, 0x4a, 0xfb, 0xfb, 0xb4, 0x47, 0x03, 0xdb, 0x95, 0xab, 0x84, 0x0a, 0x3d, 0xbd,
+	0x16, 0x23, 0x30, 0xa1, 0x61, 0x0f, 0xc1, 0xd2, 0x3e, 0x02, 0xbf, 0x27, 0x6c, 0x47, 0xe9, 0x50,
+	0xc2, 0x1b, 0x3a, 0x12, 0x4d, 0x5a, 0xeb, 0x37, 0x4b, 0x30, 0xfd, 0x9a, 0xbf, 0xfb, 0x2e, 0x29,
+	0xfd, 0x91, 0xad, 0xd1, 0x14, 0xdf, 0x41, 0x8d, 0x66, 0x07, 0x9e, 0x8e, 0x22, 0x16, 0x28, 0xe1,
+	0x7b, 0x9d, 0x90, 0xdb, 0x70, 0x56, 0x1d, 0xcf, 0x09, 0xf7, 0x69, 0x47, 0x06, 0x3b, 0xbd, 0x9f,
+	0x39, 0x95, 0xb6, 0xb7, 0xd7, 0xb3, 0x48, 0x70, 0x54, 0x5b, 0xbe, 0xc3, 0x88, 0x6a, 0xa7, 0xbc,
+	0x48, 0x9a, 0x8c, 0x08, 0x17, 0x3b, 0x8c, 0x06, 0x47, 0x83, 0xca, 0xfa, 0xcd, 0x2a, 0xd4, 0x55,
+	0x4d, 0x7d, 0x96, 0xf3, 0xb2, 0x1b, 0xf8, 0x07, 0x34, 0x10, 0x71, 0x65, 0xb2, 0x40, 0x59, 0x53,
+	0x80, 0x30, 0xc6, 0x31, 0xf7, 0x48, 0xe4, 0xf7, 0x9d, 0x76, 0xda, 0x3d, 0xb8, 0xcd, 0x80, 0x28,
+	0x70, 0x7c, 0xcd, 0xe4, 0xb6, 0x5b, 0x99, 0xa0, 0x94, 0xac, 0x99, 0x1c, 0x8a, 0x12, 0x1b, 0xaf,
+	0x99, 0xe5, 0x89, 0xaf, 0x99, 0x1f, 0x52, 0xa7, 0x85, 0x8a, 0xb9, 0x68, 0xa7, 0xf4, 0x7b, 0x56,
+	0x42, 0xdd, 0x0e, 0xdd, 0x46, 0x35, 0x67, 0xfd, 0xc3, 0xd6, 0x52, 0x6b, 0x5d, 0x96, 0x50, 0x5f,
+	0x6a, 0xad, 0x23, 0x67, 0x4a, 0xd6, 0x60, 0x5a, 0x25, 0x00, 0xd3, 0x40, 0x66, 0xe0, 0xfc, 0x58,
+	0x6c, 0x50, 0xdd, 0x4a, 0x50, 0xf7, 0x8f, 0x17, 0xce, 0xf1, 0x17, 0xa1, 0xc1, 0x50, 0x6f, 0x6b,
+	0xe4, 0x1e, 0xcb, 0xcf, 0xb6, 0x96, 0x8a, 0x8d, 0x31, 0xd1, 0x98, 0xa6, 0x67, 0x8e, 0x96, 0x3d,
+	0x91, 0x1b, 0xfb, 0xaa, 0xf4, 0x6d, 0xd4, 0xf9, 0xbb, 0x51, 0x8e, 0x96, 0x55, 0x03, 0x8b, 0x29,
+	0x6a, 0xbe, 0x51, 0x53, 0xbe, 0x88, 0x86, 0x91, 0xdd, 0xeb, 0xf3, 0x45, 0xac, 0xa6, 0x6d, 0xd4,
+	0x1a, 0x0e, 0x0d, 0x4a, 0xb6, 0x51, 0x3b, 0x1d, 0xda, 0xeb, 0xfb, 0x11, 0xcb, 0xcc, 0x4b, 0x69,
+	0x32, 0x6b, 0x0a, 0x83, 0x1a, 0x95, 0xb0, 0x88, 0x27, 0xb6, 0xb2, 0x19, 0xd3, 0x0b, 0x35, 0xb2,
+	0xe4, 0x81, 0xa8, 0x04, 0x22, 0x0f, 0x8f, 0x22, 0x13, 0x5d, 0x14, 0xb4, 0xd6, 0x2b, 0x81, 0xe8,
+	0x68, 0x4c, 0xd3, 0x33, 0xc9, 0xf4, 0x9e, 0xdd, 0x8e, 0xdc, 0xa3, 0x4d, 0xaf, 0x2d, 0x22, 0x7e,
+	0x6a, 0x89, 0xe4, 0x6b, 0x09, 0x0a, 0x75, 0x3a, 0xeb, 0x1f, 0x57, 0x60, 0x5a, 0x7c, 0x4c, 0x42,
+	0xab, 0x98, 0xe4, 0xe7, 0xf4, 0x19, 0x1e, 0xfd, 0x1d, 0x0e, 0x7a, 0x34, 0xe0, 0xee, 0xd0, 0x46,
+	0x69, 0x28, 0xa4, 0x29, 0x41, 0xaa, 0x08, 0xf0, 0x04, 0xf4, 0x87, 0xfc, 0x3b, 0x7b, 0x19, 0x66,
+	0xf8, 0x25, 0x20, 0xf2, 0xec, 0x2b, 0x3f, 0x34, 0x35, 0x33, 0x6f, 0x68, 0x38, 0x34, 0x28, 0xc9,
+	0x9f, 0x2c, 0x48, 0x75, 0x60, 0xcb, 0x0f, 0xf9, 0xb7, 0xd2, 0xa8, 0xe5, 0x34, 0x4e, 0x89, 0x29,
+	0xa0, 0xb3, 0x14, 0x05, 0x8a, 0x0c, 0x10, 0x9a, 0x42, 0xd9, 0x1e, 0x7e, 0x40, 0x8f, 0xe4, 0x77,
+	0x5d, 0x37, 0xd3, 0x47, 0x6e, 0xc4, 0x08, 0x4c, 0x68, 0xc8, 0x17, 0xb4, 0x6a, 0x06, 0x62, 0xbe,
+	0x35, 0xc0, 0xd0, 0xbb, 0xe6, 0xaf, 0x99, 0xe8, 0xfb, 0x2c, 0xc1, 0x96, 0x75, 0x2d, 0x05, 0xc7,
+	0x34, 0x1f, 0xeb, 0x57, 0x0a, 0x40, 0x86, 0x1f, 0x82, 0xbc, 0x02, 0xd5, 0xbe, 0x50, 0xf0, 0x0a,
+	0x46, 0x42, 0x43, 0x55, 0x69, 0x76, 0xe7, 0xf4, 0x56, 0x0c, 0x86, 0xd5, 0xbe, 0x52, 0xe6, 0x22,
+	0xb5, 0x6c, 0x14, 0xc7, 0x53, 0xe6, 0x92, 0xb5, 0x25, 0xe1, 0x65, 0xfd, 0x5e, 0x11, 0xea, 0xeb,
+	0xce, 0x1e, 0x6d, 0x1f, 0xb5, 0x5d, 0xe6, 0x07, 0xb9, 0xd8, 0xa1, 0x2e, 0x65, 0xdd, 0xbd, 0x1e,
+	0xd8, 0x6d, 0xba, 0x45, 0x03, 0xc7, 0xef, 0xc8, 0xfd, 0x52, 0x26, 0x90, 0x5e, 0x62, 0x39, 0x18,
+	0x2b, 0x23, 0xa9, 0xf0, 0x01, 0x1c, 0xc8, 0x1a, 0xcc, 0x74, 0x68, 0xe8, 0x04, 0xb4, 0xb3, 0xa5,
+	0xd9, 0xb9, 0x62, 0x77, 0xd7, 0xcc, 0x8a, 0x86, 0xbb, 0x7f, 0xbc, 0x30, 0x1b, 0x87, 0x60, 0x70,
+	0x00, 0x1a, 0x4d, 0x99, 0x1a, 0xd0, 0xb7, 0x07, 0x21, 0xcd, 0xe8, 0x67, 0x89, 0xf7, 0x93, 0xab,
+	0x01, 0x5b, 0xd9, 0x24, 0x38, 0xaa, 0x2d, 0xd9, 0x85, 0x06, 0xef, 0x7f, 0x16, 0x5f, 0x51, 0x90,
+	0xe3, 0x43, 0x27, 0xc7, 0x0b, 0xd6, 0x0a, 0xed, 0x07, 0xb4, 0x6d, 0x47, 0xb4, 0xb3, 0x32, 0x82,
+	0x1a, 0x47, 0xf2, 0xb1, 0x7e, 0xa3, 0x08, 0xec, 0x6a, 0x1f, 0xf2, 0xa2, 0x72, 0xfb, 0x15, 0x8c,
+	0x20, 0x9b, 0xc4, 0xed, 0x57, 0x5f, 0xf7, 0xbb, 0xa6, 0xb3, 0x8f, 0xdc, 0x66, 0xdb, 0x18, 0xaf,
+	0xa3, 0x1a, 0x97, 0x10, 0x91, 0xa3, 0xf8, 0x91, 0x64, 0x1b, 0x33, 0xd0, 0xf7, 0x8f, 0x17, 0xc8,
+	0xba, 0xdf, 0x4d, 0x41, 0x31, 0xcd, 0x85, 0x78, 0x50, 0x0b, 0xed, 0x5e, 0xdf, 0x8d, 0x8b, 0x97,
+	0xe4, 0xf1, 0xab, 0xac, 0xfb, 0xdd, 0x96, 0xe4, 0x25, 0xcf, 0xff, 0xf2, 0x17, 0x2a, 0x19, 0x72,
+	0x9f, 0x31, 0xea, 0xc7, 0x96, 0x87, 0xf6, 0x99, 0x54, 0x0d, 0x59, 0x13, 0x60, 0xed, 0xc1, 0xb4,
+	0x26, 0x89, 0xad, 0xa4, 0xf4, 0x90, 0x06, 0x47, 0x37, 0xa5, 0xe3, 0x55, 0xad, 0xa4, 0xd7, 0x38,
+	0x14, 0x25, 0x96, 0xad, 0x15, 0x7d, 0x1a, 0x88, 0xb7, 0x21, 0x0d, 0x7a, 0x6a, 0xad, 0xd8, 0x8a,
+	0x11, 0x98, 0xd0, 0x58, 0xdf, 0x2c, 0x81, 0xba, 0xbe, 0x8e, 0xb0, 0x4a, 0x82, 0xb6, 0xe7, 0xf9,
+	0x91, 0xbc, 0x1a, 0x4e, 0x64, 0x10, 0x60, 0xee, 0x5b, 0xf2, 0x16, 0x97, 0x12, 0xa6, 0x22, 0xc4,
+	0x41, 0xed, 0x98, 0x1a, 0x06, 0x75, 0xd9, 0xac, 0x60, 0x85, 0x11, 0x0f, 0xbf, 0x91, 0xbf, 0x17,
+	0xa7, 0x88, 0x7e, 0xbf, 0xf8, 0x69, 0x38, 0x97, 0xee, 0xec, 0x59, 0x1c, 0xe7, 0xb9, 0x12, 0x0b,
+	0x8a, 0x00, 0x49, 0x4e, 0xcc, 0x23, 0xf0, 0xbd, 0x39, 0x86, 0xef, 0x6d, 0xfc, 0xfb, 0x2b, 0x92,
+	0x4e, 0x8f, 0xf4, 0xb7, 0xdd, 0x49, 0xf9, 0xdb, 0xd6, 0x26, 0x21, 0xec, 0xc1, 0x3e, 0xb6, 0x5d,
+	0xb8, 0x90, 0xd0, 0x26, 0xfb, 0xc0, 0x8d, 0xd4, 0x3a, 0x5d, 0x30, 0xf4, 0xee, 0xf4, 0x3a, 0x3d,
+	0x9f, 0xb0, 0xc8, 0x58, 0xa9, 0xad, 0x5f, 0x2b, 0xc0, 0x39, 0x5d, 0x08, 0x2f, 0xd1, 0xfd, 0x71,
+	0x56, 0xb9, 0xd0, 0xee, 0x70, 0x17, 0x3e, 0x2f, 0x12, 0x20, 0x2a, 0xe5, 0xcb, 0x4a, 0x84, 0x1a,
+	0x02, 0x4d, 0x3a, 0xe6, 0x78, 0x66, 0x80, 0xed, 0x5c, 0x75, 0x39, 0xb9, 0x85, 0x15, 0x13, 0x36,
+	0xa8, 0xf3, 0xb4, 0x7e, 0x50, 0x80, 0x39, 0xbd, 0xc3, 0x0f, 0xdd, 0xd9, 0xb8, 0x6f, 0x3a, 0x1b,
+	0x97, 0x27, 0xf0, 0xde, 0x47, 0x38, 0x18, 0xbf, 0x36, 0xad, 0x3f, 0x1a, 0x77, 0x2a, 0xea, 0xde,
+	0x8d, 0xc2, 0x03, 0xbd, 0x1b, 0xef, 0xfe, 0x1b, 0xb9, 0x46, 0xd9, 0x5a, 0xca, 0x8f, 0xb1, 0xad,
+	0xe5, 0x9d, 0xbc, 0xd6, 0x4b, 0xbb, 0x9a, 0xaa, 0x9a, 0xe3, 0x6a, 0xaa, 0x9e, 0xba, 0x9a, 0x6a,
+	0x6a, 0x62, 0x0b, 0xdb, 0x69, 0xae, 0xa7, 0xaa, 0x3d, 0xd2, 0xeb, 0xa9, 0xea, 0x0f, 0xeb, 0x7a,
+	0x2a, 0xc8, 0x7b, 0x3d, 0xd5, 0xd7, 0x0b, 0x30, 0xd7, 0x31, 0x0a, 0x0e, 0x37, 0xa6, 0x73, 0x6e,
+	0x67, 0x66, 0xfd, 0x62, 0x61, 0xe7, 0x36, 0x61, 0x98, 0x12, 0x99, 0x75, 0x29, 0xd4, 0xcc, 0x3b,
+	0x73, 0x29, 0xd4, 0x57, 0xa0, 0xee, 0xc6, 0x7b, 0x5d, 0x63, 0x36, 0xe7, 0xb7, 0x9f, 0xb1, 0x7f,
+	0x26, 0xea, 0xa4, 0x02, 0x61, 0x22, 0xd1, 0xfa, 0xdf, 0x53, 0xfa, 0x86, 0xf8, 0xa8, 0x83, 0x0c,
+	0x3e, 0x66, 0x06, 0x19, 0x5c, 0x4e, 0x07, 0x19, 0x0c, 0xed, 0xe6, 0x82, 0x9c, 0x55, 0x43, 0x52,
+	0xfb, 0x44, 0x89, 0x57, 0x26, 0x56, 0x53, 0x2e, 0x63, 0xaf, 0x58, 0x82, 0x79, 0xa9, 0x04, 0xc4,
+	0x48, 0xbe, 0xc8, 0xce, 0x26, 0xda, 0xfd, 0x8a, 0x89, 0xc6, 0x34, 0x3d, 0x13, 0x18, 0xc6, 0x17,
+	0x43, 0x57, 0xcc, 0xf2, 0x4b, 0xea, 0xd2, 0x66, 0x45, 0x21, 0x8a, 0xf5, 0xd9, 0xa1, 0x0c, 0x15,
+	0x30, 0x8a, 0xf5, 0x31, 0x28, 0x4a, 0xac, 0x1e, 0x2f, 0x31, 0xf5, 0x36, 0xf1, 0x12, 0x36, 0x0b,
+	0xe3, 0x0e, 0x23, 0x31, 0x99, 0x3a, 0x8d, 0xda, 0x99, 0x8f, 0xdd, 0x5a, 0xc8, 0xb7, 0x62, 0x83,
+	0x3a, 0x4f, 0x16, 0xac, 0xc7, 0x7e, 0xf2, 0x95, 0xa5, 0xb3, 0x14, 0x35, 0xea, 0x67, 0x96, 0xa1,
+	0x6c, 0x34, 0xeb, 0x1a, 0x1f, 0x34, 0xb8, 0x8e, 0x08, 0xa9, 0x80, 0x71, 0x42, 0x2a, 0x98, 0xef,
+	0x83, 0xe9, 0x4a, 0x47, 0xea, 0xb5, 0x4e, 0xf3, 0xd7, 0xaa, 0x7c, 0x1f, 0xa8, 0x23, 0xd1, 0xa4,
+	0x65, 0xb3, 0x62, 0x20, 0x87, 0x21, 0x6e, 0x3e, 0x63, 0xce, 0x8a, 0x1d, 0x13, 0x8d, 0x69, 0x7a,
+	0x96, 0x56, 0xa8, 0x40, 0x7a, 0x37, 0x66, 0x39, 0x1f, 0x95, 0x56, 0xb8, 0x93, 0x41, 0x83, 0x99,
+	0x2d, 0xb9, 0x9d, 0x94, 0x87, 0x03, 0x47, 0xaf, 0xda, 0xe1, 0xbe, 0xcc, 0x4f, 0x4c, 0xec, 0xa4,
+	0x09, 0x0a, 0x75, 0x3a, 0x66, 0x92, 0x15, 0xec, 0x78, 0xab, 0x79, 0x33, 0x05, 0x78, 0x47, 0x61,
+	0x50, 0xa3, 0xb2, 0xbe, 0x5e, 0x87, 0xe9, 0x9b, 0x76, 0xe4, 0x1c, 0x52, 0x1e, 0x69, 0xf5, 0x70,
+	0x82, 0x50, 0x7e, 0xbe, 0x00, 0x4f, 0x99, 0x79, 0xb5, 0x0f, 0x31, 0x12, 0x85, 0x5f, 0x81, 0x83,
+	0x99, 0xd2, 0x70, 0x44, 0x2f, 0x78, 0x4c, 0xca, 0x50, 0x9a, 0xee, 0xc3, 0x8e, 0x49, 0x69, 0x8d,
+	0x12, 0x88, 0xa3, 0xfb, 0xf2, 0x6e, 0x89, 0x49, 0x79, 0xbc, 0x6f, 0x5f, 0x4d, 0x45, 0xcc, 0x4c,
+	0x3d, 0x36, 0x11, 0x33, 0xb5, 0xc7, 0x42, 0xeb, 0xef, 0x6b, 0x11, 0x33, 0xf5, 0x9c, 0x21, 0xfb,
+	0xb2, 0x14, 0x85, 0xe0, 0x36, 0x2a, 0xf2, 0x86, 0xd7, 0x4c, 0x8d, 0x23, 0x19, 0x44, 0x70, 0x7e,
+	0xe8, 0xb4, 0x1b, 0x85, 0x9c, 0xc1, 0xf9, 0x49, 0x02, 0x8b, 0x0c, 0xce, 0x0f, 0x99, 0xf7, 0x85,
+	0xf3, 0x4e, 0xee, 0xa4, 0x2c, 0xe6, 0xba, 0x93, 0x92, 0xdd, 0xb5, 0xe8, 0x1d, 0xd0, 0xa3, 0xb3,
+	0x55, 0x1f, 0xe5, 0x87, 0xc0, 0x9b, 0xcc, 0x67, 0xca, 0x1b, 0x5b, 0xbf, 0x5f, 0x02, 0x60, 0x8f,
+	0x7f, 0xba, 0xd8, 0x15, 0x96, 0xe7, 0x20, 0x22, 0x35, 0x1a, 0x45, 0x73, 0x89, 0x96, 0x01, 0x1c,
+	0x18, 0xe3, 0x99, 0x23, 0xea, 0xce, 0x80, 0x0e, 0xe2, 0x40, 0x4c, 0x75, 0x6e, 0xf8, 0x1c, 0x03,
+	0xa2, 0xc0, 0x3d, 0x3c, 0x3f, 0x52, 0x1c, 0xe3, 0x52, 0x79, 0x58, 0x31, 0x2e, 0x43, 0x51, 0x0f,
+	0xd5, 0xd3, 0x47, 0x3d, 0xb0, 0xec, 0xd2, 0x24, 0xeb, 0x2c, 0x61, 0x31, 0x65, 0x66, 0x97, 0x5e,
+	0x1b, 0x26, 0xc1, 0xac, 0x76, 0xcc, 0x5f, 0xe5, 0x1f, 0xd2, 0x80, 0xf5, 0x9c, 0x5b, 0x8f, 0x44,
+	0x6d, 0x08, 0xa5, 0x0b, 0x6d, 0x6a, 0x38, 0x34, 0x28, 0xad, 0x3a, 0x4c, 0xdd, 0xf4, 0x79, 0x9a,
+	0xaa, 0xf5, 0xdb, 0x45, 0x80, 0x24, 0x97, 0x8f, 0xfc, 0xd5, 0x91, 0x15, 0xff, 0x0b, 0x0f, 0x61,
+	0xc9, 0x3a, 0xf3, 0x45, 0x00, 0xec, 0x96, 0x03, 0xda, 0xeb, 0x47, 0x47, 0x2b, 0x4e, 0xd0, 0x28,
+	0x8e, 0xce, 0x36, 0xbd, 0x26, 0x69, 0x86, 0x6f, 0x39, 0x88, 0x31, 0xa8, 0xf8, 0x90, 0x7d, 0xa8,
+	0x79, 0xfe, 0x1b, 0x2c, 0x6f, 0x31, 0x56, 0x0e, 0xc6, 0xbf, 0xbd, 0x5e, 0x0e, 0xab, 0xf0, 0xd2,
+	0xca, 0x1f, 0x38, 0xe5, 0xc9, 0xc1, 0xfe, 0xb9, 0x22, 0x5c, 0xc8, 0x18, 0x07, 0xf2, 0x59, 0x38,
+	0x27, 0xd3, 0x26, 0x97, 0x5d, 0x3b, 0x0c, 0xb5, 0x7a, 0x30, 0xfc, 0xb6, 0xbf, 0x56, 0x0a, 0x87,
+	0x43, 0xd4, 0xe4, 0x0d, 0x96, 0xbc, 0xd3, 0xa6, 0x61, 0xb8, 0xe1, 0x77, 0xe2, 0x53, 0xcd, 0x67,
+	0x44, 0x32, 0x4e, 0x0c, 0xbd, 0x7f, 0xbc, 0xf0, 0x91, 0xac, 0x24, 0xea, 0xd4, 0x38, 0x27, 0x0d,
+	0x50, 0x63, 0xc9, 0xb2, 0x83, 0x84, 0x25, 0x43, 0x55, 0x33, 0x3d, 0xfb, 0x6d, 0x23, 0x73, 0x49,
+	0x25, 0x5a, 0x3e, 0x29, 0x35, 0x8e, 0xcc, 0x1f, 0x54, 0x8b, 0x5d, 0x5d, 0x8f, 0xc0, 0xa2, 0xdd,
+	0x35, 0x2c, 0xda, 0x13, 0x4a, 0x9b, 0xce, 0xb2, 0x67, 0xfb, 0x29, 0x7b, 0xf6, 0xf5, 0xfc, 0xa2,
+	0x1e, 0x6c, 0xcd, 0xfe, 0x6b, 0x25, 0x98, 0x8b, 0x49, 0xf3, 0xda, 0x99, 0x3f, 0x05, 0xf3, 0x22,
+	0x96, 0x74, 0xc3, 0xbe, 0x27, 0x8a, 0xaa, 0xf3, 0x01, 0x2b, 0x8b, 0x74, 0xe3, 0xa6, 0x89, 0xc2,
+	0x34, 0x2d, 0x9b, 0xd6, 0x02, 0xb4, 0xc3, 0x8e, 0x92, 0xbc, 0x33, 0xf2, 0xd4, 0xcc, 0xa7, 0x75,
+	0x33, 0x85, 0xc3, 0x21, 0xea, 0xb4, 0xa1, 0xbb, 0x3c, 0x79, 0x43, 0x37, 0xbb, 0x1c, 0xbb, 0xad,
+	0xae, 0x62, 0x95, 0x1b, 0xc7, 0xf2, 0x04, 0xee, 0x9e, 0x95, 0x97, 0x81, 0xa8, 0xdf, 0xa8, 0x89,
+	0xb1, 0x7e, 0xab, 0x00, 0x33, 0xc9, 0x4b, 0x7a, 0xe8, 0xb6, 0xf5, 0x3d, 0xd3, 0xb6, 0xbe, 0x94,
+	0x7b, 0x0e, 0x8e, 0xb0, 0xac, 0x7f, 0xab, 0x06, 0x46, 0xc9, 0x00, 0x56, 0xd3, 0xd0, 0xc9, 0xcc,
+	0x2a, 0xd1, 0x96, 0x38, 0x55, 0xd3, 0x70, 0x6d, 0x24, 0x25, 0x3e, 0x80, 0x0b, 0x19, 0x40, 0xed,
+	0x90, 0x06, 0x91, 0xd3, 0xa6, 0xf1, 0xf3, 0x5d, 0xcf, 0xad, 0xcd, 0x4a, 0xff, 0x81, 0x1a, 0xd3,
+	0x5b, 0x52, 0x00, 0x2a, 0x51, 0x64, 0x17, 0x2a, 0xb4, 0xd3, 0xa5, 0x71, 0x6a, 0x77, 0xce, 0x3b,
+	0x6f, 0xd5, 0x78, 0xb2, 0x5f, 0x21, 0x0a, 0xd6, 0x24, 0xd4, 0x6d, 0x74, 0xe5, 0x9c, 0xba, 0xe9,
+	0x29, 0x2d, 0x73, 0xe4, 0x40, 0x19, 0xaa, 0x2b, 0x13, 0x5a, 0xb1, 0x1e, 0x60, 0xa6, 0x0e, 0xa1,
+	0x7e, 0xd7, 0x8e, 0x68, 0xd0, 0xb3, 0x83, 0x83, 0x46, 0x35, 0xe7, 0x13, 0xde, 0x8e, 0x39, 0x25,
+	0x4f, 0xa8, 0x40, 0x98, 0xc8, 0x61, 0x39, 0xeb, 0x71, 0x15, 0xea, 0xd8, 0x1a, 0x3f, 0xbe, 0xd0,
+	0xf8, 0x0c, 0x13, 0xca, 0x00, 0x93, 0xf8, 0x27, 0x26, 0x32, 0xc8, 0x21, 0x40, 0x52, 0x1e, 0xa4,
+	0x51, 0xbb, 0x5c, 0xca, 0x25, 0x51, 0x95, 0x1f, 0x49, 0xf6, 0x38, 0x05, 0x0a, 0x51, 0x93, 0xc4,
+	0xac, 0xcd, 0xf3, 0xa9, 0x2f, 0x27, 0x77, 0xb1, 0xed, 0xd4, 0x57, 0x2a, 0xb6, 0x82, 0x14, 0x10,
+	0xd3, 0x52, 0xad, 0xff, 0x59, 0x49, 0x76, 0xa5, 0x47, 0x6d, 0xec, 0xfd, 0xa8, 0x69, 0xec, 0xbd,
+	0x94, 0x36, 0xf6, 0xa6, 0x42, 0x6c, 0xce, 0x9e, 0x53, 0x96, 0xb2, 0x91, 0x96, 0x1f, 0x82, 0x8d,
+	0xf4, 0x05, 0x98, 0x3e, 0xe4, 0x6b, 0x92, 0xa8, 0x12, 0x5f, 0xe1, 0xbb, 0x28, 0xdf, 0xd8, 0x6e,
+	0x25, 0x60, 0xd4, 0x69, 0x58, 0x13, 0xa1, 0x80, 0x25, 0xb7, 0x35, 0xcb, 0x26, 0xad, 0x04, 0x8c,
+	0x3a, 0x0d, 0x4f, 0x47, 0x71, 0xbc, 0x03, 0xd1, 0x60, 0x8a, 0x37, 0x10, 0x21, 0xf0, 0x31, 0x10,
+	0x13, 0x3c, 0x33, 0xc6, 0x0d, 0x3a, 0x7b, 0x82, 0xb6, 0xc6, 0x69, 0xb9, 0x82, 0xbd, 0xb3, 0xb2,
+	0x2a, 0x48, 0x15, 0x96, 0xf5, 0xa4, 0x67, 0xf7, 0x63, 0x44, 0xa3, 0x9e, 0xf4, 0x64, 0x23, 0x01,
+	0xa3, 0x4e, 0xc3, 0x72, 0x09, 0x02, 0xda, 0x19, 0xb4, 0xa9, 0x6a, 0x05, 0xbc, 0x95, 0xbc, 0x5f,
+	0x53, 0xc7, 0x60, 0x8a, 0x72, 0x84, 0xa5, 0x77, 0x7a, 0x2c, 0x4b, 0xef, 0xa7, 0x61, 0xae, 0x13,
+	0xd8, 0x8e, 0x47, 0x3b, 0x9b, 0x1e, 0x8f, 0xa3, 0x92, 0x49, 0x31, 0xca, 0xcb, 0xb2, 0x62, 0x60,
+	0x31, 0x45, 0x6d, 0xfd, 0xb3, 0x22, 0x54, 0xc4, 0xad, 0x9f, 0x6b, 0x70, 0x81, 0x99, 0x86, 0x1c,
+	0xdb, 0xe5, 0x77, 0x3d, 0xe8, 0xf1, 0x64, 0x95, 0xe6, 0xd3, 0xec, 0xe0, 0xb7, 0x36, 0x8c, 0xc6,
+	0xac, 0x36, 0x6c, 0x70, 0x64, 0xc5, 0x87, 0x98, 0x8b, 0x30, 0x86, 0x8a, 0x6b, 0xaf, 0x0d, 0x0c,
+	0xa6, 0x28, 0x99, 0x2e, 0xd8, 0x1f, 0x0a, 0x14, 0xab, 0x08, 0x5d, 0xd0, 0x8c, 0xdd, 0x32, 0xe9,
+	0xf8, 0x19, 0x65, 0xc0, 0xcf, 0x03, 0x2a, 0xe3, 0x5e, 0xc6, 0x2a, 0x89, 0x33, 0x4a, 0x0a, 0x87,
+	0x43, 0xd4, 0x8c, 0xc3, 0x9e, 0xed, 0xb8, 0x83, 0x80, 0x26, 0x1c, 0x2a, 0x09, 0x87, 0xd5, 0x14,
+	0x0e, 0x87, 0xa8, 0xad, 0x6d, 0x60, 0x45, 0xa1, 0x42, 0x9b, 0x97, 0x4e, 0x4e, 0x0c, 0x2a, 0x85,
+	0x5c, 0x06, 0x15, 0xeb, 0x97, 0x4a, 0x30, 0x23, 0xd8, 0x4a, 0x6b, 0xc8, 0x55, 0x00, 0x59, 0xa1,
+	0xb9, 0xd3, 0x89, 0xab, 0x0b, 0x25, 0x4b, 0xad, 0xc2, 0xa0, 0x46, 0x75, 0xba, 0x00, 0xdc, 0x97,
+	0x61, 0x26, 0x0e, 0xa8, 0xe5, 0x0a, 0x50, 0x2a, 0x49, 0x69, 0x59, 0xc3, 0xa1, 0x41, 0xc9, 0x2e,
+	0xc6, 0x08, 0x07, 0xbb, 0xa2, 0x22, 0xa0, 0xe3, 0x7b, 0xbc, 0xb5, 0x28, 0x9d, 0xa9, 0x6a, 0x28,
+	0xb5, 0x52, 0x78, 0x1c, 0x6a, 0xc1, 0xbc, 0x49, 0x3d, 0xfb, 0xde, 0x8e, 0x67, 0x4b, 0x4d, 0x57,
+	0x73, 0x5f, 0x6d, 0x48, 0x38, 0x2a, 0x0a, 0x62, 0x4b, 0x63, 0x4a, 0x35, 0x6f, 0xa9, 0x20, 0xf5,
+	0xca, 0x86, 0xcc, 0x29, 0x3f, 0x0e, 0x35, 0xbb, 0xd3, 0x73, 0x3c, 0x76, 0x65, 0xd4, 0x94, 0xe9,
+	0xde, 0x5a, 0xe2, 0x70, 0x5c, 0x47, 0x45, 0x61, 0xfd, 0xf7, 0x02, 0x90, 0xe1, 0xac, 0x6d, 0xb2,
+	0x0f, 0x55, 0x8f, 0xfb, 0x13, 0x72, 0x97, 0x42, 0xd0, 0xdc, 0x12, 0x42, 0x5b, 0x91, 0x00, 0xc9,
+	0x9f, 0x85, 0x07, 0xd2, 0x7b, 0x11, 0x0d, 0x3c, 0x55, 0x52, 0x62, 0x25, 0x9f, 0x99, 0x52, 0xa6,
+	0x0d, 0x0b, 0xcb, 0x84, 0xe4, 0x8c, 0x4a, 0x86, 0xf5, 0xb7, 0xca, 0x30, 0xad, 0xd1, 0xbd, 0x9d,
+	0x99, 0x8e, 0x57, 0x94, 0x15, 0x66, 0xfc, 0x9d, 0x40, 0xf4, 0xd0, 0xa8, 0x28, 0x2b, 0x51, 0xec,
+	0x0e, 0x2e, 0x8d, 0x8e, 0x4d, 0xf7, 0x9e, 0x1d, 0x46, 0xc6, 0x9c, 0x54, 0xd3, 0x7d, 0x43, 0x61,
+	0x50, 0xa3, 0x62, 0x17, 0xc7, 0x0c, 0x42, 0x1a, 0xc8, 0x39, 0xa8, 0x0e, 0xbe, 0xec, 0x7e, 0x23,
+	0xe4, 0x18, 0xb2, 0x09, 0xb5, 0xf8, 0x02, 0xa1, 0xb3, 0xdd, 0x45, 0x24, 0x6e, 0x05, 0x94, 0x4d,
+	0x51, 0x31, 0x21, 0x5d, 0x38, 0x17, 0xf7, 0x3a, 0xc6, 0x9e, 0xad, 0xee, 0x8f, 0x58, 0xa7, 0x52,
+	0x2c, 0x70, 0x88, 0xa9, 0xc8, 0x50, 0x90, 0xc3, 0x13, 0x2a, 0xfb, 0x9c, 0x96, 0xa1, 0x90, 0xe0,
+	0xd0, 0xa0, 0x8c, 0xed, 0x9a, 0xb5, 0x89, 0xdb, 0x35, 0x3f, 0x0c, 0x53, 0xb2, 0x3c, 0xbe, 0xcc,
+	0xb6, 0x50, 0xca, 0x88, 0x2c, 0xa1, 0x8f, 0x31, 0xde, 0xfa, 0x4e, 0x01, 0x66, 0x0d, 0x13, 0x38,
+	0xf9, 0x80, 0x5e, 0x31, 0xc1, 0xb8, 0xdd, 0x4a, 0x2b, 0x74, 0xc0, 0x6a, 0x5a, 0xf1, 0xd7, 0x3b,
+	0x54, 0xd3, 0x8a, 0x43, 0x51, 0x62, 0x59, 0x4f, 0xa4, 0x93, 0x2d, 0xad, 0x16, 0x49, 0x2f, 0x1c,
+	0xc6, 0x78, 0xe1, 0xbb, 0x16, 0xa3, 0x93, 0xbe, 0x3a, 0x28, 0x1e, 0x43, 0x54, 0x14, 0xd6, 0x3f,
+	0xe0, 0xfd, 0x8e, 0x82, 0x23, 0x65, 0x15, 0xeb, 0xc2, 0x94, 0xcc, 0x33, 0x6a, 0x14, 0x72, 0x9a,
+	0xe5, 0x64, 0xf6, 0x92, 0x4c, 0x9e, 0xb0, 0xdb, 0x07, 0x9b, 0x7b, 0x7b, 0x18, 0x73, 0x27, 0xd7,
+	0xa0, 0xee, 0x7b, 0x72, 0xfb, 0x69, 0x14, 0xd5, 0xed, 0x9c, 0xf5, 0xcd, 0x18, 0xc8, 0x12, 0x0e,
+	0xd5, 0x0f, 0xa3, 0x93, 0x98, 0xb4, 0x64, 0xa5, 0x90, 0x9e, 0x64, 0x57, 0x07, 0x3a, 0x5e, 0xd7,
+	0x8c, 0xbd, 0x20, 0x2e, 0xbf, 0xae, 0x77, 0xc7, 0xb3, 0x0f, 0x6d, 0xc7, 0x65, 0x19, 0xa4, 0x6f,
+	0x6b, 0xd5, 0x1a, 0x44, 0x8e, 0xbb, 0xe8, 0x78, 0x51, 0x18, 0x05, 0x4c, 0xe3, 0xde, 0x0c, 0x5a,
+	0x51, 0xc0, 0x02, 0x89, 0xe3, 0x0b, 0x7b, 0x35, 0x5e, 0x98, 0xe2, 0x6d, 0xfd, 0xc7, 0x32, 0xf0,
+	0xb4, 0x06, 0xf2, 0x71, 0xa8, 0xf7, 0x68, 0x7b, 0xdf, 0xf6, 0x9c, 0x30, 0xbe, 0x31, 0x95, 0x59,
+	0x5c, 0xeb, 0x1b, 0x31, 0xf0, 0x3e, 0x7b, 0x15, 0x4b, 0xad, 0x75, 0x5e, 0x79, 0x20, 0xa1, 0x65,
+	0x41, 0x6e, 0xdd, 0x30, 0xb4, 0xfb, 0x4e, 0xee, 0x20, 0x37, 0x71, 0x2f, 0x9b, 0x58, 0x4c, 0xc5,
+	0xff, 0x28, 0x59, 0x33, 0xa7, 0x4b, 0xdf, 0xb5, 0x1d, 0x2f, 0x77, 0x45, 0x24, 0xf6, 0x04, 0x5b,
+	0x8c, 0x93, 0xd8, 0xdb, 0xf9, 0xbf, 0x28, 0x78, 0x93, 0x01, 0x4c, 0x87, 0xed, 0xc0, 0xee, 0x85,
+	0xfb, 0xf6, 0xd5, 0x97, 0x3e, 0xd6, 0x28, 0x4f, 0x4c, 0x94, 0x50, 0xa4, 0x97, 0x71, 0x69, 0xa3,
+	0xf5, 0xea, 0xd2, 0xd5, 0x97, 0x3e, 0x86, 0xba, 0x1c, 0x5d, 0xec, 0x4b, 0x2f, 0x5c, 0x6d, 0x54,
+	0x1e, 0x8e, 0xd8, 0x97, 0x5e, 0xb8, 0x8a, 0xba, 0x1c, 0x36, 0xa4, 0xbe, 0xb6, 0x65, 0xe7, 0x13,
+	0xb8, 0x99, 0xf8, 0xb1, 0xf8, 0xbf, 0x28, 0x78, 0x5b, 0xff, 0xab, 0x00, 0x75, 0x85, 0x67, 0xcb,
+	0xbc, 0xb8, 0x83, 0x63, 0x6d, 0xa5, 0x51, 0x38, 0xf3, 0x32, 0xbf, 0x2c, 0x9b, 0xa2, 0x62, 0xc2,
+	0xae, 0x99, 0x13, 0xff, 0x8b, 0x26, 0x67, 0xf3, 0x96, 0xf1, 0x54, 0xc5, 0x65, 0xad, 0x39, 0x1a,
+	0xcc, 0x98, 0xfb, 0x86, 0xeb, 0x7c, 0xf1, 0x7d, 0x94, 0x8d, 0x92, 0xe9, 0xbe, 0xd9, 0xd6, 0x91,
+	0x68, 0xd2, 0xaa, 0x07, 0xe7, 0x6f, 0x82, 0xec, 0x00, 0xb0, 0x7d, 0x4e, 0xf6, 0xf2, 0x4c, 0x8f,
+	0xce, 0x2d, 0x83, 0x3b, 0xaa, 0x31, 0x6a, 0x8c, 0x32, 0x2e, 0xf2, 0x2b, 0x4e, 0xfa, 0x22, 0xbf,
+	0x2b, 0x50, 0xdf, 0xb7, 0xbd, 0x4e, 0xb8, 0x6f, 0x1f, 0x50, 0x99, 0x58, 0xa9, 0xec, 0x25, 0xaf,
+	0xc6, 0x08, 0x4c, 0x68, 0xac, 0x7f, 0x54, 0x05, 0x11, 0xf7, 0xc7, 0x96, 0xf4, 0x8e, 0x13, 0x8a,
+	0x4c, 0xf9, 0x02, 0x6f, 0xa9, 0x96, 0xf4, 0x15, 0x09, 0x47, 0x45, 0xc1, 0xee, 0x5c, 0xeb, 0x39,
+	0x9e, 0x3c, 0x9c, 0xf0, 0x0d, 0x6d, 0xc3, 0xf1, 0x90, 0xc1, 0x38, 0xca, 0xbe, 0xd7, 0x28, 0x69,
+	0x28, 0xfb, 0x1e, 0x32, 0x18, 0x33, 0x3a, 0xbb, 0xbe, 0x7f, 0xc0, 0x16, 0x67, 0x3d, 0xe9, 0x64,
+	0x56, 0x58, 0x1a, 0xd6, 0x4d, 0x14, 0xa6, 0x69, 0x59, 0x4e, 0xcc, 0x5b, 0x34, 0xf0, 0xe5, 0x6e,
+	0xd4, 0x72, 0x29, 0xed, 0xc7, 0x6c, 0x84, 0xca, 0xcb, 0x73, 0x62, 0xbe, 0x98, 0x4d, 0x82, 0xa3,
+	0xda, 0x32, 0xb6, 0x91, 0x1d, 0x74, 0x69, 0xb4, 0x15, 0xf8, 0xec, 0x58, 0xc3, 0x6a, 0xb2, 0x4a,
+	0xb6, 0xd5, 0x84, 0xed, 0x76, 0x36, 0x09, 0x8e, 0x6a, 0x4b, 0x3e, 0x0f, 0x0d, 0x81, 0x12, 0x2a,
+	0xed, 0x92, 0x58, 0xc4, 0x1d, 0x97, 0xdd, 0xb5, 0x2c, 0x0e, 0xe0, 0x3c, 0x1e, 0x62, 0x7b, 0x04,
+	0x0d, 0x8e, 0x6c, 0x4d, 0x5e, 0x63, 0x57, 0xe9, 0xf1, 0xe7, 0x08, 0x59, 0x3e, 0x87, 0x8a, 0x05,
+	0x9d, 0x8d, 0x93, 0x97, 0xe2, 0xe4, 0x1d, 0x4c, 0x51, 0xe1, 0x50, 0x3b, 0x82, 0xf0, 0x14, 0x0f,
+	0xf8, 0xdc, 0xe9, 0x2f, 0xfb, 0xbe, 0xdb, 0xf1, 0xef, 0x7a, 0xf1, 0xb3, 0x8b, 0xb3, 0x3c, 0x0f,
+	0x80, 0x69, 0x65, 0x52, 0xe0, 0x88, 0x96, 0xec, 0xc9, 0x39, 0x66, 0xc5, 0xbf, 0xeb, 0xa5, 0xb9,
+	0x42, 0xf2, 0xe4, 0xad, 0x11, 0x34, 0x38, 0xb2, 0x35, 0x59, 0x05, 0x92, 0x7e, 0x82, 0x9d, 0xbe,
+	0x0c, 0xd1, 0x7a, 0x4a, 0x14, 0xe1, 0x4f, 0x63, 0x31, 0xa3, 0x05, 0x59, 0x87, 0x27, 0xd2, 0x50,
+	0x26, 0x4e, 0x46, 0x6b, 0xf1, 0xcb, 0x26, 0x31, 0x03, 0x8f, 0x99, 0xad, 0xac, 0x69, 0xa8, 0xf3,
+	0xa3, 0x23, 0xb3, 0xa5, 0x58, 0xff, 0xa1, 0x08, 0xf3, 0xa9, 0x42, 0xe6, 0x8f, 0xc0, 0xe9, 0xe5,
+	0x19, 0x4e, 0xaf, 0xf1, 0x5d, 0xb9, 0xa9, 0x9e, 0x8f, 0xf4, 0x7d, 0x1d, 0xa6, 0x7c, 0x5f, 0x37,
+	0x27, 0x26, 0xf1, 0xc1, 0x2e, 0xb0, 0x93, 0x02, 0x5c, 0x48, 0xb5, 0x78, 0x04, 0x4e, 0x96, 0x9e,
+	0xe9, 0x64, 0x79, 0x75, 0x52, 0x0f, 0x3b, 0xc2, 0xd7, 0xf2, 0xfb, 0xc3, 0x0f, 0xd9, 0x12, 0x0e,
+	0xc7, 0x29, 0x59, 0x33, 0x3a, 0xf7, 0x71, 0x58, 0xb2, 0xe7, 0xef, 0xd7, 0xac, 0x6c, 0xe9, 0x75,
+	0x31, 0x96, 0x42, 0x42, 0xa8, 0xc5, 0x85, 0xa1, 0x27, 0xeb, 0x4e, 0x55, 0x83, 0x1d, 0x43, 0x51,
+	0x09, 0xb2, 0xbe, 0x55, 0x82, 0x27, 0x33, 0x27, 0xc5, 0xa3, 0x33, 0x2b, 0x7f, 0xd2, 0x34, 0x2b,
+	0x7f, 0x30, 0x6d, 0x56, 0x7e, 0x22, 0xd5, 0xbf, 0xc7, 0xd8, 0xba, 0x3c, 0x41, 0x8b, 0xa9, 0x35,
+	0x0f, 0xb3, 0x46, 0x31, 0x73, 0xeb, 0xf7, 0x2a, 0x30, 0xad, 0xcd, 0xa4, 0xc7, 0xaf, 0x34, 0xeb,
+	0x2b, 0x30, 0xd7, 0x0b, 0xbb, 0x6b, 0x2b, 0x22, 0xac, 0x26, 0xae, 0x36, 0x21, 0xeb, 0xd6, 0x6c,
+	0x18, 0x18, 0x4c, 0x51, 0x92, 0x75, 0x78, 0x32, 0x60, 0x65, 0x5d, 0xc2, 0xc8, 0xb4, 0xbb, 0x36,
+	0xca, 0xfa, 0x76, 0x93, 0x22, 0x08, 0x31, 0xbb, 0x11, 0x5b, 0x42, 0x44, 0x18, 0x4a, 0x25, 0xe7,
+	0x77, 0x14, 0x8f, 0x37, 0x63, 0x26, 0xcb, 0x98, 0x6a, 0x10, 0x14, 0x52, 0x46, 0xe4, 0xda, 0x54,
+	0xdf, 0xc1, 0x5c, 0x1b, 0x3d, 0xc0, 0x77, 0xea, 0x81, 0x01, 0xbe, 0x8f, 0x75, 0x3c, 0xa3, 0xf5,
+	0x55, 0x30, 0x06, 0x9c, 0x79, 0x1c, 0xd5, 0xc3, 0xe6, 0x0e, 0x32, 0x4c, 0xf2, 0x5d, 0xb8, 0x73,
+	0x46, 0xfd, 0xc4, 0x44, 0x86, 0xb5, 0xc7, 0xbe, 0x42, 0x5e, 0xcb, 0x42, 0x96, 0xcb, 0xd7, 0x2a,
+	0x4e, 0x17, 0x26, 0x57, 0x71, 0xda, 0xfa, 0xb7, 0x45, 0xa8, 0x2b, 0xe7, 0xe3, 0x29, 0xee, 0x9e,
+	0x37, 0x06, 0xa2, 0xf8, 0xf0, 0x07, 0x42, 0xcf, 0xde, 0x2a, 0xe5, 0xc8, 0xde, 0xea, 0x27, 0xb7,
+	0x0d, 0x94, 0x73, 0xa6, 0x6f, 0xa9, 0xe1, 0x92, 0xf7, 0x14, 0xc8, 0x91, 0x4d, 0x5f, 0x5a, 0xf0,
+	0x26, 0x9c, 0x4b, 0x53, 0x72, 0x8b, 0x5a, 0x7b, 0x9f, 0x76, 0x06, 0x6e, 0x3c, 0xc6, 0x89, 0x45,
+	0x4d, 0xc2, 0x51, 0x51, 0xb0, 0x8f, 0x89, 0xbd, 0xa6, 0xb7, 0x7c, 0x2f, 0xde, 0xa3, 0xc4, 0x55,
+	0xcc, 0x12, 0x86, 0x0a, 0x6b, 0xfd, 0xd7, 0x12, 0x3c, 0xa3, 0x84, 0x85, 0x1b, 0xb6, 0x67, 0x77,
+	0xcd, 0xc8, 0xea, 0xf7, 0x8a, 0x33, 0x9d, 0x61, 0x11, 0x1b, 0x1d, 0x89, 0x5e, 0x7a, 0xe7, 0x23,
+	0xd1, 0xad, 0xff, 0x5b, 0x04, 0x9e, 0x0d, 0xca, 0x6e, 0x10, 0x89, 0xc7, 0x93, 0xfd, 0x6e, 0x14,
+	0x72, 0xee, 0x39, 0x4b, 0x1a, 0xb3, 0xc4, 0x5a, 0xae, 0x43, 0xd1, 0x10, 0x48, 0x7c, 0xa8, 0xed,
+	0xd9, 0xae, 0xcb, 0x0e, 0xef, 0xb9, 0x15, 0x47, 0x43, 0x38, 0x9f, 0xe6, 0xab, 0x92, 0x35, 0x2a,
+	0x21, 0x2c, 0x05, 0x70, 0x36, 0xd0, 0xad, 0xb7, 0x8d, 0x52, 0x4e, 0x1d, 0xc4, 0xb0, 0x05, 0xeb,
+	0xf9, 0x3f, 0x1a, 0x18, 0x4d, 0x99, 0xd6, 0x7f, 0x29, 0xc0, 0x6c, 0xcb, 0x75, 0x58, 0xbd, 0x09,
+	0xb9, 0x36, 0x23, 0x54, 0x5d, 0x11, 0x5d, 0x57, 0x18, 0xff, 0xd2, 0x76, 0x19, 0x84, 0x27, 0x39,
+	0x91, 0x4d, 0xa8, 0x84, 0xae, 0xd3, 0xa1, 0x63, 0x26, 0x87, 0x73, 0xb3, 0x1f, 0xeb, 0x25, 0x53,
+	0x16, 0xd8, 0x1f, 0x66, 0x35, 0x12, 0x15, 0x0a, 0xe3, 0xda, 0x18, 0x9a, 0xd5, 0xa8, 0x15, 0x23,
+	0x30, 0xa1, 0xb1, 0x7e, 0xa3, 0x06, 0x32, 0xaf, 0x99, 0x0c, 0xa0, 0xde, 0x8d, 0x6f, 0x4c, 0x97,
+	0xcf, 0x38, 0x81, 0xdb, 0xde, 0x05, 0x73, 0xb1, 0xf6, 0x2b, 0x20, 0x26, 0x92, 0x08, 0x85, 0x0a,
+	0x2f, 0xd3, 0x93, 0xdb, 0x57, 0xa7, 0x15, 0x64, 0x12, 0x23, 0xc3, 0x01, 0x28, 0xb8, 0x33, 0x3f,
+	0xe9, 0x7e, 0x14, 0xf5, 0x1b, 0xa5, 0x9c, 0x7e, 0xd2, 0xe4, 0xfa, 0x02, 0xa1, 0xcd, 0xb2, 0xdf,
+	0xc8, 0x59, 0x33, 0x11, 0x9e, 0x1d, 0x85, 0xb9, 0x6f, 0x6d, 0x49, 0x42, 0xfe, 0x65, 0x46, 0x80,
+	0x1d, 0x85, 0xc8, 0x59, 0x93, 0x9f, 0x84, 0xe9, 0x28, 0xb0, 0xbd, 0x90, 0x95, 0x58, 0xa1, 0x41,
+	0xa3, 0x92, 0xf3, 0xcb, 0xd8, 0x59, 0xd9, 0x4e, 0xb8, 0x89, 0xf0, 0x02, 0x03, 0x84, 0xba, 0x34,
+	0x72, 0xc0, 0x62, 0x49, 0x44, 0xc7, 0xa4, 0xfe, 0xb9, 0x94, 0x43, 0xb2, 0x1e, 0xef, 0x1d, 0xff,
+	0x42, 0x25, 0x80, 0xcd, 0xc6, 0xa4, 0xa6, 0xf7, 0x54, 0xce, 0xd9, 0x98, 0xaa, 0x02, 0xfa, 0x80,
+	0x62, 0xde, 0xbd, 0xe4, 0x60, 0x5e, 0xcb, 0x39, 0xb8, 0xc6, 0x01, 0x4b, 0xde, 0xbf, 0x93, 0x3e,
+	0x96, 0x3b, 0x50, 0xed, 0x73, 0xc7, 0x7b, 0xa3, 0x9e, 0x73, 0x6d, 0xd5, 0x63, 0x23, 0xc4, 0x5a,
+	0x23, 0x20, 0x28, 0x05, 0x90, 0x2f, 0x41, 0x29, 0xbc, 0x13, 0x36, 0x20, 0xa7, 0x3a, 0xd7, 0xba,
+	0x13, 0xcf, 0x4d, 0x6e, 0x10, 0x6e, 0xdd, 0x09, 0x91, 0xf1, 0x65, 0x76, 0xf7, 0x29, 0x86, 0x63,
+	0x7b, 0xc6, 0x15, 0xa8, 0xdb, 0x77, 0x43, 0xa4, 0xdd, 0x24, 0x5d, 0x50, 0xad, 0x42, 0x4b, 0xb7,
+	0x5b, 0x02, 0x81, 0x09, 0x0d, 0x6b, 0xc0, 0x73, 0x4e, 0xb8, 0x6f, 0xbb, 0x68, 0x36, 0xf8, 0x5c,
+	0x8c, 0xc0, 0x84, 0x86, 0xdc, 0x82, 0xa7, 0xf8, 0x8f, 0xcd, 0xbb, 0x1e, 0x0d, 0x96, 0x6e, 0xb7,
+	0x96, 0xda, 0x6d, 0x16, 0x55, 0xb4, 0xb6, 0xd2, 0x28, 0x19, 0xe1, 0x63, 0x4f, 0x7d, 0x2e, 0x93,
+	0x0a, 0x47, 0xb4, 0x66, 0x41, 0x50, 0x54, 0x7a, 0x12, 0x98, 0x73, 0x5e, 0x38, 0x44, 0xb9, 0x3b,
+	0x27, 0x76, 0x30, 0x70, 0xc7, 0xbc, 0x46, 0x63, 0xfd, 0x56, 0x19, 0xea, 0x6a, 0x50, 0xde, 0xc5,
+	0x8f, 0xbe, 0x0c, 0xe7, 0x0f, 0x9d, 0xd0, 0x11, 0x86, 0x69, 0x3d, 0x96, 0xbb, 0x22, 0xb4, 0xaa,
+	0x5b, 0x69, 0x24, 0x0e, 0xd3, 0xb3, 0xf8, 0xa9, 0x9e, 0x7d, 0xef, 0xe6, 0xa0, 0xb7, 0x4b, 0x83,
+	0xcd, 0x3d, 0x75, 0xbd, 0x4c, 0x25, 0x89, 0x9f, 0xda, 0x18, 0x46, 0x63, 0x56, 0x1b, 0xe6, 0x61,
+	0xb8, 0x6b, 0x3b, 0xa2, 0x5a, 0x99, 0x66, 0xc3, 0xaf, 0x08, 0x0f, 0xc3, 0x6d, 0x13, 0x85, 0x69,
+	0xda, 0xf4, 0x9b, 0x9c, 0x7a, 0xfb, 0x37, 0xc9, 0x4c, 0x0c, 0x76, 0x14, 0x05, 0xce, 0xee, 0x20,
+	0xe2, 0x43, 0x2d, 0x82, 0x40, 0xa5, 0x89, 0x61, 0xc9, 0xc0, 0x60, 0x8a, 0x92, 0x6c, 0xc2, 0x93,
+	0xd2, 0x14, 0x64, 0x12, 0xca, 0x7a, 0xd1, 0x5c, 0x03, 0xdc, 0xc8, 0x22, 0xc0, 0xec, 0x76, 0x56,
+	0x0f, 0xa4, 0x29, 0x8b, 0xb4, 0x01, 0xd8, 0x23, 0x39, 0x7a, 0x11, 0xa7, 0x2b, 0xa7, 0xd3, 0x14,
+	0x96, 0xe3, 0x76, 0xda, 0xbd, 0xfc, 0x8a, 0x15, 0x6a, 0x6c, 0xad, 0x7f, 0x57, 0x04, 0x16, 0xc8,
+	0x20, 0xee, 0xda, 0x0d, 0x69, 0x7b, 0x10, 0xd0, 0xd6, 0x81, 0xd3, 0xbf, 0x45, 0x03, 0x67, 0xef,
+	0x48, 0x7a, 0x91, 0xb4, 0xbb, 0x76, 0xd3, 0x14, 0x98, 0xd1, 0x8a, 0x3b, 0x09, 0xed, 0x65, 0x1a,
+	0xe4, 0x70, 0x12, 0x2e, 0x25, 0xcd, 0xd1, 0x60, 0xc6, 0x3c, 0x7b, 0xed, 0x84, 0x75, 0xe9, 0xcc,
+	0x9e, 0x3d, 0x8d, 0xb1, 0xc6, 0x88, 0x20, 0xaf, 0xce, 0x27, 0xb9, 0x96, 0xcf, 0xc2, 0x75, 0x56,
+	0x16, 0xf0, 0x93, 0x4c, 0x13, 0x36, 0x96, 0x07, 0xb3, 0xdb, 0x76, 0x37, 0x19, 0x78, 0xf2, 0x09,
+	0xa8, 0xf9, 0x7d, 0x4d, 0xd1, 0xaa, 0xf3, 0xc4, 0xdf, 0xda, 0xa6, 0x84, 0xb1, 0x68, 0xd7, 0x75,
+	0xbf, 0xeb, 0xb4, 0x63, 0x00, 0x2a, 0x72, 0x62, 0x41, 0x95, 0x97, 0x98, 0x12, 0x06, 0xec, 0xba,
+	0x58, 0xe9, 0x6f, 0x71, 0x08, 0x4a, 0x8c, 0xf5, 0xd3, 0x65, 0x48, 0x22, 0x9c, 0x49, 0x08, 0x55,
+	0x51, 0xde, 0xa2, 0x51, 0xc8, 0x19, 0x29, 0x7e, 0x8a, 0x4a, 0x1a, 0x52, 0x14, 0xe9, 0x42, 0xe9,
+	0x4d, 0x7f, 0x37, 0xb7, 0x4a, 0xa7, 0x55, 0x1f, 0x16, 0xdf, 0xae, 0x06, 0x40, 0x26, 0x81, 0xfc,
+	0x42, 0x01, 0xce, 0x87, 0xe9, 0x43, 0xb1, 0x9c, 0x0e, 0x98, 0xff, 0xf4, 0x9f, 0x3e, 0x66, 0xcb,
+	0x0c, 0xed, 0x51, 0x68, 0x1c, 0xee, 0x0b, 0x1b, 0x7f, 0x11, 0xf0, 0xdb, 0x28, 0xe7, 0x1c, 0x7f,
+	0x11, 0x44, 0x6c, 0x8e, 0xbf, 0x09, 0x43, 0x29, 0xca, 0xfa, 0x5a, 0x11, 0xa6, 0x35, 0x3d, 0xee,
+	0x14, 0x36, 0x9f, 0x67, 0xa1, 0x6c, 0x07, 0xdd, 0x78, 0x5a, 0x09, 0x43, 0x2d, 0x2b, 0x6d, 0xcf,
+	0xa1, 0xe4, 0x1e, 0x54, 0x0f, 0xee, 0x72, 0xbc, 0xb0, 0xcf, 0x6c, 0x8d, 0x1f, 0x36, 0x95, 0xf4,
+	0x6a, 0xf1, 0x06, 0x67, 0x99, 0xaa, 0xe0, 0x76, 0xe3, 0x36, 0x97, 0x2b, 0xe5, 0xb1, 0x0a, 0x6c,
+	0x1a, 0xd9, 0x99, 0x2a, 0xb0, 0xfd, 0xd3, 0x22, 0x94, 0x76, 0x56, 0x56, 0x1f, 0xb9, 0x5d, 0x8f,
+	0xec, 0xc3, 0xd4, 0xee, 0xc0, 0x71, 0x23, 0xc7, 0xcb, 0x5d, 0x4a, 0x7f, 0x75, 0xe0, 0xb5, 0x13,
+	0xcb, 0x5e, 0x53, 0x70, 0xc5, 0x98, 0x3d, 0x8b, 0xbe, 0xea, 0x8a, 0x3b, 0x33, 0x73, 0x27, 0x45,
+	0xca, 0xbb, 0x37, 0x85, 0x20, 0xf9, 0x03, 0x63, 0xee, 0xd6, 0x11, 0x54, 0x77, 0x56, 0xa4, 0x41,
+	0xe0, 0x11, 0x5b, 0x49, 0x7f, 0x12, 0xd4, 0xf9, 0xe0, 0xd1, 0x0b, 0xff, 0x9d, 0x02, 0x98, 0x47,
+	0xa2, 0x47, 0x3f, 0x9b, 0x0e, 0xd2, 0xb3, 0x69, 0x65, 0x12, 0x1f, 0x5f, 0xf6, 0x84, 0xb2, 0xfe,
+	0x75, 0x01, 0x52, 0x35, 0x89, 0xc8, 0xc7, 0xe4, 0xb5, 0x38, 0x66, 0x22, 0x58, 0x7c, 0x2d, 0x0e,
+	0x31, 0xa9, 0xb5, 0xeb, 0x71, 0xbe, 0xc1, 0x0c, 0x39, 0x7a, 0xa4, 0x5d, 0xa3, 0x98, 0xd3, 0xc1,
+	0x9c, 0x19, 0xb7, 0x27, 0x33, 0x24, 0x75, 0x14, 0x9a, 0x72, 0xad, 0x7f, 0x58, 0x84, 0xea, 0x23,
+	0x2b, 0xc3, 0x48, 0x0d, 0xff, 0xfd, 0x72, 0xce, 0xd5, 0x7e, 0xa4, 0xdb, 0xbe, 0x97, 0x72, 0xdb,
+	0x5f, 0xcb, 0x2b, 0xe8, 0xc1, 0xde, 0xfa, 0x7f, 0x59, 0x00, 0xb9, 0xd7, 0xac, 0x79, 0x61, 0x64,
+	0x7b, 0xfc, 0xb2, 0xc4, 0x78, 0x63, 0xcb, 0xeb, 0xc3, 0x15, 0x8c, 0xa5, 0x2e, 0xc3, 0xff, 0x8f,
+	0x37, 0x32, 0x66, 0x4c, 0xdf, 0xf7, 0xc3, 0xc8, 0x4b, 0x4e, 0x47, 0xca, 0x98, 0xfe, 0xaa, 0x84,
+	0xa3, 0xa2, 0x48, 0xc7, 0xbd, 0x56, 0x46, 0xc7, 0xbd, 0x5a, 0x5f, 0x84, 0xf9, 0x74, 0x2d, 0xc9,
+	0xeb, 0x99, 0xb5, 0x24, 0x3f, 0x30, 0xa2, 0x96, 0xe4, 0xf4, 0xe8, 0x3a, 0x92, 0xbf, 0x5a, 0x84,
+	0x99, 0x77, 0x4b, 0x0d, 0xc9, 0xac, 0xf4, 0xe1, 0x52, 0xce, 0xf4, 0xe1, 0xf2, 0x59, 0xd2, 0x87,
+	0xad, 0xef, 0x17, 0x00, 0x1e, 0x59, 0x01, 0xcb, 0x8e, 0x19, 0xff, 0x91, 0x7b, 0xce, 0x66, 0x87,
+	0x7d, 0x7c, 0x7b, 0x2a, 0x7e, 0x24, 0xee, 0x4c, 0x67, 0xf5, 0xe4, 0x6c, 0x23, 0x69, 0x35, 0xb7,
+	0x2e, 0x9e, 0xca, 0x81, 0x55, 0x99, 0x4e, 0x26, 0x1c, 0x53, 0x62, 0x59, 0xd4, 0x7c, 0x1c, 0x9d,
+	0xa1, 0x19, 0x1c, 0x86, 0x6e, 0x12, 0x17, 0xb9, 0x2d, 0x3a, 0xe5, 0xdb, 0x24, 0x09, 0x97, 0x26,
+	0x92, 0x24, 0xac, 0x3b, 0x96, 0xcb, 0x0f, 0x74, 0x2c, 0x1f, 0x42, 0x7d, 0x2f, 0xf0, 0x7b, 0x3c,
+	0x0f, 0x97, 0x5f, 0x60, 0x93, 0x67, 0x01, 0x5c, 0xf6, 0x7b, 0xbb, 0x2c, 0x1d, 0x8c, 0x71, 0x4b,
+	0x8c, 0x2f, 0xab, 0x31, 0x7f, 0x4c, 0x44, 0x71, 0x0f, 0xa3, 0x2f, 0xa4, 0x56, 0x27, 0x29, 0x35,
+	0xb9, 0x16, 0x5d, 0x70, 0xc7, 0x58, 0x8c, 0x99, 0x7b, 0x3b, 0xf5, 0x88, 0x72, 0x6f, 0x8f, 0xf4,
+	0x94, 0xe6, 0x5a, 0x4e, 0xe3, 0xeb, 0x99, 0x4a, 0x0e, 0xa6, 0x32, 0xfd, 0xeb, 0x8f, 0x26, 0xd3,
+	0xff, 0xcf, 0x4f, 0xc5, 0x0b, 0xf6, 0x63, 0x77, 0x91, 0xe2, 0x7b, 0xf5, 0x0d, 0xbb, 0x74, 0xa8,
+	0xf8, 0x60, 0xed, 0x11, 0x16, 0x1f, 0xac, 0x4f, 0xa6, 0xf8, 0x20, 0xe4, 0x2b, 0x3e, 0x38, 0x3d,
+	0xa1, 0xe2, 0x83, 0x33, 0x93, 0x2a, 0x3e, 0x38, 0x3b, 0x56, 0xf1, 0xc1, 0xb9, 0x53, 0x15, 0x1f,
+	0x3c, 0x2e, 0x41, 0xca, 0xb0, 0xf1, 0x5e, 0x78, 0xc3, 0x1f, 0xaa, 0xf0, 0x86, 0x6f, 0x16, 0x21,
+	0xd9, 0x78, 0xce, 0x98, 0xaf, 0xf0, 0x79, 0x9e, 0x1e, 0xcb, 0x53, 0xad, 0xc7, 0xd4, 0x87, 0x67,
+	0x64, 0x2a, 0x2d, 0xe7, 0x81, 0x8a, 0x1b, 0xdb, 0x7a, 0x1c, 0x75, 0xf5, 0x79, 0x6e, 0x47, 0x71,
+	0x72, 0x8b, 0xba, 0xd8, 0x7a, 0x92, 0xdf, 0xa8, 0x89, 0xb1, 0x7e, 0xbe, 0x02, 0x55, 0x19, 0x61,
+	0x40, 0xa1, 0xb2, 0xe7, 0xdc, 0xa3, 0x9d, 0xdc, 0x21, 0xc1, 0xab, 0x8c, 0x8b, 0x60, 0x2a, 0x3c,
+	0xe1, 0x1c, 0x80, 0x82, 0x3b, 0x77, 0x71, 0x8a, 0xc8, 0x86, 0x46, 0x31, 0xaf, 0x8b, 0x53, 0x8f,
+	0x90, 0x90, 0x2e, 0x4e, 0x01, 0xc2, 0x58, 0x06, 0x17, 0x27, 0x2f, 0x76, 0xca, 0x1b, 0xc8, 0x61,
+	0x04, 0xcb, 0x49, 0x71, 0x02, 0x84, 0xb1, 0x0c, 0xf2, 0x15, 0x98, 0xb6, 0xdb, 0xed, 0x41, 0x6f,
+	0xe0, 0x72, 0xf3, 0x7a, 0xde, 0x1a, 0x9d, 0x4b, 0x09, 0x2f, 0x29, 0x96, 0x9f, 0xa6, 0x34, 0x30,
+	0xea, 0xf2, 0xd8, 0x3b, 0x6c, 0xab, 0xe2, 0x0f, 0xf9, 0x2e, 0x7c, 0x1f, 0x78, 0x91, 0xfe, 0x0e,
+	0x39, 0x00, 0x05, 0x77, 0xe6, 0x37, 0xee, 0xba, 0xfe, 0xae, 0x1d, 0x5f, 0xb2, 0x34, 0xbe, 0x1a,
+	0x7a, 0x9d, 0xb3, 0x91, 0x82, 0x44, 0x06, 0x20, 0x87, 0xa0, 0x14, 0xd0, 0xfc, 0xd2, 0xf7, 0x7e,
+	0x78, 0xe9, 0x7d, 0xdf, 0xff, 0xe1, 0xa5, 0xf7, 0xfd, 0xe0, 0x87, 0x97, 0xde, 0xf7, 0xd3, 0x27,
+	0x97, 0x0a, 0xdf, 0x3b, 0xb9, 0x54, 0xf8, 0xfe, 0xc9, 0xa5, 0xc2, 0x0f, 0x4e, 0x2e, 0x15, 0xfe,
+	0xd3, 0xc9, 0xa5, 0xc2, 0x5f, 0xf8, 0xcf, 0x97, 0xde, 0xf7, 0xc5, 0x8f, 0x27, 0xf2, 0xaf, 0xc4,
+	0xf2, 0xaf, 0xc4, 0xd2, 0xae, 0xf4, 0x0f, 0xba, 0xac, 0x9c, 0x59, 0x98, 0x40, 0x62, 0xf9, 0xff,
+	0x6f, 0x00, 0x7e, 0x32, 0x8f, 0xf0, 0xbe, 0xd5, 0x00, 0x00,
 }
 
 func (m *AbstractPodTemplate) Marshal() (dAtA []byte, err error) {
@@ -4041,6 +4759,30 @@ func (m *AbstractSink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.HTTP != nil {
+		{
+			size, err := m.HTTP.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.File != nil {
+		{
+			size, err := m.File.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.Sqs != nil {
 		{
 			size, err := m.Sqs.MarshalToSizedBuffer(dAtA[:i])
@@ -4136,6 +4878,20 @@ func (m *AbstractVertex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DeadLetter != nil {
+		{
+			size, err := m.DeadLetter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
 	{
 		size, err := m.UpdateStrategy.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -4406,6 +5162,30 @@ func (m *Backoff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Jitter != nil {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(*m.Jitter))))
+		i--
+		dAtA[i] = 0x29
+	}
+	if m.Factor != nil {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(*m.Factor))))
+		i--
+		dAtA[i] = 0x21
+	}
+	if m.Cap != nil {
+		{
+			size, err := m.Cap.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Steps != nil {
 		i = encodeVarintGenerated(dAtA, i, uint64(*m.Steps))
 		i--
@@ -4516,6 +5296,18 @@ func (m *BufferServiceConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Disk != nil {
+		{
+			size, err := m.Disk.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.JetStream != nil {
 		{
 			size, err := m.JetStream.MarshalToSizedBuffer(dAtA[:i])
@@ -4543,6 +5335,101 @@ func (m *BufferServiceConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ClaimCheck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClaimCheck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClaimCheck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PersistentVolumeClaim != nil {
+		{
+			size, err := m.PersistentVolumeClaim.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.S3 != nil {
+		{
+			size, err := m.S3.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ThresholdBytes != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.ThresholdBytes))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClaimCheckS3) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClaimCheckS3) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClaimCheckS3) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Prefix)
+	copy(dAtA[i:], m.Prefix)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Prefix)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.EndpointURL)
+	copy(dAtA[i:], m.EndpointURL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.EndpointURL)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Region)
+	copy(dAtA[i:], m.Region)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Region)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Bucket)
+	copy(dAtA[i:], m.Bucket)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Bucket)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *CombinedEdge) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4620,6 +5507,39 @@ func (m *CombinedEdge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Compression) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Compression) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Compression) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MinPayloadBytes != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.MinPayloadBytes))
+		i--
+		dAtA[i] = 0x10
+	}
+	i -= len(m.Type)
+	copy(dAtA[i:], m.Type)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Type)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Container) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4877,6 +5797,44 @@ func (m *ContainerTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CountWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CountWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CountWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timeout != nil {
+		{
+			size, err := m.Timeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Count))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
 func (m *DaemonTemplate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4939,6 +5897,196 @@ func (m *DaemonTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DeadLetter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeadLetter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeadLetter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RetryInterval != nil {
+		{
+			size, err := m.RetryInterval.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MaxRetries != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.MaxRetries))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DiskBufferService) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DiskBufferService) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DiskBufferService) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SyncInterval != nil {
+		{
+			size, err := m.SyncInterval.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MaxSegmentSize != nil {
+		{
+			size, err := m.MaxSegmentSize.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.DiskStorage.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DiskConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DiskConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DiskConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.SyncInterval.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	i = encodeVarintGenerated(dAtA, i, uint64(m.MaxSegmentBytes))
+	i--
+	dAtA[i] = 0x10
+	{
+		size, err := m.DiskStorage.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DiskStorage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DiskStorage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DiskStorage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PersistentVolumeClaim != nil {
+		{
+			size, err := m.PersistentVolumeClaim.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.HostPath != nil {
+		{
+			size, err := m.HostPath.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Edge) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4959,6 +6107,30 @@ func (m *Edge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Priority != nil {
+		{
+			size, err := m.Priority.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Compression != nil {
+		{
+			size, err := m.Compression.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.OnFull != nil {
 		i -= len(*m.OnFull)
 		copy(dAtA[i:], *m.OnFull)
@@ -4991,6 +6163,182 @@ func (m *Edge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EdgePriority) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EdgePriority) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EdgePriority) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Weights) > 0 {
+		for iNdEx := len(m.Weights) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarintGenerated(dAtA, i, uint64(m.Weights[iNdEx]))
+			i--
+			dAtA[i] = 0x20
+		}
+	}
+	if len(m.Tags) > 0 {
+		keysForTags := make([]string, 0, len(m.Tags))
+		for k := range m.Tags {
+			keysForTags = append(keysForTags, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForTags)
+		for iNdEx := len(keysForTags) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Tags[string(keysForTags[iNdEx])]
+			baseI := i
+			i = encodeVarintGenerated(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(keysForTags[iNdEx])
+			copy(dAtA[i:], keysForTags[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForTags[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	i -= len(m.Header)
+	copy(dAtA[i:], m.Header)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Header)))
+	i--
+	dAtA[i] = 0x12
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Lanes))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *FileSink) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FileSink) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FileSink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.VolumeName)
+	copy(dAtA[i:], m.VolumeName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.VolumeName)))
+	i--
+	dAtA[i] = 0x42
+	i--
+	if m.PartitionByKeys {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x38
+	i -= len(m.EventTimeLayout)
+	copy(dAtA[i:], m.EventTimeLayout)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.EventTimeLayout)))
+	i--
+	dAtA[i] = 0x32
+	if m.RollInterval != nil {
+		{
+			size, err := m.RollInterval.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.MaxFileBytes))
+	i--
+	dAtA[i] = 0x20
+	i -= len(m.Encoding)
+	copy(dAtA[i:], m.Encoding)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Encoding)))
+	i--
+	dAtA[i] = 0x1a
+	if m.S3 != nil {
+		{
+			size, err := m.S3.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	i -= len(m.Path)
+	copy(dAtA[i:], m.Path)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Path)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *FileSinkS3) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FileSinkS3) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FileSinkS3) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.EndpointURL)
+	copy(dAtA[i:], m.EndpointURL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.EndpointURL)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Region)
+	copy(dAtA[i:], m.Region)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Region)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Bucket)
+	copy(dAtA[i:], m.Bucket)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Bucket)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *FixedWindow) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5054,6 +6402,11 @@ func (m *ForwardConditions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.Expression)
+	copy(dAtA[i:], m.Expression)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Expression)))
+	i--
+	dAtA[i] = 0x12
 	if m.Tags != nil {
 		{
 			size, err := m.Tags.MarshalToSizedBuffer(dAtA[:i])
@@ -5218,6 +6571,101 @@ func (m *GSSAPI) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GeneratorOutOfOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GeneratorOutOfOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GeneratorOutOfOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Delay.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Percent))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *GeneratorReplay) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GeneratorReplay) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GeneratorReplay) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i--
+	if m.RewriteEventTime {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x30
+	i -= len(m.EventTimeField)
+	copy(dAtA[i:], m.EventTimeField)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.EventTimeField)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.KeyField)
+	copy(dAtA[i:], m.KeyField)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.KeyField)))
+	i--
+	dAtA[i] = 0x22
+	i--
+	if m.Loop {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x18
+	i -= len(m.VolumeName)
+	copy(dAtA[i:], m.VolumeName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.VolumeName)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Path)
+	copy(dAtA[i:], m.Path)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Path)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *GeneratorSource) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5238,6 +6686,37 @@ func (m *GeneratorSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.OutOfOrder != nil {
+		{
+			size, err := m.OutOfOrder.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Replay != nil {
+		{
+			size, err := m.Replay.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Template != nil {
+		i -= len(*m.Template)
+		copy(dAtA[i:], *m.Template)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.Template)))
+		i--
+		dAtA[i] = 0x42
+	}
 	if m.ValueBlob != nil {
 		i -= len(*m.ValueBlob)
 		copy(dAtA[i:], *m.ValueBlob)
@@ -6031,6 +7510,34 @@ func (m *GetVertexPodSpecReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GlobalWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GlobalWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GlobalWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Trigger)
+	copy(dAtA[i:], m.Trigger)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Trigger)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *GroupBy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6051,6 +7558,11 @@ func (m *GroupBy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.LateDataTag)
+	copy(dAtA[i:], m.LateDataTag)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.LateDataTag)))
+	i--
+	dAtA[i] = 0x2a
 	if m.Storage != nil {
 		{
 			size, err := m.Storage.MarshalToSizedBuffer(dAtA[:i])
@@ -6096,6 +7608,190 @@ func (m *GroupBy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HTTPBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HTTPBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HTTPBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.EventTimeField)
+	copy(dAtA[i:], m.EventTimeField)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.EventTimeField)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.IDField)
+	copy(dAtA[i:], m.IDField)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.IDField)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *HTTPSink) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HTTPSink) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HTTPSink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Concurrency))
+	i--
+	dAtA[i] = 0x48
+	if m.Timeout != nil {
+		{
+			size, err := m.Timeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.TLS != nil {
+		{
+			size, err := m.TLS.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.BearerTokenSecret != nil {
+		{
+			size, err := m.BearerTokenSecret.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.BasicAuth != nil {
+		{
+			size, err := m.BasicAuth.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Batch != nil {
+		{
+			size, err := m.Batch.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Headers) > 0 {
+		keysForHeaders := make([]string, 0, len(m.Headers))
+		for k := range m.Headers {
+			keysForHeaders = append(keysForHeaders, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForHeaders)
+		for iNdEx := len(keysForHeaders) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Headers[string(keysForHeaders[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForHeaders[iNdEx])
+			copy(dAtA[i:], keysForHeaders[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForHeaders[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	i -= len(m.Method)
+	copy(dAtA[i:], m.Method)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Method)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.URL)
+	copy(dAtA[i:], m.URL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *HTTPSinkBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HTTPSinkBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HTTPSinkBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Format)
+	copy(dAtA[i:], m.Format)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Format)))
+	i--
+	dAtA[i] = 0x12
+	i = encodeVarintGenerated(dAtA, i, uint64(m.MaxMessages))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
 func (m *HTTPSource) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6116,6 +7812,38 @@ func (m *HTTPSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AckTimeout != nil {
+		{
+			size, err := m.AckTimeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	i--
+	if m.AckAfterWrite {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x20
+	if m.Batch != nil {
+		{
+			size, err := m.Batch.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	i--
 	if m.Service {
 		dAtA[i] = 1
@@ -6198,6 +7926,41 @@ func (m *IdleSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *InterStepBuffer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InterStepBuffer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InterStepBuffer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Compression != nil {
+		{
+			size, err := m.Compression.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *InterStepBufferService) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6318,6 +8081,18 @@ func (m *InterStepBufferServiceSpec) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	if m.Disk != nil {
+		{
+			size, err := m.Disk.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.JetStream != nil {
 		{
 			size, err := m.JetStream.MarshalToSizedBuffer(dAtA[:i])
@@ -6609,6 +8384,63 @@ func (m *JetStreamSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.KeyFromHeader)
+	copy(dAtA[i:], m.KeyFromHeader)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.KeyFromHeader)))
+	i--
+	dAtA[i] = 0x62
+	i--
+	if m.Ephemeral {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x58
+	if m.AckWait != nil {
+		{
+			size, err := m.AckWait.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.MaxAckPending))
+	i--
+	dAtA[i] = 0x48
+	i = encodeVarintGenerated(dAtA, i, uint64(m.StartSequence))
+	i--
+	dAtA[i] = 0x40
+	if m.StartTime != nil {
+		{
+			size, err := m.StartTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	i -= len(m.DeliverPolicy)
+	copy(dAtA[i:], m.DeliverPolicy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DeliverPolicy)))
+	i--
+	dAtA[i] = 0x32
+	if len(m.FilterSubjects) > 0 {
+		for iNdEx := len(m.FilterSubjects) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FilterSubjects[iNdEx])
+			copy(dAtA[i:], m.FilterSubjects[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.FilterSubjects[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if m.Auth != nil {
 		{
 			size, err := m.Auth.MarshalToSizedBuffer(dAtA[:i])
@@ -6721,6 +8553,56 @@ func (m *KafkaSink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i--
+	if m.ExactlyOnce {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x70
+	i = encodeVarintGenerated(dAtA, i, uint64(m.MaxMessageBytes))
+	i--
+	dAtA[i] = 0x68
+	i -= len(m.Compression)
+	copy(dAtA[i:], m.Compression)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Compression)))
+	i--
+	dAtA[i] = 0x62
+	i--
+	if m.Idempotent {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x58
+	i--
+	if m.SetTimestamp {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x50
+	i--
+	if m.ForwardHeaders {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x48
+	i -= len(m.PartitionHeader)
+	copy(dAtA[i:], m.PartitionHeader)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PartitionHeader)))
+	i--
+	dAtA[i] = 0x42
+	i -= len(m.Partitioner)
+	copy(dAtA[i:], m.Partitioner)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Partitioner)))
+	i--
+	dAtA[i] = 0x3a
 	if m.SASL != nil {
 		{
 			size, err := m.SASL.MarshalToSizedBuffer(dAtA[:i])
@@ -6795,6 +8677,28 @@ func (m *KafkaSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.EventTimeSource)
+	copy(dAtA[i:], m.EventTimeSource)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.EventTimeSource)))
+	i--
+	dAtA[i] = 0x52
+	i -= len(m.KeyHeader)
+	copy(dAtA[i:], m.KeyHeader)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.KeyHeader)))
+	i--
+	dAtA[i] = 0x4a
+	if m.StartPosition != nil {
+		{
+			size, err := m.StartPosition.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
 	i -= len(m.KafkaVersion)
 	copy(dAtA[i:], m.KafkaVersion)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.KafkaVersion)))
@@ -6851,6 +8755,46 @@ func (m *KafkaSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *KafkaStartPosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KafkaStartPosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KafkaStartPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timestamp != nil {
+		{
+			size, err := m.Timestamp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	i -= len(m.Policy)
+	copy(dAtA[i:], m.Policy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Policy)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Lifecycle) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6914,6 +8858,60 @@ func (m *Log) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.MaxPayloadBytes))
+	i--
+	dAtA[i] = 0x20
+	if m.Sampling != nil {
+		{
+			size, err := m.Sampling.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	i -= len(m.PayloadEncoding)
+	copy(dAtA[i:], m.PayloadEncoding)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PayloadEncoding)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Format)
+	copy(dAtA[i:], m.Format)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Format)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *LogSampling) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LogSampling) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LogSampling) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.PerSecond))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintGenerated(dAtA, i, uint64(m.EveryN))
+	i--
+	dAtA[i] = 0x8
 	return len(dAtA) - i, nil
 }
 
@@ -7621,6 +9619,19 @@ func (m *NatsSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.OverflowSize))
+	i--
+	dAtA[i] = 0x40
+	i -= len(m.EventTimeFromHeader)
+	copy(dAtA[i:], m.EventTimeFromHeader)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.EventTimeFromHeader)))
+	i--
+	dAtA[i] = 0x3a
+	i -= len(m.KeyFromHeader)
+	copy(dAtA[i:], m.KeyFromHeader)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.KeyFromHeader)))
+	i--
+	dAtA[i] = 0x32
 	if m.Auth != nil {
 		{
 			size, err := m.Auth.MarshalToSizedBuffer(dAtA[:i])
@@ -7867,6 +9878,18 @@ func (m *PipelineLimits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ClaimCheck != nil {
+		{
+			size, err := m.ClaimCheck.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.ReadTimeout != nil {
 		{
 			size, err := m.ReadTimeout.MarshalToSizedBuffer(dAtA[:i])
@@ -7964,6 +9987,18 @@ func (m *PipelineSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.InterStepBuffer != nil {
+		{
+			size, err := m.InterStepBuffer.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
 	if len(m.SideInputs) > 0 {
 		for iNdEx := len(m.SideInputs) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -8255,6 +10290,11 @@ func (m *PulsarSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.AdminURL)
+	copy(dAtA[i:], m.AdminURL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.AdminURL)))
+	i--
+	dAtA[i] = 0x3a
 	if m.Auth != nil {
 		{
 			size, err := m.Auth.MarshalToSizedBuffer(dAtA[:i])
@@ -8360,6 +10400,31 @@ func (m *RedisConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i--
+	if m.Cluster {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x48
+	if m.TLS != nil {
+		{
+			size, err := m.TLS.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	i -= len(m.SentinelUser)
+	copy(dAtA[i:], m.SentinelUser)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SentinelUser)))
+	i--
+	dAtA[i] = 0x3a
 	if m.SentinelPassword != nil {
 		{
 			size, err := m.SentinelPassword.MarshalToSizedBuffer(dAtA[:i])
@@ -9650,6 +11715,13 @@ func (m *SqsSink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.EndpointURL != nil {
+		i -= len(*m.EndpointURL)
+		copy(dAtA[i:], *m.EndpointURL)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.EndpointURL)))
+		i--
+		dAtA[i] = 0x22
+	}
 	i -= len(m.QueueOwnerAWSAccountID)
 	copy(dAtA[i:], m.QueueOwnerAWSAccountID)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.QueueOwnerAWSAccountID)))
@@ -10478,6 +12550,18 @@ func (m *VertexSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ClaimCheck != nil {
+		{
+			size, err := m.ClaimCheck.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
 	{
 		size, err := m.Lifecycle.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -10777,6 +12861,30 @@ func (m *Window) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Global != nil {
+		{
+			size, err := m.Global.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Count != nil {
+		{
+			size, err := m.Count.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.Accumulator != nil {
 		{
 			size, err := m.Accumulator.MarshalToSizedBuffer(dAtA[:i])
@@ -10936,6 +13044,14 @@ func (m *AbstractSink) Size() (n int) {
 		l = m.Sqs.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.File != nil {
+		l = m.File.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.HTTP != nil {
+		l = m.HTTP.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -11008,6 +13124,10 @@ func (m *AbstractVertex) Size() (n int) {
 	}
 	l = m.UpdateStrategy.Size()
 	n += 2 + l + sovGenerated(uint64(l))
+	if m.DeadLetter != nil {
+		l = m.DeadLetter.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -11050,6 +13170,16 @@ func (m *Backoff) Size() (n int) {
 	if m.Steps != nil {
 		n += 1 + sovGenerated(uint64(*m.Steps))
 	}
+	if m.Cap != nil {
+		l = m.Cap.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Factor != nil {
+		n += 9
+	}
+	if m.Jitter != nil {
+		n += 9
+	}
 	return n
 }
 
@@ -11093,6 +13223,47 @@ func (m *BufferServiceConfig) Size() (n int) {
 		l = m.JetStream.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Disk != nil {
+		l = m.Disk.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *ClaimCheck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ThresholdBytes != nil {
+		n += 1 + sovGenerated(uint64(*m.ThresholdBytes))
+	}
+	if m.S3 != nil {
+		l = m.S3.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.PersistentVolumeClaim != nil {
+		l = m.PersistentVolumeClaim.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *ClaimCheckS3) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Bucket)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Region)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.EndpointURL)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Prefix)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -11125,6 +13296,20 @@ func (m *CombinedEdge) Size() (n int) {
 	return n
 }
 
+func (m *Compression) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.MinPayloadBytes != nil {
+		n += 1 + sovGenerated(uint64(*m.MinPayloadBytes))
+	}
+	return n
+}
+
 func (m *Container) Size() (n int) {
 	if m == nil {
 		return 0
@@ -11227,6 +13412,20 @@ func (m *ContainerTemplate) Size() (n int) {
 	return n
 }
 
+func (m *CountWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.Count))
+	if m.Timeout != nil {
+		l = m.Timeout.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *DaemonTemplate) Size() (n int) {
 	if m == nil {
 		return 0
@@ -11249,6 +13448,72 @@ func (m *DaemonTemplate) Size() (n int) {
 	return n
 }
 
+func (m *DeadLetter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxRetries != nil {
+		n += 1 + sovGenerated(uint64(*m.MaxRetries))
+	}
+	if m.RetryInterval != nil {
+		l = m.RetryInterval.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *DiskBufferService) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DiskStorage.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.MaxSegmentSize != nil {
+		l = m.MaxSegmentSize.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.SyncInterval != nil {
+		l = m.SyncInterval.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *DiskConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DiskStorage.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.MaxSegmentBytes))
+	l = m.SyncInterval.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *DiskStorage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HostPath != nil {
+		l = m.HostPath.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.PersistentVolumeClaim != nil {
+		l = m.PersistentVolumeClaim.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *Edge) Size() (n int) {
 	if m == nil {
 		return 0
@@ -11267,6 +13532,81 @@ func (m *Edge) Size() (n int) {
 		l = len(*m.OnFull)
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Compression != nil {
+		l = m.Compression.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Priority != nil {
+		l = m.Priority.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *EdgePriority) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.Lanes))
+	l = len(m.Header)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Tags) > 0 {
+		for k, v := range m.Tags {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + sovGenerated(uint64(v))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Weights) > 0 {
+		for _, e := range m.Weights {
+			n += 1 + sovGenerated(uint64(e))
+		}
+	}
+	return n
+}
+
+func (m *FileSink) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.S3 != nil {
+		l = m.S3.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.Encoding)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.MaxFileBytes))
+	if m.RollInterval != nil {
+		l = m.RollInterval.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.EventTimeLayout)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	l = len(m.VolumeName)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *FileSinkS3) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Bucket)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Region)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.EndpointURL)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -11294,6 +13634,8 @@ func (m *ForwardConditions) Size() (n int) {
 		l = m.Tags.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	l = len(m.Expression)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -11355,6 +13697,37 @@ func (m *GSSAPI) Size() (n int) {
 	return n
 }
 
+func (m *GeneratorOutOfOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.Percent))
+	l = m.Delay.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *GeneratorReplay) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.VolumeName)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	l = len(m.KeyField)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.EventTimeField)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	return n
+}
+
 func (m *GeneratorSource) Size() (n int) {
 	if m == nil {
 		return 0
@@ -11385,6 +13758,18 @@ func (m *GeneratorSource) Size() (n int) {
 		l = len(*m.ValueBlob)
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Template != nil {
+		l = len(*m.Template)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Replay != nil {
+		l = m.Replay.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.OutOfOrder != nil {
+		l = m.OutOfOrder.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -11652,6 +14037,17 @@ func (m *GetVertexPodSpecReq) Size() (n int) {
 	return n
 }
 
+func (m *GlobalWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Trigger)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *GroupBy) Size() (n int) {
 	if m == nil {
 		return 0
@@ -11669,6 +14065,75 @@ func (m *GroupBy) Size() (n int) {
 		l = m.Storage.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	l = len(m.LateDataTag)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *HTTPBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.IDField)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.EventTimeField)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *HTTPSink) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.URL)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Method)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Headers) > 0 {
+		for k, v := range m.Headers {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if m.Batch != nil {
+		l = m.Batch.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.BasicAuth != nil {
+		l = m.BasicAuth.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.BearerTokenSecret != nil {
+		l = m.BearerTokenSecret.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.TLS != nil {
+		l = m.TLS.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Timeout != nil {
+		l = m.Timeout.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 1 + sovGenerated(uint64(m.Concurrency))
+	return n
+}
+
+func (m *HTTPSinkBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.MaxMessages))
+	l = len(m.Format)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -11683,6 +14148,15 @@ func (m *HTTPSource) Size() (n int) {
 		n += 1 + l + sovGenerated(uint64(l))
 	}
 	n += 2
+	if m.Batch != nil {
+		l = m.Batch.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 2
+	if m.AckTimeout != nil {
+		l = m.AckTimeout.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -11707,6 +14181,19 @@ func (m *IdleSource) Size() (n int) {
 	return n
 }
 
+func (m *InterStepBuffer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Compression != nil {
+		l = m.Compression.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *InterStepBufferService) Size() (n int) {
 	if m == nil {
 		return 0
@@ -11753,6 +14240,10 @@ func (m *InterStepBufferServiceSpec) Size() (n int) {
 		l = m.JetStream.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Disk != nil {
+		l = m.Disk.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -11860,6 +14351,27 @@ func (m *JetStreamSource) Size() (n int) {
 		l = m.Auth.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if len(m.FilterSubjects) > 0 {
+		for _, s := range m.FilterSubjects {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.DeliverPolicy)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.StartTime != nil {
+		l = m.StartTime.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 1 + sovGenerated(uint64(m.StartSequence))
+	n += 1 + sovGenerated(uint64(m.MaxAckPending))
+	if m.AckWait != nil {
+		l = m.AckWait.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 2
+	l = len(m.KeyFromHeader)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -11909,6 +14421,17 @@ func (m *KafkaSink) Size() (n int) {
 		l = m.SASL.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	l = len(m.Partitioner)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.PartitionHeader)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	n += 2
+	n += 2
+	l = len(m.Compression)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.MaxMessageBytes))
+	n += 2
 	return n
 }
 
@@ -11940,6 +14463,29 @@ func (m *KafkaSource) Size() (n int) {
 	}
 	l = len(m.KafkaVersion)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.StartPosition != nil {
+		l = m.StartPosition.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.KeyHeader)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.EventTimeSource)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *KafkaStartPosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Policy)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Timestamp != nil {
+		l = m.Timestamp.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -11969,6 +14515,26 @@ func (m *Log) Size() (n int) {
 	}
 	var l int
 	_ = l
+	l = len(m.Format)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.PayloadEncoding)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Sampling != nil {
+		l = m.Sampling.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 1 + sovGenerated(uint64(m.MaxPayloadBytes))
+	return n
+}
+
+func (m *LogSampling) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.EveryN))
+	n += 1 + sovGenerated(uint64(m.PerSecond))
 	return n
 }
 
@@ -12228,6 +14794,11 @@ func (m *NatsSource) Size() (n int) {
 		l = m.Auth.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	l = len(m.KeyFromHeader)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.EventTimeFromHeader)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.OverflowSize))
 	return n
 }
 
@@ -12316,6 +14887,10 @@ func (m *PipelineLimits) Size() (n int) {
 		l = m.ReadTimeout.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.ClaimCheck != nil {
+		l = m.ClaimCheck.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -12374,6 +14949,10 @@ func (m *PipelineSpec) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if m.InterStepBuffer != nil {
+		l = m.InterStepBuffer.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -12470,6 +15049,8 @@ func (m *PulsarSource) Size() (n int) {
 		l = m.Auth.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	l = len(m.AdminURL)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -12512,6 +15093,13 @@ func (m *RedisConfig) Size() (n int) {
 		l = m.SentinelPassword.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	l = len(m.SentinelUser)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.TLS != nil {
+		l = m.TLS.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 2
 	return n
 }
 
@@ -12971,6 +15559,10 @@ func (m *SqsSink) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.QueueOwnerAWSAccountID)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.EndpointURL != nil {
+		l = len(*m.EndpointURL)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -13311,6 +15903,10 @@ func (m *VertexSpec) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = m.Lifecycle.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.ClaimCheck != nil {
+		l = m.ClaimCheck.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -13404,6 +16000,14 @@ func (m *Window) Size() (n int) {
 		l = m.Accumulator.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Count != nil {
+		l = m.Count.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Global != nil {
+		l = m.Global.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -13472,6 +16076,8 @@ func (this *AbstractSink) String() string {
 		`UDSink:` + strings.Replace(this.UDSink.String(), "UDSink", "UDSink", 1) + `,`,
 		`Serve:` + strings.Replace(this.Serve.String(), "ServeSink", "ServeSink", 1) + `,`,
 		`Sqs:` + strings.Replace(this.Sqs.String(), "SqsSink", "SqsSink", 1) + `,`,
+		`File:` + strings.Replace(this.File.String(), "FileSink", "FileSink", 1) + `,`,
+		`HTTP:` + strings.Replace(this.HTTP.String(), "HTTPSink", "HTTPSink", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -13512,6 +16118,7 @@ func (this *AbstractVertex) String() string {
 		`SideInputs:` + fmt.Sprintf("%v", this.SideInputs) + `,`,
 		`SideInputsContainerTemplate:` + strings.Replace(this.SideInputsContainerTemplate.String(), "ContainerTemplate", "ContainerTemplate", 1) + `,`,
 		`UpdateStrategy:` + strings.Replace(strings.Replace(this.UpdateStrategy.String(), "UpdateStrategy", "UpdateStrategy", 1), `&`, ``, 1) + `,`,
+		`DeadLetter:` + strings.Replace(this.DeadLetter.String(), "DeadLetter", "DeadLetter", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -13543,6 +16150,9 @@ func (this *Backoff) String() string {
 	s := strings.Join([]string{`&Backoff{`,
 		`Interval:` + strings.Replace(fmt.Sprintf("%v", this.Interval), "Duration", "v11.Duration", 1) + `,`,
 		`Steps:` + valueToStringGenerated(this.Steps) + `,`,
+		`Cap:` + strings.Replace(fmt.Sprintf("%v", this.Cap), "Duration", "v11.Duration", 1) + `,`,
+		`Factor:` + valueToStringGenerated(this.Factor) + `,`,
+		`Jitter:` + valueToStringGenerated(this.Jitter) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -13574,6 +16184,32 @@ func (this *BufferServiceConfig) String() string {
 	s := strings.Join([]string{`&BufferServiceConfig{`,
 		`Redis:` + strings.Replace(this.Redis.String(), "RedisConfig", "RedisConfig", 1) + `,`,
 		`JetStream:` + strings.Replace(this.JetStream.String(), "JetStreamConfig", "JetStreamConfig", 1) + `,`,
+		`Disk:` + strings.Replace(this.Disk.String(), "DiskConfig", "DiskConfig", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ClaimCheck) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ClaimCheck{`,
+		`ThresholdBytes:` + valueToStringGenerated(this.ThresholdBytes) + `,`,
+		`S3:` + strings.Replace(this.S3.String(), "ClaimCheckS3", "ClaimCheckS3", 1) + `,`,
+		`PersistentVolumeClaim:` + strings.Replace(fmt.Sprintf("%v", this.PersistentVolumeClaim), "PersistentVolumeClaimVolumeSource", "v1.PersistentVolumeClaimVolumeSource", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ClaimCheckS3) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ClaimCheckS3{`,
+		`Bucket:` + fmt.Sprintf("%v", this.Bucket) + `,`,
+		`Region:` + fmt.Sprintf("%v", this.Region) + `,`,
+		`EndpointURL:` + fmt.Sprintf("%v", this.EndpointURL) + `,`,
+		`Prefix:` + fmt.Sprintf("%v", this.Prefix) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -13594,6 +16230,17 @@ func (this *CombinedEdge) String() string {
 	}, "")
 	return s
 }
+func (this *Compression) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Compression{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`MinPayloadBytes:` + valueToStringGenerated(this.MinPayloadBytes) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Container) String() string {
 	if this == nil {
 		return "nil"
@@ -13661,6 +16308,17 @@ func (this *ContainerTemplate) String() string {
 	}, "")
 	return s
 }
+func (this *CountWindow) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CountWindow{`,
+		`Count:` + fmt.Sprintf("%v", this.Count) + `,`,
+		`Timeout:` + strings.Replace(fmt.Sprintf("%v", this.Timeout), "Duration", "v11.Duration", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *DaemonTemplate) String() string {
 	if this == nil {
 		return "nil"
@@ -13674,6 +16332,52 @@ func (this *DaemonTemplate) String() string {
 	}, "")
 	return s
 }
+func (this *DeadLetter) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeadLetter{`,
+		`MaxRetries:` + valueToStringGenerated(this.MaxRetries) + `,`,
+		`RetryInterval:` + strings.Replace(fmt.Sprintf("%v", this.RetryInterval), "Duration", "v11.Duration", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DiskBufferService) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DiskBufferService{`,
+		`DiskStorage:` + strings.Replace(strings.Replace(this.DiskStorage.String(), "DiskStorage", "DiskStorage", 1), `&`, ``, 1) + `,`,
+		`MaxSegmentSize:` + strings.Replace(fmt.Sprintf("%v", this.MaxSegmentSize), "Quantity", "resource.Quantity", 1) + `,`,
+		`SyncInterval:` + strings.Replace(fmt.Sprintf("%v", this.SyncInterval), "Duration", "v11.Duration", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DiskConfig) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DiskConfig{`,
+		`DiskStorage:` + strings.Replace(strings.Replace(this.DiskStorage.String(), "DiskStorage", "DiskStorage", 1), `&`, ``, 1) + `,`,
+		`MaxSegmentBytes:` + fmt.Sprintf("%v", this.MaxSegmentBytes) + `,`,
+		`SyncInterval:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.SyncInterval), "Duration", "v11.Duration", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DiskStorage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DiskStorage{`,
+		`HostPath:` + strings.Replace(fmt.Sprintf("%v", this.HostPath), "HostPathVolumeSource", "v1.HostPathVolumeSource", 1) + `,`,
+		`PersistentVolumeClaim:` + strings.Replace(fmt.Sprintf("%v", this.PersistentVolumeClaim), "PersistentVolumeClaimVolumeSource", "v1.PersistentVolumeClaimVolumeSource", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Edge) String() string {
 	if this == nil {
 		return "nil"
@@ -13683,6 +16387,60 @@ func (this *Edge) String() string {
 		`To:` + fmt.Sprintf("%v", this.To) + `,`,
 		`Conditions:` + strings.Replace(this.Conditions.String(), "ForwardConditions", "ForwardConditions", 1) + `,`,
 		`OnFull:` + valueToStringGenerated(this.OnFull) + `,`,
+		`Compression:` + strings.Replace(this.Compression.String(), "Compression", "Compression", 1) + `,`,
+		`Priority:` + strings.Replace(this.Priority.String(), "EdgePriority", "EdgePriority", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EdgePriority) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForTags := make([]string, 0, len(this.Tags))
+	for k := range this.Tags {
+		keysForTags = append(keysForTags, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForTags)
+	mapStringForTags := "map[string]int32{"
+	for _, k := range keysForTags {
+		mapStringForTags += fmt.Sprintf("%v: %v,", k, this.Tags[k])
+	}
+	mapStringForTags += "}"
+	s := strings.Join([]string{`&EdgePriority{`,
+		`Lanes:` + fmt.Sprintf("%v", this.Lanes) + `,`,
+		`Header:` + fmt.Sprintf("%v", this.Header) + `,`,
+		`Tags:` + mapStringForTags + `,`,
+		`Weights:` + fmt.Sprintf("%v", this.Weights) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FileSink) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&FileSink{`,
+		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
+		`S3:` + strings.Replace(this.S3.String(), "FileSinkS3", "FileSinkS3", 1) + `,`,
+		`Encoding:` + fmt.Sprintf("%v", this.Encoding) + `,`,
+		`MaxFileBytes:` + fmt.Sprintf("%v", this.MaxFileBytes) + `,`,
+		`RollInterval:` + strings.Replace(fmt.Sprintf("%v", this.RollInterval), "Duration", "v11.Duration", 1) + `,`,
+		`EventTimeLayout:` + fmt.Sprintf("%v", this.EventTimeLayout) + `,`,
+		`PartitionByKeys:` + fmt.Sprintf("%v", this.PartitionByKeys) + `,`,
+		`VolumeName:` + fmt.Sprintf("%v", this.VolumeName) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FileSinkS3) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&FileSinkS3{`,
+		`Bucket:` + fmt.Sprintf("%v", this.Bucket) + `,`,
+		`Region:` + fmt.Sprintf("%v", this.Region) + `,`,
+		`EndpointURL:` + fmt.Sprintf("%v", this.EndpointURL) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -13704,6 +16462,7 @@ func (this *ForwardConditions) String() string {
 	}
 	s := strings.Join([]string{`&ForwardConditions{`,
 		`Tags:` + strings.Replace(this.Tags.String(), "TagConditions", "TagConditions", 1) + `,`,
+		`Expression:` + fmt.Sprintf("%v", this.Expression) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -13746,6 +16505,32 @@ func (this *GSSAPI) String() string {
 	}, "")
 	return s
 }
+func (this *GeneratorOutOfOrder) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GeneratorOutOfOrder{`,
+		`Percent:` + fmt.Sprintf("%v", this.Percent) + `,`,
+		`Delay:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Delay), "Duration", "v11.Duration", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GeneratorReplay) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GeneratorReplay{`,
+		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
+		`VolumeName:` + fmt.Sprintf("%v", this.VolumeName) + `,`,
+		`Loop:` + fmt.Sprintf("%v", this.Loop) + `,`,
+		`KeyField:` + fmt.Sprintf("%v", this.KeyField) + `,`,
+		`EventTimeField:` + fmt.Sprintf("%v", this.EventTimeField) + `,`,
+		`RewriteEventTime:` + fmt.Sprintf("%v", this.RewriteEventTime) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *GeneratorSource) String() string {
 	if this == nil {
 		return "nil"
@@ -13758,6 +16543,9 @@ func (this *GeneratorSource) String() string {
 		`Value:` + valueToStringGenerated(this.Value) + `,`,
 		`Jitter:` + strings.Replace(fmt.Sprintf("%v", this.Jitter), "Duration", "v11.Duration", 1) + `,`,
 		`ValueBlob:` + valueToStringGenerated(this.ValueBlob) + `,`,
+		`Template:` + valueToStringGenerated(this.Template) + `,`,
+		`Replay:` + strings.Replace(this.Replay.String(), "GeneratorReplay", "GeneratorReplay", 1) + `,`,
+		`OutOfOrder:` + strings.Replace(this.OutOfOrder.String(), "GeneratorOutOfOrder", "GeneratorOutOfOrder", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -13992,6 +16780,16 @@ func (this *GetVertexPodSpecReq) String() string {
 	}, "")
 	return s
 }
+func (this *GlobalWindow) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GlobalWindow{`,
+		`Trigger:` + fmt.Sprintf("%v", this.Trigger) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *GroupBy) String() string {
 	if this == nil {
 		return "nil"
@@ -14001,6 +16799,57 @@ func (this *GroupBy) String() string {
 		`Keyed:` + fmt.Sprintf("%v", this.Keyed) + `,`,
 		`AllowedLateness:` + strings.Replace(fmt.Sprintf("%v", this.AllowedLateness), "Duration", "v11.Duration", 1) + `,`,
 		`Storage:` + strings.Replace(this.Storage.String(), "PBQStorage", "PBQStorage", 1) + `,`,
+		`LateDataTag:` + fmt.Sprintf("%v", this.LateDataTag) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HTTPBatch) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HTTPBatch{`,
+		`IDField:` + fmt.Sprintf("%v", this.IDField) + `,`,
+		`EventTimeField:` + fmt.Sprintf("%v", this.EventTimeField) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HTTPSink) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForHeaders := make([]string, 0, len(this.Headers))
+	for k := range this.Headers {
+		keysForHeaders = append(keysForHeaders, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForHeaders)
+	mapStringForHeaders := "map[string]string{"
+	for _, k := range keysForHeaders {
+		mapStringForHeaders += fmt.Sprintf("%v: %v,", k, this.Headers[k])
+	}
+	mapStringForHeaders += "}"
+	s := strings.Join([]string{`&HTTPSink{`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`Method:` + fmt.Sprintf("%v", this.Method) + `,`,
+		`Headers:` + mapStringForHeaders + `,`,
+		`Batch:` + strings.Replace(this.Batch.String(), "HTTPSinkBatch", "HTTPSinkBatch", 1) + `,`,
+		`BasicAuth:` + strings.Replace(this.BasicAuth.String(), "BasicAuth", "BasicAuth", 1) + `,`,
+		`BearerTokenSecret:` + strings.Replace(fmt.Sprintf("%v", this.BearerTokenSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`TLS:` + strings.Replace(this.TLS.String(), "TLS", "TLS", 1) + `,`,
+		`Timeout:` + strings.Replace(fmt.Sprintf("%v", this.Timeout), "Duration", "v11.Duration", 1) + `,`,
+		`Concurrency:` + fmt.Sprintf("%v", this.Concurrency) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HTTPSinkBatch) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HTTPSinkBatch{`,
+		`MaxMessages:` + fmt.Sprintf("%v", this.MaxMessages) + `,`,
+		`Format:` + fmt.Sprintf("%v", this.Format) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14012,6 +16861,9 @@ func (this *HTTPSource) String() string {
 	s := strings.Join([]string{`&HTTPSource{`,
 		`Auth:` + strings.Replace(this.Auth.String(), "Authorization", "Authorization", 1) + `,`,
 		`Service:` + fmt.Sprintf("%v", this.Service) + `,`,
+		`Batch:` + strings.Replace(this.Batch.String(), "HTTPBatch", "HTTPBatch", 1) + `,`,
+		`AckAfterWrite:` + fmt.Sprintf("%v", this.AckAfterWrite) + `,`,
+		`AckTimeout:` + strings.Replace(fmt.Sprintf("%v", this.AckTimeout), "Duration", "v11.Duration", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14028,6 +16880,16 @@ func (this *IdleSource) String() string {
 	}, "")
 	return s
 }
+func (this *InterStepBuffer) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&InterStepBuffer{`,
+		`Compression:` + strings.Replace(this.Compression.String(), "Compression", "Compression", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *InterStepBufferService) String() string {
 	if this == nil {
 		return "nil"
@@ -14063,6 +16925,7 @@ func (this *InterStepBufferServiceSpec) String() string {
 	s := strings.Join([]string{`&InterStepBufferServiceSpec{`,
 		`Redis:` + strings.Replace(this.Redis.String(), "RedisBufferService", "RedisBufferService", 1) + `,`,
 		`JetStream:` + strings.Replace(this.JetStream.String(), "JetStreamBufferService", "JetStreamBufferService", 1) + `,`,
+		`Disk:` + strings.Replace(this.Disk.String(), "DiskBufferService", "DiskBufferService", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14125,6 +16988,14 @@ func (this *JetStreamSource) String() string {
 		`Stream:` + fmt.Sprintf("%v", this.Stream) + `,`,
 		`TLS:` + strings.Replace(this.TLS.String(), "TLS", "TLS", 1) + `,`,
 		`Auth:` + strings.Replace(this.Auth.String(), "NatsAuth", "NatsAuth", 1) + `,`,
+		`FilterSubjects:` + fmt.Sprintf("%v", this.FilterSubjects) + `,`,
+		`DeliverPolicy:` + fmt.Sprintf("%v", this.DeliverPolicy) + `,`,
+		`StartTime:` + strings.Replace(fmt.Sprintf("%v", this.StartTime), "Time", "v11.Time", 1) + `,`,
+		`StartSequence:` + fmt.Sprintf("%v", this.StartSequence) + `,`,
+		`MaxAckPending:` + fmt.Sprintf("%v", this.MaxAckPending) + `,`,
+		`AckWait:` + strings.Replace(fmt.Sprintf("%v", this.AckWait), "Duration", "v11.Duration", 1) + `,`,
+		`Ephemeral:` + fmt.Sprintf("%v", this.Ephemeral) + `,`,
+		`KeyFromHeader:` + fmt.Sprintf("%v", this.KeyFromHeader) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14153,6 +17024,14 @@ func (this *KafkaSink) String() string {
 		`TLS:` + strings.Replace(this.TLS.String(), "TLS", "TLS", 1) + `,`,
 		`Config:` + fmt.Sprintf("%v", this.Config) + `,`,
 		`SASL:` + strings.Replace(this.SASL.String(), "SASL", "SASL", 1) + `,`,
+		`Partitioner:` + fmt.Sprintf("%v", this.Partitioner) + `,`,
+		`PartitionHeader:` + fmt.Sprintf("%v", this.PartitionHeader) + `,`,
+		`ForwardHeaders:` + fmt.Sprintf("%v", this.ForwardHeaders) + `,`,
+		`SetTimestamp:` + fmt.Sprintf("%v", this.SetTimestamp) + `,`,
+		`Idempotent:` + fmt.Sprintf("%v", this.Idempotent) + `,`,
+		`Compression:` + fmt.Sprintf("%v", this.Compression) + `,`,
+		`MaxMessageBytes:` + fmt.Sprintf("%v", this.MaxMessageBytes) + `,`,
+		`ExactlyOnce:` + fmt.Sprintf("%v", this.ExactlyOnce) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14169,6 +17048,20 @@ func (this *KafkaSource) String() string {
 		`Config:` + fmt.Sprintf("%v", this.Config) + `,`,
 		`SASL:` + strings.Replace(this.SASL.String(), "SASL", "SASL", 1) + `,`,
 		`KafkaVersion:` + fmt.Sprintf("%v", this.KafkaVersion) + `,`,
+		`StartPosition:` + strings.Replace(this.StartPosition.String(), "KafkaStartPosition", "KafkaStartPosition", 1) + `,`,
+		`KeyHeader:` + fmt.Sprintf("%v", this.KeyHeader) + `,`,
+		`EventTimeSource:` + fmt.Sprintf("%v", this.EventTimeSource) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *KafkaStartPosition) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&KafkaStartPosition{`,
+		`Policy:` + fmt.Sprintf("%v", this.Policy) + `,`,
+		`Timestamp:` + strings.Replace(fmt.Sprintf("%v", this.Timestamp), "Time", "v11.Time", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14191,6 +17084,21 @@ func (this *Log) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&Log{`,
+		`Format:` + fmt.Sprintf("%v", this.Format) + `,`,
+		`PayloadEncoding:` + fmt.Sprintf("%v", this.PayloadEncoding) + `,`,
+		`Sampling:` + strings.Replace(this.Sampling.String(), "LogSampling", "LogSampling", 1) + `,`,
+		`MaxPayloadBytes:` + fmt.Sprintf("%v", this.MaxPayloadBytes) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *LogSampling) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&LogSampling{`,
+		`EveryN:` + fmt.Sprintf("%v", this.EveryN) + `,`,
+		`PerSecond:` + fmt.Sprintf("%v", this.PerSecond) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14376,6 +17284,9 @@ func (this *NatsSource) String() string {
 		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
 		`TLS:` + strings.Replace(this.TLS.String(), "TLS", "TLS", 1) + `,`,
 		`Auth:` + strings.Replace(this.Auth.String(), "NatsAuth", "NatsAuth", 1) + `,`,
+		`KeyFromHeader:` + fmt.Sprintf("%v", this.KeyFromHeader) + `,`,
+		`EventTimeFromHeader:` + fmt.Sprintf("%v", this.EventTimeFromHeader) + `,`,
+		`OverflowSize:` + fmt.Sprintf("%v", this.OverflowSize) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14434,6 +17345,7 @@ func (this *PipelineLimits) String() string {
 		`BufferMaxLength:` + valueToStringGenerated(this.BufferMaxLength) + `,`,
 		`BufferUsageLimit:` + valueToStringGenerated(this.BufferUsageLimit) + `,`,
 		`ReadTimeout:` + strings.Replace(fmt.Sprintf("%v", this.ReadTimeout), "Duration", "v11.Duration", 1) + `,`,
+		`ClaimCheck:` + strings.Replace(this.ClaimCheck.String(), "ClaimCheck", "ClaimCheck", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14482,6 +17394,7 @@ func (this *PipelineSpec) String() string {
 		`Watermark:` + strings.Replace(strings.Replace(this.Watermark.String(), "Watermark", "Watermark", 1), `&`, ``, 1) + `,`,
 		`Templates:` + strings.Replace(this.Templates.String(), "Templates", "Templates", 1) + `,`,
 		`SideInputs:` + repeatedStringForSideInputs + `,`,
+		`InterStepBuffer:` + strings.Replace(this.InterStepBuffer.String(), "InterStepBuffer", "InterStepBuffer", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14542,6 +17455,7 @@ func (this *PulsarSource) String() string {
 		`SubscriptionName:` + fmt.Sprintf("%v", this.SubscriptionName) + `,`,
 		`MaxUnack:` + fmt.Sprintf("%v", this.MaxUnack) + `,`,
 		`Auth:` + strings.Replace(this.Auth.String(), "PulsarAuth", "PulsarAuth", 1) + `,`,
+		`AdminURL:` + fmt.Sprintf("%v", this.AdminURL) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14568,6 +17482,9 @@ func (this *RedisConfig) String() string {
 		`User:` + fmt.Sprintf("%v", this.User) + `,`,
 		`Password:` + strings.Replace(fmt.Sprintf("%v", this.Password), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
 		`SentinelPassword:` + strings.Replace(fmt.Sprintf("%v", this.SentinelPassword), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`SentinelUser:` + fmt.Sprintf("%v", this.SentinelUser) + `,`,
+		`TLS:` + strings.Replace(this.TLS.String(), "TLS", "TLS", 1) + `,`,
+		`Cluster:` + fmt.Sprintf("%v", this.Cluster) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14866,6 +17783,7 @@ func (this *SqsSink) String() string {
 		`AWSRegion:` + fmt.Sprintf("%v", this.AWSRegion) + `,`,
 		`QueueName:` + fmt.Sprintf("%v", this.QueueName) + `,`,
 		`QueueOwnerAWSAccountID:` + fmt.Sprintf("%v", this.QueueOwnerAWSAccountID) + `,`,
+		`EndpointURL:` + valueToStringGenerated(this.EndpointURL) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -15102,6 +18020,7 @@ func (this *VertexSpec) String() string {
 		`ToEdges:` + repeatedStringForToEdges + `,`,
 		`Watermark:` + strings.Replace(strings.Replace(this.Watermark.String(), "Watermark", "Watermark", 1), `&`, ``, 1) + `,`,
 		`Lifecycle:` + strings.Replace(strings.Replace(this.Lifecycle.String(), "VertexLifecycle", "VertexLifecycle", 1), `&`, ``, 1) + `,`,
+		`ClaimCheck:` + strings.Replace(this.ClaimCheck.String(), "ClaimCheck", "ClaimCheck", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -15162,6 +18081,8 @@ func (this *Window) String() string {
 		`Sliding:` + strings.Replace(this.Sliding.String(), "SlidingWindow", "SlidingWindow", 1) + `,`,
 		`Session:` + strings.Replace(this.Session.String(), "SessionWindow", "SessionWindow", 1) + `,`,
 		`Accumulator:` + strings.Replace(this.Accumulator.String(), "AccumulatorWindow", "AccumulatorWindow", 1) + `,`,
+		`Count:` + strings.Replace(this.Count.String(), "CountWindow", "CountWindow", 1) + `,`,
+		`Global:` + strings.Replace(this.Global.String(), "GlobalWindow", "GlobalWindow", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -16012,6 +18933,78 @@ func (m *AbstractSink) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.File == nil {
+				m.File = &FileSink{}
+			}
+			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HTTP", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HTTP == nil {
+				m.HTTP = &HTTPSink{}
+			}
+			if err := m.HTTP.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -16599,6 +19592,42 @@ func (m *AbstractVertex) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadLetter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DeadLetter == nil {
+				m.DeadLetter = &DeadLetter{}
+			}
+			if err := m.DeadLetter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -16877,6 +19906,66 @@ func (m *Backoff) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Steps = &v
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Cap == nil {
+				m.Cap = &v11.Duration{}
+			}
+			if err := m.Cap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Factor", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			v2 := float64(math.Float64frombits(v))
+			m.Factor = &v2
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jitter", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			v2 := float64(math.Float64frombits(v))
+			m.Jitter = &v2
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -17171,6 +20260,42 @@ func (m *BufferServiceConfig) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disk", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Disk == nil {
+				m.Disk = &DiskConfig{}
+			}
+			if err := m.Disk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -17192,7 +20317,7 @@ func (m *BufferServiceConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CombinedEdge) Unmarshal(dAtA []byte) error {
+func (m *ClaimCheck) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17215,82 +20340,17 @@ func (m *CombinedEdge) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CombinedEdge: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClaimCheck: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CombinedEdge: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClaimCheck: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Edge", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Edge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromVertexType", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.FromVertexType = VertexType(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromVertexPartitionCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ThresholdBytes", wireType)
 			}
-			var v int32
+			var v uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17300,15 +20360,15 @@ func (m *CombinedEdge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				v |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.FromVertexPartitionCount = &v
-		case 4:
+			m.ThresholdBytes = &v
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromVertexLimits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field S3", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17335,68 +20395,16 @@ func (m *CombinedEdge) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FromVertexLimits == nil {
-				m.FromVertexLimits = &VertexLimits{}
+			if m.S3 == nil {
+				m.S3 = &ClaimCheckS3{}
 			}
-			if err := m.FromVertexLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.S3.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToVertexType", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ToVertexType = VertexType(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToVertexPartitionCount", wireType)
-			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.ToVertexPartitionCount = &v
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToVertexLimits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PersistentVolumeClaim", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17423,10 +20431,10 @@ func (m *CombinedEdge) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ToVertexLimits == nil {
-				m.ToVertexLimits = &VertexLimits{}
+			if m.PersistentVolumeClaim == nil {
+				m.PersistentVolumeClaim = &v1.PersistentVolumeClaimVolumeSource{}
 			}
-			if err := m.ToVertexLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PersistentVolumeClaim.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -17451,7 +20459,7 @@ func (m *CombinedEdge) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Container) Unmarshal(dAtA []byte) error {
+func (m *ClaimCheckS3) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17474,15 +20482,15 @@ func (m *Container) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Container: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClaimCheckS3: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Container: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClaimCheckS3: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17510,11 +20518,11 @@ func (m *Container) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Image = string(dAtA[iNdEx:postIndex])
+			m.Bucket = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17542,11 +20550,11 @@ func (m *Container) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Command = append(m.Command, string(dAtA[iNdEx:postIndex]))
+			m.Region = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndpointURL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17574,13 +20582,13 @@ func (m *Container) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
+			m.EndpointURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17590,29 +20598,77 @@ func (m *Container) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Env = append(m.Env, v1.EnvVar{})
-			if err := m.Env[len(m.Env)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Prefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 5:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CombinedEdge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CombinedEdge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CombinedEdge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnvFrom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Edge", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17639,16 +20695,15 @@ func (m *Container) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EnvFrom = append(m.EnvFrom, v1.EnvFromSource{})
-			if err := m.EnvFrom[len(m.EnvFrom)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Edge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VolumeMounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromVertexType", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17658,31 +20713,29 @@ func (m *Container) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VolumeMounts = append(m.VolumeMounts, v1.VolumeMount{})
-			if err := m.VolumeMounts[len(m.VolumeMounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.FromVertexType = VertexType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromVertexPartitionCount", wireType)
 			}
-			var msglen int
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17692,28 +20745,15 @@ func (m *Container) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Resources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
+			m.FromVertexPartitionCount = &v
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContext", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromVertexLimits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17740,16 +20780,16 @@ func (m *Container) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SecurityContext == nil {
-				m.SecurityContext = &v1.SecurityContext{}
+			if m.FromVertexLimits == nil {
+				m.FromVertexLimits = &VertexLimits{}
 			}
-			if err := m.SecurityContext.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FromVertexLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ImagePullPolicy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToVertexType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17777,14 +20817,13 @@ func (m *Container) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := k8s_io_api_core_v1.PullPolicy(dAtA[iNdEx:postIndex])
-			m.ImagePullPolicy = &s
+			m.ToVertexType = VertexType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadinessProbe", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToVertexPartitionCount", wireType)
 			}
-			var msglen int
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17794,31 +20833,15 @@ func (m *Container) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ReadinessProbe == nil {
-				m.ReadinessProbe = &Probe{}
-			}
-			if err := m.ReadinessProbe.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 11:
+			m.ToVertexPartitionCount = &v
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LivenessProbe", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToVertexLimits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17845,18 +20868,68 @@ func (m *Container) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LivenessProbe == nil {
-				m.LivenessProbe = &Probe{}
+			if m.ToVertexLimits == nil {
+				m.ToVertexLimits = &VertexLimits{}
 			}
-			if err := m.LivenessProbe.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ToVertexLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Compression) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Compression: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Compression: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ports", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17866,26 +20939,44 @@ func (m *Container) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ports = append(m.Ports, v1.ContainerPort{})
-			if err := m.Ports[len(m.Ports)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Type = CompressionType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPayloadBytes", wireType)
+			}
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MinPayloadBytes = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -17907,7 +20998,7 @@ func (m *Container) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContainerTemplate) Unmarshal(dAtA []byte) error {
+func (m *Container) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17930,17 +21021,17 @@ func (m *ContainerTemplate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContainerTemplate: wiretype end group for non-group")
+			return fmt.Errorf("proto: Container: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContainerTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Container: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17950,28 +21041,27 @@ func (m *ContainerTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Resources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Image = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ImagePullPolicy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17999,13 +21089,13 @@ func (m *ContainerTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ImagePullPolicy = k8s_io_api_core_v1.PullPolicy(dAtA[iNdEx:postIndex])
+			m.Command = append(m.Command, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContext", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18015,27 +21105,23 @@ func (m *ContainerTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SecurityContext == nil {
-				m.SecurityContext = &v1.SecurityContext{}
-			}
-			if err := m.SecurityContext.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
@@ -18107,7 +21193,7 @@ func (m *ContainerTemplate) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadinessProbe", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VolumeMounts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18134,16 +21220,14 @@ func (m *ContainerTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ReadinessProbe == nil {
-				m.ReadinessProbe = &Probe{}
-			}
-			if err := m.ReadinessProbe.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.VolumeMounts = append(m.VolumeMounts, v1.VolumeMount{})
+			if err := m.VolumeMounts[len(m.VolumeMounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LivenessProbe", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18170,66 +21254,13 @@ func (m *ContainerTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LivenessProbe == nil {
-				m.LivenessProbe = &Probe{}
-			}
-			if err := m.LivenessProbe.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Resources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DaemonTemplate) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DaemonTemplate: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DaemonTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AbstractPodTemplate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContext", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18256,15 +21287,18 @@ func (m *DaemonTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AbstractPodTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.SecurityContext == nil {
+				m.SecurityContext = &v1.SecurityContext{}
+			}
+			if err := m.SecurityContext.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImagePullPolicy", wireType)
 			}
-			var v int32
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18274,15 +21308,28 @@ func (m *DaemonTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Replicas = &v
-		case 3:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := k8s_io_api_core_v1.PullPolicy(dAtA[iNdEx:postIndex])
+			m.ImagePullPolicy = &s
+			iNdEx = postIndex
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerTemplate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadinessProbe", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18309,16 +21356,16 @@ func (m *DaemonTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ContainerTemplate == nil {
-				m.ContainerTemplate = &ContainerTemplate{}
+			if m.ReadinessProbe == nil {
+				m.ReadinessProbe = &Probe{}
 			}
-			if err := m.ContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ReadinessProbe.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitContainerTemplate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LivenessProbe", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18345,10 +21392,44 @@ func (m *DaemonTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.InitContainerTemplate == nil {
-				m.InitContainerTemplate = &ContainerTemplate{}
+			if m.LivenessProbe == nil {
+				m.LivenessProbe = &Probe{}
 			}
-			if err := m.InitContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LivenessProbe.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ports", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ports = append(m.Ports, v1.ContainerPort{})
+			if err := m.Ports[len(m.Ports)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -18373,7 +21454,7 @@ func (m *DaemonTemplate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Edge) Unmarshal(dAtA []byte) error {
+func (m *ContainerTemplate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18396,17 +21477,17 @@ func (m *Edge) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Edge: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContainerTemplate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Edge: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContainerTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18416,27 +21497,28 @@ func (m *Edge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.From = string(dAtA[iNdEx:postIndex])
+			if err := m.Resources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ImagePullPolicy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18464,11 +21546,11 @@ func (m *Edge) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.To = string(dAtA[iNdEx:postIndex])
+			m.ImagePullPolicy = k8s_io_api_core_v1.PullPolicy(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContext", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18495,18 +21577,18 @@ func (m *Edge) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Conditions == nil {
-				m.Conditions = &ForwardConditions{}
+			if m.SecurityContext == nil {
+				m.SecurityContext = &v1.SecurityContext{}
 			}
-			if err := m.Conditions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SecurityContext.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OnFull", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18516,78 +21598,63 @@ func (m *Edge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := BufferFullWritingStrategy(dAtA[iNdEx:postIndex])
-			m.OnFull = &s
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			m.Env = append(m.Env, v1.EnvVar{})
+			if err := m.Env[len(m.Env)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnvFrom", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *FixedWindow) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.EnvFrom = append(m.EnvFrom, v1.EnvFromSource{})
+			if err := m.EnvFrom[len(m.EnvFrom)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: FixedWindow: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FixedWindow: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Length", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadinessProbe", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18614,18 +21681,18 @@ func (m *FixedWindow) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Length == nil {
-				m.Length = &v11.Duration{}
+			if m.ReadinessProbe == nil {
+				m.ReadinessProbe = &Probe{}
 			}
-			if err := m.Length.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ReadinessProbe.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Streaming", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LivenessProbe", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18635,12 +21702,28 @@ func (m *FixedWindow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Streaming = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LivenessProbe == nil {
+				m.LivenessProbe = &Probe{}
+			}
+			if err := m.LivenessProbe.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -18662,7 +21745,7 @@ func (m *FixedWindow) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ForwardConditions) Unmarshal(dAtA []byte) error {
+func (m *CountWindow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18685,15 +21768,34 @@ func (m *ForwardConditions) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ForwardConditions: wiretype end group for non-group")
+			return fmt.Errorf("proto: CountWindow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ForwardConditions: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CountWindow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18720,10 +21822,10 @@ func (m *ForwardConditions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Tags == nil {
-				m.Tags = &TagConditions{}
+			if m.Timeout == nil {
+				m.Timeout = &v11.Duration{}
 			}
-			if err := m.Tags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Timeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -18748,7 +21850,7 @@ func (m *ForwardConditions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Function) Unmarshal(dAtA []byte) error {
+func (m *DaemonTemplate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18771,17 +21873,17 @@ func (m *Function) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Function: wiretype end group for non-group")
+			return fmt.Errorf("proto: DaemonTemplate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Function: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DaemonTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AbstractPodTemplate", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18791,29 +21893,50 @@ func (m *Function) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if err := m.AbstractPodTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Replicas = &v
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerTemplate", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18823,27 +21946,31 @@ func (m *Function) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
+			if m.ContainerTemplate == nil {
+				m.ContainerTemplate = &ContainerTemplate{}
+			}
+			if err := m.ContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KWArgs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitContainerTemplate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18870,103 +21997,12 @@ func (m *Function) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.KWArgs == nil {
-				m.KWArgs = make(map[string]string)
+			if m.InitContainerTemplate == nil {
+				m.InitContainerTemplate = &ContainerTemplate{}
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.InitContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.KWArgs[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -18989,7 +22025,7 @@ func (m *Function) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GSSAPI) Unmarshal(dAtA []byte) error {
+func (m *DeadLetter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19012,17 +22048,17 @@ func (m *GSSAPI) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GSSAPI: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeadLetter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GSSAPI: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeadLetter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceName", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRetries", wireType)
 			}
-			var stringLen uint64
+			var v uint32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19032,29 +22068,17 @@ func (m *GSSAPI) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ServiceName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.MaxRetries = &v
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Realm", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryInterval", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19064,27 +22088,81 @@ func (m *GSSAPI) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Realm = string(dAtA[iNdEx:postIndex])
+			if m.RetryInterval == nil {
+				m.RetryInterval = &v11.Duration{}
+			}
+			if err := m.RetryInterval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DiskBufferService) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DiskBufferService: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DiskBufferService: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsernameSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DiskStorage", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19111,18 +22189,15 @@ func (m *GSSAPI) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UsernameSecret == nil {
-				m.UsernameSecret = &v1.SecretKeySelector{}
-			}
-			if err := m.UsernameSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DiskStorage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSegmentSize", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19132,28 +22207,31 @@ func (m *GSSAPI) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := KRB5AuthType(dAtA[iNdEx:postIndex])
-			m.AuthType = &s
+			if m.MaxSegmentSize == nil {
+				m.MaxSegmentSize = &resource.Quantity{}
+			}
+			if err := m.MaxSegmentSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PasswordSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncInterval", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19180,16 +22258,66 @@ func (m *GSSAPI) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PasswordSecret == nil {
-				m.PasswordSecret = &v1.SecretKeySelector{}
+			if m.SyncInterval == nil {
+				m.SyncInterval = &v11.Duration{}
 			}
-			if err := m.PasswordSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SyncInterval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DiskConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DiskConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DiskConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeytabSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DiskStorage", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19216,16 +22344,32 @@ func (m *GSSAPI) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.KeytabSecret == nil {
-				m.KeytabSecret = &v1.SecretKeySelector{}
-			}
-			if err := m.KeytabSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DiskStorage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSegmentBytes", wireType)
+			}
+			m.MaxSegmentBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSegmentBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KerberosConfigSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncInterval", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19252,10 +22396,7 @@ func (m *GSSAPI) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.KerberosConfigSecret == nil {
-				m.KerberosConfigSecret = &v1.SecretKeySelector{}
-			}
-			if err := m.KerberosConfigSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SyncInterval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -19280,7 +22421,7 @@ func (m *GSSAPI) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GeneratorSource) Unmarshal(dAtA []byte) error {
+func (m *DiskStorage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19303,17 +22444,17 @@ func (m *GeneratorSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GeneratorSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: DiskStorage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GeneratorSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DiskStorage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RPU", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostPath", wireType)
 			}
-			var v int64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19323,15 +22464,31 @@ func (m *GeneratorSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.RPU = &v
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HostPath == nil {
+				m.HostPath = &v1.HostPathVolumeSource{}
+			}
+			if err := m.HostPath.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PersistentVolumeClaim", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19358,142 +22515,13 @@ func (m *GeneratorSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Duration == nil {
-				m.Duration = &v11.Duration{}
+			if m.PersistentVolumeClaim == nil {
+				m.PersistentVolumeClaim = &v1.PersistentVolumeClaimVolumeSource{}
 			}
-			if err := m.Duration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PersistentVolumeClaim.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgSize", wireType)
-			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.MsgSize = &v
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyCount", wireType)
-			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.KeyCount = &v
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
-			}
-			var v uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Value = &v
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Jitter", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Jitter == nil {
-				m.Jitter = &v11.Duration{}
-			}
-			if err := m.Jitter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueBlob", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.ValueBlob = &s
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -19515,7 +22543,7 @@ func (m *GeneratorSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetDaemonDeploymentReq) Unmarshal(dAtA []byte) error {
+func (m *Edge) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19538,15 +22566,15 @@ func (m *GetDaemonDeploymentReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetDaemonDeploymentReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: Edge: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetDaemonDeploymentReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Edge: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ISBSvcType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19574,11 +22602,11 @@ func (m *GetDaemonDeploymentReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ISBSvcType = ISBSvcType(dAtA[iNdEx:postIndex])
+			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19606,11 +22634,47 @@ func (m *GetDaemonDeploymentReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Image = string(dAtA[iNdEx:postIndex])
+			m.To = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PullPolicy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Conditions == nil {
+				m.Conditions = &ForwardConditions{}
+			}
+			if err := m.Conditions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnFull", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19638,11 +22702,12 @@ func (m *GetDaemonDeploymentReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PullPolicy = k8s_io_api_core_v1.PullPolicy(dAtA[iNdEx:postIndex])
+			s := BufferFullWritingStrategy(dAtA[iNdEx:postIndex])
+			m.OnFull = &s
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Compression", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19669,14 +22734,16 @@ func (m *GetDaemonDeploymentReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Env = append(m.Env, v1.EnvVar{})
-			if err := m.Env[len(m.Env)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Compression == nil {
+				m.Compression = &Compression{}
+			}
+			if err := m.Compression.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultResources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19703,7 +22770,10 @@ func (m *GetDaemonDeploymentReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DefaultResources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Priority == nil {
+				m.Priority = &EdgePriority{}
+			}
+			if err := m.Priority.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -19728,7 +22798,7 @@ func (m *GetDaemonDeploymentReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetJetStreamServiceSpecReq) Unmarshal(dAtA []byte) error {
+func (m *EdgePriority) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19751,15 +22821,66 @@ func (m *GetJetStreamServiceSpecReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetJetStreamServiceSpecReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: EdgePriority: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetJetStreamServiceSpecReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EdgePriority: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lanes", wireType)
+			}
+			m.Lanes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Lanes |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Header = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19786,11 +22907,11 @@ func (m *GetJetStreamServiceSpecReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
+			if m.Tags == nil {
+				m.Tags = make(map[string]int32)
 			}
 			var mapkey string
-			var mapvalue string
+			var mapvalue int32
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -19839,7 +22960,6 @@ func (m *GetJetStreamServiceSpecReq) Unmarshal(dAtA []byte) error {
 					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowGenerated
@@ -19849,24 +22969,11 @@ func (m *GetJetStreamServiceSpecReq) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
+						mapvalue |= int32(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
 				} else {
 					iNdEx = entryPreIndex
 					skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -19882,83 +22989,83 @@ func (m *GetJetStreamServiceSpecReq) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Labels[mapkey] = mapvalue
+			m.Tags[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterPort", wireType)
-			}
-			m.ClusterPort = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ClusterPort |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientPort", wireType)
-			}
-			m.ClientPort = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
+		case 4:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+				m.Weights = append(m.Weights, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ClientPort |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				if packedLen < 0 {
+					return ErrInvalidLengthGenerated
 				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MonitorPort", wireType)
-			}
-			m.MonitorPort = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenerated
 				}
-				if iNdEx >= l {
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MonitorPort |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetricsPort", wireType)
-			}
-			m.MetricsPort = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
 				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+				elementCount = count
+				if elementCount != 0 && len(m.Weights) == 0 {
+					m.Weights = make([]int32, 0, elementCount)
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MetricsPort |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Weights = append(m.Weights, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weights", wireType)
 			}
 		default:
 			iNdEx = preIndex
@@ -19981,7 +23088,7 @@ func (m *GetJetStreamServiceSpecReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetJetStreamStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
+func (m *FileSink) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20004,15 +23111,15 @@ func (m *GetJetStreamStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetJetStreamStatefulSetSpecReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: FileSink: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetJetStreamStatefulSetSpecReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FileSink: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20040,11 +23147,11 @@ func (m *GetJetStreamStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServiceName = string(dAtA[iNdEx:postIndex])
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field S3", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20071,107 +23178,16 @@ func (m *GetJetStreamStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
+			if m.S3 == nil {
+				m.S3 = &FileSinkS3{}
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.S3.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NatsImage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Encoding", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20199,13 +23215,32 @@ func (m *GetJetStreamStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NatsImage = string(dAtA[iNdEx:postIndex])
+			m.Encoding = FileEncoding(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFileBytes", wireType)
+			}
+			m.MaxFileBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxFileBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetricsExporterImage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RollInterval", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20215,27 +23250,31 @@ func (m *GetJetStreamStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MetricsExporterImage = string(dAtA[iNdEx:postIndex])
+			if m.RollInterval == nil {
+				m.RollInterval = &v11.Duration{}
+			}
+			if err := m.RollInterval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigReloaderImage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EventTimeLayout", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20263,13 +23302,13 @@ func (m *GetJetStreamStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConfigReloaderImage = string(dAtA[iNdEx:postIndex])
+			m.EventTimeLayout = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterPort", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionByKeys", wireType)
 			}
-			m.ClusterPort = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20279,71 +23318,15 @@ func (m *GetJetStreamStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ClusterPort |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientPort", wireType)
-			}
-			m.ClientPort = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ClientPort |= int32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.PartitionByKeys = bool(v != 0)
 		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MonitorPort", wireType)
-			}
-			m.MonitorPort = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MonitorPort |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetricsPort", wireType)
-			}
-			m.MetricsPort = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MetricsPort |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServerAuthSecretName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VolumeName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20371,43 +23354,61 @@ func (m *GetJetStreamStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServerAuthSecretName = string(dAtA[iNdEx:postIndex])
+			m.VolumeName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServerEncryptionSecretName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FileSinkS3) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServerEncryptionSecretName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 12:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FileSinkS3: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FileSinkS3: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20435,11 +23436,11 @@ func (m *GetJetStreamStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConfigMapName = string(dAtA[iNdEx:postIndex])
+			m.Bucket = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PvcNameIfNeeded", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20467,11 +23468,11 @@ func (m *GetJetStreamStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PvcNameIfNeeded = string(dAtA[iNdEx:postIndex])
+			m.Region = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 14:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartCommand", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndpointURL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20499,40 +23500,7 @@ func (m *GetJetStreamStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StartCommand = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 15:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultResources", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.DefaultResources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.EndpointURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -20555,7 +23523,7 @@ func (m *GetJetStreamStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetMonoVertexDaemonDeploymentReq) Unmarshal(dAtA []byte) error {
+func (m *FixedWindow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20578,17 +23546,17 @@ func (m *GetMonoVertexDaemonDeploymentReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetMonoVertexDaemonDeploymentReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: FixedWindow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetMonoVertexDaemonDeploymentReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FixedWindow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Length", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20598,29 +23566,33 @@ func (m *GetMonoVertexDaemonDeploymentReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Image = string(dAtA[iNdEx:postIndex])
+			if m.Length == nil {
+				m.Length = &v11.Duration{}
+			}
+			if err := m.Length.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PullPolicy", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Streaming", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20630,27 +23602,65 @@ func (m *GetMonoVertexDaemonDeploymentReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
+			m.Streaming = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PullPolicy = k8s_io_api_core_v1.PullPolicy(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ForwardConditions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ForwardConditions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ForwardConditions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20677,16 +23687,18 @@ func (m *GetMonoVertexDaemonDeploymentReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Env = append(m.Env, v1.EnvVar{})
-			if err := m.Env[len(m.Env)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Tags == nil {
+				m.Tags = &TagConditions{}
+			}
+			if err := m.Tags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultResources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20696,24 +23708,23 @@ func (m *GetMonoVertexDaemonDeploymentReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DefaultResources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Expression = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -20736,7 +23747,7 @@ func (m *GetMonoVertexDaemonDeploymentReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetMonoVertexPodSpecReq) Unmarshal(dAtA []byte) error {
+func (m *Function) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20759,15 +23770,15 @@ func (m *GetMonoVertexPodSpecReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetMonoVertexPodSpecReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: Function: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetMonoVertexPodSpecReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Function: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20795,11 +23806,11 @@ func (m *GetMonoVertexPodSpecReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Image = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PullPolicy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20827,128 +23838,11 @@ func (m *GetMonoVertexPodSpecReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PullPolicy = k8s_io_api_core_v1.PullPolicy(dAtA[iNdEx:postIndex])
+			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Env = append(m.Env, v1.EnvVar{})
-			if err := m.Env[len(m.Env)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultResources", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.DefaultResources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetRedisServiceSpecReq) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetRedisServiceSpecReq: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetRedisServiceSpecReq: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KWArgs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20975,8 +23869,8 @@ func (m *GetRedisServiceSpecReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
+			if m.KWArgs == nil {
+				m.KWArgs = make(map[string]string)
 			}
 			var mapkey string
 			var mapvalue string
@@ -21071,46 +23965,8 @@ func (m *GetRedisServiceSpecReq) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Labels[mapkey] = mapvalue
+			m.KWArgs[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RedisContainerPort", wireType)
-			}
-			m.RedisContainerPort = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RedisContainerPort |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SentinelContainerPort", wireType)
-			}
-			m.SentinelContainerPort = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SentinelContainerPort |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -21132,7 +23988,7 @@ func (m *GetRedisServiceSpecReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetRedisStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
+func (m *GSSAPI) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21155,10 +24011,10 @@ func (m *GetRedisStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetRedisStatefulSetSpecReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: GSSAPI: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetRedisStatefulSetSpecReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GSSAPI: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -21195,7 +24051,39 @@ func (m *GetRedisStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Realm", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Realm = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsernameSecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21222,107 +24110,16 @@ func (m *GetRedisStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
+			if m.UsernameSecret == nil {
+				m.UsernameSecret = &v1.SecretKeySelector{}
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.UsernameSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RedisImage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21350,13 +24147,14 @@ func (m *GetRedisStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RedisImage = string(dAtA[iNdEx:postIndex])
+			s := KRB5AuthType(dAtA[iNdEx:postIndex])
+			m.AuthType = &s
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SentinelImage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PasswordSecret", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21366,29 +24164,33 @@ func (m *GetRedisStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SentinelImage = string(dAtA[iNdEx:postIndex])
+			if m.PasswordSecret == nil {
+				m.PasswordSecret = &v1.SecretKeySelector{}
+			}
+			if err := m.PasswordSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetricsExporterImage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeytabSecret", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21398,29 +24200,33 @@ func (m *GetRedisStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MetricsExporterImage = string(dAtA[iNdEx:postIndex])
+			if m.KeytabSecret == nil {
+				m.KeytabSecret = &v1.SecretKeySelector{}
+			}
+			if err := m.KeytabSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitContainerImage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KerberosConfigSecret", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21430,29 +24236,83 @@ func (m *GetRedisStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InitContainerImage = string(dAtA[iNdEx:postIndex])
+			if m.KerberosConfigSecret == nil {
+				m.KerberosConfigSecret = &v1.SecretKeySelector{}
+			}
+			if err := m.KerberosConfigSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GeneratorOutOfOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GeneratorOutOfOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GeneratorOutOfOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RedisContainerPort", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Percent", wireType)
 			}
-			m.RedisContainerPort = 0
+			m.Percent = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21462,16 +24322,16 @@ func (m *GetRedisStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RedisContainerPort |= int32(b&0x7F) << shift
+				m.Percent |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SentinelContainerPort", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delay", wireType)
 			}
-			m.SentinelContainerPort = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21481,16 +24341,80 @@ func (m *GetRedisStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SentinelContainerPort |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RedisMetricsContainerPort", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.RedisMetricsContainerPort = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Delay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GeneratorReplay) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GeneratorReplay: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GeneratorReplay: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21500,14 +24424,27 @@ func (m *GetRedisStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RedisMetricsContainerPort |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CredentialSecretName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VolumeName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21535,11 +24472,11 @@ func (m *GetRedisStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CredentialSecretName = string(dAtA[iNdEx:postIndex])
+			m.VolumeName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLSEnabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Loop", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -21556,10 +24493,10 @@ func (m *GetRedisStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.TLSEnabled = bool(v != 0)
-		case 12:
+			m.Loop = bool(v != 0)
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PvcNameIfNeeded", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyField", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21587,11 +24524,11 @@ func (m *GetRedisStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PvcNameIfNeeded = string(dAtA[iNdEx:postIndex])
+			m.KeyField = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfConfigMapName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EventTimeField", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21619,13 +24556,103 @@ func (m *GetRedisStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConfConfigMapName = string(dAtA[iNdEx:postIndex])
+			m.EventTimeField = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 14:
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewriteEventTime", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RewriteEventTime = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GeneratorSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GeneratorSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GeneratorSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RPU", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RPU = &v
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScriptsConfigMapName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21635,29 +24662,4813 @@ func (m *GetRedisStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScriptsConfigMapName = string(dAtA[iNdEx:postIndex])
+			if m.Duration == nil {
+				m.Duration = &v11.Duration{}
+			}
+			if err := m.Duration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 15:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgSize", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MsgSize = &v
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyCount", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.KeyCount = &v
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Value = &v
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jitter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Jitter == nil {
+				m.Jitter = &v11.Duration{}
+			}
+			if err := m.Jitter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueBlob", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.ValueBlob = &s
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Template = &s
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Replay == nil {
+				m.Replay = &GeneratorReplay{}
+			}
+			if err := m.Replay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutOfOrder", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OutOfOrder == nil {
+				m.OutOfOrder = &GeneratorOutOfOrder{}
+			}
+			if err := m.OutOfOrder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetDaemonDeploymentReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetDaemonDeploymentReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetDaemonDeploymentReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ISBSvcType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ISBSvcType = ISBSvcType(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Image = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PullPolicy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PullPolicy = k8s_io_api_core_v1.PullPolicy(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Env = append(m.Env, v1.EnvVar{})
+			if err := m.Env[len(m.Env)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultResources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DefaultResources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetJetStreamServiceSpecReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetJetStreamServiceSpecReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetJetStreamServiceSpecReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterPort", wireType)
+			}
+			m.ClusterPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClusterPort |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientPort", wireType)
+			}
+			m.ClientPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClientPort |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MonitorPort", wireType)
+			}
+			m.MonitorPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MonitorPort |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetricsPort", wireType)
+			}
+			m.MetricsPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MetricsPort |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetJetStreamStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetJetStreamStatefulSetSpecReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetJetStreamStatefulSetSpecReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NatsImage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NatsImage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetricsExporterImage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetricsExporterImage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigReloaderImage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConfigReloaderImage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterPort", wireType)
+			}
+			m.ClusterPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClusterPort |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientPort", wireType)
+			}
+			m.ClientPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClientPort |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MonitorPort", wireType)
+			}
+			m.MonitorPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MonitorPort |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetricsPort", wireType)
+			}
+			m.MetricsPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MetricsPort |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerAuthSecretName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServerAuthSecretName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerEncryptionSecretName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServerEncryptionSecretName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConfigMapName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PvcNameIfNeeded", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PvcNameIfNeeded = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartCommand", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartCommand = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultResources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DefaultResources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetMonoVertexDaemonDeploymentReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetMonoVertexDaemonDeploymentReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetMonoVertexDaemonDeploymentReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Image = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PullPolicy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PullPolicy = k8s_io_api_core_v1.PullPolicy(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Env = append(m.Env, v1.EnvVar{})
+			if err := m.Env[len(m.Env)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultResources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DefaultResources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetMonoVertexPodSpecReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetMonoVertexPodSpecReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetMonoVertexPodSpecReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Image = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PullPolicy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PullPolicy = k8s_io_api_core_v1.PullPolicy(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Env = append(m.Env, v1.EnvVar{})
+			if err := m.Env[len(m.Env)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultResources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DefaultResources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetRedisServiceSpecReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetRedisServiceSpecReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetRedisServiceSpecReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedisContainerPort", wireType)
+			}
+			m.RedisContainerPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RedisContainerPort |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SentinelContainerPort", wireType)
+			}
+			m.SentinelContainerPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SentinelContainerPort |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetRedisStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetRedisStatefulSetSpecReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetRedisStatefulSetSpecReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedisImage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RedisImage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SentinelImage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SentinelImage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetricsExporterImage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetricsExporterImage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitContainerImage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InitContainerImage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedisContainerPort", wireType)
+			}
+			m.RedisContainerPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RedisContainerPort |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SentinelContainerPort", wireType)
+			}
+			m.SentinelContainerPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SentinelContainerPort |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedisMetricsContainerPort", wireType)
+			}
+			m.RedisMetricsContainerPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RedisMetricsContainerPort |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialSecretName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CredentialSecretName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TLSEnabled = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PvcNameIfNeeded", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PvcNameIfNeeded = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfConfigMapName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConfConfigMapName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScriptsConfigMapName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScriptsConfigMapName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthConfigMapName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HealthConfigMapName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultResources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DefaultResources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetServingPipelineResourceReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetServingPipelineResourceReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetServingPipelineResourceReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ISBSvcConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ISBSvcConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Image = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PullPolicy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PullPolicy = k8s_io_api_core_v1.PullPolicy(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Env = append(m.Env, v1.EnvVar{})
+			if err := m.Env[len(m.Env)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultResources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DefaultResources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetSideInputDeploymentReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetSideInputDeploymentReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetSideInputDeploymentReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ISBSvcType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ISBSvcType = ISBSvcType(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Image = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PullPolicy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PullPolicy = k8s_io_api_core_v1.PullPolicy(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Env = append(m.Env, v1.EnvVar{})
+			if err := m.Env[len(m.Env)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultResources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DefaultResources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetVertexPodSpecReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetVertexPodSpecReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetVertexPodSpecReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ISBSvcType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ISBSvcType = ISBSvcType(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Image = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PullPolicy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PullPolicy = k8s_io_api_core_v1.PullPolicy(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Env = append(m.Env, v1.EnvVar{})
+			if err := m.Env[len(m.Env)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SideInputsStoreName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SideInputsStoreName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServingSourceStreamName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServingSourceStreamName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PipelineSpec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PipelineSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultResources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DefaultResources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GlobalWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GlobalWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GlobalWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trigger", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trigger = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GroupBy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GroupBy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GroupBy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Window.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keyed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Keyed = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedLateness", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AllowedLateness == nil {
+				m.AllowedLateness = &v11.Duration{}
+			}
+			if err := m.AllowedLateness.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Storage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Storage == nil {
+				m.Storage = &PBQStorage{}
+			}
+			if err := m.Storage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LateDataTag", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LateDataTag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HTTPBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HTTPBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HTTPBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IDField", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IDField = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventTimeField", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventTimeField = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HTTPSink) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HTTPSink: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HTTPSink: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Method = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Headers[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Batch == nil {
+				m.Batch = &HTTPSinkBatch{}
+			}
+			if err := m.Batch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasicAuth", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BasicAuth == nil {
+				m.BasicAuth = &BasicAuth{}
+			}
+			if err := m.BasicAuth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BearerTokenSecret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BearerTokenSecret == nil {
+				m.BearerTokenSecret = &v1.SecretKeySelector{}
+			}
+			if err := m.BearerTokenSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TLS == nil {
+				m.TLS = &TLS{}
+			}
+			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Timeout == nil {
+				m.Timeout = &v11.Duration{}
+			}
+			if err := m.Timeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Concurrency", wireType)
+			}
+			m.Concurrency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Concurrency |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HTTPSinkBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HTTPSinkBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HTTPSinkBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMessages", wireType)
+			}
+			m.MaxMessages = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMessages |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Format = HTTPSinkBatchFormat(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HTTPSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HTTPSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HTTPSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Auth == nil {
+				m.Auth = &Authorization{}
+			}
+			if err := m.Auth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Service = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Batch == nil {
+				m.Batch = &HTTPBatch{}
+			}
+			if err := m.Batch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AckAfterWrite", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AckAfterWrite = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AckTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AckTimeout == nil {
+				m.AckTimeout = &v11.Duration{}
+			}
+			if err := m.AckTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IdleSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IdleSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IdleSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Threshold == nil {
+				m.Threshold = &v11.Duration{}
+			}
+			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StepInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StepInterval == nil {
+				m.StepInterval = &v11.Duration{}
+			}
+			if err := m.StepInterval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncrementBy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.IncrementBy == nil {
+				m.IncrementBy = &v11.Duration{}
+			}
+			if err := m.IncrementBy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InterStepBuffer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InterStepBuffer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InterStepBuffer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compression", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Compression == nil {
+				m.Compression = &Compression{}
+			}
+			if err := m.Compression.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InterStepBufferService) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InterStepBufferService: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InterStepBufferService: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InterStepBufferServiceList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InterStepBufferServiceList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InterStepBufferServiceList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HealthConfigMapName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21667,27 +29478,28 @@ func (m *GetRedisStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HealthConfigMapName = string(dAtA[iNdEx:postIndex])
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 16:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultResources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21714,7 +29526,8 @@ func (m *GetRedisStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DefaultResources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, InterStepBufferService{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -21739,7 +29552,7 @@ func (m *GetRedisStatefulSetSpecReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetServingPipelineResourceReq) Unmarshal(dAtA []byte) error {
+func (m *InterStepBufferServiceSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21762,15 +29575,15 @@ func (m *GetServingPipelineResourceReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetServingPipelineResourceReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: InterStepBufferServiceSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetServingPipelineResourceReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InterStepBufferServiceSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ISBSvcConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Redis", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21797,77 +29610,16 @@ func (m *GetServingPipelineResourceReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ISBSvcConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Redis == nil {
+				m.Redis = &RedisBufferService{}
+			}
+			if err := m.Redis.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Image = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PullPolicy", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PullPolicy = k8s_io_api_core_v1.PullPolicy(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JetStream", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21894,14 +29646,16 @@ func (m *GetServingPipelineResourceReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Env = append(m.Env, v1.EnvVar{})
-			if err := m.Env[len(m.Env)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.JetStream == nil {
+				m.JetStream = &JetStreamBufferService{}
+			}
+			if err := m.JetStream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultResources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Disk", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21928,7 +29682,10 @@ func (m *GetServingPipelineResourceReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DefaultResources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Disk == nil {
+				m.Disk = &DiskBufferService{}
+			}
+			if err := m.Disk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -21953,7 +29710,7 @@ func (m *GetServingPipelineResourceReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetSideInputDeploymentReq) Unmarshal(dAtA []byte) error {
+func (m *InterStepBufferServiceStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21976,17 +29733,17 @@ func (m *GetSideInputDeploymentReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetSideInputDeploymentReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: InterStepBufferServiceStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetSideInputDeploymentReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InterStepBufferServiceStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ISBSvcType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21996,27 +29753,28 @@ func (m *GetSideInputDeploymentReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ISBSvcType = ISBSvcType(dAtA[iNdEx:postIndex])
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22044,11 +29802,11 @@ func (m *GetSideInputDeploymentReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Image = string(dAtA[iNdEx:postIndex])
+			m.Phase = ISBSvcPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PullPolicy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22076,11 +29834,11 @@ func (m *GetSideInputDeploymentReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PullPolicy = k8s_io_api_core_v1.PullPolicy(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22107,16 +29865,15 @@ func (m *GetSideInputDeploymentReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Env = append(m.Env, v1.EnvVar{})
-			if err := m.Env[len(m.Env)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultResources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22126,25 +29883,43 @@ func (m *GetSideInputDeploymentReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DefaultResources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Type = ISBSvcType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservedGeneration", wireType)
+			}
+			m.ObservedGeneration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObservedGeneration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -22166,7 +29941,7 @@ func (m *GetSideInputDeploymentReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetVertexPodSpecReq) Unmarshal(dAtA []byte) error {
+func (m *JetStreamBufferService) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22189,15 +29964,15 @@ func (m *GetVertexPodSpecReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetVertexPodSpecReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: JetStreamBufferService: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetVertexPodSpecReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JetStreamBufferService: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ISBSvcType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22225,13 +30000,13 @@ func (m *GetVertexPodSpecReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ISBSvcType = ISBSvcType(dAtA[iNdEx:postIndex])
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
 			}
-			var stringLen uint64
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22241,29 +30016,17 @@ func (m *GetVertexPodSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Image = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Replicas = &v
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PullPolicy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerTemplate", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22273,27 +30036,31 @@ func (m *GetVertexPodSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PullPolicy = k8s_io_api_core_v1.PullPolicy(dAtA[iNdEx:postIndex])
+			if m.ContainerTemplate == nil {
+				m.ContainerTemplate = &ContainerTemplate{}
+			}
+			if err := m.ContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReloaderContainerTemplate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22320,16 +30087,18 @@ func (m *GetVertexPodSpecReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Env = append(m.Env, v1.EnvVar{})
-			if err := m.Env[len(m.Env)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ReloaderContainerTemplate == nil {
+				m.ReloaderContainerTemplate = &ContainerTemplate{}
+			}
+			if err := m.ReloaderContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SideInputsStoreName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MetricsContainerTemplate", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22339,59 +30108,31 @@ func (m *GetVertexPodSpecReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SideInputsStoreName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServingSourceStreamName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if m.MetricsContainerTemplate == nil {
+				m.MetricsContainerTemplate = &ContainerTemplate{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.MetricsContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.ServingSourceStreamName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PipelineSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Persistence", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22418,13 +30159,16 @@ func (m *GetVertexPodSpecReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PipelineSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Persistence == nil {
+				m.Persistence = &PersistenceStrategy{}
+			}
+			if err := m.Persistence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultResources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AbstractPodTemplate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22451,65 +30195,15 @@ func (m *GetVertexPodSpecReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DefaultResources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AbstractPodTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GroupBy) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GroupBy: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GroupBy: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Settings", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22519,50 +30213,30 @@ func (m *GroupBy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Window.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Settings = &s
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Keyed", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Keyed = bool(v != 0)
-		case 3:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowedLateness", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartArgs", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22572,33 +30246,29 @@ func (m *GroupBy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AllowedLateness == nil {
-				m.AllowedLateness = &v11.Duration{}
-			}
-			if err := m.AllowedLateness.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.StartArgs = append(m.StartArgs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Storage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BufferConfig", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22608,83 +30278,30 @@ func (m *GroupBy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Storage == nil {
-				m.Storage = &PBQStorage{}
-			}
-			if err := m.Storage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.BufferConfig = &s
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *HTTPSource) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: HTTPSource: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HTTPSource: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Encryption", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22694,31 +30311,15 @@ func (m *HTTPSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Auth == nil {
-				m.Auth = &Authorization{}
-			}
-			if err := m.Auth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
+			m.Encryption = bool(v != 0)
+		case 12:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -22735,7 +30336,7 @@ func (m *HTTPSource) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Service = bool(v != 0)
+			m.TLS = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -22757,7 +30358,7 @@ func (m *HTTPSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IdleSource) Unmarshal(dAtA []byte) error {
+func (m *JetStreamConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22780,17 +30381,17 @@ func (m *IdleSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IdleSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: JetStreamConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IdleSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JetStreamConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22800,31 +30401,27 @@ func (m *IdleSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Threshold == nil {
-				m.Threshold = &v11.Duration{}
-			}
-			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StepInterval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22851,18 +30448,18 @@ func (m *IdleSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StepInterval == nil {
-				m.StepInterval = &v11.Duration{}
+			if m.Auth == nil {
+				m.Auth = &NatsAuth{}
 			}
-			if err := m.StepInterval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Auth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncrementBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamConfig", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22872,28 +30469,44 @@ func (m *IdleSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.IncrementBy == nil {
-				m.IncrementBy = &v11.Duration{}
+			m.StreamConfig = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSEnabled", wireType)
 			}
-			if err := m.IncrementBy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.TLSEnabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -22915,7 +30528,7 @@ func (m *IdleSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InterStepBufferService) Unmarshal(dAtA []byte) error {
+func (m *JetStreamSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22938,17 +30551,17 @@ func (m *InterStepBufferService) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InterStepBufferService: wiretype end group for non-group")
+			return fmt.Errorf("proto: JetStreamSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InterStepBufferService: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JetStreamSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22958,28 +30571,59 @@ func (m *InterStepBufferService) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stream = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23006,13 +30650,16 @@ func (m *InterStepBufferService) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.TLS == nil {
+				m.TLS = &TLS{}
+			}
+			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23039,65 +30686,50 @@ func (m *InterStepBufferService) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Auth == nil {
+				m.Auth = &NatsAuth{}
+			}
+			if err := m.Auth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FilterSubjects", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *InterStepBufferServiceList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: InterStepBufferServiceList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InterStepBufferServiceList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.FilterSubjects = append(m.FilterSubjects, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeliverPolicy", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23107,28 +30739,27 @@ func (m *InterStepBufferServiceList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DeliverPolicy = JetStreamDeliverPolicy(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23155,64 +30786,54 @@ func (m *InterStepBufferServiceList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, InterStepBufferService{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.StartTime == nil {
+				m.StartTime = &v11.Time{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.StartTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartSequence", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *InterStepBufferServiceSpec) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			m.StartSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAckPending", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.MaxAckPending = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAckPending |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: InterStepBufferServiceSpec: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InterStepBufferServiceSpec: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Redis", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AckWait", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23239,18 +30860,38 @@ func (m *InterStepBufferServiceSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Redis == nil {
-				m.Redis = &RedisBufferService{}
+			if m.AckWait == nil {
+				m.AckWait = &v11.Duration{}
 			}
-			if err := m.Redis.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AckWait.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ephemeral", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Ephemeral = bool(v != 0)
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JetStream", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyFromHeader", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23260,27 +30901,23 @@ func (m *InterStepBufferServiceSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.JetStream == nil {
-				m.JetStream = &JetStreamBufferService{}
-			}
-			if err := m.JetStream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.KeyFromHeader = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -23303,7 +30940,7 @@ func (m *InterStepBufferServiceSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InterStepBufferServiceStatus) Unmarshal(dAtA []byte) error {
+func (m *JobTemplate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23326,15 +30963,15 @@ func (m *InterStepBufferServiceStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InterStepBufferServiceStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobTemplate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InterStepBufferServiceStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AbstractPodTemplate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23361,77 +30998,13 @@ func (m *InterStepBufferServiceStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AbstractPodTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Phase = ISBSvcPhase(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Message = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerTemplate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23458,15 +31031,18 @@ func (m *InterStepBufferServiceStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ContainerTemplate == nil {
+				m.ContainerTemplate = &ContainerTemplate{}
+			}
+			if err := m.ContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TTLSecondsAfterFinished", wireType)
 			}
-			var stringLen uint64
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23476,29 +31052,17 @@ func (m *InterStepBufferServiceStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Type = ISBSvcType(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+			m.TTLSecondsAfterFinished = &v
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObservedGeneration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BackoffLimit", wireType)
 			}
-			m.ObservedGeneration = 0
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23508,11 +31072,12 @@ func (m *InterStepBufferServiceStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ObservedGeneration |= int64(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.BackoffLimit = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -23534,7 +31099,7 @@ func (m *InterStepBufferServiceStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JetStreamBufferService) Unmarshal(dAtA []byte) error {
+func (m *KafkaSink) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23557,15 +31122,15 @@ func (m *JetStreamBufferService) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JetStreamBufferService: wiretype end group for non-group")
+			return fmt.Errorf("proto: KafkaSink: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JetStreamBufferService: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KafkaSink: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Brokers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23593,13 +31158,13 @@ func (m *JetStreamBufferService) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			m.Brokers = append(m.Brokers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
 			}
-			var v int32
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23609,17 +31174,29 @@ func (m *JetStreamBufferService) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Replicas = &v
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Topic = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerTemplate", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetKey", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23629,31 +31206,15 @@ func (m *JetStreamBufferService) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ContainerTemplate == nil {
-				m.ContainerTemplate = &ContainerTemplate{}
-			}
-			if err := m.ContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.SetKey = bool(v != 0)
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReloaderContainerTemplate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23680,18 +31241,18 @@ func (m *JetStreamBufferService) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ReloaderContainerTemplate == nil {
-				m.ReloaderContainerTemplate = &ContainerTemplate{}
+			if m.TLS == nil {
+				m.TLS = &TLS{}
 			}
-			if err := m.ReloaderContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetricsContainerTemplate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23701,31 +31262,27 @@ func (m *JetStreamBufferService) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MetricsContainerTemplate == nil {
-				m.MetricsContainerTemplate = &ContainerTemplate{}
-			}
-			if err := m.MetricsContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Config = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Persistence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SASL", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23752,18 +31309,18 @@ func (m *JetStreamBufferService) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Persistence == nil {
-				m.Persistence = &PersistenceStrategy{}
+			if m.SASL == nil {
+				m.SASL = &SASL{}
 			}
-			if err := m.Persistence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SASL.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AbstractPodTemplate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Partitioner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23773,28 +31330,27 @@ func (m *JetStreamBufferService) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AbstractPodTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Partitioner = KafkaPartitioner(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Settings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionHeader", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23822,14 +31378,13 @@ func (m *JetStreamBufferService) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Settings = &s
+			m.PartitionHeader = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartArgs", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardHeaders", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23839,27 +31394,55 @@ func (m *JetStreamBufferService) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
+			m.ForwardHeaders = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetTimestamp", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.SetTimestamp = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Idempotent", wireType)
 			}
-			m.StartArgs = append(m.StartArgs, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 10:
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Idempotent = bool(v != 0)
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BufferConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Compression", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23887,14 +31470,13 @@ func (m *JetStreamBufferService) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.BufferConfig = &s
+			m.Compression = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 13:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Encryption", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMessageBytes", wireType)
 			}
-			var v int
+			m.MaxMessageBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23904,15 +31486,14 @@ func (m *JetStreamBufferService) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.MaxMessageBytes |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Encryption = bool(v != 0)
-		case 12:
+		case 14:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExactlyOnce", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -23929,7 +31510,7 @@ func (m *JetStreamBufferService) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.TLS = bool(v != 0)
+			m.ExactlyOnce = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -23951,7 +31532,7 @@ func (m *JetStreamBufferService) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JetStreamConfig) Unmarshal(dAtA []byte) error {
+func (m *KafkaSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23974,15 +31555,15 @@ func (m *JetStreamConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JetStreamConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: KafkaSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JetStreamConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KafkaSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Brokers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24010,13 +31591,13 @@ func (m *JetStreamConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			m.Brokers = append(m.Brokers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24026,31 +31607,27 @@ func (m *JetStreamConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Auth == nil {
-				m.Auth = &NatsAuth{}
-			}
-			if err := m.Auth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Topic = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StreamConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerGroupName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24078,13 +31655,13 @@ func (m *JetStreamConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StreamConfig = string(dAtA[iNdEx:postIndex])
+			m.ConsumerGroupName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLSEnabled", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24094,65 +31671,31 @@ func (m *JetStreamConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.TLSEnabled = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *JetStreamSource) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.TLS == nil {
+				m.TLS = &TLS{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: JetStreamSource: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JetStreamSource: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24180,11 +31723,47 @@ func (m *JetStreamSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			m.Config = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SASL", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SASL == nil {
+				m.SASL = &SASL{}
+			}
+			if err := m.SASL.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KafkaVersion", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24212,11 +31791,11 @@ func (m *JetStreamSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Stream = string(dAtA[iNdEx:postIndex])
+			m.KafkaVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartPosition", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24243,18 +31822,18 @@ func (m *JetStreamSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TLS == nil {
-				m.TLS = &TLS{}
+			if m.StartPosition == nil {
+				m.StartPosition = &KafkaStartPosition{}
 			}
-			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StartPosition.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyHeader", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24264,27 +31843,55 @@ func (m *JetStreamSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Auth == nil {
-				m.Auth = &NatsAuth{}
+			m.KeyHeader = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventTimeSource", wireType)
 			}
-			if err := m.Auth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.EventTimeSource = KafkaEventTimeSource(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -24307,7 +31914,7 @@ func (m *JetStreamSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobTemplate) Unmarshal(dAtA []byte) error {
+func (m *KafkaStartPosition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24330,17 +31937,17 @@ func (m *JobTemplate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobTemplate: wiretype end group for non-group")
+			return fmt.Errorf("proto: KafkaStartPosition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KafkaStartPosition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AbstractPodTemplate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24350,28 +31957,27 @@ func (m *JobTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AbstractPodTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Policy = KafkaStartPolicy(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerTemplate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24398,53 +32004,13 @@ func (m *JobTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ContainerTemplate == nil {
-				m.ContainerTemplate = &ContainerTemplate{}
+			if m.Timestamp == nil {
+				m.Timestamp = &v11.Time{}
 			}
-			if err := m.ContainerTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Timestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TTLSecondsAfterFinished", wireType)
-			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.TTLSecondsAfterFinished = &v
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BackoffLimit", wireType)
-			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.BackoffLimit = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -24466,7 +32032,7 @@ func (m *JobTemplate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KafkaSink) Unmarshal(dAtA []byte) error {
+func (m *Lifecycle) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24489,17 +32055,17 @@ func (m *KafkaSink) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KafkaSink: wiretype end group for non-group")
+			return fmt.Errorf("proto: Lifecycle: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KafkaSink: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Lifecycle: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Brokers", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletionGracePeriodSeconds", wireType)
 			}
-			var stringLen uint64
+			var v int64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24509,27 +32075,15 @@ func (m *KafkaSink) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Brokers = append(m.Brokers, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
+			m.DeletionGracePeriodSeconds = &v
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredPhase", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24557,13 +32111,13 @@ func (m *KafkaSink) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Topic = string(dAtA[iNdEx:postIndex])
+			m.DesiredPhase = PipelinePhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SetKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseGracePeriodSeconds", wireType)
 			}
-			var v int
+			var v int64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24573,85 +32127,17 @@ func (m *KafkaSink) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				v |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.SetKey = bool(v != 0)
+			m.PauseGracePeriodSeconds = &v
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.TLS == nil {
-				m.TLS = &TLS{}
-			}
-			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Config = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SASL", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeprecatedDeleteGracePeriodSeconds", wireType)
 			}
-			var msglen int
+			var v int64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24661,28 +32147,12 @@ func (m *KafkaSink) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.SASL == nil {
-				m.SASL = &SASL{}
-			}
-			if err := m.SASL.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.DeprecatedDeleteGracePeriodSeconds = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -24704,7 +32174,7 @@ func (m *KafkaSink) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KafkaSource) Unmarshal(dAtA []byte) error {
+func (m *Log) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24727,15 +32197,15 @@ func (m *KafkaSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KafkaSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: Log: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KafkaSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Log: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Brokers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24763,11 +32233,11 @@ func (m *KafkaSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Brokers = append(m.Brokers, string(dAtA[iNdEx:postIndex]))
+			m.Format = LogFormat(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadEncoding", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24795,43 +32265,11 @@ func (m *KafkaSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Topic = string(dAtA[iNdEx:postIndex])
+			m.PayloadEncoding = LogPayloadEncoding(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerGroupName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ConsumerGroupName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sampling", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24858,86 +32296,18 @@ func (m *KafkaSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TLS == nil {
-				m.TLS = &TLS{}
+			if m.Sampling == nil {
+				m.Sampling = &LogSampling{}
 			}
-			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Sampling.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Config = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SASL", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.SASL == nil {
-				m.SASL = &SASL{}
-			}
-			if err := m.SASL.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KafkaVersion", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPayloadBytes", wireType)
 			}
-			var stringLen uint64
+			m.MaxPayloadBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24947,24 +32317,11 @@ func (m *KafkaSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MaxPayloadBytes |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.KafkaVersion = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -24986,7 +32343,7 @@ func (m *KafkaSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Lifecycle) Unmarshal(dAtA []byte) error {
+func (m *LogSampling) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25009,17 +32366,17 @@ func (m *Lifecycle) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Lifecycle: wiretype end group for non-group")
+			return fmt.Errorf("proto: LogSampling: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Lifecycle: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LogSampling: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeletionGracePeriodSeconds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EveryN", wireType)
 			}
-			var v int64
+			m.EveryN = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25029,69 +32386,16 @@ func (m *Lifecycle) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int64(b&0x7F) << shift
+				m.EveryN |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.DeletionGracePeriodSeconds = &v
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DesiredPhase", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DesiredPhase = PipelinePhase(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PauseGracePeriodSeconds", wireType)
-			}
-			var v int64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.PauseGracePeriodSeconds = &v
-		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeprecatedDeleteGracePeriodSeconds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PerSecond", wireType)
 			}
-			var v int64
+			m.PerSecond = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25101,62 +32405,11 @@ func (m *Lifecycle) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int64(b&0x7F) << shift
+				m.PerSecond |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.DeprecatedDeleteGracePeriodSeconds = &v
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Log) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Log: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Log: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -27443,11 +34696,43 @@ func (m *NatsSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subject = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27475,13 +34760,13 @@ func (m *NatsSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Subject = string(dAtA[iNdEx:postIndex])
+			m.Queue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27491,27 +34776,31 @@ func (m *NatsSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Queue = string(dAtA[iNdEx:postIndex])
+			if m.TLS == nil {
+				m.TLS = &TLS{}
+			}
+			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27538,18 +34827,18 @@ func (m *NatsSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TLS == nil {
-				m.TLS = &TLS{}
+			if m.Auth == nil {
+				m.Auth = &NatsAuth{}
 			}
-			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Auth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyFromHeader", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27559,28 +34848,75 @@ func (m *NatsSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Auth == nil {
-				m.Auth = &NatsAuth{}
+			m.KeyFromHeader = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventTimeFromHeader", wireType)
 			}
-			if err := m.Auth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.EventTimeFromHeader = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OverflowSize", wireType)
+			}
+			m.OverflowSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OverflowSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -28236,6 +35572,42 @@ func (m *PipelineLimits) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimCheck", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ClaimCheck == nil {
+				m.ClaimCheck = &ClaimCheck{}
+			}
+			if err := m.ClaimCheck.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -28675,6 +36047,42 @@ func (m *PipelineSpec) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterStepBuffer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InterStepBuffer == nil {
+				m.InterStepBuffer = &InterStepBuffer{}
+			}
+			if err := m.InterStepBuffer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -29483,6 +36891,38 @@ func (m *PulsarSource) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdminURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -29848,13 +37288,101 @@ func (m *RedisConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SentinelPassword == nil {
-				m.SentinelPassword = &v1.SecretKeySelector{}
+			if m.SentinelPassword == nil {
+				m.SentinelPassword = &v1.SecretKeySelector{}
+			}
+			if err := m.SentinelPassword.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SentinelUser", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SentinelUser = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TLS == nil {
+				m.TLS = &TLS{}
 			}
-			if err := m.SentinelPassword.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cluster", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Cluster = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -33532,6 +41060,39 @@ func (m *SqsSink) Unmarshal(dAtA []byte) error {
 			}
 			m.QueueOwnerAWSAccountID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndpointURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.EndpointURL = &s
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -35843,64 +43404,215 @@ func (m *VertexList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, Vertex{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, Vertex{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VertexSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VertexSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VertexSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AbstractVertex", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AbstractVertex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PipelineName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PipelineName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterStepBufferServiceName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InterStepBufferServiceName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Replicas = &v
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromEdges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromEdges = append(m.FromEdges, CombinedEdge{})
+			if err := m.FromEdges[len(m.FromEdges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *VertexSpec) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: VertexSpec: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VertexSpec: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AbstractVertex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToEdges", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -35927,97 +43639,14 @@ func (m *VertexSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AbstractVertex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ToEdges = append(m.ToEdges, CombinedEdge{})
+			if err := m.ToEdges[len(m.ToEdges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PipelineName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PipelineName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InterStepBufferServiceName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.InterStepBufferServiceName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
-			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Replicas = &v
-		case 5:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromEdges", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Watermark", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -36044,14 +43673,13 @@ func (m *VertexSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FromEdges = append(m.FromEdges, CombinedEdge{})
-			if err := m.FromEdges[len(m.FromEdges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Watermark.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToEdges", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Lifecycle", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -36078,14 +43706,13 @@ func (m *VertexSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToEdges = append(m.ToEdges, CombinedEdge{})
-			if err := m.ToEdges[len(m.ToEdges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Lifecycle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Watermark", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimCheck", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -36112,40 +43739,10 @@ func (m *VertexSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Watermark.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lifecycle", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+			if m.ClaimCheck == nil {
+				m.ClaimCheck = &ClaimCheck{}
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Lifecycle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ClaimCheck.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -37062,6 +44659,78 @@ func (m *Window) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Count == nil {
+				m.Count = &CountWindow{}
+			}
+			if err := m.Count.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Global", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Global == nil {
+				m.Global = &GlobalWindow{}
+			}
+			if err := m.Global.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -50,4 +50,48 @@ type GeneratorSource struct {
 	// if present, the Value and MsgSize fields will be ignored.
 	// +optional
 	ValueBlob *string `json:"valueBlob,omitempty" protobuf:"bytes,7,opt,name=valueBlob"`
+	// Template is an optional Go template of the payload, with the sprig functions, e.g. randInt and uuidv4.
+	// The fields are .Key, .KeyIndex, .Replica, .Counter (incremented for each message), .KeyCounter
+	// (incremented for each message of the key) and .EventTime.
+	// if present, the Value, MsgSize and ValueBlob fields will be ignored.
+	// +optional
+	Template *string `json:"template,omitempty" protobuf:"bytes,8,opt,name=template"`
+	// Replay replays the lines of a JSONL file as the payloads, instead of generating them.
+	// RPU lines are replayed per Duration, and the KeyCount is ignored.
+	// +optional
+	Replay *GeneratorReplay `json:"replay,omitempty" protobuf:"bytes,9,opt,name=replay"`
+	// OutOfOrder delays the event times of a percentage of the messages, used to simulate late data.
+	// +optional
+	OutOfOrder *GeneratorOutOfOrder `json:"outOfOrder,omitempty" protobuf:"bytes,10,opt,name=outOfOrder"`
+}
+
+// GeneratorReplay is a JSONL file to replay by the generator.
+type GeneratorReplay struct {
+	// Path of the JSONL file.
+	Path string `json:"path" protobuf:"bytes,1,opt,name=path"`
+	// VolumeName is the name of a volume of the vertex, it's mounted at the directory of the path.
+	// +optional
+	VolumeName string `json:"volumeName,omitempty" protobuf:"bytes,2,opt,name=volumeName"`
+	// Loop replays the file from the beginning after the end of it, it's replayed only once if false.
+	// +optional
+	Loop bool `json:"loop,omitempty" protobuf:"varint,3,opt,name=loop"`
+	// KeyField is the field of a line used as the key, the generator keys are used if it's not specified.
+	// +optional
+	KeyField string `json:"keyField,omitempty" protobuf:"bytes,4,opt,name=keyField"`
+	// EventTimeField is the field of a line used as the event time, in RFC3339 format or epoch milliseconds.
+	// The time of the generation is used if it's not specified.
+	// +optional
+	EventTimeField string `json:"eventTimeField,omitempty" protobuf:"bytes,5,opt,name=eventTimeField"`
+	// RewriteEventTime shifts the event times read from the EventTimeField, so that the first line of each replay
+	// starts at the time of the replay, and the intervals between the lines are kept.
+	// +optional
+	RewriteEventTime bool `json:"rewriteEventTime,omitempty" protobuf:"varint,6,opt,name=rewriteEventTime"`
+}
+
+// GeneratorOutOfOrder delays the event times of a percentage of the generated messages.
+type GeneratorOutOfOrder struct {
+	// Percent of the messages to delay, between 0 and 100.
+	Percent int32 `json:"percent" protobuf:"varint,1,opt,name=percent"`
+	// Delay of the event times of the messages, the messages are late if it's longer than the allowed lateness.
+	Delay metav1.Duration `json:"delay" protobuf:"bytes,2,opt,name=delay"`
 }
@@ -0,0 +1,119 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// HTTPSinkBatchFormat is the format of the body of a batch request.
+// +kubebuilder:validation:Enum=jsonArray;ndjson
+type HTTPSinkBatchFormat string
+
+const (
+	// HTTPSinkBatchFormatJSONArray sends a JSON array of the payloads, a payload is embedded as is if it's valid JSON,
+	// otherwise it's a string.
+	HTTPSinkBatchFormatJSONArray HTTPSinkBatchFormat = "jsonArray"
+	// HTTPSinkBatchFormatNDJSON sends the payloads separated by new lines.
+	HTTPSinkBatchFormatNDJSON HTTPSinkBatchFormat = "ndjson"
+)
+
+// HTTPSink sends the messages to an HTTP endpoint.
+// The 2xx responses are successes, the 408, 429 and 5xx responses are retried with the retry strategy of the sink,
+// the other 4xx responses are not retried, the OnFailure strategy is applied to the messages right away.
+type HTTPSink struct {
+	// URL of the endpoint, a Go template rendered with the message, e.g. "https://example.com/events/{{ index .Keys 0 }}".
+	// The fields of the message are .ID, .Keys, .Headers and .EventTime.
+	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
+	// Method of the requests, defaults to POST.
+	// +kubebuilder:default=POST
+	// +optional
+	Method string `json:"method,omitempty" protobuf:"bytes,2,opt,name=method"`
+	// Headers of the requests, the values are Go templates rendered with the message like the URL.
+	// +optional
+	Headers map[string]string `json:"headers,omitempty" protobuf:"bytes,3,rep,name=headers"`
+	// Batch sends the messages in batches, the messages with the same rendered URL and headers are sent together.
+	// Each message is sent in its own request if it's not specified.
+	// +optional
+	Batch *HTTPSinkBatch `json:"batch,omitempty" protobuf:"bytes,4,opt,name=batch"`
+	// BasicAuth sets the basic authentication of the requests.
+	// +optional
+	BasicAuth *BasicAuth `json:"basicAuth,omitempty" protobuf:"bytes,5,opt,name=basicAuth"`
+	// BearerTokenSecret refers to the secret that contains the bearer token of the requests.
+	// +optional
+	BearerTokenSecret *corev1.SecretKeySelector `json:"bearerTokenSecret,omitempty" protobuf:"bytes,6,opt,name=bearerTokenSecret"`
+	// TLS configuration of the client, the cert and key secrets are used for mTLS.
+	// +optional
+	TLS *TLS `json:"tls,omitempty" protobuf:"bytes,7,opt,name=tls"`
+	// Timeout of a request, defaults to 30s.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty" protobuf:"bytes,8,opt,name=timeout"`
+	// Concurrency is the max number of requests sent concurrently, defaults to 8.
+	// +optional
+	Concurrency int32 `json:"concurrency,omitempty" protobuf:"varint,9,opt,name=concurrency"`
+}
+
+// HTTPSinkBatch is the batching of the HTTP sink.
+// If the endpoint responds a batch with 207 Multi-Status and a body of {"results": [{"statusCode": 200}, ...]},
+// the status code of each message is taken from the result at the same index.
+type HTTPSinkBatch struct {
+	// MaxMessages is the max number of messages in a request, defaults to 100.
+	// +optional
+	MaxMessages int32 `json:"maxMessages,omitempty" protobuf:"varint,1,opt,name=maxMessages"`
+	// Format of the body, jsonArray or ndjson, defaults to jsonArray.
+	// +kubebuilder:default=jsonArray
+	// +optional
+	Format HTTPSinkBatchFormat `json:"format,omitempty" protobuf:"bytes,2,opt,name=format,casttype=HTTPSinkBatchFormat"`
+}
+
+func (h HTTPSink) GetMethod() string {
+	if h.Method == "" {
+		return "POST"
+	}
+	return h.Method
+}
+
+func (h HTTPSink) GetTimeout() time.Duration {
+	if h.Timeout != nil && h.Timeout.Duration > 0 {
+		return h.Timeout.Duration
+	}
+	return DefaultHTTPSinkTimeout
+}
+
+func (h HTTPSink) GetConcurrency() int {
+	if h.Concurrency > 0 {
+		return int(h.Concurrency)
+	}
+	return DefaultHTTPSinkConcurrency
+}
+
+func (b HTTPSinkBatch) GetMaxMessages() int {
+	if b.MaxMessages > 0 {
+		return int(b.MaxMessages)
+	}
+	return DefaultHTTPSinkBatchMaxMessages
+}
+
+func (b HTTPSinkBatch) GetFormat() HTTPSinkBatchFormat {
+	if b.Format == "" {
+		return HTTPSinkBatchFormatJSONArray
+	}
+	return b.Format
+}
@@ -16,7 +16,12 @@ limitations under the License.
 
 package v1alpha1
 
-import corev1 "k8s.io/api/core/v1"
+import (
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
 
 type HTTPSource struct {
 	// +optional
@@ -24,6 +29,36 @@ type HTTPSource struct {
 	// Whether to create a ClusterIP Service
 	// +optional
 	Service bool `json:"service" protobuf:"bytes,2,opt,name=service"`
+	// Batch configures the batch endpoint "/vertices/{vertexName}/batch", which accepts newline-delimited JSON
+	// or a JSON array, one message per element.
+	// +optional
+	Batch *HTTPBatch `json:"batch,omitempty" protobuf:"bytes,3,opt,name=batch"`
+	// Whether to hold the HTTP response until the messages are written to the inter-step buffer.
+	// When enabled, the batch endpoint reports the status of each message.
+	// +optional
+	AckAfterWrite bool `json:"ackAfterWrite,omitempty" protobuf:"varint,4,opt,name=ackAfterWrite"`
+	// The maximum time to wait for the messages to be written when ackAfterWrite is enabled, defaults to 30s.
+	// +optional
+	AckTimeout *metav1.Duration `json:"ackTimeout,omitempty" protobuf:"bytes,5,opt,name=ackTimeout"`
+}
+
+func (h HTTPSource) GetAckTimeout() time.Duration {
+	if h.AckTimeout != nil {
+		return h.AckTimeout.Duration
+	}
+	return DefaultHTTPSourceAckTimeout
+}
+
+type HTTPBatch struct {
+	// GJSON path of the field in each element used as the message ID for deduplication.
+	// A random UUID is generated if it's not specified or the field is missing.
+	// +optional
+	IDField string `json:"idField,omitempty" protobuf:"bytes,1,opt,name=idField"`
+	// GJSON path of the field in each element used as the event time, which could be the number of milliseconds
+	// elapsed since January 1, 1970 UTC, or a RFC3339 formatted string.
+	// The "x-numaflow-event-time" header or the time of the request is used if it's not specified or the field is missing.
+	// +optional
+	EventTimeField string `json:"eventTimeField,omitempty" protobuf:"bytes,2,opt,name=eventTimeField"`
 }
 
 type Authorization struct {
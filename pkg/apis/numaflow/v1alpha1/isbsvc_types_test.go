@@ -188,6 +188,15 @@ func TestInterStepBufferService_GetType(t *testing.T) {
 			},
 			want: ISBSvcTypeJetStream,
 		},
+		{
+			name: "Disk type",
+			isbs: InterStepBufferService{
+				Spec: InterStepBufferServiceSpec{
+					Disk: &DiskBufferService{},
+				},
+			},
+			want: ISBSvcTypeDisk,
+		},
 		{
 			name: "Unknown type",
 			isbs: InterStepBufferService{
@@ -16,6 +16,12 @@ limitations under the License.
 
 package v1alpha1
 
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
 type JetStreamSource struct {
 	// URL to connect to NATS cluster, multiple urls could be separated by comma.
 	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
@@ -27,4 +33,53 @@ type JetStreamSource struct {
 	// Auth information
 	// +optional
 	Auth *NatsAuth `json:"auth,omitempty" protobuf:"bytes,4,opt,name=auth"`
+	// FilterSubjects only consumes the messages of the subjects of the stream, all the messages are consumed if it's empty.
+	// +optional
+	FilterSubjects []string `json:"filterSubjects,omitempty" protobuf:"bytes,5,rep,name=filterSubjects"`
+	// DeliverPolicy is where the consumer starts, "all", "new", "byStartTime" or "bySequence", defaults to "all".
+	// It's only applied when the consumer is created, the deliver policy of an existing durable consumer can not be changed.
+	// +kubebuilder:validation:Enum="";all;new;byStartTime;bySequence
+	// +optional
+	DeliverPolicy JetStreamDeliverPolicy `json:"deliverPolicy,omitempty" protobuf:"bytes,6,opt,name=deliverPolicy,casttype=JetStreamDeliverPolicy"`
+	// StartTime is required by the "byStartTime" deliver policy.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty" protobuf:"bytes,7,opt,name=startTime"`
+	// StartSequence is required by the "bySequence" deliver policy.
+	// +optional
+	StartSequence uint64 `json:"startSequence,omitempty" protobuf:"varint,8,opt,name=startSequence"`
+	// MaxAckPending is the max number of messages delivered but not acknowledged, the default of the server is used if it's 0.
+	// +optional
+	MaxAckPending int64 `json:"maxAckPending,omitempty" protobuf:"varint,9,opt,name=maxAckPending"`
+	// AckWait is the time to wait for the acknowledgement of a message before it's redelivered, defaults to 30s.
+	// The acknowledgement of a message in processing is extended periodically.
+	// +optional
+	AckWait *metav1.Duration `json:"ackWait,omitempty" protobuf:"bytes,10,opt,name=ackWait"`
+	// Ephemeral uses an ephemeral consumer of each replica instead of the durable consumer of the vertex, it's removed by
+	// the server after the replica is gone. Each replica of the vertex receives all the messages with an ephemeral consumer.
+	// +optional
+	Ephemeral bool `json:"ephemeral,omitempty" protobuf:"varint,11,opt,name=ephemeral"`
+	// KeyFromHeader is the name of the message header whose value is used as the message key.
+	// +optional
+	KeyFromHeader string `json:"keyFromHeader,omitempty" protobuf:"bytes,12,opt,name=keyFromHeader"`
+}
+
+type JetStreamDeliverPolicy string
+
+const (
+	JetStreamDeliverAll         JetStreamDeliverPolicy = "all"
+	JetStreamDeliverNew         JetStreamDeliverPolicy = "new"
+	JetStreamDeliverByStartTime JetStreamDeliverPolicy = "byStartTime"
+	JetStreamDeliverBySequence  JetStreamDeliverPolicy = "bySequence"
+)
+
+func (j JetStreamSource) GetAckWait() time.Duration {
+	if j.AckWait != nil && j.AckWait.Duration > 0 {
+		return j.AckWait.Duration
+	}
+	return DefaultJetStreamSourceAckWait
+}
+
+// HasConsumerOptions returns true if any of the consumer options is specified.
+func (j JetStreamSource) HasConsumerOptions() bool {
+	return len(j.FilterSubjects) > 0 || j.DeliverPolicy != "" || j.MaxAckPending != 0 || j.AckWait != nil || j.Ephemeral || j.KeyFromHeader != ""
 }
@@ -35,4 +35,50 @@ type KafkaSink struct {
 	// SASL.enable=true default for SASL.
 	// +optional
 	SASL *SASL `json:"sasl" protobuf:"bytes,6,opt,name=sasl"`
+	// Partitioner specifies how the records are assigned to the partitions of the topic.
+	// "keyHash" hashes the keys of the messages, regardless of SetKey.
+	// "header" hashes the value of the header specified by PartitionHeader.
+	// "roundRobin" distributes the records evenly.
+	// If not provided, the records are hashed by the Kafka key if SetKey is true, otherwise randomly assigned.
+	// +kubebuilder:validation:Enum="";keyHash;header;roundRobin
+	// +optional
+	Partitioner KafkaPartitioner `json:"partitioner,omitempty" protobuf:"bytes,7,opt,name=partitioner,casttype=KafkaPartitioner"`
+	// PartitionHeader is the name of the message header used by the "header" partitioner.
+	// +optional
+	PartitionHeader string `json:"partitionHeader,omitempty" protobuf:"bytes,8,opt,name=partitionHeader"`
+	// ForwardHeaders forwards the headers of the messages as Kafka record headers, along with the event time
+	// in the "x-numaflow-event-time" header.
+	// +optional
+	ForwardHeaders bool `json:"forwardHeaders,omitempty" protobuf:"varint,9,opt,name=forwardHeaders"`
+	// SetTimestamp sets the timestamp of the records to the event time of the messages.
+	// +optional
+	SetTimestamp bool `json:"setTimestamp,omitempty" protobuf:"varint,10,opt,name=setTimestamp"`
+	// Idempotent enables the idempotent producer, so that the retries of the producer don't introduce duplicates.
+	// +optional
+	Idempotent bool `json:"idempotent,omitempty" protobuf:"varint,11,opt,name=idempotent"`
+	// Compression codec of the producer, "none", "gzip", "snappy", "lz4" or "zstd".
+	// It overrides "producer.compression" in the config.
+	// +kubebuilder:validation:Enum="";none;gzip;snappy;lz4;zstd
+	// +optional
+	Compression string `json:"compression,omitempty" protobuf:"bytes,12,opt,name=compression"`
+	// MaxMessageBytes is the max size of a record, including the key, value and headers.
+	// The records exceeding it are rejected as non-retryable errors, instead of being retried.
+	// It overrides "producer.maxMessageBytes" in the config, which defaults to 1000000.
+	// +optional
+	MaxMessageBytes int32 `json:"maxMessageBytes,omitempty" protobuf:"varint,13,opt,name=maxMessageBytes"`
+	// ExactlyOnce writes the messages in Kafka transactions, along with a checkpoint of the inter-step buffer offsets,
+	// so that the messages redelivered after a crash are not written again. It implies Idempotent.
+	// It requires the JetStream inter-step buffer service, and the vertex can not scale beyond 1 replica.
+	// The consumers of the topic need to use "isolation.level=read_committed" to only read the committed records.
+	// It's only supported by the main sink, not the fallback sink.
+	// +optional
+	ExactlyOnce bool `json:"exactlyOnce,omitempty" protobuf:"varint,14,opt,name=exactlyOnce"`
 }
+
+type KafkaPartitioner string
+
+const (
+	KafkaPartitionerKeyHash    KafkaPartitioner = "keyHash"
+	KafkaPartitionerHeader     KafkaPartitioner = "header"
+	KafkaPartitionerRoundRobin KafkaPartitioner = "roundRobin"
+)
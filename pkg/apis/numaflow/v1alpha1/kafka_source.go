@@ -16,6 +16,8 @@ limitations under the License.
 
 package v1alpha1
 
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
 type KafkaSource struct {
 	Brokers           []string `json:"brokers,omitempty" protobuf:"bytes,1,rep,name=brokers"`
 	Topic             string   `json:"topic" protobuf:"bytes,2,opt,name=topic"`
@@ -31,4 +33,45 @@ type KafkaSource struct {
 	// +optional
 	SASL         *SASL  `json:"sasl" protobuf:"bytes,6,opt,name=sasl"`
 	KafkaVersion string `json:"kafkaVersion,omitempty" protobuf:"bytes,7,opt,name=kafkaVersion"`
+	// StartPosition specifies where to start consuming a partition when the consumer group has no committed offset for it,
+	// e.g. when the source is attached to the topic for the first time. It overrides "consumer.offsets.initial" in the config.
+	// +optional
+	StartPosition *KafkaStartPosition `json:"startPosition,omitempty" protobuf:"bytes,8,opt,name=startPosition"`
+	// KeyHeader is the name of the record header whose value is used as the message key.
+	// If not provided, the record key is used.
+	// +optional
+	KeyHeader string `json:"keyHeader,omitempty" protobuf:"bytes,9,opt,name=keyHeader"`
+	// EventTimeSource specifies where the event time of the messages comes from, "recordTimestamp" or "logAppendTime".
+	// "recordTimestamp" uses the timestamp of the records, whatever the timestamp type of the topic is.
+	// "logAppendTime" requires the topic to be configured with "message.timestamp.type=LogAppendTime", so that the
+	// event time is the time the broker appended the record, the source fails to start otherwise.
+	// Defaults to "recordTimestamp".
+	// +kubebuilder:validation:Enum="";recordTimestamp;logAppendTime
+	// +optional
+	EventTimeSource KafkaEventTimeSource `json:"eventTimeSource,omitempty" protobuf:"bytes,10,opt,name=eventTimeSource,casttype=KafkaEventTimeSource"`
 }
+
+type KafkaStartPosition struct {
+	// Policy of the start position, "earliest", "latest" or "timestamp".
+	// +kubebuilder:validation:Enum=earliest;latest;timestamp
+	Policy KafkaStartPolicy `json:"policy" protobuf:"bytes,1,opt,name=policy,casttype=KafkaStartPolicy"`
+	// Timestamp is required by the "timestamp" policy, consuming starts from the earliest record whose timestamp is
+	// greater than or equal to it, or the latest if there's no such record.
+	// +optional
+	Timestamp *metav1.Time `json:"timestamp,omitempty" protobuf:"bytes,2,opt,name=timestamp"`
+}
+
+type KafkaStartPolicy string
+
+const (
+	KafkaStartEarliest  KafkaStartPolicy = "earliest"
+	KafkaStartLatest    KafkaStartPolicy = "latest"
+	KafkaStartTimestamp KafkaStartPolicy = "timestamp"
+)
+
+type KafkaEventTimeSource string
+
+const (
+	KafkaEventTimeRecordTimestamp KafkaEventTimeSource = "recordTimestamp"
+	KafkaEventTimeLogAppendTime   KafkaEventTimeSource = "logAppendTime"
+)
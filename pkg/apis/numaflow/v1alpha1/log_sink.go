@@ -16,5 +16,72 @@ limitations under the License.
 
 package v1alpha1
 
+// LogFormat is the output format of the log sink.
+// +kubebuilder:validation:Enum=text;json;payload
+type LogFormat string
+
+const (
+	// LogFormatText prints a line with the payload, keys, event time, headers and ID of a message.
+	LogFormatText LogFormat = "text"
+	// LogFormatJSON prints a JSON object per line.
+	LogFormatJSON LogFormat = "json"
+	// LogFormatPayload prints the payload only.
+	LogFormatPayload LogFormat = "payload"
+)
+
+// LogPayloadEncoding is the encoding of the payloads printed by the log sink.
+// +kubebuilder:validation:Enum=text;hex;base64
+type LogPayloadEncoding string
+
+const (
+	LogPayloadEncodingText   LogPayloadEncoding = "text"
+	LogPayloadEncodingHex    LogPayloadEncoding = "hex"
+	LogPayloadEncodingBase64 LogPayloadEncoding = "base64"
+)
+
 type Log struct {
+	// Format of the output, text, json or payload, defaults to text.
+	// The json and payload formats are printed to stdout without any prefix, so that they can be consumed by log collectors.
+	// +kubebuilder:default=text
+	// +optional
+	Format LogFormat `json:"format,omitempty" protobuf:"bytes,1,opt,name=format,casttype=LogFormat"`
+	// PayloadEncoding is the encoding of the payloads, text, hex or base64 for binary data, defaults to text.
+	// +kubebuilder:default=text
+	// +optional
+	PayloadEncoding LogPayloadEncoding `json:"payloadEncoding,omitempty" protobuf:"bytes,2,opt,name=payloadEncoding,casttype=LogPayloadEncoding"`
+	// Sampling prints a sample of the messages, all the messages are printed if it's not specified.
+	// +optional
+	Sampling *LogSampling `json:"sampling,omitempty" protobuf:"bytes,3,opt,name=sampling"`
+	// MaxPayloadBytes truncates the payloads longer than it before they are encoded, 0 means no limit.
+	// +optional
+	MaxPayloadBytes int32 `json:"maxPayloadBytes,omitempty" protobuf:"varint,4,opt,name=maxPayloadBytes"`
+}
+
+// LogSampling is the sampling of the messages printed by the log sink, the messages not sampled are still acknowledged.
+type LogSampling struct {
+	// EveryN prints every Nth message.
+	// +optional
+	EveryN int32 `json:"everyN,omitempty" protobuf:"varint,1,opt,name=everyN"`
+	// PerSecond is the max number of messages printed per second, applied after EveryN.
+	// +optional
+	PerSecond int32 `json:"perSecond,omitempty" protobuf:"varint,2,opt,name=perSecond"`
+}
+
+func (l Log) GetFormat() LogFormat {
+	if l.Format == "" {
+		return LogFormatText
+	}
+	return l.Format
+}
+
+func (l Log) GetPayloadEncoding() LogPayloadEncoding {
+	if l.PayloadEncoding == "" {
+		return LogPayloadEncodingText
+	}
+	return l.PayloadEncoding
+}
+
+// IsDefault returns true if none of the options is specified.
+func (l Log) IsDefault() bool {
+	return l.GetFormat() == LogFormatText && l.GetPayloadEncoding() == LogPayloadEncodingText && l.Sampling == nil && l.MaxPayloadBytes == 0
 }
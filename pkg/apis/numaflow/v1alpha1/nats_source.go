@@ -29,4 +29,18 @@ type NatsSource struct {
 	// Auth information
 	// +optional
 	Auth *NatsAuth `json:"auth,omitempty" protobuf:"bytes,5,opt,name=auth"`
+	// KeyFromHeader is the name of the message header whose value is used as the message key.
+	// +optional
+	KeyFromHeader string `json:"keyFromHeader,omitempty" protobuf:"bytes,6,opt,name=keyFromHeader"`
+	// EventTimeFromHeader is the name of the message header whose value is used as the event time, in RFC3339 format
+	// or epoch milliseconds. The time the message is received is used if it's not specified, or the header is missing or invalid.
+	// +optional
+	EventTimeFromHeader string `json:"eventTimeFromHeader,omitempty" protobuf:"bytes,7,opt,name=eventTimeFromHeader"`
+	// OverflowSize is the max number of messages buffered locally, in addition to the read buffer, when the inter-step
+	// buffer is under back pressure. The messages received when the overflow is full are dropped, and counted in the
+	// nats_source_dropped_total metric.
+	// If it's not specified, receiving blocks under back pressure, and the messages exceeding the pending limits of the
+	// NATS client are dropped as a slow consumer, which are counted in the same metric.
+	// +optional
+	OverflowSize int32 `json:"overflowSize,omitempty" protobuf:"varint,8,opt,name=overflowSize"`
 }
@@ -165,6 +165,9 @@ func (p Pipeline) GetAllBuffers() []string {
 	r := []string{}
 	for _, v := range p.Spec.Vertices {
 		r = append(r, v.OwnedBufferNames(p.Namespace, p.Name)...)
+		if dlq := v.DeadLetterBufferName(p.Namespace, p.Name); dlq != "" {
+			r = append(r, dlq)
+		}
 	}
 	return r
 }
@@ -423,6 +426,7 @@ func (p Pipeline) GetPipelineLimits() PipelineLimits {
 		if x.ReadTimeout != nil {
 			limits.ReadTimeout = x.ReadTimeout
 		}
+		limits.ClaimCheck = x.ClaimCheck
 	}
 	return limits
 }
@@ -504,6 +508,9 @@ type PipelineSpec struct {
 	// SideInputs defines the Side Inputs of a pipeline.
 	// +optional
 	SideInputs []SideInput `json:"sideInputs,omitempty" protobuf:"bytes,8,rep,name=sideInputs"`
+	// InterStepBuffer defines the pipeline level settings of the inter step buffers, they can be overridden by the edges.
+	// +optional
+	InterStepBuffer *InterStepBuffer `json:"interStepBuffer,omitempty" protobuf:"bytes,9,opt,name=interStepBuffer"`
 }
 
 func (pipeline PipelineSpec) GetMatchingVertices(f func(AbstractVertex) bool) map[string]*AbstractVertex {
@@ -535,6 +542,13 @@ func (pipeline PipelineSpec) GetSinksByName() map[string]*AbstractVertex {
 	})
 }
 
+// InterStepBuffer defines the settings of the inter step buffers of a pipeline.
+type InterStepBuffer struct {
+	// Compression of the message payloads written to the inter step buffers.
+	// +optional
+	Compression *Compression `json:"compression,omitempty" protobuf:"bytes,1,opt,name=compression"`
+}
+
 type Watermark struct {
 	// Disabled toggles the watermark propagation, defaults to false.
 	// +kubebuilder:default=false
@@ -630,6 +644,10 @@ type PipelineLimits struct {
 	// +kubebuilder:default= "1s"
 	// +optional
 	ReadTimeout *metav1.Duration `json:"readTimeout,omitempty" protobuf:"bytes,4,opt,name=readTimeout"`
+	// ClaimCheck stores the payloads larger than a threshold out of the inter-step buffers, which only carry the
+	// references to them, so the vertices can emit messages larger than the max message size of the buffers.
+	// +optional
+	ClaimCheck *ClaimCheck `json:"claimCheck,omitempty" protobuf:"bytes,5,opt,name=claimCheck"`
 }
 
 type PipelineStatus struct {
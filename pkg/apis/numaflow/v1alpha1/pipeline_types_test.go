@@ -81,6 +81,14 @@ func Test_GetAllBuffers(t *testing.T) {
 	assert.Equal(t, 2, len(s))
 	assert.Contains(t, s, testPipeline.Namespace+"-"+testPipeline.Name+"-p1-0")
 	assert.Contains(t, s, testPipeline.Namespace+"-"+testPipeline.Name+"-output-0")
+
+	pl := testPipeline.DeepCopy()
+	pl.Spec.Vertices[1].DeadLetter = &DeadLetter{}
+	pl.Spec.Vertices[2].DeadLetter = &DeadLetter{}
+	s = pl.GetAllBuffers()
+	assert.Equal(t, 4, len(s))
+	assert.Contains(t, s, testPipeline.Namespace+"-"+testPipeline.Name+"-p1-dlq")
+	assert.Contains(t, s, testPipeline.Namespace+"-"+testPipeline.Name+"-output-dlq")
 }
 
 func Test_GetVertex(t *testing.T) {
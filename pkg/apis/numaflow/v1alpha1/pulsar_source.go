@@ -26,4 +26,8 @@ type PulsarSource struct {
 	// Auth information
 	// +optional
 	Auth *PulsarAuth `json:"auth,omitempty" protobuf:"bytes,6,opt,name=auth"`
+	// AdminURL is the URL of the Pulsar web service (e.g. http://pulsar-broker:8080), it is used to query the
+	// subscription backlog to calculate pending messages. If not specified, pending messages are not available.
+	// +optional
+	AdminURL string `json:"adminUrl,omitempty" protobuf:"bytes,7,opt,name=adminUrl"`
 }
@@ -42,7 +42,7 @@ type RedisConfig struct {
 	// Only required when Sentinel is used
 	// +optional
 	MasterName string `json:"masterName,omitempty" protobuf:"bytes,3,opt,name=masterName"`
-	// Redis user
+	// Redis ACL user, the default user is used if it is not provided
 	// +optional
 	User string `json:"user,omitempty" protobuf:"bytes,4,opt,name=user"`
 	// Redis password secret selector
@@ -51,6 +51,16 @@ type RedisConfig struct {
 	// Sentinel password secret selector
 	// +optional
 	SentinelPassword *corev1.SecretKeySelector `json:"sentinelPassword,omitempty" protobuf:"bytes,6,opt,name=sentinelPassword"`
+	// Sentinel ACL user, the default user is used if it is not provided
+	// +optional
+	SentinelUser string `json:"sentinelUser,omitempty" protobuf:"bytes,7,opt,name=sentinelUser"`
+	// TLS configuration for the connections to Redis and Sentinel
+	// +optional
+	TLS *TLS `json:"tls,omitempty" protobuf:"bytes,8,opt,name=tls"`
+	// Cluster indicates the Redis URL is the address of a Redis Cluster, it is implied if the URL has multiple
+	// comma separated addresses. Can not be used together with Sentinel.
+	// +optional
+	Cluster bool `json:"cluster,omitempty" protobuf:"varint,9,opt,name=cluster"`
 }
 
 type NativeRedis struct {
@@ -25,9 +25,10 @@ type OnFailureRetryStrategy string
 
 // Constants representing the possible actions that can be taken when a failure occurs during an operation.
 const (
-	OnFailureRetry    OnFailureRetryStrategy = "retry"    // Retry the operation.
-	OnFailureFallback OnFailureRetryStrategy = "fallback" // Reroute the operation to a fallback mechanism.
-	OnFailureDrop     OnFailureRetryStrategy = "drop"     // Drop the operation and perform no further action.
+	OnFailureRetry      OnFailureRetryStrategy = "retry"      // Retry the operation.
+	OnFailureFallback   OnFailureRetryStrategy = "fallback"   // Reroute the operation to a fallback mechanism.
+	OnFailureDrop       OnFailureRetryStrategy = "drop"       // Drop the operation and perform no further action.
+	OnFailureDeadLetter OnFailureRetryStrategy = "deadletter" // Write the failed messages to the dead-letter buffer.
 )
 
 // RetryStrategy struct encapsulates the settings for retrying operations in the event of failures.
@@ -40,7 +41,8 @@ type RetryStrategy struct {
 	// The possible values are:
 	// 1. "retry": start another round of retrying the operation,
 	// 2. "fallback": re-route the operation to a fallback sink and
-	// 3. "drop": drop the operation and perform no further action.
+	// 3. "drop": drop the operation and perform no further action and
+	// 4. "deadletter": write the failed messages to the dead-letter buffer of the vertex, requires deadLetter to be configured in the vertex.
 	// The default action is to retry.
 	// +optional
 	// +kubebuilder:default="retry"
@@ -56,13 +58,17 @@ type Backoff struct {
 	// Steps defines the number of times to try writing to a sink including retries
 	// +optional
 	Steps *uint32 `json:"steps,omitempty" protobuf:"bytes,2,opt,name=steps"`
-	// TODO(Retry): Enable after we add support for exponential backoff
-	//// +optional
-	//Cap *metav1.Duration `json:"cap,omitempty" protobuf:"bytes,3,opt,name=cap"`
-	//// +optional
-	//Factor *floatstr `json:"factor,omitempty" protobuf:"bytes,2,opt,name=factor"`
-	//// +optional
-	//Jitter *floatstr `json:"jitter,omitempty" protobuf:"bytes,3,opt,name=jitter"`
+	// Cap is the maximum delay between two retries, the delay stops growing once it reaches the cap.
+	// +optional
+	Cap *metav1.Duration `json:"cap,omitempty" protobuf:"bytes,3,opt,name=cap"`
+	// Factor is multiplied to the delay after each retry, a value of 1 (the default) means a fixed interval.
+	// Must be greater than or equal to 1.
+	// +optional
+	Factor *float64 `json:"factor,omitempty" protobuf:"fixed64,4,opt,name=factor"`
+	// Jitter adds a random amount of up to Jitter*delay to each delay, in order to spread out the retries
+	// of the replicas. Must be in the range of [0, 1].
+	// +optional
+	Jitter *float64 `json:"jitter,omitempty" protobuf:"fixed64,5,opt,name=jitter"`
 }
 
 // GetBackoff constructs a wait.Backoff configuration using default values and optionally overrides
@@ -84,6 +90,15 @@ func (r RetryStrategy) GetBackoff() wait.Backoff {
 		if r.BackOff.Steps != nil {
 			wt.Steps = int(*r.BackOff.Steps)
 		}
+		if r.BackOff.Cap != nil {
+			wt.Cap = r.BackOff.Cap.Duration
+		}
+		if r.BackOff.Factor != nil {
+			wt.Factor = *r.BackOff.Factor
+		}
+		if r.BackOff.Jitter != nil {
+			wt.Jitter = *r.BackOff.Jitter
+		}
 	}
 
 	// Returns the fully configured Backoff structure, which is either default or overridden by custom settings.
@@ -98,7 +113,7 @@ func (r RetryStrategy) GetOnFailureRetryStrategy() OnFailureRetryStrategy {
 		return DefaultOnFailureRetryStrategy
 	}
 	switch *r.OnFailure {
-	case OnFailureRetry, OnFailureFallback, OnFailureDrop:
+	case OnFailureRetry, OnFailureFallback, OnFailureDrop, OnFailureDeadLetter:
 		// If a custom on-failure behavior is specified
 		return *r.OnFailure
 	default:
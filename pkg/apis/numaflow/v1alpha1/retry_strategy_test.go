@@ -26,6 +26,8 @@ import (
 
 func TestGetBackoff(t *testing.T) {
 	steps := uint32(10)
+	factor := 2.0
+	jitter := 0.2
 	tests := []struct {
 		name            string
 		strategy        RetryStrategy
@@ -65,12 +67,31 @@ func TestGetBackoff(t *testing.T) {
 				Steps:    DefaultRetrySteps,
 			},
 		},
+		{
+			name: "exponential backoff",
+			strategy: RetryStrategy{
+				BackOff: &Backoff{
+					Interval: &metav1.Duration{Duration: 10 * time.Millisecond},
+					Steps:    &steps,
+					Cap:      &metav1.Duration{Duration: time.Second},
+					Factor:   &factor,
+					Jitter:   &jitter,
+				},
+			},
+			expectedBackoff: wait.Backoff{
+				Duration: 10 * time.Millisecond,
+				Steps:    10,
+				Cap:      time.Second,
+				Factor:   2.0,
+				Jitter:   0.2,
+			},
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			got := tt.strategy.GetBackoff()
-			if got.Duration != tt.expectedBackoff.Duration || got.Steps != tt.expectedBackoff.Steps {
+			if got != tt.expectedBackoff {
 				t.Errorf("GetBackoff() = %v, want %v", got, tt.expectedBackoff)
 			}
 		})
@@ -52,6 +52,12 @@ type AbstractSink struct {
 	// SQS sink is used to write the data to the AWS SQS.
 	// +optional
 	Sqs *SqsSink `json:"sqs,omitempty" protobuf:"bytes,6,opt,name=sqs"`
+	// File sink is used to write the data to files in a local path or an S3 compatible object store.
+	// +optional
+	File *FileSink `json:"file,omitempty" protobuf:"bytes,7,opt,name=file"`
+	// HTTP sink is used to send the data to an HTTP endpoint.
+	// +optional
+	HTTP *HTTPSink `json:"http,omitempty" protobuf:"bytes,8,opt,name=http"`
 }
 
 func (s Sink) getContainers(req getContainerReq) ([]corev1.Container, []corev1.Container, error) {
@@ -145,5 +151,5 @@ func (s Sink) getFallbackUDSinkContainer(mainContainerReq getContainerReq) corev
 
 // IsAnySinkSpecified returns true if any sink is specified.
 func (a *AbstractSink) IsAnySinkSpecified() bool {
-	return a.Log != nil || a.Kafka != nil || a.Blackhole != nil || a.UDSink != nil
+	return a.Log != nil || a.Kafka != nil || a.Blackhole != nil || a.UDSink != nil || a.File != nil || a.HTTP != nil
 }
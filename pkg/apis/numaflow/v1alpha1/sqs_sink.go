@@ -9,4 +9,9 @@ type SqsSink struct {
 
 	// QueueOwnerAWSAccountID is the queue owner aws account id
 	QueueOwnerAWSAccountID string `json:"queueOwnerAWSAccountID" protobuf:"bytes,3,name=queueOwnerAWSAccountID"`
+
+	// EndpointURL is the custom endpoint URL for the AWS SQS API.
+	// This is useful for testing with localstack or when using VPC endpoints.
+	// +optional
+	EndpointURL *string `json:"endpointUrl,omitempty" protobuf:"bytes,4,opt,name=endpointUrl"`
 }
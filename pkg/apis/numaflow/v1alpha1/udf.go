@@ -28,7 +28,7 @@ import (
 )
 
 type Function struct {
-	// +kubebuilder:validation:Enum=cat;filter
+	// +kubebuilder:validation:Enum=cat;filter;project;keyExtractor;template;encode;decode;flatMap;count;sum;min;max;topK
 	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
 	// +optional
 	Args []string `json:"args,omitempty" protobuf:"bytes,2,rep,name=args"`
@@ -47,7 +47,11 @@ type UDF struct {
 
 func (in UDF) getContainers(req getContainerReq) ([]corev1.Container, []corev1.Container, error) {
 	monitorContainer := buildMonitorContainer(req)
-	sidecarContainers := []corev1.Container{monitorContainer, in.getUDFContainer(req)}
+	sidecarContainers := []corev1.Container{monitorContainer}
+	// builtin reduce functions run in the main container
+	if in.GroupBy == nil || in.Builtin == nil {
+		sidecarContainers = append(sidecarContainers, in.getUDFContainer(req))
+	}
 	return sidecarContainers, []corev1.Container{in.getMainContainer(req)}, nil
 }
 
@@ -136,6 +140,11 @@ type GroupBy struct {
 	AllowedLateness *metav1.Duration `json:"allowedLateness,omitempty" protobuf:"bytes,3,opt,name=allowedLateness"`
 	// Storage is used to define the PBQ storage for a reduce vertex.
 	Storage *PBQStorage `json:"storage,omitempty" protobuf:"bytes,4,opt,name=storage"`
+	// LateDataTag is the tag the late messages, which are behind (Watermark - AllowedLateness), are forwarded with
+	// instead of being dropped. Only the outgoing edges whose conditions match the tag, and the ones without any
+	// conditions, receive them. The window the message would have been assigned to is attached as headers.
+	// +optional
+	LateDataTag string `json:"lateDataTag,omitempty" protobuf:"bytes,5,opt,name=lateDataTag"`
 }
 
 // Window describes windowing strategy
@@ -148,6 +157,10 @@ type Window struct {
 	Session *SessionWindow `json:"session" protobuf:"bytes,3,opt,name=session"`
 	// +optional
 	Accumulator *AccumulatorWindow `json:"accumulator" protobuf:"bytes,4,opt,name=accumulator"`
+	// +optional
+	Count *CountWindow `json:"count" protobuf:"bytes,5,opt,name=count"`
+	// +optional
+	Global *GlobalWindow `json:"global" protobuf:"bytes,6,opt,name=global"`
 }
 
 // FixedWindow describes a fixed window
@@ -184,6 +197,24 @@ type AccumulatorWindow struct {
 	Timeout *metav1.Duration `json:"timeout,omitempty" protobuf:"bytes,1,opt,name=timeout"`
 }
 
+// CountWindow describes a count window, which is closed for a key once it has received the given number of messages.
+type CountWindow struct {
+	// Count is the number of messages of a key after which the window of the key is closed.
+	Count uint32 `json:"count" protobuf:"varint,1,opt,name=count"`
+	// Timeout is the duration, in event time since the first message of the window, after which a window
+	// which has not received Count messages is closed anyway.
+	Timeout *metav1.Duration `json:"timeout,omitempty" protobuf:"bytes,2,opt,name=timeout"`
+}
+
+// GlobalWindow describes a global window, which is only closed for a key when a message matches the trigger
+// expression, or for all the keys when the vertex is shutting down (e.g., the pipeline is paused).
+type GlobalWindow struct {
+	// Trigger is a boolean expression evaluated against every message (payload, keys and headers), the window
+	// of the message's key is closed after the message is added to it if the expression evaluates to true.
+	// +optional
+	Trigger string `json:"trigger,omitempty" protobuf:"bytes,1,opt,name=trigger"`
+}
+
 // PBQStorage defines the persistence configuration for a vertex.
 type PBQStorage struct {
 	// +optional
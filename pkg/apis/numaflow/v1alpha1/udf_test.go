@@ -90,6 +90,23 @@ func TestUDF_getContainers(t *testing.T) {
 	assert.Equal(t, int32(5), sc[1].LivenessProbe.FailureThreshold)
 }
 
+func TestUDF_getContainers_builtinReduce(t *testing.T) {
+	x := UDF{
+		Builtin: &Function{Name: "count"},
+		GroupBy: &GroupBy{},
+	}
+	sc, c, err := x.getContainers(getContainerReq{
+		image:      "main-image",
+		isbSvcType: ISBSvcTypeJetStream,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(c))
+	assert.Contains(t, c[0].Args, "--type="+string(VertexTypeReduceUDF))
+	// no udf container for builtin reduce functions
+	assert.Equal(t, 1, len(sc))
+	assert.Equal(t, CtrMonitor, sc[0].Name)
+}
+
 func Test_getUDFContainer(t *testing.T) {
 	t.Run("with customized image", func(t *testing.T) {
 		x := UDF{
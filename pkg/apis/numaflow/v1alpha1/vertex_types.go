@@ -20,6 +20,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"path"
 	"sort"
 	"strings"
 
@@ -290,6 +291,35 @@ func (v Vertex) GetPodSpec(req GetVertexPodSpecReq) (*corev1.PodSpec, error) {
 	if err != nil {
 		return nil, err
 	}
+	// the built-in file sink writes to the volume in the main container
+	if x := v.Spec.Sink; x != nil && x.File != nil && x.File.VolumeName != "" {
+		containers[0].VolumeMounts = append(containers[0].VolumeMounts, corev1.VolumeMount{Name: x.File.VolumeName, MountPath: x.File.Path})
+	}
+	// the generator replays the file from the volume in the main container
+	if x := v.Spec.Source; x != nil && x.Generator != nil && x.Generator.Replay != nil && x.Generator.Replay.VolumeName != "" {
+		containers[0].VolumeMounts = append(containers[0].VolumeMounts, corev1.VolumeMount{Name: x.Generator.Replay.VolumeName, MountPath: path.Dir(x.Generator.Replay.Path)})
+	}
+	// the oversized payloads are stored to and loaded from the claim check volume in the main container
+	if x := v.Spec.ClaimCheck; x != nil && x.PersistentVolumeClaim != nil {
+		claimCheckVolName := "claim-check-vol"
+		volumes = append(volumes, corev1.Volume{
+			Name:         claimCheckVolName,
+			VolumeSource: corev1.VolumeSource{PersistentVolumeClaim: x.PersistentVolumeClaim.DeepCopy()},
+		})
+		containers[0].VolumeMounts = append(containers[0].VolumeMounts, corev1.VolumeMount{Name: claimCheckVolName, MountPath: PathClaimCheckMount})
+	}
+
+	// the pod annotations tell the vertex whether it's shutting down because the pipeline is paused
+	if v.Spec.FlushesWindowsOnPause() {
+		podInfoVolName := "podinfo"
+		volumes = append(volumes, corev1.Volume{
+			Name: podInfoVolName,
+			VolumeSource: corev1.VolumeSource{DownwardAPI: &corev1.DownwardAPIVolumeSource{
+				Items: []corev1.DownwardAPIVolumeFile{{Path: "annotations", FieldRef: &corev1.ObjectFieldSelector{FieldPath: "metadata.annotations"}}},
+			}},
+		})
+		containers[0].VolumeMounts = append(containers[0].VolumeMounts, corev1.VolumeMount{Name: podInfoVolName, MountPath: PathPodInfoMount, ReadOnly: true})
+	}
 
 	var readyzInitDeploy, readyzPeriodSeconds, readyzTimeoutSeconds, readyzFailureThreshold int32 = NumaContainerReadyzInitialDelaySeconds, NumaContainerReadyzPeriodSeconds, NumaContainerReadyzTimeoutSeconds, NumaContainerReadyzFailureThreshold
 	var liveZInitDeploy, liveZPeriodSeconds, liveZTimeoutSeconds, liveZFailureThreshold int32 = NumaContainerLivezInitialDelaySeconds, NumaContainerLivezPeriodSeconds, NumaContainerLivezTimeoutSeconds, NumaContainerLivezFailureThreshold
@@ -440,6 +470,22 @@ func (v Vertex) OwnedBuffers() []string {
 	return v.Spec.OwnedBufferNames(v.Namespace, v.Spec.PipelineName)
 }
 
+// GetInputPriority returns the priority settings of the buffers that the vertex owns, which are the same for all
+// the from edges with priorities, or nil if none of them has.
+func (v Vertex) GetInputPriority() *EdgePriority {
+	for _, e := range v.Spec.FromEdges {
+		if e.Priority != nil {
+			return e.Priority
+		}
+	}
+	return nil
+}
+
+// GetDeadLetterBuffer returns the dead-letter buffer of the vertex, or an empty string if it's not enabled.
+func (v Vertex) GetDeadLetterBuffer() string {
+	return v.Spec.DeadLetterBufferName(v.Namespace, v.Spec.PipelineName)
+}
+
 // GetFromBuckets returns the buckets that the vertex reads from.
 // For a source vertex, it returns the source bucket name.
 func (v Vertex) GetFromBuckets() []string {
@@ -528,6 +574,9 @@ type VertexSpec struct {
 	// +kubebuilder:default={"desiredPhase": Running}
 	// +optional
 	Lifecycle VertexLifecycle `json:"lifecycle,omitempty" protobuf:"bytes,8,opt,name=lifecycle"`
+	// ClaimCheck indicates where the oversized payloads are stored, it's populated from the pipeline limits.
+	// +optional
+	ClaimCheck *ClaimCheck `json:"claimCheck,omitempty" protobuf:"bytes,9,opt,name=claimCheck"`
 }
 
 type AbstractVertex struct {
@@ -577,6 +626,9 @@ type AbstractVertex struct {
 	// +kubebuilder:default={"type": "RollingUpdate", "rollingUpdate": {"maxUnavailable": "25%"}}
 	// +optional
 	UpdateStrategy UpdateStrategy `json:"updateStrategy,omitempty" protobuf:"bytes,16,opt,name=updateStrategy"`
+	// DeadLetter enables the dead-letter buffer of the vertex, it applies to map udf and sink vertices only.
+	// +optional
+	DeadLetter *DeadLetter `json:"deadLetter,omitempty" protobuf:"bytes,17,opt,name=deadLetter"`
 }
 
 type VertexLifecycle struct {
@@ -663,6 +715,25 @@ func (av AbstractVertex) OwnedBufferNames(namespace, pipeline string) []string {
 	return r
 }
 
+// FlushesWindowsOnPause returns true if the vertex closes its windows when the pipeline is paused, i.e., it's a
+// reduce vertex with global windows.
+func (av AbstractVertex) FlushesWindowsOnPause() bool {
+	return av.IsReduceUDF() && av.UDF.GroupBy.Window.Global != nil
+}
+
+// HasDeadLetter returns true if the dead-letter buffer is enabled for the vertex.
+func (av AbstractVertex) HasDeadLetter() bool {
+	return av.DeadLetter != nil && (av.IsMapUDF() || av.IsASink())
+}
+
+// DeadLetterBufferName returns the dead-letter buffer name of the vertex, or an empty string if it's not enabled.
+func (av AbstractVertex) DeadLetterBufferName(namespace, pipeline string) string {
+	if !av.HasDeadLetter() {
+		return ""
+	}
+	return GenerateDeadLetterBufferName(namespace, pipeline, av.Name)
+}
+
 type VertexLimits struct {
 	// Read batch size from the source or buffer.
 	// It overrides the settings from pipeline limits.
@@ -371,6 +371,42 @@ func TestGetPodSpec(t *testing.T) {
 		assert.Equal(t, CtrMonitor, s.InitContainers[1].Name)
 	})
 
+	t.Run("test file sink with volume", func(t *testing.T) {
+		testObj := testVertex.DeepCopy()
+		testObj.Spec.Sink = &Sink{AbstractSink: AbstractSink{File: &FileSink{Path: "/data/out", VolumeName: "out"}}}
+		testObj.Spec.Volumes = []corev1.Volume{{Name: "out", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}}}
+		s, err := testObj.GetPodSpec(req)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, len(s.Volumes))
+		assert.Contains(t, s.Containers[0].VolumeMounts, corev1.VolumeMount{Name: "out", MountPath: "/data/out"})
+		assert.Equal(t, 1, len(s.InitContainers[1].VolumeMounts))
+	})
+
+	t.Run("test claim check volume", func(t *testing.T) {
+		testObj := testVertex.DeepCopy()
+		testObj.Spec.Sink = &Sink{AbstractSink: AbstractSink{Log: &Log{}}}
+		testObj.Spec.ClaimCheck = &ClaimCheck{PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "claims"}}
+		s, err := testObj.GetPodSpec(req)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, len(s.Volumes))
+		assert.Equal(t, "claims", s.Volumes[2].PersistentVolumeClaim.ClaimName)
+		assert.Contains(t, s.Containers[0].VolumeMounts, corev1.VolumeMount{Name: "claim-check-vol", MountPath: PathClaimCheckMount})
+	})
+
+	t.Run("test global window podinfo volume", func(t *testing.T) {
+		testObj := testVertex.DeepCopy()
+		testObj.Spec.Sink = nil
+		testObj.Spec.UDF = &UDF{
+			Container: &Container{Image: "my-image"},
+			GroupBy:   &GroupBy{Window: Window{Global: &GlobalWindow{}}},
+		}
+		s, err := testObj.GetPodSpec(req)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, len(s.Volumes))
+		assert.Equal(t, "metadata.annotations", s.Volumes[2].DownwardAPI.Items[0].FieldRef.FieldPath)
+		assert.Contains(t, s.Containers[0].VolumeMounts, corev1.VolumeMount{Name: "podinfo", MountPath: PathPodInfoMount, ReadOnly: true})
+	})
+
 	t.Run("test user-defined sink", func(t *testing.T) {
 		testObj := testVertex.DeepCopy()
 		testObj.Spec.Sink = &Sink{
@@ -111,7 +111,7 @@ func (in *AbstractSink) DeepCopyInto(out *AbstractSink) {
 	if in.Log != nil {
 		in, out := &in.Log, &out.Log
 		*out = new(Log)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Kafka != nil {
 		in, out := &in.Kafka, &out.Kafka
@@ -136,7 +136,17 @@ func (in *AbstractSink) DeepCopyInto(out *AbstractSink) {
 	if in.Sqs != nil {
 		in, out := &in.Sqs, &out.Sqs
 		*out = new(SqsSink)
-		**out = **in
+		(*in).DeepCopyInto(*out)
+	}
+	if in.File != nil {
+		in, out := &in.File, &out.File
+		*out = new(FileSink)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(HTTPSink)
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
@@ -223,6 +233,11 @@ func (in *AbstractVertex) DeepCopyInto(out *AbstractVertex) {
 		(*in).DeepCopyInto(*out)
 	}
 	in.UpdateStrategy.DeepCopyInto(&out.UpdateStrategy)
+	if in.DeadLetter != nil {
+		in, out := &in.DeadLetter, &out.DeadLetter
+		*out = new(DeadLetter)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -291,6 +306,21 @@ func (in *Backoff) DeepCopyInto(out *Backoff) {
 		*out = new(uint32)
 		**out = **in
 	}
+	if in.Cap != nil {
+		in, out := &in.Cap, &out.Cap
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Factor != nil {
+		in, out := &in.Factor, &out.Factor
+		*out = new(float64)
+		**out = **in
+	}
+	if in.Jitter != nil {
+		in, out := &in.Jitter, &out.Jitter
+		*out = new(float64)
+		**out = **in
+	}
 	return
 }
 
@@ -359,6 +389,11 @@ func (in *BufferServiceConfig) DeepCopyInto(out *BufferServiceConfig) {
 		*out = new(JetStreamConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Disk != nil {
+		in, out := &in.Disk, &out.Disk
+		*out = new(DiskConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -372,6 +407,53 @@ func (in *BufferServiceConfig) DeepCopy() *BufferServiceConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClaimCheck) DeepCopyInto(out *ClaimCheck) {
+	*out = *in
+	if in.ThresholdBytes != nil {
+		in, out := &in.ThresholdBytes, &out.ThresholdBytes
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.S3 != nil {
+		in, out := &in.S3, &out.S3
+		*out = new(ClaimCheckS3)
+		**out = **in
+	}
+	if in.PersistentVolumeClaim != nil {
+		in, out := &in.PersistentVolumeClaim, &out.PersistentVolumeClaim
+		*out = new(v1.PersistentVolumeClaimVolumeSource)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClaimCheck.
+func (in *ClaimCheck) DeepCopy() *ClaimCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(ClaimCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClaimCheckS3) DeepCopyInto(out *ClaimCheckS3) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClaimCheckS3.
+func (in *ClaimCheckS3) DeepCopy() *ClaimCheckS3 {
+	if in == nil {
+		return nil
+	}
+	out := new(ClaimCheckS3)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CombinedEdge) DeepCopyInto(out *CombinedEdge) {
 	*out = *in
@@ -409,6 +491,27 @@ func (in *CombinedEdge) DeepCopy() *CombinedEdge {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Compression) DeepCopyInto(out *Compression) {
+	*out = *in
+	if in.MinPayloadBytes != nil {
+		in, out := &in.MinPayloadBytes, &out.MinPayloadBytes
+		*out = new(uint32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Compression.
+func (in *Compression) DeepCopy() *Compression {
+	if in == nil {
+		return nil
+	}
+	out := new(Compression)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Container) DeepCopyInto(out *Container) {
 	*out = *in
@@ -528,6 +631,27 @@ func (in *ContainerTemplate) DeepCopy() *ContainerTemplate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CountWindow) DeepCopyInto(out *CountWindow) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CountWindow.
+func (in *CountWindow) DeepCopy() *CountWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(CountWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DaemonTemplate) DeepCopyInto(out *DaemonTemplate) {
 	*out = *in
@@ -560,6 +684,103 @@ func (in *DaemonTemplate) DeepCopy() *DaemonTemplate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeadLetter) DeepCopyInto(out *DeadLetter) {
+	*out = *in
+	if in.MaxRetries != nil {
+		in, out := &in.MaxRetries, &out.MaxRetries
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.RetryInterval != nil {
+		in, out := &in.RetryInterval, &out.RetryInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeadLetter.
+func (in *DeadLetter) DeepCopy() *DeadLetter {
+	if in == nil {
+		return nil
+	}
+	out := new(DeadLetter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskBufferService) DeepCopyInto(out *DiskBufferService) {
+	*out = *in
+	in.DiskStorage.DeepCopyInto(&out.DiskStorage)
+	if in.MaxSegmentSize != nil {
+		in, out := &in.MaxSegmentSize, &out.MaxSegmentSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.SyncInterval != nil {
+		in, out := &in.SyncInterval, &out.SyncInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskBufferService.
+func (in *DiskBufferService) DeepCopy() *DiskBufferService {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskBufferService)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskConfig) DeepCopyInto(out *DiskConfig) {
+	*out = *in
+	in.DiskStorage.DeepCopyInto(&out.DiskStorage)
+	out.SyncInterval = in.SyncInterval
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskConfig.
+func (in *DiskConfig) DeepCopy() *DiskConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskStorage) DeepCopyInto(out *DiskStorage) {
+	*out = *in
+	if in.HostPath != nil {
+		in, out := &in.HostPath, &out.HostPath
+		*out = new(v1.HostPathVolumeSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PersistentVolumeClaim != nil {
+		in, out := &in.PersistentVolumeClaim, &out.PersistentVolumeClaim
+		*out = new(v1.PersistentVolumeClaimVolumeSource)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskStorage.
+func (in *DiskStorage) DeepCopy() *DiskStorage {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskStorage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Edge) DeepCopyInto(out *Edge) {
 	*out = *in
@@ -573,6 +794,16 @@ func (in *Edge) DeepCopyInto(out *Edge) {
 		*out = new(BufferFullWritingStrategy)
 		**out = **in
 	}
+	if in.Compression != nil {
+		in, out := &in.Compression, &out.Compression
+		*out = new(Compression)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(EdgePriority)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -586,6 +817,76 @@ func (in *Edge) DeepCopy() *Edge {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EdgePriority) DeepCopyInto(out *EdgePriority) {
+	*out = *in
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]int32, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Weights != nil {
+		in, out := &in.Weights, &out.Weights
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EdgePriority.
+func (in *EdgePriority) DeepCopy() *EdgePriority {
+	if in == nil {
+		return nil
+	}
+	out := new(EdgePriority)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FileSink) DeepCopyInto(out *FileSink) {
+	*out = *in
+	if in.S3 != nil {
+		in, out := &in.S3, &out.S3
+		*out = new(FileSinkS3)
+		**out = **in
+	}
+	if in.RollInterval != nil {
+		in, out := &in.RollInterval, &out.RollInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileSink.
+func (in *FileSink) DeepCopy() *FileSink {
+	if in == nil {
+		return nil
+	}
+	out := new(FileSink)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FileSinkS3) DeepCopyInto(out *FileSinkS3) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileSinkS3.
+func (in *FileSinkS3) DeepCopy() *FileSinkS3 {
+	if in == nil {
+		return nil
+	}
+	out := new(FileSinkS3)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FixedWindow) DeepCopyInto(out *FixedWindow) {
 	*out = *in
@@ -697,6 +998,39 @@ func (in *GSSAPI) DeepCopy() *GSSAPI {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GeneratorOutOfOrder) DeepCopyInto(out *GeneratorOutOfOrder) {
+	*out = *in
+	out.Delay = in.Delay
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GeneratorOutOfOrder.
+func (in *GeneratorOutOfOrder) DeepCopy() *GeneratorOutOfOrder {
+	if in == nil {
+		return nil
+	}
+	out := new(GeneratorOutOfOrder)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GeneratorReplay) DeepCopyInto(out *GeneratorReplay) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GeneratorReplay.
+func (in *GeneratorReplay) DeepCopy() *GeneratorReplay {
+	if in == nil {
+		return nil
+	}
+	out := new(GeneratorReplay)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GeneratorSource) DeepCopyInto(out *GeneratorSource) {
 	*out = *in
@@ -735,6 +1069,21 @@ func (in *GeneratorSource) DeepCopyInto(out *GeneratorSource) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Template != nil {
+		in, out := &in.Template, &out.Template
+		*out = new(string)
+		**out = **in
+	}
+	if in.Replay != nil {
+		in, out := &in.Replay, &out.Replay
+		*out = new(GeneratorReplay)
+		**out = **in
+	}
+	if in.OutOfOrder != nil {
+		in, out := &in.OutOfOrder, &out.OutOfOrder
+		*out = new(GeneratorOutOfOrder)
+		**out = **in
+	}
 	return
 }
 
@@ -988,6 +1337,22 @@ func (in *GetVertexPodSpecReq) DeepCopy() *GetVertexPodSpecReq {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GlobalWindow) DeepCopyInto(out *GlobalWindow) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalWindow.
+func (in *GlobalWindow) DeepCopy() *GlobalWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(GlobalWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GroupBy) DeepCopyInto(out *GroupBy) {
 	*out = *in
@@ -1015,6 +1380,86 @@ func (in *GroupBy) DeepCopy() *GroupBy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPBatch) DeepCopyInto(out *HTTPBatch) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPBatch.
+func (in *HTTPBatch) DeepCopy() *HTTPBatch {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPBatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPSink) DeepCopyInto(out *HTTPSink) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Batch != nil {
+		in, out := &in.Batch, &out.Batch
+		*out = new(HTTPSinkBatch)
+		**out = **in
+	}
+	if in.BasicAuth != nil {
+		in, out := &in.BasicAuth, &out.BasicAuth
+		*out = new(BasicAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BearerTokenSecret != nil {
+		in, out := &in.BearerTokenSecret, &out.BearerTokenSecret
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(TLS)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPSink.
+func (in *HTTPSink) DeepCopy() *HTTPSink {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPSink)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPSinkBatch) DeepCopyInto(out *HTTPSinkBatch) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPSinkBatch.
+func (in *HTTPSinkBatch) DeepCopy() *HTTPSinkBatch {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPSinkBatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTPSource) DeepCopyInto(out *HTTPSource) {
 	*out = *in
@@ -1023,6 +1468,16 @@ func (in *HTTPSource) DeepCopyInto(out *HTTPSource) {
 		*out = new(Authorization)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Batch != nil {
+		in, out := &in.Batch, &out.Batch
+		*out = new(HTTPBatch)
+		**out = **in
+	}
+	if in.AckTimeout != nil {
+		in, out := &in.AckTimeout, &out.AckTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -1067,6 +1522,27 @@ func (in *IdleSource) DeepCopy() *IdleSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterStepBuffer) DeepCopyInto(out *InterStepBuffer) {
+	*out = *in
+	if in.Compression != nil {
+		in, out := &in.Compression, &out.Compression
+		*out = new(Compression)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterStepBuffer.
+func (in *InterStepBuffer) DeepCopy() *InterStepBuffer {
+	if in == nil {
+		return nil
+	}
+	out := new(InterStepBuffer)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InterStepBufferService) DeepCopyInto(out *InterStepBufferService) {
 	*out = *in
@@ -1141,6 +1617,11 @@ func (in *InterStepBufferServiceSpec) DeepCopyInto(out *InterStepBufferServiceSp
 		*out = new(JetStreamBufferService)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Disk != nil {
+		in, out := &in.Disk, &out.Disk
+		*out = new(DiskBufferService)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1263,6 +1744,20 @@ func (in *JetStreamSource) DeepCopyInto(out *JetStreamSource) {
 		*out = new(NatsAuth)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.FilterSubjects != nil {
+		in, out := &in.FilterSubjects, &out.FilterSubjects
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.AckWait != nil {
+		in, out := &in.AckWait, &out.AckWait
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -1357,6 +1852,11 @@ func (in *KafkaSource) DeepCopyInto(out *KafkaSource) {
 		*out = new(SASL)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.StartPosition != nil {
+		in, out := &in.StartPosition, &out.StartPosition
+		*out = new(KafkaStartPosition)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1370,6 +1870,26 @@ func (in *KafkaSource) DeepCopy() *KafkaSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaStartPosition) DeepCopyInto(out *KafkaStartPosition) {
+	*out = *in
+	if in.Timestamp != nil {
+		in, out := &in.Timestamp, &out.Timestamp
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaStartPosition.
+func (in *KafkaStartPosition) DeepCopy() *KafkaStartPosition {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaStartPosition)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Lifecycle) DeepCopyInto(out *Lifecycle) {
 	*out = *in
@@ -1404,6 +1924,11 @@ func (in *Lifecycle) DeepCopy() *Lifecycle {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Log) DeepCopyInto(out *Log) {
 	*out = *in
+	if in.Sampling != nil {
+		in, out := &in.Sampling, &out.Sampling
+		*out = new(LogSampling)
+		**out = **in
+	}
 	return
 }
 
@@ -1417,6 +1942,22 @@ func (in *Log) DeepCopy() *Log {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogSampling) DeepCopyInto(out *LogSampling) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogSampling.
+func (in *LogSampling) DeepCopy() *LogSampling {
+	if in == nil {
+		return nil
+	}
+	out := new(LogSampling)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Metadata) DeepCopyInto(out *Metadata) {
 	*out = *in
@@ -1878,6 +2419,11 @@ func (in *PipelineLimits) DeepCopyInto(out *PipelineLimits) {
 		*out = new(metav1.Duration)
 		**out = **in
 	}
+	if in.ClaimCheck != nil {
+		in, out := &in.ClaimCheck, &out.ClaimCheck
+		*out = new(ClaimCheck)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1960,6 +2506,11 @@ func (in *PipelineSpec) DeepCopyInto(out *PipelineSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.InterStepBuffer != nil {
+		in, out := &in.InterStepBuffer, &out.InterStepBuffer
+		*out = new(InterStepBuffer)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2143,6 +2694,11 @@ func (in *RedisConfig) DeepCopyInto(out *RedisConfig) {
 		*out = new(v1.SecretKeySelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(TLS)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2805,6 +3361,11 @@ func (in *Source) DeepCopy() *Source {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SqsSink) DeepCopyInto(out *SqsSink) {
 	*out = *in
+	if in.EndpointURL != nil {
+		in, out := &in.EndpointURL, &out.EndpointURL
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -3287,6 +3848,11 @@ func (in *VertexSpec) DeepCopyInto(out *VertexSpec) {
 	}
 	in.Watermark.DeepCopyInto(&out.Watermark)
 	out.Lifecycle = in.Lifecycle
+	if in.ClaimCheck != nil {
+		in, out := &in.ClaimCheck, &out.ClaimCheck
+		*out = new(ClaimCheck)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -3394,6 +3960,16 @@ func (in *Window) DeepCopyInto(out *Window) {
 		*out = new(AccumulatorWindow)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Count != nil {
+		in, out := &in.Count, &out.Count
+		*out = new(CountWindow)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Global != nil {
+		in, out := &in.Global, &out.Global
+		*out = new(GlobalWindow)
+		**out = **in
+	}
 	return
 }
 
@@ -37,15 +37,28 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.BasicAuth":                        schema_pkg_apis_numaflow_v1alpha1_BasicAuth(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Blackhole":                        schema_pkg_apis_numaflow_v1alpha1_Blackhole(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.BufferServiceConfig":              schema_pkg_apis_numaflow_v1alpha1_BufferServiceConfig(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ClaimCheck":                       schema_pkg_apis_numaflow_v1alpha1_ClaimCheck(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ClaimCheckS3":                     schema_pkg_apis_numaflow_v1alpha1_ClaimCheckS3(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.CombinedEdge":                     schema_pkg_apis_numaflow_v1alpha1_CombinedEdge(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Compression":                      schema_pkg_apis_numaflow_v1alpha1_Compression(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Container":                        schema_pkg_apis_numaflow_v1alpha1_Container(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ContainerTemplate":                schema_pkg_apis_numaflow_v1alpha1_ContainerTemplate(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.CountWindow":                      schema_pkg_apis_numaflow_v1alpha1_CountWindow(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.DaemonTemplate":                   schema_pkg_apis_numaflow_v1alpha1_DaemonTemplate(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.DeadLetter":                       schema_pkg_apis_numaflow_v1alpha1_DeadLetter(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.DiskBufferService":                schema_pkg_apis_numaflow_v1alpha1_DiskBufferService(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.DiskConfig":                       schema_pkg_apis_numaflow_v1alpha1_DiskConfig(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.DiskStorage":                      schema_pkg_apis_numaflow_v1alpha1_DiskStorage(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Edge":                             schema_pkg_apis_numaflow_v1alpha1_Edge(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.EdgePriority":                     schema_pkg_apis_numaflow_v1alpha1_EdgePriority(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.FileSink":                         schema_pkg_apis_numaflow_v1alpha1_FileSink(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.FileSinkS3":                       schema_pkg_apis_numaflow_v1alpha1_FileSinkS3(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.FixedWindow":                      schema_pkg_apis_numaflow_v1alpha1_FixedWindow(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ForwardConditions":                schema_pkg_apis_numaflow_v1alpha1_ForwardConditions(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Function":                         schema_pkg_apis_numaflow_v1alpha1_Function(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.GSSAPI":                           schema_pkg_apis_numaflow_v1alpha1_GSSAPI(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.GeneratorOutOfOrder":              schema_pkg_apis_numaflow_v1alpha1_GeneratorOutOfOrder(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.GeneratorReplay":                  schema_pkg_apis_numaflow_v1alpha1_GeneratorReplay(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.GeneratorSource":                  schema_pkg_apis_numaflow_v1alpha1_GeneratorSource(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.GetDaemonDeploymentReq":           schema_pkg_apis_numaflow_v1alpha1_GetDaemonDeploymentReq(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.GetJetStreamServiceSpecReq":       schema_pkg_apis_numaflow_v1alpha1_GetJetStreamServiceSpecReq(ref),
@@ -57,9 +70,14 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.GetServingPipelineResourceReq":    schema_pkg_apis_numaflow_v1alpha1_GetServingPipelineResourceReq(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.GetSideInputDeploymentReq":        schema_pkg_apis_numaflow_v1alpha1_GetSideInputDeploymentReq(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.GetVertexPodSpecReq":              schema_pkg_apis_numaflow_v1alpha1_GetVertexPodSpecReq(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.GlobalWindow":                     schema_pkg_apis_numaflow_v1alpha1_GlobalWindow(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.GroupBy":                          schema_pkg_apis_numaflow_v1alpha1_GroupBy(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.HTTPBatch":                        schema_pkg_apis_numaflow_v1alpha1_HTTPBatch(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.HTTPSink":                         schema_pkg_apis_numaflow_v1alpha1_HTTPSink(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.HTTPSinkBatch":                    schema_pkg_apis_numaflow_v1alpha1_HTTPSinkBatch(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.HTTPSource":                       schema_pkg_apis_numaflow_v1alpha1_HTTPSource(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.IdleSource":                       schema_pkg_apis_numaflow_v1alpha1_IdleSource(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.InterStepBuffer":                  schema_pkg_apis_numaflow_v1alpha1_InterStepBuffer(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.InterStepBufferService":           schema_pkg_apis_numaflow_v1alpha1_InterStepBufferService(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.InterStepBufferServiceList":       schema_pkg_apis_numaflow_v1alpha1_InterStepBufferServiceList(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.InterStepBufferServiceSpec":       schema_pkg_apis_numaflow_v1alpha1_InterStepBufferServiceSpec(ref),
@@ -70,8 +88,10 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.JobTemplate":                      schema_pkg_apis_numaflow_v1alpha1_JobTemplate(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.KafkaSink":                        schema_pkg_apis_numaflow_v1alpha1_KafkaSink(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.KafkaSource":                      schema_pkg_apis_numaflow_v1alpha1_KafkaSource(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.KafkaStartPosition":               schema_pkg_apis_numaflow_v1alpha1_KafkaStartPosition(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Lifecycle":                        schema_pkg_apis_numaflow_v1alpha1_Lifecycle(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Log":                              schema_pkg_apis_numaflow_v1alpha1_Log(ref),
+		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.LogSampling":                      schema_pkg_apis_numaflow_v1alpha1_LogSampling(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Metadata":                         schema_pkg_apis_numaflow_v1alpha1_Metadata(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.MonoVertex":                       schema_pkg_apis_numaflow_v1alpha1_MonoVertex(ref),
 		"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.MonoVertexLifecycle":              schema_pkg_apis_numaflow_v1alpha1_MonoVertexLifecycle(ref),
@@ -337,11 +357,23 @@ func schema_pkg_apis_numaflow_v1alpha1_AbstractSink(ref common.ReferenceCallback
 							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.SqsSink"),
 						},
 					},
+					"file": {
+						SchemaProps: spec.SchemaProps{
+							Description: "File sink is used to write the data to files in a local path or an S3 compatible object store.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.FileSink"),
+						},
+					},
+					"http": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HTTP sink is used to send the data to an HTTP endpoint.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.HTTPSink"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Blackhole", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.KafkaSink", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Log", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ServeSink", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.SqsSink", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.UDSink"},
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Blackhole", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.FileSink", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.HTTPSink", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.KafkaSink", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Log", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ServeSink", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.SqsSink", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.UDSink"},
 	}
 }
 
@@ -616,12 +648,18 @@ func schema_pkg_apis_numaflow_v1alpha1_AbstractVertex(ref common.ReferenceCallba
 							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.UpdateStrategy"),
 						},
 					},
+					"deadLetter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeadLetter enables the dead-letter buffer of the vertex, it applies to map udf and sink vertices only.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.DeadLetter"),
+						},
+					},
 				},
 				Required: []string{"name"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ContainerTemplate", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Metadata", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Scale", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Sink", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Source", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.UDF", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.UpdateStrategy", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.VertexLimits", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.Container", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/api/core/v1.PodDNSConfig", "k8s.io/api/core/v1.PodResourceClaim", "k8s.io/api/core/v1.PodSecurityContext", "k8s.io/api/core/v1.Toleration", "k8s.io/api/core/v1.Volume"},
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ContainerTemplate", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.DeadLetter", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Metadata", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Scale", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Sink", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Source", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.UDF", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.UpdateStrategy", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.VertexLimits", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.Container", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/api/core/v1.PodDNSConfig", "k8s.io/api/core/v1.PodResourceClaim", "k8s.io/api/core/v1.PodSecurityContext", "k8s.io/api/core/v1.Toleration", "k8s.io/api/core/v1.Volume"},
 	}
 }
 
@@ -686,6 +724,26 @@ func schema_pkg_apis_numaflow_v1alpha1_Backoff(ref common.ReferenceCallback) com
 							Format:      "int64",
 						},
 					},
+					"cap": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Cap is the maximum delay between two retries, the delay stops growing once it reaches the cap.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"factor": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Factor is multiplied to the delay after each retry, a value of 1 (the default) means a fixed interval. Must be greater than or equal to 1.",
+							Type:        []string{"number"},
+							Format:      "double",
+						},
+					},
+					"jitter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Jitter adds a random amount of up to Jitter*delay to each delay, in order to spread out the retries of the replicas. Must be in the range of [0, 1].",
+							Type:        []string{"number"},
+							Format:      "double",
+						},
+					},
 				},
 			},
 		},
@@ -748,11 +806,94 @@ func schema_pkg_apis_numaflow_v1alpha1_BufferServiceConfig(ref common.ReferenceC
 							Ref: ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.JetStreamConfig"),
 						},
 					},
+					"disk": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.DiskConfig"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.DiskConfig", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.JetStreamConfig", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.RedisConfig"},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_ClaimCheck(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClaimCheck stores the payloads larger than a threshold out of the Inter-Step Buffers, either in an S3 compatible object store or in a shared volume, and the messages in the buffers only carry the references to them. The payloads are loaded back transparently when the messages are read, and deleted once the messages are acknowledged by the vertex reading them, e.g. once the sink has written them. Only one of the stores can be specified.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"thresholdBytes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ThresholdBytes is the size above which a payload is stored out of the buffer, defaults to 512Ki.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"s3": {
+						SchemaProps: spec.SchemaProps{
+							Description: "S3 stores the payloads in an S3 compatible object store.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ClaimCheckS3"),
+						},
+					},
+					"persistentVolumeClaim": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PersistentVolumeClaim stores the payloads in an existing claim, which is mounted to all the vertex pods of the pipeline, so its access mode needs to be ReadWriteMany.",
+							Ref:         ref("k8s.io/api/core/v1.PersistentVolumeClaimVolumeSource"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.JetStreamConfig", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.RedisConfig"},
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ClaimCheckS3", "k8s.io/api/core/v1.PersistentVolumeClaimVolumeSource"},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_ClaimCheckS3(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClaimCheckS3 is an S3 compatible object store. The credentials are resolved with the default AWS credential chain, e.g. the AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY environment variables or the IRSA web identity token.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"bucket": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Bucket is the name of the bucket.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"region": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Region of the bucket.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"endpointUrl": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EndpointURL is the custom endpoint URL of the object store, e.g. a MinIO server. The path-style URLs are used if it's specified.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"prefix": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Prefix is the key prefix of the stored payloads.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"bucket", "region"},
+			},
+		},
 	}
 }
 
@@ -790,6 +931,18 @@ func schema_pkg_apis_numaflow_v1alpha1_CombinedEdge(ref common.ReferenceCallback
 							Format:      "",
 						},
 					},
+					"compression": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Compression of the message payloads written to the inter step buffer, it overrides the pipeline level settings.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Compression"),
+						},
+					},
+					"priority": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Priority assigns the messages forwarded through the edge to the priority lanes of the to vertex buffers, only supported by the JetStream ISB Service.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.EdgePriority"),
+						},
+					},
 					"fromVertexType": {
 						SchemaProps: spec.SchemaProps{
 							Description: "From vertex type.",
@@ -835,7 +988,34 @@ func schema_pkg_apis_numaflow_v1alpha1_CombinedEdge(ref common.ReferenceCallback
 			},
 		},
 		Dependencies: []string{
-			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ForwardConditions", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.VertexLimits"},
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Compression", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.EdgePriority", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ForwardConditions", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.VertexLimits"},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_Compression(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Compression defines how the payloads of the messages written to an Inter-Step Buffer are compressed. The codec is recorded in the header of each message, so the readers decompress the payloads transparently, and the messages written without compression can always be read.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the codec to compress the payloads, defaults to \"none\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"minPayloadBytes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MinPayloadBytes is the minimal size of a payload to be compressed, smaller payloads are written uncompressed. Defaults to 256.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -1047,6 +1227,36 @@ func schema_pkg_apis_numaflow_v1alpha1_ContainerTemplate(ref common.ReferenceCal
 	}
 }
 
+func schema_pkg_apis_numaflow_v1alpha1_CountWindow(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CountWindow describes a count window, which is closed for a key once it has received the given number of messages.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"count": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Count is the number of messages of a key after which the window of the key is closed.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"timeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timeout is the duration, in event time since the first message of the window, after which a window which has not received Count messages is closed anyway.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+				Required: []string{"count"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
 func schema_pkg_apis_numaflow_v1alpha1_DaemonTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1196,63 +1406,376 @@ func schema_pkg_apis_numaflow_v1alpha1_DaemonTemplate(ref common.ReferenceCallba
 							Format:      "int32",
 						},
 					},
-					"containerTemplate": {
+					"containerTemplate": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ContainerTemplate"),
+						},
+					},
+					"initContainerTemplate": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ContainerTemplate"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ContainerTemplate", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Metadata", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/api/core/v1.PodDNSConfig", "k8s.io/api/core/v1.PodResourceClaim", "k8s.io/api/core/v1.PodSecurityContext", "k8s.io/api/core/v1.Toleration"},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_DeadLetter(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DeadLetter configures the dead-letter buffer of a vertex. Messages which still fail after the retry budget is exhausted are written to the dead-letter buffer together with the error metadata, instead of being retried forever. It applies to map udf and sink vertices only.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxRetries": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxRetries is the number of retries of a failed map udf batch before the messages are dead-lettered, defaults to 3. For sink vertices, the retry budget is defined by the retry strategy of the sink.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"retryInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RetryInterval is the interval between two retries of a failed map udf batch, defaults to 1s.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_DiskBufferService(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DiskBufferService is an ISB Service backed by segmented log files on a volume, it does not require any external broker.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"hostPath": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HostPath is a directory on the node, it is only suitable for single node clusters.",
+							Ref:         ref("k8s.io/api/core/v1.HostPathVolumeSource"),
+						},
+					},
+					"persistentVolumeClaim": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PersistentVolumeClaim is an existing claim, its access mode needs to be ReadWriteMany if the pods of the pipelines can be scheduled on different nodes.",
+							Ref:         ref("k8s.io/api/core/v1.PersistentVolumeClaimVolumeSource"),
+						},
+					},
+					"maxSegmentSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Max size of a segment file, a new segment file is started once it is reached, defaults to 64Mi.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"syncInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Interval of syncing the written segments to the disk, defaults to 1s. Messages written after the last sync might be lost if the node crashes.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.HostPathVolumeSource", "k8s.io/api/core/v1.PersistentVolumeClaimVolumeSource", "k8s.io/apimachinery/pkg/api/resource.Quantity", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_DiskConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"hostPath": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HostPath is a directory on the node, it is only suitable for single node clusters.",
+							Ref:         ref("k8s.io/api/core/v1.HostPathVolumeSource"),
+						},
+					},
+					"persistentVolumeClaim": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PersistentVolumeClaim is an existing claim, its access mode needs to be ReadWriteMany if the pods of the pipelines can be scheduled on different nodes.",
+							Ref:         ref("k8s.io/api/core/v1.PersistentVolumeClaimVolumeSource"),
+						},
+					},
+					"maxSegmentBytes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Max size in bytes of a segment file",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"syncInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Interval of syncing the written segments to the disk",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.HostPathVolumeSource", "k8s.io/api/core/v1.PersistentVolumeClaimVolumeSource", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_DiskStorage(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DiskStorage is the volume to store the buffers and the watermark buckets, only one of them can be specified.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"hostPath": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HostPath is a directory on the node, it is only suitable for single node clusters.",
+							Ref:         ref("k8s.io/api/core/v1.HostPathVolumeSource"),
+						},
+					},
+					"persistentVolumeClaim": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PersistentVolumeClaim is an existing claim, its access mode needs to be ReadWriteMany if the pods of the pipelines can be scheduled on different nodes.",
+							Ref:         ref("k8s.io/api/core/v1.PersistentVolumeClaimVolumeSource"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.HostPathVolumeSource", "k8s.io/api/core/v1.PersistentVolumeClaimVolumeSource"},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_Edge(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"from": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"to": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditional forwarding, only allowed when \"From\" is a Source or UDF.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ForwardConditions"),
+						},
+					},
+					"onFull": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OnFull specifies the behaviour for the write actions when the inter step buffer is full. There are currently two options, retryUntilSuccess and discardLatest. if not provided, the default value is set to \"retryUntilSuccess\"",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"compression": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Compression of the message payloads written to the inter step buffer, it overrides the pipeline level settings.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Compression"),
+						},
+					},
+					"priority": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Priority assigns the messages forwarded through the edge to the priority lanes of the to vertex buffers, only supported by the JetStream ISB Service.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.EdgePriority"),
+						},
+					},
+				},
+				Required: []string{"from", "to"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Compression", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.EdgePriority", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ForwardConditions"},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_EdgePriority(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "EdgePriority assigns the messages to the priority lanes of the inter step buffers, the messages in the higher priority lanes are read before the ones in the lower priority lanes. Lane 0 has the lowest priority, it is where the messages without a priority go, including the ones forwarded through the edges without priority settings. The lanes are not supported by the edges to a reduce vertex, because reading the messages out of order could make the ones in the lower priority lanes late.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"lanes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Lanes is the number of the priority lanes, it needs to be the same for all the edges to a vertex.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"header": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Header is the name of the message header of which the value is the lane of a message, e.g. \"x-priority\". A value which is not a number is treated as 0, and a number greater than the highest lane as the highest lane.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"tags": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Tags maps the message tags to the lanes, a message with multiple tags goes to the highest lane of them. The tags take precedence over the header.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: 0,
+										Type:    []string{"integer"},
+										Format:  "int32",
+									},
+								},
+							},
+						},
+					},
+					"weights": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Weights are the minimal shares of a read batch of the lanes from lane 0, relative to each other, which prevent the lower priority lanes from starving. The rest of a batch is filled from the highest priority lanes first. Defaults to 1 for every lane.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: 0,
+										Type:    []string{"integer"},
+										Format:  "int32",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"lanes"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_FileSink(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "FileSink writes the messages to files in a local path or an S3 compatible object store. The messages are staged and written to the files when they roll, they are acked after the files are committed.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path is the directory to write the files to, or the key prefix of the files if S3 is specified.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"s3": {
+						SchemaProps: spec.SchemaProps{
+							Description: "S3 writes the files to an S3 compatible object store instead of the local path.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.FileSinkS3"),
+						},
+					},
+					"encoding": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Encoding of the files, jsonl, csv or parquet, defaults to jsonl.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maxFileBytes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxFileBytes rolls the files once one of them reaches the size, defaults to 64Mi.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"rollInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RollInterval rolls the files once the oldest of them reaches the age, defaults to 1m. The messages are acked after the files roll, so it needs to be less than the ack wait of the inter-step buffer.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"eventTimeLayout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EventTimeLayout partitions the directories by the event time of the messages in UTC, formatted with the Go time layout, e.g. \"2006/01/02/15\" for hourly directories.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"partitionByKeys": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ContainerTemplate"),
+							Description: "PartitionByKeys partitions the directories by the keys of the messages, after the event time directories.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"initContainerTemplate": {
+					"volumeName": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ContainerTemplate"),
+							Description: "VolumeName is the name of a volume of the vertex, which is mounted at the path in the main container. It's required to write to a local path which survives the restarts, e.g. a persistent volume claim.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"path"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ContainerTemplate", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Metadata", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/api/core/v1.PodDNSConfig", "k8s.io/api/core/v1.PodResourceClaim", "k8s.io/api/core/v1.PodSecurityContext", "k8s.io/api/core/v1.Toleration"},
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.FileSinkS3", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
-func schema_pkg_apis_numaflow_v1alpha1_Edge(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_numaflow_v1alpha1_FileSinkS3(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "FileSinkS3 is an S3 compatible object store. The credentials are resolved with the default AWS credential chain, e.g. the AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY environment variables or the IRSA web identity token.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"from": {
-						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
-						},
-					},
-					"to": {
+					"bucket": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "Bucket is the name of the bucket.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"conditions": {
+					"region": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Conditional forwarding, only allowed when \"From\" is a Source or UDF.",
-							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ForwardConditions"),
+							Description: "Region of the bucket.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"onFull": {
+					"endpointUrl": {
 						SchemaProps: spec.SchemaProps{
-							Description: "OnFull specifies the behaviour for the write actions when the inter step buffer is full. There are currently two options, retryUntilSuccess and discardLatest. if not provided, the default value is set to \"retryUntilSuccess\"",
+							Description: "EndpointURL is the custom endpoint URL of the object store, e.g. a MinIO server. The path-style URLs are used if it's specified.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"from", "to"},
+				Required: []string{"bucket", "region"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ForwardConditions"},
 	}
 }
 
@@ -1296,8 +1819,14 @@ func schema_pkg_apis_numaflow_v1alpha1_ForwardConditions(ref common.ReferenceCal
 							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.TagConditions"),
 						},
 					},
+					"expression": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Expression is a boolean expression evaluated against the \"payload\" (string), \"keys\" ([]string) and \"headers\" (map[string]string) of a message, the message is forwarded through the edge if it returns true. For example, `json(payload).amount > 100 && headers[\"x-region\"] == \"us\"`. If both Tags and Expression are specified, a message needs to satisfy both of them.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
-				Required: []string{"tags"},
 			},
 		},
 		Dependencies: []string{
@@ -1416,6 +1945,93 @@ func schema_pkg_apis_numaflow_v1alpha1_GSSAPI(ref common.ReferenceCallback) comm
 	}
 }
 
+func schema_pkg_apis_numaflow_v1alpha1_GeneratorOutOfOrder(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GeneratorOutOfOrder delays the event times of a percentage of the generated messages.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"percent": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Percent of the messages to delay, between 0 and 100.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"delay": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Delay of the event times of the messages, the messages are late if it's longer than the allowed lateness.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+				Required: []string{"percent", "delay"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_GeneratorReplay(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GeneratorReplay is a JSONL file to replay by the generator.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path of the JSONL file.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"volumeName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VolumeName is the name of a volume of the vertex, it's mounted at the directory of the path.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"loop": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Loop replays the file from the beginning after the end of it, it's replayed only once if false.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"keyField": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KeyField is the field of a line used as the key, the generator keys are used if it's not specified.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"eventTimeField": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EventTimeField is the field of a line used as the event time, in RFC3339 format or epoch milliseconds. The time of the generation is used if it's not specified.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"rewriteEventTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RewriteEventTime shifts the event times read from the EventTimeField, so that the first line of each replay starts at the time of the replay, and the intervals between the lines are kept.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"path"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_numaflow_v1alpha1_GeneratorSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1467,11 +2083,30 @@ func schema_pkg_apis_numaflow_v1alpha1_GeneratorSource(ref common.ReferenceCallb
 							Format:      "",
 						},
 					},
+					"template": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Template is an optional Go template of the payload, with the sprig functions, e.g. randInt and uuidv4. The fields are .Key, .KeyIndex, .Replica, .Counter (incremented for each message), .KeyCounter (incremented for each message of the key) and .EventTime. if present, the Value, MsgSize and ValueBlob fields will be ignored.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"replay": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Replay replays the lines of a JSONL file as the payloads, instead of generating them. RPU lines are replayed per Duration, and the KeyCount is ignored.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.GeneratorReplay"),
+						},
+					},
+					"outOfOrder": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OutOfOrder delays the event times of a percentage of the messages, used to simulate late data.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.GeneratorOutOfOrder"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.GeneratorOutOfOrder", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.GeneratorReplay", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
@@ -2169,6 +2804,26 @@ func schema_pkg_apis_numaflow_v1alpha1_GetVertexPodSpecReq(ref common.ReferenceC
 	}
 }
 
+func schema_pkg_apis_numaflow_v1alpha1_GlobalWindow(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GlobalWindow describes a global window, which is only closed for a key when a message matches the trigger expression, or for all the keys when the vertex is shutting down (e.g., the pipeline is paused).",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"trigger": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Trigger is a boolean expression evaluated against every message (payload, keys and headers), the window of the message's key is closed after the message is added to it if the expression evaluates to true.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_numaflow_v1alpha1_GroupBy(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2202,6 +2857,13 @@ func schema_pkg_apis_numaflow_v1alpha1_GroupBy(ref common.ReferenceCallback) com
 							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PBQStorage"),
 						},
 					},
+					"lateDataTag": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LateDataTag is the tag the late messages, which are behind (Watermark - AllowedLateness), are forwarded with instead of being dropped. Only the outgoing edges whose conditions match the tag, and the ones without any conditions, receive them. The window the message would have been assigned to is attached as headers.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"window"},
 			},
@@ -2211,6 +2873,143 @@ func schema_pkg_apis_numaflow_v1alpha1_GroupBy(ref common.ReferenceCallback) com
 	}
 }
 
+func schema_pkg_apis_numaflow_v1alpha1_HTTPBatch(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"idField": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GJSON path of the field in each element used as the message ID for deduplication. A random UUID is generated if it's not specified or the field is missing.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"eventTimeField": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GJSON path of the field in each element used as the event time, which could be the number of milliseconds elapsed since January 1, 1970 UTC, or a RFC3339 formatted string. The \"x-numaflow-event-time\" header or the time of the request is used if it's not specified or the field is missing.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_HTTPSink(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HTTPSink sends the messages to an HTTP endpoint. The 2xx responses are successes, the 408, 429 and 5xx responses are retried with the retry strategy of the sink, the other 4xx responses are not retried, the OnFailure strategy is applied to the messages right away.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URL of the endpoint, a Go template rendered with the message, e.g. \"https://example.com/events/{{ index .Keys 0 }}\". The fields of the message are .ID, .Keys, .Headers and .EventTime.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"method": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Method of the requests, defaults to POST.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"headers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Headers of the requests, the values are Go templates rendered with the message like the URL.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"batch": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Batch sends the messages in batches, the messages with the same rendered URL and headers are sent together. Each message is sent in its own request if it's not specified.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.HTTPSinkBatch"),
+						},
+					},
+					"basicAuth": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BasicAuth sets the basic authentication of the requests.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.BasicAuth"),
+						},
+					},
+					"bearerTokenSecret": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BearerTokenSecret refers to the secret that contains the bearer token of the requests.",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+					"tls": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TLS configuration of the client, the cert and key secrets are used for mTLS.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.TLS"),
+						},
+					},
+					"timeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timeout of a request, defaults to 30s.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"concurrency": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Concurrency is the max number of requests sent concurrently, defaults to 8.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"url"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.BasicAuth", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.HTTPSinkBatch", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.TLS", "k8s.io/api/core/v1.SecretKeySelector", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_HTTPSinkBatch(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HTTPSinkBatch is the batching of the HTTP sink. If the endpoint responds a batch with 207 Multi-Status and a body of {\"results\": [{\"statusCode\": 200}, ...]}, the status code of each message is taken from the result at the same index.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxMessages": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxMessages is the max number of messages in a request, defaults to 100.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"format": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Format of the body, jsonArray or ndjson, defaults to jsonArray.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_numaflow_v1alpha1_HTTPSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2230,11 +3029,30 @@ func schema_pkg_apis_numaflow_v1alpha1_HTTPSource(ref common.ReferenceCallback)
 							Format:      "",
 						},
 					},
+					"batch": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Batch configures the batch endpoint \"/vertices/{vertexName}/batch\", which accepts newline-delimited JSON or a JSON array, one message per element.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.HTTPBatch"),
+						},
+					},
+					"ackAfterWrite": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Whether to hold the HTTP response until the messages are written to the inter-step buffer. When enabled, the batch endpoint reports the status of each message.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"ackTimeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The maximum time to wait for the messages to be written when ackAfterWrite is enabled, defaults to 30s.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Authorization"},
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Authorization", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.HTTPBatch", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
@@ -2270,6 +3088,27 @@ func schema_pkg_apis_numaflow_v1alpha1_IdleSource(ref common.ReferenceCallback)
 	}
 }
 
+func schema_pkg_apis_numaflow_v1alpha1_InterStepBuffer(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InterStepBuffer defines the settings of the inter step buffers of a pipeline.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"compression": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Compression of the message payloads written to the inter step buffers.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Compression"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Compression"},
+	}
+}
+
 func schema_pkg_apis_numaflow_v1alpha1_InterStepBufferService(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2382,11 +3221,16 @@ func schema_pkg_apis_numaflow_v1alpha1_InterStepBufferServiceSpec(ref common.Ref
 							Ref: ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.JetStreamBufferService"),
 						},
 					},
+					"disk": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.DiskBufferService"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.JetStreamBufferService", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.RedisBufferService"},
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.DiskBufferService", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.JetStreamBufferService", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.RedisBufferService"},
 	}
 }
 
@@ -2757,12 +3601,74 @@ func schema_pkg_apis_numaflow_v1alpha1_JetStreamSource(ref common.ReferenceCallb
 							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.NatsAuth"),
 						},
 					},
+					"filterSubjects": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FilterSubjects only consumes the messages of the subjects of the stream, all the messages are consumed if it's empty.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"deliverPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeliverPolicy is where the consumer starts, \"all\", \"new\", \"byStartTime\" or \"bySequence\", defaults to \"all\". It's only applied when the consumer is created, the deliver policy of an existing durable consumer can not be changed.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"startTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StartTime is required by the \"byStartTime\" deliver policy.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"startSequence": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StartSequence is required by the \"bySequence\" deliver policy.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"maxAckPending": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxAckPending is the max number of messages delivered but not acknowledged, the default of the server is used if it's 0.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"ackWait": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AckWait is the time to wait for the acknowledgement of a message before it's redelivered, defaults to 30s. The acknowledgement of a message in processing is extended periodically.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"ephemeral": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Ephemeral uses an ephemeral consumer of each replica instead of the durable consumer of the vertex, it's removed by the server after the replica is gone. Each replica of the vertex receives all the messages with an ephemeral consumer.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"keyFromHeader": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KeyFromHeader is the name of the message header whose value is used as the message key.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"url", "stream"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.NatsAuth", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.TLS"},
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.NatsAuth", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.TLS", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -2988,6 +3894,62 @@ func schema_pkg_apis_numaflow_v1alpha1_KafkaSink(ref common.ReferenceCallback) c
 							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.SASL"),
 						},
 					},
+					"partitioner": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Partitioner specifies how the records are assigned to the partitions of the topic. \"keyHash\" hashes the keys of the messages, regardless of SetKey. \"header\" hashes the value of the header specified by PartitionHeader. \"roundRobin\" distributes the records evenly. If not provided, the records are hashed by the Kafka key if SetKey is true, otherwise randomly assigned.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"partitionHeader": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PartitionHeader is the name of the message header used by the \"header\" partitioner.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"forwardHeaders": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ForwardHeaders forwards the headers of the messages as Kafka record headers, along with the event time in the \"x-numaflow-event-time\" header.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"setTimestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SetTimestamp sets the timestamp of the records to the event time of the messages.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"idempotent": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Idempotent enables the idempotent producer, so that the retries of the producer don't introduce duplicates.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"compression": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Compression codec of the producer, \"none\", \"gzip\", \"snappy\", \"lz4\" or \"zstd\". It overrides \"producer.compression\" in the config.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maxMessageBytes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxMessageBytes is the max size of a record, including the key, value and headers. The records exceeding it are rejected as non-retryable errors, instead of being retried. It overrides \"producer.maxMessageBytes\" in the config, which defaults to 1000000.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"exactlyOnce": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExactlyOnce writes the messages in Kafka transactions, along with a checkpoint of the inter-step buffer offsets, so that the messages redelivered after a crash are not written again. It implies Idempotent. It requires the JetStream inter-step buffer service, and the vertex can not scale beyond 1 replica. The consumers of the topic need to use \"isolation.level=read_committed\" to only read the committed records. It's only supported by the main sink, not the fallback sink.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"topic"},
 			},
@@ -3054,12 +4016,61 @@ func schema_pkg_apis_numaflow_v1alpha1_KafkaSource(ref common.ReferenceCallback)
 							Format: "",
 						},
 					},
+					"startPosition": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StartPosition specifies where to start consuming a partition when the consumer group has no committed offset for it, e.g. when the source is attached to the topic for the first time. It overrides \"consumer.offsets.initial\" in the config.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.KafkaStartPosition"),
+						},
+					},
+					"keyHeader": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KeyHeader is the name of the record header whose value is used as the message key. If not provided, the record key is used.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"eventTimeSource": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EventTimeSource specifies where the event time of the messages comes from, \"recordTimestamp\" or \"logAppendTime\". \"recordTimestamp\" uses the timestamp of the records, whatever the timestamp type of the topic is. \"logAppendTime\" requires the topic to be configured with \"message.timestamp.type=LogAppendTime\", so that the event time is the time the broker appended the record, the source fails to start otherwise. Defaults to \"recordTimestamp\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"topic"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.SASL", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.TLS"},
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.KafkaStartPosition", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.SASL", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.TLS"},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_KafkaStartPosition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"policy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Policy of the start position, \"earliest\", \"latest\" or \"timestamp\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"timestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timestamp is required by the \"timestamp\" policy, consuming starts from the earliest record whose timestamp is greater than or equal to it, or the latest if there's no such record.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"policy"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -3108,6 +4119,64 @@ func schema_pkg_apis_numaflow_v1alpha1_Log(ref common.ReferenceCallback) common.
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"format": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Format of the output, text, json or payload, defaults to text. The json and payload formats are printed to stdout without any prefix, so that they can be consumed by log collectors.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"payloadEncoding": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PayloadEncoding is the encoding of the payloads, text, hex or base64 for binary data, defaults to text.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"sampling": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Sampling prints a sample of the messages, all the messages are printed if it's not specified.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.LogSampling"),
+						},
+					},
+					"maxPayloadBytes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxPayloadBytes truncates the payloads longer than it before they are encoded, 0 means no limit.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.LogSampling"},
+	}
+}
+
+func schema_pkg_apis_numaflow_v1alpha1_LogSampling(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LogSampling is the sampling of the messages printed by the log sink, the messages not sampled are still acknowledged.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"everyN": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EveryN prints every Nth message.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"perSecond": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PerSecond is the max number of messages printed per second, applied after EveryN.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
 			},
 		},
 	}
@@ -3948,6 +5017,27 @@ func schema_pkg_apis_numaflow_v1alpha1_NatsSource(ref common.ReferenceCallback)
 							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.NatsAuth"),
 						},
 					},
+					"keyFromHeader": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KeyFromHeader is the name of the message header whose value is used as the message key.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"eventTimeFromHeader": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EventTimeFromHeader is the name of the message header whose value is used as the event time, in RFC3339 format or epoch milliseconds. The time the message is received is used if it's not specified, or the header is missing or invalid.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"overflowSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OverflowSize is the max number of messages buffered locally, in addition to the read buffer, when the inter-step buffer is under back pressure. The messages received when the overflow is full are dropped, and counted in the nats_source_dropped_total metric. If it's not specified, receiving blocks under back pressure, and the messages exceeding the pending limits of the NATS client are dropped as a slow consumer, which are counted in the same metric.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 				},
 				Required: []string{"url", "subject", "queue"},
 			},
@@ -4113,11 +5203,17 @@ func schema_pkg_apis_numaflow_v1alpha1_PipelineLimits(ref common.ReferenceCallba
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
+					"claimCheck": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClaimCheck stores the payloads larger than a threshold out of the inter-step buffers, which only carry the references to them, so the vertices can emit messages larger than the max message size of the buffers.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ClaimCheck"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ClaimCheck", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
@@ -4254,11 +5350,17 @@ func schema_pkg_apis_numaflow_v1alpha1_PipelineSpec(ref common.ReferenceCallback
 							},
 						},
 					},
+					"interStepBuffer": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InterStepBuffer defines the pipeline level settings of the inter step buffers, they can be overridden by the edges.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.InterStepBuffer"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.AbstractVertex", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Edge", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Lifecycle", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PipelineLimits", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.SideInput", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Templates", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Watermark"},
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.AbstractVertex", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Edge", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.InterStepBuffer", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Lifecycle", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PipelineLimits", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.SideInput", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Templates", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Watermark"},
 	}
 }
 
@@ -4479,6 +5581,13 @@ func schema_pkg_apis_numaflow_v1alpha1_PulsarSource(ref common.ReferenceCallback
 							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.PulsarAuth"),
 						},
 					},
+					"adminUrl": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AdminURL is the URL of the Pulsar web service (e.g. http://pulsar-broker:8080), it is used to query the subscription backlog to calculate pending messages. If not specified, pending messages are not available.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"serverAddr", "topic", "consumerName", "subscriptionName"},
 			},
@@ -4543,7 +5652,7 @@ func schema_pkg_apis_numaflow_v1alpha1_RedisConfig(ref common.ReferenceCallback)
 					},
 					"user": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Redis user",
+							Description: "Redis ACL user, the default user is used if it is not provided",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -4560,11 +5669,31 @@ func schema_pkg_apis_numaflow_v1alpha1_RedisConfig(ref common.ReferenceCallback)
 							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
 						},
 					},
+					"sentinelUser": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Sentinel ACL user, the default user is used if it is not provided",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"tls": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TLS configuration for the connections to Redis and Sentinel",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.TLS"),
+						},
+					},
+					"cluster": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Cluster indicates the Redis URL is the address of a Redis Cluster, it is implied if the URL has multiple comma separated addresses. Can not be used together with Sentinel.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.SecretKeySelector"},
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.TLS", "k8s.io/api/core/v1.SecretKeySelector"},
 	}
 }
 
@@ -4623,7 +5752,7 @@ func schema_pkg_apis_numaflow_v1alpha1_RetryStrategy(ref common.ReferenceCallbac
 					},
 					"onFailure": {
 						SchemaProps: spec.SchemaProps{
-							Description: "OnFailure specifies the action to take when the specified retry strategy fails. The possible values are: 1. \"retry\": start another round of retrying the operation, 2. \"fallback\": re-route the operation to a fallback sink and 3. \"drop\": drop the operation and perform no further action. The default action is to retry.",
+							Description: "OnFailure specifies the action to take when the specified retry strategy fails. The possible values are: 1. \"retry\": start another round of retrying the operation, 2. \"fallback\": re-route the operation to a fallback sink and 3. \"drop\": drop the operation and perform no further action and 4. \"deadletter\": write the failed messages to the dead-letter buffer of the vertex, requires deadLetter to be configured in the vertex. The default action is to retry.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -5596,6 +6725,18 @@ func schema_pkg_apis_numaflow_v1alpha1_Sink(ref common.ReferenceCallback) common
 							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.SqsSink"),
 						},
 					},
+					"file": {
+						SchemaProps: spec.SchemaProps{
+							Description: "File sink is used to write the data to files in a local path or an S3 compatible object store.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.FileSink"),
+						},
+					},
+					"http": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HTTP sink is used to send the data to an HTTP endpoint.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.HTTPSink"),
+						},
+					},
 					"fallback": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Fallback sink can be imagined as DLQ for primary Sink. The writes to Fallback sink will only be initiated if the ud-sink response field sets it.",
@@ -5613,7 +6754,7 @@ func schema_pkg_apis_numaflow_v1alpha1_Sink(ref common.ReferenceCallback) common
 			},
 		},
 		Dependencies: []string{
-			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.AbstractSink", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Blackhole", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.KafkaSink", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Log", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.RetryStrategy", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ServeSink", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.SqsSink", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.UDSink"},
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.AbstractSink", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Blackhole", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.FileSink", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.HTTPSink", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.KafkaSink", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Log", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.RetryStrategy", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ServeSink", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.SqsSink", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.UDSink"},
 	}
 }
 
@@ -5745,6 +6886,13 @@ func schema_pkg_apis_numaflow_v1alpha1_SqsSink(ref common.ReferenceCallback) com
 							Format:      "",
 						},
 					},
+					"endpointUrl": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EndpointURL is the custom endpoint URL for the AWS SQS API. This is useful for testing with localstack or when using VPC endpoints.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"awsRegion", "queueName", "queueOwnerAWSAccountID"},
 			},
@@ -6623,6 +7771,12 @@ func schema_pkg_apis_numaflow_v1alpha1_VertexSpec(ref common.ReferenceCallback)
 							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.UpdateStrategy"),
 						},
 					},
+					"deadLetter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeadLetter enables the dead-letter buffer of the vertex, it applies to map udf and sink vertices only.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.DeadLetter"),
+						},
+					},
 					"pipelineName": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
@@ -6683,12 +7837,18 @@ func schema_pkg_apis_numaflow_v1alpha1_VertexSpec(ref common.ReferenceCallback)
 							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.VertexLifecycle"),
 						},
 					},
+					"claimCheck": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClaimCheck indicates where the oversized payloads are stored, it's populated from the pipeline limits.",
+							Ref:         ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ClaimCheck"),
+						},
+					},
 				},
 				Required: []string{"name", "pipelineName"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.CombinedEdge", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ContainerTemplate", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Metadata", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Scale", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Sink", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Source", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.UDF", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.UpdateStrategy", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.VertexLifecycle", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.VertexLimits", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Watermark", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.Container", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/api/core/v1.PodDNSConfig", "k8s.io/api/core/v1.PodResourceClaim", "k8s.io/api/core/v1.PodSecurityContext", "k8s.io/api/core/v1.Toleration", "k8s.io/api/core/v1.Volume"},
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ClaimCheck", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.CombinedEdge", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.ContainerTemplate", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.DeadLetter", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Metadata", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Scale", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Sink", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Source", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.UDF", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.UpdateStrategy", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.VertexLifecycle", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.VertexLimits", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.Watermark", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.Container", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/api/core/v1.PodDNSConfig", "k8s.io/api/core/v1.PodResourceClaim", "k8s.io/api/core/v1.PodSecurityContext", "k8s.io/api/core/v1.Toleration", "k8s.io/api/core/v1.Volume"},
 	}
 }
 
@@ -7037,11 +8197,21 @@ func schema_pkg_apis_numaflow_v1alpha1_Window(ref common.ReferenceCallback) comm
 							Ref: ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.AccumulatorWindow"),
 						},
 					},
+					"count": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.CountWindow"),
+						},
+					},
+					"global": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.GlobalWindow"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.AccumulatorWindow", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.FixedWindow", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.SessionWindow", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.SlidingWindow"},
+			"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.AccumulatorWindow", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.CountWindow", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.FixedWindow", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.GlobalWindow", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.SessionWindow", "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1.SlidingWindow"},
 	}
 }
 
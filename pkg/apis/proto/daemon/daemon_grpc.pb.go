@@ -34,12 +34,15 @@ import (
 const _ = grpc.SupportPackageIsVersion8
 
 const (
-	DaemonService_ListBuffers_FullMethodName           = "/daemon.DaemonService/ListBuffers"
-	DaemonService_GetBuffer_FullMethodName             = "/daemon.DaemonService/GetBuffer"
-	DaemonService_GetVertexMetrics_FullMethodName      = "/daemon.DaemonService/GetVertexMetrics"
-	DaemonService_GetPipelineWatermarks_FullMethodName = "/daemon.DaemonService/GetPipelineWatermarks"
-	DaemonService_GetPipelineStatus_FullMethodName     = "/daemon.DaemonService/GetPipelineStatus"
-	DaemonService_GetVertexErrors_FullMethodName       = "/daemon.DaemonService/GetVertexErrors"
+	DaemonService_ListBuffers_FullMethodName              = "/daemon.DaemonService/ListBuffers"
+	DaemonService_GetBuffer_FullMethodName                = "/daemon.DaemonService/GetBuffer"
+	DaemonService_GetVertexMetrics_FullMethodName         = "/daemon.DaemonService/GetVertexMetrics"
+	DaemonService_GetPipelineWatermarks_FullMethodName    = "/daemon.DaemonService/GetPipelineWatermarks"
+	DaemonService_GetPipelineStatus_FullMethodName        = "/daemon.DaemonService/GetPipelineStatus"
+	DaemonService_GetVertexErrors_FullMethodName          = "/daemon.DaemonService/GetVertexErrors"
+	DaemonService_ListDeadLetterMessages_FullMethodName   = "/daemon.DaemonService/ListDeadLetterMessages"
+	DaemonService_GetDeadLetterMessage_FullMethodName     = "/daemon.DaemonService/GetDeadLetterMessage"
+	DaemonService_ReplayDeadLetterMessages_FullMethodName = "/daemon.DaemonService/ReplayDeadLetterMessages"
 )
 
 // DaemonServiceClient is the client API for DaemonService service.
@@ -55,6 +58,11 @@ type DaemonServiceClient interface {
 	GetPipelineWatermarks(ctx context.Context, in *GetPipelineWatermarksRequest, opts ...grpc.CallOption) (*GetPipelineWatermarksResponse, error)
 	GetPipelineStatus(ctx context.Context, in *GetPipelineStatusRequest, opts ...grpc.CallOption) (*GetPipelineStatusResponse, error)
 	GetVertexErrors(ctx context.Context, in *GetVertexErrorsRequest, opts ...grpc.CallOption) (*GetVertexErrorsResponse, error)
+	// ListDeadLetterMessages returns the messages in the dead-letter buffer of the given vertex
+	ListDeadLetterMessages(ctx context.Context, in *ListDeadLetterMessagesRequest, opts ...grpc.CallOption) (*ListDeadLetterMessagesResponse, error)
+	GetDeadLetterMessage(ctx context.Context, in *GetDeadLetterMessageRequest, opts ...grpc.CallOption) (*GetDeadLetterMessageResponse, error)
+	// ReplayDeadLetterMessages writes the given dead-lettered messages back to the input buffer of the vertex
+	ReplayDeadLetterMessages(ctx context.Context, in *ReplayDeadLetterMessagesRequest, opts ...grpc.CallOption) (*ReplayDeadLetterMessagesResponse, error)
 }
 
 type daemonServiceClient struct {
@@ -125,6 +133,36 @@ func (c *daemonServiceClient) GetVertexErrors(ctx context.Context, in *GetVertex
 	return out, nil
 }
 
+func (c *daemonServiceClient) ListDeadLetterMessages(ctx context.Context, in *ListDeadLetterMessagesRequest, opts ...grpc.CallOption) (*ListDeadLetterMessagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDeadLetterMessagesResponse)
+	err := c.cc.Invoke(ctx, DaemonService_ListDeadLetterMessages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonServiceClient) GetDeadLetterMessage(ctx context.Context, in *GetDeadLetterMessageRequest, opts ...grpc.CallOption) (*GetDeadLetterMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDeadLetterMessageResponse)
+	err := c.cc.Invoke(ctx, DaemonService_GetDeadLetterMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonServiceClient) ReplayDeadLetterMessages(ctx context.Context, in *ReplayDeadLetterMessagesRequest, opts ...grpc.CallOption) (*ReplayDeadLetterMessagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReplayDeadLetterMessagesResponse)
+	err := c.cc.Invoke(ctx, DaemonService_ReplayDeadLetterMessages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DaemonServiceServer is the server API for DaemonService service.
 // All implementations must embed UnimplementedDaemonServiceServer
 // for forward compatibility
@@ -138,6 +176,11 @@ type DaemonServiceServer interface {
 	GetPipelineWatermarks(context.Context, *GetPipelineWatermarksRequest) (*GetPipelineWatermarksResponse, error)
 	GetPipelineStatus(context.Context, *GetPipelineStatusRequest) (*GetPipelineStatusResponse, error)
 	GetVertexErrors(context.Context, *GetVertexErrorsRequest) (*GetVertexErrorsResponse, error)
+	// ListDeadLetterMessages returns the messages in the dead-letter buffer of the given vertex
+	ListDeadLetterMessages(context.Context, *ListDeadLetterMessagesRequest) (*ListDeadLetterMessagesResponse, error)
+	GetDeadLetterMessage(context.Context, *GetDeadLetterMessageRequest) (*GetDeadLetterMessageResponse, error)
+	// ReplayDeadLetterMessages writes the given dead-lettered messages back to the input buffer of the vertex
+	ReplayDeadLetterMessages(context.Context, *ReplayDeadLetterMessagesRequest) (*ReplayDeadLetterMessagesResponse, error)
 	mustEmbedUnimplementedDaemonServiceServer()
 }
 
@@ -163,6 +206,15 @@ func (UnimplementedDaemonServiceServer) GetPipelineStatus(context.Context, *GetP
 func (UnimplementedDaemonServiceServer) GetVertexErrors(context.Context, *GetVertexErrorsRequest) (*GetVertexErrorsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetVertexErrors not implemented")
 }
+func (UnimplementedDaemonServiceServer) ListDeadLetterMessages(context.Context, *ListDeadLetterMessagesRequest) (*ListDeadLetterMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeadLetterMessages not implemented")
+}
+func (UnimplementedDaemonServiceServer) GetDeadLetterMessage(context.Context, *GetDeadLetterMessageRequest) (*GetDeadLetterMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeadLetterMessage not implemented")
+}
+func (UnimplementedDaemonServiceServer) ReplayDeadLetterMessages(context.Context, *ReplayDeadLetterMessagesRequest) (*ReplayDeadLetterMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayDeadLetterMessages not implemented")
+}
 func (UnimplementedDaemonServiceServer) mustEmbedUnimplementedDaemonServiceServer() {}
 
 // UnsafeDaemonServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -284,6 +336,60 @@ func _DaemonService_GetVertexErrors_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DaemonService_ListDeadLetterMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeadLetterMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServiceServer).ListDeadLetterMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DaemonService_ListDeadLetterMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServiceServer).ListDeadLetterMessages(ctx, req.(*ListDeadLetterMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaemonService_GetDeadLetterMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeadLetterMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServiceServer).GetDeadLetterMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DaemonService_GetDeadLetterMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServiceServer).GetDeadLetterMessage(ctx, req.(*GetDeadLetterMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaemonService_ReplayDeadLetterMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayDeadLetterMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServiceServer).ReplayDeadLetterMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DaemonService_ReplayDeadLetterMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServiceServer).ReplayDeadLetterMessages(ctx, req.(*ReplayDeadLetterMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DaemonService_ServiceDesc is the grpc.ServiceDesc for DaemonService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -315,6 +421,18 @@ var DaemonService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetVertexErrors",
 			Handler:    _DaemonService_GetVertexErrors_Handler,
 		},
+		{
+			MethodName: "ListDeadLetterMessages",
+			Handler:    _DaemonService_ListDeadLetterMessages_Handler,
+		},
+		{
+			MethodName: "GetDeadLetterMessage",
+			Handler:    _DaemonService_GetDeadLetterMessage_Handler,
+		},
+		{
+			MethodName: "ReplayDeadLetterMessages",
+			Handler:    _DaemonService_ReplayDeadLetterMessages_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/apis/proto/daemon/daemon.proto",
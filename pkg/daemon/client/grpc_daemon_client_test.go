@@ -65,6 +65,21 @@ func (m *mockDaemonServiceClient) GetVertexErrors(ctx context.Context, in *daemo
 	return args.Get(0).(*daemon.GetVertexErrorsResponse), args.Error(1)
 }
 
+func (m *mockDaemonServiceClient) ListDeadLetterMessages(ctx context.Context, in *daemon.ListDeadLetterMessagesRequest, opts ...grpc.CallOption) (*daemon.ListDeadLetterMessagesResponse, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*daemon.ListDeadLetterMessagesResponse), args.Error(1)
+}
+
+func (m *mockDaemonServiceClient) GetDeadLetterMessage(ctx context.Context, in *daemon.GetDeadLetterMessageRequest, opts ...grpc.CallOption) (*daemon.GetDeadLetterMessageResponse, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*daemon.GetDeadLetterMessageResponse), args.Error(1)
+}
+
+func (m *mockDaemonServiceClient) ReplayDeadLetterMessages(ctx context.Context, in *daemon.ReplayDeadLetterMessagesRequest, opts ...grpc.CallOption) (*daemon.ReplayDeadLetterMessagesResponse, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*daemon.ReplayDeadLetterMessagesResponse), args.Error(1)
+}
+
 func TestGrpcDaemonClient_ListPipelineBuffers(t *testing.T) {
 	t.Run("successful listing", func(t *testing.T) {
 		mockClient := new(mockDaemonServiceClient)
@@ -75,7 +75,12 @@ func (ds *daemonServer) Run(ctx context.Context) error {
 
 	switch ds.isbSvcType {
 	case v1alpha1.ISBSvcTypeRedis:
-		isbSvcClient = isbsvc.NewISBRedisSvc(redisclient.NewInClusterRedisClient())
+		redisClient, err := redisclient.NewInClusterRedisClient()
+		if err != nil {
+			log.Errorw("Failed to get a Redis client.", zap.Error(err))
+			return err
+		}
+		isbSvcClient = isbsvc.NewISBRedisSvc(redisClient)
 	case v1alpha1.ISBSvcTypeJetStream:
 		natsClientPool, err = jsclient.NewClientPool(ctx, jsclient.WithClientPoolSize(1))
 		if err != nil {
@@ -88,6 +93,8 @@ func (ds *daemonServer) Run(ctx context.Context) error {
 			log.Errorw("Failed to get an ISB Service client.", zap.Error(err))
 			return err
 		}
+	case v1alpha1.ISBSvcTypeDisk:
+		isbSvcClient = isbsvc.NewISBDiskSvc(v1alpha1.PathISBSvcDiskMount)
 	default:
 		return fmt.Errorf("unsupported isbsvc buffer type %q", ds.isbSvcType)
 	}
@@ -0,0 +1,113 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/apis/proto/daemon"
+	"github.com/numaproj/numaflow/pkg/isbsvc"
+)
+
+const defaultDeadLetterListLimit = 100
+
+// ListDeadLetterMessages is used to list the messages in the dead-letter buffer of a vertex
+func (ps *PipelineMetadataQuery) ListDeadLetterMessages(ctx context.Context, req *daemon.ListDeadLetterMessagesRequest) (*daemon.ListDeadLetterMessagesResponse, error) {
+	vertex, err := ps.getDeadLetterVertex(req.GetVertex())
+	if err != nil {
+		return nil, err
+	}
+	limit := int(req.GetLimit())
+	if limit <= 0 {
+		limit = defaultDeadLetterListLimit
+	}
+	buffer := vertex.DeadLetterBufferName(ps.pipeline.Namespace, ps.pipeline.Name)
+	messages, err := ps.isbSvcClient.ListDeadLetterMessages(ctx, buffer, req.GetFromOffset(), limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the messages of dead-letter buffer %q, %w", buffer, err)
+	}
+	resp := new(daemon.ListDeadLetterMessagesResponse)
+	for _, m := range messages {
+		resp.Messages = append(resp.Messages, toDaemonDeadLetterMessage(m))
+	}
+	return resp, nil
+}
+
+// GetDeadLetterMessage is used to inspect a message in the dead-letter buffer of a vertex
+func (ps *PipelineMetadataQuery) GetDeadLetterMessage(ctx context.Context, req *daemon.GetDeadLetterMessageRequest) (*daemon.GetDeadLetterMessageResponse, error) {
+	vertex, err := ps.getDeadLetterVertex(req.GetVertex())
+	if err != nil {
+		return nil, err
+	}
+	buffer := vertex.DeadLetterBufferName(ps.pipeline.Namespace, ps.pipeline.Name)
+	m, err := ps.isbSvcClient.GetDeadLetterMessage(ctx, buffer, req.GetOffset())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the message at offset %q of dead-letter buffer %q, %w", req.GetOffset(), buffer, err)
+	}
+	return &daemon.GetDeadLetterMessageResponse{Message: toDaemonDeadLetterMessage(m)}, nil
+}
+
+// ReplayDeadLetterMessages is used to write the dead-lettered messages back to the input buffer of a vertex.
+// The messages are replayed one by one, the ones failed to replay are kept in the dead-letter buffer.
+func (ps *PipelineMetadataQuery) ReplayDeadLetterMessages(ctx context.Context, req *daemon.ReplayDeadLetterMessagesRequest) (*daemon.ReplayDeadLetterMessagesResponse, error) {
+	vertex, err := ps.getDeadLetterVertex(req.GetVertex())
+	if err != nil {
+		return nil, err
+	}
+	if len(req.GetOffsets()) == 0 {
+		return nil, fmt.Errorf("no offset of the messages to replay is specified")
+	}
+	buffer := vertex.DeadLetterBufferName(ps.pipeline.Namespace, ps.pipeline.Name)
+	toBuffers := vertex.OwnedBufferNames(ps.pipeline.Namespace, ps.pipeline.Name)
+	resp := &daemon.ReplayDeadLetterMessagesResponse{Errors: map[string]string{}}
+	for _, offset := range req.GetOffsets() {
+		if err := ps.isbSvcClient.ReplayDeadLetterMessage(ctx, buffer, offset, toBuffers); err != nil {
+			resp.Errors[offset] = err.Error()
+			continue
+		}
+		resp.ReplayedOffsets = append(resp.ReplayedOffsets, offset)
+	}
+	return resp, nil
+}
+
+// getDeadLetterVertex returns the vertex with the given name, which must have the dead-letter buffer enabled.
+func (ps *PipelineMetadataQuery) getDeadLetterVertex(name string) (*v1alpha1.AbstractVertex, error) {
+	vertex := ps.pipeline.GetVertex(name)
+	if vertex == nil {
+		return nil, fmt.Errorf("vertex %q not found in pipeline %q", name, ps.pipeline.Name)
+	}
+	if !vertex.HasDeadLetter() {
+		return nil, fmt.Errorf("dead letter is not enabled for vertex %q", name)
+	}
+	return vertex, nil
+}
+
+func toDaemonDeadLetterMessage(m *isbsvc.DeadLetterMessage) *daemon.DeadLetterMessage {
+	return &daemon.DeadLetterMessage{
+		Offset:    m.Offset,
+		Id:        m.Message.ID.String(),
+		Keys:      m.Message.Keys,
+		Payload:   m.Message.Payload,
+		EventTime: timestamppb.New(m.Message.EventTime),
+		Headers:   m.Message.Headers,
+		Error:     m.Message.Headers[v1alpha1.KeyMetaDeadLetterError],
+	}
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/apis/proto/daemon"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/isbsvc"
+	"github.com/numaproj/numaflow/pkg/shared/deadletter"
+)
+
+func newDeadLetterTestPipeline() *v1alpha1.Pipeline {
+	return &v1alpha1.Pipeline{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "simple-pipeline",
+			Namespace: "numaflow-system",
+		},
+		Spec: v1alpha1.PipelineSpec{
+			Vertices: []v1alpha1.AbstractVertex{
+				{Name: "in", Source: &v1alpha1.Source{}},
+				{Name: "cat", UDF: &v1alpha1.UDF{}, DeadLetter: &v1alpha1.DeadLetter{}, Partitions: ptr.To[int32](2)},
+				{Name: "out", Sink: &v1alpha1.Sink{}},
+			},
+			Edges: []v1alpha1.Edge{
+				{From: "in", To: "cat"},
+				{From: "cat", To: "out"},
+			},
+		},
+	}
+}
+
+func newDeadLetterTestMessages() []*isbsvc.DeadLetterMessage {
+	var result []*isbsvc.DeadLetterMessage
+	for i, offset := range []string{"1", "2", "3"} {
+		msg := isb.Message{
+			Header: isb.Header{
+				ID:      isb.MessageID{VertexName: "in", Offset: offset},
+				Keys:    []string{"k"},
+				Headers: map[string]string{"a": "b"},
+			},
+			Body: isb.Body{Payload: []byte("test")},
+		}
+		result = append(result, &isbsvc.DeadLetterMessage{
+			Offset:  offset,
+			Message: deadletter.NewMessage(msg, "cat", int32(i%2), 3, errors.New("udf error")),
+		})
+	}
+	return result
+}
+
+func TestListDeadLetterMessages(t *testing.T) {
+	ms := &mockIsbSvcClient{deadLetterMessages: newDeadLetterTestMessages()}
+	q, err := NewPipelineMetadataQuery(ms, newDeadLetterTestPipeline(), nil, nil, nil)
+	assert.NoError(t, err)
+
+	resp, err := q.ListDeadLetterMessages(context.Background(), &daemon.ListDeadLetterMessagesRequest{Pipeline: "simple-pipeline", Vertex: "cat"})
+	assert.NoError(t, err)
+	assert.Len(t, resp.Messages, 3)
+	assert.Equal(t, "1", resp.Messages[0].Offset)
+	assert.Equal(t, "in-1-0", resp.Messages[0].Id)
+	assert.Equal(t, "udf error", resp.Messages[0].Error)
+	assert.Equal(t, []byte("test"), resp.Messages[0].Payload)
+	assert.Equal(t, "b", resp.Messages[0].Headers["a"])
+
+	resp, err = q.ListDeadLetterMessages(context.Background(), &daemon.ListDeadLetterMessagesRequest{Pipeline: "simple-pipeline", Vertex: "cat", FromOffset: "2", Limit: 1})
+	assert.NoError(t, err)
+	assert.Len(t, resp.Messages, 1)
+	assert.Equal(t, "2", resp.Messages[0].Offset)
+
+	_, err = q.ListDeadLetterMessages(context.Background(), &daemon.ListDeadLetterMessagesRequest{Pipeline: "simple-pipeline", Vertex: "out"})
+	assert.ErrorContains(t, err, "dead letter is not enabled")
+	_, err = q.ListDeadLetterMessages(context.Background(), &daemon.ListDeadLetterMessagesRequest{Pipeline: "simple-pipeline", Vertex: "nonexistent"})
+	assert.ErrorContains(t, err, "not found")
+}
+
+func TestGetDeadLetterMessage(t *testing.T) {
+	ms := &mockIsbSvcClient{deadLetterMessages: newDeadLetterTestMessages()}
+	q, err := NewPipelineMetadataQuery(ms, newDeadLetterTestPipeline(), nil, nil, nil)
+	assert.NoError(t, err)
+
+	resp, err := q.GetDeadLetterMessage(context.Background(), &daemon.GetDeadLetterMessageRequest{Pipeline: "simple-pipeline", Vertex: "cat", Offset: "3"})
+	assert.NoError(t, err)
+	assert.Equal(t, "3", resp.Message.Offset)
+	assert.Equal(t, "cat", resp.Message.Headers[v1alpha1.KeyMetaDeadLetterVertex])
+
+	_, err = q.GetDeadLetterMessage(context.Background(), &daemon.GetDeadLetterMessageRequest{Pipeline: "simple-pipeline", Vertex: "cat", Offset: "4"})
+	assert.Error(t, err)
+}
+
+func TestReplayDeadLetterMessages(t *testing.T) {
+	ms := &mockIsbSvcClient{deadLetterMessages: newDeadLetterTestMessages()}
+	q, err := NewPipelineMetadataQuery(ms, newDeadLetterTestPipeline(), nil, nil, nil)
+	assert.NoError(t, err)
+
+	resp, err := q.ReplayDeadLetterMessages(context.Background(), &daemon.ReplayDeadLetterMessagesRequest{Pipeline: "simple-pipeline", Vertex: "cat", Offsets: []string{"1", "2", "4"}})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"1", "2"}, resp.ReplayedOffsets)
+	assert.Contains(t, resp.Errors, "4")
+	// messages are replayed to the partitions they were read from
+	assert.Equal(t, map[string]string{
+		"1": "numaflow-system-simple-pipeline-cat-0",
+		"2": "numaflow-system-simple-pipeline-cat-1",
+	}, ms.replayed)
+
+	_, err = q.ReplayDeadLetterMessages(context.Background(), &daemon.ReplayDeadLetterMessagesRequest{Pipeline: "simple-pipeline", Vertex: "cat"})
+	assert.Error(t, err)
+}
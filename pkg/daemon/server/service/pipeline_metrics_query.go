@@ -117,6 +117,12 @@ func (ps *PipelineMetadataQuery) GetBuffer(ctx context.Context, req *daemon.GetB
 		BufferUsage:      wrapperspb.Double(usage),
 		IsFull:           wrapperspb.Bool(usage >= bufferUsageLimit),
 	}
+	for lane, count := range bufferInfo.PriorityPendingCounts {
+		if b.PriorityPendingCounts == nil {
+			b.PriorityPendingCounts = make(map[int32]*wrapperspb.Int64Value)
+		}
+		b.PriorityPendingCounts[lane] = wrapperspb.Int64(count)
+	}
 	resp := new(daemon.GetBufferResponse)
 	resp.Buffer = b
 	return resp, nil
@@ -292,6 +298,10 @@ func listBuffers(ctx context.Context, pipeline *v1alpha1.Pipeline, isbSvcClient
 
 	buffers := []*daemon.BufferInfo{}
 	for _, buffer := range pipeline.GetAllBuffers() {
+		// dead-letter buffers are not consumed by any vertex, they are served by the dead-letter APIs.
+		if v1alpha1.IsDeadLetterBuffer(buffer) {
+			continue
+		}
 		bufferInfo, err := isbSvcClient.GetBufferInfo(ctx, buffer)
 		if err != nil {
 			return nil, fmt.Errorf("failed to get information of buffer %q", buffer)
@@ -317,6 +327,12 @@ func listBuffers(ctx context.Context, pipeline *v1alpha1.Pipeline, isbSvcClient
 			BufferUsage:      wrapperspb.Double(usage),
 			IsFull:           wrapperspb.Bool(usage >= bufferUsageLimit),
 		}
+		for lane, count := range bufferInfo.PriorityPendingCounts {
+			if b.PriorityPendingCounts == nil {
+				b.PriorityPendingCounts = make(map[int32]*wrapperspb.Int64Value)
+			}
+			b.PriorityPendingCounts[lane] = wrapperspb.Int64(count)
+		}
 		buffers = append(buffers, b)
 	}
 	resp.Buffers = buffers
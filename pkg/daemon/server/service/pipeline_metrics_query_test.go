@@ -19,6 +19,7 @@ package service
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"io"
 	"net/http"
 	"testing"
@@ -33,6 +34,7 @@ import (
 	"github.com/numaproj/numaflow/pkg/isbsvc"
 	nats2 "github.com/numaproj/numaflow/pkg/shared/clients/nats"
 	"github.com/numaproj/numaflow/pkg/shared/clients/nats/test"
+	"github.com/numaproj/numaflow/pkg/shared/deadletter"
 	"github.com/numaproj/numaflow/pkg/watermark/store"
 )
 
@@ -47,6 +49,9 @@ func (m *mockHttpClient) Get(url string) (*http.Response, error) {
 }
 
 type mockIsbSvcClient struct {
+	deadLetterMessages []*isbsvc.DeadLetterMessage
+	// replayed records the buffer each replayed dead-letter message is written to, keyed by offset
+	replayed map[string]string
 }
 
 func (ms *mockIsbSvcClient) GetBufferInfo(ctx context.Context, buffer string) (*isbsvc.BufferInfo, error) {
@@ -74,6 +79,37 @@ func (ms *mockIsbSvcClient) CreateWatermarkStores(ctx context.Context, bucketNam
 	return nil, nil
 }
 
+func (ms *mockIsbSvcClient) ListDeadLetterMessages(ctx context.Context, buffer, fromOffset string, limit int) ([]*isbsvc.DeadLetterMessage, error) {
+	var result []*isbsvc.DeadLetterMessage
+	for _, m := range ms.deadLetterMessages {
+		if m.Offset >= fromOffset && len(result) < limit {
+			result = append(result, m)
+		}
+	}
+	return result, nil
+}
+
+func (ms *mockIsbSvcClient) GetDeadLetterMessage(ctx context.Context, buffer, offset string) (*isbsvc.DeadLetterMessage, error) {
+	for _, m := range ms.deadLetterMessages {
+		if m.Offset == offset {
+			return m, nil
+		}
+	}
+	return nil, fmt.Errorf("message at offset %q not found", offset)
+}
+
+func (ms *mockIsbSvcClient) ReplayDeadLetterMessage(ctx context.Context, buffer, offset string, toBuffers []string) error {
+	m, err := ms.GetDeadLetterMessage(ctx, buffer, offset)
+	if err != nil {
+		return err
+	}
+	if ms.replayed == nil {
+		ms.replayed = make(map[string]string)
+	}
+	ms.replayed[offset] = toBuffers[deadletter.Partition(m.Message)%len(toBuffers)]
+	return nil
+}
+
 // mock rater
 type mockRater_TestGetVertexMetrics struct {
 }
@@ -0,0 +1,44 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package forwarder
+
+import (
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/shared/expr"
+	sharedutil "github.com/numaproj/numaflow/pkg/shared/util"
+)
+
+// MatchConditions tells if a message should be forwarded through an edge with the given conditions. A message is
+// forwarded if the edge has no conditions, or it satisfies all the conditions specified. An expression which can
+// not be evaluated against the message, for example the payload is not a JSON, is treated as not satisfied.
+func MatchConditions(conditions *dfv1.ForwardConditions, tags []string, msg *isb.Message) bool {
+	if conditions == nil {
+		return true
+	}
+	if conditions.Tags != nil && len(conditions.Tags.Values) > 0 && !sharedutil.CompareSlice(conditions.Tags.GetOperator(), tags, conditions.Tags.Values) {
+		return false
+	}
+	if conditions.Expression != "" {
+		if msg == nil {
+			return false
+		}
+		ok, err := expr.EvalMessageBool(conditions.Expression, msg.Payload, msg.Keys, msg.Headers)
+		return err == nil && ok
+	}
+	return true
+}
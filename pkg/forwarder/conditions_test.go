@@ -0,0 +1,96 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package forwarder
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/utils/ptr"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+)
+
+func TestMatchConditions(t *testing.T) {
+	msg := &isb.Message{
+		Header: isb.Header{Keys: []string{"k1"}, Headers: map[string]string{"x-region": "us"}},
+		Body:   isb.Body{Payload: []byte(`{"amount": 120}`)},
+	}
+	tests := []struct {
+		name       string
+		conditions *dfv1.ForwardConditions
+		tags       []string
+		msg        *isb.Message
+		want       bool
+	}{
+		{name: "no conditions", want: true, msg: msg},
+		{name: "empty conditions", conditions: &dfv1.ForwardConditions{}, want: true, msg: msg},
+		{
+			name:       "tags matched",
+			conditions: &dfv1.ForwardConditions{Tags: &dfv1.TagConditions{Values: []string{"a", "b"}}},
+			tags:       []string{"b"},
+			msg:        msg,
+			want:       true,
+		},
+		{
+			name:       "tags not matched",
+			conditions: &dfv1.ForwardConditions{Tags: &dfv1.TagConditions{Operator: ptr.To(dfv1.LogicOperatorAnd), Values: []string{"a", "b"}}},
+			tags:       []string{"b", "c"},
+			msg:        msg,
+			want:       false,
+		},
+		{
+			name:       "expression matched",
+			conditions: &dfv1.ForwardConditions{Expression: `int(json(payload).amount) > 100 && headers["x-region"] == "us"`},
+			msg:        msg,
+			want:       true,
+		},
+		{
+			name:       "expression not matched",
+			conditions: &dfv1.ForwardConditions{Expression: `"k2" in keys`},
+			msg:        msg,
+			want:       false,
+		},
+		{
+			name: "tags matched but expression not matched",
+			conditions: &dfv1.ForwardConditions{
+				Tags:       &dfv1.TagConditions{Values: []string{"a"}},
+				Expression: `headers["x-region"] == "eu"`,
+			},
+			tags: []string{"a"},
+			msg:  msg,
+			want: false,
+		},
+		{
+			name:       "expression evaluation error",
+			conditions: &dfv1.ForwardConditions{Expression: `json(keys[0]).a == 1`},
+			msg:        msg,
+			want:       false,
+		},
+		{
+			name:       "expression without message",
+			conditions: &dfv1.ForwardConditions{Expression: `true`},
+			want:       false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, MatchConditions(tt.conditions, tt.tags, tt.msg))
+		})
+	}
+}
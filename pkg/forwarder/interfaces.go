@@ -16,10 +16,14 @@ limitations under the License.
 
 package forwarder
 
+import "github.com/numaproj/numaflow/pkg/isb"
+
 // VertexBuffer points to the partition of a buffer owned by the vertex.
 type VertexBuffer struct {
 	ToVertexName         string
 	ToVertexPartitionIdx int32
+	// PriorityLane is the priority lane of the partition to write the message to, 0 is the lowest priority.
+	PriorityLane int32
 }
 
 // ToWhichStepDecider decides which step to forward after applying the WhereTo function.
@@ -36,15 +40,18 @@ type ToWhichStepDecider interface {
 	//
 	// - id: Used by shuffle to decide which partition to write, if the toVertex is a 'map' and has
 	// multiple partitions. It is deterministic messages with same id will always go to the same partition.
-	WhereTo([]string, []string, string) ([]VertexBuffer, error)
+	//
+	// - msg: Used for conditional forwarding with expressions, which are evaluated against the payload, keys
+	// and headers of the message.
+	WhereTo([]string, []string, string, *isb.Message) ([]VertexBuffer, error)
 }
 
 // GoWhere is the step decider on where it needs to go
-type GoWhere func([]string, []string, string) ([]VertexBuffer, error)
+type GoWhere func([]string, []string, string, *isb.Message) ([]VertexBuffer, error)
 
 // WhereTo decides where the data goes to.
-func (gw GoWhere) WhereTo(ks []string, ts []string, id string) ([]VertexBuffer, error) {
-	return gw(ks, ts, id)
+func (gw GoWhere) WhereTo(ks []string, ts []string, id string, msg *isb.Message) ([]VertexBuffer, error) {
+	return gw(ks, ts, id, msg)
 }
 
 // StarterStopper starts/stops the forwarding.
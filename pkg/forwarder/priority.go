@@ -0,0 +1,65 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package forwarder
+
+import (
+	"strconv"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+)
+
+// PriorityLane returns the priority lane of a message forwarded through an edge with the given priority settings.
+// The highest lane of the matching tags is used if there is any, otherwise the lane in the header. The messages
+// without a priority, or forwarded through an edge without priority settings, go to lane 0.
+func PriorityLane(priority *dfv1.EdgePriority, tags []string, msg *isb.Message) int32 {
+	if priority == nil || priority.Lanes < 2 {
+		return 0
+	}
+	lane := int32(-1)
+	for _, tag := range tags {
+		if l, ok := priority.Tags[tag]; ok && l > lane {
+			lane = l
+		}
+	}
+	if lane < 0 && priority.Header != "" && msg != nil {
+		if v, err := strconv.Atoi(msg.Headers[priority.Header]); err == nil {
+			lane = int32(v)
+		}
+	}
+	return min(max(lane, 0), priority.Lanes-1)
+}
+
+// WithPriorityLane returns a copy of the message with the priority lane in the header, which tells the writer which
+// lane to write the message to. The headers are copied since they are shared by the messages forwarded through
+// the other edges. A lane left in the header by the writers not supporting the priority lanes is removed.
+func WithPriorityLane(msg isb.Message, lane int32) isb.Message {
+	if _, ok := msg.Headers[dfv1.KeyMetaPriorityLane]; !ok && lane == 0 {
+		return msg
+	}
+	headers := make(map[string]string, len(msg.Headers)+1)
+	for k, v := range msg.Headers {
+		if k != dfv1.KeyMetaPriorityLane {
+			headers[k] = v
+		}
+	}
+	if lane > 0 {
+		headers[dfv1.KeyMetaPriorityLane] = strconv.Itoa(int(lane))
+	}
+	msg.Headers = headers
+	return msg
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package forwarder
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+)
+
+func TestPriorityLane(t *testing.T) {
+	priority := &dfv1.EdgePriority{Lanes: 3, Header: "x-priority", Tags: map[string]int32{"urgent": 2, "high": 1}}
+	msg := func(p string) *isb.Message {
+		return &isb.Message{Header: isb.Header{Headers: map[string]string{"x-priority": p}}}
+	}
+	tests := []struct {
+		name     string
+		priority *dfv1.EdgePriority
+		tags     []string
+		msg      *isb.Message
+		want     int32
+	}{
+		{name: "no priority", tags: []string{"urgent"}, msg: msg("2"), want: 0},
+		{name: "single lane", priority: &dfv1.EdgePriority{Lanes: 1, Header: "x-priority"}, msg: msg("2"), want: 0},
+		{name: "highest tag", priority: priority, tags: []string{"high", "urgent"}, msg: msg("0"), want: 2},
+		{name: "tags over header", priority: priority, tags: []string{"high"}, msg: msg("2"), want: 1},
+		{name: "header", priority: priority, tags: []string{"other"}, msg: msg("1"), want: 1},
+		{name: "header over highest lane", priority: priority, msg: msg("9"), want: 2},
+		{name: "negative header", priority: priority, msg: msg("-1"), want: 0},
+		{name: "invalid header", priority: priority, msg: msg("high"), want: 0},
+		{name: "nil message", priority: priority, want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, PriorityLane(tt.priority, tt.tags, tt.msg))
+		})
+	}
+}
+
+func TestWithPriorityLane(t *testing.T) {
+	headers := map[string]string{"k": "v"}
+	msg := isb.Message{Header: isb.Header{Headers: headers}}
+	assert.Equal(t, msg, WithPriorityLane(msg, 0))
+
+	m := WithPriorityLane(msg, 2)
+	assert.Equal(t, map[string]string{"k": "v", dfv1.KeyMetaPriorityLane: "2"}, m.Headers)
+	// the headers shared with the other edges are not modified
+	assert.Equal(t, map[string]string{"k": "v"}, headers)
+
+	m = WithPriorityLane(m, 0)
+	assert.Equal(t, map[string]string{"k": "v"}, m.Headers)
+}
@@ -0,0 +1,400 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package claimcheck implements the claim check pattern for the inter-step buffers. The payloads larger than a
+// threshold are stored out of the buffers, and the messages only carry the references to them in the header.
+// The payloads are loaded back when the messages are read, and deleted once the messages are acknowledged. Every
+// message written has its own stored payload, a payload forwarded unchanged is copied in the store rather than
+// uploaded again.
+package claimcheck
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/shared/deadletter"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+)
+
+// Wrap wraps the readers and writers of a vertex in place, including the dead-letter writer if it's not nil, so that
+// the payloads larger than the threshold of the claim check are stored out of the buffers. They are left as is if the
+// claim check is not configured.
+func Wrap(ctx context.Context, vertex *dfv1.Vertex, readers []isb.BufferReader, writers map[string][]isb.BufferWriter, deadLetterWriter *isb.BufferWriter) error {
+	claimCheck := vertex.Spec.ClaimCheck
+	if claimCheck == nil {
+		return nil
+	}
+	store, err := NewStore(ctx, claimCheck)
+	if err != nil {
+		return fmt.Errorf("failed to create the claim check store, %w", err)
+	}
+	// the payloads read by the vertex are tracked so that the writers can copy them instead of uploading them again
+	tracked := newPayloads()
+	var dl *deadLetter
+	if deadLetterWriter != nil && *deadLetterWriter != nil {
+		// the messages of which the payloads are missing are dead-lettered as is
+		dl = &deadLetter{writer: *deadLetterWriter, vertex: vertex.Spec.Name, retryInterval: vertex.Spec.DeadLetter.GetRetryInterval()}
+		*deadLetterWriter = newBufferWriter(ctx, *deadLetterWriter, store, claimCheck.GetThresholdBytes(), tracked)
+	}
+	for i, r := range readers {
+		readers[i] = newBufferReader(ctx, r, store, tracked, dl)
+	}
+	for _, partitions := range writers {
+		for i, w := range partitions {
+			partitions[i] = newBufferWriter(ctx, w, store, claimCheck.GetThresholdBytes(), tracked)
+		}
+	}
+	return nil
+}
+
+// payloads tracks the stored payloads of the messages being processed by a vertex by their digests, so that a payload
+// written unchanged to the next buffer is copied from the object it's read from.
+type payloads struct {
+	mu sync.Mutex
+	// keys are the keys of the payloads being processed, by the digests of the payloads.
+	keys map[[sha256.Size]byte][]string
+	// digests are the digests of the payloads being processed, by their keys.
+	digests map[string][sha256.Size]byte
+}
+
+func newPayloads() *payloads {
+	return &payloads{keys: make(map[[sha256.Size]byte][]string), digests: make(map[string][sha256.Size]byte)}
+}
+
+// track tracks the payload of a message read, a redelivered message is tracked once.
+func (p *payloads) track(key string, data []byte) {
+	digest := sha256.Sum256(data)
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if _, ok := p.digests[key]; ok {
+		return
+	}
+	p.digests[key] = digest
+	p.keys[digest] = append(p.keys[digest], key)
+}
+
+// lookup returns the key of a payload being processed equal to the data.
+func (p *payloads) lookup(data []byte) (string, bool) {
+	digest := sha256.Sum256(data)
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	keys := p.keys[digest]
+	if len(keys) == 0 {
+		return "", false
+	}
+	return keys[0], true
+}
+
+// untrack stops tracking the payload of a message acknowledged.
+func (p *payloads) untrack(key string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	digest, ok := p.digests[key]
+	if !ok {
+		return
+	}
+	delete(p.digests, key)
+	keys := p.keys[digest]
+	for i, k := range keys {
+		if k == key {
+			keys = append(keys[:i], keys[i+1:]...)
+			break
+		}
+	}
+	if len(keys) == 0 {
+		delete(p.keys, digest)
+	} else {
+		p.keys[digest] = keys
+	}
+}
+
+// bufferWriter stores the oversized payloads before writing the messages to the buffer.
+type bufferWriter struct {
+	isb.BufferWriter
+	store          Store
+	thresholdBytes int
+	payloads       *payloads
+	log            *zap.SugaredLogger
+}
+
+// NewBufferWriter returns a writer storing the payloads larger than the threshold in the store, and writing the
+// messages with the references to them to the buffer.
+func NewBufferWriter(ctx context.Context, writer isb.BufferWriter, store Store, thresholdBytes int) isb.BufferWriter {
+	return newBufferWriter(ctx, writer, store, thresholdBytes, newPayloads())
+}
+
+func newBufferWriter(ctx context.Context, writer isb.BufferWriter, store Store, thresholdBytes int, tracked *payloads) *bufferWriter {
+	return &bufferWriter{
+		BufferWriter:   writer,
+		store:          store,
+		thresholdBytes: thresholdBytes,
+		payloads:       tracked,
+		log:            logging.FromContext(ctx).With("claimCheckWriter", writer.GetName()),
+	}
+}
+
+// Write stores the oversized payloads, and writes the messages of which the payloads are stored successfully.
+// The messages failing to be stored are returned with retryable errors.
+func (w *bufferWriter) Write(ctx context.Context, messages []isb.Message) ([]isb.Offset, []error) {
+	offsets := make([]isb.Offset, len(messages))
+	errs := make([]error, len(messages))
+	toWrite := make([]isb.Message, 0, len(messages))
+	indices := make([]int, 0, len(messages))
+	for i, m := range messages {
+		if m.Header.Kind != isb.Data || len(m.Body.Payload) <= w.thresholdBytes {
+			toWrite = append(toWrite, m)
+			indices = append(indices, i)
+			continue
+		}
+		// the key is the same when the message is written again, e.g. after a redelivery, so it's only stored once
+		key := w.GetName() + "/" + url.PathEscape(m.Header.ID.String())
+		if err := w.save(ctx, key, m.Body.Payload); err != nil {
+			claimCheckErrors.With(map[string]string{labelBuffer: w.GetName(), labelOperation: "put"}).Inc()
+			errs[i] = isb.BufferWriteErr{Name: w.GetName(), InternalErr: true, Message: fmt.Sprintf("failed to store the payload of message %s, %s", m.Header.ID, err)}
+			continue
+		}
+		headers := make(map[string]string, len(m.Header.Headers)+1)
+		for k, v := range m.Header.Headers {
+			headers[k] = v
+		}
+		headers[dfv1.KeyMetaClaimCheck] = key
+		m.Header.Headers = headers
+		m.Body.Payload = nil
+		toWrite = append(toWrite, m)
+		indices = append(indices, i)
+	}
+	if len(toWrite) == 0 {
+		return offsets, errs
+	}
+	writeOffsets, writeErrs := w.BufferWriter.Write(ctx, toWrite)
+	for j, i := range indices {
+		if j < len(writeOffsets) {
+			offsets[i] = writeOffsets[j]
+		}
+		if j < len(writeErrs) {
+			errs[i] = writeErrs[j]
+		}
+	}
+	return offsets, errs
+}
+
+// save stores a payload, by copying the object of the same payload read by the vertex if there's one, so that the
+// payload isn't uploaded again. It's uploaded if the copy fails, e.g. the message read has been acknowledged since.
+func (w *bufferWriter) save(ctx context.Context, key string, data []byte) error {
+	labels := map[string]string{labelBuffer: w.GetName()}
+	if src, ok := w.payloads.lookup(data); ok {
+		err := w.store.Copy(ctx, src, key)
+		if err == nil {
+			claimCheckStoredPayloads.With(labels).Inc()
+			claimCheckCopiedPayloads.With(labels).Inc()
+			return nil
+		}
+		claimCheckErrors.With(map[string]string{labelBuffer: w.GetName(), labelOperation: "copy"}).Inc()
+		w.log.Debugw("Failed to copy the stored payload, uploading it", zap.String("source", src), zap.String("key", key), zap.Error(err))
+	}
+	if err := w.store.Put(ctx, key, data); err != nil {
+		return err
+	}
+	claimCheckStoredPayloads.With(labels).Inc()
+	claimCheckStoredBytes.With(labels).Add(float64(len(data)))
+	return nil
+}
+
+// deadLetter writes the messages of which the stored payloads are missing to the dead-letter buffer of the vertex.
+type deadLetter struct {
+	writer        isb.BufferWriter
+	vertex        string
+	retryInterval time.Duration
+}
+
+// bufferReader loads the stored payloads of the messages read from the buffer, and deletes them once the messages are
+// acknowledged.
+type bufferReader struct {
+	isb.BufferReader
+	store      Store
+	payloads   *payloads
+	deadLetter *deadLetter
+	log        *zap.SugaredLogger
+}
+
+// NewBufferReader returns a reader loading the stored payloads of the messages read from the buffer.
+func NewBufferReader(ctx context.Context, reader isb.BufferReader, store Store) isb.BufferReader {
+	return newBufferReader(ctx, reader, store, newPayloads(), nil)
+}
+
+func newBufferReader(ctx context.Context, reader isb.BufferReader, store Store, tracked *payloads, dl *deadLetter) *bufferReader {
+	return &bufferReader{
+		BufferReader: reader,
+		store:        store,
+		payloads:     tracked,
+		deadLetter:   dl,
+		log:          logging.FromContext(ctx).With("claimCheckReader", reader.GetName()),
+	}
+}
+
+// Read reads the messages, and replaces the references with the stored payloads. The messages failing to be loaded
+// are not returned, they are not acknowledged so that they are redelivered, except the ones of which the payloads
+// are missing, which can never be loaded. They are dead-lettered if the dead-letter buffer is configured, and dropped
+// otherwise.
+func (r *bufferReader) Read(ctx context.Context, count int64) ([]*isb.ReadMessage, error) {
+	messages, err := r.BufferReader.Read(ctx, count)
+	result := messages[:0]
+	var failed []isb.Offset
+	var missing []*isb.ReadMessage
+	var loadErr error
+	for _, m := range messages {
+		key, ok := m.Header.Headers[dfv1.KeyMetaClaimCheck]
+		if !ok {
+			result = append(result, m)
+			continue
+		}
+		payload, getErr := r.store.Get(ctx, key)
+		if getErr != nil {
+			claimCheckErrors.With(map[string]string{labelBuffer: r.GetName(), labelOperation: "get"}).Inc()
+			if errors.Is(getErr, fs.ErrNotExist) {
+				missing = append(missing, m)
+				continue
+			}
+			r.log.Errorw("Failed to load the stored payload", zap.String("key", key), zap.Error(getErr))
+			failed = append(failed, m.ReadOffset)
+			loadErr = errors.Join(loadErr, getErr)
+			continue
+		}
+		claimCheckLoadedPayloads.With(map[string]string{labelBuffer: r.GetName()}).Inc()
+		r.payloads.track(key, payload)
+		headers := make(map[string]string, len(m.Header.Headers)-1)
+		for k, v := range m.Header.Headers {
+			if k != dfv1.KeyMetaClaimCheck {
+				headers[k] = v
+			}
+		}
+		m.Header.Headers = headers
+		m.Body.Payload = payload
+		m.ReadOffset = &offset{Offset: m.ReadOffset, reader: r, key: key}
+		result = append(result, m)
+	}
+	if len(missing) > 0 {
+		failed = append(failed, r.discardMissing(ctx, missing)...)
+	}
+	if len(failed) > 0 {
+		r.BufferReader.NoAck(ctx, failed)
+	}
+	if err == nil && loadErr != nil {
+		err = isb.BufferReadErr{Name: r.GetName(), InternalErr: true, Message: loadErr.Error()}
+	}
+	return result, err
+}
+
+// discardMissing dead-letters, or drops if there's no dead-letter buffer, the messages of which the stored payloads
+// are missing, and acknowledges them. It returns the offsets of the messages failing to be dead-lettered.
+func (r *bufferReader) discardMissing(ctx context.Context, messages []*isb.ReadMessage) []isb.Offset {
+	offsets := make([]isb.Offset, len(messages))
+	for i, m := range messages {
+		offsets[i] = m.ReadOffset
+		r.log.Errorw("The stored payload is missing", zap.String("id", m.Header.ID.String()), zap.String("key", m.Header.Headers[dfv1.KeyMetaClaimCheck]), zap.Bool("deadLettered", r.deadLetter != nil))
+	}
+	if dl := r.deadLetter; dl != nil {
+		dlMessages := make([]isb.Message, len(messages))
+		for i, m := range messages {
+			dlMessages[i] = deadletter.NewMessage(m.Message, dl.vertex, r.GetPartitionIdx(), 0, fmt.Errorf("the stored payload %q is missing", m.Header.Headers[dfv1.KeyMetaClaimCheck]))
+		}
+		if err := deadletter.Write(ctx, dl.writer, dlMessages, dl.retryInterval, func() bool { return false }); err != nil {
+			r.log.Errorw("Failed to dead-letter the messages of which the stored payloads are missing", zap.Error(err))
+			return offsets
+		}
+	} else {
+		claimCheckDroppedMessages.With(map[string]string{labelBuffer: r.GetName()}).Add(float64(len(messages)))
+	}
+	for i, err := range r.BufferReader.Ack(ctx, offsets) {
+		if err != nil {
+			r.log.Errorw("Failed to acknowledge the message of which the stored payload is missing", zap.String("id", messages[i].Header.ID.String()), zap.Error(err))
+		}
+	}
+	return nil
+}
+
+// Ack acknowledges the offsets, and deletes the stored payloads of the acknowledged messages.
+func (r *bufferReader) Ack(ctx context.Context, offsets []isb.Offset) []error {
+	unwrapped := make([]isb.Offset, len(offsets))
+	for i, o := range offsets {
+		unwrapped[i] = unwrap(o)
+	}
+	errs := r.BufferReader.Ack(ctx, unwrapped)
+	var wg sync.WaitGroup
+	for i, o := range offsets {
+		if co, ok := o.(*offset); ok && (i >= len(errs) || errs[i] == nil) {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				r.delete(ctx, co.key)
+			}()
+		}
+	}
+	wg.Wait()
+	return errs
+}
+
+// NoAck cancels the acknowledgement of the offsets, the stored payloads are kept for the redelivery.
+func (r *bufferReader) NoAck(ctx context.Context, offsets []isb.Offset) {
+	unwrapped := make([]isb.Offset, len(offsets))
+	for i, o := range offsets {
+		unwrapped[i] = unwrap(o)
+	}
+	r.BufferReader.NoAck(ctx, unwrapped)
+}
+
+// delete deletes a stored payload. A payload failing to be deleted is left behind since the message has been
+// acknowledged, it can be cleaned up by the retention policy of the store.
+func (r *bufferReader) delete(ctx context.Context, key string) {
+	r.payloads.untrack(key)
+	if err := r.store.Delete(ctx, key); err != nil {
+		claimCheckErrors.With(map[string]string{labelBuffer: r.GetName(), labelOperation: "delete"}).Inc()
+		r.log.Warnw("Failed to delete the stored payload", zap.String("key", key), zap.Error(err))
+	}
+}
+
+// offset is the offset of a message with a stored payload, the payload is deleted once it is acknowledged.
+type offset struct {
+	isb.Offset
+	reader *bufferReader
+	key    string
+}
+
+// AckIt acknowledges the offset, and deletes the stored payload.
+func (o *offset) AckIt() error {
+	if err := o.Offset.AckIt(); err != nil {
+		return err
+	}
+	o.reader.delete(context.Background(), o.key)
+	return nil
+}
+
+func unwrap(o isb.Offset) isb.Offset {
+	if co, ok := o.(*offset); ok {
+		return co.Offset
+	}
+	return o
+}
@@ -0,0 +1,310 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package claimcheck
+
+import (
+	"context"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/isb/stores/simplebuffer"
+	"github.com/numaproj/numaflow/pkg/isb/testutils"
+)
+
+func TestClaimCheck_WriteAndRead(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	dir := t.TempDir()
+	store := newVolumeStore(dir)
+	buffer := simplebuffer.NewInMemoryBuffer("claim-check", 10, 0)
+	writer := NewBufferWriter(ctx, buffer, store, 16)
+	reader := NewBufferReader(ctx, buffer, store)
+
+	messages := testutils.BuildTestWriteMessages(2, time.Unix(1636470000, 0), nil, "test-vertex")
+	large := []byte(strings.Repeat("x", 32))
+	messages[1].Body.Payload = large
+	small := messages[0].Body.Payload[:8]
+	messages[0].Body.Payload = small
+
+	_, errs := writer.Write(ctx, messages)
+	for _, err := range errs {
+		require.NoError(t, err)
+	}
+	// the original message is not modified
+	assert.Equal(t, large, messages[1].Body.Payload)
+	assert.NotContains(t, messages[1].Header.Headers, dfv1.KeyMetaClaimCheck)
+	key := "claim-check/" + messages[1].Header.ID.String()
+	stored, err := os.ReadFile(filepath.Join(dir, key))
+	require.NoError(t, err)
+	assert.Equal(t, large, stored)
+
+	readMessages, err := reader.Read(ctx, 2)
+	require.NoError(t, err)
+	require.Len(t, readMessages, 2)
+	assert.Equal(t, small, readMessages[0].Body.Payload)
+	assert.Equal(t, large, readMessages[1].Body.Payload)
+	assert.NotContains(t, readMessages[1].Header.Headers, dfv1.KeyMetaClaimCheck)
+	assert.Equal(t, "value1", readMessages[1].Header.Headers["key1"])
+
+	errs = reader.Ack(ctx, []isb.Offset{readMessages[0].ReadOffset, readMessages[1].ReadOffset})
+	for _, err := range errs {
+		require.NoError(t, err)
+	}
+	_, err = os.Stat(filepath.Join(dir, key))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestClaimCheck_Forward(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	dir := t.TempDir()
+	store := newVolumeStore(dir)
+	in := simplebuffer.NewInMemoryBuffer("in", 10, 0)
+	out := simplebuffer.NewInMemoryBuffer("out", 10, 0)
+	dlq := simplebuffer.NewInMemoryBuffer("dlq", 10, 0)
+	// the vertex between the buffers
+	tracked := newPayloads()
+	reader := newBufferReader(ctx, in, store, tracked, nil)
+	writer := newBufferWriter(ctx, out, store, 16, tracked)
+	deadLetterWriter := newBufferWriter(ctx, dlq, store, 16, tracked)
+
+	messages := testutils.BuildTestWriteMessages(3, time.Unix(1636470000, 0), nil, "test-vertex")
+	for i := range messages {
+		messages[i].Body.Payload = []byte(strings.Repeat(strconv.Itoa(i), 32))
+	}
+	_, errs := NewBufferWriter(ctx, in, store, 16).Write(ctx, messages)
+	for _, err := range errs {
+		require.NoError(t, err)
+	}
+	readMessages, err := reader.Read(ctx, 3)
+	require.NoError(t, err)
+	require.Len(t, readMessages, 3)
+
+	// the first payload is forwarded unchanged to two messages, the second is changed, and the third is dead-lettered
+	unchanged1, unchanged2 := readMessages[0].Message, readMessages[0].Message
+	unchanged1.Header.ID.Index, unchanged2.Header.ID.Index = 10, 11
+	changed := readMessages[1].Message
+	changed.Body.Payload = []byte(strings.Repeat("x", 32))
+	_, errs = writer.Write(ctx, []isb.Message{unchanged1, unchanged2, changed})
+	for _, err := range errs {
+		require.NoError(t, err)
+	}
+	_, errs = deadLetterWriter.Write(ctx, []isb.Message{readMessages[2].Message})
+	require.NoError(t, errs[0])
+
+	// every message written has its own stored payload, the unchanged ones are copied from the payloads read
+	inKey := func(m isb.Message) string { return "in/" + m.Header.ID.String() }
+	outKey := func(m isb.Message) string { return "out/" + m.Header.ID.String() }
+	assert.ElementsMatch(t, []string{
+		inKey(messages[0]), inKey(messages[1]), inKey(messages[2]),
+		outKey(unchanged1), outKey(unchanged2), outKey(changed),
+		"dlq/" + readMessages[2].Header.ID.String(),
+	}, storedKeys(t, dir))
+	source, err := os.Stat(filepath.Join(dir, inKey(messages[0])))
+	require.NoError(t, err)
+	copied, err := os.Stat(filepath.Join(dir, outKey(unchanged2)))
+	require.NoError(t, err)
+	assert.True(t, os.SameFile(source, copied))
+	deadLettered, err := dlq.Read(ctx, 1)
+	require.NoError(t, err)
+	require.Len(t, deadLettered, 1)
+	assert.Equal(t, "dlq/"+readMessages[2].Header.ID.String(), deadLettered[0].Header.Headers[dfv1.KeyMetaClaimCheck])
+
+	// the next vertex only deletes its own payloads, so the messages read can still be redelivered
+	sinkReader := NewBufferReader(ctx, out, store)
+	sinkMessages, err := sinkReader.Read(ctx, 3)
+	require.NoError(t, err)
+	require.Len(t, sinkMessages, 3)
+	assert.Equal(t, messages[0].Body.Payload, sinkMessages[0].Body.Payload)
+	assert.Equal(t, messages[0].Body.Payload, sinkMessages[1].Body.Payload)
+	assert.Equal(t, changed.Body.Payload, sinkMessages[2].Body.Payload)
+	for _, err := range sinkReader.Ack(ctx, offsetsOf(sinkMessages)) {
+		require.NoError(t, err)
+	}
+	assert.ElementsMatch(t, []string{
+		inKey(messages[0]), inKey(messages[1]), inKey(messages[2]),
+		"dlq/" + readMessages[2].Header.ID.String(),
+	}, storedKeys(t, dir))
+
+	for _, err := range reader.Ack(ctx, offsetsOf(readMessages)) {
+		require.NoError(t, err)
+	}
+	assert.Equal(t, []string{"dlq/" + readMessages[2].Header.ID.String()}, storedKeys(t, dir))
+}
+
+func offsetsOf(messages []*isb.ReadMessage) []isb.Offset {
+	var result []isb.Offset
+	for _, m := range messages {
+		result = append(result, m.ReadOffset)
+	}
+	return result
+}
+
+func storedKeys(t *testing.T, dir string) []string {
+	var keys []string
+	require.NoError(t, filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(dir, p)
+		keys = append(keys, filepath.ToSlash(rel))
+		return err
+	}))
+	sort.Strings(keys)
+	return keys
+}
+
+func TestClaimCheck_ReadMissingPayload(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	messages := testutils.BuildTestWriteMessages(2, time.Unix(1636470000, 0), nil, "test-vertex")
+	messages[0].Header.Headers[dfv1.KeyMetaClaimCheck] = "claim-check/missing"
+
+	t.Run("dead-lettered", func(t *testing.T) {
+		buffer := simplebuffer.NewInMemoryBuffer("claim-check", 10, 0)
+		dlq := simplebuffer.NewInMemoryBuffer("dlq", 10, 0)
+		dl := &deadLetter{writer: dlq, vertex: "test-vertex", retryInterval: time.Millisecond}
+		reader := newBufferReader(ctx, buffer, newVolumeStore(t.TempDir()), newPayloads(), dl)
+		_, errs := buffer.Write(ctx, messages)
+		require.NoError(t, errs[0])
+
+		// the message of which the payload is missing is not redelivered
+		readMessages, err := reader.Read(ctx, 2)
+		require.NoError(t, err)
+		require.Len(t, readMessages, 1)
+		assert.Equal(t, messages[1].Header.ID, readMessages[0].Header.ID)
+		deadLettered, err := dlq.Read(ctx, 1)
+		require.NoError(t, err)
+		require.Len(t, deadLettered, 1)
+		assert.Equal(t, messages[0].Header.ID, deadLettered[0].Header.ID)
+		assert.Equal(t, "claim-check/missing", deadLettered[0].Header.Headers[dfv1.KeyMetaClaimCheck])
+		assert.Contains(t, deadLettered[0].Header.Headers[dfv1.KeyMetaDeadLetterError], `the stored payload "claim-check/missing" is missing`)
+	})
+
+	t.Run("dropped", func(t *testing.T) {
+		buffer := simplebuffer.NewInMemoryBuffer("claim-check", 10, 0)
+		reader := NewBufferReader(ctx, buffer, newVolumeStore(t.TempDir()))
+		_, errs := buffer.Write(ctx, messages)
+		require.NoError(t, errs[0])
+
+		readMessages, err := reader.Read(ctx, 2)
+		require.NoError(t, err)
+		require.Len(t, readMessages, 1)
+		assert.Equal(t, messages[1].Header.ID, readMessages[0].Header.ID)
+	})
+}
+
+func TestClaimCheck_ReadFailure(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	buffer := simplebuffer.NewInMemoryBuffer("claim-check", 10, 0)
+	dir := t.TempDir()
+	reader := NewBufferReader(ctx, buffer, newVolumeStore(dir))
+
+	messages := testutils.BuildTestWriteMessages(1, time.Unix(1636470000, 0), nil, "test-vertex")
+	messages[0].Header.Headers[dfv1.KeyMetaClaimCheck] = "claim-check"
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "claim-check"), 0o755))
+	_, errs := buffer.Write(ctx, messages)
+	require.NoError(t, errs[0])
+
+	// a payload failing to be loaded for another reason is redelivered
+	readMessages, err := reader.Read(ctx, 1)
+	assert.Empty(t, readMessages)
+	var readErr isb.BufferReadErr
+	require.ErrorAs(t, err, &readErr)
+	assert.True(t, readErr.IsInternalErr())
+}
+
+func TestVolumeStore(t *testing.T) {
+	ctx := context.Background()
+	store := newVolumeStore(t.TempDir())
+	require.NoError(t, store.Put(ctx, "buffer/a", []byte("data")))
+	data, err := store.Get(ctx, "buffer/a")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("data"), data)
+	require.NoError(t, store.Copy(ctx, "buffer/a", "next/b"))
+	// copying again replaces the copy
+	require.NoError(t, store.Copy(ctx, "buffer/a", "next/b"))
+	require.NoError(t, store.Delete(ctx, "buffer/a"))
+	require.NoError(t, store.Delete(ctx, "buffer/a"))
+	_, err = store.Get(ctx, "buffer/a")
+	assert.ErrorIs(t, err, fs.ErrNotExist)
+	assert.ErrorIs(t, store.Copy(ctx, "buffer/a", "next/c"), fs.ErrNotExist)
+	data, err = store.Get(ctx, "next/b")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("data"), data)
+}
+
+func TestS3Store(t *testing.T) {
+	t.Setenv("AWS_ACCESS_KEY_ID", "test-key")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "test-secret")
+	objects := make(map[string]string)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=test-key/") {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		p := r.URL.EscapedPath()
+		switch r.Method {
+		case http.MethodPut:
+			b, _ := io.ReadAll(r.Body)
+			objects[p] = string(b)
+		case http.MethodGet:
+			v, ok := objects[p]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = w.Write([]byte(v))
+		case http.MethodDelete:
+			delete(objects, p)
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	store, err := newS3Store(ctx, &dfv1.ClaimCheckS3{Bucket: "my-bucket", Region: "us-west-2", EndpointURL: server.URL, Prefix: "/payloads/"})
+	require.NoError(t, err)
+	require.NoError(t, store.Put(ctx, "buffer/simple-pipeline-0-0-0", []byte("data")))
+	assert.Equal(t, "data", objects["/my-bucket/payloads/buffer/simple-pipeline-0-0-0"])
+	data, err := store.Get(ctx, "buffer/simple-pipeline-0-0-0")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("data"), data)
+	require.NoError(t, store.Delete(ctx, "buffer/simple-pipeline-0-0-0"))
+	assert.Empty(t, objects)
+	_, err = store.Get(ctx, "buffer/simple-pipeline-0-0-0")
+	assert.Error(t, err)
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package claimcheck
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const (
+	labelBuffer    = "buffer"
+	labelOperation = "operation"
+)
+
+// claimCheckStoredPayloads is the total number of payloads stored out of the buffer
+var claimCheckStoredPayloads = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "isb_claim_check",
+	Name:      "stored_payloads_total",
+	Help:      "Total number of payloads stored out of the buffer",
+}, []string{labelBuffer})
+
+// claimCheckStoredBytes is the total size of the payloads stored out of the buffer
+var claimCheckStoredBytes = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "isb_claim_check",
+	Name:      "stored_bytes_total",
+	Help:      "Total size of the payloads stored out of the buffer",
+}, []string{labelBuffer})
+
+// claimCheckCopiedPayloads is the total number of payloads stored by copying the objects of the payloads read
+var claimCheckCopiedPayloads = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "isb_claim_check",
+	Name:      "copied_payloads_total",
+	Help:      "Total number of payloads stored by copying the objects of the payloads read",
+}, []string{labelBuffer})
+
+// claimCheckLoadedPayloads is the total number of stored payloads loaded back when the messages are read
+var claimCheckLoadedPayloads = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "isb_claim_check",
+	Name:      "loaded_payloads_total",
+	Help:      "Total number of stored payloads loaded back when the messages are read",
+}, []string{labelBuffer})
+
+// claimCheckErrors is the total number of failed operations of the store
+var claimCheckErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "isb_claim_check",
+	Name:      "errors_total",
+	Help:      "Total number of failed put, copy, get and delete operations of the store",
+}, []string{labelBuffer, labelOperation})
+
+// claimCheckDroppedMessages is the total number of messages dropped because their stored payloads are missing, when
+// there's no dead-letter buffer
+var claimCheckDroppedMessages = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "isb_claim_check",
+	Name:      "dropped_messages_total",
+	Help:      "Total number of messages dropped because their stored payloads are missing",
+}, []string{labelBuffer})
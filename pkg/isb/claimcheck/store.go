@@ -0,0 +1,137 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package claimcheck
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	s3client "github.com/numaproj/numaflow/pkg/shared/clients/s3"
+)
+
+// Store is where the oversized payloads are stored, it is safe for concurrent use. The errors of the payloads which
+// do not exist wrap fs.ErrNotExist.
+type Store interface {
+	// Put durably writes a payload, the payload is either fully written or not visible at all.
+	Put(ctx context.Context, key string, data []byte) error
+	// Copy stores the payload of a key with another key, without transferring the payload if the store supports it.
+	Copy(ctx context.Context, srcKey, dstKey string) error
+	// Get reads a payload.
+	Get(ctx context.Context, key string) ([]byte, error)
+	// Delete deletes a payload, it does not fail if the payload does not exist.
+	Delete(ctx context.Context, key string) error
+}
+
+// NewStore returns the store of the claim check settings.
+func NewStore(ctx context.Context, claimCheck *dfv1.ClaimCheck) (Store, error) {
+	switch {
+	case claimCheck.S3 != nil:
+		return newS3Store(ctx, claimCheck.S3)
+	case claimCheck.PersistentVolumeClaim != nil:
+		return newVolumeStore(dfv1.PathClaimCheckMount), nil
+	default:
+		return nil, fmt.Errorf("no claim check store is specified")
+	}
+}
+
+// volumeStore stores the payloads as files in a directory of a shared volume.
+type volumeStore struct {
+	dir string
+}
+
+func newVolumeStore(dir string) *volumeStore {
+	return &volumeStore{dir: dir}
+}
+
+// Put writes the payload to a temporary file, syncs it, and renames it to the key.
+func (s *volumeStore) Put(_ context.Context, key string, data []byte) error {
+	filePath := filepath.Join(s.dir, filepath.FromSlash(key))
+	dir := filepath.Dir(filePath)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("failed to create directory %q, %w", dir, err)
+	}
+	tmp, err := os.CreateTemp(dir, "."+filepath.Base(filePath)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary file, %w", err)
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("failed to write file %q, %w", tmp.Name(), err)
+	}
+	if err := tmp.Sync(); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("failed to sync file %q, %w", tmp.Name(), err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to close file %q, %w", tmp.Name(), err)
+	}
+	if err := os.Rename(tmp.Name(), filePath); err != nil {
+		return fmt.Errorf("failed to rename file %q, %w", tmp.Name(), err)
+	}
+	return nil
+}
+
+// Copy hard links the file of the payload to a temporary file, and renames it to the key.
+func (s *volumeStore) Copy(_ context.Context, srcKey, dstKey string) error {
+	filePath := filepath.Join(s.dir, filepath.FromSlash(dstKey))
+	dir := filepath.Dir(filePath)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("failed to create directory %q, %w", dir, err)
+	}
+	tmp, err := os.CreateTemp(dir, "."+filepath.Base(filePath)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary file, %w", err)
+	}
+	_ = tmp.Close()
+	_ = os.Remove(tmp.Name())
+	if err := os.Link(filepath.Join(s.dir, filepath.FromSlash(srcKey)), tmp.Name()); err != nil {
+		return fmt.Errorf("failed to copy payload %q, %w", srcKey, err)
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+	if err := os.Rename(tmp.Name(), filePath); err != nil {
+		return fmt.Errorf("failed to rename file %q, %w", tmp.Name(), err)
+	}
+	return nil
+}
+
+func (s *volumeStore) Get(_ context.Context, key string) ([]byte, error) {
+	data, err := os.ReadFile(filepath.Join(s.dir, filepath.FromSlash(key)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read payload %q, %w", key, err)
+	}
+	return data, nil
+}
+
+func (s *volumeStore) Delete(_ context.Context, key string) error {
+	if err := os.Remove(filepath.Join(s.dir, filepath.FromSlash(key))); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to delete payload %q, %w", key, err)
+	}
+	return nil
+}
+
+// newS3Store returns a store keeping the payloads as objects in a bucket of an S3 compatible object store.
+func newS3Store(ctx context.Context, s3 *dfv1.ClaimCheckS3) (Store, error) {
+	client, err := s3client.NewClient(ctx, s3.Region, s3.EndpointURL)
+	if err != nil {
+		return nil, err
+	}
+	return s3client.NewBucket(client, s3.Bucket, s3.Prefix), nil
+}
@@ -0,0 +1,195 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package isb
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+)
+
+// Compression is the codec used to compress the payload of a message stored in the buffer.
+type Compression int16
+
+const (
+	CompressionNone   Compression = iota // Uncompressed payload
+	CompressionSnappy                    // Snappy block format
+	CompressionZstd                      // Zstandard frame
+	CompressionGzip                      // Gzip stream
+)
+
+func (c Compression) String() string {
+	switch c {
+	case CompressionNone:
+		return string(dfv1.CompressionTypeNone)
+	case CompressionSnappy:
+		return string(dfv1.CompressionTypeSnappy)
+	case CompressionZstd:
+		return string(dfv1.CompressionTypeZstd)
+	case CompressionGzip:
+		return string(dfv1.CompressionTypeGzip)
+	default:
+		return "unknown"
+	}
+}
+
+// compressionOf returns the Compression of the given compression type.
+func compressionOf(t dfv1.CompressionType) (Compression, error) {
+	switch t {
+	case dfv1.CompressionTypeNone:
+		return CompressionNone, nil
+	case dfv1.CompressionTypeSnappy:
+		return CompressionSnappy, nil
+	case dfv1.CompressionTypeZstd:
+		return CompressionZstd, nil
+	case dfv1.CompressionTypeGzip:
+		return CompressionGzip, nil
+	default:
+		return CompressionNone, fmt.Errorf("unsupported compression type %q", t)
+	}
+}
+
+var (
+	// the zstd encoder and decoder are safe for concurrent use with EncodeAll and DecodeAll
+	zstdEncoder = sync.OnceValues(func() (*zstd.Encoder, error) { return zstd.NewWriter(nil) })
+	zstdDecoder = sync.OnceValues(func() (*zstd.Decoder, error) { return zstd.NewReader(nil) })
+	gzipWriters = sync.Pool{New: func() any { return gzip.NewWriter(nil) }}
+)
+
+func compress(c Compression, payload []byte) ([]byte, error) {
+	switch c {
+	case CompressionNone:
+		return payload, nil
+	case CompressionSnappy:
+		return snappy.Encode(nil, payload), nil
+	case CompressionZstd:
+		encoder, err := zstdEncoder()
+		if err != nil {
+			return nil, err
+		}
+		return encoder.EncodeAll(payload, nil), nil
+	case CompressionGzip:
+		var buf bytes.Buffer
+		w := gzipWriters.Get().(*gzip.Writer)
+		defer gzipWriters.Put(w)
+		w.Reset(&buf)
+		if _, err := w.Write(payload); err != nil {
+			return nil, err
+		}
+		if err := w.Close(); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	default:
+		return nil, fmt.Errorf("unsupported compression %d", c)
+	}
+}
+
+func decompress(c Compression, payload []byte) ([]byte, error) {
+	switch c {
+	case CompressionNone:
+		return payload, nil
+	case CompressionSnappy:
+		return snappy.Decode(nil, payload)
+	case CompressionZstd:
+		decoder, err := zstdDecoder()
+		if err != nil {
+			return nil, err
+		}
+		return decoder.DecodeAll(payload, nil)
+	case CompressionGzip:
+		r, err := gzip.NewReader(bytes.NewReader(payload))
+		if err != nil {
+			return nil, err
+		}
+		defer func() { _ = r.Close() }()
+		return io.ReadAll(r)
+	default:
+		return nil, fmt.Errorf("unsupported compression %d", c)
+	}
+}
+
+// Decompress decompresses the payload of the message according to the codec in the header, and resets the codec.
+// It does nothing for an uncompressed message.
+func (m *Message) Decompress() error {
+	if m.Header.Compression == CompressionNone {
+		return nil
+	}
+	payload, err := decompress(m.Header.Compression, m.Body.Payload)
+	if err != nil {
+		return fmt.Errorf("failed to decompress the %s payload of message %s, %w", m.Header.Compression, m.Header.ID, err)
+	}
+	m.Body.Payload = payload
+	m.Header.Compression = CompressionNone
+	return nil
+}
+
+// Compressor compresses the payloads of the messages written to a buffer, it is safe for concurrent use.
+// A nil Compressor leaves the messages uncompressed.
+type Compressor struct {
+	buffer          string
+	compression     Compression
+	minPayloadBytes int
+}
+
+// NewCompressor returns a Compressor of the buffer with the given compression settings,
+// or nil if the payloads are not to be compressed.
+func NewCompressor(buffer string, compression *dfv1.Compression) (*Compressor, error) {
+	c, err := compressionOf(compression.GetType())
+	if err != nil {
+		return nil, err
+	}
+	if c == CompressionNone {
+		return nil, nil
+	}
+	return &Compressor{buffer: buffer, compression: c, minPayloadBytes: compression.GetMinPayloadBytes()}, nil
+}
+
+// Compress returns the message with the payload compressed and the codec recorded in the header.
+// The message is returned as is if the payload is smaller than the minimal size, or compressing it
+// does not save any space.
+func (c *Compressor) Compress(m Message) (Message, error) {
+	if c == nil || m.Header.Kind != Data {
+		return m, nil
+	}
+	labels := map[string]string{labelBuffer: c.buffer, labelCodec: c.compression.String()}
+	compressionInputBytes.With(labels).Add(float64(len(m.Body.Payload)))
+	if len(m.Body.Payload) < c.minPayloadBytes || len(m.Body.Payload) == 0 {
+		compressionOutputBytes.With(labels).Add(float64(len(m.Body.Payload)))
+		return m, nil
+	}
+	payload, err := compress(c.compression, m.Body.Payload)
+	if err != nil {
+		return m, fmt.Errorf("failed to compress the payload of message %s with %s, %w", m.Header.ID, c.compression, err)
+	}
+	if len(payload) >= len(m.Body.Payload) {
+		compressionOutputBytes.With(labels).Add(float64(len(m.Body.Payload)))
+		return m, nil
+	}
+	compressionOutputBytes.With(labels).Add(float64(len(payload)))
+	compressionRatio.With(labels).Observe(float64(len(m.Body.Payload)) / float64(len(payload)))
+	m.Body.Payload = payload
+	m.Header.Compression = c.compression
+	return m, nil
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package isb
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+)
+
+func testCompressionMessage(payload []byte) Message {
+	return Message{
+		Header: Header{
+			MessageInfo: MessageInfo{EventTime: time.UnixMilli(1676617200000)},
+			Kind:        Data,
+			ID:          MessageID{VertexName: "test-vertex", Offset: "1", Index: 0},
+			Keys:        []string{"key"},
+		},
+		Body: Body{Payload: payload},
+	}
+}
+
+func TestCompressor(t *testing.T) {
+	payload := bytes.Repeat([]byte(`{"name":"numaflow","value":12345}`), 100)
+
+	for _, ct := range []dfv1.CompressionType{dfv1.CompressionTypeSnappy, dfv1.CompressionTypeZstd, dfv1.CompressionTypeGzip} {
+		t.Run(string(ct), func(t *testing.T) {
+			c, err := NewCompressor("test-buffer", &dfv1.Compression{Type: ct})
+			require.NoError(t, err)
+			m, err := c.Compress(testCompressionMessage(payload))
+			require.NoError(t, err)
+			assert.Equal(t, ct, dfv1.CompressionType(m.Header.Compression.String()))
+			assert.Less(t, len(m.Body.Payload), len(payload))
+
+			data, err := m.MarshalBinary()
+			require.NoError(t, err)
+			var got Message
+			require.NoError(t, got.UnmarshalBinary(data))
+			assert.Equal(t, CompressionNone, got.Header.Compression)
+			assert.Equal(t, payload, got.Body.Payload)
+		})
+	}
+
+	t.Run("none", func(t *testing.T) {
+		c, err := NewCompressor("test-buffer", &dfv1.Compression{Type: dfv1.CompressionTypeNone})
+		assert.NoError(t, err)
+		assert.Nil(t, c)
+		m, err := c.Compress(testCompressionMessage(payload))
+		assert.NoError(t, err)
+		assert.Equal(t, CompressionNone, m.Header.Compression)
+		assert.Equal(t, payload, m.Body.Payload)
+	})
+
+	t.Run("small payload", func(t *testing.T) {
+		c, err := NewCompressor("test-buffer", &dfv1.Compression{Type: dfv1.CompressionTypeZstd})
+		require.NoError(t, err)
+		m, err := c.Compress(testCompressionMessage([]byte("small")))
+		assert.NoError(t, err)
+		assert.Equal(t, CompressionNone, m.Header.Compression)
+		assert.Equal(t, []byte("small"), m.Body.Payload)
+	})
+
+	t.Run("unsupported", func(t *testing.T) {
+		_, err := NewCompressor("test-buffer", &dfv1.Compression{Type: "lz4"})
+		assert.Error(t, err)
+	})
+}
+
+func TestMessage_DecompressUncompressed(t *testing.T) {
+	m := testCompressionMessage([]byte("uncompressed"))
+	data, err := m.MarshalBinary()
+	require.NoError(t, err)
+	var got Message
+	require.NoError(t, got.UnmarshalBinary(data))
+	assert.Equal(t, []byte("uncompressed"), got.Body.Payload)
+}
@@ -73,6 +73,9 @@ type Header struct {
 	// http headers and Numaflow platform headers like tracing headers etc.
 	//TODO: can we rename this? Gets confusing for understanding headers under header
 	Headers map[string]string
+	// Compression is the codec of the payload when the message is stored in the buffer. It is always CompressionNone
+	// for the messages being processed, because the writers compress and the readers decompress the payloads.
+	Compression Compression
 }
 
 // MessageID is the message ID of the message which is used for exactly-once-semantics.
@@ -0,0 +1,50 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package isb
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const (
+	labelBuffer = "buffer"
+	labelCodec  = "codec"
+)
+
+// compressionInputBytes is the total size of the payloads before compression
+var compressionInputBytes = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "isb_compression",
+	Name:      "input_bytes_total",
+	Help:      "Total size of the payloads before compression",
+}, []string{labelBuffer, labelCodec})
+
+// compressionOutputBytes is the total size of the payloads written to the buffer after compression,
+// including the payloads left uncompressed
+var compressionOutputBytes = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "isb_compression",
+	Name:      "output_bytes_total",
+	Help:      "Total size of the payloads after compression, including the ones left uncompressed",
+}, []string{labelBuffer, labelCodec})
+
+// compressionRatio is the ratio of the original size to the compressed size of the compressed payloads
+var compressionRatio = promauto.NewHistogramVec(prometheus.HistogramOpts{
+	Subsystem: "isb_compression",
+	Name:      "ratio",
+	Help:      "Ratio of the original size to the compressed size of the compressed payloads",
+	Buckets:   []float64{1.1, 1.5, 2, 3, 4, 6, 8, 12, 16, 24, 32},
+}, []string{labelBuffer, labelCodec})
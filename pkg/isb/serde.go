@@ -21,8 +21,9 @@ func (m Message) MarshalBinary() ([]byte, error) {
 				Offset:     m.Header.ID.Offset,
 				Index:      m.Header.ID.Index,
 			},
-			Keys:    m.Header.Keys,
-			Headers: m.Header.Headers,
+			Keys:        m.Header.Keys,
+			Headers:     m.Header.Headers,
+			Compression: isb.Compression(m.Header.Compression),
 		},
 		Body: &isb.Body{
 			Payload: m.Body.Payload,
@@ -55,13 +56,15 @@ func (m *Message) UnmarshalBinary(data []byte) error {
 			Offset:     pb.Header.Id.Offset,
 			Index:      pb.Header.Id.Index,
 		},
-		Keys:    pb.Header.Keys,
-		Headers: pb.Header.Headers,
+		Keys:        pb.Header.Keys,
+		Headers:     pb.Header.Headers,
+		Compression: Compression(pb.Header.Compression),
 	}
 
 	m.Body.Payload = pb.Body.Payload
 
-	return nil
+	// the payload is decompressed transparently, the messages written without compression are left as is.
+	return m.Decompress()
 }
 
 // MarshalBinary encodes Header to proto bytes.
@@ -71,10 +74,11 @@ func (h Header) MarshalBinary() ([]byte, error) {
 			EventTime: timestamppb.New(h.MessageInfo.EventTime),
 			IsLate:    h.MessageInfo.IsLate,
 		},
-		Kind:    isb.MessageKind(h.Kind),
-		Id:      &isb.MessageID{VertexName: h.ID.VertexName, Offset: h.ID.Offset, Index: h.ID.Index},
-		Keys:    h.Keys,
-		Headers: h.Headers,
+		Kind:        isb.MessageKind(h.Kind),
+		Id:          &isb.MessageID{VertexName: h.ID.VertexName, Offset: h.ID.Offset, Index: h.ID.Index},
+		Keys:        h.Keys,
+		Headers:     h.Headers,
+		Compression: isb.Compression(h.Compression),
 	}
 	return proto.Marshal(pb)
 }
@@ -105,6 +109,7 @@ func (h *Header) UnmarshalBinary(data []byte) error {
 
 	h.Keys = pb.Keys
 	h.Headers = pb.Headers
+	h.Compression = Compression(pb.Compression)
 
 	return nil
 }
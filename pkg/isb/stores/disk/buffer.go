@@ -0,0 +1,160 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package disk implements the inter-step buffer with segmented log files on a volume shared by all the pods
+// of a pipeline. Each buffer is a directory with the segment files and a state file, the state file keeps
+// the write position and the acknowledgements, and it is guarded by a file lock, so that the readers and
+// writers in different pods can share the buffer.
+package disk
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/numaproj/numaflow/pkg/isb"
+)
+
+// BufferDir returns the directory of the buffer under the mount path of the disk ISB Service volume.
+func BufferDir(rootDir, name string) string {
+	return filepath.Join(rootDir, buffersDir, name)
+}
+
+// CreateBuffer creates the buffer if it does not exist yet.
+func CreateBuffer(rootDir, name string) error {
+	dir := BufferDir(rootDir, name)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("failed to create the directory of buffer %q, %w", name, err)
+	}
+	fp, err := os.OpenFile(filepath.Join(dir, lockFileName), os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to create the lock file of buffer %q, %w", name, err)
+	}
+	_ = fp.Close()
+	lock, err := newBufferLock(dir, 0)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = lock.close() }()
+	// the state is initialized if it doesn't exist
+	return lock.update(func(*state) error { return nil })
+}
+
+// DeleteBuffer deletes the buffer and all of its messages.
+func DeleteBuffer(rootDir, name string) error {
+	return os.RemoveAll(BufferDir(rootDir, name))
+}
+
+// ValidateBuffer checks if the buffer exists.
+func ValidateBuffer(rootDir, name string) error {
+	dir := BufferDir(rootDir, name)
+	if _, err := os.Stat(filepath.Join(dir, stateFileName)); err != nil {
+		return fmt.Errorf("failed to query buffer %q, %w", name, err)
+	}
+	return nil
+}
+
+// BufferInfo is the state information of a buffer.
+type BufferInfo struct {
+	// Pending is the number of messages not acknowledged yet
+	Pending int64
+	// AckPending is the number of messages delivered but not acknowledged yet
+	AckPending int64
+	// Total is the number of messages in the segment files, including the acknowledged ones
+	Total int64
+}
+
+// GetBufferInfo returns the state information of the buffer.
+func GetBufferInfo(rootDir, name string) (*BufferInfo, error) {
+	var info *BufferInfo
+	err := withState(rootDir, name, func(s *state) error {
+		info = &BufferInfo{
+			Pending:    s.pending(),
+			AckPending: int64(len(s.Inflight)),
+			Total:      s.LastSeq - s.Segments[0] + 1,
+		}
+		return nil
+	})
+	return info, err
+}
+
+// StoredMessage is a message in a buffer and its sequence.
+type StoredMessage struct {
+	Seq     int64
+	Message *isb.Message
+}
+
+// ListMessages returns at most limit messages not acknowledged yet, starting from the given sequence.
+func ListMessages(rootDir, name string, fromSeq int64, limit int) ([]*StoredMessage, error) {
+	var segments, seqs []int64
+	err := withState(rootDir, name, func(s *state) error {
+		for seq := max(fromSeq, s.AckFloor+1); seq <= s.LastSeq && len(seqs) < limit; seq++ {
+			if !s.isAcked(seq) {
+				seqs = append(seqs, seq)
+			}
+		}
+		segments = s.Segments
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	r := newSegmentReader(BufferDir(rootDir, name))
+	defer r.close()
+	result := make([]*StoredMessage, 0, len(seqs))
+	for _, seq := range seqs {
+		msg, err := r.read(segments, seq)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, &StoredMessage{Seq: seq, Message: msg})
+	}
+	return result, nil
+}
+
+// ErrMessageNotFound is returned when the message does not exist or has been acknowledged.
+var ErrMessageNotFound = errors.New("message not found")
+
+// GetMessage returns the message of the given sequence if it has not been acknowledged.
+func GetMessage(rootDir, name string, seq int64) (*isb.Message, error) {
+	messages, err := ListMessages(rootDir, name, seq, 1)
+	if err != nil {
+		return nil, err
+	}
+	if len(messages) == 0 || messages[0].Seq != seq {
+		return nil, fmt.Errorf("message %d of buffer %q, %w", seq, name, ErrMessageNotFound)
+	}
+	return messages[0].Message, nil
+}
+
+// RemoveMessage acknowledges the message of the given sequence, so that it will not be delivered.
+func RemoveMessage(rootDir, name string, seq int64) error {
+	return withState(rootDir, name, func(s *state) error {
+		s.ack(seq)
+		return nil
+	})
+}
+
+// withState calls fn with the state of the buffer, the changes fn makes are saved unless it returns an error.
+func withState(rootDir, name string, fn func(s *state) error) error {
+	lock, err := newBufferLock(BufferDir(rootDir, name), 0)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = lock.close() }()
+	return lock.update(fn)
+}
@@ -0,0 +1,324 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package disk
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/isb/testutils"
+)
+
+func offsetsOf(messages []*isb.ReadMessage) []isb.Offset {
+	var result []isb.Offset
+	for _, m := range messages {
+		result = append(result, m.ReadOffset)
+	}
+	return result
+}
+
+func newTestBuffer(t *testing.T) string {
+	dir := t.TempDir()
+	require.NoError(t, CreateBuffer(dir, "test-buffer"))
+	// creating an existing buffer is a no-op
+	require.NoError(t, CreateBuffer(dir, "test-buffer"))
+	require.NoError(t, ValidateBuffer(dir, "test-buffer"))
+	return dir
+}
+
+func TestDiskBuffer_WriteReadAck(t *testing.T) {
+	ctx := context.Background()
+	dir := newTestBuffer(t)
+	writer, err := NewDiskBufferWriter(ctx, dir, "test-buffer", 0)
+	require.NoError(t, err)
+	defer func() { _ = writer.Close() }()
+	reader, err := NewDiskBufferReader(ctx, dir, "test-buffer", "test-consumer", 0, WithReadTimeOut(100*time.Millisecond))
+	require.NoError(t, err)
+	defer func() { _ = reader.Close() }()
+
+	writeMessages := testutils.BuildTestWriteMessages(10, time.Unix(1636470000, 0), []string{"k"}, "test-vertex")
+	offsets, errs := writer.Write(ctx, writeMessages)
+	for i := range errs {
+		assert.NoError(t, errs[i])
+		assert.Equal(t, int64(i+1), mustSequence(t, offsets[i]))
+	}
+
+	readMessages, err := reader.Read(ctx, 4)
+	require.NoError(t, err)
+	require.Len(t, readMessages, 4)
+	for i, m := range readMessages {
+		assert.Equal(t, writeMessages[i].ID, m.ID)
+		assert.Equal(t, writeMessages[i].Payload, m.Payload)
+		assert.Equal(t, uint64(1), m.Metadata.NumDelivered)
+	}
+	pending, err := reader.Pending(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(10), pending)
+
+	for _, err := range reader.Ack(ctx, offsetsOf(readMessages)) {
+		assert.NoError(t, err)
+	}
+	pending, _ = reader.Pending(ctx)
+	assert.Equal(t, int64(6), pending)
+
+	readMessages, err = reader.Read(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, readMessages, 6)
+	assert.Equal(t, writeMessages[4].ID, readMessages[0].ID)
+	reader.Ack(ctx, offsetsOf(readMessages))
+	pending, _ = reader.Pending(ctx)
+	assert.Equal(t, int64(0), pending)
+
+	// nothing to read before the timeout
+	readMessages, err = reader.Read(ctx, 10)
+	require.NoError(t, err)
+	assert.Empty(t, readMessages)
+}
+
+func mustSequence(t *testing.T, o isb.Offset) int64 {
+	seq, err := o.Sequence()
+	require.NoError(t, err)
+	return seq
+}
+
+func TestDiskBuffer_Redelivery(t *testing.T) {
+	ctx := context.Background()
+	dir := newTestBuffer(t)
+	writer, err := NewDiskBufferWriter(ctx, dir, "test-buffer", 0)
+	require.NoError(t, err)
+	defer func() { _ = writer.Close() }()
+	writer.Write(ctx, testutils.BuildTestWriteMessages(6, time.Unix(1636470000, 0), nil, "test-vertex"))
+
+	crashedCtx, crash := context.WithCancel(ctx)
+	r1, err := NewDiskBufferReader(crashedCtx, dir, "test-buffer", "c1", 0, WithReadTimeOut(100*time.Millisecond), WithConsumerTimeout(time.Second))
+	require.NoError(t, err)
+	r2, err := NewDiskBufferReader(ctx, dir, "test-buffer", "c2", 0, WithReadTimeOut(100*time.Millisecond), WithConsumerTimeout(time.Second))
+	require.NoError(t, err)
+	defer func() { _ = r2.Close() }()
+
+	// competing readers get different messages
+	m1, _ := r1.Read(ctx, 2)
+	m2, _ := r2.Read(ctx, 2)
+	require.Len(t, m1, 2)
+	require.Len(t, m2, 2)
+	assert.Equal(t, int64(1), mustSequence(t, m1[0].ReadOffset))
+	assert.Equal(t, int64(3), mustSequence(t, m2[0].ReadOffset))
+
+	// no-acked messages are redelivered first
+	r2.NoAck(ctx, offsetsOf(m2[:1]))
+	m2, _ = r2.Read(ctx, 2)
+	require.Len(t, m2, 2)
+	assert.Equal(t, int64(3), mustSequence(t, m2[0].ReadOffset))
+	assert.Equal(t, uint64(2), m2[0].Metadata.NumDelivered)
+	assert.Equal(t, int64(5), mustSequence(t, m2[1].ReadOffset))
+	r2.Ack(ctx, offsetsOf(m2))
+
+	// the in-flight messages of a restarted reader are redelivered
+	crash()
+	<-r1.(*diskReader).heartbeatDone
+	restartedCtx, stopRestarted := context.WithCancel(ctx)
+	restarted, err := NewDiskBufferReader(restartedCtx, dir, "test-buffer", "c1", 0, WithReadTimeOut(100*time.Millisecond), WithConsumerTimeout(time.Second))
+	require.NoError(t, err)
+	m1, _ = restarted.Read(ctx, 10)
+	require.Len(t, m1, 3)
+	assert.Equal(t, []int64{1, 2, 6}, []int64{mustSequence(t, m1[0].ReadOffset), mustSequence(t, m1[1].ReadOffset), mustSequence(t, m1[2].ReadOffset)})
+
+	// the in-flight messages of a live reader are kept past the consumer timeout
+	time.Sleep(1500 * time.Millisecond)
+	m2, _ = r2.Read(ctx, 10)
+	assert.Empty(t, m2)
+
+	// the in-flight messages of a dead reader are redelivered to the others after the consumer timeout,
+	// the heartbeat of the reader stops with its context, as if its process was gone
+	stopRestarted()
+	<-restarted.(*diskReader).heartbeatDone
+	time.Sleep(1100 * time.Millisecond)
+	m2, _ = r2.Read(ctx, 10)
+	assert.Len(t, m2, 3)
+	r2.Ack(ctx, offsetsOf(m2))
+	pending, _ := r2.Pending(ctx)
+	assert.Equal(t, int64(1), pending)
+}
+
+func TestDiskBuffer_StateJournal(t *testing.T) {
+	dir := newTestBuffer(t)
+	bufferDir := BufferDir(dir, "test-buffer")
+	l1, err := newBufferLock(bufferDir, 0)
+	require.NoError(t, err)
+	defer func() { _ = l1.close() }()
+	l2, err := newBufferLock(bufferDir, 0)
+	require.NoError(t, err)
+	defer func() { _ = l2.close() }()
+
+	require.NoError(t, l1.update(func(s *state) error {
+		s.appended(10, 100)
+		return nil
+	}))
+	var seqs []int64
+	require.NoError(t, l2.update(func(s *state) error {
+		// the changes made with the other lock are replayed from the journal
+		assert.Equal(t, int64(10), s.LastSeq)
+		seqs, _ = s.claim("c1", 4)
+		return nil
+	}))
+	require.Len(t, seqs, 4)
+	require.NoError(t, l1.update(func(s *state) error {
+		s.ack(seqs[0])
+		s.ack(seqs[1])
+		return nil
+	}))
+	// a failed update is discarded
+	require.Error(t, l1.update(func(s *state) error {
+		s.ack(seqs[2])
+		return assert.AnError
+	}))
+
+	// compacting the journal keeps the state
+	require.NoError(t, l1.update(func(s *state) error {
+		return nil
+	}))
+	require.NoError(t, l1.compact())
+	_, err = os.Stat(filepath.Join(bufferDir, journalFileName))
+	require.NoError(t, err)
+	info, err := GetBufferInfo(dir, "test-buffer")
+	require.NoError(t, err)
+	assert.Equal(t, &BufferInfo{Pending: 8, AckPending: 2, Total: 10}, info)
+	require.NoError(t, l2.update(func(s *state) error {
+		s.ack(seqs[2])
+		return nil
+	}))
+	info, err = GetBufferInfo(dir, "test-buffer")
+	require.NoError(t, err)
+	assert.Equal(t, &BufferInfo{Pending: 7, AckPending: 1, Total: 10}, info)
+}
+
+func TestDiskBuffer_Full(t *testing.T) {
+	ctx := context.Background()
+	dir := newTestBuffer(t)
+	messages := testutils.BuildTestWriteMessages(5, time.Unix(1636470000, 0), nil, "test-vertex")
+
+	writer, err := NewDiskBufferWriter(ctx, dir, "test-buffer", 0, WithMaxLength(5), WithBufferUsageLimit(0.8))
+	require.NoError(t, err)
+	defer func() { _ = writer.Close() }()
+	_, errs := writer.Write(ctx, messages)
+	for _, err := range errs {
+		assert.NoError(t, err)
+	}
+	_, errs = writer.Write(ctx, messages[:1])
+	assert.Equal(t, isb.BufferWriteErr{Name: "test-buffer", Full: true, Message: isb.BufferFullMessage}, errs[0])
+
+	discardWriter, err := NewDiskBufferWriter(ctx, dir, "test-buffer", 0, WithMaxLength(5), WithBufferFullWritingStrategy(dfv1.DiscardLatest))
+	require.NoError(t, err)
+	defer func() { _ = discardWriter.Close() }()
+	_, errs = discardWriter.Write(ctx, messages[:1])
+	assert.Equal(t, isb.NonRetryableBufferWriteErr{Name: "test-buffer", Message: isb.BufferFullMessage}, errs[0])
+}
+
+func TestDiskBuffer_Segments(t *testing.T) {
+	ctx := context.Background()
+	dir := newTestBuffer(t)
+	writer, err := NewDiskBufferWriter(ctx, dir, "test-buffer", 0, WithMaxSegmentBytes(1))
+	require.NoError(t, err)
+	defer func() { _ = writer.Close() }()
+	reader, err := NewDiskBufferReader(ctx, dir, "test-buffer", "c1", 0, WithReadTimeOut(100*time.Millisecond))
+	require.NoError(t, err)
+	defer func() { _ = reader.Close() }()
+
+	// every write starts a new segment as the max segment size is 1 byte
+	for i := 0; i < 3; i++ {
+		writer.Write(ctx, testutils.BuildTestWriteMessages(2, time.Unix(1636470000, 0), nil, "test-vertex"))
+	}
+	files, _ := filepath.Glob(filepath.Join(BufferDir(dir, "test-buffer"), segmentPrefix+"-*"))
+	assert.Len(t, files, 3)
+
+	messages, err := reader.Read(ctx, 6)
+	require.NoError(t, err)
+	require.Len(t, messages, 6)
+	reader.Ack(ctx, offsetsOf(messages[:3]))
+	files, _ = filepath.Glob(filepath.Join(BufferDir(dir, "test-buffer"), segmentPrefix+"-*"))
+	assert.Len(t, files, 2)
+	reader.Ack(ctx, offsetsOf(messages[3:]))
+	files, _ = filepath.Glob(filepath.Join(BufferDir(dir, "test-buffer"), segmentPrefix+"-*"))
+	// the last segment is kept for writing
+	assert.Equal(t, []string{filepath.Join(BufferDir(dir, "test-buffer"), segmentFileName(5))}, files)
+
+	info, err := GetBufferInfo(dir, "test-buffer")
+	require.NoError(t, err)
+	assert.Equal(t, &BufferInfo{Pending: 0, AckPending: 0, Total: 2}, info)
+}
+
+func TestDiskBuffer_Corruption(t *testing.T) {
+	ctx := context.Background()
+	dir := newTestBuffer(t)
+	writer, err := NewDiskBufferWriter(ctx, dir, "test-buffer", 0)
+	require.NoError(t, err)
+	writer.Write(ctx, testutils.BuildTestWriteMessages(1, time.Unix(1636470000, 0), nil, "test-vertex"))
+	_ = writer.Close()
+
+	segment := filepath.Join(BufferDir(dir, "test-buffer"), segmentFileName(1))
+	data, err := os.ReadFile(segment)
+	require.NoError(t, err)
+	data[len(data)-1]++
+	require.NoError(t, os.WriteFile(segment, data, 0644))
+
+	reader, err := NewDiskBufferReader(ctx, dir, "test-buffer", "c1", 0)
+	require.NoError(t, err)
+	defer func() { _ = reader.Close() }()
+	_, err = reader.Read(ctx, 1)
+	assert.ErrorContains(t, err, errChecksumMismatch.Error())
+}
+
+func TestDiskBuffer_Messages(t *testing.T) {
+	ctx := context.Background()
+	dir := newTestBuffer(t)
+	writer, err := NewDiskBufferWriter(ctx, dir, "test-buffer", 0)
+	require.NoError(t, err)
+	defer func() { _ = writer.Close() }()
+	writeMessages := testutils.BuildTestWriteMessages(3, time.Unix(1636470000, 0), nil, "test-vertex")
+	writer.Write(ctx, writeMessages)
+
+	messages, err := ListMessages(dir, "test-buffer", 0, 10)
+	require.NoError(t, err)
+	require.Len(t, messages, 3)
+	assert.Equal(t, writeMessages[1].ID, messages[1].Message.ID)
+
+	require.NoError(t, RemoveMessage(dir, "test-buffer", 2))
+	_, err = GetMessage(dir, "test-buffer", 2)
+	assert.ErrorIs(t, err, ErrMessageNotFound)
+	m, err := GetMessage(dir, "test-buffer", 3)
+	require.NoError(t, err)
+	assert.Equal(t, writeMessages[2].ID, m.ID)
+
+	// the removed message is not delivered
+	reader, err := NewDiskBufferReader(ctx, dir, "test-buffer", "c1", 0, WithReadTimeOut(100*time.Millisecond))
+	require.NoError(t, err)
+	readMessages, _ := reader.Read(ctx, 10)
+	require.Len(t, readMessages, 2)
+	assert.Equal(t, int64(3), mustSequence(t, readMessages[1].ReadOffset))
+	require.NoError(t, reader.Close())
+
+	require.NoError(t, DeleteBuffer(dir, "test-buffer"))
+	assert.Error(t, ValidateBuffer(dir, "test-buffer"))
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package disk
+
+import (
+	"os"
+	"time"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	sharedutil "github.com/numaproj/numaflow/pkg/shared/util"
+)
+
+// envSyncInterval returns the sync interval configured in the ISB Service, or the default one.
+func envSyncInterval() time.Duration {
+	if v, ok := os.LookupEnv(dfv1.EnvISBSvcDiskSyncInterval); ok {
+		if d, err := time.ParseDuration(v); err == nil {
+			return d
+		}
+	}
+	return dfv1.DefaultISBSvcDiskSyncInterval
+}
+
+// options for writing to the disk buffer
+type writeOptions struct {
+	// maxLength is the maximum length of the buffer before it reaches full
+	maxLength int64
+	// bufferUsageLimit is the limit of buffer usage before we declare it as full
+	bufferUsageLimit float64
+	// bufferFullWritingStrategy is the writing strategy when buffer is full
+	bufferFullWritingStrategy dfv1.BufferFullWritingStrategy
+	// maxSegmentBytes is the size of a segment file to start a new one
+	maxSegmentBytes int64
+	// syncInterval is the interval to sync the written segments to the disk
+	syncInterval time.Duration
+	// compression is the compression of the message payloads
+	compression *dfv1.Compression
+}
+
+func defaultWriteOptions() *writeOptions {
+	return &writeOptions{
+		maxLength:                 dfv1.DefaultBufferLength,
+		bufferUsageLimit:          dfv1.DefaultBufferUsageLimit,
+		bufferFullWritingStrategy: dfv1.RetryUntilSuccess,
+		maxSegmentBytes:           int64(sharedutil.LookupEnvIntOr(dfv1.EnvISBSvcDiskMaxSegmentBytes, dfv1.DefaultISBSvcDiskMaxSegmentBytes)),
+		syncInterval:              envSyncInterval(),
+	}
+}
+
+type WriteOption func(*writeOptions) error
+
+// WithMaxLength sets buffer max length option
+func WithMaxLength(length int64) WriteOption {
+	return func(o *writeOptions) error {
+		o.maxLength = length
+		return nil
+	}
+}
+
+// WithBufferUsageLimit sets buffer usage limit option
+func WithBufferUsageLimit(usageLimit float64) WriteOption {
+	return func(o *writeOptions) error {
+		o.bufferUsageLimit = usageLimit
+		return nil
+	}
+}
+
+// WithBufferFullWritingStrategy sets the writing strategy when buffer is full
+func WithBufferFullWritingStrategy(s dfv1.BufferFullWritingStrategy) WriteOption {
+	return func(o *writeOptions) error {
+		o.bufferFullWritingStrategy = s
+		return nil
+	}
+}
+
+// WithMaxSegmentBytes sets the size of a segment file to start a new one
+func WithMaxSegmentBytes(size int64) WriteOption {
+	return func(o *writeOptions) error {
+		o.maxSegmentBytes = size
+		return nil
+	}
+}
+
+// WithWriteSyncInterval sets the interval to sync the written segments to the disk
+func WithWriteSyncInterval(interval time.Duration) WriteOption {
+	return func(o *writeOptions) error {
+		o.syncInterval = interval
+		return nil
+	}
+}
+
+// WithCompression sets the compression of the message payloads
+func WithCompression(c *dfv1.Compression) WriteOption {
+	return func(o *writeOptions) error {
+		o.compression = c
+		return nil
+	}
+}
+
+// options for reading from the disk buffer
+type readOptions struct {
+	// readTimeOut is the timeout needed for read timeout
+	readTimeOut time.Duration
+	// pollInterval is the interval to check new messages when the buffer is empty
+	pollInterval time.Duration
+	// consumerTimeout is the time after which the in-flight messages of an inactive reader are redelivered
+	consumerTimeout time.Duration
+	// syncInterval is the interval to sync the buffer state to the disk
+	syncInterval time.Duration
+}
+
+func defaultReadOptions() *readOptions {
+	return &readOptions{
+		readTimeOut:     time.Second,
+		pollInterval:    10 * time.Millisecond,
+		consumerTimeout: dfv1.DefaultISBSvcDiskConsumerTimeout,
+		syncInterval:    envSyncInterval(),
+	}
+}
+
+type ReadOption func(*readOptions) error
+
+// WithReadTimeOut is used to set read timeout option
+func WithReadTimeOut(timeout time.Duration) ReadOption {
+	return func(o *readOptions) error {
+		o.readTimeOut = timeout
+		return nil
+	}
+}
+
+// WithPollInterval sets the interval to check new messages when the buffer is empty
+func WithPollInterval(interval time.Duration) ReadOption {
+	return func(o *readOptions) error {
+		o.pollInterval = interval
+		return nil
+	}
+}
+
+// WithConsumerTimeout sets the time after which the in-flight messages of an inactive reader are redelivered
+func WithConsumerTimeout(timeout time.Duration) ReadOption {
+	return func(o *readOptions) error {
+		o.consumerTimeout = timeout
+		return nil
+	}
+}
+
+// WithReadSyncInterval sets the interval to sync the buffer state to the disk
+func WithReadSyncInterval(interval time.Duration) ReadOption {
+	return func(o *readOptions) error {
+		o.syncInterval = interval
+		return nil
+	}
+}
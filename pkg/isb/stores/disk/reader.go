@@ -0,0 +1,244 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package disk
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+)
+
+// diskReader reads messages from the segment files of a buffer. Multiple readers, even in different processes,
+// can read from the same buffer, each message is delivered to one of them. A reader keeps itself alive with a
+// heartbeat until it's closed, the messages delivered to a reader which has not been alive for longer than the
+// consumer timeout, e.g. its process is gone, are redelivered to the others.
+type diskReader struct {
+	name         string
+	partitionIdx int32
+	dir          string
+	consumer     string
+	lock         *bufferLock
+	opts         *readOptions
+	mu           sync.Mutex
+	segments     *segmentReader
+	log          *zap.SugaredLogger
+	// stopHeartbeat stops the heartbeat of the reader.
+	stopHeartbeat context.CancelFunc
+	heartbeatDone chan struct{}
+}
+
+var _ isb.BufferReader = (*diskReader)(nil)
+
+// NewDiskBufferReader returns a reader of the buffer, rootDir is the mount path of the disk ISB Service volume,
+// and consumer is the unique name of the reader. The messages delivered to the same consumer before, but not
+// acknowledged, are redelivered.
+func NewDiskBufferReader(ctx context.Context, rootDir, name, consumer string, partitionIdx int32, opts ...ReadOption) (isb.BufferReader, error) {
+	o := defaultReadOptions()
+	for _, opt := range opts {
+		if err := opt(o); err != nil {
+			return nil, err
+		}
+	}
+	dir := BufferDir(rootDir, name)
+	lock, err := newBufferLock(dir, o.syncInterval)
+	if err != nil {
+		return nil, err
+	}
+	dr := &diskReader{
+		name:         name,
+		partitionIdx: partitionIdx,
+		dir:          dir,
+		consumer:     consumer,
+		lock:         lock,
+		opts:         o,
+		segments:     newSegmentReader(dir),
+		log:          logging.FromContext(ctx).With("bufferReader", name),
+	}
+	if err := lock.update(func(s *state) error {
+		s.releaseConsumer(consumer)
+		s.seen(consumer, time.Now())
+		return nil
+	}); err != nil {
+		_ = lock.close()
+		return nil, err
+	}
+	hctx, cancel := context.WithCancel(ctx)
+	dr.stopHeartbeat = cancel
+	dr.heartbeatDone = make(chan struct{})
+	go dr.heartbeat(hctx)
+	return dr, nil
+}
+
+// heartbeat refreshes the last seen time of the reader periodically, including when it doesn't read because the
+// messages in flight are being processed, so that they are not redelivered to the others.
+func (dr *diskReader) heartbeat(ctx context.Context) {
+	defer close(dr.heartbeatDone)
+	ticker := time.NewTicker(dr.opts.consumerTimeout / 4)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := dr.lock.update(func(s *state) error {
+				s.seen(dr.consumer, time.Now())
+				return nil
+			}); err != nil {
+				dr.log.Warnw("Failed to refresh the reader heartbeat", zap.Error(err))
+			}
+		}
+	}
+}
+
+func (dr *diskReader) GetName() string {
+	return dr.name
+}
+
+func (dr *diskReader) GetPartitionIdx() int32 {
+	return dr.partitionIdx
+}
+
+// Close releases the in-flight messages of the reader, so that they can be redelivered immediately.
+func (dr *diskReader) Close() error {
+	dr.stopHeartbeat()
+	<-dr.heartbeatDone
+	if err := dr.lock.update(func(s *state) error {
+		s.releaseConsumer(dr.consumer)
+		return nil
+	}); err != nil {
+		dr.log.Warnw("Failed to release in-flight messages", zap.Error(err))
+	}
+	dr.mu.Lock()
+	dr.segments.close()
+	dr.mu.Unlock()
+	return dr.lock.close()
+}
+
+func (dr *diskReader) Read(ctx context.Context, count int64) ([]*isb.ReadMessage, error) {
+	cctx, cancel := context.WithTimeout(ctx, dr.opts.readTimeOut)
+	defer cancel()
+	ticker := time.NewTicker(dr.opts.pollInterval)
+	defer ticker.Stop()
+	for {
+		var seqs []int64
+		var counts []uint64
+		var segments []int64
+		err := dr.lock.update(func(s *state) error {
+			s.expireConsumers(time.Now(), dr.opts.consumerTimeout)
+			seqs, counts = s.claim(dr.consumer, count)
+			segments = append(segments, s.Segments...)
+			return nil
+		})
+		if err != nil {
+			return nil, isb.BufferReadErr{Name: dr.name, Message: err.Error()}
+		}
+		if len(seqs) > 0 {
+			return dr.readMessages(segments, seqs, counts)
+		}
+		select {
+		case <-cctx.Done():
+			return []*isb.ReadMessage{}, nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (dr *diskReader) readMessages(segments, seqs []int64, counts []uint64) ([]*isb.ReadMessage, error) {
+	dr.mu.Lock()
+	defer dr.mu.Unlock()
+	result := make([]*isb.ReadMessage, 0, len(seqs))
+	for i, seq := range seqs {
+		msg, err := dr.segments.read(segments, seq)
+		if err != nil {
+			return result, isb.MessageReadErr{Name: dr.name, Message: err.Error()}
+		}
+		result = append(result, &isb.ReadMessage{
+			Message:    *msg,
+			ReadOffset: isb.NewSimpleIntPartitionOffset(seq, dr.partitionIdx),
+			Metadata:   isb.MessageMetadata{NumDelivered: counts[i]},
+		})
+	}
+	return result, nil
+}
+
+// Ack acknowledges the offsets, and deletes the segment files of which all the messages have been acknowledged.
+func (dr *diskReader) Ack(_ context.Context, offsets []isb.Offset) []error {
+	errs := make([]error, len(offsets))
+	var segments []int64
+	err := dr.lock.update(func(s *state) error {
+		for i, o := range offsets {
+			seq, err := o.Sequence()
+			if err != nil {
+				errs[i] = isb.MessageAckErr{Name: dr.name, Offset: o, Message: err.Error()}
+				continue
+			}
+			s.ack(seq)
+		}
+		for _, firstSeq := range s.obsoleteSegments() {
+			if err := os.Remove(filepath.Join(dr.dir, segmentFileName(firstSeq))); err != nil && !os.IsNotExist(err) {
+				dr.log.Warnw("Failed to delete an acknowledged segment file", zap.Int64("firstSeq", firstSeq), zap.Error(err))
+			}
+		}
+		segments = append(segments, s.Segments...)
+		return nil
+	})
+	if err != nil {
+		for i, o := range offsets {
+			errs[i] = isb.MessageAckErr{Name: dr.name, Offset: o, Message: err.Error()}
+		}
+		return errs
+	}
+	dr.mu.Lock()
+	dr.segments.prune(segments)
+	dr.mu.Unlock()
+	return errs
+}
+
+// NoAck makes the offsets available for redelivery.
+func (dr *diskReader) NoAck(_ context.Context, offsets []isb.Offset) {
+	err := dr.lock.update(func(s *state) error {
+		for _, o := range offsets {
+			if seq, err := o.Sequence(); err == nil {
+				s.release(seq, dr.consumer)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		dr.log.Errorw("Failed to release offsets", zap.Error(err))
+	}
+}
+
+// Pending returns the number of messages not acknowledged yet, including the in-flight ones.
+func (dr *diskReader) Pending(_ context.Context) (int64, error) {
+	var pending int64
+	if err := dr.lock.update(func(s *state) error {
+		pending = s.pending()
+		return nil
+	}); err != nil {
+		return isb.PendingNotAvailable, fmt.Errorf("failed to get the pending messages of buffer %q, %w", dr.name, err)
+	}
+	return pending, nil
+}
@@ -0,0 +1,187 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package disk
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/numaproj/numaflow/pkg/isb"
+)
+
+const (
+	// entryHeaderSize is the encoded size of an entryHeader.
+	entryHeaderSize = 20
+	segmentPrefix   = "segment"
+)
+
+var errChecksumMismatch = fmt.Errorf("data checksum not match")
+
+// entryHeader is the header of each entry in a segment file.
+type entryHeader struct {
+	Seq        int64
+	MessageLen int64
+	Checksum   uint32
+}
+
+func calculateChecksum(data []byte) uint32 {
+	return crc32.ChecksumIEEE(data)
+}
+
+func segmentFileName(firstSeq int64) string {
+	return fmt.Sprintf("%s-%020d", segmentPrefix, firstSeq)
+}
+
+// encodeEntry builds a segment entry of the message. The format as follow is
+//
+//	+--------------------+-----------------+--------------+----------------+
+//	| sequence (int64)   | msg-len (int64) | CRC (uint32) | message []byte |
+//	+--------------------+-----------------+--------------+----------------+
+//
+// CRC will be used for detecting message corruptions.
+func encodeEntry(buf *bytes.Buffer, seq int64, message isb.Message) error {
+	body, err := message.MarshalBinary()
+	if err != nil {
+		return fmt.Errorf("failed to encode the message, %w", err)
+	}
+	header := entryHeader{Seq: seq, MessageLen: int64(len(body)), Checksum: calculateChecksum(body)}
+	if err := binary.Write(buf, binary.LittleEndian, header); err != nil {
+		return err
+	}
+	_, err = buf.Write(body)
+	return err
+}
+
+// segmentIndex is the positions of the entries of a segment file, which are built lazily when reading.
+type segmentIndex struct {
+	fp        *os.File
+	firstSeq  int64
+	positions []int64
+	// scanned is the position up to which the file has been scanned.
+	scanned int64
+}
+
+// segmentReader reads messages from the segment files of a buffer by sequence.
+type segmentReader struct {
+	dir     string
+	indexes map[int64]*segmentIndex
+}
+
+func newSegmentReader(dir string) *segmentReader {
+	return &segmentReader{dir: dir, indexes: make(map[int64]*segmentIndex)}
+}
+
+// read returns the message of the given sequence, segments are the first sequences of the existing segment files.
+func (r *segmentReader) read(segments []int64, seq int64) (*isb.Message, error) {
+	i := sort.Search(len(segments), func(i int) bool { return segments[i] > seq }) - 1
+	if i < 0 {
+		return nil, fmt.Errorf("message %d not found in buffer %q", seq, filepath.Base(r.dir))
+	}
+	idx, err := r.index(segments[i])
+	if err != nil {
+		return nil, err
+	}
+	n := int(seq - idx.firstSeq)
+	for len(idx.positions) <= n {
+		if err := idx.scan(); err != nil {
+			return nil, fmt.Errorf("message %d not found in buffer %q, %w", seq, filepath.Base(r.dir), err)
+		}
+	}
+	header, body, err := readEntry(idx.fp, idx.positions[n])
+	if err != nil {
+		return nil, err
+	}
+	if header.Seq != seq {
+		return nil, fmt.Errorf("expected message %d in buffer %q, got %d", seq, filepath.Base(r.dir), header.Seq)
+	}
+	msg := &isb.Message{}
+	if err := msg.UnmarshalBinary(body); err != nil {
+		return nil, fmt.Errorf("failed to decode message %d in buffer %q, %w", seq, filepath.Base(r.dir), err)
+	}
+	return msg, nil
+}
+
+func (r *segmentReader) index(firstSeq int64) (*segmentIndex, error) {
+	if idx, ok := r.indexes[firstSeq]; ok {
+		return idx, nil
+	}
+	fp, err := os.Open(filepath.Join(r.dir, segmentFileName(firstSeq)))
+	if err != nil {
+		return nil, err
+	}
+	idx := &segmentIndex{fp: fp, firstSeq: firstSeq}
+	r.indexes[firstSeq] = idx
+	return idx, nil
+}
+
+// prune closes the segment files which no longer exist.
+func (r *segmentReader) prune(segments []int64) {
+	for firstSeq, idx := range r.indexes {
+		if len(segments) == 0 || firstSeq < segments[0] {
+			_ = idx.fp.Close()
+			delete(r.indexes, firstSeq)
+		}
+	}
+}
+
+func (r *segmentReader) close() {
+	r.prune(nil)
+}
+
+// scan indexes the next entry of the segment file.
+func (idx *segmentIndex) scan() error {
+	header, _, err := readEntry(idx.fp, idx.scanned)
+	if err != nil {
+		return err
+	}
+	idx.positions = append(idx.positions, idx.scanned)
+	idx.scanned += entryHeaderSize + header.MessageLen
+	return nil
+}
+
+// readEntry reads the entry at the given position of the segment file, and verifies its checksum.
+func readEntry(fp *os.File, pos int64) (*entryHeader, []byte, error) {
+	var buf [entryHeaderSize]byte
+	if _, err := fp.ReadAt(buf[:], pos); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, nil, io.ErrUnexpectedEOF
+		}
+		return nil, nil, err
+	}
+	header := &entryHeader{}
+	if err := binary.Read(bytes.NewReader(buf[:]), binary.LittleEndian, header); err != nil {
+		return nil, nil, err
+	}
+	body := make([]byte, header.MessageLen)
+	if _, err := fp.ReadAt(body, pos+entryHeaderSize); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, nil, io.ErrUnexpectedEOF
+		}
+		return nil, nil, err
+	}
+	if calculateChecksum(body) != header.Checksum {
+		return nil, nil, errChecksumMismatch
+	}
+	return header, body, nil
+}
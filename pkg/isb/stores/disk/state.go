@@ -0,0 +1,588 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package disk
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"syscall"
+	"time"
+)
+
+const (
+	buffersDir      = "buffers"
+	stateFileName   = "state"
+	journalFileName = "journal"
+	lockFileName    = "lock"
+
+	// journalCompactionBytes is the size of the journal above which it's compacted into a new state snapshot.
+	journalCompactionBytes = 4 * 1024 * 1024
+)
+
+// delivery is a message which has been delivered but not acknowledged yet.
+type delivery struct {
+	// Consumer is the reader the message is delivered to, empty means the message is waiting to be redelivered.
+	Consumer string `json:"consumer,omitempty"`
+	// Count is the number of times the message has been delivered.
+	Count uint64 `json:"count"`
+}
+
+// state is the state of a buffer shared by all the readers and writers, it is always read and written with the
+// buffer lock held. It's persisted as a snapshot and a journal of the operations applied to the snapshot, each
+// change of the state is made by an operation, which is recorded to be appended to the journal.
+type state struct {
+	// Generation is incremented every time the journal is compacted into a new snapshot.
+	Generation int64 `json:"generation"`
+	// Segments are the first sequences of the segment files, in ascending order.
+	Segments []int64 `json:"segments"`
+	// ActiveSize is the size of the last segment file.
+	ActiveSize int64 `json:"activeSize"`
+	// LastSeq is the sequence of the last written message, sequences start from 1.
+	LastSeq int64 `json:"lastSeq"`
+	// Delivered is the sequence of the last message delivered for the first time.
+	Delivered int64 `json:"delivered"`
+	// AckFloor is the sequence up to which all the messages have been acknowledged.
+	AckFloor int64 `json:"ackFloor"`
+	// Acked are the acknowledged sequences above the AckFloor, in ascending order.
+	Acked []int64 `json:"acked,omitempty"`
+	// Inflight are the delivered but not acknowledged messages.
+	Inflight map[int64]*delivery `json:"inflight,omitempty"`
+	// Consumers are the last time (unix milliseconds) each reader was seen alive.
+	Consumers map[string]int64 `json:"consumers,omitempty"`
+
+	// ops are the operations applied since the state was loaded or persisted, to be appended to the journal.
+	ops []op
+}
+
+type opType string
+
+const (
+	// opJournal is the first record of a journal, N is the generation of the snapshot the journal applies to.
+	opJournal         opType = "journal"
+	opAck             opType = "ack"
+	opClaim           opType = "claim"
+	opRelease         opType = "release"
+	opReleaseConsumer opType = "releaseConsumer"
+	opSeen            opType = "seen"
+	opRotate          opType = "rotate"
+	opAppend          opType = "append"
+	opGCSegments      opType = "gcSegments"
+)
+
+// op is an operation on the state, the operations are deterministic so that replaying the journal on the snapshot
+// results in the same state.
+type op struct {
+	Type     opType `json:"t"`
+	Seq      int64  `json:"s,omitempty"`
+	Consumer string `json:"c,omitempty"`
+	N        int64  `json:"n,omitempty"`
+}
+
+// errStaleJournal is returned when the journal doesn't apply to the snapshot.
+var errStaleJournal = errors.New("stale journal")
+
+func newState() *state {
+	return &state{Segments: []int64{1}}
+}
+
+// apply applies an operation read from the journal.
+func (s *state) apply(o op) error {
+	switch o.Type {
+	case opJournal:
+		if o.N != s.Generation {
+			return fmt.Errorf("%w, generation %d is not %d", errStaleJournal, o.N, s.Generation)
+		}
+	case opAck:
+		s.applyAck(o.Seq)
+	case opClaim:
+		s.applyClaim(o.Consumer, o.N)
+	case opRelease:
+		s.applyRelease(o.Seq, o.Consumer)
+	case opReleaseConsumer:
+		s.applyReleaseConsumer(o.Consumer)
+	case opSeen:
+		s.applySeen(o.Consumer, o.N)
+	case opRotate:
+		s.applyRotate()
+	case opAppend:
+		s.LastSeq, s.ActiveSize = o.Seq, o.N
+	case opGCSegments:
+		s.applyGCSegments()
+	default:
+		return fmt.Errorf("unknown operation %q", o.Type)
+	}
+	return nil
+}
+
+func (s *state) record(o op) {
+	s.ops = append(s.ops, o)
+}
+
+// pending returns the number of messages not acknowledged yet.
+func (s *state) pending() int64 {
+	return s.LastSeq - s.AckFloor - int64(len(s.Acked))
+}
+
+// isAcked returns if the message of the given sequence has been acknowledged.
+func (s *state) isAcked(seq int64) bool {
+	if seq <= s.AckFloor {
+		return true
+	}
+	i := sort.Search(len(s.Acked), func(i int) bool { return s.Acked[i] >= seq })
+	return i < len(s.Acked) && s.Acked[i] == seq
+}
+
+// ack acknowledges the message of the given sequence, and moves the AckFloor forward if possible.
+func (s *state) ack(seq int64) {
+	s.record(op{Type: opAck, Seq: seq})
+	s.applyAck(seq)
+}
+
+func (s *state) applyAck(seq int64) {
+	delete(s.Inflight, seq)
+	if seq > s.LastSeq || s.isAcked(seq) {
+		return
+	}
+	i := sort.Search(len(s.Acked), func(i int) bool { return s.Acked[i] >= seq })
+	s.Acked = append(s.Acked, 0)
+	copy(s.Acked[i+1:], s.Acked[i:])
+	s.Acked[i] = seq
+	for len(s.Acked) > 0 && s.Acked[0] == s.AckFloor+1 {
+		s.AckFloor++
+		s.Acked = s.Acked[1:]
+	}
+}
+
+// claim assigns at most count messages to the consumer, the ones waiting to be redelivered come first.
+// It returns the claimed sequences and their delivery counts.
+func (s *state) claim(consumer string, count int64) ([]int64, []uint64) {
+	delivered := s.Delivered
+	seqs, counts := s.applyClaim(consumer, count)
+	if len(seqs) > 0 || s.Delivered != delivered {
+		s.record(op{Type: opClaim, Consumer: consumer, N: count})
+	}
+	return seqs, counts
+}
+
+func (s *state) applyClaim(consumer string, count int64) ([]int64, []uint64) {
+	var seqs []int64
+	var counts []uint64
+	var redeliveries []int64
+	for seq, d := range s.Inflight {
+		if d.Consumer == "" {
+			redeliveries = append(redeliveries, seq)
+		}
+	}
+	sort.Slice(redeliveries, func(i, j int) bool { return redeliveries[i] < redeliveries[j] })
+	for _, seq := range redeliveries {
+		if int64(len(seqs)) >= count {
+			return seqs, counts
+		}
+		d := s.Inflight[seq]
+		d.Consumer = consumer
+		d.Count++
+		seqs = append(seqs, seq)
+		counts = append(counts, d.Count)
+	}
+	for int64(len(seqs)) < count && s.Delivered < s.LastSeq {
+		s.Delivered++
+		if s.isAcked(s.Delivered) {
+			// removed without being delivered, e.g. a replayed dead-letter message
+			continue
+		}
+		if s.Inflight == nil {
+			s.Inflight = make(map[int64]*delivery)
+		}
+		s.Inflight[s.Delivered] = &delivery{Consumer: consumer, Count: 1}
+		seqs = append(seqs, s.Delivered)
+		counts = append(counts, 1)
+	}
+	return seqs, counts
+}
+
+// release makes the message of the given sequence available for redelivery, if it's delivered to the consumer.
+func (s *state) release(seq int64, consumer string) {
+	if d, ok := s.Inflight[seq]; ok && d.Consumer == consumer {
+		s.record(op{Type: opRelease, Seq: seq, Consumer: consumer})
+		s.applyRelease(seq, consumer)
+	}
+}
+
+func (s *state) applyRelease(seq int64, consumer string) {
+	if d, ok := s.Inflight[seq]; ok && d.Consumer == consumer {
+		d.Consumer = ""
+	}
+}
+
+// releaseConsumer makes all the messages delivered to the consumer available for redelivery.
+func (s *state) releaseConsumer(consumer string) {
+	s.record(op{Type: opReleaseConsumer, Consumer: consumer})
+	s.applyReleaseConsumer(consumer)
+}
+
+func (s *state) applyReleaseConsumer(consumer string) {
+	for _, d := range s.Inflight {
+		if d.Consumer == consumer {
+			d.Consumer = ""
+		}
+	}
+	delete(s.Consumers, consumer)
+}
+
+// seen records the consumer is alive at the given time.
+func (s *state) seen(consumer string, now time.Time) {
+	s.record(op{Type: opSeen, Consumer: consumer, N: now.UnixMilli()})
+	s.applySeen(consumer, now.UnixMilli())
+}
+
+func (s *state) applySeen(consumer string, unixMilli int64) {
+	if s.Consumers == nil {
+		s.Consumers = make(map[string]int64)
+	}
+	s.Consumers[consumer] = unixMilli
+}
+
+// expireConsumers releases the messages of the consumers which have not been seen for longer than the timeout.
+func (s *state) expireConsumers(now time.Time, timeout time.Duration) {
+	var expired []string
+	for c, lastSeen := range s.Consumers {
+		if now.Sub(time.UnixMilli(lastSeen)) > timeout {
+			expired = append(expired, c)
+		}
+	}
+	// sorted to journal the same operations in the same order as they are applied
+	sort.Strings(expired)
+	for _, c := range expired {
+		s.releaseConsumer(c)
+	}
+}
+
+// rotate starts a new segment file for the messages written next.
+func (s *state) rotate() {
+	s.record(op{Type: opRotate})
+	s.applyRotate()
+}
+
+func (s *state) applyRotate() {
+	s.Segments = append(s.Segments, s.LastSeq+1)
+	s.ActiveSize = 0
+}
+
+// appended moves the last sequence and the size of the last segment file forward after writing messages.
+func (s *state) appended(lastSeq, activeSize int64) {
+	s.record(op{Type: opAppend, Seq: lastSeq, N: activeSize})
+	s.LastSeq, s.ActiveSize = lastSeq, activeSize
+}
+
+// obsoleteSegments removes and returns the segments of which all the messages have been acknowledged,
+// the last segment is always kept for writing.
+func (s *state) obsoleteSegments() []int64 {
+	result := s.applyGCSegments()
+	if len(result) > 0 {
+		s.record(op{Type: opGCSegments})
+	}
+	return result
+}
+
+func (s *state) applyGCSegments() []int64 {
+	var result []int64
+	for len(s.Segments) > 1 && s.Segments[1] <= s.AckFloor+1 {
+		result = append(result, s.Segments[0])
+		s.Segments = s.Segments[1:]
+	}
+	return result
+}
+
+// bufferLock guards the state of a buffer with a file lock, so that the readers and writers in different processes
+// can share it. The mutex is needed because the file lock doesn't exclude the goroutines sharing the same file descriptor.
+// The state is cached along with the offset of the journal applied to it, so that only the operations appended by
+// the others since then are read when the lock is acquired, and only the new operations are appended.
+type bufferLock struct {
+	dir string
+	mu  sync.Mutex
+	fp  *os.File
+	// syncInterval is the interval to sync the journal to the disk.
+	syncInterval time.Duration
+	lastSync     time.Time
+
+	// s is the cached state, nil if it has to be loaded.
+	s *state
+	// snapshot is the file info of the snapshot the cached state is loaded from, it's changed by a compaction.
+	snapshot os.FileInfo
+	journal  *os.File
+	// offset is the offset of the journal up to which the operations have been applied to the cached state.
+	offset int64
+}
+
+func newBufferLock(dir string, syncInterval time.Duration) (*bufferLock, error) {
+	fp, err := os.OpenFile(filepath.Join(dir, lockFileName), os.O_RDWR, 0644)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("buffer %q does not exist", filepath.Base(dir))
+		}
+		return nil, err
+	}
+	return &bufferLock{dir: dir, fp: fp, syncInterval: syncInterval}, nil
+}
+
+// update calls fn with the up-to-date state with the lock held, and appends the operations fn applies to the
+// journal. The changes are discarded if fn returns an error.
+func (l *bufferLock) update(fn func(s *state) error) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if err := syscall.Flock(int(l.fp.Fd()), syscall.LOCK_EX); err != nil {
+		return fmt.Errorf("failed to lock buffer %q, %w", filepath.Base(l.dir), err)
+	}
+	defer func() { _ = syscall.Flock(int(l.fp.Fd()), syscall.LOCK_UN) }()
+	if err := l.refresh(); err != nil {
+		l.reset()
+		return err
+	}
+	if err := fn(l.s); err != nil {
+		l.reset()
+		return err
+	}
+	if len(l.s.ops) == 0 {
+		return nil
+	}
+	if err := l.appendOps(); err != nil {
+		l.reset()
+		return err
+	}
+	if l.offset >= journalCompactionBytes {
+		if err := l.compact(); err != nil {
+			l.reset()
+			return err
+		}
+	}
+	return nil
+}
+
+// refresh brings the cached state up to date, it's reloaded if the journal has been compacted by the others.
+func (l *bufferLock) refresh() error {
+	if l.s != nil {
+		if l.unchanged(stateFileName, l.snapshot) && l.unchanged(journalFileName, l.journalInfo()) {
+			return l.replay()
+		}
+		l.reset()
+	}
+	return l.load()
+}
+
+func (l *bufferLock) unchanged(name string, cached os.FileInfo) bool {
+	info, err := os.Stat(filepath.Join(l.dir, name))
+	return err == nil && cached != nil && os.SameFile(info, cached)
+}
+
+func (l *bufferLock) journalInfo() os.FileInfo {
+	info, err := l.journal.Stat()
+	if err != nil {
+		return nil
+	}
+	return info
+}
+
+// load loads the snapshot and replays the journal. The state is initialized if the buffer is being created, and
+// the journal is started over if it doesn't apply to the snapshot, i.e. the compaction was interrupted after the
+// new snapshot was saved, which has all the operations of the journal.
+func (l *bufferLock) load() error {
+	s, err := loadSnapshot(l.dir)
+	if errors.Is(err, os.ErrNotExist) {
+		// the buffer is being created
+		s = newState()
+		err = saveSnapshot(l.dir, s)
+	}
+	if err != nil {
+		return err
+	}
+	if l.snapshot, err = os.Stat(filepath.Join(l.dir, stateFileName)); err != nil {
+		return err
+	}
+	l.s = s
+	l.journal, err = os.OpenFile(filepath.Join(l.dir, journalFileName), os.O_RDWR, 0644)
+	if errors.Is(err, os.ErrNotExist) {
+		return l.newJournal()
+	}
+	if err != nil {
+		return err
+	}
+	l.offset = 0
+	if err = l.replay(); !errors.Is(err, errStaleJournal) {
+		return err
+	}
+	_ = l.journal.Close()
+	l.journal = nil
+	return l.newJournal()
+}
+
+// replay applies the operations appended to the journal since the last replay. A partially written operation at the
+// end, left by a crash, is truncated since the journal is only appended with the lock held.
+func (l *bufferLock) replay() error {
+	info, err := l.journal.Stat()
+	if err != nil {
+		return err
+	}
+	if info.Size() <= l.offset {
+		return nil
+	}
+	data := make([]byte, info.Size()-l.offset)
+	if _, err := l.journal.ReadAt(data, l.offset); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("failed to read the journal of buffer %q, %w", filepath.Base(l.dir), err)
+	}
+	for len(data) > 0 {
+		i := bytes.IndexByte(data, '\n')
+		if i < 0 {
+			return l.journal.Truncate(l.offset)
+		}
+		var o op
+		if err := json.Unmarshal(data[:i], &o); err != nil {
+			return fmt.Errorf("failed to unmarshal the journal of buffer %q, %w", filepath.Base(l.dir), err)
+		}
+		if l.offset == 0 && o.Type != opJournal {
+			return fmt.Errorf("the journal of buffer %q has no header", filepath.Base(l.dir))
+		}
+		if err := l.s.apply(o); err != nil {
+			return fmt.Errorf("failed to replay the journal of buffer %q, %w", filepath.Base(l.dir), err)
+		}
+		l.offset += int64(i + 1)
+		data = data[i+1:]
+	}
+	return nil
+}
+
+// appendOps appends the operations of the cached state to the journal.
+func (l *bufferLock) appendOps() error {
+	buf := new(bytes.Buffer)
+	enc := json.NewEncoder(buf)
+	for _, o := range l.s.ops {
+		if err := enc.Encode(o); err != nil {
+			return fmt.Errorf("failed to marshal the journal of buffer %q, %w", filepath.Base(l.dir), err)
+		}
+	}
+	l.s.ops = nil
+	if _, err := l.journal.WriteAt(buf.Bytes(), l.offset); err != nil {
+		return fmt.Errorf("failed to write the journal of buffer %q, %w", filepath.Base(l.dir), err)
+	}
+	l.offset += int64(buf.Len())
+	if time.Since(l.lastSync) >= l.syncInterval {
+		l.lastSync = time.Now()
+		if err := l.journal.Sync(); err != nil {
+			return fmt.Errorf("failed to sync the journal of buffer %q, %w", filepath.Base(l.dir), err)
+		}
+	}
+	return nil
+}
+
+// compact saves the cached state as a new snapshot, and starts a new journal applying to it.
+func (l *bufferLock) compact() error {
+	l.s.Generation++
+	if err := saveSnapshot(l.dir, l.s); err != nil {
+		return err
+	}
+	var err error
+	if l.snapshot, err = os.Stat(filepath.Join(l.dir, stateFileName)); err != nil {
+		return err
+	}
+	_ = l.journal.Close()
+	l.journal = nil
+	return l.newJournal()
+}
+
+// newJournal replaces the journal with an empty one applying to the cached state.
+func (l *bufferLock) newJournal() error {
+	data, err := json.Marshal(op{Type: opJournal, N: l.s.Generation})
+	if err != nil {
+		return err
+	}
+	if err := writeFileAtomically(filepath.Join(l.dir, journalFileName), append(data, '\n')); err != nil {
+		return fmt.Errorf("failed to write the journal of buffer %q, %w", filepath.Base(l.dir), err)
+	}
+	if l.journal, err = os.OpenFile(filepath.Join(l.dir, journalFileName), os.O_RDWR, 0644); err != nil {
+		return err
+	}
+	l.offset = int64(len(data) + 1)
+	return nil
+}
+
+// reset drops the cached state, so that it's loaded again with the lock held next time.
+func (l *bufferLock) reset() {
+	if l.journal != nil {
+		_ = l.journal.Close()
+	}
+	l.s, l.snapshot, l.journal, l.offset = nil, nil, nil, 0
+}
+
+func (l *bufferLock) close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.journal != nil {
+		_ = l.journal.Sync()
+	}
+	l.reset()
+	return l.fp.Close()
+}
+
+func loadSnapshot(dir string) (*state, error) {
+	data, err := os.ReadFile(filepath.Join(dir, stateFileName))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the state of buffer %q, %w", filepath.Base(dir), err)
+	}
+	s := &state{}
+	if err := json.Unmarshal(data, s); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal the state of buffer %q, %w", filepath.Base(dir), err)
+	}
+	return s, nil
+}
+
+func saveSnapshot(dir string, s *state) error {
+	data, err := json.Marshal(s)
+	if err != nil {
+		return fmt.Errorf("failed to marshal the state of buffer %q, %w", filepath.Base(dir), err)
+	}
+	if err := writeFileAtomically(filepath.Join(dir, stateFileName), data); err != nil {
+		return fmt.Errorf("failed to write the state of buffer %q, %w", filepath.Base(dir), err)
+	}
+	return nil
+}
+
+// writeFileAtomically writes the data to a temporary file and renames it, so that the file is never partially written.
+func writeFileAtomically(path string, data []byte) error {
+	tmp := path + ".tmp"
+	fp, err := os.OpenFile(tmp, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+	if _, err := fp.Write(data); err != nil {
+		_ = fp.Close()
+		return err
+	}
+	if err := fp.Sync(); err != nil {
+		_ = fp.Close()
+		return err
+	}
+	if err := fp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
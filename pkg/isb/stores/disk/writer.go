@@ -0,0 +1,181 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package disk
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"go.uber.org/zap"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+)
+
+// diskWriter appends messages to the segment files of a buffer. Multiple writers, even in different processes,
+// can write to the same buffer, the buffer lock makes the appends atomic.
+type diskWriter struct {
+	name         string
+	partitionIdx int32
+	dir          string
+	lock         *bufferLock
+	opts         *writeOptions
+	compressor   *isb.Compressor
+	// fp is the last segment file, and activeSeq is its first sequence
+	fp        *os.File
+	activeSeq int64
+	lastSync  time.Time
+	log       *zap.SugaredLogger
+}
+
+var _ isb.BufferWriter = (*diskWriter)(nil)
+
+// NewDiskBufferWriter returns a writer of the buffer, rootDir is the mount path of the disk ISB Service volume.
+func NewDiskBufferWriter(ctx context.Context, rootDir, name string, partitionIdx int32, opts ...WriteOption) (isb.BufferWriter, error) {
+	o := defaultWriteOptions()
+	for _, opt := range opts {
+		if err := opt(o); err != nil {
+			return nil, err
+		}
+	}
+	compressor, err := isb.NewCompressor(name, o.compression)
+	if err != nil {
+		return nil, err
+	}
+	dir := BufferDir(rootDir, name)
+	lock, err := newBufferLock(dir, o.syncInterval)
+	if err != nil {
+		return nil, err
+	}
+	return &diskWriter{
+		name:         name,
+		partitionIdx: partitionIdx,
+		dir:          dir,
+		lock:         lock,
+		opts:         o,
+		compressor:   compressor,
+		lastSync:     time.Now(),
+		log:          logging.FromContext(ctx).With("bufferWriter", name),
+	}, nil
+}
+
+func (dw *diskWriter) GetName() string {
+	return dw.name
+}
+
+func (dw *diskWriter) GetPartitionIdx() int32 {
+	return dw.partitionIdx
+}
+
+func (dw *diskWriter) Close() error {
+	if dw.fp != nil {
+		_ = dw.fp.Sync()
+		_ = dw.fp.Close()
+	}
+	return dw.lock.close()
+}
+
+// Write appends the messages to the buffer, all of them fail if the buffer is full.
+func (dw *diskWriter) Write(_ context.Context, messages []isb.Message) ([]isb.Offset, []error) {
+	errs := make([]error, len(messages))
+	offsets := make([]isb.Offset, len(messages))
+	err := dw.lock.update(func(s *state) error {
+		if float64(s.pending()) >= float64(dw.opts.maxLength)*dw.opts.bufferUsageLimit {
+			for i := range errs {
+				switch dw.opts.bufferFullWritingStrategy {
+				case dfv1.DiscardLatest:
+					// user explicitly wants to discard the message when buffer if full.
+					errs[i] = isb.NonRetryableBufferWriteErr{Name: dw.name, Message: isb.BufferFullMessage}
+				default:
+					errs[i] = isb.BufferWriteErr{Name: dw.name, Full: true, Message: isb.BufferFullMessage}
+				}
+			}
+			return nil
+		}
+		if s.ActiveSize >= dw.opts.maxSegmentBytes {
+			s.rotate()
+		}
+		if err := dw.openActiveSegment(s.Segments[len(s.Segments)-1]); err != nil {
+			return err
+		}
+		buf := new(bytes.Buffer)
+		seq := s.LastSeq
+		for i, m := range messages {
+			m, err := dw.compressor.Compress(m)
+			if err != nil {
+				errs[i] = isb.NonRetryableBufferWriteErr{Name: dw.name, Message: err.Error()}
+				continue
+			}
+			if err := encodeEntry(buf, seq+1, m); err != nil {
+				errs[i] = isb.NonRetryableBufferWriteErr{Name: dw.name, Message: err.Error()}
+				continue
+			}
+			seq++
+			offsets[i] = isb.NewSimpleIntPartitionOffset(seq, dw.partitionIdx)
+		}
+		wrote, err := dw.fp.WriteAt(buf.Bytes(), s.ActiveSize)
+		if err != nil {
+			return err
+		}
+		if wrote != buf.Len() {
+			return fmt.Errorf("expected to write %d, but wrote only %d", buf.Len(), wrote)
+		}
+		if time.Since(dw.lastSync) >= dw.opts.syncInterval {
+			dw.lastSync = time.Now()
+			if err := dw.fp.Sync(); err != nil {
+				return err
+			}
+		}
+		// Only move the last sequence forward when we successfully write for atomicity.
+		s.appended(seq, s.ActiveSize+int64(wrote))
+		return nil
+	})
+	if err != nil {
+		dw.log.Errorw("Failed to write messages", zap.Error(err))
+		for i := range errs {
+			if errs[i] == nil {
+				errs[i] = isb.BufferWriteErr{Name: dw.name, Message: err.Error()}
+			}
+			offsets[i] = nil
+		}
+	}
+	return offsets, errs
+}
+
+// openActiveSegment opens the last segment file for writing, it's created if it doesn't exist yet.
+func (dw *diskWriter) openActiveSegment(firstSeq int64) error {
+	if dw.fp != nil && dw.activeSeq == firstSeq {
+		return nil
+	}
+	if dw.fp != nil {
+		_ = dw.fp.Sync()
+		_ = dw.fp.Close()
+		dw.fp = nil
+	}
+	fp, err := os.OpenFile(filepath.Join(dw.dir, segmentFileName(firstSeq)), os.O_WRONLY|os.O_CREATE, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to open segment file, %w", err)
+	}
+	dw.fp = fp
+	dw.activeSeq = firstSeq
+	return nil
+}
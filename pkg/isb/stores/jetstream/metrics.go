@@ -107,3 +107,17 @@ var isbAckTime = promauto.NewHistogramVec(prometheus.HistogramOpts{
 	Help:      "Processing times of acks for jetstream",
 	Buckets:   prometheus.ExponentialBucketsRange(100, 60000000*2, 10),
 }, []string{"buffer"})
+
+// isbPriorityLanePending is calculated based on the messages in pending of each priority lane
+var isbPriorityLanePending = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Subsystem: "isb_jetstream",
+	Name:      "priority_lane_pending",
+	Help:      "Number of pending messages of a priority lane",
+}, []string{"buffer", "lane"})
+
+// isbPriorityLaneReads is used to indicate the number of messages read from each priority lane
+var isbPriorityLaneReads = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "isb_jetstream",
+	Name:      "priority_lane_read_total",
+	Help:      "Total number of messages read from a priority lane",
+}, []string{"buffer", "lane"})
@@ -32,6 +32,10 @@ type writeOptions struct {
 	refreshInterval time.Duration
 	// bufferFullWritingStrategy is the writing strategy when buffer is full
 	bufferFullWritingStrategy dfv1.BufferFullWritingStrategy
+	// compression is the compression of the message payloads
+	compression *dfv1.Compression
+	// priorityLanes is the number of the priority lanes of the buffer
+	priorityLanes int
 }
 
 func defaultWriteOptions() *writeOptions {
@@ -40,6 +44,7 @@ func defaultWriteOptions() *writeOptions {
 		bufferUsageLimit:          dfv1.DefaultBufferUsageLimit,
 		refreshInterval:           1 * time.Second,
 		bufferFullWritingStrategy: dfv1.RetryUntilSuccess,
+		priorityLanes:             1,
 	}
 }
 
@@ -77,10 +82,28 @@ func WithBufferFullWritingStrategy(s dfv1.BufferFullWritingStrategy) WriteOption
 	}
 }
 
+// WithCompression sets the compression of the message payloads
+func WithCompression(c *dfv1.Compression) WriteOption {
+	return func(o *writeOptions) error {
+		o.compression = c
+		return nil
+	}
+}
+
+// WithPriorityLanes sets the number of the priority lanes of the buffer to write to
+func WithPriorityLanes(lanes int) WriteOption {
+	return func(o *writeOptions) error {
+		o.priorityLanes = lanes
+		return nil
+	}
+}
+
 // options for reading from JetStream
 type readOptions struct {
 	// readTimeOut is the timeout needed for read timeout
 	readTimeOut time.Duration
+	// priorityWeights are the weights of the priority lanes of the buffer, from lane 0
+	priorityWeights []int32
 }
 
 type ReadOption func(*readOptions) error
@@ -93,6 +116,14 @@ func WithReadTimeOut(timeout time.Duration) ReadOption {
 	}
 }
 
+// WithReadPriorityLanes sets the weights of the priority lanes of the buffer to read from, from lane 0
+func WithReadPriorityLanes(weights []int32) ReadOption {
+	return func(o *readOptions) error {
+		o.priorityWeights = weights
+		return nil
+	}
+}
+
 func defaultReadOptions() *readOptions {
 	return &readOptions{
 		readTimeOut: time.Second,
@@ -0,0 +1,109 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jetstream
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/nats-io/nats.go"
+)
+
+// The priority lanes of a buffer are the subjects of its stream, so that the messages in all the lanes share the
+// sequences of the stream, which are used as the offsets for the watermarks. Lane 0 is the subject of the stream
+// and is consumed by the consumer of the stream, so a buffer without priority lanes only has lane 0. Each higher
+// lane has a subject and a consumer filtering it.
+
+// PriorityLaneSubject returns the subject of a priority lane of the stream.
+func PriorityLaneSubject(subject string, lane int) string {
+	if lane == 0 {
+		return subject
+	}
+	return subject + ".priority-" + strconv.Itoa(lane)
+}
+
+// PriorityLaneConsumer returns the name of the consumer of a priority lane of the stream.
+func PriorityLaneConsumer(stream string, lane int) string {
+	if lane == 0 {
+		return stream
+	}
+	return stream + "-priority-" + strconv.Itoa(lane)
+}
+
+// ParsePriorityLaneConsumer returns the priority lane of a consumer of the stream, or false if it is not the
+// consumer of a lane.
+func ParsePriorityLaneConsumer(stream, consumer string) (int, bool) {
+	if consumer == stream {
+		return 0, true
+	}
+	s, ok := strings.CutPrefix(consumer, stream+"-priority-")
+	if !ok {
+		return 0, false
+	}
+	lane, err := strconv.Atoi(s)
+	if err != nil || lane < 1 {
+		return 0, false
+	}
+	return lane, true
+}
+
+// ensurePriorityLanes adds the subjects of the priority lanes to the stream, and creates the consumers of them with
+// the same settings as the consumer of the stream if they do not exist. It is called by both the writers and the
+// readers, so that no message is written to a lane without a consumer.
+func ensurePriorityLanes(js nats.JetStreamContext, stream, subject string, lanes int) error {
+	if lanes < 2 {
+		return nil
+	}
+	streamInfo, err := js.StreamInfo(stream)
+	if err != nil {
+		return fmt.Errorf("failed to get information of stream %q, %w", stream, err)
+	}
+	wildcard := subject + ".*"
+	if !slices.Contains(streamInfo.Config.Subjects, wildcard) {
+		config := streamInfo.Config
+		config.Subjects = append(slices.Clone(config.Subjects), wildcard)
+		if _, err := js.UpdateStream(&config); err != nil {
+			return fmt.Errorf("failed to add the subjects of the priority lanes to stream %q, %w", stream, err)
+		}
+	}
+	consumerInfo, err := js.ConsumerInfo(stream, stream)
+	if err != nil {
+		return fmt.Errorf("failed to get consumer information of stream %q, %w", stream, err)
+	}
+	for lane := 1; lane < lanes; lane++ {
+		name := PriorityLaneConsumer(stream, lane)
+		if _, err := js.ConsumerInfo(stream, name); err == nil {
+			continue
+		} else if !errors.Is(err, nats.ErrConsumerNotFound) {
+			return fmt.Errorf("failed to get information of consumer %q of stream %q, %w", name, stream, err)
+		}
+		if _, err := js.AddConsumer(stream, &nats.ConsumerConfig{
+			Durable:       name,
+			DeliverPolicy: nats.DeliverAllPolicy,
+			AckPolicy:     nats.AckExplicitPolicy,
+			AckWait:       consumerInfo.Config.AckWait,
+			MaxAckPending: consumerInfo.Config.MaxAckPending,
+			FilterSubject: PriorityLaneSubject(subject, lane),
+		}); err != nil {
+			return fmt.Errorf("failed to create consumer %q of stream %q, %w", name, stream, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,160 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jetstream
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/isb/testutils"
+	natsclient "github.com/numaproj/numaflow/pkg/shared/clients/nats"
+	natstest "github.com/numaproj/numaflow/pkg/shared/clients/nats/test"
+)
+
+func TestPriorityLaneNames(t *testing.T) {
+	assert.Equal(t, "buffer", PriorityLaneSubject("buffer", 0))
+	assert.Equal(t, "buffer.priority-2", PriorityLaneSubject("buffer", 2))
+	assert.Equal(t, "buffer", PriorityLaneConsumer("buffer", 0))
+	assert.Equal(t, "buffer-priority-2", PriorityLaneConsumer("buffer", 2))
+
+	for consumer, want := range map[string]int{"buffer": 0, "buffer-priority-1": 1, "buffer-priority-12": 12} {
+		lane, ok := ParsePriorityLaneConsumer("buffer", consumer)
+		assert.True(t, ok, consumer)
+		assert.Equal(t, want, lane, consumer)
+	}
+	for _, consumer := range []string{"other", "buffer-priority-0", "buffer-priority-x", "buffer-1"} {
+		_, ok := ParsePriorityLaneConsumer("buffer", consumer)
+		assert.False(t, ok, consumer)
+	}
+}
+
+func TestJetStreamBufferPriorityLanes(t *testing.T) {
+	s := natstest.RunJetStreamServer(t)
+	defer natstest.ShutdownJetStreamServer(t, s)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Minute)
+	defer cancel()
+
+	client := natsclient.NewTestClientWithServer(t, s)
+	defer client.Close()
+	js, err := client.JetStreamContext()
+	require.NoError(t, err)
+
+	streamName := "testJetStreamBufferPriorityLanes"
+	addStream(t, js, streamName)
+	defer deleteStream(t, js, streamName)
+	defer func() { _ = js.DeleteConsumer(streamName, PriorityLaneConsumer(streamName, 1)) }()
+
+	bw, err := NewJetStreamBufferWriter(ctx, client, streamName, streamName, streamName, defaultPartitionIdx, WithPriorityLanes(2))
+	require.NoError(t, err)
+	jw := bw.(*jetStreamWriter)
+	defer jw.Close()
+	_, err = js.ConsumerInfo(streamName, PriorityLaneConsumer(streamName, 1))
+	require.NoError(t, err)
+
+	for jw.isFull.Load() {
+		select {
+		case <-ctx.Done():
+			t.Fatalf("expected not to be full, %s", ctx.Err())
+		default:
+			time.Sleep(1 * time.Millisecond)
+		}
+	}
+
+	// 3 messages in lane 0 followed by 3 messages in lane 1
+	messages := testutils.BuildTestWriteMessages(int64(6), time.Unix(1636470000, 0), nil, "testVertex")
+	for i := 3; i < 6; i++ {
+		messages[i].Header.Headers = map[string]string{dfv1.KeyMetaPriorityLane: "1", "lane": "1"}
+	}
+	_, errs := jw.Write(ctx, messages)
+	for _, e := range errs {
+		require.NoError(t, e)
+	}
+
+	br, err := NewJetStreamBufferReader(ctx, client, streamName, streamName, streamName, defaultPartitionIdx, WithReadPriorityLanes([]int32{1, 3}))
+	require.NoError(t, err)
+	jr := br.(*jetStreamReader)
+	defer jr.Close()
+	laneInfo, err := js.ConsumerInfo(streamName, PriorityLaneConsumer(streamName, 1))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), laneInfo.NumPending)
+
+	// lane 1 takes 3 of 4 messages of the batch, and the messages are sorted by the sequences of the stream
+	readMessages, err := jr.Read(ctx, 4)
+	require.NoError(t, err)
+	require.Len(t, readMessages, 4)
+	highs := 0
+	for _, m := range readMessages {
+		if m.Header.Headers["lane"] == "1" {
+			highs++
+		}
+		assert.NotContains(t, m.Header.Headers, dfv1.KeyMetaPriorityLane)
+	}
+	assert.Equal(t, 3, highs)
+	for i := 1; i < len(readMessages); i++ {
+		prev, _ := readMessages[i-1].ReadOffset.Sequence()
+		seq, _ := readMessages[i].ReadOffset.Sequence()
+		assert.Less(t, prev, seq)
+	}
+	offsets := make([]isb.Offset, len(readMessages))
+	for i, m := range readMessages {
+		offsets[i] = m.ReadOffset
+	}
+	for _, e := range jr.Ack(ctx, offsets) {
+		require.NoError(t, e)
+	}
+
+	readMessages, err = jr.Read(ctx, 4)
+	require.NoError(t, err)
+	require.Len(t, readMessages, 2)
+	for _, m := range readMessages {
+		assert.NotContains(t, m.Header.Headers, "lane")
+	}
+	offsets = offsets[:0]
+	for _, m := range readMessages {
+		offsets = append(offsets, m.ReadOffset)
+	}
+	for _, e := range jr.Ack(ctx, offsets) {
+		require.NoError(t, e)
+	}
+
+	// on an idle buffer, the read waits for the highest priority lane instead of polling all the lanes
+	br, err = NewJetStreamBufferReader(ctx, client, streamName, streamName, streamName, defaultPartitionIdx, WithReadPriorityLanes([]int32{1, 3}), WithReadTimeOut(10*time.Second))
+	require.NoError(t, err)
+	jr = br.(*jetStreamReader)
+	defer jr.Close()
+	go func() {
+		time.Sleep(500 * time.Millisecond)
+		high := testutils.BuildTestWriteMessages(int64(1), time.Unix(1636470000, 0), nil, "testVertex")
+		high[0].Header.ID.Offset = "high"
+		high[0].Header.Headers = map[string]string{dfv1.KeyMetaPriorityLane: "1", "lane": "1"}
+		_, errs := jw.Write(ctx, high)
+		assert.NoError(t, errs[0])
+	}()
+	start := time.Now()
+	readMessages, err = jr.Read(ctx, 4)
+	require.NoError(t, err)
+	require.Len(t, readMessages, 1)
+	assert.Equal(t, "1", readMessages[0].Header.Headers["lane"])
+	assert.Less(t, time.Since(start), 5*time.Second)
+}
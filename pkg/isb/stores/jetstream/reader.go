@@ -17,9 +17,12 @@ limitations under the License.
 package jetstream
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -32,6 +35,10 @@ import (
 	"github.com/numaproj/numaflow/pkg/shared/logging"
 )
 
+// priorityLaneFetchWait is how long to wait for the messages of a priority lane, it is short because the other lanes
+// are waiting to be polled.
+const priorityLaneFetchWait = 10 * time.Millisecond
+
 type jetStreamReader struct {
 	name                   string
 	stream                 string
@@ -41,7 +48,16 @@ type jetStreamReader struct {
 	opts                   *readOptions
 	inProgressTickDuration time.Duration
 	partitionIdx           int32
-	log                    *zap.SugaredLogger
+	// lanes are the priority lanes of the buffer from lane 0, it is empty if the buffer has no priority lanes
+	lanes []*priorityLane
+	log   *zap.SugaredLogger
+}
+
+// priorityLane is a priority lane of the buffer being read.
+type priorityLane struct {
+	sub    *nats.Subscription
+	weight int
+	labels map[string]string
 }
 
 // NewJetStreamBufferReader is used to provide a new JetStream buffer reader connection
@@ -88,6 +104,26 @@ func NewJetStreamBufferReader(ctx context.Context, client *jsclient.Client, name
 
 	reader.sub = sub
 	reader.inProgressTickDuration = time.Duration(inProgressTickSeconds * int64(time.Second))
+
+	if len(o.priorityWeights) > 1 {
+		if err := ensurePriorityLanes(jsContext, stream, subject, len(o.priorityWeights)); err != nil {
+			return nil, err
+		}
+		for lane, weight := range o.priorityWeights {
+			laneSub := sub
+			if lane > 0 {
+				consumer := PriorityLaneConsumer(stream, lane)
+				if laneSub, err = reader.client.Subscribe(PriorityLaneSubject(subject, lane), consumer, nats.Bind(stream, consumer)); err != nil {
+					return nil, fmt.Errorf("failed to subscribe to priority lane %d of subject %q, %w", lane, subject, err)
+				}
+			}
+			reader.lanes = append(reader.lanes, &priorityLane{
+				sub:    laneSub,
+				weight: int(max(weight, 1)),
+				labels: map[string]string{"buffer": name, "lane": strconv.Itoa(lane)},
+			})
+		}
+	}
 	return reader, nil
 }
 
@@ -105,11 +141,28 @@ func (jr *jetStreamReader) Close() error {
 			jr.log.Errorw("Failed to unsubscribe", zap.Error(err))
 		}
 	}
+	// lane 0 is the subscription of the stream
+	for _, l := range jr.lanes[min(1, len(jr.lanes)):] {
+		if err := l.sub.Unsubscribe(); err != nil {
+			jr.log.Errorw("Failed to unsubscribe from priority lane", zap.Error(err))
+		}
+	}
 	return nil
 }
 
 func (jr *jetStreamReader) Pending(_ context.Context) (int64, error) {
-	return jr.client.PendingForStream(jr.stream, jr.stream)
+	if len(jr.lanes) == 0 {
+		return jr.client.PendingForStream(jr.stream, jr.stream)
+	}
+	var pending int64
+	for lane := range jr.lanes {
+		p, err := jr.client.PendingForStream(jr.stream, PriorityLaneConsumer(jr.stream, lane))
+		if err != nil {
+			return isb.PendingNotAvailable, err
+		}
+		pending += p
+	}
+	return pending, nil
 }
 
 func (jr *jetStreamReader) Read(_ context.Context, count int64) ([]*isb.ReadMessage, error) {
@@ -119,7 +172,12 @@ func (jr *jetStreamReader) Read(_ context.Context, count int64) ([]*isb.ReadMess
 	}(time.Now())
 	var err error
 	var result []*isb.ReadMessage
-	msgs, err := jr.sub.Fetch(int(count), nats.MaxWait(jr.opts.readTimeOut))
+	var msgs []*nats.Msg
+	if len(jr.lanes) > 0 {
+		msgs, err = jr.fetchPriorityLanes(int(count))
+	} else {
+		msgs, err = jr.sub.Fetch(int(count), nats.MaxWait(jr.opts.readTimeOut))
+	}
 	if err != nil && !errors.Is(err, nats.ErrTimeout) {
 		isbReadErrors.With(map[string]string{"buffer": jr.GetName()}).Inc()
 		return nil, fmt.Errorf("failed to fetch messages from jet stream subject %q, %w", jr.subject, err)
@@ -144,6 +202,61 @@ func (jr *jetStreamReader) Read(_ context.Context, count int64) ([]*isb.ReadMess
 		}
 		result = append(result, rm)
 	}
+	if len(jr.lanes) > 0 {
+		// the watermark of a batch is fetched with the offset of the first message, so the messages read from the
+		// priority lanes are sorted by the sequences of the stream, in case they are assigned later watermarks.
+		slices.SortFunc(result, func(a, b *isb.ReadMessage) int {
+			return cmp.Compare(a.ReadOffset.(*offset).seq, b.ReadOffset.(*offset).seq)
+		})
+	}
+	return result, nil
+}
+
+// fetchPriorityLanes fetches at most count messages from the priority lanes. Each lane is first given a share of the
+// batch in proportion to its weight, so that the lower priority lanes are not starved, then the rest of the batch is
+// filled from the highest priority lanes first. If a full pass over the lanes finds no message, it waits for the
+// messages of the highest priority lane until the read timeout elapses, rather than polling all the lanes.
+func (jr *jetStreamReader) fetchPriorityLanes(count int) ([]*nats.Msg, error) {
+	deadline := time.Now().Add(jr.opts.readTimeOut)
+	totalWeight := 0
+	for _, l := range jr.lanes {
+		totalWeight += l.weight
+	}
+	var result []*nats.Msg
+	drained := make([]bool, len(jr.lanes))
+	fetch := func(lane, batch int, wait time.Duration) error {
+		l := jr.lanes[lane]
+		msgs, err := l.sub.Fetch(batch, nats.MaxWait(wait))
+		if err != nil && !errors.Is(err, nats.ErrTimeout) {
+			return fmt.Errorf("failed to fetch messages from priority lane %d, %w", lane, err)
+		}
+		drained[lane] = len(msgs) < batch
+		isbPriorityLaneReads.With(l.labels).Add(float64(len(msgs)))
+		result = append(result, msgs...)
+		return nil
+	}
+	for lane := len(jr.lanes) - 1; lane >= 0 && len(result) < count; lane-- {
+		share := max(1, count*jr.lanes[lane].weight/totalWeight)
+		if err := fetch(lane, min(share, count-len(result)), priorityLaneFetchWait); err != nil {
+			return result, err
+		}
+	}
+	for lane := len(jr.lanes) - 1; lane >= 0 && len(result) < count; lane-- {
+		if drained[lane] {
+			continue
+		}
+		if err := fetch(lane, count-len(result), priorityLaneFetchWait); err != nil {
+			return result, err
+		}
+	}
+	if len(result) > 0 {
+		return result, nil
+	}
+	if wait := time.Until(deadline); wait > 0 {
+		if err := fetch(len(jr.lanes)-1, count, wait); err != nil {
+			return result, err
+		}
+	}
 	return result, nil
 }
 
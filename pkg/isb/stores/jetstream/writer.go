@@ -19,6 +19,7 @@ package jetstream
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"sync"
 	"time"
 
@@ -41,6 +42,7 @@ type jetStreamWriter struct {
 	client       *jsclient.Client
 	js           nats.JetStreamContext
 	opts         *writeOptions
+	compressor   *isb.Compressor
 	isFull       *atomic.Bool
 	log          *zap.SugaredLogger
 }
@@ -56,12 +58,21 @@ func NewJetStreamBufferWriter(ctx context.Context, client *jsclient.Client, name
 		}
 	}
 
+	compressor, err := isb.NewCompressor(name, o.compression)
+	if err != nil {
+		return nil, err
+	}
+
 	js, err := client.JetStreamContext(nats.PublishAsyncMaxPending(1024))
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to get JetStream context for writer")
 	}
 
+	if err := ensurePriorityLanes(js, stream, subject, o.priorityLanes); err != nil {
+		return nil, err
+	}
+
 	result := &jetStreamWriter{
 		name:         name,
 		partitionIdx: partitionIdx,
@@ -70,6 +81,7 @@ func NewJetStreamBufferWriter(ctx context.Context, client *jsclient.Client, name
 		client:       client,
 		js:           js,
 		opts:         o,
+		compressor:   compressor,
 		isFull:       atomic.NewBool(true),
 		log:          logging.FromContext(ctx).With("bufferWriter", name).With("stream", stream).With("subject", subject).With("partitionIdx", partitionIdx),
 	}
@@ -93,14 +105,23 @@ func (jw *jetStreamWriter) runStatusChecker(ctx context.Context) {
 			jw.log.Errorw("Failed to get stream info in the writer", zap.Error(err))
 			return
 		}
-		c, err := js.ConsumerInfo(jw.stream, jw.stream)
-		if err != nil {
-			isbFullErrors.With(labels).Inc()
-			jw.log.Errorw("Failed to get consumer info in the writer", zap.Error(err))
-			return
+		// the consumers of all the priority lanes share the stream
+		var numPending, numAckPending int64
+		for lane := 0; lane < jw.opts.priorityLanes; lane++ {
+			c, err := js.ConsumerInfo(jw.stream, PriorityLaneConsumer(jw.stream, lane))
+			if err != nil {
+				isbFullErrors.With(labels).Inc()
+				jw.log.Errorw("Failed to get consumer info in the writer", zap.Error(err))
+				return
+			}
+			numPending += int64(c.NumPending)
+			numAckPending += int64(c.NumAckPending)
+			if jw.opts.priorityLanes > 1 {
+				isbPriorityLanePending.With(map[string]string{"buffer": jw.GetName(), "lane": strconv.Itoa(lane)}).Set(float64(c.NumPending))
+			}
 		}
 		var solidUsage, softUsage float64
-		softUsage = (float64(c.NumPending) + float64(c.NumAckPending)) / float64(jw.opts.maxLength)
+		softUsage = (float64(numPending) + float64(numAckPending)) / float64(jw.opts.maxLength)
 		if s.Config.Retention == nats.LimitsPolicy {
 			solidUsage = softUsage
 		} else {
@@ -116,13 +137,11 @@ func (jw *jetStreamWriter) runStatusChecker(ctx context.Context) {
 		}
 		isbSoftUsage.With(labels).Set(softUsage)
 		isbSolidUsage.With(labels).Set(solidUsage)
-		isbPending.With(labels).Set(float64(c.NumPending))
-		isbAckPending.With(labels).Set(float64(c.NumAckPending))
+		isbPending.With(labels).Set(float64(numPending))
+		isbAckPending.With(labels).Set(float64(numAckPending))
 
-		jw.log.Debugw("Consumption information", zap.Any("totalMsgs", s.State.Msgs), zap.Any("pending", c.NumPending),
-			zap.Any("ackPending", c.NumAckPending), zap.Any("waiting", c.NumWaiting),
-			zap.Any("ackFloorStreamId", c.AckFloor.Stream), zap.Any("deliveredStreamId", c.Delivered.Stream),
-			zap.Float64("solidUsage", solidUsage), zap.Float64("softUsage", softUsage))
+		jw.log.Debugw("Consumption information", zap.Any("totalMsgs", s.State.Msgs), zap.Any("pending", numPending),
+			zap.Any("ackPending", numAckPending), zap.Float64("solidUsage", solidUsage), zap.Float64("softUsage", softUsage))
 	}
 	checkStatus()
 
@@ -188,13 +207,14 @@ func (jw *jetStreamWriter) asyncWrite(_ context.Context, messages []isb.Message,
 	var writeOffsets = make([]isb.Offset, len(messages))
 	var futures = make([]nats.PubAckFuture, len(messages))
 	for index, message := range messages {
-		payload, err := message.MarshalBinary()
+		subject, message := jw.route(message)
+		payload, err := jw.encode(message)
 		if err != nil {
 			errs[index] = err
 			continue
 		}
 		m := &nats.Msg{
-			Subject: jw.subject,
+			Subject: subject,
 			Data:    payload,
 		}
 		var pubOpts []nats.PubOpt
@@ -265,13 +285,14 @@ func (jw *jetStreamWriter) syncWrite(_ context.Context, messages []isb.Message,
 		wg.Add(1)
 		go func(message isb.Message, idx int) {
 			defer wg.Done()
-			payload, err := message.MarshalBinary()
+			subject, message := jw.route(message)
+			payload, err := jw.encode(message)
 			if err != nil {
 				errs[idx] = err
 				return
 			}
 			m := &nats.Msg{
-				Subject: jw.subject,
+				Subject: subject,
 				Data:    payload,
 			}
 			pubOpts := []nats.PubOpt{nats.AckWait(2 * time.Second)}
@@ -325,3 +346,30 @@ func (w *writeOffset) NoAck() error {
 func (w *writeOffset) PartitionIdx() int32 {
 	return w.partitionIdx
 }
+
+// route returns the subject of the priority lane in the header of the message, and the message without it.
+// The lane greater than the highest one of the buffer is treated as the highest one.
+func (jw *jetStreamWriter) route(message isb.Message) (string, isb.Message) {
+	v, ok := message.Headers[v1alpha1.KeyMetaPriorityLane]
+	if !ok {
+		return jw.subject, message
+	}
+	headers := make(map[string]string, len(message.Headers)-1)
+	for k, h := range message.Headers {
+		if k != v1alpha1.KeyMetaPriorityLane {
+			headers[k] = h
+		}
+	}
+	message.Headers = headers
+	lane, _ := strconv.Atoi(v)
+	return PriorityLaneSubject(jw.subject, min(max(lane, 0), jw.opts.priorityLanes-1)), message
+}
+
+// encode compresses the payload of the message if configured, and marshals it for writing.
+func (jw *jetStreamWriter) encode(message isb.Message) ([]byte, error) {
+	message, err := jw.compressor.Compress(message)
+	if err != nil {
+		return nil, err
+	}
+	return message.MarshalBinary()
+}
@@ -169,6 +169,9 @@ func getHeaderAndBody(field string, value interface{}) (msg isb.Message, err err
 	}
 
 	msg.Body.Payload = []byte(value.(string))
+	if err = msg.Decompress(); err != nil {
+		return msg, err
+	}
 	return msg, nil
 }
 
@@ -301,7 +304,12 @@ func (br *BufferRead) setError(errMsg string, err error) {
 	br.setIsEmptyFlag(false)
 }
 
-func (br *BufferRead) Pending(_ context.Context) (int64, error) {
-	// TODO: not implemented
-	return isb.PendingNotAvailable, nil
+// Pending returns the number of the messages in the stream not delivered to the consumer group yet, plus the ones
+// delivered but not acknowledged, which is the same as the pending count of a JetStream buffer.
+func (br *BufferRead) Pending(ctx context.Context) (int64, error) {
+	pending, ackPending, err := br.StreamGroupPending(ctx, br.GetStreamName(), br.GetGroupName())
+	if err != nil {
+		return isb.PendingNotAvailable, err
+	}
+	return pending + ackPending, nil
 }
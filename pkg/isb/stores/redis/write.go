@@ -48,7 +48,8 @@ type BufferWrite struct {
 	*BufferWriteInfo
 	*redisclient.RedisClient
 	redisclient.Options
-	log *zap.SugaredLogger
+	compressor *isb.Compressor
+	log        *zap.SugaredLogger
 }
 
 // BufferWriteInfo will contain the buffer infoRefreshInterval from the writer point of view.
@@ -103,6 +104,13 @@ func NewBufferWrite(ctx context.Context, client *redisclient.RedisClient, name s
 
 	rqw.log = logging.FromContext(ctx).With("bufferWriter", rqw.GetName())
 
+	compressor, err := isb.NewCompressor(name, options.Compression)
+	if err != nil {
+		// the compression is validated with the pipeline, fall back to uncompressed payloads
+		rqw.log.Errorw("Invalid compression, writing uncompressed payloads", zap.Error(err))
+	}
+	rqw.compressor = compressor
+
 	// setWriteInfo is used to update isFull flag and minId once
 	rqw.setWriteInfo(ctx)
 
@@ -178,6 +186,11 @@ func (bw *BufferWrite) Write(_ context.Context, messages []isb.Message) ([]isb.O
 	// Maybe just do pipelined write, always?
 	if !bw.Pipelining {
 		for idx, message := range messages {
+			message, err := bw.compressor.Compress(message)
+			if err != nil {
+				errs[idx] = err
+				continue
+			}
 			// Reference the Payload in Body directly when writing to Redis ISB to avoid extra marshaling.
 			// TODO: revisit directly Payload reference when Body structure changes
 			errs[idx] = script.Run(ctx, bw.Client, []string{bw.GetHashKeyName(message.EventTime), bw.Stream}, message.Header.ID.String(), message.Header, message.Body.Payload, bw.BufferWriteInfo.minId.String()).Err()
@@ -215,6 +228,11 @@ func (bw *BufferWrite) pipelinedWrite(ctx context.Context, script *redis.Script,
 	pipe := bw.Client.Pipeline()
 
 	for idx, message := range messages {
+		message, err := bw.compressor.Compress(message)
+		if err != nil {
+			errs[idx] = err
+			continue
+		}
 		// Reference the Payload in Body directly when writing to Redis ISB to avoid extra marshaling.
 		// TODO: revisit directly Payload reference when Body structure changes
 		headerBytes, err := message.Header.MarshalBinary()
@@ -236,6 +254,10 @@ func (bw *BufferWrite) pipelinedWrite(ctx context.Context, script *redis.Script,
 	}
 
 	for idx, cmd := range cmds {
+		if cmd == nil {
+			// the message failed to encode, and was not written
+			continue
+		}
 		err := cmd.Err()
 		if err != nil && strings.HasPrefix(err.Error(), "NOSCRIPT ") {
 			scriptMissing = true
@@ -251,6 +273,11 @@ func (bw *BufferWrite) GetHashKeyName(startTime time.Time) string {
 	return fmt.Sprintf("%s-h-%d", bw.Stream, startTime.Truncate(exactlyOnceHashWindow).Unix())
 }
 
+// HashKeysPattern returns the pattern of the hash keys of a stream, which deduplicate the messages written to it.
+func HashKeysPattern(stream string) string {
+	return stream + "-h-*"
+}
+
 // GetStreamName gets the stream name. Stream name is derived from the name.
 func (bw *BufferWrite) GetStreamName() string {
 	return bw.Stream
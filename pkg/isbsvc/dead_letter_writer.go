@@ -0,0 +1,81 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package isbsvc
+
+import (
+	"context"
+	"fmt"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	diskisb "github.com/numaproj/numaflow/pkg/isb/stores/disk"
+	jetstreamisb "github.com/numaproj/numaflow/pkg/isb/stores/jetstream"
+	redisisb "github.com/numaproj/numaflow/pkg/isb/stores/redis"
+	jsclient "github.com/numaproj/numaflow/pkg/shared/clients/nats"
+	redisclient "github.com/numaproj/numaflow/pkg/shared/clients/redis"
+)
+
+// The dead-letter writers use the default buffer full writing strategy, which retries the writes when the
+// dead-letter buffer is full, since the original messages are acked once they are dead-lettered.
+
+// NewRedisDeadLetterWriter returns the writer of the dead-letter buffer of the vertex, or nil if it's not enabled.
+func NewRedisDeadLetterWriter(ctx context.Context, vertex *dfv1.Vertex, client *redisclient.RedisClient) (isb.BufferWriter, error) {
+	buffer := vertex.GetDeadLetterBuffer()
+	if buffer == "" {
+		return nil, nil
+	}
+	var writeOpts []redisclient.Option
+	if x := vertex.Spec.Limits; x != nil && x.BufferMaxLength != nil {
+		writeOpts = append(writeOpts, redisclient.WithMaxLength(int64(*x.BufferMaxLength)))
+	}
+	return redisisb.NewBufferWrite(ctx, client, buffer, buffer+"-group", 0, writeOpts...), nil
+}
+
+// NewJetStreamDeadLetterWriter returns the writer of the dead-letter buffer of the vertex, or nil if it's not enabled.
+func NewJetStreamDeadLetterWriter(ctx context.Context, vertex *dfv1.Vertex, client *jsclient.Client) (isb.BufferWriter, error) {
+	buffer := vertex.GetDeadLetterBuffer()
+	if buffer == "" {
+		return nil, nil
+	}
+	var writeOpts []jetstreamisb.WriteOption
+	if x := vertex.Spec.Limits; x != nil && x.BufferMaxLength != nil {
+		writeOpts = append(writeOpts, jetstreamisb.WithMaxLength(int64(*x.BufferMaxLength)))
+	}
+	streamName := JetStreamName(buffer)
+	writer, err := jetstreamisb.NewJetStreamBufferWriter(ctx, client, buffer, streamName, streamName, 0, writeOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the writer of dead-letter buffer %q, %w", buffer, err)
+	}
+	return writer, nil
+}
+
+// NewDiskDeadLetterWriter returns the writer of the dead-letter buffer of the vertex, or nil if it's not enabled.
+func NewDiskDeadLetterWriter(ctx context.Context, vertex *dfv1.Vertex) (isb.BufferWriter, error) {
+	buffer := vertex.GetDeadLetterBuffer()
+	if buffer == "" {
+		return nil, nil
+	}
+	var writeOpts []diskisb.WriteOption
+	if x := vertex.Spec.Limits; x != nil && x.BufferMaxLength != nil {
+		writeOpts = append(writeOpts, diskisb.WithMaxLength(int64(*x.BufferMaxLength)))
+	}
+	writer, err := diskisb.NewDiskBufferWriter(ctx, dfv1.PathISBSvcDiskMount, buffer, 0, writeOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the writer of dead-letter buffer %q, %w", buffer, err)
+	}
+	return writer, nil
+}
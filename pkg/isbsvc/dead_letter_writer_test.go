@@ -0,0 +1,78 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package isbsvc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	nats2 "github.com/numaproj/numaflow/pkg/shared/clients/nats"
+	"github.com/numaproj/numaflow/pkg/shared/clients/nats/test"
+)
+
+func TestNewDeadLetterWriter(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	vertex := &dfv1.Vertex{Spec: dfv1.VertexSpec{
+		PipelineName: "test-pl",
+		AbstractVertex: dfv1.AbstractVertex{
+			Name: "map",
+			UDF:  &dfv1.UDF{Builtin: &dfv1.Function{Name: "cat"}},
+		},
+	}}
+
+	t.Run("not enabled", func(t *testing.T) {
+		w, err := NewRedisDeadLetterWriter(ctx, vertex, nil)
+		assert.NoError(t, err)
+		assert.Nil(t, w)
+		w, err = NewJetStreamDeadLetterWriter(ctx, vertex, nil)
+		assert.NoError(t, err)
+		assert.Nil(t, w)
+		w, err = NewDiskDeadLetterWriter(ctx, vertex)
+		assert.NoError(t, err)
+		assert.Nil(t, w)
+	})
+
+	t.Run("jetstream", func(t *testing.T) {
+		s := test.RunJetStreamServer(t)
+		defer test.ShutdownJetStreamServer(t, s)
+		client := nats2.NewTestClient(t, s.ClientURL())
+		defer client.Close()
+
+		vertex := vertex.DeepCopy()
+		vertex.Spec.DeadLetter = &dfv1.DeadLetter{}
+		isbSvc, err := NewISBJetStreamSvc(client)
+		require.NoError(t, err)
+		require.NoError(t, isbSvc.CreateBuffersAndBuckets(ctx, []string{vertex.GetDeadLetterBuffer()}, nil, "", ""))
+
+		w, err := NewJetStreamDeadLetterWriter(ctx, vertex, client)
+		require.NoError(t, err)
+		assert.Equal(t, vertex.GetDeadLetterBuffer(), w.GetName())
+		// the writer considers the buffer full until the first buffer info refresh
+		msgs := []isb.Message{{Header: isb.Header{ID: isb.MessageID{VertexName: "in", Offset: "1"}}}}
+		assert.Eventually(t, func() bool {
+			_, errs := w.Write(ctx, msgs)
+			return errs[0] == nil
+		}, 5*time.Second, 100*time.Millisecond)
+	})
+}
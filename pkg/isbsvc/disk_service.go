@@ -0,0 +1,213 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package isbsvc
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"go.uber.org/zap"
+
+	"github.com/numaproj/numaflow/pkg/isb"
+	diskisb "github.com/numaproj/numaflow/pkg/isb/stores/disk"
+	"github.com/numaproj/numaflow/pkg/shared/deadletter"
+	diskkv "github.com/numaproj/numaflow/pkg/shared/kvs/disk"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+	wmstore "github.com/numaproj/numaflow/pkg/watermark/store"
+)
+
+type isbsDiskSvc struct {
+	// rootDir is the mount path of the disk ISB Service volume
+	rootDir string
+}
+
+// NewISBDiskSvc is used to return a new object of type isbsDiskSvc
+func NewISBDiskSvc(rootDir string) ISBService {
+	return &isbsDiskSvc{rootDir: rootDir}
+}
+
+// CreateBuffersAndBuckets is used to create the buffer and bucket directories on the volume.
+func (d *isbsDiskSvc) CreateBuffersAndBuckets(ctx context.Context, buffers, buckets []string, sideInputsStore string, servingSourceStore string, opts ...CreateOption) error {
+	if servingSourceStore != "" {
+		return fmt.Errorf("serving source store is not supported by the disk ISB Service")
+	}
+	log := logging.FromContext(ctx)
+	for _, buffer := range buffers {
+		if err := diskisb.CreateBuffer(d.rootDir, buffer); err != nil {
+			return fmt.Errorf("failed to create buffer %q, %w", buffer, err)
+		}
+		log.Infow("Succeeded to create a buffer", zap.String("buffer", buffer))
+	}
+	for _, bucket := range buckets {
+		for _, kvName := range []string{wmstore.JetStreamOTKVName(bucket), wmstore.JetStreamProcessorKVName(bucket)} {
+			if err := diskkv.CreateBucket(d.rootDir, kvName); err != nil {
+				return fmt.Errorf("failed to create bucket %q, %w", kvName, err)
+			}
+		}
+		log.Infow("Succeeded to create the watermark buckets", zap.String("bucket", bucket))
+	}
+	if sideInputsStore != "" {
+		kvName := JetStreamSideInputsStoreKVName(sideInputsStore)
+		if err := diskkv.CreateBucket(d.rootDir, kvName); err != nil {
+			return fmt.Errorf("failed to create side inputs bucket %q, %w", kvName, err)
+		}
+		log.Infow("Succeeded to create a side inputs bucket", zap.String("bucket", kvName))
+	}
+	return nil
+}
+
+// DeleteBuffersAndBuckets is used to delete the buffer and bucket directories on the volume.
+func (d *isbsDiskSvc) DeleteBuffersAndBuckets(ctx context.Context, buffers, buckets []string, sideInputsStore string, servingSourceStore string) error {
+	log := logging.FromContext(ctx)
+	for _, buffer := range buffers {
+		if err := diskisb.DeleteBuffer(d.rootDir, buffer); err != nil {
+			return fmt.Errorf("failed to delete buffer %q, %w", buffer, err)
+		}
+		log.Infow("Succeeded to delete a buffer", zap.String("buffer", buffer))
+	}
+	for _, bucket := range buckets {
+		for _, kvName := range []string{wmstore.JetStreamOTKVName(bucket), wmstore.JetStreamProcessorKVName(bucket)} {
+			if err := diskkv.DeleteBucket(d.rootDir, kvName); err != nil {
+				return fmt.Errorf("failed to delete bucket %q, %w", kvName, err)
+			}
+		}
+		log.Infow("Succeeded to delete the watermark buckets", zap.String("bucket", bucket))
+	}
+	if sideInputsStore != "" {
+		kvName := JetStreamSideInputsStoreKVName(sideInputsStore)
+		if err := diskkv.DeleteBucket(d.rootDir, kvName); err != nil {
+			return fmt.Errorf("failed to delete side inputs bucket %q, %w", kvName, err)
+		}
+		log.Infow("Succeeded to delete a side inputs bucket", zap.String("bucket", kvName))
+	}
+	return nil
+}
+
+// ValidateBuffersAndBuckets is used to validate the buffer and bucket directories exist.
+func (d *isbsDiskSvc) ValidateBuffersAndBuckets(ctx context.Context, buffers, buckets []string, sideInputsStore string, servingSourceStore string) error {
+	if servingSourceStore != "" {
+		return fmt.Errorf("serving source store is not supported by the disk ISB Service")
+	}
+	for _, buffer := range buffers {
+		if err := diskisb.ValidateBuffer(d.rootDir, buffer); err != nil {
+			return err
+		}
+	}
+	for _, bucket := range buckets {
+		for _, kvName := range []string{wmstore.JetStreamOTKVName(bucket), wmstore.JetStreamProcessorKVName(bucket)} {
+			if err := diskkv.ValidateBucket(d.rootDir, kvName); err != nil {
+				return err
+			}
+		}
+	}
+	if sideInputsStore != "" {
+		if err := diskkv.ValidateBucket(d.rootDir, JetStreamSideInputsStoreKVName(sideInputsStore)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetBufferInfo is used to provide buffer information like pending count, buffer length, has unprocessed data etc.
+func (d *isbsDiskSvc) GetBufferInfo(ctx context.Context, buffer string) (*BufferInfo, error) {
+	info, err := diskisb.GetBufferInfo(d.rootDir, buffer)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get information of buffer %q, %w", buffer, err)
+	}
+	return &BufferInfo{
+		Name:            buffer,
+		PendingCount:    info.Pending - info.AckPending,
+		AckPendingCount: info.AckPending,
+		TotalMessages:   info.Pending,
+	}, nil
+}
+
+// CreateWatermarkStores is used to create watermark stores.
+func (d *isbsDiskSvc) CreateWatermarkStores(ctx context.Context, bucketName string, fromBufferPartitionCount int, isReduce bool) ([]wmstore.WatermarkStore, error) {
+	log := logging.FromContext(ctx).With("bucket", bucketName)
+	ctx = logging.WithLogger(ctx, log)
+	var wmStores []wmstore.WatermarkStore
+	partitions := 1
+	if isReduce {
+		partitions = fromBufferPartitionCount
+	}
+	for i := 0; i < partitions; i++ {
+		wmStore, err := wmstore.BuildDiskWatermarkStore(ctx, bucketName, d.rootDir)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create new disk watermark store, %w", err)
+		}
+		wmStores = append(wmStores, wmStore)
+	}
+	return wmStores, nil
+}
+
+func (d *isbsDiskSvc) ListDeadLetterMessages(ctx context.Context, buffer, fromOffset string, limit int) ([]*DeadLetterMessage, error) {
+	var from int64
+	if fromOffset != "" {
+		seq, err := strconv.ParseInt(fromOffset, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid offset %q, %w", fromOffset, err)
+		}
+		from = seq
+	}
+	messages, err := diskisb.ListMessages(d.rootDir, buffer, from, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the messages of buffer %q, %w", buffer, err)
+	}
+	result := make([]*DeadLetterMessage, 0, len(messages))
+	for _, m := range messages {
+		result = append(result, &DeadLetterMessage{Offset: strconv.FormatInt(m.Seq, 10), Message: *m.Message})
+	}
+	return result, nil
+}
+
+func (d *isbsDiskSvc) GetDeadLetterMessage(ctx context.Context, buffer, offset string) (*DeadLetterMessage, error) {
+	seq, err := strconv.ParseInt(offset, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid offset %q, %w", offset, err)
+	}
+	m, err := diskisb.GetMessage(d.rootDir, buffer, seq)
+	if err != nil {
+		return nil, err
+	}
+	return &DeadLetterMessage{Offset: offset, Message: *m}, nil
+}
+
+func (d *isbsDiskSvc) ReplayDeadLetterMessage(ctx context.Context, buffer, offset string, toBuffers []string) error {
+	if len(toBuffers) == 0 {
+		return fmt.Errorf("no buffer to replay the message to")
+	}
+	m, err := d.GetDeadLetterMessage(ctx, buffer, offset)
+	if err != nil {
+		return err
+	}
+	toBuffer := toBuffers[deadletter.Partition(m.Message)%len(toBuffers)]
+	writer, err := diskisb.NewDiskBufferWriter(ctx, d.rootDir, toBuffer, 0)
+	if err != nil {
+		return fmt.Errorf("failed to create a writer of buffer %q, %w", toBuffer, err)
+	}
+	defer func() { _ = writer.Close() }()
+	if _, errs := writer.Write(ctx, []isb.Message{deadletter.ToReplayMessage(m.Message, offset)}); errs[0] != nil {
+		return fmt.Errorf("failed to replay the message at offset %q to buffer %q, %w", offset, toBuffer, errs[0])
+	}
+	seq, _ := strconv.ParseInt(offset, 10, 64)
+	if err := diskisb.RemoveMessage(d.rootDir, buffer, seq); err != nil {
+		return fmt.Errorf("failed to delete the replayed message at offset %q, %w", offset, err)
+	}
+	return nil
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package isbsvc
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	diskisb "github.com/numaproj/numaflow/pkg/isb/stores/disk"
+	"github.com/numaproj/numaflow/pkg/shared/deadletter"
+)
+
+func TestDiskSvc_CreationDeletionValidation(t *testing.T) {
+	ctx := context.Background()
+	isbSvc := NewISBDiskSvc(t.TempDir())
+
+	buffers := []string{"test-buffer-1", "test-buffer-2"}
+	buckets := []string{"test-bucket-1", "test-bucket-2"}
+	sideInputStore := "test-side-input-store"
+
+	assert.Error(t, isbSvc.ValidateBuffersAndBuckets(ctx, buffers, buckets, sideInputStore, ""))
+	assert.NoError(t, isbSvc.CreateBuffersAndBuckets(ctx, buffers, buckets, sideInputStore, ""))
+	// creation is idempotent
+	assert.NoError(t, isbSvc.CreateBuffersAndBuckets(ctx, buffers, buckets, sideInputStore, ""))
+	assert.NoError(t, isbSvc.ValidateBuffersAndBuckets(ctx, buffers, buckets, sideInputStore, ""))
+	assert.NoError(t, isbSvc.DeleteBuffersAndBuckets(ctx, buffers, buckets, sideInputStore, ""))
+	assert.Error(t, isbSvc.ValidateBuffersAndBuckets(ctx, buffers, buckets, sideInputStore, ""))
+
+	// serving source store is not supported
+	assert.Error(t, isbSvc.CreateBuffersAndBuckets(ctx, buffers, buckets, sideInputStore, "test-serving-store"))
+}
+
+func TestDiskSvc_GetBufferInfo(t *testing.T) {
+	ctx := context.Background()
+	rootDir := t.TempDir()
+	isbSvc := NewISBDiskSvc(rootDir)
+
+	buffer := "test-buffer"
+	assert.NoError(t, isbSvc.CreateBuffersAndBuckets(ctx, []string{buffer}, nil, "", ""))
+
+	writer, err := diskisb.NewDiskBufferWriter(ctx, rootDir, buffer, 0)
+	assert.NoError(t, err)
+	defer func() { _ = writer.Close() }()
+	var messages []isb.Message
+	for i := 0; i < 3; i++ {
+		messages = append(messages, isb.Message{
+			Header: isb.Header{ID: isb.MessageID{VertexName: "in", Offset: strconv.Itoa(i)}},
+			Body:   isb.Body{Payload: []byte("payload")},
+		})
+	}
+	_, errs := writer.Write(ctx, messages)
+	for _, err := range errs {
+		assert.NoError(t, err)
+	}
+
+	reader, err := diskisb.NewDiskBufferReader(ctx, rootDir, buffer, "test-consumer", 0)
+	assert.NoError(t, err)
+	defer func() { _ = reader.Close() }()
+	read, err := reader.Read(ctx, 1)
+	assert.NoError(t, err)
+	assert.Len(t, read, 1)
+
+	info, err := isbSvc.GetBufferInfo(ctx, buffer)
+	assert.NoError(t, err)
+	assert.Equal(t, buffer, info.Name)
+	assert.Equal(t, int64(2), info.PendingCount)
+	assert.Equal(t, int64(1), info.AckPendingCount)
+	assert.Equal(t, int64(3), info.TotalMessages)
+}
+
+func TestDiskSvc_CreateWatermarkStores(t *testing.T) {
+	ctx := context.Background()
+	isbSvc := NewISBDiskSvc(t.TempDir())
+
+	assert.NoError(t, isbSvc.CreateBuffersAndBuckets(ctx, []string{"test-buffer"}, []string{"test-bucket"}, "", ""))
+	stores, err := isbSvc.CreateWatermarkStores(ctx, "test-bucket", 3, true)
+	assert.NoError(t, err)
+	assert.Len(t, stores, 3)
+	for _, s := range stores {
+		_ = s.Close()
+	}
+}
+
+func TestDiskSvc_DeadLetterMessages(t *testing.T) {
+	ctx := context.Background()
+	rootDir := t.TempDir()
+	isbSvc := NewISBDiskSvc(rootDir)
+
+	dlqBuffer := "test-pl-map-dlq"
+	toBuffers := []string{"test-pl-map-0", "test-pl-map-1"}
+	assert.NoError(t, isbSvc.CreateBuffersAndBuckets(ctx, append([]string{dlqBuffer}, toBuffers...), nil, "", ""))
+
+	writer, err := diskisb.NewDiskBufferWriter(ctx, rootDir, dlqBuffer, 0)
+	assert.NoError(t, err)
+	for i := 0; i < 3; i++ {
+		msg := isb.Message{
+			Header: isb.Header{ID: isb.MessageID{VertexName: "in", Offset: strconv.Itoa(i)}, Keys: []string{"k"}},
+			Body:   isb.Body{Payload: []byte(fmt.Sprintf("payload-%d", i))},
+		}
+		_, errs := writer.Write(ctx, []isb.Message{deadletter.NewMessage(msg, "map", 1, 3, fmt.Errorf("udf error"))})
+		assert.NoError(t, errs[0])
+	}
+	assert.NoError(t, writer.Close())
+
+	messages, err := isbSvc.ListDeadLetterMessages(ctx, dlqBuffer, "", 10)
+	assert.NoError(t, err)
+	assert.Len(t, messages, 3)
+	messages, err = isbSvc.ListDeadLetterMessages(ctx, dlqBuffer, "2", 1)
+	assert.NoError(t, err)
+	assert.Len(t, messages, 1)
+	assert.Equal(t, "2", messages[0].Offset)
+
+	m, err := isbSvc.GetDeadLetterMessage(ctx, dlqBuffer, "1")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("payload-0"), m.Message.Payload)
+	assert.Equal(t, "udf error", m.Message.Headers[dfv1.KeyMetaDeadLetterError])
+
+	assert.NoError(t, isbSvc.ReplayDeadLetterMessage(ctx, dlqBuffer, "1", toBuffers))
+	// the replayed message is removed from the dead-letter buffer
+	messages, err = isbSvc.ListDeadLetterMessages(ctx, dlqBuffer, "", 10)
+	assert.NoError(t, err)
+	assert.Len(t, messages, 2)
+	_, err = isbSvc.GetDeadLetterMessage(ctx, dlqBuffer, "1")
+	assert.ErrorIs(t, err, diskisb.ErrMessageNotFound)
+
+	// the message is replayed to the partition it was read from, without the dead-letter metadata
+	replayed, err := diskisb.GetMessage(rootDir, toBuffers[1], 1)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("payload-0"), replayed.Payload)
+	assert.Empty(t, replayed.Headers[dfv1.KeyMetaDeadLetterError])
+	assert.Equal(t, "0-replay-1", replayed.ID.Offset)
+}
@@ -19,6 +19,7 @@ package isbsvc
 import (
 	"context"
 
+	"github.com/numaproj/numaflow/pkg/isb"
 	"github.com/numaproj/numaflow/pkg/watermark/store"
 )
 
@@ -34,6 +35,14 @@ type ISBService interface {
 	GetBufferInfo(ctx context.Context, buffer string) (*BufferInfo, error)
 	// CreateWatermarkStores creates watermark stores
 	CreateWatermarkStores(ctx context.Context, bucketName string, partitions int, isReduce bool) ([]store.WatermarkStore, error)
+	// ListDeadLetterMessages returns at most limit messages of the dead-letter buffer, starting from the given offset.
+	// An empty offset means starting from the earliest message.
+	ListDeadLetterMessages(ctx context.Context, buffer, fromOffset string, limit int) ([]*DeadLetterMessage, error)
+	// GetDeadLetterMessage returns the message at the given offset of the dead-letter buffer
+	GetDeadLetterMessage(ctx context.Context, buffer, offset string) (*DeadLetterMessage, error)
+	// ReplayDeadLetterMessage writes the message at the given offset of the dead-letter buffer back to the partition
+	// of toBuffers it was read from, and then removes it from the dead-letter buffer.
+	ReplayDeadLetterMessage(ctx context.Context, buffer, offset string, toBuffers []string) error
 }
 
 // createOptions describes the options for creating buffers and buckets
@@ -58,4 +67,13 @@ type BufferInfo struct {
 	PendingCount    int64
 	AckPendingCount int64
 	TotalMessages   int64
+	// PriorityPendingCounts are the pending counts of the priority lanes, keyed by the lanes. It is empty if the
+	// buffer does not have priority lanes.
+	PriorityPendingCounts map[int32]int64
+}
+
+// DeadLetterMessage wraps a message in a dead-letter buffer and its offset
+type DeadLetterMessage struct {
+	Offset  string
+	Message isb.Message
 }
@@ -21,13 +21,16 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/nats-io/nats.go"
 	"github.com/spf13/viper"
 	"go.uber.org/zap"
 
+	jetstreamisb "github.com/numaproj/numaflow/pkg/isb/stores/jetstream"
 	jsclient "github.com/numaproj/numaflow/pkg/shared/clients/nats"
+	"github.com/numaproj/numaflow/pkg/shared/deadletter"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
 	wmstore "github.com/numaproj/numaflow/pkg/watermark/store"
 )
@@ -271,6 +274,13 @@ func (jss *jetStreamSvc) DeleteBuffersAndBuckets(ctx context.Context, buffers, b
 			return fmt.Errorf("failed to delete processor KV %q, %w", procKVName, err)
 		}
 		log.Infow("Succeeded to delete a processor KV", zap.String("kvName", procKVName))
+		// the checkpoint KV only exists for the sinks with exactly-once delivery.
+		ckptKVName := JetStreamCheckpointKVName(bucket)
+		if err := jss.js.DeleteKeyValue(ckptKVName); err == nil {
+			log.Infow("Succeeded to delete a checkpoint KV", zap.String("kvName", ckptKVName))
+		} else if !errors.Is(err, nats.ErrBucketNotFound) && !errors.Is(err, nats.ErrStreamNotFound) {
+			return fmt.Errorf("failed to delete checkpoint KV %q, %w", ckptKVName, err)
+		}
 	}
 
 	if sideInputsStore != "" {
@@ -355,15 +365,27 @@ func (jss *jetStreamSvc) GetBufferInfo(ctx context.Context, buffer string) (*Buf
 	if err != nil {
 		return nil, fmt.Errorf("failed to get consumer information of stream %q", streamName)
 	}
-	totalMessages := int64(stream.State.Msgs)
-	if stream.Config.Retention == nats.LimitsPolicy {
-		totalMessages = int64(consumer.NumPending) + int64(consumer.NumAckPending)
-	}
 	bufferInfo := &BufferInfo{
 		Name:            buffer,
 		PendingCount:    int64(consumer.NumPending),
 		AckPendingCount: int64(consumer.NumAckPending),
-		TotalMessages:   totalMessages,
+	}
+	// the messages of the priority lanes are pending in the consumers of the lanes
+	if len(stream.Config.Subjects) > 1 {
+		bufferInfo.PriorityPendingCounts = map[int32]int64{0: int64(consumer.NumPending)}
+		for c := range jss.js.ConsumersInfo(streamName, nats.Context(ctx)) {
+			lane, ok := jetstreamisb.ParsePriorityLaneConsumer(streamName, c.Name)
+			if !ok || lane == 0 {
+				continue
+			}
+			bufferInfo.PriorityPendingCounts[int32(lane)] = int64(c.NumPending)
+			bufferInfo.PendingCount += int64(c.NumPending)
+			bufferInfo.AckPendingCount += int64(c.NumAckPending)
+		}
+	}
+	bufferInfo.TotalMessages = int64(stream.State.Msgs)
+	if stream.Config.Retention == nats.LimitsPolicy {
+		bufferInfo.TotalMessages = bufferInfo.PendingCount + bufferInfo.AckPendingCount
 	}
 	return bufferInfo, nil
 }
@@ -392,6 +414,11 @@ func JetStreamName(bufferName string) string {
 	return bufferName
 }
 
+// JetStreamCheckpointKVName returns the name of the KV storing the sink checkpoints of a bucket.
+func JetStreamCheckpointKVName(bucketName string) string {
+	return fmt.Sprintf("%s_CKPT", bucketName)
+}
+
 func JetStreamSideInputsStoreKVName(sideInputStoreName string) string {
 	return fmt.Sprintf("%s_SIDE_INPUTS", sideInputStoreName)
 }
@@ -407,3 +434,81 @@ func JetStreamServingResponseStoreName(servingSourceStoreName string) string {
 func JetStreamServingStatusStoreName(servingSourceStoreName string) string {
 	return fmt.Sprintf("%s_SERVING_STATUS_STORE", servingSourceStoreName)
 }
+
+func (jss *jetStreamSvc) ListDeadLetterMessages(ctx context.Context, buffer, fromOffset string, limit int) ([]*DeadLetterMessage, error) {
+	streamName := JetStreamName(buffer)
+	stream, err := jss.js.StreamInfo(streamName, nats.Context(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get information of stream %q, %w", streamName, err)
+	}
+	seq := stream.State.FirstSeq
+	if fromOffset != "" {
+		from, err := strconv.ParseUint(fromOffset, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid offset %q, %w", fromOffset, err)
+		}
+		seq = max(seq, from)
+	}
+	var result []*DeadLetterMessage
+	for ; seq <= stream.State.LastSeq && len(result) < limit; seq++ {
+		m, err := jss.getDeadLetterMessage(ctx, streamName, seq)
+		if err != nil {
+			// the message has been replayed or discarded
+			if errors.Is(err, nats.ErrMsgNotFound) {
+				continue
+			}
+			return nil, err
+		}
+		result = append(result, m)
+	}
+	return result, nil
+}
+
+func (jss *jetStreamSvc) GetDeadLetterMessage(ctx context.Context, buffer, offset string) (*DeadLetterMessage, error) {
+	seq, err := strconv.ParseUint(offset, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid offset %q, %w", offset, err)
+	}
+	return jss.getDeadLetterMessage(ctx, JetStreamName(buffer), seq)
+}
+
+func (jss *jetStreamSvc) ReplayDeadLetterMessage(ctx context.Context, buffer, offset string, toBuffers []string) error {
+	if len(toBuffers) == 0 {
+		return fmt.Errorf("no buffer to replay the message to")
+	}
+	seq, err := strconv.ParseUint(offset, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid offset %q, %w", offset, err)
+	}
+	streamName := JetStreamName(buffer)
+	m, err := jss.getDeadLetterMessage(ctx, streamName, seq)
+	if err != nil {
+		return err
+	}
+	toBuffer := toBuffers[deadletter.Partition(m.Message)%len(toBuffers)]
+	msg := deadletter.ToReplayMessage(m.Message, offset)
+	payload, err := msg.MarshalBinary()
+	if err != nil {
+		return fmt.Errorf("failed to marshal the message at offset %q, %w", offset, err)
+	}
+	toStreamName := JetStreamName(toBuffer)
+	if _, err := jss.js.PublishMsg(&nats.Msg{Subject: toStreamName, Data: payload}, nats.MsgId(msg.ID.String()), nats.Context(ctx)); err != nil {
+		return fmt.Errorf("failed to replay the message at offset %q to stream %q, %w", offset, toStreamName, err)
+	}
+	if err := jss.js.DeleteMsg(streamName, seq, nats.Context(ctx)); err != nil {
+		return fmt.Errorf("failed to delete the replayed message at offset %q, %w", offset, err)
+	}
+	return nil
+}
+
+func (jss *jetStreamSvc) getDeadLetterMessage(ctx context.Context, streamName string, seq uint64) (*DeadLetterMessage, error) {
+	raw, err := jss.js.GetMsg(streamName, seq, nats.Context(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the message at offset %d of stream %q, %w", seq, streamName, err)
+	}
+	m := &DeadLetterMessage{Offset: strconv.FormatUint(seq, 10)}
+	if err := m.Message.UnmarshalBinary(raw.Data); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal the message at offset %d of stream %q, %w", seq, streamName, err)
+	}
+	return m, nil
+}
@@ -2,13 +2,18 @@ package isbsvc
 
 import (
 	"context"
+	"fmt"
+	"strconv"
 	"testing"
 
 	"github.com/nats-io/nats.go"
 	"github.com/stretchr/testify/assert"
 
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
 	nats2 "github.com/numaproj/numaflow/pkg/shared/clients/nats"
 	"github.com/numaproj/numaflow/pkg/shared/clients/nats/test"
+	"github.com/numaproj/numaflow/pkg/shared/deadletter"
 )
 
 func TestJetstreamSvc_CreationDeletionValidation(t *testing.T) {
@@ -80,6 +85,53 @@ func TestJetstreamSvc_GetBufferInfo(t *testing.T) {
 	assert.Equal(t, int64(0), info.TotalMessages)
 }
 
+func TestJetstreamSvc_GetBufferInfo_PriorityLanes(t *testing.T) {
+	ctx := context.Background()
+	s := test.RunJetStreamServer(t)
+	defer test.ShutdownJetStreamServer(t, s)
+
+	client := nats2.NewTestClient(t, s.ClientURL())
+	defer client.Close()
+
+	jsCtx, err := client.JetStreamContext()
+	assert.NoError(t, err)
+
+	buffer := "test-buffer"
+	_, err = jsCtx.AddStream(&nats.StreamConfig{
+		Name:     buffer,
+		Subjects: []string{buffer, buffer + ".*"},
+	})
+	assert.NoError(t, err)
+	_, err = jsCtx.AddConsumer(buffer, &nats.ConsumerConfig{
+		Durable:       buffer,
+		FilterSubject: buffer,
+	})
+	assert.NoError(t, err)
+	_, err = jsCtx.AddConsumer(buffer, &nats.ConsumerConfig{
+		Durable:       buffer + "-priority-1",
+		FilterSubject: buffer + ".priority-1",
+	})
+	assert.NoError(t, err)
+	for i := 0; i < 2; i++ {
+		_, err = jsCtx.Publish(buffer, []byte("low"))
+		assert.NoError(t, err)
+	}
+	for i := 0; i < 3; i++ {
+		_, err = jsCtx.Publish(buffer+".priority-1", []byte("high"))
+		assert.NoError(t, err)
+	}
+
+	isbSvc, err := NewISBJetStreamSvc(client)
+	assert.NoError(t, err)
+
+	info, err := isbSvc.GetBufferInfo(ctx, buffer)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5), info.PendingCount)
+	assert.Equal(t, int64(0), info.AckPendingCount)
+	assert.Equal(t, int64(5), info.TotalMessages)
+	assert.Equal(t, map[int32]int64{0: 2, 1: 3}, info.PriorityPendingCounts)
+}
+
 func TestJetstreamSvc_CreateWatermarkStores(t *testing.T) {
 	ctx := context.Background()
 	s := test.RunJetStreamServer(t)
@@ -104,3 +156,64 @@ func TestJetstreamSvc_CreateWatermarkStores(t *testing.T) {
 	_, err = isbSvc.CreateWatermarkStores(ctx, bucketName, partitions, false)
 	assert.NoError(t, err)
 }
+
+func TestJetstreamSvc_DeadLetterMessages(t *testing.T) {
+	ctx := context.Background()
+	s := test.RunJetStreamServer(t)
+	defer test.ShutdownJetStreamServer(t, s)
+
+	client := nats2.NewTestClient(t, s.ClientURL())
+	defer client.Close()
+
+	isbSvc, err := NewISBJetStreamSvc(client)
+	assert.NoError(t, err)
+
+	dlqBuffer := "test-pl-map-dlq"
+	toBuffers := []string{"test-pl-map-0", "test-pl-map-1"}
+	err = isbSvc.CreateBuffersAndBuckets(ctx, append([]string{dlqBuffer}, toBuffers...), nil, "", "")
+	assert.NoError(t, err)
+
+	jsCtx, err := client.JetStreamContext()
+	assert.NoError(t, err)
+	for i := 0; i < 3; i++ {
+		msg := isb.Message{
+			Header: isb.Header{ID: isb.MessageID{VertexName: "in", Offset: strconv.Itoa(i)}, Keys: []string{"k"}},
+			Body:   isb.Body{Payload: []byte(fmt.Sprintf("payload-%d", i))},
+		}
+		data, err := deadletter.NewMessage(msg, "map", 1, 3, fmt.Errorf("udf error")).MarshalBinary()
+		assert.NoError(t, err)
+		_, err = jsCtx.Publish(JetStreamName(dlqBuffer), data)
+		assert.NoError(t, err)
+	}
+
+	messages, err := isbSvc.ListDeadLetterMessages(ctx, dlqBuffer, "", 10)
+	assert.NoError(t, err)
+	assert.Len(t, messages, 3)
+	messages, err = isbSvc.ListDeadLetterMessages(ctx, dlqBuffer, "2", 1)
+	assert.NoError(t, err)
+	assert.Len(t, messages, 1)
+	assert.Equal(t, "2", messages[0].Offset)
+
+	m, err := isbSvc.GetDeadLetterMessage(ctx, dlqBuffer, "1")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("payload-0"), m.Message.Payload)
+	assert.Equal(t, "udf error", m.Message.Headers[dfv1.KeyMetaDeadLetterError])
+
+	err = isbSvc.ReplayDeadLetterMessage(ctx, dlqBuffer, "1", toBuffers)
+	assert.NoError(t, err)
+	// the replayed message is removed from the dead-letter buffer
+	messages, err = isbSvc.ListDeadLetterMessages(ctx, dlqBuffer, "", 10)
+	assert.NoError(t, err)
+	assert.Len(t, messages, 2)
+	_, err = isbSvc.GetDeadLetterMessage(ctx, dlqBuffer, "1")
+	assert.Error(t, err)
+
+	// the message is replayed to the partition it was read from, without the dead-letter metadata
+	raw, err := jsCtx.GetMsg(JetStreamName(toBuffers[1]), 1)
+	assert.NoError(t, err)
+	var replayed isb.Message
+	assert.NoError(t, replayed.UnmarshalBinary(raw.Data))
+	assert.Equal(t, []byte("payload-0"), replayed.Payload)
+	assert.Empty(t, replayed.Headers[dfv1.KeyMetaDeadLetterError])
+	assert.Equal(t, "0-replay-1", replayed.ID.Offset)
+}
@@ -20,11 +20,13 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/redis/go-redis/v9"
 	"go.uber.org/multierr"
 	"go.uber.org/zap"
 
 	redis2 "github.com/numaproj/numaflow/pkg/isb/stores/redis"
 	redisclient "github.com/numaproj/numaflow/pkg/shared/clients/redis"
+	"github.com/numaproj/numaflow/pkg/shared/deadletter"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
 	"github.com/numaproj/numaflow/pkg/watermark/store"
 )
@@ -71,7 +73,6 @@ func (r *isbsRedisSvc) DeleteBuffersAndBuckets(ctx context.Context, buffers, buc
 	if len(buffers) == 0 && len(buckets) == 0 {
 		return nil
 	}
-	// FIXME: delete the keys created by the lua script
 	log := logging.FromContext(ctx)
 	var errList error
 	for _, s := range buffers {
@@ -94,6 +95,11 @@ func (r *isbsRedisSvc) DeleteBuffersAndBuckets(ctx context.Context, buffers, buc
 		} else {
 			log.Infow("Redis keys deleted", zap.String("stream", stream))
 		}
+		// the hash keys created by the lua script expire eventually, delete them anyway to clean up right away.
+		if err := r.client.DeleteKeysByPattern(ctx, redis2.HashKeysPattern(stream)); err != nil {
+			errList = multierr.Append(errList, err)
+			log.Errorw("Failed to delete Redis hash keys.", zap.String("stream", stream), zap.Error(err))
+		}
 	}
 	if errList != nil {
 		return fmt.Errorf("failed to delete all or some Redis StreamGroups and keys")
@@ -122,17 +128,18 @@ func (r *isbsRedisSvc) ValidateBuffersAndBuckets(ctx context.Context, buffers, b
 
 // GetBufferInfo is used to provide buffer information like pending count, buffer length, has unprocessed data etc.
 func (r *isbsRedisSvc) GetBufferInfo(ctx context.Context, buffer string) (*BufferInfo, error) {
+	stream := redisclient.GetRedisStreamName(buffer)
 	group := fmt.Sprintf("%s-group", buffer)
-	rqw := redis2.NewBufferWrite(ctx, redisclient.NewInClusterRedisClient(), buffer, group, 0, redisclient.WithRefreshBufferWriteInfo(false))
-	var bufferWrite = rqw.(*redis2.BufferWrite)
-
+	pending, ackPending, err := r.client.StreamGroupPending(ctx, stream, group)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pending messages of buffer %q, %w", buffer, err)
+	}
 	bufferInfo := &BufferInfo{
 		Name:            buffer,
-		PendingCount:    bufferWrite.GetPendingCount(),
-		AckPendingCount: 0,                             // TODO: this should not be 0
-		TotalMessages:   bufferWrite.GetPendingCount(), // TODO: what should this be?
+		PendingCount:    pending,
+		AckPendingCount: ackPending,
+		TotalMessages:   pending + ackPending,
 	}
-
 	return bufferInfo, nil
 }
 
@@ -151,3 +158,75 @@ func (r *isbsRedisSvc) CreateWatermarkStores(ctx context.Context, bucketName str
 
 	return wmStores, nil
 }
+
+func (r *isbsRedisSvc) ListDeadLetterMessages(ctx context.Context, buffer, fromOffset string, limit int) ([]*DeadLetterMessage, error) {
+	stream := redisclient.GetRedisStreamName(buffer)
+	if fromOffset == "" {
+		fromOffset = "-"
+	}
+	xMessages, err := r.client.Client.XRangeN(ctx, stream, fromOffset, "+", int64(limit)).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the messages of stream %q, %w", stream, err)
+	}
+	result := make([]*DeadLetterMessage, 0, len(xMessages))
+	for _, xm := range xMessages {
+		m, err := toDeadLetterMessage(xm)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, m)
+	}
+	return result, nil
+}
+
+func (r *isbsRedisSvc) GetDeadLetterMessage(ctx context.Context, buffer, offset string) (*DeadLetterMessage, error) {
+	stream := redisclient.GetRedisStreamName(buffer)
+	xMessages, err := r.client.Client.XRange(ctx, stream, offset, offset).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the message at offset %q of stream %q, %w", offset, stream, err)
+	}
+	if len(xMessages) == 0 {
+		return nil, fmt.Errorf("message at offset %q of stream %q not found", offset, stream)
+	}
+	return toDeadLetterMessage(xMessages[0])
+}
+
+func (r *isbsRedisSvc) ReplayDeadLetterMessage(ctx context.Context, buffer, offset string, toBuffers []string) error {
+	if len(toBuffers) == 0 {
+		return fmt.Errorf("no buffer to replay the message to")
+	}
+	m, err := r.GetDeadLetterMessage(ctx, buffer, offset)
+	if err != nil {
+		return err
+	}
+	toStream := redisclient.GetRedisStreamName(toBuffers[deadletter.Partition(m.Message)%len(toBuffers)])
+	msg := deadletter.ToReplayMessage(m.Message, offset)
+	headerBytes, err := msg.Header.MarshalBinary()
+	if err != nil {
+		return fmt.Errorf("failed to marshal the message header at offset %q, %w", offset, err)
+	}
+	// same layout as the buffer writer, the header as the field and the payload as the value
+	if err := r.client.Client.XAdd(ctx, &redis.XAddArgs{Stream: toStream, Values: []interface{}{headerBytes, msg.Payload}}).Err(); err != nil {
+		return fmt.Errorf("failed to replay the message at offset %q to stream %q, %w", offset, toStream, err)
+	}
+	if err := r.client.Client.XDel(ctx, redisclient.GetRedisStreamName(buffer), offset).Err(); err != nil {
+		return fmt.Errorf("failed to delete the replayed message at offset %q, %w", offset, err)
+	}
+	return nil
+}
+
+func toDeadLetterMessage(xm redis.XMessage) (*DeadLetterMessage, error) {
+	// the messages have only one field/value pair, i.e., header/payload
+	if len(xm.Values) != 1 {
+		return nil, fmt.Errorf("expected only 1 pair of field/value in message %q, got %d", xm.ID, len(xm.Values))
+	}
+	m := &DeadLetterMessage{Offset: xm.ID}
+	for f, v := range xm.Values {
+		if err := m.Message.Header.UnmarshalBinary([]byte(f)); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal the header of message %q, %w", xm.ID, err)
+		}
+		payload, _ := v.(string)
+		m.Message.Payload = []byte(payload)
+	}
+	return m, nil
+}
@@ -66,7 +66,7 @@ func TestIsbsRedisSvc_Buffers(t *testing.T) {
 
 	readMessages, err := rqr.Read(ctx, 10)
 	assert.Nil(t, err)
-	// ACK just 1 message, which leaves a pending count of 9
+	// ACK just 1 message, which leaves an ack pending count of 9
 	var readOffsets = make([]string, 1)
 	readOffsets[0] = readMessages[0].ReadOffset.String()
 	_ = redisClient.Client.XAck(redisclient.RedisContext, stream, group, readOffsets...).Err()
@@ -75,7 +75,9 @@ func TestIsbsRedisSvc_Buffers(t *testing.T) {
 	for _, buffer := range buffers {
 		bufferInfo, err := isbsRedisSvc.GetBufferInfo(ctx, buffer)
 		assert.NoError(t, err)
-		assert.Equal(t, int64(9), bufferInfo.PendingCount)
+		assert.Equal(t, int64(0), bufferInfo.PendingCount)
+		assert.Equal(t, int64(9), bufferInfo.AckPendingCount)
+		assert.Equal(t, int64(9), bufferInfo.TotalMessages)
 	}
 
 	// delete buffer
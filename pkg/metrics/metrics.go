@@ -38,6 +38,7 @@ const (
 	LabelSDKVersion         = "version"
 	LabelSDKType            = "type" // container type, e.g sourcer, sourcetransformer, sinker, etc. see serverinfo.ContainerType
 	LabelReason             = "reason"
+	LabelSchemaType         = "schema_type"
 )
 
 var (
@@ -139,6 +140,13 @@ var (
 		Help:      "Total number of Bytes Dropped",
 	}, []string{LabelVertex, LabelPipeline, LabelVertexType, LabelVertexReplicaIndex, LabelPartitionName, LabelReason})
 
+	// DeadLetterMessagesCount is used to indicate the number of messages written to the dead-letter buffer
+	DeadLetterMessagesCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Subsystem: "forwarder",
+		Name:      "dead_letter_total",
+		Help:      "Total number of Messages written to the dead-letter buffer",
+	}, []string{LabelVertex, LabelPipeline, LabelVertexType, LabelVertexReplicaIndex, LabelPartitionName})
+
 	// AckMessagesCount is used to indicate the number of  messages acknowledged
 	AckMessagesCount = promauto.NewCounterVec(prometheus.CounterOpts{
 		Subsystem: "forwarder",
@@ -252,6 +260,16 @@ var (
 	}, []string{LabelVertex, LabelPipeline, LabelVertexType, LabelVertexReplicaIndex, LabelPartitionName})
 )
 
+// Builtin source transformer specific metrics
+var (
+	// SchemaValidationFailures is used to indicate the number of messages which failed the validation of the schemaValidator builtin transformer
+	SchemaValidationFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+		Subsystem: "builtin_transformer",
+		Name:      "schema_validation_failures_total",
+		Help:      "Total number of messages which failed the schema validation",
+	}, []string{LabelVertex, LabelPipeline, LabelSchemaType})
+)
+
 // Reduce forwarder specific metrics
 var (
 	// ReduceDroppedMessagesCount is used to indicate the number of messages dropped
@@ -261,6 +279,13 @@ var (
 		Help:      "Total number of Messages Dropped",
 	}, []string{LabelVertex, LabelPipeline, LabelVertexReplicaIndex, LabelReason})
 
+	// ReduceLateMessagesForwardedCount is used to indicate the number of late messages forwarded with the late data tag
+	ReduceLateMessagesForwardedCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Subsystem: "reduce_data_forward",
+		Name:      "late_forwarded_total",
+		Help:      "Total number of late Messages forwarded with the late data tag",
+	}, []string{LabelVertex, LabelPipeline, LabelVertexReplicaIndex})
+
 	// PBQWriteErrorCount is used to indicate the number of errors while writing to pbq
 	PBQWriteErrorCount = promauto.NewCounterVec(prometheus.CounterOpts{
 		Subsystem: "reduce_pbq",
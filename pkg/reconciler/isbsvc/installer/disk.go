@@ -0,0 +1,64 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package installer
+
+import (
+	"context"
+	"fmt"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"go.uber.org/zap"
+)
+
+type diskInstaller struct {
+	isbSvc *dfv1.InterStepBufferService
+	logger *zap.SugaredLogger
+}
+
+// NewDiskInstaller returns an installer for the disk ISB Service. There is
+// nothing to deploy, the volume is mounted by the pods using the service.
+func NewDiskInstaller(isbSvc *dfv1.InterStepBufferService, logger *zap.SugaredLogger) Installer {
+	return &diskInstaller{
+		isbSvc: isbSvc,
+		logger: logger.With("isbsvc", isbSvc.Name),
+	}
+}
+
+func (di *diskInstaller) Install(ctx context.Context) (*dfv1.BufferServiceConfig, error) {
+	disk := di.isbSvc.Spec.Disk
+	if disk == nil {
+		return nil, fmt.Errorf("invalid InterStepBufferService spec, no disk config")
+	}
+	di.isbSvc.Status.SetType(dfv1.ISBSvcTypeDisk)
+	di.isbSvc.Status.MarkConfigured()
+	di.isbSvc.Status.MarkDeployed()
+	di.logger.Info("Using disk config")
+	return &dfv1.BufferServiceConfig{Disk: &dfv1.DiskConfig{
+		DiskStorage:     *disk.DiskStorage.DeepCopy(),
+		MaxSegmentBytes: disk.GetMaxSegmentBytes(),
+		SyncInterval:    disk.GetSyncInterval(),
+	}}, nil
+}
+
+func (di *diskInstaller) Uninstall(ctx context.Context) error {
+	di.logger.Info("Nothing to uninstall")
+	return nil
+}
+
+func (di *diskInstaller) CheckChildrenResourceStatus(ctx context.Context) error {
+	return nil
+}
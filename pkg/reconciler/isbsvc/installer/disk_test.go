@@ -0,0 +1,80 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package installer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+)
+
+var testDiskIsbSvc = &dfv1.InterStepBufferService{
+	ObjectMeta: metav1.ObjectMeta{
+		Namespace: testNamespace,
+		Name:      testISBSName,
+	},
+	Spec: dfv1.InterStepBufferServiceSpec{
+		Disk: &dfv1.DiskBufferService{
+			DiskStorage: dfv1.DiskStorage{
+				HostPath: &corev1.HostPathVolumeSource{Path: "/mnt/isbsvc"},
+			},
+		},
+	},
+}
+
+func TestDiskInstallation(t *testing.T) {
+	t.Run("bad installation", func(t *testing.T) {
+		badIsbs := testDiskIsbSvc.DeepCopy()
+		badIsbs.Spec.Disk = nil
+		installer := &diskInstaller{
+			isbSvc: badIsbs,
+			logger: zaptest.NewLogger(t).Sugar(),
+		}
+		_, err := installer.Install(context.TODO())
+		assert.Error(t, err)
+	})
+
+	t.Run("good installation", func(t *testing.T) {
+		goodIsbs := testDiskIsbSvc.DeepCopy()
+		installer := &diskInstaller{
+			isbSvc: goodIsbs,
+			logger: zaptest.NewLogger(t).Sugar(),
+		}
+		c, err := installer.Install(context.TODO())
+		assert.NoError(t, err)
+		assert.NotNil(t, c.Disk)
+		assert.Equal(t, "/mnt/isbsvc", c.Disk.HostPath.Path)
+		assert.Equal(t, int64(dfv1.DefaultISBSvcDiskMaxSegmentBytes), c.Disk.MaxSegmentBytes)
+		assert.Equal(t, dfv1.DefaultISBSvcDiskSyncInterval, c.Disk.SyncInterval.Duration)
+		assert.Equal(t, dfv1.ISBSvcTypeDisk, goodIsbs.Status.Type)
+		assert.True(t, goodIsbs.Status.IsReady())
+	})
+}
+
+func TestDiskUninstallation(t *testing.T) {
+	installer := &diskInstaller{
+		isbSvc: testDiskIsbSvc.DeepCopy(),
+		logger: zaptest.NewLogger(t).Sugar(),
+	}
+	assert.NoError(t, installer.Uninstall(context.TODO()))
+}
@@ -147,6 +147,14 @@ func TestGetInstaller(t *testing.T) {
 		assert.True(t, ok)
 	})
 
+	t.Run("get disk installer", func(t *testing.T) {
+		installer, err := getInstaller(testDiskIsbSvc, nil, nil, fakeConfig, zaptest.NewLogger(t).Sugar(), nil)
+		assert.NoError(t, err)
+		assert.NotNil(t, installer)
+		_, ok := installer.(*diskInstaller)
+		assert.True(t, ok)
+	})
+
 	t.Run("test error", func(t *testing.T) {
 		testObj := testNativeRedisIsbSvc.DeepCopy()
 		testObj.Spec.Redis = nil
@@ -295,6 +295,9 @@ func (r *pipelineReconciler) reconcileFixedResources(ctx context.Context, pl *df
 		for _, b := range v.OwnedBuffers() {
 			oldBuffers[b] = b
 		}
+		if b := v.GetDeadLetterBuffer(); b != "" {
+			oldBuffers[b] = b
+		}
 		for _, b := range v.GetFromBuckets() {
 			oldBuckets[b] = b
 		}
@@ -476,6 +479,7 @@ func (r *pipelineReconciler) createOrUpdateDaemonDeployment(ctx context.Context,
 		pl.Status.MarkDeployFailed("BuildDaemonDeployFailed", err.Error())
 		return fmt.Errorf("failed to build daemon deployment spec, %w", err)
 	}
+	sharedutil.AddISBSvcDiskVolume(&deploy.Spec.Template.Spec, isbSvcConfig)
 	deployHash := sharedutil.MustHash(deploy.Spec)
 	deploy.Annotations = map[string]string{dfv1.KeyHash: deployHash}
 	existingDeploy := &appv1.Deployment{}
@@ -551,6 +555,7 @@ func (r *pipelineReconciler) createOrUpdateSIMDeployments(ctx context.Context, p
 		return fmt.Errorf("failed to find existing Side Inputs Manager Deployments, %w", err)
 	}
 	for _, newObj := range newObjs {
+		sharedutil.AddISBSvcDiskVolume(&newObj.Spec.Template.Spec, isbSvcConfig)
 		deployHash := sharedutil.MustHash(newObj.Spec)
 		if newObj.Annotations == nil {
 			newObj.Annotations = make(map[string]string)
@@ -680,6 +685,7 @@ func buildVertices(pl *dfv1.Pipeline) map[string]dfv1.Vertex {
 			FromEdges:                  fromEdges,
 			ToEdges:                    toEdges,
 			Watermark:                  pl.Spec.Watermark,
+			ClaimCheck:                 pl.GetPipelineLimits().ClaimCheck.DeepCopy(),
 			Replicas:                   &replicas,
 			Lifecycle: dfv1.VertexLifecycle{
 				DesiredPhase: dfv1.VertexPhase(pl.GetDesiredPhase()),
@@ -767,6 +773,10 @@ func copyEdges(pl *dfv1.Pipeline, edges []dfv1.Edge) []dfv1.CombinedEdge {
 		vTo := pl.GetVertex(e.To)
 		fromVertexLimits := mergeLimits(pl.GetPipelineLimits(), vFrom.Limits)
 		toVertexLimits := mergeLimits(pl.GetPipelineLimits(), vTo.Limits)
+		if e.Compression == nil && pl.Spec.InterStepBuffer != nil {
+			// the edge inherits the compression of the pipeline if it's not configured
+			e.Compression = pl.Spec.InterStepBuffer.Compression.DeepCopy()
+		}
 		combinedEdge := dfv1.CombinedEdge{
 			Edge:                     e,
 			FromVertexType:           vFrom.GetVertexType(),
@@ -829,6 +839,7 @@ func buildISBBatchJob(pl *dfv1.Pipeline, image string, isbSvcConfig dfv1.BufferS
 			jt.ContainerTemplate.ApplyToNumaflowContainers(spec.Template.Spec.Containers)
 		}
 	}
+	sharedutil.AddISBSvcDiskVolume(&spec.Template.Spec, isbSvcConfig)
 	return &batchv1.Job{
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace: pl.Namespace,
@@ -871,6 +882,9 @@ func (r *pipelineReconciler) resumePipeline(ctx context.Context, pl *dfv1.Pipeli
 			}
 		}
 	}
+	if err := r.markPodsPausing(ctx, pl, false); err != nil {
+		return true, err
+	}
 	_, err := r.updateVerticeDesiredPhase(ctx, pl, allVertexFilter, dfv1.VertexPhaseRunning)
 	if err != nil {
 		return false, err
@@ -890,6 +904,10 @@ func (r *pipelineReconciler) pausePipeline(ctx context.Context, pl *dfv1.Pipelin
 	)
 	pl.Status.MarkPhasePausing()
 
+	if err := r.markPodsPausing(ctx, pl, true); err != nil {
+		return true, err
+	}
+
 	if pl.GetAnnotations() == nil || pl.GetAnnotations()[dfv1.KeyPauseTimestamp] == "" {
 		_, err := r.updateVerticeDesiredPhase(ctx, pl, sourceVertexFilter, dfv1.VertexPhasePaused)
 		if err != nil {
@@ -949,6 +967,41 @@ func (r *pipelineReconciler) pausePipeline(ctx context.Context, pl *dfv1.Pipelin
 	return true, err
 }
 
+// markPodsPausing sets or removes the pausing annotation of the pods of the vertices which close their windows when the
+// pipeline is paused. The pods read it through the downward API when they are shutting down, so that the windows are
+// only closed if the pipeline is being paused, and are replayed from the WAL otherwise.
+func (r *pipelineReconciler) markPodsPausing(ctx context.Context, pl *dfv1.Pipeline, pausing bool) error {
+	var vertices []string
+	for _, v := range pl.Spec.Vertices {
+		if v.FlushesWindowsOnPause() {
+			vertices = append(vertices, v.Name)
+		}
+	}
+	if len(vertices) == 0 {
+		return nil
+	}
+	pods := corev1.PodList{}
+	label := fmt.Sprintf("%s=%s, %s in (%s)", dfv1.KeyPipelineName, pl.Name,
+		dfv1.KeyVertexName, strings.Join(vertices, ","))
+	selector, _ := labels.Parse(label)
+	if err := r.client.List(ctx, &pods, &client.ListOptions{Namespace: pl.Namespace, LabelSelector: selector}); err != nil {
+		return err
+	}
+	patchJson := `{"metadata":{"annotations":{"` + dfv1.KeyPausing + `":null}}}`
+	if pausing {
+		patchJson = `{"metadata":{"annotations":{"` + dfv1.KeyPausing + `":"true"}}}`
+	}
+	for _, pod := range pods.Items {
+		if _, ok := pod.GetAnnotations()[dfv1.KeyPausing]; ok == pausing {
+			continue
+		}
+		if err := r.client.Patch(ctx, &pod, client.RawPatch(types.MergePatchType, []byte(patchJson))); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
 // noSourceVertexPodsRunning checks whether any source vertex has running replicas
 func (r *pipelineReconciler) noSourceVertexPodsRunning(ctx context.Context, pl *dfv1.Pipeline) (bool, error) {
 	sources := pl.Spec.GetSourcesByName()
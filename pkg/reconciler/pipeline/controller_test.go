@@ -543,6 +543,40 @@ func Test_pauseAndResumePipeline(t *testing.T) {
 		assert.Equal(t, int32(2), *v[testObj.Name+"-"+testObj.Spec.Vertices[2].Name].Spec.Replicas)
 		assert.NoError(t, err)
 	})
+
+	t.Run("test global window pipeline", func(t *testing.T) {
+		cl := fake.NewClientBuilder().Build()
+		ctx := context.TODO()
+		testIsbSvc := testNativeRedisIsbSvc.DeepCopy()
+		testIsbSvc.Status.MarkConfigured()
+		testIsbSvc.Status.MarkDeployed()
+		err := cl.Create(ctx, testIsbSvc)
+		assert.Nil(t, err)
+		r := fakeReconciler(t, cl)
+		testObj := testReducePipeline.DeepCopy()
+		testObj.Spec.Vertices[1].UDF.GroupBy.Window = dfv1.Window{Global: &dfv1.GlobalWindow{}}
+		_, err = r.reconcile(ctx, testObj)
+		assert.NoError(t, err)
+		pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: "test-pl-p1-0", Labels: map[string]string{
+			dfv1.KeyPipelineName: testObj.Name,
+			dfv1.KeyVertexName:   testObj.Spec.Vertices[1].Name,
+		}}}
+		err = cl.Create(ctx, pod)
+		assert.NoError(t, err)
+
+		// the pods of the global window vertex are told that the pipeline is being paused
+		_, err = r.pausePipeline(ctx, testObj)
+		assert.NoError(t, err)
+		err = cl.Get(ctx, client.ObjectKeyFromObject(pod), pod)
+		assert.NoError(t, err)
+		assert.Equal(t, "true", pod.GetAnnotations()[dfv1.KeyPausing])
+
+		_, err = r.resumePipeline(ctx, testObj)
+		assert.NoError(t, err)
+		err = cl.Get(ctx, client.ObjectKeyFromObject(pod), pod)
+		assert.NoError(t, err)
+		assert.NotContains(t, pod.GetAnnotations(), dfv1.KeyPausing)
+	})
 }
 
 func Test_copyVertexLimits(t *testing.T) {
@@ -628,6 +662,20 @@ func Test_copyEdges(t *testing.T) {
 		assert.Equal(t, int32(2), *result[0].FromVertexPartitionCount)
 	})
 
+	t.Run("test copy compression", func(t *testing.T) {
+		pl := testPipeline.DeepCopy()
+		pl.Spec.InterStepBuffer = &dfv1.InterStepBuffer{Compression: &dfv1.Compression{Type: dfv1.CompressionTypeZstd}}
+		edges := []dfv1.Edge{
+			{From: "input", To: "p1"},
+			{From: "p1", To: "output", Compression: &dfv1.Compression{Type: dfv1.CompressionTypeNone}},
+		}
+		result := copyEdges(pl, edges)
+		assert.Equal(t, 2, len(result))
+		assert.Equal(t, dfv1.CompressionTypeZstd, result[0].Compression.GetType())
+		assert.Equal(t, dfv1.CompressionTypeNone, result[1].Compression.GetType())
+		assert.Nil(t, edges[0].Compression)
+	})
+
 }
 
 func Test_buildISBBatchJob(t *testing.T) {
@@ -35,8 +35,11 @@ func ValidateInterStepBufferService(isbsvc *dfv1.InterStepBufferService) error {
 	if isbsvc.Spec.Redis != nil && isbsvc.Spec.JetStream != nil {
 		return fmt.Errorf(`invalid spec: "spec.redis" and "spec.jetstream" can not be defined together`)
 	}
-	if isbsvc.Spec.Redis == nil && isbsvc.Spec.JetStream == nil {
-		return fmt.Errorf(`invalid spec: either "spec.redis" or "spec.jetstream" needs to be specified`)
+	if isbsvc.Spec.Disk != nil && (isbsvc.Spec.Redis != nil || isbsvc.Spec.JetStream != nil) {
+		return fmt.Errorf(`invalid spec: "spec.disk" can not be defined together with "spec.redis" or "spec.jetstream"`)
+	}
+	if isbsvc.Spec.Redis == nil && isbsvc.Spec.JetStream == nil && isbsvc.Spec.Disk == nil {
+		return fmt.Errorf(`invalid spec: either "spec.redis", "spec.jetstream" or "spec.disk" needs to be specified`)
 	}
 	if isbsvc.Spec.Redis != nil {
 		if isbsvc.Spec.Redis.Native != nil && isbsvc.Spec.Redis.External != nil {
@@ -50,6 +53,14 @@ func ValidateInterStepBufferService(isbsvc *dfv1.InterStepBufferService) error {
 				return fmt.Errorf(`invalid spec: "spec.redis.native.version" is not defined`)
 			}
 		}
+		if external := isbsvc.Spec.Redis.External; external != nil {
+			if external.Cluster && external.MasterName != "" {
+				return fmt.Errorf(`invalid spec: "spec.redis.external.cluster" and "spec.redis.external.masterName" can not be defined together`)
+			}
+			if x := external.TLS; x != nil && (x.CertSecret == nil) != (x.KeySecret == nil) {
+				return fmt.Errorf(`invalid spec: "spec.redis.external.tls.certSecret" and "spec.redis.external.tls.keySecret" must be defined together`)
+			}
+		}
 	}
 	if x := isbsvc.Spec.JetStream; x != nil {
 		if x.Version == "" {
@@ -59,5 +70,25 @@ func ValidateInterStepBufferService(isbsvc *dfv1.InterStepBufferService) error {
 			return fmt.Errorf(`invalid spec: min value for "spec.jetstream.replicas" is 1 and can't be 2`)
 		}
 	}
+	if x := isbsvc.Spec.Disk; x != nil {
+		if x.HostPath != nil && x.PersistentVolumeClaim != nil {
+			return fmt.Errorf(`invalid spec: "spec.disk.hostPath" and "spec.disk.persistentVolumeClaim" can not be defined together`)
+		}
+		if x.HostPath == nil && x.PersistentVolumeClaim == nil {
+			return fmt.Errorf(`invalid spec: either "spec.disk.hostPath" or "spec.disk.persistentVolumeClaim" must be defined`)
+		}
+		if x.HostPath != nil && x.HostPath.Path == "" {
+			return fmt.Errorf(`invalid spec: "spec.disk.hostPath.path" is not defined`)
+		}
+		if x.PersistentVolumeClaim != nil && x.PersistentVolumeClaim.ClaimName == "" {
+			return fmt.Errorf(`invalid spec: "spec.disk.persistentVolumeClaim.claimName" is not defined`)
+		}
+		if x.MaxSegmentSize != nil && x.MaxSegmentSize.Value() <= 0 {
+			return fmt.Errorf(`invalid spec: "spec.disk.maxSegmentSize" must be greater than 0`)
+		}
+		if x.SyncInterval != nil && x.SyncInterval.Duration < 0 {
+			return fmt.Errorf(`invalid spec: "spec.disk.syncInterval" can not be negative`)
+		}
+	}
 	return nil
 }
@@ -20,6 +20,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	apiresource "k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
@@ -51,6 +53,20 @@ var (
 			},
 		},
 	}
+
+	testDiskIsbs = &dfv1.InterStepBufferService{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test-ns",
+			Name:      dfv1.DefaultISBSvcName,
+		},
+		Spec: dfv1.InterStepBufferServiceSpec{
+			Disk: &dfv1.DiskBufferService{
+				DiskStorage: dfv1.DiskStorage{
+					HostPath: &corev1.HostPathVolumeSource{Path: "/mnt/isbsvc"},
+				},
+			},
+		},
+	}
 )
 
 func TestValidateInterStepBuffer(t *testing.T) {
@@ -72,7 +88,7 @@ func TestValidateInterStepBuffer(t *testing.T) {
 		isbs.Spec.Redis = nil
 		err := ValidateInterStepBufferService(isbs)
 		assert.Error(t, err)
-		assert.Contains(t, err.Error(), `either "spec.redis" or "spec.jetstream" needs to be specified`)
+		assert.Contains(t, err.Error(), `either "spec.redis", "spec.jetstream" or "spec.disk" needs to be specified`)
 	})
 
 	t.Run("test missing version", func(t *testing.T) {
@@ -99,6 +115,30 @@ func TestValidateInterStepBuffer(t *testing.T) {
 		assert.Contains(t, err.Error(), "must be defined")
 	})
 
+	t.Run("test external redis cluster with sentinel", func(t *testing.T) {
+		isbs := testRedisIsbs.DeepCopy()
+		isbs.Spec.Redis.Native = nil
+		isbs.Spec.Redis.External = &dfv1.RedisConfig{URL: "redis-cluster:6379", Cluster: true}
+		assert.NoError(t, ValidateInterStepBufferService(isbs))
+		isbs.Spec.Redis.External.MasterName = "mymaster"
+		err := ValidateInterStepBufferService(isbs)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "can not be defined together")
+	})
+
+	t.Run("test external redis tls", func(t *testing.T) {
+		isbs := testRedisIsbs.DeepCopy()
+		isbs.Spec.Redis.Native = nil
+		isbs.Spec.Redis.External = &dfv1.RedisConfig{URL: "redis:6379", TLS: &dfv1.TLS{InsecureSkipVerify: true}}
+		assert.NoError(t, ValidateInterStepBufferService(isbs))
+		isbs.Spec.Redis.External.TLS.CertSecret = &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "redis-tls"}, Key: "tls.crt"}
+		err := ValidateInterStepBufferService(isbs)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "must be defined together")
+		isbs.Spec.Redis.External.TLS.KeySecret = &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "redis-tls"}, Key: "tls.key"}
+		assert.NoError(t, ValidateInterStepBufferService(isbs))
+	})
+
 	t.Run("test missing jetstream version", func(t *testing.T) {
 		isbs := testJetStreamIsbs.DeepCopy()
 		isbs.Spec.JetStream.Version = ""
@@ -107,6 +147,49 @@ func TestValidateInterStepBuffer(t *testing.T) {
 		assert.Contains(t, err.Error(), "is not defined")
 	})
 
+	t.Run("test good disk isb", func(t *testing.T) {
+		err := ValidateInterStepBufferService(testDiskIsbs)
+		assert.NoError(t, err)
+	})
+
+	t.Run("test both disk and jetstream configured", func(t *testing.T) {
+		isbs := testDiskIsbs.DeepCopy()
+		isbs.Spec.JetStream = testJetStreamIsbs.DeepCopy().Spec.JetStream
+		err := ValidateInterStepBufferService(isbs)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `"spec.disk" can not be defined together`)
+	})
+
+	t.Run("test disk storage", func(t *testing.T) {
+		isbs := testDiskIsbs.DeepCopy()
+		isbs.Spec.Disk.PersistentVolumeClaim = &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "isbsvc"}
+		err := ValidateInterStepBufferService(isbs)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "can not be defined together")
+
+		isbs.Spec.Disk.HostPath = nil
+		assert.NoError(t, ValidateInterStepBufferService(isbs))
+
+		isbs.Spec.Disk.PersistentVolumeClaim.ClaimName = ""
+		err = ValidateInterStepBufferService(isbs)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `"spec.disk.persistentVolumeClaim.claimName" is not defined`)
+
+		isbs.Spec.Disk.PersistentVolumeClaim = nil
+		err = ValidateInterStepBufferService(isbs)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "must be defined")
+	})
+
+	t.Run("test invalid disk segment size", func(t *testing.T) {
+		isbs := testDiskIsbs.DeepCopy()
+		q := apiresource.MustParse("0")
+		isbs.Spec.Disk.MaxSegmentSize = &q
+		err := ValidateInterStepBufferService(isbs)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `"spec.disk.maxSegmentSize" must be greater than 0`)
+	})
+
 	t.Run("test nil ISB Service", func(t *testing.T) {
 		err := ValidateInterStepBufferService(nil)
 		assert.Error(t, err)
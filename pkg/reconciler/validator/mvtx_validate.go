@@ -41,12 +41,30 @@ func ValidateMonoVertex(mvtx *dfv1.MonoVertex) error {
 	if err := validateSource(*mvtx.Spec.Source); err != nil {
 		return fmt.Errorf("invalid source: %w", err)
 	}
+	if x := mvtx.Spec.Source.Generator; x != nil && (x.Template != nil || x.Replay != nil || x.OutOfOrder != nil) {
+		return fmt.Errorf("invalid source: template, replay and outOfOrder of the generator source are not supported by monovertex")
+	}
+	if x := mvtx.Spec.Source.JetStream; x != nil && x.HasConsumerOptions() {
+		return fmt.Errorf("invalid source: consumer options of the jetstream source are not supported by monovertex")
+	}
 	if mvtx.Spec.Sink == nil {
 		return fmt.Errorf("sink is not defined")
 	}
 	if err := validateSink(*mvtx.Spec.Sink); err != nil {
 		return fmt.Errorf("invalid sink: %w", err)
 	}
+	if x := mvtx.Spec.Sink.Kafka; x != nil && x.ExactlyOnce {
+		return fmt.Errorf("invalid sink: exactly-once kafka sink requires an inter-step buffer, it's not supported by monovertex")
+	}
+	if mvtx.Spec.Sink.File != nil {
+		return fmt.Errorf("invalid sink: file sink is not supported by monovertex")
+	}
+	if mvtx.Spec.Sink.HTTP != nil || (mvtx.Spec.Sink.Fallback != nil && mvtx.Spec.Sink.Fallback.HTTP != nil) {
+		return fmt.Errorf("invalid sink: http sink is not supported by monovertex")
+	}
+	if x := mvtx.Spec.Sink.Log; x != nil && !x.IsDefault() {
+		return fmt.Errorf("invalid sink: log sink options are not supported by monovertex")
+	}
 	for _, ic := range mvtx.Spec.InitContainers {
 		if isReservedContainerName(ic.Name) {
 			return fmt.Errorf("invalid init container name: %q is reserved for containers created by numaflow", ic.Name)
@@ -18,11 +18,19 @@ package validator
 
 import (
 	"fmt"
+	"path"
+	"slices"
+	"text/template"
 
+	"github.com/Masterminds/sprig/v3"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
 
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	builtinreduce "github.com/numaproj/numaflow/pkg/reduce/applier/builtin"
+	"github.com/numaproj/numaflow/pkg/shared/expr"
+	"github.com/numaproj/numaflow/pkg/udf/builtin"
 )
 
 func ValidatePipeline(pl *dfv1.Pipeline) error {
@@ -132,6 +140,26 @@ func ValidatePipeline(pl *dfv1.Pipeline) error {
 		} else {
 			toFromEdge[e.From+e.To] = true
 		}
+		if e.Conditions != nil && e.Conditions.Expression != "" {
+			if _, err := expr.CompileMessageBool(e.Conditions.Expression); err != nil {
+				return fmt.Errorf("invalid edge from %q to %q: %w", e.From, e.To, err)
+			}
+		}
+		if err := validateCompression(e.Compression); err != nil {
+			return fmt.Errorf("invalid edge from %q to %q: %w", e.From, e.To, err)
+		}
+	}
+
+	if pl.Spec.InterStepBuffer != nil {
+		if err := validateCompression(pl.Spec.InterStepBuffer.Compression); err != nil {
+			return fmt.Errorf("invalid interStepBuffer: %w", err)
+		}
+	}
+
+	if x := pl.Spec.Limits; x != nil {
+		if err := validateClaimCheck(x.ClaimCheck); err != nil {
+			return err
+		}
 	}
 
 	if len(namesInEdges) != len(names) {
@@ -167,9 +195,182 @@ func ValidatePipeline(pl *dfv1.Pipeline) error {
 		return err
 	}
 
+	if err := validateLateDataTags(*pl); err != nil {
+		return err
+	}
+
+	if err := validatePriorityLanes(*pl); err != nil {
+		return err
+	}
+
+	if err := validateRustRuntime(pl.Spec, runsOnRust); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// runsOnRust returns whether the vertex runs on the Rust runtime, which is selected by the NUMAFLOW_RUNTIME
+// environment variable of the container template, for example all the vertices of a ServingPipeline.
+func runsOnRust(v dfv1.AbstractVertex) bool {
+	if v.ContainerTemplate == nil {
+		return false
+	}
+	for _, e := range v.ContainerTemplate.Env {
+		if e.Name == dfv1.EnvNumaflowRuntime {
+			return e.Value == "rust"
+		}
+	}
+	return false
+}
+
+// validateRustRuntime rejects the features which are not supported by the Rust runtime on the vertices running on it
+// and their edges, rather than ignoring them silently.
+func validateRustRuntime(spec dfv1.PipelineSpec, isRust func(dfv1.AbstractVertex) bool) error {
+	rustVertices := make(map[string]bool)
+	for _, v := range spec.Vertices {
+		if isRust(v) {
+			rustVertices[v.Name] = true
+		}
+	}
+	if len(rustVertices) == 0 {
+		return nil
+	}
+	// the claim check applies to all the buffers of the pipeline
+	if spec.Limits != nil && spec.Limits.ClaimCheck != nil {
+		return fmt.Errorf("invalid limits: claim check is not supported by the Rust runtime")
+	}
+	for _, e := range spec.Edges {
+		if rustVertices[e.From] && e.Conditions != nil && e.Conditions.Expression != "" {
+			return fmt.Errorf("invalid edge from %q to %q: conditions expression is not supported by the Rust runtime", e.From, e.To)
+		}
+		compression := e.Compression
+		if compression == nil && spec.InterStepBuffer != nil {
+			compression = spec.InterStepBuffer.Compression
+		}
+		if (rustVertices[e.From] || rustVertices[e.To]) && compression.GetType() != dfv1.CompressionTypeNone {
+			return fmt.Errorf("invalid edge from %q to %q: compression is not supported by the Rust runtime", e.From, e.To)
+		}
+		if (rustVertices[e.From] || rustVertices[e.To]) && e.Priority != nil {
+			return fmt.Errorf("invalid edge from %q to %q: priority lanes are not supported by the Rust runtime", e.From, e.To)
+		}
+	}
+	return nil
+}
+
+// validateCompression validates the compression of the inter-step buffer messages.
+func validateCompression(c *dfv1.Compression) error {
+	switch c.GetType() {
+	case dfv1.CompressionTypeNone, dfv1.CompressionTypeSnappy, dfv1.CompressionTypeZstd, dfv1.CompressionTypeGzip:
+		return nil
+	default:
+		return fmt.Errorf("unsupported compression type %q", c.GetType())
+	}
+}
+
+// validateClaimCheck validates the store of the oversized payloads, exactly one of the stores is required.
+func validateClaimCheck(c *dfv1.ClaimCheck) error {
+	if c == nil {
+		return nil
+	}
+	if (c.S3 == nil) == (c.PersistentVolumeClaim == nil) {
+		return fmt.Errorf("invalid claim check, exactly one of s3 and persistentVolumeClaim is required")
+	}
+	if x := c.S3; x != nil && (x.Bucket == "" || x.Region == "") {
+		return fmt.Errorf("invalid claim check, s3 bucket and region are required")
+	}
+	if x := c.PersistentVolumeClaim; x != nil && x.ClaimName == "" {
+		return fmt.Errorf("invalid claim check, persistentVolumeClaim claimName is required")
+	}
+	if c.ThresholdBytes != nil && *c.ThresholdBytes == 0 {
+		return fmt.Errorf("invalid claim check, thresholdBytes must be greater than 0")
+	}
+	return nil
+}
+
+// validateLateDataTags validates that the late data tag of a reduce vertex is used by the tag conditions of
+// at least one of its outgoing edges, the late messages would be forwarded nowhere or everywhere otherwise.
+func validateLateDataTags(pl dfv1.Pipeline) error {
+	for _, v := range pl.Spec.Vertices {
+		if v.UDF == nil || v.UDF.GroupBy == nil || v.UDF.GroupBy.LateDataTag == "" {
+			continue
+		}
+		tag := v.UDF.GroupBy.LateDataTag
+		found := false
+		for _, e := range pl.Spec.Edges {
+			if e.From == v.Name && e.Conditions != nil && e.Conditions.Tags != nil && slices.Contains(e.Conditions.Tags.Values, tag) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("invalid vertex %q, late data tag %q is not used by the tag conditions of any outgoing edge", v.Name, tag)
+		}
+	}
+	return nil
+}
+
+// validatePriorityLanes validates the priority lanes of the edges. All the edges to a vertex need the same lanes
+// and weights since they are written to the same buffers, and the edges to a reduce vertex or an exactly once Kafka
+// sink can not have lanes because the messages are read out of order.
+func validatePriorityLanes(pl dfv1.Pipeline) error {
+	for _, v := range pl.Spec.Vertices {
+		fromEdges := pl.GetFromEdges(v.Name)
+		var priority *dfv1.EdgePriority
+		for i, e := range fromEdges {
+			if i > 0 && !equalPriorityLanes(priority, e.Priority) {
+				return fmt.Errorf("invalid edge from %q to %q, the priority lanes and weights of all the edges to a vertex must be the same", e.From, e.To)
+			}
+			priority = e.Priority
+		}
+		if priority == nil {
+			continue
+		}
+		for _, e := range fromEdges {
+			if err := validateEdgePriority(e.Priority); err != nil {
+				return fmt.Errorf("invalid edge from %q to %q, %w", e.From, e.To, err)
+			}
+		}
+		if v.IsReduceUDF() {
+			return fmt.Errorf("invalid vertex %q, priority lanes are not supported by reduce vertices", v.Name)
+		}
+		if v.Sink != nil && v.Sink.Kafka != nil && v.Sink.Kafka.ExactlyOnce {
+			return fmt.Errorf("invalid vertex %q, priority lanes are not supported by exactly once Kafka sinks", v.Name)
+		}
+	}
+	return nil
+}
+
+func validateEdgePriority(p *dfv1.EdgePriority) error {
+	if p.Lanes < 2 || p.Lanes > dfv1.MaxPriorityLanes {
+		return fmt.Errorf("priority lanes should be between 2 and %d", dfv1.MaxPriorityLanes)
+	}
+	if p.Header == "" && len(p.Tags) == 0 {
+		return fmt.Errorf("either priority header or tags is required")
+	}
+	for tag, lane := range p.Tags {
+		if lane < 0 || lane >= p.Lanes {
+			return fmt.Errorf("priority lane %d of tag %q should be between 0 and %d", lane, tag, p.Lanes-1)
+		}
+	}
+	if len(p.Weights) > 0 && len(p.Weights) != int(p.Lanes) {
+		return fmt.Errorf("the number of priority weights should be the same as the number of lanes")
+	}
+	for _, w := range p.Weights {
+		if w <= 0 {
+			return fmt.Errorf("priority weights should be greater than 0")
+		}
+	}
 	return nil
 }
 
+func equalPriorityLanes(a, b *dfv1.EdgePriority) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Lanes == b.Lanes && slices.Equal(a.GetWeights(), b.GetWeights())
+}
+
 // validateIdleSource validates the idle source watermark config.
 // The threshold should be greater than or equal to incrementBy.
 func validateIdleSource(pl dfv1.Pipeline) error {
@@ -237,10 +438,22 @@ func validateVertex(v dfv1.AbstractVertex) error {
 			return fmt.Errorf("vertex %q: sidecar container name %q is reserved for containers created by numaflow", v.Name, sc.Name)
 		}
 	}
+	if err := validateDeadLetter(v); err != nil {
+		return fmt.Errorf("invalid vertex %q: %w", v.Name, err)
+	}
 	if v.Source != nil {
 		if err := validateSource(*v.Source); err != nil {
 			return fmt.Errorf("invalid vertex %q: %w", v.Name, err)
 		}
+		// each replica receives all the messages with an ephemeral consumer, the messages would be duplicated
+		if x := v.Source.JetStream; x != nil && x.Ephemeral && v.Scale.GetMaxReplicas() > 1 {
+			return fmt.Errorf("invalid vertex %q: ephemeral jetstream consumer requires max replicas of the vertex to be no more than 1", v.Name)
+		}
+		if x := v.Source.Generator; x != nil && x.Replay != nil && x.Replay.VolumeName != "" {
+			if !slices.ContainsFunc(v.Volumes, func(vol corev1.Volume) bool { return vol.Name == x.Replay.VolumeName }) {
+				return fmt.Errorf("invalid vertex %q: volume %q of the generator replay is not found", v.Name, x.Replay.VolumeName)
+			}
+		}
 		return nil
 	}
 
@@ -255,6 +468,18 @@ func validateVertex(v dfv1.AbstractVertex) error {
 		if err := validateSink(*v.Sink); err != nil {
 			return fmt.Errorf("invalid vertex %q: %w", v.Name, err)
 		}
+		if x := v.Sink.File; x != nil && x.VolumeName != "" {
+			if x.S3 != nil {
+				return fmt.Errorf("invalid vertex %q: volumeName of the file sink can not be used with s3", v.Name)
+			}
+			if !slices.ContainsFunc(v.Volumes, func(vol corev1.Volume) bool { return vol.Name == x.VolumeName }) {
+				return fmt.Errorf("invalid vertex %q: volume %q of the file sink is not found", v.Name, x.VolumeName)
+			}
+		}
+		// the replicas of a sink compete for the same buffer partitions, the checkpoints only work with one replica.
+		if x := v.Sink.Kafka; x != nil && x.ExactlyOnce && v.Scale.GetMaxReplicas() > 1 {
+			return fmt.Errorf("invalid vertex %q: exactly-once kafka sink requires max replicas of the vertex to be no more than 1", v.Name)
+		}
 		return nil
 	}
 	return nil
@@ -279,17 +504,30 @@ func validateMapUDF(udf dfv1.UDF) error {
 	} else if udf.Builtin == nil {
 		return fmt.Errorf("invalid udf, either specify a builtin function, or a customized image")
 	}
+	if b := udf.Builtin; b != nil {
+		builtinFunc := &builtin.Builtin{Name: b.Name, Args: b.Args, KWArgs: b.KWArgs}
+		if err := builtinFunc.Validate(); err != nil {
+			return fmt.Errorf("invalid builtin function %q, %w", b.Name, err)
+		}
+	}
 	return nil
 }
 
 func validateReduceUDF(udf dfv1.UDF) error {
-	if udf.Builtin != nil {
-		// No builtin function supported for reduce vertices.
-		return fmt.Errorf("invalid udf, there's no buildin function support in reduce vertices")
-	}
 	if udf.Container != nil {
-		if udf.Container.Image == "" {
-			return fmt.Errorf("invalid udf spec, a customized image is required")
+		if udf.Container.Image == "" && udf.Builtin == nil {
+			return fmt.Errorf("invalid udf spec, either specify a builtin function, or a customized image")
+		}
+		if udf.Container.Image != "" && udf.Builtin != nil {
+			return fmt.Errorf("invalid udf, can not specify both builtin function, and a customized image")
+		}
+	}
+	if b := udf.Builtin; b != nil {
+		if udf.GroupBy.Window.Fixed == nil && udf.GroupBy.Window.Sliding == nil {
+			return fmt.Errorf("invalid builtin function %q, only fixed and sliding windows are supported", b.Name)
+		}
+		if _, err := builtinreduce.New(b.Name, b.KWArgs); err != nil {
+			return fmt.Errorf("invalid builtin function %q, %w", b.Name, err)
 		}
 	}
 
@@ -297,9 +535,11 @@ func validateReduceUDF(udf dfv1.UDF) error {
 	s := udf.GroupBy.Window.Sliding
 	ss := udf.GroupBy.Window.Session
 	accum := udf.GroupBy.Window.Accumulator
+	c := udf.GroupBy.Window.Count
+	g := udf.GroupBy.Window.Global
 
 	storage := udf.GroupBy.Storage
-	if f == nil && s == nil && ss == nil && accum == nil {
+	if f == nil && s == nil && ss == nil && accum == nil && c == nil && g == nil {
 		return fmt.Errorf(`invalid "groupBy.window", no windowing strategy specified`)
 	}
 	if f != nil && s != nil {
@@ -311,6 +551,12 @@ func validateReduceUDF(udf dfv1.UDF) error {
 	if s != nil && ss != nil {
 		return fmt.Errorf(`invalid "groupBy.window", either sliding or session is allowed, not both`)
 	}
+	if c != nil && (f != nil || s != nil || ss != nil || accum != nil || g != nil) {
+		return fmt.Errorf(`invalid "groupBy.window", count can not be specified with other windowing strategies`)
+	}
+	if g != nil && (f != nil || s != nil || ss != nil || accum != nil) {
+		return fmt.Errorf(`invalid "groupBy.window", global can not be specified with other windowing strategies`)
+	}
 
 	if f != nil && f.Length == nil {
 		return fmt.Errorf(`invalid "groupBy.window.fixed", "length" is missing`)
@@ -327,6 +573,17 @@ func validateReduceUDF(udf dfv1.UDF) error {
 	if accum != nil && accum.Timeout == nil {
 		return fmt.Errorf(`invalid "groupBy.window.accumulator", "timeout" is missing`)
 	}
+	if c != nil && c.Count == 0 {
+		return fmt.Errorf(`invalid "groupBy.window.count", "count" should be greater than 0`)
+	}
+	if c != nil && c.Timeout == nil {
+		return fmt.Errorf(`invalid "groupBy.window.count", "timeout" is missing`)
+	}
+	if g != nil && g.Trigger != "" {
+		if _, err := expr.CompileMessageBool(g.Trigger); err != nil {
+			return fmt.Errorf(`invalid "groupBy.window.global", "trigger" is invalid, %w`, err)
+		}
+	}
 	if storage == nil {
 		return fmt.Errorf(`invalid "groupBy", "storage" is missing`)
 	}
@@ -597,6 +854,104 @@ func validateSource(source dfv1.Source) error {
 			return fmt.Errorf("invalid user-defined source spec, only one of 'http', 'kafka', 'nats', 'generator' and 'udSource' can be specified")
 		}
 	}
+	if err := validateGeneratorSource(source.Generator); err != nil {
+		return err
+	}
+	if x := source.Nats; x != nil && x.OverflowSize < 0 {
+		return fmt.Errorf("invalid nats source spec, overflowSize must not be negative")
+	}
+	if err := validateJetStreamSource(source.JetStream); err != nil {
+		return err
+	}
+	if x := source.Kafka; x != nil {
+		if p := x.StartPosition; p != nil && p.Policy == dfv1.KafkaStartTimestamp && p.Timestamp == nil {
+			return fmt.Errorf("invalid kafka source spec, startPosition timestamp is required by the %q policy", p.Policy)
+		}
+	}
+	return nil
+}
+
+// validateGeneratorSource checks the templating, replay and out of order options of a generator source.
+func validateGeneratorSource(g *dfv1.GeneratorSource) error {
+	if g == nil {
+		return nil
+	}
+	if g.Template != nil && g.Replay != nil {
+		return fmt.Errorf("invalid generator source, template and replay can not be used together")
+	}
+	if x := g.Template; x != nil {
+		if _, err := template.New("payload").Funcs(sprig.TxtFuncMap()).Parse(*x); err != nil {
+			return fmt.Errorf("invalid generator source, failed to parse the template, %w", err)
+		}
+	}
+	if x := g.Replay; x != nil {
+		if x.Path == "" {
+			return fmt.Errorf("invalid generator source, replay path is required")
+		}
+		if x.VolumeName != "" && path.Dir(x.Path) == "/" {
+			return fmt.Errorf("invalid generator source, replay path with a volume can not be in the root directory")
+		}
+		if x.RewriteEventTime && x.EventTimeField == "" {
+			return fmt.Errorf("invalid generator source, rewriteEventTime requires eventTimeField")
+		}
+	}
+	if x := g.OutOfOrder; x != nil {
+		if x.Percent < 0 || x.Percent > 100 {
+			return fmt.Errorf("invalid generator source, outOfOrder percent must be between 0 and 100")
+		}
+		if x.Delay.Duration < 0 {
+			return fmt.Errorf("invalid generator source, outOfOrder delay must not be negative")
+		}
+	}
+	return nil
+}
+
+// validateJetStreamSource checks the consumer options of a jetstream source.
+func validateJetStreamSource(j *dfv1.JetStreamSource) error {
+	if j == nil {
+		return nil
+	}
+	for _, subject := range j.FilterSubjects {
+		if subject == "" {
+			return fmt.Errorf("invalid jetstream source spec, filterSubjects must not contain an empty subject")
+		}
+	}
+	switch j.DeliverPolicy {
+	case "", dfv1.JetStreamDeliverAll, dfv1.JetStreamDeliverNew:
+	case dfv1.JetStreamDeliverByStartTime:
+		if j.StartTime == nil {
+			return fmt.Errorf("invalid jetstream source spec, startTime is required by the deliver policy %q", j.DeliverPolicy)
+		}
+	case dfv1.JetStreamDeliverBySequence:
+		if j.StartSequence == 0 {
+			return fmt.Errorf("invalid jetstream source spec, startSequence is required by the deliver policy %q", j.DeliverPolicy)
+		}
+	default:
+		return fmt.Errorf("invalid jetstream source spec, unsupported deliver policy %q", j.DeliverPolicy)
+	}
+	if j.MaxAckPending < 0 {
+		return fmt.Errorf("invalid jetstream source spec, maxAckPending must not be negative")
+	}
+	if j.AckWait != nil && j.AckWait.Duration < 0 {
+		return fmt.Errorf("invalid jetstream source spec, ackWait must not be negative")
+	}
+	return nil
+}
+
+// validateDeadLetter checks the dead-letter settings of a vertex
+func validateDeadLetter(v dfv1.AbstractVertex) error {
+	if v.Sink != nil && v.Sink.RetryStrategy.GetOnFailureRetryStrategy() == dfv1.OnFailureDeadLetter && v.DeadLetter == nil {
+		return fmt.Errorf("given OnFailure strategy is deadletter but deadLetter is not configured")
+	}
+	if v.DeadLetter == nil {
+		return nil
+	}
+	if !v.IsMapUDF() && !v.IsASink() {
+		return fmt.Errorf("deadLetter is only supported for map and sink vertices")
+	}
+	if v.DeadLetter.RetryInterval != nil && v.DeadLetter.RetryInterval.Duration < 0 {
+		return fmt.Errorf("deadLetter retryInterval must not be negative")
+	}
 	return nil
 }
 
@@ -606,10 +961,119 @@ func validateSink(sink dfv1.Sink) error {
 	if ok := hasValidSinkRetryStrategy(sink); !ok {
 		return fmt.Errorf("given OnFailure strategy is fallback but fallback sink is not provided")
 	}
+	if err := validateSinkRetryBackoff(sink.RetryStrategy.BackOff); err != nil {
+		return err
+	}
+	if err := validateKafkaSink(sink.Kafka); err != nil {
+		return err
+	}
+	if x := sink.Fallback; x != nil && x.Kafka != nil && x.Kafka.ExactlyOnce {
+		return fmt.Errorf("exactlyOnce is not supported by the fallback kafka sink")
+	}
+	if err := validateFileSink(sink.File); err != nil {
+		return err
+	}
+	if x := sink.Fallback; x != nil && x.File != nil {
+		return fmt.Errorf("file sink is not supported as a fallback sink")
+	}
+	if err := validateLogSink(sink.Log); err != nil {
+		return err
+	}
+	if err := validateHTTPSink(sink.HTTP); err != nil {
+		return err
+	}
+	if x := sink.Fallback; x != nil {
+		if err := validateHTTPSink(x.HTTP); err != nil {
+			return fmt.Errorf("invalid fallback sink, %w", err)
+		}
+	}
 	// TODO: add more validations for each sink type
 	return nil
 }
 
+// validateKafkaSink checks the producer options of a kafka sink.
+func validateKafkaSink(k *dfv1.KafkaSink) error {
+	if k == nil {
+		return nil
+	}
+	if k.Partitioner == dfv1.KafkaPartitionerHeader && k.PartitionHeader == "" {
+		return fmt.Errorf("invalid kafka sink, partitionHeader is required by the %q partitioner", k.Partitioner)
+	}
+	if k.MaxMessageBytes < 0 {
+		return fmt.Errorf("invalid kafka sink, maxMessageBytes must not be negative")
+	}
+	return nil
+}
+
+// validateFileSink checks the options of a file sink.
+func validateFileSink(f *dfv1.FileSink) error {
+	if f == nil {
+		return nil
+	}
+	if f.S3 == nil && f.Path == "" {
+		return fmt.Errorf("invalid file sink, path is required")
+	}
+	if x := f.S3; x != nil && (x.Bucket == "" || x.Region == "") {
+		return fmt.Errorf("invalid file sink, s3 bucket and region are required")
+	}
+	// the files are staged in memory before they are committed
+	if f.MaxFileBytes < 0 || f.MaxFileBytes > 1024*1024*1024 {
+		return fmt.Errorf("invalid file sink, maxFileBytes must be between 0 and 1Gi")
+	}
+	if f.RollInterval != nil && f.RollInterval.Duration < 0 {
+		return fmt.Errorf("invalid file sink, rollInterval must not be negative")
+	}
+	return nil
+}
+
+// validateLogSink checks the options of a log sink.
+func validateLogSink(l *dfv1.Log) error {
+	if l == nil {
+		return nil
+	}
+	if l.MaxPayloadBytes < 0 {
+		return fmt.Errorf("invalid log sink, maxPayloadBytes must not be negative")
+	}
+	if x := l.Sampling; x != nil && (x.EveryN < 0 || x.PerSecond < 0) {
+		return fmt.Errorf("invalid log sink, sampling everyN and perSecond must not be negative")
+	}
+	return nil
+}
+
+// validateHTTPSink checks the options of an HTTP sink.
+func validateHTTPSink(h *dfv1.HTTPSink) error {
+	if h == nil {
+		return nil
+	}
+	if h.URL == "" {
+		return fmt.Errorf("invalid http sink, url is required")
+	}
+	if _, err := template.New("url").Parse(h.URL); err != nil {
+		return fmt.Errorf("invalid http sink, failed to parse the url template, %w", err)
+	}
+	for k, v := range h.Headers {
+		if _, err := template.New(k).Parse(v); err != nil {
+			return fmt.Errorf("invalid http sink, failed to parse the template of header %q, %w", k, err)
+		}
+	}
+	if x := h.BasicAuth; x != nil && (x.User == nil || x.Password == nil) {
+		return fmt.Errorf("invalid http sink, basicAuth requires both user and password")
+	}
+	if h.BasicAuth != nil && h.BearerTokenSecret != nil {
+		return fmt.Errorf("invalid http sink, basicAuth and bearerTokenSecret can not be used together")
+	}
+	if h.Timeout != nil && h.Timeout.Duration < 0 {
+		return fmt.Errorf("invalid http sink, timeout must not be negative")
+	}
+	if h.Concurrency < 0 {
+		return fmt.Errorf("invalid http sink, concurrency must not be negative")
+	}
+	if x := h.Batch; x != nil && x.MaxMessages < 0 {
+		return fmt.Errorf("invalid http sink, batch maxMessages must not be negative")
+	}
+	return nil
+}
+
 // HasValidSinkRetryStrategy checks if the provided RetryStrategy is valid based on the sink's configuration.
 // This validation ensures that the retry strategy is compatible with the sink's current setup
 func hasValidSinkRetryStrategy(s dfv1.Sink) bool {
@@ -626,6 +1090,30 @@ func hasValidSinkRetryStrategy(s dfv1.Sink) bool {
 	return true
 }
 
+// validateSinkRetryBackoff checks if the exponential backoff parameters of the sink retry strategy are in sane ranges.
+func validateSinkRetryBackoff(b *dfv1.Backoff) error {
+	if b == nil {
+		return nil
+	}
+	interval := dfv1.DefaultRetryInterval
+	if b.Interval != nil {
+		if b.Interval.Duration <= 0 {
+			return fmt.Errorf("invalid retry strategy, backoff interval must be greater than 0")
+		}
+		interval = b.Interval.Duration
+	}
+	if b.Cap != nil && b.Cap.Duration < interval {
+		return fmt.Errorf("invalid retry strategy, backoff cap %v must not be less than the interval %v", b.Cap.Duration, interval)
+	}
+	if b.Factor != nil && *b.Factor < 1 {
+		return fmt.Errorf("invalid retry strategy, backoff factor must be greater than or equal to 1, got %v", *b.Factor)
+	}
+	if b.Jitter != nil && (*b.Jitter < 0 || *b.Jitter > 1) {
+		return fmt.Errorf("invalid retry strategy, backoff jitter must be in the range of [0, 1], got %v", *b.Jitter)
+	}
+	return nil
+}
+
 // HasValidFallbackSink checks if the Sink vertex has a valid fallback sink configured
 func hasValidFallbackSink(s *dfv1.Sink) bool {
 	return s.Fallback != nil && s.Fallback.UDSink != nil
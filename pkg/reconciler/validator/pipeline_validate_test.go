@@ -436,6 +436,115 @@ func TestValidatePipeline(t *testing.T) {
 		assert.Contains(t, err.Error(), "cannot define multiple edges")
 	})
 
+	t.Run("builtin function with valid args", func(t *testing.T) {
+		testObj := testPipeline.DeepCopy()
+		testObj.Spec.Vertices[1].UDF.Builtin = &dfv1.Function{Name: "decode", KWArgs: map[string]string{"encoding": "base64"}}
+		err := ValidatePipeline(testObj)
+		assert.NoError(t, err)
+	})
+
+	t.Run("builtin function with invalid args", func(t *testing.T) {
+		testObj := testPipeline.DeepCopy()
+		testObj.Spec.Vertices[1].UDF.Builtin = &dfv1.Function{Name: "project"}
+		err := ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `invalid builtin function "project"`)
+	})
+
+	t.Run("edge - valid expression condition", func(t *testing.T) {
+		testObj := testPipeline.DeepCopy()
+		testObj.Spec.Edges[1].Conditions = &dfv1.ForwardConditions{Expression: `json(payload).amount > 100 && headers["x-region"] == "us"`}
+		err := ValidatePipeline(testObj)
+		assert.NoError(t, err)
+	})
+
+	t.Run("edge - invalid expression condition", func(t *testing.T) {
+		testObj := testPipeline.DeepCopy()
+		testObj.Spec.Edges[1].Conditions = &dfv1.ForwardConditions{Expression: `json(payload).amount >`}
+		err := ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "unable to compile expression")
+	})
+
+	t.Run("edge - expression condition on rust runtime", func(t *testing.T) {
+		testObj := testPipeline.DeepCopy()
+		testObj.Spec.Edges[1].Conditions = &dfv1.ForwardConditions{Expression: `headers["x-region"] == "us"`}
+		testObj.Spec.Vertices[1].ContainerTemplate = &dfv1.ContainerTemplate{
+			Env: []corev1.EnvVar{{Name: dfv1.EnvNumaflowRuntime, Value: "rust"}},
+		}
+		err := ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "conditions expression is not supported by the Rust runtime")
+
+		testObj.Spec.Vertices[1].ContainerTemplate.Env[0].Value = "golang"
+		assert.NoError(t, ValidatePipeline(testObj))
+	})
+
+	t.Run("edge - valid compression", func(t *testing.T) {
+		testObj := testPipeline.DeepCopy()
+		testObj.Spec.InterStepBuffer = &dfv1.InterStepBuffer{Compression: &dfv1.Compression{Type: dfv1.CompressionTypeSnappy}}
+		testObj.Spec.Edges[1].Compression = &dfv1.Compression{Type: dfv1.CompressionTypeZstd}
+		err := ValidatePipeline(testObj)
+		assert.NoError(t, err)
+	})
+
+	t.Run("edge - compression on rust runtime", func(t *testing.T) {
+		testObj := testPipeline.DeepCopy()
+		testObj.Spec.InterStepBuffer = &dfv1.InterStepBuffer{Compression: &dfv1.Compression{Type: dfv1.CompressionTypeSnappy}}
+		testObj.Spec.Vertices[2].ContainerTemplate = &dfv1.ContainerTemplate{
+			Env: []corev1.EnvVar{{Name: dfv1.EnvNumaflowRuntime, Value: "rust"}},
+		}
+		err := ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "compression is not supported by the Rust runtime")
+
+		testObj.Spec.Edges[1].Compression = &dfv1.Compression{Type: dfv1.CompressionTypeNone}
+		assert.NoError(t, ValidatePipeline(testObj))
+	})
+
+	t.Run("edge - invalid compression", func(t *testing.T) {
+		testObj := testPipeline.DeepCopy()
+		testObj.Spec.Edges[1].Compression = &dfv1.Compression{Type: "lz4"}
+		err := ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `unsupported compression type "lz4"`)
+		testObj.Spec.Edges[1].Compression = nil
+		testObj.Spec.InterStepBuffer = &dfv1.InterStepBuffer{Compression: &dfv1.Compression{Type: "lz4"}}
+		err = ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid interStepBuffer")
+	})
+
+	t.Run("claim check", func(t *testing.T) {
+		testObj := testPipeline.DeepCopy()
+		testObj.Spec.Limits = &dfv1.PipelineLimits{ClaimCheck: &dfv1.ClaimCheck{S3: &dfv1.ClaimCheckS3{Bucket: "my-bucket", Region: "us-west-2"}}}
+		assert.NoError(t, ValidatePipeline(testObj))
+		testObj.Spec.Limits.ClaimCheck.PersistentVolumeClaim = &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "claims"}
+		err := ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "exactly one of s3 and persistentVolumeClaim is required")
+		testObj.Spec.Limits.ClaimCheck.S3 = nil
+		assert.NoError(t, ValidatePipeline(testObj))
+		testObj.Spec.Limits.ClaimCheck = &dfv1.ClaimCheck{S3: &dfv1.ClaimCheckS3{Bucket: "my-bucket"}}
+		err = ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "s3 bucket and region are required")
+	})
+
+	t.Run("claim check on rust runtime", func(t *testing.T) {
+		testObj := testPipeline.DeepCopy()
+		testObj.Spec.Limits = &dfv1.PipelineLimits{ClaimCheck: &dfv1.ClaimCheck{S3: &dfv1.ClaimCheckS3{Bucket: "my-bucket", Region: "us-west-2"}}}
+		testObj.Spec.Vertices[2].ContainerTemplate = &dfv1.ContainerTemplate{
+			Env: []corev1.EnvVar{{Name: dfv1.EnvNumaflowRuntime, Value: "rust"}},
+		}
+		err := ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "claim check is not supported by the Rust runtime")
+
+		testObj.Spec.Limits.ClaimCheck = nil
+		assert.NoError(t, ValidatePipeline(testObj))
+	})
+
 	t.Run("UDF not connected to pipeline", func(t *testing.T) {
 		testObj := testPipeline.DeepCopy()
 		testObj.Spec.Vertices = append(testObj.Spec.Vertices, dfv1.AbstractVertex{Name: "input1", UDF: &dfv1.UDF{Builtin: &dfv1.Function{Name: "cat"}}})
@@ -589,7 +698,7 @@ func TestValidateReducePipeline(t *testing.T) {
 		}
 		err := ValidatePipeline(testObj)
 		assert.Error(t, err)
-		assert.Contains(t, err.Error(), "no buildin function support in reduce vertices")
+		assert.Contains(t, err.Error(), "can not specify both builtin function, and a customized image")
 	})
 
 	t.Run("test no image in container", func(t *testing.T) {
@@ -597,7 +706,35 @@ func TestValidateReducePipeline(t *testing.T) {
 		testObj.Spec.Vertices[1].UDF.Container.Image = ""
 		err := ValidatePipeline(testObj)
 		assert.Error(t, err)
-		assert.Contains(t, err.Error(), "a customized image is required")
+		assert.Contains(t, err.Error(), "either specify a builtin function, or a customized image")
+	})
+
+	t.Run("test builtin reduce function", func(t *testing.T) {
+		testObj := testReducePipeline.DeepCopy()
+		testObj.Spec.Vertices[1].UDF.Container = nil
+		testObj.Spec.Vertices[1].UDF.Builtin = &dfv1.Function{Name: "topK", KWArgs: map[string]string{"k": "5", "path": "amount"}}
+		err := ValidatePipeline(testObj)
+		assert.NoError(t, err)
+
+		testObj.Spec.Vertices[1].UDF.Builtin = &dfv1.Function{Name: "topK"}
+		err = ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `invalid builtin function "topK"`)
+
+		testObj.Spec.Vertices[1].UDF.Builtin = &dfv1.Function{Name: "cat"}
+		err = ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "unrecognized function")
+	})
+
+	t.Run("test builtin reduce function with session window", func(t *testing.T) {
+		testObj := testReducePipeline.DeepCopy()
+		testObj.Spec.Vertices[1].UDF.Container = nil
+		testObj.Spec.Vertices[1].UDF.Builtin = &dfv1.Function{Name: "count"}
+		testObj.Spec.Vertices[1].UDF.GroupBy.Window = dfv1.Window{Session: &dfv1.SessionWindow{Timeout: &metav1.Duration{Duration: time.Minute}}}
+		err := ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "only fixed and sliding windows are supported")
 	})
 
 	t.Run("test partitions", func(t *testing.T) {
@@ -640,6 +777,80 @@ func TestValidateReducePipeline(t *testing.T) {
 		assert.Contains(t, err.Error(), `either emptyDir or persistentVolumeClaim is allowed, not both`)
 	})
 
+	t.Run("late data tag", func(t *testing.T) {
+		testObj := testReducePipeline.DeepCopy()
+		testObj.Spec.Vertices[1].UDF.GroupBy.LateDataTag = "late"
+		err := ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `late data tag "late" is not used by the tag conditions of any outgoing edge`)
+
+		testObj.Spec.Edges[1].Conditions = &dfv1.ForwardConditions{Tags: &dfv1.TagConditions{Values: []string{"late"}}}
+		err = ValidatePipeline(testObj)
+		assert.NoError(t, err)
+	})
+
+	t.Run("priority lanes", func(t *testing.T) {
+		testObj := testPipeline.DeepCopy()
+		testObj.Spec.Edges[0].Priority = &dfv1.EdgePriority{Lanes: 3, Tags: map[string]int32{"urgent": 2}}
+		assert.NoError(t, ValidatePipeline(testObj))
+
+		testObj.Spec.Edges[0].Priority.Lanes = 1
+		err := ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "priority lanes should be between 2 and 10")
+
+		testObj.Spec.Edges[0].Priority = &dfv1.EdgePriority{Lanes: 2}
+		err = ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "either priority header or tags is required")
+
+		testObj.Spec.Edges[0].Priority = &dfv1.EdgePriority{Lanes: 2, Tags: map[string]int32{"urgent": 2}}
+		err = ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `priority lane 2 of tag "urgent" should be between 0 and 1`)
+
+		testObj.Spec.Edges[0].Priority = &dfv1.EdgePriority{Lanes: 2, Header: "x-priority", Weights: []int32{1, 2, 3}}
+		err = ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "the number of priority weights should be the same as the number of lanes")
+
+		testObj.Spec.Edges[0].Priority.Weights = []int32{1, 0}
+		err = ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "priority weights should be greater than 0")
+
+		testObj = testPipeline.DeepCopy()
+		testObj.Spec.Vertices = append(testObj.Spec.Vertices, dfv1.AbstractVertex{Name: "p2", UDF: &dfv1.UDF{Builtin: &dfv1.Function{Name: "cat"}}})
+		testObj.Spec.Edges = append(testObj.Spec.Edges, dfv1.Edge{From: "input", To: "p2"}, dfv1.Edge{From: "p2", To: "output"})
+		testObj.Spec.Edges[1].Priority = &dfv1.EdgePriority{Lanes: 2, Header: "x-priority"}
+		err = ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "the priority lanes and weights of all the edges to a vertex must be the same")
+
+		testObj.Spec.Edges[3].Priority = &dfv1.EdgePriority{Lanes: 2, Tags: map[string]int32{"urgent": 1}}
+		assert.NoError(t, ValidatePipeline(testObj))
+
+		testObj.Spec.Vertices[2].Sink.Kafka = &dfv1.KafkaSink{Brokers: []string{"localhost:9092"}, Topic: "test", ExactlyOnce: true}
+		testObj.Spec.Vertices[2].Scale.Max = ptr.To[int32](1)
+		err = ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "priority lanes are not supported by exactly once Kafka sinks")
+
+		testObj = testReducePipeline.DeepCopy()
+		testObj.Spec.Edges[0].Priority = &dfv1.EdgePriority{Lanes: 2, Header: "x-priority"}
+		err = ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `invalid vertex "p1", priority lanes are not supported by reduce vertices`)
+
+		testObj = testPipeline.DeepCopy()
+		testObj.Spec.Edges[0].Priority = &dfv1.EdgePriority{Lanes: 2, Header: "x-priority"}
+		testObj.Spec.Vertices[1].ContainerTemplate = &dfv1.ContainerTemplate{
+			Env: []corev1.EnvVar{{Name: dfv1.EnvNumaflowRuntime, Value: "rust"}},
+		}
+		err = ValidatePipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "priority lanes are not supported by the Rust runtime")
+	})
 }
 
 func TestValidateVertex(t *testing.T) {
@@ -681,6 +892,52 @@ func TestValidateVertex(t *testing.T) {
 		assert.Contains(t, err.Error(), "or equal to")
 	})
 
+	t.Run("exactly-once kafka sink", func(t *testing.T) {
+		v := dfv1.AbstractVertex{
+			Name: "my-vertex",
+			Sink: &dfv1.Sink{
+				AbstractSink: dfv1.AbstractSink{
+					Kafka: &dfv1.KafkaSink{ExactlyOnce: true},
+				},
+			},
+		}
+		err := validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "max replicas")
+		v.Scale.Max = ptr.To[int32](1)
+		assert.NoError(t, validateVertex(v))
+	})
+
+	t.Run("ephemeral jetstream consumer", func(t *testing.T) {
+		v := dfv1.AbstractVertex{
+			Name: "my-vertex",
+			Source: &dfv1.Source{
+				JetStream: &dfv1.JetStreamSource{URL: "nats://localhost:4222", Stream: "s", Ephemeral: true},
+			},
+		}
+		err := validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "max replicas")
+		v.Scale.Max = ptr.To[int32](1)
+		assert.NoError(t, validateVertex(v))
+	})
+
+	t.Run("file sink volume", func(t *testing.T) {
+		v := dfv1.AbstractVertex{
+			Name: "my-vertex",
+			Sink: &dfv1.Sink{
+				AbstractSink: dfv1.AbstractSink{
+					File: &dfv1.FileSink{Path: "/data", VolumeName: "out"},
+				},
+			},
+		}
+		err := validateVertex(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "not found")
+		v.Volumes = []corev1.Volume{{Name: "out"}}
+		assert.NoError(t, validateVertex(v))
+	})
+
 	t.Run("rollingUpdateStrategy - invalid maxUnavailable", func(t *testing.T) {
 		v := dfv1.AbstractVertex{
 			Name: "my-vertex",
@@ -798,6 +1055,43 @@ func TestValidateUDF(t *testing.T) {
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), `"timeout" is missing`)
 	})
+
+	t.Run("bad count window", func(t *testing.T) {
+		udf := dfv1.UDF{
+			GroupBy: &dfv1.GroupBy{
+				Window: dfv1.Window{
+					Count: &dfv1.CountWindow{Timeout: &metav1.Duration{Duration: time.Minute}},
+				},
+			},
+		}
+		err := validateUDF(udf)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `"count" should be greater than 0`)
+
+		udf.GroupBy.Window.Count = &dfv1.CountWindow{Count: 10}
+		err = validateUDF(udf)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `"timeout" is missing`)
+
+		udf.GroupBy.Window.Count.Timeout = &metav1.Duration{Duration: time.Minute}
+		udf.GroupBy.Window.Global = &dfv1.GlobalWindow{}
+		err = validateUDF(udf)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `count can not be specified with other windowing strategies`)
+	})
+
+	t.Run("bad global window trigger", func(t *testing.T) {
+		udf := dfv1.UDF{
+			GroupBy: &dfv1.GroupBy{
+				Window: dfv1.Window{
+					Global: &dfv1.GlobalWindow{Trigger: `json(payload).done ==`},
+				},
+			},
+		}
+		err := validateUDF(udf)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `"trigger" is invalid`)
+	})
 }
 
 func Test_validateSideInputs(t *testing.T) {
@@ -1137,6 +1431,58 @@ func Test_validateIdleSource(t *testing.T) {
 }
 
 // TestValidateSink tests the validateSink function with different sink configurations.
+func TestValidateSource(t *testing.T) {
+	t.Run("kafka start position", func(t *testing.T) {
+		src := dfv1.Source{Kafka: &dfv1.KafkaSource{StartPosition: &dfv1.KafkaStartPosition{Policy: dfv1.KafkaStartTimestamp}}}
+		err := validateSource(src)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "startPosition timestamp is required")
+		src.Kafka.StartPosition.Timestamp = &metav1.Time{Time: time.Now()}
+		assert.NoError(t, validateSource(src))
+		src.Kafka.StartPosition = &dfv1.KafkaStartPosition{Policy: dfv1.KafkaStartEarliest}
+		assert.NoError(t, validateSource(src))
+	})
+
+	t.Run("nats overflow", func(t *testing.T) {
+		src := dfv1.Source{Nats: &dfv1.NatsSource{URL: "nats://localhost:4222", Subject: "s", OverflowSize: -1}}
+		assert.Error(t, validateSource(src))
+		src.Nats.OverflowSize = 100
+		assert.NoError(t, validateSource(src))
+	})
+
+	t.Run("jetstream deliver policy", func(t *testing.T) {
+		src := dfv1.Source{JetStream: &dfv1.JetStreamSource{URL: "nats://localhost:4222", Stream: "s", DeliverPolicy: dfv1.JetStreamDeliverByStartTime}}
+		err := validateSource(src)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "startTime is required")
+		src.JetStream.StartTime = &metav1.Time{Time: time.Now()}
+		assert.NoError(t, validateSource(src))
+		src.JetStream.DeliverPolicy = dfv1.JetStreamDeliverBySequence
+		assert.Error(t, validateSource(src))
+		src.JetStream.StartSequence = 100
+		assert.NoError(t, validateSource(src))
+		src.JetStream.FilterSubjects = []string{"orders.*", ""}
+		assert.Error(t, validateSource(src))
+	})
+
+	t.Run("generator", func(t *testing.T) {
+		tmpl := `{"id":"{{ uuidv4 }}","n":{{ .Counter }}}`
+		src := dfv1.Source{Generator: &dfv1.GeneratorSource{Template: &tmpl}}
+		assert.NoError(t, validateSource(src))
+		invalid := `{{ .Counter`
+		src.Generator.Template = &invalid
+		assert.Error(t, validateSource(src))
+		src.Generator = &dfv1.GeneratorSource{Replay: &dfv1.GeneratorReplay{Path: "/data/events.jsonl", RewriteEventTime: true}}
+		err := validateSource(src)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "eventTimeField")
+		src.Generator.Replay.EventTimeField = "ts"
+		assert.NoError(t, validateSource(src))
+		src.Generator.OutOfOrder = &dfv1.GeneratorOutOfOrder{Percent: 101}
+		assert.Error(t, validateSource(src))
+	})
+}
+
 func TestValidateSink(t *testing.T) {
 	onFailFallback := dfv1.OnFailureFallback
 	tests := []struct {
@@ -1170,6 +1516,126 @@ func TestValidateSink(t *testing.T) {
 			},
 			expectedError: true,
 		},
+		{
+			name: "Exactly-once fallback kafka sink",
+			sink: dfv1.Sink{
+				RetryStrategy: dfv1.RetryStrategy{OnFailure: &onFailFallback},
+				Fallback: &dfv1.AbstractSink{
+					Kafka: &dfv1.KafkaSink{ExactlyOnce: true},
+				},
+			},
+			expectedError: true,
+		},
+		{
+			name: "File sink without path",
+			sink: dfv1.Sink{
+				AbstractSink: dfv1.AbstractSink{
+					File: &dfv1.FileSink{},
+				},
+			},
+			expectedError: true,
+		},
+		{
+			name: "File sink with s3 prefix",
+			sink: dfv1.Sink{
+				AbstractSink: dfv1.AbstractSink{
+					File: &dfv1.FileSink{S3: &dfv1.FileSinkS3{Bucket: "my-bucket", Region: "us-west-2"}},
+				},
+			},
+			expectedError: false,
+		},
+		{
+			name: "File sink with too large files",
+			sink: dfv1.Sink{
+				AbstractSink: dfv1.AbstractSink{
+					File: &dfv1.FileSink{Path: "/data", MaxFileBytes: 2 * 1024 * 1024 * 1024},
+				},
+			},
+			expectedError: true,
+		},
+		{
+			name: "File fallback sink",
+			sink: dfv1.Sink{
+				RetryStrategy: dfv1.RetryStrategy{OnFailure: &onFailFallback},
+				Fallback: &dfv1.AbstractSink{
+					File: &dfv1.FileSink{Path: "/data"},
+				},
+			},
+			expectedError: true,
+		},
+		{
+			name: "Log sink with negative sampling",
+			sink: dfv1.Sink{
+				AbstractSink: dfv1.AbstractSink{
+					Log: &dfv1.Log{Sampling: &dfv1.LogSampling{EveryN: -1}},
+				},
+			},
+			expectedError: true,
+		},
+		{
+			name: "Log sink with json format",
+			sink: dfv1.Sink{
+				AbstractSink: dfv1.AbstractSink{
+					Log: &dfv1.Log{Format: dfv1.LogFormatJSON, MaxPayloadBytes: 1024},
+				},
+			},
+			expectedError: false,
+		},
+		{
+			name: "HTTP sink without url",
+			sink: dfv1.Sink{
+				AbstractSink: dfv1.AbstractSink{
+					HTTP: &dfv1.HTTPSink{},
+				},
+			},
+			expectedError: true,
+		},
+		{
+			name: "HTTP sink with invalid header template",
+			sink: dfv1.Sink{
+				AbstractSink: dfv1.AbstractSink{
+					HTTP: &dfv1.HTTPSink{URL: "http://example.com", Headers: map[string]string{"X-Key": "{{ .Keys"}},
+				},
+			},
+			expectedError: true,
+		},
+		{
+			name: "HTTP sink with templated url",
+			sink: dfv1.Sink{
+				AbstractSink: dfv1.AbstractSink{
+					HTTP: &dfv1.HTTPSink{URL: "http://example.com/{{ index .Keys 0 }}", Batch: &dfv1.HTTPSinkBatch{MaxMessages: 10}},
+				},
+			},
+			expectedError: false,
+		},
+		{
+			name: "HTTP fallback sink without url",
+			sink: dfv1.Sink{
+				RetryStrategy: dfv1.RetryStrategy{OnFailure: &onFailFallback},
+				Fallback: &dfv1.AbstractSink{
+					HTTP: &dfv1.HTTPSink{},
+				},
+			},
+			expectedError: true,
+		},
+		{
+			name: "Kafka sink with header partitioner but no header",
+			sink: dfv1.Sink{
+				AbstractSink: dfv1.AbstractSink{
+					Kafka: &dfv1.KafkaSink{Partitioner: dfv1.KafkaPartitionerHeader},
+				},
+			},
+			expectedError: true,
+		},
+		{
+			name: "Kafka sink with header partitioner",
+			sink: dfv1.Sink{
+				AbstractSink: dfv1.AbstractSink{
+					Kafka: &dfv1.KafkaSink{Partitioner: dfv1.KafkaPartitionerHeader, PartitionHeader: "tenant"},
+				},
+			},
+			expectedError: false,
+		},
 		{
 			name: "Invalid configuration, fallback needed but not provided",
 			sink: dfv1.Sink{
@@ -1192,6 +1658,67 @@ func TestValidateSink(t *testing.T) {
 	}
 }
 
+func TestValidateSinkRetryBackoff(t *testing.T) {
+	tests := []struct {
+		name    string
+		backoff *dfv1.Backoff
+		wantErr string
+	}{
+		{name: "no backoff", backoff: nil},
+		{
+			name: "valid exponential backoff",
+			backoff: &dfv1.Backoff{
+				Interval: &metav1.Duration{Duration: 10 * time.Millisecond},
+				Cap:      &metav1.Duration{Duration: 10 * time.Second},
+				Factor:   ptr.To(2.0),
+				Jitter:   ptr.To(0.1),
+			},
+		},
+		{
+			name:    "cap with default interval",
+			backoff: &dfv1.Backoff{Cap: &metav1.Duration{Duration: time.Second}},
+		},
+		{
+			name:    "zero interval",
+			backoff: &dfv1.Backoff{Interval: &metav1.Duration{Duration: 0}},
+			wantErr: "backoff interval must be greater than 0",
+		},
+		{
+			name: "cap less than interval",
+			backoff: &dfv1.Backoff{
+				Interval: &metav1.Duration{Duration: time.Second},
+				Cap:      &metav1.Duration{Duration: time.Millisecond},
+			},
+			wantErr: "must not be less than the interval",
+		},
+		{
+			name:    "factor less than 1",
+			backoff: &dfv1.Backoff{Factor: ptr.To(0.5)},
+			wantErr: "backoff factor must be greater than or equal to 1",
+		},
+		{
+			name:    "negative jitter",
+			backoff: &dfv1.Backoff{Jitter: ptr.To(-0.1)},
+			wantErr: "backoff jitter must be in the range of [0, 1]",
+		},
+		{
+			name:    "jitter greater than 1",
+			backoff: &dfv1.Backoff{Jitter: ptr.To(1.5)},
+			wantErr: "backoff jitter must be in the range of [0, 1]",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateSink(dfv1.Sink{RetryStrategy: dfv1.RetryStrategy{BackOff: tt.backoff}})
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
 func TestIsValidSinkRetryStrategy(t *testing.T) {
 	zeroSteps := uint32(0)
 	tests := []struct {
@@ -1257,3 +1784,67 @@ func TestIsValidSinkRetryStrategy(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateDeadLetter(t *testing.T) {
+	deadLetterStrategy := dfv1.OnFailureDeadLetter
+	tests := []struct {
+		name    string
+		vertex  dfv1.AbstractVertex
+		wantErr string
+	}{
+		{
+			name:   "no dead letter",
+			vertex: dfv1.AbstractVertex{Name: "map", UDF: &dfv1.UDF{}},
+		},
+		{
+			name:   "map vertex",
+			vertex: dfv1.AbstractVertex{Name: "map", UDF: &dfv1.UDF{}, DeadLetter: &dfv1.DeadLetter{MaxRetries: ptr.To[uint32](5)}},
+		},
+		{
+			name: "sink vertex with deadletter strategy",
+			vertex: dfv1.AbstractVertex{
+				Name:       "sink",
+				Sink:       &dfv1.Sink{RetryStrategy: dfv1.RetryStrategy{OnFailure: &deadLetterStrategy}},
+				DeadLetter: &dfv1.DeadLetter{},
+			},
+		},
+		{
+			name:    "sink vertex with deadletter strategy but no dead letter",
+			vertex:  dfv1.AbstractVertex{Name: "sink", Sink: &dfv1.Sink{RetryStrategy: dfv1.RetryStrategy{OnFailure: &deadLetterStrategy}}},
+			wantErr: "deadLetter is not configured",
+		},
+		{
+			name: "reduce vertex",
+			vertex: dfv1.AbstractVertex{
+				Name:       "reduce",
+				UDF:        &dfv1.UDF{GroupBy: &dfv1.GroupBy{}},
+				DeadLetter: &dfv1.DeadLetter{},
+			},
+			wantErr: "only supported for map and sink vertices",
+		},
+		{
+			name:    "source vertex",
+			vertex:  dfv1.AbstractVertex{Name: "in", Source: &dfv1.Source{}, DeadLetter: &dfv1.DeadLetter{}},
+			wantErr: "only supported for map and sink vertices",
+		},
+		{
+			name: "negative retry interval",
+			vertex: dfv1.AbstractVertex{
+				Name:       "map",
+				UDF:        &dfv1.UDF{},
+				DeadLetter: &dfv1.DeadLetter{RetryInterval: &metav1.Duration{Duration: -time.Second}},
+			},
+			wantErr: "retryInterval must not be negative",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateDeadLetter(tt.vertex)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.wantErr)
+			}
+		})
+	}
+}
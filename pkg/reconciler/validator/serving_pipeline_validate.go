@@ -21,5 +21,6 @@ import (
 )
 
 func ValidateServingPipeline(spl *dfv1.ServingPipeline) error {
-	return nil
+	// all the vertices of a ServingPipeline run on the Rust runtime.
+	return validateRustRuntime(spl.Spec.Pipeline, func(dfv1.AbstractVertex) bool { return true })
 }
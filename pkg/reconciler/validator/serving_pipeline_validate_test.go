@@ -15,3 +15,34 @@ limitations under the License.
 */
 
 package validator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+)
+
+func TestValidateServingPipeline(t *testing.T) {
+	spl := &dfv1.ServingPipeline{
+		Spec: dfv1.ServingPipelineSpec{
+			Pipeline: dfv1.PipelineSpec{
+				Vertices: []dfv1.AbstractVertex{
+					{Name: "in", Source: &dfv1.Source{Serving: &dfv1.ServingSource{}}},
+					{Name: "out", Sink: &dfv1.Sink{}},
+				},
+				Edges: []dfv1.Edge{{From: "in", To: "out"}},
+			},
+		},
+	}
+	assert.NoError(t, ValidateServingPipeline(spl))
+
+	t.Run("expression condition", func(t *testing.T) {
+		testObj := spl.DeepCopy()
+		testObj.Spec.Pipeline.Edges[0].Conditions = &dfv1.ForwardConditions{Expression: `headers["x-region"] == "us"`}
+		err := ValidateServingPipeline(testObj)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "not supported by the Rust runtime")
+	})
+}
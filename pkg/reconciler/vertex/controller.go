@@ -367,7 +367,7 @@ func (r *vertexReconciler) orchestratePodsFromTo(ctx context.Context, vertex *df
 			labels[dfv1.KeyVertexName] = vertex.Spec.Name
 			annotations[dfv1.KeyHash] = newHash
 			annotations[dfv1.KeyReplica] = strconv.Itoa(replica)
-			if vertex.IsMapUDF() || vertex.IsReduceUDF() {
+			if vertex.IsMapUDF() || (vertex.IsReduceUDF() && vertex.Spec.UDF.Builtin == nil) {
 				annotations[dfv1.KeyDefaultContainer] = dfv1.CtrUdf
 			} else if vertex.IsUDSink() {
 				annotations[dfv1.KeyDefaultContainer] = dfv1.CtrUdsink
@@ -551,6 +551,7 @@ func (r *vertexReconciler) buildPodSpec(vertex *dfv1.Vertex, pl *dfv1.Pipeline,
 	vols, volMounts := sharedutil.VolumesFromSecretsAndConfigMaps(vertex)
 	podSpec.Volumes = append(podSpec.Volumes, vols...)
 	podSpec.Containers[0].VolumeMounts = append(podSpec.Containers[0].VolumeMounts, volMounts...)
+	sharedutil.AddISBSvcDiskVolume(podSpec, isbSvcConfig)
 
 	if vertex.IsReduceUDF() && vertex.Spec.UDF.GroupBy.Storage.NoStore == nil {
 		// Add pvc for reduce vertex pods
@@ -585,6 +586,9 @@ func (r *vertexReconciler) buildPodSpec(vertex *dfv1.Vertex, pl *dfv1.Pipeline,
 		bks = append(bks, pl.GetAllBuckets()...)
 	} else {
 		bfs = append(bfs, vertex.OwnedBuffers()...)
+		if b := vertex.GetDeadLetterBuffer(); b != "" {
+			bfs = append(bfs, b)
+		}
 		bks = append(bks, vertex.GetFromBuckets()...)
 		bks = append(bks, vertex.GetToBuckets()...)
 	}
@@ -0,0 +1,178 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package builtin provides the builtin reduce functions, which run in-process in the numa container of a reduce
+// vertex, instead of in a user-defined container.
+package builtin
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/tidwall/gjson"
+)
+
+// Aggregator aggregates the messages of a key in a window.
+type Aggregator interface {
+	// Add adds the payload of a message to the aggregation.
+	Add(payload []byte)
+	// Result returns the result of the aggregation, it will be encoded as JSON.
+	Result() any
+}
+
+// New returns a function to create the aggregators of the builtin reduce function with the given name.
+func New(name string, kwargs map[string]string) (func() Aggregator, error) {
+	switch name {
+	case "count":
+		return func() Aggregator { return &count{} }, nil
+	case "sum":
+		e := newExtractor(kwargs)
+		return func() Aggregator { return &sum{extractor: e} }, nil
+	case "min":
+		e := newExtractor(kwargs)
+		return func() Aggregator { return &minMax{extractor: e, less: true} }, nil
+	case "max":
+		e := newExtractor(kwargs)
+		return func() Aggregator { return &minMax{extractor: e} }, nil
+	case "topK":
+		e := newExtractor(kwargs)
+		k, err := strconv.Atoi(kwargs["k"])
+		if err != nil || k <= 0 {
+			return nil, fmt.Errorf(`invalid "k" %q, a positive integer is required`, kwargs["k"])
+		}
+		return func() Aggregator { return &topK{extractor: e, k: k} }, nil
+	default:
+		return nil, fmt.Errorf("unrecognized function %q", name)
+	}
+}
+
+// extractor extracts a numeric value from the payload of a message.
+type extractor struct {
+	// path is the dot-separated path of the JSON field to extract the value from, the whole payload is used if it's empty.
+	path string
+}
+
+func newExtractor(kwargs map[string]string) extractor {
+	return extractor{path: kwargs["path"]}
+}
+
+// value returns the numeric value of the payload, and false if the value is missing or not numeric.
+func (e extractor) value(payload []byte) (float64, bool) {
+	var v gjson.Result
+	if e.path == "" {
+		v = gjson.ParseBytes(payload)
+	} else {
+		if !gjson.ValidBytes(payload) {
+			return 0, false
+		}
+		v = gjson.GetBytes(payload, e.path)
+	}
+	switch v.Type {
+	case gjson.Number:
+		return v.Num, true
+	case gjson.String:
+		f, err := strconv.ParseFloat(strings.TrimSpace(v.Str), 64)
+		// NaN and Inf can not be encoded as JSON
+		return f, err == nil && !math.IsNaN(f) && !math.IsInf(f, 0)
+	default:
+		return 0, false
+	}
+}
+
+// count counts the messages.
+type count struct {
+	n int64
+}
+
+func (c *count) Add([]byte) {
+	c.n++
+}
+
+func (c *count) Result() any {
+	return c.n
+}
+
+// sum sums up the values of the messages, messages without a numeric value are skipped.
+type sum struct {
+	extractor
+	total float64
+}
+
+func (s *sum) Add(payload []byte) {
+	if v, ok := s.value(payload); ok {
+		s.total += v
+	}
+}
+
+func (s *sum) Result() any {
+	return s.total
+}
+
+// minMax tracks the minimum or the maximum value of the messages, messages without a numeric value are skipped.
+type minMax struct {
+	extractor
+	// less is true to track the minimum value, otherwise the maximum value is tracked.
+	less   bool
+	result *float64
+}
+
+func (m *minMax) Add(payload []byte) {
+	v, ok := m.value(payload)
+	if !ok {
+		return
+	}
+	if m.result == nil || (m.less && v < *m.result) || (!m.less && v > *m.result) {
+		m.result = &v
+	}
+}
+
+func (m *minMax) Result() any {
+	return m.result
+}
+
+// topK tracks the k largest values of the messages in descending order, messages without a numeric value are skipped.
+type topK struct {
+	extractor
+	k      int
+	values []float64
+}
+
+func (t *topK) Add(payload []byte) {
+	v, ok := t.value(payload)
+	if !ok {
+		return
+	}
+	i := sort.Search(len(t.values), func(i int) bool { return t.values[i] < v })
+	if i >= t.k {
+		return
+	}
+	t.values = append(t.values, 0)
+	copy(t.values[i+1:], t.values[i:])
+	t.values[i] = v
+	if len(t.values) > t.k {
+		t.values = t.values[:t.k]
+	}
+}
+
+func (t *topK) Result() any {
+	if t.values == nil {
+		return []float64{}
+	}
+	return t.values
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builtin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func aggregate(t *testing.T, name string, kwargs map[string]string, payloads ...string) any {
+	t.Helper()
+	newAggregator, err := New(name, kwargs)
+	assert.NoError(t, err)
+	a := newAggregator()
+	for _, p := range payloads {
+		a.Add([]byte(p))
+	}
+	return a.Result()
+}
+
+func TestNew(t *testing.T) {
+	for _, name := range []string{"count", "sum", "min", "max"} {
+		_, err := New(name, nil)
+		assert.NoError(t, err)
+	}
+	_, err := New("topK", map[string]string{"k": "3"})
+	assert.NoError(t, err)
+	_, err = New("topK", nil)
+	assert.Error(t, err)
+	_, err = New("topK", map[string]string{"k": "0"})
+	assert.Error(t, err)
+	_, err = New("cat", nil)
+	assert.ErrorContains(t, err, "unrecognized function")
+}
+
+func TestCount(t *testing.T) {
+	assert.Equal(t, int64(0), aggregate(t, "count", nil))
+	assert.Equal(t, int64(3), aggregate(t, "count", nil, "a", "b", `{"c":1}`))
+}
+
+func TestSum(t *testing.T) {
+	assert.Equal(t, 6.5, aggregate(t, "sum", nil, "1", "2", "3.5"))
+	assert.Equal(t, 0.0, aggregate(t, "sum", nil))
+	kwargs := map[string]string{"path": "order.amount"}
+	assert.Equal(t, 15.0, aggregate(t, "sum", kwargs,
+		`{"order":{"amount":10}}`,
+		`{"order":{"amount":"5"}}`,
+		`{"order":{"amount":"abc"}}`,
+		`{"order":{}}`,
+		`not json`,
+		`{"order":{"amount":"NaN"}}`,
+	))
+}
+
+func TestMinMax(t *testing.T) {
+	kwargs := map[string]string{"path": "v"}
+	payloads := []string{`{"v":3}`, `{"v":-1.5}`, `{"v":10}`, `{"x":100}`}
+	assert.Equal(t, -1.5, *aggregate(t, "min", kwargs, payloads...).(*float64))
+	assert.Equal(t, 10.0, *aggregate(t, "max", kwargs, payloads...).(*float64))
+	assert.Nil(t, aggregate(t, "min", kwargs).(*float64))
+	assert.Nil(t, aggregate(t, "max", kwargs, `{"x":1}`).(*float64))
+}
+
+func TestTopK(t *testing.T) {
+	kwargs := map[string]string{"k": "3"}
+	assert.Equal(t, []float64{}, aggregate(t, "topK", kwargs))
+	assert.Equal(t, []float64{2, 1}, aggregate(t, "topK", kwargs, "1", "2"))
+	assert.Equal(t, []float64{9, 7, 7}, aggregate(t, "topK", kwargs, "5", "7", "1", "9", "7", "3", "x"))
+}
@@ -0,0 +1,161 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builtin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/reduce/pbq/partition"
+	"github.com/numaproj/numaflow/pkg/window"
+)
+
+// AlignedReduce is a reduce applier that applies a builtin reduce function on aligned (fixed and sliding) windows.
+// It implements the applier.ReduceApplier interface.
+type AlignedReduce struct {
+	vertexName    string
+	vertexReplica int32
+	name          string
+	newAggregator func() Aggregator
+}
+
+// keyedAggregator is the aggregator of a key in a window.
+type keyedAggregator struct {
+	keys       []string
+	aggregator Aggregator
+}
+
+func NewAlignedReduce(vertexName string, vertexReplica int32, fn *dfv1.Function) (*AlignedReduce, error) {
+	newAggregator, err := New(fn.Name, fn.KWArgs)
+	if err != nil {
+		return nil, err
+	}
+	return &AlignedReduce{
+		vertexName:    vertexName,
+		vertexReplica: vertexReplica,
+		name:          fn.Name,
+		newAggregator: newAggregator,
+	}, nil
+}
+
+// IsHealthy always returns nil since the builtin reduce function runs in-process.
+func (r *AlignedReduce) IsHealthy(context.Context) error {
+	return nil
+}
+
+// ApplyReduce aggregates the messages of each key in the window, once the request stream is closed, it emits one
+// JSON result for each key followed by an EOF response for the window.
+func (r *AlignedReduce) ApplyReduce(ctx context.Context, partitionID *partition.ID, requestsStream <-chan *window.TimedWindowRequest) (<-chan *window.TimedWindowResponse, <-chan error) {
+	var (
+		errCh      = make(chan error, 1)
+		responseCh = make(chan *window.TimedWindowResponse)
+	)
+
+	go func() {
+		aggregators := make(map[string]*keyedAggregator)
+		// keep the order of the keys to emit the results in the order the keys were seen
+		var order []string
+	readLoop:
+		for {
+			select {
+			case <-ctx.Done():
+				errCh <- ctx.Err()
+				return
+			case req, ok := <-requestsStream:
+				if !ok {
+					break readLoop
+				}
+				if req == nil || req.ReadMessage == nil {
+					continue
+				}
+				key := strings.Join(req.ReadMessage.Keys, dfv1.KeysDelimitter)
+				a, existing := aggregators[key]
+				if !existing {
+					a = &keyedAggregator{keys: req.ReadMessage.Keys, aggregator: r.newAggregator()}
+					aggregators[key] = a
+					order = append(order, key)
+				}
+				a.aggregator.Add(req.ReadMessage.Payload)
+			}
+		}
+
+		win := window.NewAlignedTimedWindow(partitionID.Start, partitionID.End, partitionID.Slot)
+		for index, key := range order {
+			writeMessage, err := r.buildMessage(partitionID, int32(index), aggregators[key])
+			if err != nil {
+				errCh <- err
+				return
+			}
+			select {
+			case responseCh <- &window.TimedWindowResponse{WriteMessage: writeMessage, Window: win}:
+			case <-ctx.Done():
+				errCh <- ctx.Err()
+				return
+			}
+		}
+		select {
+		case responseCh <- &window.TimedWindowResponse{Window: win, EOF: true}:
+		case <-ctx.Done():
+			errCh <- ctx.Err()
+			return
+		}
+		close(responseCh)
+	}()
+
+	return responseCh, errCh
+}
+
+// buildMessage builds the result message of a key in the window.
+func (r *AlignedReduce) buildMessage(partitionID *partition.ID, index int32, a *keyedAggregator) (*isb.WriteMessage, error) {
+	keys := a.keys
+	if keys == nil {
+		keys = []string{}
+	}
+	payload, err := json.Marshal(map[string]any{
+		"start": partitionID.Start.UnixMilli(),
+		"end":   partitionID.End.UnixMilli(),
+		"keys":  keys,
+		r.name:  a.aggregator.Result(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal the result of builtin function %q, %w", r.name, err)
+	}
+	return &isb.WriteMessage{
+		Message: isb.Message{
+			Header: isb.Header{
+				MessageInfo: isb.MessageInfo{
+					EventTime: partitionID.End.Add(-1 * time.Millisecond),
+				},
+				Keys: a.keys,
+				// create a unique message id for each response message which will be used for deduplication
+				ID: isb.MessageID{
+					VertexName: r.vertexName,
+					Offset:     fmt.Sprintf("%s-%d", partitionID.String(), r.vertexReplica),
+					Index:      index,
+				},
+			},
+			Body: isb.Body{
+				Payload: payload,
+			},
+		},
+	}, nil
+}
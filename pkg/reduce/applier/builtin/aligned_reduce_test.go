@@ -0,0 +1,113 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builtin
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/reduce/pbq/partition"
+	"github.com/numaproj/numaflow/pkg/window"
+)
+
+func TestNewAlignedReduce(t *testing.T) {
+	_, err := NewAlignedReduce("reduce", 0, &dfv1.Function{Name: "sum", KWArgs: map[string]string{"path": "v"}})
+	assert.NoError(t, err)
+	_, err = NewAlignedReduce("reduce", 0, &dfv1.Function{Name: "filter"})
+	assert.Error(t, err)
+}
+
+func TestAlignedReduce_ApplyReduce(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	r, err := NewAlignedReduce("reduce", 1, &dfv1.Function{Name: "sum", KWArgs: map[string]string{"path": "v"}})
+	assert.NoError(t, err)
+	assert.NoError(t, r.IsHealthy(ctx))
+
+	start := time.UnixMilli(60000)
+	end := time.UnixMilli(120000)
+	pid := &partition.ID{Start: start, End: end, Slot: "slot-0"}
+	win := window.NewAlignedTimedWindow(start, end, "slot-0")
+
+	requests := make(chan *window.TimedWindowRequest, 10)
+	for i, k := range []string{"a", "b", "a"} {
+		requests <- &window.TimedWindowRequest{
+			Operation: window.Append,
+			ReadMessage: &isb.ReadMessage{
+				Message: isb.Message{
+					Header: isb.Header{Keys: []string{k}},
+					Body:   isb.Body{Payload: []byte(`{"v":` + strconv.Itoa(i+1) + `}`)},
+				},
+			},
+			ID:      pid,
+			Windows: []window.TimedWindow{win},
+		}
+	}
+	close(requests)
+
+	responseCh, errCh := r.ApplyReduce(ctx, pid, requests)
+	var responses []*window.TimedWindowResponse
+	for response := range responseCh {
+		responses = append(responses, response)
+	}
+	select {
+	case err := <-errCh:
+		t.Fatalf("unexpected error: %v", err)
+	default:
+	}
+
+	assert.Len(t, responses, 3)
+	expected := []struct {
+		keys []string
+		sum  float64
+	}{{[]string{"a"}, 4}, {[]string{"b"}, 2}}
+	for i, e := range expected {
+		msg := responses[i].WriteMessage
+		assert.False(t, responses[i].EOF)
+		assert.Equal(t, e.keys, msg.Keys)
+		assert.Equal(t, end.Add(-time.Millisecond), msg.EventTime)
+		assert.Equal(t, int32(i), msg.ID.Index)
+		assert.Equal(t, "60000-120000-slot-0-1", msg.ID.Offset)
+		var result map[string]any
+		assert.NoError(t, json.Unmarshal(msg.Payload, &result))
+		assert.Equal(t, e.sum, result["sum"])
+		assert.Equal(t, float64(60000), result["start"])
+		assert.Equal(t, float64(120000), result["end"])
+		assert.Equal(t, []any{e.keys[0]}, result["keys"])
+	}
+	assert.True(t, responses[2].EOF)
+	assert.Equal(t, end, responses[2].Window.EndTime())
+}
+
+func TestAlignedReduce_ApplyReduceCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	r, err := NewAlignedReduce("reduce", 0, &dfv1.Function{Name: "count"})
+	assert.NoError(t, err)
+
+	pid := &partition.ID{Start: time.UnixMilli(0), End: time.UnixMilli(1000)}
+	_, errCh := r.ApplyReduce(ctx, pid, make(chan *window.TimedWindowRequest))
+	cancel()
+	assert.ErrorIs(t, <-errCh, context.Canceled)
+}
@@ -29,8 +29,10 @@ package reduce
 
 import (
 	"context"
+	"fmt"
 	"math"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -74,6 +76,9 @@ type DataForward struct {
 	of                  *pnf.ProcessAndForward
 	opts                *Options
 	currentWatermark    time.Time // if watermark is -1, then make sure event-time is < watermark
+	windowSpec          dfv1.Window
+	lateMessages        []*isb.WriteMessage // late messages of the batch to be forwarded with the late data tag
+	lateReadMessages    []*isb.ReadMessage  // read messages the late messages of the batch came from
 	log                 *zap.SugaredLogger
 }
 
@@ -118,6 +123,7 @@ func NewDataForward(ctx context.Context,
 		of:                  of,
 		wmbChecker:          wmb.NewWMBChecker(2), // TODO: make configurable
 		currentWatermark:    time.UnixMilli(-1),
+		windowSpec:          vertexInstance.Vertex.Spec.UDF.GroupBy.Window,
 		log:                 logging.FromContext(ctx),
 		opts:                options}
 
@@ -420,8 +426,12 @@ func (df *DataForward) associatePBQAndPnF(ctx context.Context, partitionID *part
 		}
 		// since we created a brand new PBQ it means there is no PnF listening on this PBQ.
 		// we should create and attach the read side of the loop (PnF) to the partition and then
-		// start process-and-forward (pnf) loop
-		df.of.AsyncSchedulePnF(ctx, partitionID, q)
+		// start process-and-forward (pnf) loop, the pnf has to outlive the read loop if the windows are flushed on shutdown
+		pnfCtx := ctx
+		if df.opts.flushCtx != nil {
+			pnfCtx = df.opts.flushCtx
+		}
+		df.of.AsyncSchedulePnF(pnfCtx, partitionID, q)
 		df.log.Debugw("Successfully Created/Found pbq and started PnF", zap.String("partitionID", partitionID.String()))
 	}
 
@@ -460,6 +470,29 @@ func (df *DataForward) process(ctx context.Context, messages []*isb.ReadMessage)
 		df.log.Errorw("Failed to write messages", zap.Int("totalMessages", len(messages)), zap.Int("writtenMessage", len(successfullyWrittenMessages)))
 	}
 
+	// write the late messages of the batch before they are acked along with the successfully written messages,
+	// if they can't be written their read messages are no-acked instead so that they are not lost.
+	if len(df.lateMessages) > 0 {
+		if err = df.of.ForwardLateMessages(ctx, df.lateMessages); err != nil {
+			df.log.Errorw("Failed to forward the late messages", zap.Int("lateMessages", len(df.lateMessages)), zap.Error(err))
+			late := make(map[*isb.ReadMessage]struct{}, len(df.lateReadMessages))
+			for _, m := range df.lateReadMessages {
+				late[m] = struct{}{}
+			}
+			writtenMessages := make([]*isb.ReadMessage, 0, len(successfullyWrittenMessages))
+			for _, m := range successfullyWrittenMessages {
+				if _, ok := late[m]; ok {
+					failedMessages = append(failedMessages, m)
+					continue
+				}
+				writtenMessages = append(writtenMessages, m)
+			}
+			successfullyWrittenMessages = writtenMessages
+		}
+		df.lateMessages = nil
+		df.lateReadMessages = nil
+	}
+
 	// ack the control messages
 	if len(ctrlMessages) != 0 {
 		df.ackMessages(ctx, ctrlMessages)
@@ -564,6 +597,7 @@ func (df *DataForward) handleLateMessage(message *isb.ReadMessage) []*window.Tim
 	// and some windows might have already been closed.
 	if df.windower.Strategy() != window.Fixed {
 		df.log.Infow("Dropping the late message", zap.Int64("eventTime", message.EventTime.UnixMilli()), zap.Int64("watermark", message.Watermark.UnixMilli()))
+		df.sideOutputLateMessage(message)
 		return lateMessageWindowRequests
 	}
 
@@ -571,11 +605,13 @@ func (df *DataForward) handleLateMessage(message *isb.ReadMessage) []*window.Tim
 	// if there is no window open, drop the message.
 	if nextWinAsSeenByWriter == nil {
 		df.log.Infow("Dropping the late message", zap.Int64("eventTime", message.EventTime.UnixMilli()), zap.Int64("watermark", message.Watermark.UnixMilli()))
+		df.sideOutputLateMessage(message)
 		return lateMessageWindowRequests
 	}
 	// if the message doesn't fall in the next window that is about to be closed drop it.
 	if message.EventTime.Before(nextWinAsSeenByWriter.StartTime()) {
 		df.log.Infow("Dropping the late message", zap.Int64("eventTime", message.EventTime.UnixMilli()), zap.Int64("watermark", message.Watermark.UnixMilli()), zap.Int64("nextWindowToBeClosed", nextWinAsSeenByWriter.StartTime().UnixMilli()))
+		df.sideOutputLateMessage(message)
 		return lateMessageWindowRequests
 	}
 
@@ -609,12 +645,87 @@ func (df *DataForward) handleOnTimeMessage(message *isb.ReadMessage) []*window.T
 			metrics.LabelPipeline:           df.pipelineName,
 			metrics.LabelVertexReplicaIndex: strconv.Itoa(int(df.vertexReplica)),
 			metrics.LabelReason:             "watermark_issue"}).Inc()
+		df.sideOutputLateMessage(message)
 		return []*window.TimedWindowRequest{}
 	}
 
 	return df.windower.AssignWindows(message)
 }
 
+// sideOutputLateMessage adds the late message to the messages to be forwarded with the late data tag, with the
+// window it would have been assigned to and the current watermark attached as headers. It's a no-op if the late
+// data tag is not set.
+func (df *DataForward) sideOutputLateMessage(message *isb.ReadMessage) {
+	if df.opts.lateDataTag == "" {
+		return
+	}
+
+	headers := make(map[string]string, len(message.Headers)+3)
+	for k, v := range message.Headers {
+		headers[k] = v
+	}
+	var starts, ends []string
+	for _, w := range lateMessageWindows(df.windowSpec, message.EventTime) {
+		starts = append(starts, strconv.FormatInt(w[0].UnixMilli(), 10))
+		ends = append(ends, strconv.FormatInt(w[1].UnixMilli(), 10))
+	}
+	headers[dfv1.KeyMetaLateWindowStart] = strings.Join(starts, ",")
+	headers[dfv1.KeyMetaLateWindowEnd] = strings.Join(ends, ",")
+	headers[dfv1.KeyMetaLateWatermark] = strconv.FormatInt(df.currentWatermark.UnixMilli(), 10)
+
+	df.lateMessages = append(df.lateMessages, &isb.WriteMessage{
+		Message: isb.Message{
+			Header: isb.Header{
+				MessageInfo: message.MessageInfo,
+				Keys:        message.Keys,
+				// the offset of the read message is unique for the replica, suffix it so that it doesn't collide
+				// with the ids of the reduce results.
+				ID: isb.MessageID{
+					VertexName: df.vertexName,
+					Offset:     fmt.Sprintf("%s-late-%d", message.ReadOffset.String(), df.vertexReplica),
+				},
+				Headers: headers,
+			},
+			Body: message.Body,
+		},
+		Tags: []string{df.opts.lateDataTag},
+	})
+	df.lateReadMessages = append(df.lateReadMessages, message)
+
+	metrics.ReduceLateMessagesForwardedCount.With(map[string]string{
+		metrics.LabelVertex:             df.vertexName,
+		metrics.LabelPipeline:           df.pipelineName,
+		metrics.LabelVertexReplicaIndex: strconv.Itoa(int(df.vertexReplica)),
+	}).Inc()
+}
+
+// lateMessageWindows returns the [start, end) of the windows a message with the given event time would have been
+// assigned to. The unaligned windows, whose bounds depend on the other messages of the key, are reported as the
+// window the message would have opened.
+func lateMessageWindows(spec dfv1.Window, eventTime time.Time) [][2]time.Time {
+	switch {
+	case spec.Fixed != nil && spec.Fixed.Length != nil:
+		start := eventTime.Truncate(spec.Fixed.Length.Duration)
+		return [][2]time.Time{{start, start.Add(spec.Fixed.Length.Duration)}}
+	case spec.Sliding != nil && spec.Sliding.Length != nil && spec.Sliding.Slide != nil:
+		// same as the sliding windower, a message belongs to all the windows of (length / slide) slides.
+		var windows [][2]time.Time
+		slide := spec.Sliding.Slide.Duration.Milliseconds()
+		start := time.UnixMilli((eventTime.UnixMilli() / slide) * slide)
+		end := start.Add(spec.Sliding.Length.Duration)
+		for !start.After(eventTime) && end.After(eventTime) {
+			windows = append(windows, [2]time.Time{start, end})
+			start = start.Add(-spec.Sliding.Slide.Duration)
+			end = end.Add(-spec.Sliding.Slide.Duration)
+		}
+		return windows
+	case spec.Session != nil && spec.Session.Timeout != nil:
+		return [][2]time.Time{{eventTime, eventTime.Add(spec.Session.Timeout.Duration)}}
+	default:
+		return [][2]time.Time{{eventTime, eventTime.Add(time.Millisecond)}}
+	}
+}
+
 // writeToPBQ writes to the PBQ. It will return error only if it is not failing to write to PBQ and is in a continuous
 // error loop, and we have received ctx.Done() via SIGTERM.
 func (df *DataForward) writeToPBQ(ctx context.Context, winOp *window.TimedWindowRequest, persist bool) error {
@@ -740,6 +851,18 @@ func (df *DataForward) ShutDown(ctx context.Context) {
 		df.log.Infow("Closed buffer reader", zap.String("bufferFrom", df.fromBufferPartition.GetName()))
 	}
 
+	// close the windows which are only closed when the pipeline is paused, so that their results are forwarded before
+	// the pbqs are closed. On any other shutdown they are kept, and replayed from the WAL after the restart.
+	if flusher, ok := df.windower.(window.Flusher); ok && df.opts.flushCtx != nil && df.opts.shouldFlush() {
+		windowOperations := flusher.FlushWindows()
+		df.log.Infow("Flushing windows", zap.Int("length", len(windowOperations)))
+		for _, winOp := range windowOperations {
+			if err := df.writeToPBQ(df.opts.flushCtx, winOp, false); err != nil {
+				df.log.Errorw("Failed to write flush signal to PBQ", zap.Error(err))
+			}
+		}
+	}
+
 	// flush pending messages to persistent storage
 	df.pbqManager.ShutDown(ctx)
 }
@@ -27,6 +27,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/atomic"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/numaproj/numaflow/pkg/forwarder"
@@ -127,7 +128,7 @@ type myForwardTestRoundRobin struct {
 	count atomic.Int32
 }
 
-func (f *myForwardTestRoundRobin) WhereTo(_ []string, _ []string, s string) ([]forwarder.VertexBuffer, error) {
+func (f *myForwardTestRoundRobin) WhereTo(_ []string, _ []string, s string, _ *isb.Message) ([]forwarder.VertexBuffer, error) {
 	var output = []forwarder.VertexBuffer{{
 		ToVertexName:         "reduce-to-vertex",
 		ToVertexPartitionIdx: f.count.Load() % 2,
@@ -183,7 +184,7 @@ func (f CounterReduceTest) CloseConn(_ context.Context) error {
 	return nil
 }
 
-func (f CounterReduceTest) WhereTo(_ []string, _ []string, s string) ([]forwarder.VertexBuffer, error) {
+func (f CounterReduceTest) WhereTo(_ []string, _ []string, s string, _ *isb.Message) ([]forwarder.VertexBuffer, error) {
 	return []forwarder.VertexBuffer{{
 		ToVertexName:         "reduce-to-vertex",
 		ToVertexPartitionIdx: 0,
@@ -247,7 +248,7 @@ func (s SessionSumReduceTest) CloseConn(ctx context.Context) error {
 	return nil
 }
 
-func (s SessionSumReduceTest) WhereTo(_ []string, _ []string, s2 string) ([]forwarder.VertexBuffer, error) {
+func (s SessionSumReduceTest) WhereTo(_ []string, _ []string, s2 string, _ *isb.Message) ([]forwarder.VertexBuffer, error) {
 	return []forwarder.VertexBuffer{{
 		ToVertexName:         "reduce-to-vertex",
 		ToVertexPartitionIdx: 0,
@@ -1901,3 +1902,62 @@ func buildIsbMessageAllowedLatency(messageValue int, eventTime time.Time) isb.Me
 		Body: isb.Body{Payload: result},
 	}
 }
+
+func TestLateMessageWindows(t *testing.T) {
+	eventTime := time.UnixMilli(125000)
+
+	windows := lateMessageWindows(dfv1.Window{Fixed: &dfv1.FixedWindow{Length: &metav1.Duration{Duration: time.Minute}}}, eventTime)
+	assert.Equal(t, [][2]time.Time{{time.UnixMilli(120000), time.UnixMilli(180000)}}, windows)
+
+	windows = lateMessageWindows(dfv1.Window{Sliding: &dfv1.SlidingWindow{Length: &metav1.Duration{Duration: time.Minute}, Slide: &metav1.Duration{Duration: 30 * time.Second}}}, eventTime)
+	assert.Equal(t, [][2]time.Time{{time.UnixMilli(120000), time.UnixMilli(180000)}, {time.UnixMilli(90000), time.UnixMilli(150000)}}, windows)
+
+	windows = lateMessageWindows(dfv1.Window{Session: &dfv1.SessionWindow{Timeout: &metav1.Duration{Duration: time.Minute}}}, eventTime)
+	assert.Equal(t, [][2]time.Time{{eventTime, time.UnixMilli(185000)}}, windows)
+
+	windows = lateMessageWindows(dfv1.Window{Global: &dfv1.GlobalWindow{}}, eventTime)
+	assert.Equal(t, [][2]time.Time{{eventTime, time.UnixMilli(125001)}}, windows)
+}
+
+func TestDataForward_sideOutputLateMessage(t *testing.T) {
+	df := &DataForward{
+		vertexName:       "testVertex",
+		vertexReplica:    1,
+		windowSpec:       dfv1.Window{Fixed: &dfv1.FixedWindow{Length: &metav1.Duration{Duration: time.Minute}}},
+		currentWatermark: time.UnixMilli(300000),
+		opts:             DefaultOptions(),
+	}
+	message := &isb.ReadMessage{
+		Message: isb.Message{
+			Header: isb.Header{
+				MessageInfo: isb.MessageInfo{EventTime: time.UnixMilli(125000)},
+				Keys:        []string{"key-1"},
+				Headers:     map[string]string{"h": "v"},
+			},
+			Body: isb.Body{Payload: []byte("payload")},
+		},
+		ReadOffset: isb.SimpleStringOffset(func() string { return "10" }),
+	}
+
+	// dropped without a late data tag
+	df.sideOutputLateMessage(message)
+	assert.Empty(t, df.lateMessages)
+
+	df.opts.lateDataTag = "late"
+	df.sideOutputLateMessage(message)
+	assert.Len(t, df.lateMessages, 1)
+	assert.Equal(t, []*isb.ReadMessage{message}, df.lateReadMessages)
+	late := df.lateMessages[0]
+	assert.Equal(t, []string{"late"}, late.Tags)
+	assert.Equal(t, []string{"key-1"}, late.Keys)
+	assert.Equal(t, []byte("payload"), late.Payload)
+	assert.Equal(t, "10-late-1", late.ID.Offset)
+	assert.Equal(t, map[string]string{
+		"h":                         "v",
+		dfv1.KeyMetaLateWindowStart: "120000",
+		dfv1.KeyMetaLateWindowEnd:   "180000",
+		dfv1.KeyMetaLateWatermark:   "300000",
+	}, late.Headers)
+	// the headers of the read message are not modified
+	assert.Len(t, message.Headers, 1)
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package reduce
 
 import (
+	"context"
 	"time"
 
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
@@ -28,6 +29,14 @@ type Options struct {
 	readBatchSize int64
 	// allowedLateness is the time.Duration it waits after the watermark has progressed for late-date to be included
 	allowedLateness time.Duration
+	// flushCtx is the context used for closing the windows of a window.Flusher when the forwarder shuts down, it
+	// outlives the read loop so that the closed windows can still be processed and forwarded.
+	flushCtx context.Context
+	// shouldFlush tells whether the windows are closed when the forwarder shuts down, they are replayed from the
+	// WAL after a restart otherwise.
+	shouldFlush func() bool
+	// lateDataTag is the tag the late messages are forwarded with, they are dropped if it's empty
+	lateDataTag string
 }
 
 type Option func(*Options) error
@@ -54,3 +63,23 @@ func WithAllowedLateness(t time.Duration) Option {
 		return nil
 	}
 }
+
+// WithFlushOnShutdown makes the forwarder close all the windows of the windower when it shuts down and shouldFlush
+// returns true (e.g., the pipeline is paused), if the windower is a window.Flusher. The given ctx must outlive the
+// forwarder's ctx, it's used by the PnF and for writing the close operations, and it's expected to be cancelled once
+// the PnF has been shut down.
+func WithFlushOnShutdown(ctx context.Context, shouldFlush func() bool) Option {
+	return func(o *Options) error {
+		o.flushCtx = ctx
+		o.shouldFlush = shouldFlush
+		return nil
+	}
+}
+
+// WithLateDataTag forwards the late messages, which would otherwise be dropped, with the given tag
+func WithLateDataTag(tag string) Option {
+	return func(o *Options) error {
+		o.lateDataTag = tag
+		return nil
+	}
+}
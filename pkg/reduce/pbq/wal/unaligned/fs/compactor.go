@@ -67,6 +67,7 @@ type compactor struct {
 	stopSignal          chan struct{}
 	doneCh              chan struct{}
 	latestWatermark     int64
+	keepUntrackedKeys   bool
 	log                 *zap.SugaredLogger
 }
 
@@ -500,12 +501,12 @@ func (c *compactor) shouldKeepMessage(eventTime int64, key string) bool {
 	// check if the key is present in the compaction key map
 	ce, ok := c.compactKeyMap[key]
 
-	// we should not discard the messages which are not older than the max end time
-	if ok && eventTime >= ce {
-		return true
+	if !ok {
+		return c.keepUntrackedKeys
 	}
 
-	return false
+	// we should not discard the messages which are not older than the max end time
+	return eventTime >= ce
 }
 
 // writeToFile writes the message to the compacted file and rotates the file if the max file size is reached
@@ -247,6 +247,88 @@ readLoop:
 	assert.NoError(t, err)
 }
 
+func TestReplay_AfterCompaction_OpenWindows(t *testing.T) {
+	plName := "test-pl"
+	vtxName := "test-vertex"
+	replicaIndex := int32(0)
+
+	tests := []struct {
+		name            string
+		opts            []CompactorOption
+		openKeyReplayed int
+	}{
+		// session and accumulator windows, the messages of a key without any GC event are discarded
+		{name: "discard untracked keys", openKeyReplayed: 0},
+		// count and global windows, a key without any GC event still has an open window
+		{name: "keep untracked keys", opts: []CompactorOption{WithKeepUntrackedKeys()}, openKeyReplayed: 300},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			segmentDir := t.TempDir()
+			compactDir := t.TempDir()
+			eventDir := t.TempDir()
+
+			pid := window.SharedUnalignedPartition
+			s, err := NewUnalignedWriteOnlyWAL(ctx, plName, vtxName, replicaIndex, &pid, WithStoreOptions(segmentDir, compactDir))
+			assert.NoError(t, err)
+
+			// the window of key-1 is closed for the first 100 messages, key-2 has no closed window yet.
+			closedKeyMessages := testutils.BuildTestReadMessagesIntOffset(300, time.UnixMilli(60000), []string{"key-1"})
+			openKeyMessages := testutils.BuildTestReadMessagesIntOffset(300, time.UnixMilli(60000), []string{"key-2"})
+			for i := range closedKeyMessages {
+				err = s.Write(&closedKeyMessages[i])
+				assert.NoError(t, err)
+				err = s.Write(&openKeyMessages[i])
+				assert.NoError(t, err)
+			}
+
+			tracker, err := NewGCEventsWAL(ctx, plName, vtxName, replicaIndex, WithEventsPath(eventDir), WithGCTrackerSyncDuration(100*time.Millisecond), WithGCTrackerRotationDuration(time.Second))
+			assert.NoError(t, err)
+			err = tracker.PersistGCEvent(window.NewUnalignedTimedWindow(time.UnixMilli(60000), closedKeyMessages[100].EventTime, "slot-0", []string{"key-1"}))
+			assert.NoError(t, err)
+
+			err = s.Close()
+			assert.NoError(t, err)
+			err = tracker.Close()
+			assert.NoError(t, err)
+
+			c, err := NewCompactor(ctx, plName, vtxName, replicaIndex, &pid, eventDir, segmentDir, compactDir, append(tt.opts, WithCompactionDuration(time.Second*5), WithCompactorMaxFileSize(1024*1024*5))...)
+			assert.NoError(t, err)
+			err = c.Start(ctx)
+			assert.NoError(t, err)
+			err = c.Stop()
+			assert.NoError(t, err)
+
+			sm := NewFSManager(ctx, segmentDir, compactDir, vertexInstance)
+			wls, err := sm.DiscoverWALs(ctx)
+			assert.NoError(t, err)
+			assert.Len(t, wls, 1)
+
+			readCh, errCh := wls[0].Replay()
+			replayedCount := make(map[string]int)
+		readLoop:
+			for {
+				select {
+				case msg, ok := <-readCh:
+					if !ok {
+						break readLoop
+					}
+					replayedCount[msg.Keys[0]]++
+				case err := <-errCh:
+					assert.NoError(t, err)
+				}
+			}
+			assert.Equal(t, 200, replayedCount["key-1"])
+			assert.Equal(t, tt.openKeyReplayed, replayedCount["key-2"])
+			err = wls[0].Close()
+			assert.NoError(t, err)
+		})
+	}
+}
+
 func TestFilesInDir(t *testing.T) {
 	dir := t.TempDir()
 	// delete all the files in the directory at the end
@@ -116,3 +116,12 @@ func WithCompactionDuration(maxDuration time.Duration) CompactorOption {
 		c.compactionDuration = maxDuration
 	}
 }
+
+// WithKeepUntrackedKeys keeps the messages of the keys without any GC event, which are discarded by default. It's
+// required by the windows which are closed per key, e.g., count and global windows, where a key which never had a
+// window closed still has an open window.
+func WithKeepUntrackedKeys() CompactorOption {
+	return func(c *compactor) {
+		c.keepUntrackedKeys = true
+	}
+}
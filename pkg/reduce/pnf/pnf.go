@@ -176,6 +176,29 @@ outerLoop:
 	}
 }
 
+// ForwardLateMessages writes the late messages to the ISBs. It returns only after the messages are written, so that the
+// read messages they came from can be acked. The late messages are behind the watermark, they don't move the write
+// offsets used for publishing the watermark. error != nil only when the context is closed.
+func (pf *ProcessAndForward) ForwardLateMessages(ctx context.Context, messages []*isb.WriteMessage) error {
+	messagesToStep := pf.whereToStep(messages)
+	var eg errgroup.Group
+	for key, values := range messagesToStep {
+		for index, messages := range values {
+			if len(messages) == 0 {
+				continue
+			}
+
+			func(toVertexName string, toVertexPartitionIdx int32, lateMessages []isb.Message) {
+				eg.Go(func() error {
+					_, err := pf.writeToBuffer(ctx, toVertexName, toVertexPartitionIdx, lateMessages)
+					return err
+				})
+			}(key, int32(index), messages)
+		}
+	}
+	return eg.Wait()
+}
+
 // forwardResponses forwards the writeMessages to the ISBs. It also publishes the watermark and invokes GC on PBQ.
 // The watermark is only published at COB at key level for Unaligned and at Partition level for Aligned.
 func (pf *ProcessAndForward) forwardResponses(ctx context.Context) {
@@ -381,7 +404,7 @@ func (pf *ProcessAndForward) whereToStep(writeMessages []*isb.WriteMessage) map[
 	var to []forwarder.VertexBuffer
 	var err error
 	for _, msg := range writeMessages {
-		to, err = pf.whereToDecider.WhereTo(msg.Keys, msg.Tags, msg.ID.String())
+		to, err = pf.whereToDecider.WhereTo(msg.Keys, msg.Tags, msg.ID.String(), &msg.Message)
 		if err != nil {
 			metrics.PlatformError.With(map[string]string{
 				metrics.LabelVertex:             pf.vertexName,
@@ -401,7 +424,7 @@ func (pf *ProcessAndForward) whereToStep(writeMessages []*isb.WriteMessage) map[
 			if _, ok := messagesToStep[step.ToVertexName]; !ok {
 				messagesToStep[step.ToVertexName] = make([][]isb.Message, len(pf.toBuffers[step.ToVertexName]))
 			}
-			messagesToStep[step.ToVertexName][step.ToVertexPartitionIdx] = append(messagesToStep[step.ToVertexName][step.ToVertexPartitionIdx], msg.Message)
+			messagesToStep[step.ToVertexName][step.ToVertexPartitionIdx] = append(messagesToStep[step.ToVertexName][step.ToVertexPartitionIdx], forwarder.WithPriorityLane(msg.Message, step.PriorityLane))
 		}
 
 	}
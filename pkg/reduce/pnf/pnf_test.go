@@ -39,7 +39,7 @@ type forwardTest struct {
 	buffers []string
 }
 
-func (f *forwardTest) WhereTo(_ []string, _ []string, s string) ([]forwarder.VertexBuffer, error) {
+func (f *forwardTest) WhereTo(_ []string, _ []string, s string, _ *isb.Message) ([]forwarder.VertexBuffer, error) {
 	var steps []forwarder.VertexBuffer
 	for _, buffer := range f.buffers {
 		steps = append(steps, forwarder.VertexBuffer{
@@ -114,3 +114,50 @@ func TestWriteToBuffer(t *testing.T) {
 		})
 	}
 }
+
+// TestForwardLateMessages tests that the late messages are written to the buffers before ForwardLateMessages returns.
+func TestForwardLateMessages(t *testing.T) {
+	testStartTime := time.Unix(1636470000, 0).UTC()
+	messages := testutils.BuildTestWriteMessages(int64(4), testStartTime, nil, "testVertex")
+	lateMessages := make([]*isb.WriteMessage, 0, len(messages))
+	for _, msg := range messages {
+		lateMessages = append(lateMessages, &isb.WriteMessage{Message: msg, Tags: []string{"late"}})
+	}
+
+	t.Run("written", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		buffer1 := simplebuffer.NewInMemoryBuffer("buffer1-1", 10, 0)
+		buffer2 := simplebuffer.NewInMemoryBuffer("buffer1-2", 10, 1)
+		mngr := &ProcessAndForward{
+			toBuffers:      map[string][]isb.BufferWriter{"buffer": {buffer1, buffer2}},
+			whereToDecider: &forwardTest{buffers: []string{"buffer"}},
+			log:            logging.FromContext(ctx),
+			pipelineName:   testPipelineName,
+			vertexName:     "testVertex",
+		}
+
+		assert.NoError(t, mngr.ForwardLateMessages(ctx, lateMessages))
+		assert.Len(t, buffer1.GetMessages(10), 2)
+		assert.Len(t, buffer2.GetMessages(10), 2)
+	})
+
+	t.Run("context closed", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		mngr := &ProcessAndForward{
+			toBuffers: map[string][]isb.BufferWriter{"buffer": {
+				simplebuffer.NewInMemoryBuffer("buffer2-1", 1, 0, simplebuffer.WithBufferFullWritingStrategy(dfv1.RetryUntilSuccess)),
+				simplebuffer.NewInMemoryBuffer("buffer2-2", 1, 1, simplebuffer.WithBufferFullWritingStrategy(dfv1.RetryUntilSuccess)),
+			}},
+			whereToDecider: &forwardTest{buffers: []string{"buffer"}},
+			log:            logging.FromContext(ctx),
+			pipelineName:   testPipelineName,
+			vertexName:     "testVertex",
+		}
+
+		assert.Error(t, mngr.ForwardLateMessages(ctx, lateMessages))
+	})
+}
@@ -146,8 +146,8 @@ func (c *Client) BindKVStore(kvName string) (nats.KeyValue, error) {
 	return jsContext.KeyValue(kvName)
 }
 
-// PendingForStream returns the number of pending messages for the given consumer and stream
-func (c *Client) PendingForStream(consumer string, stream string) (int64, error) {
+// PendingForStream returns the number of pending messages for the given stream and consumer
+func (c *Client) PendingForStream(stream string, consumer string) (int64, error) {
 	var (
 		err   error
 		cInfo *nats.ConsumerInfo
@@ -156,7 +156,7 @@ func (c *Client) PendingForStream(consumer string, stream string) (int64, error)
 	c.Lock()
 	defer c.Unlock()
 
-	cInfo, err = c.jsCtx.ConsumerInfo(consumer, stream)
+	cInfo, err = c.jsCtx.ConsumerInfo(stream, consumer)
 	if err != nil {
 		return isb.PendingNotAvailable, fmt.Errorf("failed to get consumer info, %w", err)
 	}
@@ -42,6 +42,8 @@ type Options struct {
 	RefreshBufferWriteInfo bool
 	// BufferFullWritingStrategy is the writing strategy when buffer is full
 	BufferFullWritingStrategy dfv1.BufferFullWritingStrategy
+	// Compression is the compression of the message payloads
+	Compression *dfv1.Compression
 }
 
 // Option to apply different options
@@ -156,3 +158,17 @@ func (s bufferFullWritingStrategy) Apply(o *Options) {
 func WithBufferFullWritingStrategy(s dfv1.BufferFullWritingStrategy) Option {
 	return bufferFullWritingStrategy(s)
 }
+
+// WithCompression option
+type compression struct {
+	c *dfv1.Compression
+}
+
+func (c compression) Apply(o *Options) {
+	o.Compression = c.c
+}
+
+// WithCompression sets the compression of the message payloads
+func WithCompression(c *dfv1.Compression) Option {
+	return compression{c: c}
+}
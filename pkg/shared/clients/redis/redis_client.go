@@ -18,6 +18,8 @@ package redis
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
 	"os"
 	"strconv"
@@ -31,6 +33,9 @@ import (
 const ReadFromEarliest = "0-0"
 const ReadFromLatest = "$"
 
+// countEntriesPageSize is the max number of the entries in a reply when counting the entries of a stream.
+const countEntriesPageSize = 1000
+
 // RedisContext is used to pass the context specifically for REDIS operations.
 // A cancelled context during SIGTERM or Ctrl-C that is propagated down will throw a context cancelled error because redis uses context to obtain connection from the connection pool.
 // All redis operations will use the below no-op context.Background() to try to process in-flight messages that we have received prior to the cancellation of the context.
@@ -50,11 +55,12 @@ func NewRedisClient(options *redis.UniversalOptions) *RedisClient {
 
 // NewInClusterRedisClient returns a new Redis Client, it assumes it's in a vertex pod,
 // where those required environment variables are available.
-func NewInClusterRedisClient() *RedisClient {
+func NewInClusterRedisClient() (*RedisClient, error) {
 	opts := &redis.UniversalOptions{
-		Username:   os.Getenv(v1alpha1.EnvISBSvcRedisUser),
-		Password:   os.Getenv(v1alpha1.EnvISBSvcRedisPassword),
-		MasterName: os.Getenv(v1alpha1.EnvISBSvcSentinelMaster),
+		Username:         os.Getenv(v1alpha1.EnvISBSvcRedisUser),
+		Password:         os.Getenv(v1alpha1.EnvISBSvcRedisPassword),
+		MasterName:       os.Getenv(v1alpha1.EnvISBSvcSentinelMaster),
+		SentinelUsername: os.Getenv(v1alpha1.EnvISBSvcRedisSentinelUser),
 		// MaxRedirects is an option for redis cluster mode.
 		// The default value is set 3 to allow redirections when using redis cluster mode.
 		// ref: if we use redis cluster client directly instead of redis universal client, the default value is 3
@@ -76,8 +82,43 @@ func NewInClusterRedisClient() *RedisClient {
 	if i, e := strconv.Atoi(os.Getenv(v1alpha1.EnvISBSvcRedisClusterMaxRedirects)); e == nil {
 		opts.MaxRedirects = i
 	}
+	if os.Getenv(v1alpha1.EnvISBSvcRedisTLSEnabled) == "true" {
+		tlsConfig, err := inClusterTLSConfig()
+		if err != nil {
+			return nil, err
+		}
+		opts.TLSConfig = tlsConfig
+	}
+	// the universal client only connects to a Redis Cluster with multiple addresses, a cluster behind a single
+	// address needs the cluster client explicitly.
+	if opts.MasterName == "" && os.Getenv(v1alpha1.EnvISBSvcRedisCluster) == "true" {
+		return &RedisClient{Client: redis.NewClusterClient(opts.Cluster())}, nil
+	}
+	return NewRedisClient(opts), nil
+}
 
-	return NewRedisClient(opts)
+// inClusterTLSConfig returns the TLS config of the connections to Redis, of which the CA cert, the client cert
+// and the key are PEM encoded in the environment variables.
+func inClusterTLSConfig() (*tls.Config, error) {
+	c := &tls.Config{
+		InsecureSkipVerify: os.Getenv(v1alpha1.EnvISBSvcRedisTLSInsecureSkipVerify) == "true",
+	}
+	if caCert := os.Getenv(v1alpha1.EnvISBSvcRedisTLSCACert); caCert != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(caCert)) {
+			return nil, fmt.Errorf("failed to parse the CA cert of Redis")
+		}
+		c.RootCAs = pool
+	}
+	cert, key := os.Getenv(v1alpha1.EnvISBSvcRedisTLSCert), os.Getenv(v1alpha1.EnvISBSvcRedisTLSKey)
+	if cert != "" || key != "" {
+		clientCert, err := tls.X509KeyPair([]byte(cert), []byte(key))
+		if err != nil {
+			return nil, fmt.Errorf("failed to load the client cert key pair of Redis, %w", err)
+		}
+		c.Certificates = []tls.Certificate{clientCert}
+	}
+	return c, nil
 }
 
 // CreateStreamGroup creates a redis stream group and creates an empty stream if it does not exist.
@@ -121,6 +162,79 @@ func (cl *RedisClient) PendingMsgCount(ctx context.Context, streamKey, consumerG
 	return pending.Count, nil
 }
 
+// StreamGroupPending returns the number of the messages in the stream not delivered to the consumer group yet, and
+// the number of the messages delivered but not acknowledged. Both of them come from the node owning the stream, so
+// that they are accurate on a Redis Cluster. The lag of the group is not available before Redis 7.0, or after some
+// entries are deleted, the entries after the last delivered one are counted then.
+func (cl *RedisClient) StreamGroupPending(ctx context.Context, streamKey, consumerGroup string) (int64, int64, error) {
+	stream, err := cl.StreamInfo(ctx, streamKey)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to get information of stream %q, %w", streamKey, err)
+	}
+	groups, err := cl.StreamGroupInfo(ctx, streamKey)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to get group information of stream %q, %w", streamKey, err)
+	}
+	for _, g := range groups {
+		if g.Name != consumerGroup {
+			continue
+		}
+		switch {
+		case g.LastDeliveredID == stream.LastGeneratedID:
+			return 0, g.Pending, nil
+		case g.Lag > 0:
+			return g.Lag, g.Pending, nil
+		default:
+			undelivered, err := cl.countEntriesAfter(ctx, streamKey, g.LastDeliveredID)
+			if err != nil {
+				return 0, 0, fmt.Errorf("failed to count the undelivered entries of stream %q, %w", streamKey, err)
+			}
+			return undelivered, g.Pending, nil
+		}
+	}
+	return 0, 0, fmt.Errorf("consumer group %q of stream %q not found", consumerGroup, streamKey)
+}
+
+// countEntriesAfter returns the number of the entries in the stream after the given ID, they are ranged in pages
+// to bound the size of each reply.
+func (cl *RedisClient) countEntriesAfter(ctx context.Context, streamKey, id string) (int64, error) {
+	var count int64
+	for {
+		// "(" makes the start exclusive
+		entries, err := cl.Client.XRangeN(ctx, streamKey, "("+id, "+", countEntriesPageSize).Result()
+		if err != nil {
+			return 0, err
+		}
+		count += int64(len(entries))
+		if len(entries) < countEntriesPageSize {
+			return count, nil
+		}
+		id = entries[len(entries)-1].ID
+	}
+}
+
+// DeleteKeysByPattern deletes the keys matching the pattern. The keys are scanned on all the master nodes of a
+// Redis Cluster, since a pattern may match keys in different hash slots.
+func (cl *RedisClient) DeleteKeysByPattern(ctx context.Context, pattern string) error {
+	deleteKeys := func(ctx context.Context, client *redis.Client) error {
+		iter := client.Scan(ctx, 0, pattern, 100).Iterator()
+		for iter.Next(ctx) {
+			if err := client.Del(ctx, iter.Val()).Err(); err != nil {
+				return err
+			}
+		}
+		return iter.Err()
+	}
+	switch c := cl.Client.(type) {
+	case *redis.ClusterClient:
+		return c.ForEachMaster(ctx, deleteKeys)
+	case *redis.Client:
+		return deleteKeys(ctx, c)
+	default:
+		return fmt.Errorf("unsupported redis client %T", cl.Client)
+	}
+}
+
 // IsStreamGroupExists check the stream group exists
 func (cl *RedisClient) IsStreamGroupExists(ctx context.Context, streamKey string, groupName string) bool {
 	result, err := cl.StreamGroupInfo(ctx, streamKey)
@@ -150,6 +264,9 @@ func NotFoundError(err error) bool {
 	return strings.Contains(err.Error(), "requires the key to exist")
 }
 
+// GetRedisStreamName returns the key of the stream of a buffer. The buffer name is the hash tag of the key, and of
+// all the other keys of the buffer, so that they are in the same hash slot of a Redis Cluster, which is required by
+// the script writing to both the stream and the deduplication hash of a buffer.
 func GetRedisStreamName(s string) string {
 	return fmt.Sprintf("{%s}", s)
 }
@@ -28,10 +28,18 @@ package redis
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
 	"errors"
 	"fmt"
+	"math/big"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/redis/go-redis/v9"
@@ -76,8 +84,11 @@ func TestNewInClusterRedisClient(t *testing.T) {
 	setEnv(v1alpha1.EnvISBSvcRedisURL, ":6379")
 	setEnv(v1alpha1.EnvISBSvcRedisClusterMaxRedirects, "5")
 
-	client := NewInClusterRedisClient()
+	client, err := NewInClusterRedisClient()
+	assert.NoError(t, err)
 	assert.NotNil(t, client)
+	_, ok := client.Client.(*redis.Client)
+	assert.True(t, ok)
 
 	// Cleanup environment variables
 	unsetEnv(v1alpha1.EnvISBSvcRedisUser)
@@ -86,6 +97,81 @@ func TestNewInClusterRedisClient(t *testing.T) {
 	unsetEnv(v1alpha1.EnvISBSvcRedisClusterMaxRedirects)
 }
 
+func TestNewInClusterRedisClient_Cluster(t *testing.T) {
+	t.Setenv(v1alpha1.EnvISBSvcRedisURL, "redis-cluster:6379")
+	t.Setenv(v1alpha1.EnvISBSvcRedisCluster, "true")
+
+	client, err := NewInClusterRedisClient()
+	assert.NoError(t, err)
+	_, ok := client.Client.(*redis.ClusterClient)
+	assert.True(t, ok)
+
+	// the cluster setting is ignored with sentinel
+	t.Setenv(v1alpha1.EnvISBSvcSentinelMaster, "mymaster")
+	t.Setenv(v1alpha1.EnvISBSvcRedisSentinelURL, "sentinel:26379")
+	client, err = NewInClusterRedisClient()
+	assert.NoError(t, err)
+	_, ok = client.Client.(*redis.Client)
+	assert.True(t, ok)
+}
+
+func TestNewInClusterRedisClient_TLS(t *testing.T) {
+	certPEM, keyPEM := generateTestCert(t)
+	t.Setenv(v1alpha1.EnvISBSvcRedisURL, ":6379")
+	t.Setenv(v1alpha1.EnvISBSvcRedisTLSEnabled, "true")
+	t.Setenv(v1alpha1.EnvISBSvcRedisTLSCACert, certPEM)
+	t.Setenv(v1alpha1.EnvISBSvcRedisTLSCert, certPEM)
+	t.Setenv(v1alpha1.EnvISBSvcRedisTLSKey, keyPEM)
+
+	c, err := inClusterTLSConfig()
+	assert.NoError(t, err)
+	assert.NotNil(t, c.RootCAs)
+	assert.Len(t, c.Certificates, 1)
+	assert.False(t, c.InsecureSkipVerify)
+	client, err := NewInClusterRedisClient()
+	assert.NoError(t, err)
+	assert.NotNil(t, client.Client.(*redis.Client).Options().TLSConfig)
+
+	t.Setenv(v1alpha1.EnvISBSvcRedisTLSCACert, "invalid")
+	_, err = NewInClusterRedisClient()
+	assert.ErrorContains(t, err, "failed to parse the CA cert")
+
+	t.Setenv(v1alpha1.EnvISBSvcRedisTLSCACert, "")
+	t.Setenv(v1alpha1.EnvISBSvcRedisTLSKey, "")
+	_, err = NewInClusterRedisClient()
+	assert.ErrorContains(t, err, "failed to load the client cert key pair")
+
+	t.Setenv(v1alpha1.EnvISBSvcRedisTLSCert, "")
+	t.Setenv(v1alpha1.EnvISBSvcRedisTLSInsecureSkipVerify, "true")
+	c, err = inClusterTLSConfig()
+	assert.NoError(t, err)
+	assert.Nil(t, c.RootCAs)
+	assert.Empty(t, c.Certificates)
+	assert.True(t, c.InsecureSkipVerify)
+}
+
+// generateTestCert returns a PEM encoded self-signed cert and its key.
+func generateTestCert(t *testing.T) (string, string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "redis"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	assert.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}))
+}
+
 func TestErrorHelpers(t *testing.T) {
 	errExist := fmt.Errorf("BUSYGROUP Consumer Group name already exists")
 	assert.True(t, IsAlreadyExistError(errExist))
@@ -158,6 +244,16 @@ func (m *MockRedisClient) XPending(ctx context.Context, stream, group string) *r
 	return cmd
 }
 
+func (m *MockRedisClient) XRangeN(ctx context.Context, stream, start, stop string, count int64) *redis.XMessageSliceCmd {
+	args := m.Called(ctx, stream, start, stop, count)
+	cmd := redis.NewXMessageSliceCmd(ctx)
+	if messages, ok := args.Get(1).([]redis.XMessage); ok {
+		cmd.SetVal(messages)
+	}
+	cmd.SetErr(args.Error(0))
+	return cmd
+}
+
 // Test suite for RedisClient
 type RedisClientTestSuite struct {
 	suite.Suite
@@ -344,6 +440,65 @@ func (suite *RedisClientTestSuite) TestIsStreamGroupExists_False_By_Empty() {
 	suite.mock.AssertCalled(suite.T(), "XInfoGroups", mock.Anything, "mystream")
 }
 
+func (suite *RedisClientTestSuite) TestStreamGroupPending() {
+	suite.mock.On("XInfoStream", mock.Anything, "mystream").Return(nil, &redis.XInfoStream{Length: 10, LastGeneratedID: "10-0"})
+	suite.mock.On("XInfoGroups", mock.Anything, "mystream").Return(nil, []redis.XInfoGroup{
+		{Name: "group1", Pending: 2, LastDeliveredID: "10-0"},
+		{Name: "group2", Pending: 3, LastDeliveredID: "5-0", Lag: 5},
+		{Name: "group3", Pending: 3, LastDeliveredID: "5-0"},
+	})
+	suite.mock.On("XRangeN", mock.Anything, "mystream", "(5-0", "+", int64(countEntriesPageSize)).Return(nil, []redis.XMessage{{ID: "9-0"}, {ID: "10-0"}})
+
+	for group, expected := range map[string][2]int64{
+		"group1": {0, 2},
+		"group2": {5, 3},
+		// the lag is not available, the entries after the last delivered one are counted
+		"group3": {2, 3},
+	} {
+		pending, ackPending, err := suite.client.StreamGroupPending(context.Background(), "mystream", group)
+		suite.NoError(err)
+		suite.Equal(expected[0], pending, group)
+		suite.Equal(expected[1], ackPending, group)
+	}
+
+	_, _, err := suite.client.StreamGroupPending(context.Background(), "mystream", "unknown")
+	suite.ErrorContains(err, "not found")
+}
+
+func (suite *RedisClientTestSuite) TestStreamGroupPending_CountEntries() {
+	suite.mock.On("XInfoStream", mock.Anything, "mystream").Return(nil, &redis.XInfoStream{Length: 1500, LastGeneratedID: "2000-0"})
+	suite.mock.On("XInfoGroups", mock.Anything, "mystream").Return(nil, []redis.XInfoGroup{
+		{Name: "mygroup", Pending: 10, LastDeliveredID: "100-0"},
+	})
+	page := make([]redis.XMessage, countEntriesPageSize)
+	for i := range page {
+		page[i] = redis.XMessage{ID: fmt.Sprintf("%d-0", 101+i)}
+	}
+	// the entries are ranged in pages, each one starts after the last entry of the previous page
+	suite.mock.On("XRangeN", mock.Anything, "mystream", "(100-0", "+", int64(countEntriesPageSize)).Return(nil, page)
+	suite.mock.On("XRangeN", mock.Anything, "mystream", fmt.Sprintf("(%d-0", 100+countEntriesPageSize), "+", int64(countEntriesPageSize)).Return(nil, []redis.XMessage{{ID: "1999-0"}, {ID: "2000-0"}})
+
+	pending, ackPending, err := suite.client.StreamGroupPending(context.Background(), "mystream", "mygroup")
+	suite.NoError(err)
+	suite.Equal(int64(countEntriesPageSize+2), pending)
+	suite.Equal(int64(10), ackPending)
+
+	suite.mock.On("XRangeN", mock.Anything, "otherstream", mock.Anything, mock.Anything, mock.Anything).Return(errors.New("error"), nil)
+	suite.mock.On("XInfoStream", mock.Anything, "otherstream").Return(nil, &redis.XInfoStream{LastGeneratedID: "2000-0"})
+	suite.mock.On("XInfoGroups", mock.Anything, "otherstream").Return(nil, []redis.XInfoGroup{{Name: "mygroup", LastDeliveredID: "100-0"}})
+	_, _, err = suite.client.StreamGroupPending(context.Background(), "otherstream", "mygroup")
+	suite.ErrorContains(err, "failed to count the undelivered entries")
+}
+
+func (suite *RedisClientTestSuite) TestStreamGroupPending_Error() {
+	suite.mock.On("XInfoStream", mock.Anything, "mystream").Return(errors.New("error"), nil)
+
+	_, _, err := suite.client.StreamGroupPending(context.Background(), "mystream", "mygroup")
+
+	suite.Error(err)
+	suite.mock.AssertNotCalled(suite.T(), "XInfoGroups", mock.Anything, "mystream")
+}
+
 // Run the test suite
 func TestRedisClientTestSuite(t *testing.T) {
 	suite.Run(t, new(RedisClientTestSuite))
@@ -0,0 +1,139 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package s3 provides the helpers to access the objects of AWS S3 compatible object stores, shared by the file sink
+// and the claim check of the inter-step buffers.
+package s3
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/url"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/smithy-go"
+)
+
+// NewClient returns an S3 client for the given region. Credentials are resolved with the default AWS credential
+// chain, e.g. environment variables or the IRSA web identity token. If endpointURL is not empty, it is used instead
+// of the AWS endpoint with path-style addressing, which is useful for S3-compatible stores such as MinIO.
+func NewClient(ctx context.Context, region, endpointURL string) (*s3.Client, error) {
+	cfg, err := awsconfig.LoadDefaultConfig(ctx, awsconfig.WithRegion(region))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load aws config, %w", err)
+	}
+	return s3.NewFromConfig(cfg, func(o *s3.Options) {
+		if endpointURL != "" {
+			o.BaseEndpoint = aws.String(endpointURL)
+			o.UsePathStyle = true
+		}
+		// the S3-compatible stores don't necessarily support the checksums of the newer SDKs
+		o.RequestChecksumCalculation = aws.RequestChecksumCalculationWhenRequired
+		o.ResponseChecksumValidation = aws.ResponseChecksumValidationWhenRequired
+	}), nil
+}
+
+// Bucket reads and writes the objects of a bucket, with the keys optionally under a prefix.
+type Bucket struct {
+	client *s3.Client
+	name   string
+	prefix string
+}
+
+// NewBucket returns a Bucket with the given client, the leading and trailing slashes of the prefix are ignored.
+func NewBucket(client *s3.Client, name, prefix string) *Bucket {
+	return &Bucket{client: client, name: name, prefix: strings.Trim(prefix, "/")}
+}
+
+func (b *Bucket) objectKey(key string) *string {
+	if b.prefix != "" {
+		key = b.prefix + "/" + key
+	}
+	return aws.String(key)
+}
+
+// Put uploads an object, which is only visible once the upload succeeds.
+func (b *Bucket) Put(ctx context.Context, key string, data []byte) error {
+	if _, err := b.client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:        aws.String(b.name),
+		Key:           b.objectKey(key),
+		Body:          bytes.NewReader(data),
+		ContentLength: aws.Int64(int64(len(data))),
+	}); err != nil {
+		return fmt.Errorf("failed to put object %q, %w", key, err)
+	}
+	return nil
+}
+
+// Copy copies an object to another key in the bucket, the object is not transferred.
+func (b *Bucket) Copy(ctx context.Context, srcKey, dstKey string) error {
+	source := url.URL{Path: b.name + "/" + *b.objectKey(srcKey)}
+	if _, err := b.client.CopyObject(ctx, &s3.CopyObjectInput{
+		Bucket:     aws.String(b.name),
+		Key:        b.objectKey(dstKey),
+		CopySource: aws.String(source.EscapedPath()),
+	}); err != nil {
+		return fmt.Errorf("failed to copy object %q, %w", srcKey, notFound(err))
+	}
+	return nil
+}
+
+// Get downloads an object, the error wraps fs.ErrNotExist if the object does not exist.
+func (b *Bucket) Get(ctx context.Context, key string) ([]byte, error) {
+	output, err := b.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(b.name),
+		Key:    b.objectKey(key),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get object %q, %w", key, notFound(err))
+	}
+	defer func() { _ = output.Body.Close() }()
+	data, err := io.ReadAll(output.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read object %q, %w", key, err)
+	}
+	return data, nil
+}
+
+// Delete deletes an object, it does not fail if the object does not exist.
+func (b *Bucket) Delete(ctx context.Context, key string) error {
+	if _, err := b.client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(b.name),
+		Key:    b.objectKey(key),
+	}); err != nil {
+		if err = notFound(err); errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		return fmt.Errorf("failed to delete object %q, %w", key, err)
+	}
+	return nil
+}
+
+// notFound returns fs.ErrNotExist along with the error if the object does not exist.
+func notFound(err error) error {
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) && (apiErr.ErrorCode() == "NoSuchKey" || apiErr.ErrorCode() == "NotFound") {
+		return errors.Join(fs.ErrNotExist, err)
+	}
+	return err
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3
+
+import (
+	"context"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBucket_CustomEndpoint(t *testing.T) {
+	t.Setenv("AWS_ACCESS_KEY_ID", "test-key")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "test-secret")
+	objects := make(map[string]string)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=test-key/") {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		p := r.URL.Path
+		switch r.Method {
+		case http.MethodPut:
+			if source := r.Header.Get("X-Amz-Copy-Source"); source != "" {
+				source, _ = url.PathUnescape(source)
+				v, ok := objects["/"+source]
+				if !ok {
+					w.WriteHeader(http.StatusNotFound)
+					_, _ = w.Write([]byte(`<Error><Code>NoSuchKey</Code></Error>`))
+					return
+				}
+				objects[p] = v
+				_, _ = w.Write([]byte(`<CopyObjectResult></CopyObjectResult>`))
+				return
+			}
+			b, _ := io.ReadAll(r.Body)
+			objects[p] = string(b)
+		case http.MethodGet:
+			v, ok := objects[p]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(`<Error><Code>NoSuchKey</Code></Error>`))
+				return
+			}
+			_, _ = w.Write([]byte(v))
+		case http.MethodDelete:
+			delete(objects, p)
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	client, err := NewClient(ctx, "us-west-2", server.URL)
+	require.NoError(t, err)
+	bucket := NewBucket(client, "my-bucket", "/payloads/")
+	require.NoError(t, bucket.Put(ctx, "keys=a:b/part-1.jsonl", []byte("data")))
+	assert.Equal(t, map[string]string{"/my-bucket/payloads/keys=a:b/part-1.jsonl": "data"}, objects)
+	data, err := bucket.Get(ctx, "keys=a:b/part-1.jsonl")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("data"), data)
+	require.NoError(t, bucket.Copy(ctx, "keys=a:b/part-1.jsonl", "copy"))
+	assert.Equal(t, "data", objects["/my-bucket/payloads/copy"])
+	require.NoError(t, bucket.Delete(ctx, "copy"))
+	require.NoError(t, bucket.Delete(ctx, "keys=a:b/part-1.jsonl"))
+	assert.Empty(t, objects)
+	_, err = bucket.Get(ctx, "keys=a:b/part-1.jsonl")
+	assert.ErrorIs(t, err, fs.ErrNotExist)
+	err = bucket.Copy(ctx, "keys=a:b/part-1.jsonl", "copy")
+	assert.ErrorIs(t, err, fs.ErrNotExist)
+}
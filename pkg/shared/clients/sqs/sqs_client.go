@@ -0,0 +1,63 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package sqs provides the helpers to create AWS SQS clients shared by the SQS source and sink.
+package sqs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+)
+
+// NewClient returns an SQS client for the given region. Credentials are resolved with the default AWS credential
+// chain, e.g. environment variables or the IRSA web identity token. If endpointURL is not empty, it is used instead
+// of the AWS endpoint, which is useful for VPC endpoints and SQS-compatible emulators such as localstack.
+func NewClient(ctx context.Context, region string, endpointURL *string) (*sqs.Client, error) {
+	cfg, err := awsconfig.LoadDefaultConfig(ctx, awsconfig.WithRegion(region))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load aws config, %w", err)
+	}
+	return sqs.NewFromConfig(cfg, func(o *sqs.Options) {
+		if endpointURL != nil && *endpointURL != "" {
+			o.BaseEndpoint = aws.String(*endpointURL)
+		}
+	}), nil
+}
+
+// QueueURLGetter is the part of the SQS client used to resolve queue URLs.
+type QueueURLGetter interface {
+	GetQueueUrl(ctx context.Context, params *sqs.GetQueueUrlInput, optFns ...func(*sqs.Options)) (*sqs.GetQueueUrlOutput, error)
+}
+
+// GetQueueURL resolves the URL of a queue from its name and optionally the account id of the queue owner.
+func GetQueueURL(ctx context.Context, client QueueURLGetter, queueName, ownerAccountID string) (string, error) {
+	input := &sqs.GetQueueUrlInput{QueueName: aws.String(queueName)}
+	if ownerAccountID != "" {
+		input.QueueOwnerAWSAccountId = aws.String(ownerAccountID)
+	}
+	output, err := client.GetQueueUrl(ctx, input)
+	if err != nil {
+		return "", fmt.Errorf("failed to get the url of sqs queue %q, %w", queueName, err)
+	}
+	if output.QueueUrl == nil {
+		return "", fmt.Errorf("empty url returned for sqs queue %q", queueName)
+	}
+	return *output.QueueUrl, nil
+}
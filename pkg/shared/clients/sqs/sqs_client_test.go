@@ -0,0 +1,50 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqs
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetQueueURL_CustomEndpoint(t *testing.T) {
+	t.Setenv("AWS_ACCESS_KEY_ID", "test")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "test")
+
+	var got map[string]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "AmazonSQS.GetQueueUrl", r.Header.Get("X-Amz-Target"))
+		_ = json.NewDecoder(r.Body).Decode(&got)
+		w.Header().Set("Content-Type", "application/x-amz-json-1.0")
+		_, _ = w.Write([]byte(`{"QueueUrl": "http://localhost:4566/123456789012/test-queue"}`))
+	}))
+	defer server.Close()
+
+	endpoint := server.URL
+	client, err := NewClient(context.Background(), "us-west-2", &endpoint)
+	require.NoError(t, err)
+	url, err := GetQueueURL(context.Background(), client, "test-queue", "123456789012")
+	require.NoError(t, err)
+	assert.Equal(t, "http://localhost:4566/123456789012/test-queue", url)
+	assert.Equal(t, map[string]string{"QueueName": "test-queue", "QueueOwnerAWSAccountId": "123456789012"}, got)
+}
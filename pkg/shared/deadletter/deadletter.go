@@ -0,0 +1,119 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package deadletter builds the messages written to, and replayed from, the dead-letter buffers.
+package deadletter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+)
+
+var metaKeys = []string{
+	dfv1.KeyMetaDeadLetterError,
+	dfv1.KeyMetaDeadLetterVertex,
+	dfv1.KeyMetaDeadLetterPartition,
+	dfv1.KeyMetaDeadLetterRetries,
+	dfv1.KeyMetaDeadLetterTimestamp,
+}
+
+// NewMessage returns a copy of the original message with the dead-letter metadata added to the headers.
+// partitionIdx is the index of the buffer partition the original message was read from.
+func NewMessage(msg isb.Message, vertex string, partitionIdx int32, retries int, err error) isb.Message {
+	headers := make(map[string]string, len(msg.Headers)+len(metaKeys))
+	for k, v := range msg.Headers {
+		headers[k] = v
+	}
+	errMsg := "unknown error"
+	if err != nil {
+		errMsg = err.Error()
+	}
+	headers[dfv1.KeyMetaDeadLetterError] = errMsg
+	headers[dfv1.KeyMetaDeadLetterVertex] = vertex
+	headers[dfv1.KeyMetaDeadLetterPartition] = strconv.Itoa(int(partitionIdx))
+	headers[dfv1.KeyMetaDeadLetterRetries] = strconv.Itoa(retries)
+	headers[dfv1.KeyMetaDeadLetterTimestamp] = strconv.FormatInt(time.Now().UnixMilli(), 10)
+	msg.Headers = headers
+	return msg
+}
+
+// Partition returns the index of the buffer partition a dead-lettered message was read from, it defaults to 0.
+func Partition(msg isb.Message) int {
+	if p, err := strconv.Atoi(msg.Headers[dfv1.KeyMetaDeadLetterPartition]); err == nil && p >= 0 {
+		return p
+	}
+	return 0
+}
+
+// ToReplayMessage returns a copy of a dead-lettered message without the dead-letter metadata. The message ID is
+// suffixed with the offset in the dead-letter buffer, so that the replayed message is not treated as a duplicate
+// by the buffer it is replayed to, nor by the dead-letter buffer if it fails again.
+func ToReplayMessage(msg isb.Message, offset string) isb.Message {
+	headers := make(map[string]string, len(msg.Headers))
+	for k, v := range msg.Headers {
+		headers[k] = v
+	}
+	for _, k := range metaKeys {
+		delete(headers, k)
+	}
+	msg.Headers = headers
+	msg.ID.Offset = msg.ID.Offset + "-replay-" + offset
+	return msg
+}
+
+// Write writes the messages to the dead-letter buffer, the failed ones are retried with the interval until they are
+// written, the context is canceled, or shouldStop returns true. The original messages are acked once they are
+// dead-lettered, so a full dead-letter buffer is retried, which applies backpressure to the vertex, rather than
+// dropping the messages. The messages which already exist in the buffer are considered written.
+func Write(ctx context.Context, writer isb.BufferWriter, messages []isb.Message, retryInterval time.Duration, shouldStop func() bool) error {
+	for {
+		var failedMessages []isb.Message
+		var lastErr error
+		_, errs := writer.Write(ctx, messages)
+		for idx, err := range errs {
+			if err == nil {
+				continue
+			}
+			var nonRetryable isb.NonRetryableBufferWriteErr
+			if errors.As(err, &nonRetryable) {
+				if nonRetryable.Message == isb.DuplicateIDMessage {
+					continue
+				}
+				return fmt.Errorf("failed to write to the dead-letter buffer %q, %w", writer.GetName(), err)
+			}
+			failedMessages = append(failedMessages, messages[idx])
+			lastErr = err
+		}
+		if len(failedMessages) == 0 {
+			return nil
+		}
+		if shouldStop() {
+			return fmt.Errorf("stopped with %d messages not written to the dead-letter buffer %q, %w", len(failedMessages), writer.GetName(), lastErr)
+		}
+		messages = failedMessages
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(retryInterval):
+		}
+	}
+}
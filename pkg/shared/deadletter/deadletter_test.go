@@ -0,0 +1,121 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deadletter
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/isb/stores/simplebuffer"
+)
+
+func TestNewMessage(t *testing.T) {
+	msg := isb.Message{
+		Header: isb.Header{
+			ID:      isb.MessageID{VertexName: "in", Offset: "1", Index: 0},
+			Keys:    []string{"k"},
+			Headers: map[string]string{"h": "v"},
+		},
+		Body: isb.Body{Payload: []byte("data")},
+	}
+	dl := NewMessage(msg, "map", 2, 3, fmt.Errorf("boom"))
+	assert.Equal(t, "boom", dl.Headers[dfv1.KeyMetaDeadLetterError])
+	assert.Equal(t, "map", dl.Headers[dfv1.KeyMetaDeadLetterVertex])
+	assert.Equal(t, "3", dl.Headers[dfv1.KeyMetaDeadLetterRetries])
+	assert.NotEmpty(t, dl.Headers[dfv1.KeyMetaDeadLetterTimestamp])
+	assert.Equal(t, "v", dl.Headers["h"])
+	assert.Equal(t, 2, Partition(dl))
+	// the original message is not modified
+	assert.Len(t, msg.Headers, 1)
+
+	replay := ToReplayMessage(dl, "10")
+	assert.Equal(t, map[string]string{"h": "v"}, replay.Headers)
+	assert.Equal(t, "1-replay-10", replay.ID.Offset)
+	assert.Equal(t, []byte("data"), replay.Payload)
+	assert.Equal(t, 0, Partition(replay))
+}
+
+// failingWriter fails the writes with the given error for the first failures times.
+type failingWriter struct {
+	*simplebuffer.InMemoryBuffer
+	err      error
+	failures int
+}
+
+func (w *failingWriter) Write(ctx context.Context, messages []isb.Message) ([]isb.Offset, []error) {
+	if w.failures > 0 {
+		w.failures--
+		errs := make([]error, len(messages))
+		for i := range errs {
+			errs[i] = w.err
+		}
+		return make([]isb.Offset, len(messages)), errs
+	}
+	return w.InMemoryBuffer.Write(ctx, messages)
+}
+
+func TestWrite(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	messages := []isb.Message{{Header: isb.Header{ID: isb.MessageID{VertexName: "in", Offset: "1"}}}}
+	never := func() bool { return false }
+
+	t.Run("full buffer is retried", func(t *testing.T) {
+		w := &failingWriter{
+			InMemoryBuffer: simplebuffer.NewInMemoryBuffer("dlq", 10, 0),
+			err:            isb.BufferWriteErr{Name: "dlq", Full: true, Message: isb.BufferFullMessage},
+			failures:       3,
+		}
+		assert.NoError(t, Write(ctx, w, messages, time.Millisecond, never))
+		assert.Equal(t, 0, w.failures)
+		assert.False(t, w.IsEmpty())
+	})
+
+	t.Run("duplicates are written", func(t *testing.T) {
+		w := &failingWriter{
+			InMemoryBuffer: simplebuffer.NewInMemoryBuffer("dlq", 10, 0),
+			err:            isb.NonRetryableBufferWriteErr{Name: "dlq", Message: isb.DuplicateIDMessage},
+			failures:       1,
+		}
+		assert.NoError(t, Write(ctx, w, messages, time.Millisecond, never))
+		assert.True(t, w.IsEmpty())
+	})
+
+	t.Run("non-retryable errors fail", func(t *testing.T) {
+		w := &failingWriter{
+			InMemoryBuffer: simplebuffer.NewInMemoryBuffer("dlq", 10, 0),
+			err:            isb.NonRetryableBufferWriteErr{Name: "dlq", Message: isb.BufferFullMessage},
+			failures:       1,
+		}
+		assert.ErrorContains(t, Write(ctx, w, messages, time.Millisecond, never), isb.BufferFullMessage)
+	})
+
+	t.Run("stopped", func(t *testing.T) {
+		w := &failingWriter{
+			InMemoryBuffer: simplebuffer.NewInMemoryBuffer("dlq", 10, 0),
+			err:            isb.BufferWriteErr{Name: "dlq", Full: true, Message: isb.BufferFullMessage},
+			failures:       100,
+		}
+		assert.ErrorContains(t, Write(ctx, w, messages, time.Millisecond, func() bool { return true }), "stopped with 1 messages")
+	})
+}
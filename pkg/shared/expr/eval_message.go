@@ -0,0 +1,83 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package expr
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/antonmedv/expr"
+	"github.com/antonmedv/expr/vm"
+)
+
+const (
+	keysVar    = "keys"
+	headersVar = "headers"
+)
+
+// programs caches the compiled message expressions, keyed by the expression.
+var programs sync.Map
+
+// CompileMessageBool compiles a boolean expression which is evaluated against the payload, keys and headers of
+// a message, the compiled program is cached so that an expression is only compiled once.
+func CompileMessageBool(expression string) (*vm.Program, error) {
+	if p, ok := programs.Load(expression); ok {
+		return p.(*vm.Program), nil
+	}
+	p, err := expr.Compile(expression, expr.Env(messageEnv("", nil, nil)))
+	if err != nil {
+		return nil, fmt.Errorf("unable to compile expression '%s': %w", expression, err)
+	}
+	programs.Store(expression, p)
+	return p, nil
+}
+
+// EvalMessageBool evaluates a boolean expression against the payload, keys and headers of a message.
+// The expression can access them with the variables "payload", "keys" and "headers".
+func EvalMessageBool(expression string, payload []byte, keys []string, headers map[string]string) (bool, error) {
+	p, err := CompileMessageBool(expression)
+	if err != nil {
+		return false, err
+	}
+	result, err := expr.Run(p, messageEnv(string(payload), keys, headers))
+	if err != nil {
+		return false, fmt.Errorf("unable to evaluate expression '%s': %s", expression, err)
+	}
+	resultBool, ok := result.(bool)
+	if !ok {
+		return false, fmt.Errorf("unable to cast expression result '%s' to bool", result)
+	}
+	return resultBool, nil
+}
+
+func messageEnv(payload string, keys []string, headers map[string]string) map[string]interface{} {
+	if keys == nil {
+		keys = []string{}
+	}
+	if headers == nil {
+		headers = map[string]string{}
+	}
+	return map[string]interface{}{
+		root:       payload,
+		keysVar:    keys,
+		headersVar: headers,
+		"sprig":    sprigFuncMap,
+		"json":     _json,
+		"int":      _int,
+		"string":   _string,
+	}
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package expr
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEvalMessageBool(t *testing.T) {
+	payload := []byte(`{"name": "numaflow", "amount": 120}`)
+	keys := []string{"k1", "k2"}
+	headers := map[string]string{"x-region": "us"}
+
+	tests := []struct {
+		name       string
+		expression string
+		want       bool
+		wantErr    bool
+	}{
+		{name: "payload", expression: `json(payload).name == "numaflow"`, want: true},
+		{name: "payload int", expression: `int(json(payload).amount) > 200`, want: false},
+		{name: "keys", expression: `"k2" in keys`, want: true},
+		{name: "headers", expression: `headers["x-region"] == "us"`, want: true},
+		{name: "missing header", expression: `headers["x-zone"] == "a"`, want: false},
+		{name: "sprig", expression: `sprig.contains("numa", payload)`, want: true},
+		{name: "combined", expression: `len(keys) == 2 && headers["x-region"] == "eu"`, want: false},
+		{name: "not a bool", expression: `payload`, wantErr: true},
+		{name: "invalid payload", expression: `json(keys[0]).a == 1`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := EvalMessageBool(tt.expression, payload, keys, headers)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestEvalMessageBool_NilKeysAndHeaders(t *testing.T) {
+	got, err := EvalMessageBool(`len(keys) == 0 && headers["a"] == ""`, []byte("data"), nil, nil)
+	assert.NoError(t, err)
+	assert.True(t, got)
+}
+
+func TestCompileMessageBool(t *testing.T) {
+	p1, err := CompileMessageBool(`payload == "a"`)
+	assert.NoError(t, err)
+	p2, err := CompileMessageBool(`payload == "a"`)
+	assert.NoError(t, err)
+	// the compiled program is cached
+	assert.Same(t, p1, p2)
+
+	_, err = CompileMessageBool(`payload ==`)
+	assert.Error(t, err)
+	_, err = CompileMessageBool(`unknown == "a"`)
+	assert.Error(t, err)
+}
@@ -0,0 +1,277 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+/*
+Package disk implements the KV store with a directory on the volume of the disk ISB Service, each key is a file
+in the directory of the bucket. Values are written to temporary files and renamed, so that they are never read
+partially, and the watchers poll the directory for changes.
+*/
+package disk
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/numaproj/numaflow/pkg/shared/kvs"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+)
+
+const bucketsDir = "buckets"
+
+// kvEntry is each key-value entry in the store and the operation associated with the kv pair.
+type kvEntry struct {
+	key   string
+	value []byte
+	op    kvs.KVWatchOp
+}
+
+// Key returns the key
+func (k kvEntry) Key() string {
+	return k.key
+}
+
+// Value returns the value.
+func (k kvEntry) Value() []byte {
+	return k.value
+}
+
+// Operation returns the operation on that key-value pair.
+func (k kvEntry) Operation() kvs.KVWatchOp {
+	return k.op
+}
+
+// diskStore implements the KV store backed up by a directory.
+type diskStore struct {
+	bucketName string
+	dir        string
+	opts       *options
+	doneCh     chan struct{}
+	closeOnce  sync.Once
+	log        *zap.SugaredLogger
+}
+
+var _ kvs.KVStorer = (*diskStore)(nil)
+
+// BucketDir returns the directory of the bucket under the mount path of the disk ISB Service volume.
+func BucketDir(rootDir, bucketName string) string {
+	return filepath.Join(rootDir, bucketsDir, bucketName)
+}
+
+// CreateBucket creates the directory of the bucket if it does not exist yet.
+func CreateBucket(rootDir, bucketName string) error {
+	return os.MkdirAll(BucketDir(rootDir, bucketName), 0755)
+}
+
+// DeleteBucket deletes the bucket and all of its keys.
+func DeleteBucket(rootDir, bucketName string) error {
+	return os.RemoveAll(BucketDir(rootDir, bucketName))
+}
+
+// ValidateBucket checks if the bucket exists.
+func ValidateBucket(rootDir, bucketName string) error {
+	if _, err := os.Stat(BucketDir(rootDir, bucketName)); err != nil {
+		return fmt.Errorf("failed to query bucket %q, %w", bucketName, err)
+	}
+	return nil
+}
+
+// NewKVDiskKVStore returns a KV store of an existing bucket, rootDir is the mount path of the disk ISB Service volume.
+func NewKVDiskKVStore(ctx context.Context, rootDir, bucketName string, opts ...Option) (kvs.KVStorer, error) {
+	if err := ValidateBucket(rootDir, bucketName); err != nil {
+		return nil, err
+	}
+	o := defaultOptions()
+	for _, opt := range opts {
+		opt(o)
+	}
+	return &diskStore{
+		bucketName: bucketName,
+		dir:        BucketDir(rootDir, bucketName),
+		opts:       o,
+		doneCh:     make(chan struct{}),
+		log:        logging.FromContext(ctx).With("bucketName", bucketName),
+	}, nil
+}
+
+// fileName escapes the key to be a file name, file names starting with "." are reserved for the temporary files.
+func fileName(key string) string {
+	name := url.PathEscape(key)
+	if strings.HasPrefix(name, ".") {
+		name = "%2E" + name[1:]
+	}
+	return name
+}
+
+// GetAllKeys returns all the keys in the key-value store.
+func (ds *diskStore) GetAllKeys(_ context.Context) ([]string, error) {
+	values, err := ds.readAll()
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
+
+// GetValue returns the value for a given key.
+func (ds *diskStore) GetValue(_ context.Context, k string) ([]byte, error) {
+	value, err := os.ReadFile(filepath.Join(ds.dir, fileName(k)))
+	if errors.Is(err, os.ErrNotExist) {
+		return []byte(""), fmt.Errorf("key %s not found", k)
+	}
+	return value, err
+}
+
+// GetStoreName returns the store name.
+func (ds *diskStore) GetStoreName() string {
+	return ds.bucketName
+}
+
+// DeleteKey deletes the key from the key-value store.
+func (ds *diskStore) DeleteKey(_ context.Context, k string) error {
+	err := os.Remove(filepath.Join(ds.dir, fileName(k)))
+	if errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("key %s not found", k)
+	}
+	return err
+}
+
+// PutKV writes the value to a temporary file and renames it to the file of the key.
+func (ds *diskStore) PutKV(_ context.Context, k string, v []byte) error {
+	select {
+	case <-ds.doneCh:
+		return fmt.Errorf("kv store is closed")
+	default:
+	}
+	fp, err := os.CreateTemp(ds.dir, ".tmp-")
+	if err != nil {
+		return fmt.Errorf("failed to create a temporary file, %w", err)
+	}
+	if _, err := fp.Write(v); err != nil {
+		_ = fp.Close()
+		_ = os.Remove(fp.Name())
+		return fmt.Errorf("failed to write the value of key %s, %w", k, err)
+	}
+	if err := fp.Close(); err != nil {
+		_ = os.Remove(fp.Name())
+		return err
+	}
+	if err := os.Rename(fp.Name(), filepath.Join(ds.dir, fileName(k))); err != nil {
+		_ = os.Remove(fp.Name())
+		return fmt.Errorf("failed to put the value of key %s, %w", k, err)
+	}
+	return nil
+}
+
+// readAll reads all the key-value pairs in the store.
+func (ds *diskStore) readAll() (map[string][]byte, error) {
+	entries, err := os.ReadDir(ds.dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read bucket %q, %w", ds.bucketName, err)
+	}
+	result := make(map[string][]byte, len(entries))
+	for _, e := range entries {
+		if e.IsDir() || strings.HasPrefix(e.Name(), ".") {
+			continue
+		}
+		key, err := url.PathUnescape(e.Name())
+		if err != nil {
+			continue
+		}
+		value, err := os.ReadFile(filepath.Join(ds.dir, e.Name()))
+		if err != nil {
+			// deleted after listing
+			continue
+		}
+		result[key] = value
+	}
+	return result, nil
+}
+
+// Watch polls the key-value store and returns the updates channel, the existing key-value pairs come first.
+// The channel is closed when the context is done or the store is closed.
+func (ds *diskStore) Watch(ctx context.Context) <-chan kvs.KVEntry {
+	var updates = make(chan kvs.KVEntry)
+	go func() {
+		defer close(updates)
+		ticker := time.NewTicker(ds.opts.pollInterval)
+		defer ticker.Stop()
+		known := make(map[string][]byte)
+		for {
+			current, err := ds.readAll()
+			if err != nil {
+				ds.log.Errorw("Failed to read the bucket", zap.Error(err))
+			} else {
+				for _, e := range diff(known, current) {
+					select {
+					case <-ctx.Done():
+						return
+					case <-ds.doneCh:
+						return
+					case updates <- e:
+					}
+				}
+				known = current
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-ds.doneCh:
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	return updates
+}
+
+// diff returns the changes from the previous key-value pairs to the current ones, sorted by keys.
+func diff(previous, current map[string][]byte) []kvs.KVEntry {
+	var result []kvs.KVEntry
+	for k, v := range current {
+		if old, ok := previous[k]; !ok || !bytes.Equal(old, v) {
+			result = append(result, kvEntry{key: k, value: v, op: kvs.KVPut})
+		}
+	}
+	for k, v := range previous {
+		if _, ok := current[k]; !ok {
+			result = append(result, kvEntry{key: k, value: v, op: kvs.KVDelete})
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Key() < result[j].Key() })
+	return result
+}
+
+// Close stops all the watchers.
+func (ds *diskStore) Close() {
+	ds.closeOnce.Do(func() {
+		close(ds.doneCh)
+	})
+}
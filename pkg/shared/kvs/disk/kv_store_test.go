@@ -0,0 +1,82 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package disk
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/numaproj/numaflow/pkg/shared/kvs"
+)
+
+func TestDiskStore(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	dir := t.TempDir()
+
+	_, err := NewKVDiskKVStore(ctx, dir, "test-bucket")
+	assert.Error(t, err)
+	require.NoError(t, CreateBucket(dir, "test-bucket"))
+	require.NoError(t, ValidateBucket(dir, "test-bucket"))
+	store, err := NewKVDiskKVStore(ctx, dir, "test-bucket", WithPollInterval(10*time.Millisecond))
+	require.NoError(t, err)
+	defer store.Close()
+	assert.Equal(t, "test-bucket", store.GetStoreName())
+
+	require.NoError(t, store.PutKV(ctx, "p-0", []byte("100")))
+	require.NoError(t, store.PutKV(ctx, ".hidden/key", []byte("200")))
+	keys, err := store.GetAllKeys(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{".hidden/key", "p-0"}, keys)
+	value, err := store.GetValue(ctx, ".hidden/key")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("200"), value)
+	_, err = store.GetValue(ctx, "p-1")
+	assert.Error(t, err)
+
+	// the existing key-value pairs come first
+	watchCh := store.Watch(ctx)
+	e := <-watchCh
+	assert.Equal(t, ".hidden/key", e.Key())
+	e = <-watchCh
+	assert.Equal(t, "p-0", e.Key())
+	assert.Equal(t, kvs.KVPut, e.Operation())
+
+	require.NoError(t, store.PutKV(ctx, "p-0", []byte("101")))
+	e = <-watchCh
+	assert.Equal(t, "p-0", e.Key())
+	assert.Equal(t, []byte("101"), e.Value())
+	assert.Equal(t, kvs.KVPut, e.Operation())
+
+	require.NoError(t, store.DeleteKey(ctx, "p-0"))
+	assert.Error(t, store.DeleteKey(ctx, "p-0"))
+	e = <-watchCh
+	assert.Equal(t, "p-0", e.Key())
+	assert.Equal(t, kvs.KVDelete, e.Operation())
+
+	store.Close()
+	_, ok := <-watchCh
+	assert.False(t, ok)
+	assert.Error(t, store.PutKV(ctx, "p-0", []byte("102")))
+
+	require.NoError(t, DeleteBucket(dir, "test-bucket"))
+	assert.Error(t, ValidateBucket(dir, "test-bucket"))
+}
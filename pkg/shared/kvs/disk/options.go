@@ -0,0 +1,41 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package disk
+
+import "time"
+
+// options for KV watcher.
+type options struct {
+	// pollInterval is the interval to check the changes of the keys.
+	pollInterval time.Duration
+}
+
+func defaultOptions() *options {
+	return &options{
+		pollInterval: 100 * time.Millisecond,
+	}
+}
+
+// Option is a function on the options kv watcher
+type Option func(*options)
+
+// WithPollInterval sets the pollInterval
+func WithPollInterval(d time.Duration) Option {
+	return func(o *options) {
+		o.pollInterval = d
+	}
+}
@@ -49,6 +49,12 @@ func GetIsbSvcEnvVars(isbSvcConfig dfv1.BufferServiceConfig) (dfv1.ISBSvcType, [
 		if x.User != "" {
 			env = append(env, corev1.EnvVar{Name: dfv1.EnvISBSvcRedisUser, Value: x.User})
 		}
+		if x.SentinelUser != "" {
+			env = append(env, corev1.EnvVar{Name: dfv1.EnvISBSvcRedisSentinelUser, Value: x.SentinelUser})
+		}
+		if x.Cluster {
+			env = append(env, corev1.EnvVar{Name: dfv1.EnvISBSvcRedisCluster, Value: "true"})
+		}
 		if x.Password != nil {
 			env = append(env, corev1.EnvVar{
 				Name: dfv1.EnvISBSvcRedisPassword, ValueFrom: &corev1.EnvVarSource{
@@ -73,6 +79,19 @@ func GetIsbSvcEnvVars(isbSvcConfig dfv1.BufferServiceConfig) (dfv1.ISBSvcType, [
 				},
 			})
 		}
+		if x.TLS != nil {
+			env = append(env, corev1.EnvVar{Name: dfv1.EnvISBSvcRedisTLSEnabled, Value: "true"})
+			env = append(env, corev1.EnvVar{Name: dfv1.EnvISBSvcRedisTLSInsecureSkipVerify, Value: strconv.FormatBool(x.TLS.InsecureSkipVerify)})
+			if x.TLS.CACertSecret != nil {
+				env = append(env, corev1.EnvVar{Name: dfv1.EnvISBSvcRedisTLSCACert, ValueFrom: &corev1.EnvVarSource{SecretKeyRef: x.TLS.CACertSecret}})
+			}
+			if x.TLS.CertSecret != nil {
+				env = append(env, corev1.EnvVar{Name: dfv1.EnvISBSvcRedisTLSCert, ValueFrom: &corev1.EnvVarSource{SecretKeyRef: x.TLS.CertSecret}})
+			}
+			if x.TLS.KeySecret != nil {
+				env = append(env, corev1.EnvVar{Name: dfv1.EnvISBSvcRedisTLSKey, ValueFrom: &corev1.EnvVarSource{SecretKeyRef: x.TLS.KeySecret}})
+			}
+		}
 		isbSvcType = dfv1.ISBSvcTypeRedis
 	} else if x := isbSvcConfig.JetStream; x != nil {
 		env = append(env, corev1.EnvVar{Name: dfv1.EnvISBSvcJetStreamURL, Value: x.URL})
@@ -96,6 +115,32 @@ func GetIsbSvcEnvVars(isbSvcConfig dfv1.BufferServiceConfig) (dfv1.ISBSvcType, [
 			}})
 		}
 		isbSvcType = dfv1.ISBSvcTypeJetStream
+	} else if x := isbSvcConfig.Disk; x != nil {
+		env = append(env, corev1.EnvVar{Name: dfv1.EnvISBSvcDiskMaxSegmentBytes, Value: strconv.FormatInt(x.MaxSegmentBytes, 10)})
+		env = append(env, corev1.EnvVar{Name: dfv1.EnvISBSvcDiskSyncInterval, Value: x.SyncInterval.Duration.String()})
+		isbSvcType = dfv1.ISBSvcTypeDisk
 	}
 	return isbSvcType, env
 }
+
+// AddISBSvcDiskVolume attaches the volume of a disk ISB Service to the pod spec, and mounts it
+// to the numaflow containers. It does nothing if the ISB Service is not a disk one.
+func AddISBSvcDiskVolume(podSpec *corev1.PodSpec, isbSvcConfig dfv1.BufferServiceConfig) {
+	if isbSvcConfig.Disk == nil {
+		return
+	}
+	volName := "isbsvc-disk-vol"
+	podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{
+		Name:         volName,
+		VolumeSource: isbSvcConfig.Disk.GetVolumeSource(),
+	})
+	mount := corev1.VolumeMount{Name: volName, MountPath: dfv1.PathISBSvcDiskMount}
+	for _, ctrs := range [][]corev1.Container{podSpec.InitContainers, podSpec.Containers} {
+		for i := range ctrs {
+			switch ctrs[i].Name {
+			case dfv1.CtrInit, dfv1.CtrMain, dfv1.CtrInitSideInputs, dfv1.CtrSideInputsWatcher:
+				ctrs[i].VolumeMounts = append(ctrs[i].VolumeMounts, mount)
+			}
+		}
+	}
+}
@@ -18,10 +18,12 @@ package util
 
 import (
 	"testing"
+	"time"
 
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func TestGetRedisIsbSvcEnvVars(t *testing.T) {
@@ -74,6 +76,37 @@ func TestGetRedisIsbSvcEnvVars(t *testing.T) {
 	assert.Contains(t, eNames, dfv1.EnvISBSvcRedisSentinelURL)
 }
 
+func TestGetRedisIsbSvcEnvVars_TLSAndCluster(t *testing.T) {
+	caCert := &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "redis-tls"}, Key: "ca.crt"}
+	cert := &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "redis-tls"}, Key: "tls.crt"}
+	key := &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "redis-tls"}, Key: "tls.key"}
+	tp, env := GetIsbSvcEnvVars(dfv1.BufferServiceConfig{
+		Redis: &dfv1.RedisConfig{
+			URL:          "redis-cluster:6379",
+			SentinelUser: "sentinel-user",
+			Cluster:      true,
+			TLS: &dfv1.TLS{
+				CACertSecret: caCert,
+				CertSecret:   cert,
+				KeySecret:    key,
+			},
+		},
+	})
+	assert.Equal(t, dfv1.ISBSvcTypeRedis, tp)
+	assert.Contains(t, env, corev1.EnvVar{Name: dfv1.EnvISBSvcRedisSentinelUser, Value: "sentinel-user"})
+	assert.Contains(t, env, corev1.EnvVar{Name: dfv1.EnvISBSvcRedisCluster, Value: "true"})
+	assert.Contains(t, env, corev1.EnvVar{Name: dfv1.EnvISBSvcRedisTLSEnabled, Value: "true"})
+	assert.Contains(t, env, corev1.EnvVar{Name: dfv1.EnvISBSvcRedisTLSInsecureSkipVerify, Value: "false"})
+	assert.Contains(t, env, corev1.EnvVar{Name: dfv1.EnvISBSvcRedisTLSCACert, ValueFrom: &corev1.EnvVarSource{SecretKeyRef: caCert}})
+	assert.Contains(t, env, corev1.EnvVar{Name: dfv1.EnvISBSvcRedisTLSCert, ValueFrom: &corev1.EnvVarSource{SecretKeyRef: cert}})
+	assert.Contains(t, env, corev1.EnvVar{Name: dfv1.EnvISBSvcRedisTLSKey, ValueFrom: &corev1.EnvVarSource{SecretKeyRef: key}})
+
+	_, env = GetIsbSvcEnvVars(dfv1.BufferServiceConfig{Redis: &dfv1.RedisConfig{URL: "redis:6379"}})
+	for _, e := range env {
+		assert.NotContains(t, []string{dfv1.EnvISBSvcRedisCluster, dfv1.EnvISBSvcRedisTLSEnabled, dfv1.EnvISBSvcRedisSentinelUser}, e.Name)
+	}
+}
+
 func TestGetJSIsbSvcEnvVars(t *testing.T) {
 	fakeIsbsConfig := dfv1.BufferServiceConfig{
 		JetStream: &dfv1.JetStreamConfig{
@@ -110,3 +143,48 @@ func TestGetJSIsbSvcEnvVars(t *testing.T) {
 	assert.Contains(t, eNames, dfv1.EnvISBSvcJetStreamPassword)
 	assert.Contains(t, eNames, dfv1.EnvISBSvcConfig)
 }
+
+func TestGetDiskIsbSvcEnvVars(t *testing.T) {
+	fakeIsbsConfig := dfv1.BufferServiceConfig{
+		Disk: &dfv1.DiskConfig{
+			DiskStorage: dfv1.DiskStorage{
+				HostPath: &corev1.HostPathVolumeSource{Path: "/mnt/isbsvc"},
+			},
+			MaxSegmentBytes: 1024,
+			SyncInterval:    metav1.Duration{Duration: 2 * time.Second},
+		},
+	}
+	tp, env := GetIsbSvcEnvVars(fakeIsbsConfig)
+	assert.Equal(t, dfv1.ISBSvcTypeDisk, tp)
+	assert.Contains(t, env, corev1.EnvVar{Name: dfv1.EnvISBSvcDiskMaxSegmentBytes, Value: "1024"})
+	assert.Contains(t, env, corev1.EnvVar{Name: dfv1.EnvISBSvcDiskSyncInterval, Value: "2s"})
+}
+
+func TestAddISBSvcDiskVolume(t *testing.T) {
+	newPodSpec := func() *corev1.PodSpec {
+		return &corev1.PodSpec{
+			InitContainers: []corev1.Container{{Name: dfv1.CtrInit}},
+			Containers:     []corev1.Container{{Name: dfv1.CtrMain}, {Name: dfv1.CtrUdf}},
+		}
+	}
+
+	t.Run("not disk", func(t *testing.T) {
+		podSpec := newPodSpec()
+		AddISBSvcDiskVolume(podSpec, dfv1.BufferServiceConfig{JetStream: &dfv1.JetStreamConfig{}})
+		assert.Equal(t, newPodSpec(), podSpec)
+	})
+
+	t.Run("disk", func(t *testing.T) {
+		podSpec := newPodSpec()
+		AddISBSvcDiskVolume(podSpec, dfv1.BufferServiceConfig{Disk: &dfv1.DiskConfig{
+			DiskStorage: dfv1.DiskStorage{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "isbsvc"},
+			},
+		}})
+		assert.Len(t, podSpec.Volumes, 1)
+		assert.Equal(t, "isbsvc", podSpec.Volumes[0].PersistentVolumeClaim.ClaimName)
+		assert.Len(t, podSpec.InitContainers[0].VolumeMounts, 1)
+		assert.Equal(t, dfv1.PathISBSvcDiskMount, podSpec.Containers[0].VolumeMounts[0].MountPath)
+		assert.Empty(t, podSpec.Containers[1].VolumeMounts)
+	})
+}
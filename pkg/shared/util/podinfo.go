@@ -0,0 +1,46 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"bufio"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// LookupPodAnnotation looks up the annotation of the pod with the given key in the annotations file of a downward API
+// volume, which has a key="value" line for each annotation. It returns false if the file or the annotation doesn't exist.
+func LookupPodAnnotation(file, key string) (string, bool) {
+	f, err := os.Open(file)
+	if err != nil {
+		return "", false
+	}
+	defer func() { _ = f.Close() }()
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		k, v, ok := strings.Cut(scanner.Text(), "=")
+		if !ok || k != key {
+			continue
+		}
+		if value, err := strconv.Unquote(v); err == nil {
+			return value, true
+		}
+		return v, true
+	}
+	return "", false
+}
@@ -0,0 +1,42 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLookupPodAnnotation(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "annotations")
+	_, ok := LookupPodAnnotation(file, "a")
+	assert.False(t, ok)
+
+	err := os.WriteFile(file, []byte("a=\"1\"\nnumaflow.numaproj.io/pausing=\"true\"\nc=\"x=\\\"y\\\"\"\n"), 0644)
+	assert.NoError(t, err)
+	v, ok := LookupPodAnnotation(file, "numaflow.numaproj.io/pausing")
+	assert.True(t, ok)
+	assert.Equal(t, "true", v)
+	v, ok = LookupPodAnnotation(file, "c")
+	assert.True(t, ok)
+	assert.Equal(t, `x="y"`, v)
+	_, ok = LookupPodAnnotation(file, "b")
+	assert.False(t, ok)
+}
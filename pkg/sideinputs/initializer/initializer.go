@@ -27,6 +27,7 @@ import (
 	"github.com/numaproj/numaflow/pkg/isbsvc"
 	jsclient "github.com/numaproj/numaflow/pkg/shared/clients/nats"
 	"github.com/numaproj/numaflow/pkg/shared/kvs"
+	diskkv "github.com/numaproj/numaflow/pkg/shared/kvs/disk"
 	"github.com/numaproj/numaflow/pkg/shared/kvs/jetstream"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
 	"github.com/numaproj/numaflow/pkg/sideinputs/utils"
@@ -82,6 +83,11 @@ func (sii *sideInputsInitializer) Run(ctx context.Context) error {
 		if err != nil {
 			return fmt.Errorf("failed to create a sideInputStore, %w", err)
 		}
+	case dfv1.ISBSvcTypeDisk:
+		sideInputStore, err = diskkv.NewKVDiskKVStore(ctx, dfv1.PathISBSvcDiskMount, isbsvc.JetStreamSideInputsStoreKVName(sii.sideInputsStore))
+		if err != nil {
+			return fmt.Errorf("failed to create a sideInputStore, %w", err)
+		}
 	default:
 		return fmt.Errorf("unrecognized isbsvc type %q", sii.isbSvcType)
 	}
@@ -33,6 +33,7 @@ import (
 	"github.com/numaproj/numaflow/pkg/sdkclient/sideinput"
 	jsclient "github.com/numaproj/numaflow/pkg/shared/clients/nats"
 	"github.com/numaproj/numaflow/pkg/shared/kvs"
+	diskkv "github.com/numaproj/numaflow/pkg/shared/kvs/disk"
 	"github.com/numaproj/numaflow/pkg/shared/kvs/jetstream"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
 )
@@ -79,6 +80,11 @@ func (sim *sideInputsManager) Start(ctx context.Context) error {
 			return fmt.Errorf("failed to create a new KVStore: %w", err)
 		}
 
+	case dfv1.ISBSvcTypeDisk:
+		siStore, err = diskkv.NewKVDiskKVStore(ctx, dfv1.PathISBSvcDiskMount, isbsvc.JetStreamSideInputsStoreKVName(sim.sideInputsStore))
+		if err != nil {
+			return fmt.Errorf("failed to create a new KVStore: %w", err)
+		}
 	default:
 		return fmt.Errorf("unrecognized isbsvc type %q", sim.isbSvcType)
 	}
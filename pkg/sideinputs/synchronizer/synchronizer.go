@@ -28,6 +28,7 @@ import (
 	"github.com/numaproj/numaflow/pkg/isbsvc"
 	jsclient "github.com/numaproj/numaflow/pkg/shared/clients/nats"
 	"github.com/numaproj/numaflow/pkg/shared/kvs"
+	diskkv "github.com/numaproj/numaflow/pkg/shared/kvs/disk"
 	"github.com/numaproj/numaflow/pkg/shared/kvs/jetstream"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
 	"github.com/numaproj/numaflow/pkg/sideinputs/utils"
@@ -83,6 +84,11 @@ func (sis *sideInputsSynchronizer) Start(ctx context.Context) error {
 		if err != nil {
 			return fmt.Errorf("failed to create a sideInputStore, %w", err)
 		}
+	case dfv1.ISBSvcTypeDisk:
+		sideInputStore, err = diskkv.NewKVDiskKVStore(ctx, dfv1.PathISBSvcDiskMount, isbsvc.JetStreamSideInputsStoreKVName(sis.sideInputsStore))
+		if err != nil {
+			return fmt.Errorf("failed to create a sideInputStore, %w", err)
+		}
 	default:
 		return fmt.Errorf("unrecognized isbsvc type %q", sis.isbSvcType)
 	}
@@ -0,0 +1,207 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package file
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/writer"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+)
+
+// encoder encodes the messages of a file.
+type encoder interface {
+	// encode appends a message to the file.
+	encode(msg isb.Message) error
+	// size returns the size of the encoded messages.
+	size() int
+	// finish returns the content of the file.
+	finish() ([]byte, error)
+}
+
+func newEncoder(encoding dfv1.FileEncoding) (encoder, error) {
+	switch encoding {
+	case dfv1.FileEncodingJSONL:
+		return &jsonlEncoder{}, nil
+	case dfv1.FileEncodingCSV:
+		e := &csvEncoder{}
+		e.writer = csv.NewWriter(&e.buf)
+		return e, nil
+	case dfv1.FileEncodingParquet:
+		return newParquetEncoder()
+	default:
+		return nil, fmt.Errorf("unsupported encoding %q", encoding)
+	}
+}
+
+// extension returns the file name extension of the encoding.
+func extension(encoding dfv1.FileEncoding) string {
+	return "." + string(encoding)
+}
+
+// joinKeys joins the keys of a message in the csv and parquet files.
+func joinKeys(keys []string) string {
+	return strings.Join(keys, ":")
+}
+
+// encodeHeaders encodes the headers of a message as a JSON object in the csv and parquet files.
+func encodeHeaders(headers map[string]string) (string, error) {
+	if len(headers) == 0 {
+		return "{}", nil
+	}
+	b, err := json.Marshal(headers)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// jsonlRecord is a line of the jsonl files.
+type jsonlRecord struct {
+	ID        string            `json:"id"`
+	Keys      []string          `json:"keys"`
+	EventTime time.Time         `json:"eventTime"`
+	Headers   map[string]string `json:"headers,omitempty"`
+	// Payload is embedded as is if it's valid JSON, otherwise it's a string
+	Payload json.RawMessage `json:"payload"`
+}
+
+type jsonlEncoder struct {
+	buf bytes.Buffer
+}
+
+func (e *jsonlEncoder) encode(msg isb.Message) error {
+	payload := json.RawMessage(msg.Payload)
+	if !json.Valid(msg.Payload) {
+		b, err := json.Marshal(string(msg.Payload))
+		if err != nil {
+			return err
+		}
+		payload = b
+	}
+	keys := msg.Keys
+	if keys == nil {
+		keys = []string{}
+	}
+	b, err := json.Marshal(jsonlRecord{ID: msg.ID.String(), Keys: keys, EventTime: msg.EventTime.UTC(), Headers: msg.Headers, Payload: payload})
+	if err != nil {
+		return err
+	}
+	e.buf.Write(b)
+	e.buf.WriteByte('\n')
+	return nil
+}
+
+func (e *jsonlEncoder) size() int {
+	return e.buf.Len()
+}
+
+func (e *jsonlEncoder) finish() ([]byte, error) {
+	return e.buf.Bytes(), nil
+}
+
+// csvEncoder writes the columns id, keys, eventTime, headers and payload, without a header line.
+type csvEncoder struct {
+	buf    bytes.Buffer
+	writer *csv.Writer
+}
+
+func (e *csvEncoder) encode(msg isb.Message) error {
+	headers, err := encodeHeaders(msg.Headers)
+	if err != nil {
+		return err
+	}
+	if err := e.writer.Write([]string{msg.ID.String(), joinKeys(msg.Keys), msg.EventTime.UTC().Format(time.RFC3339Nano), headers, string(msg.Payload)}); err != nil {
+		return err
+	}
+	e.writer.Flush()
+	return e.writer.Error()
+}
+
+func (e *csvEncoder) size() int {
+	return e.buf.Len()
+}
+
+func (e *csvEncoder) finish() ([]byte, error) {
+	return e.buf.Bytes(), nil
+}
+
+// parquetRecord is a row of the parquet files.
+type parquetRecord struct {
+	ID        string `parquet:"name=id, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Keys      string `parquet:"name=keys, type=BYTE_ARRAY, convertedtype=UTF8"`
+	EventTime int64  `parquet:"name=eventTime, type=INT64, convertedtype=TIMESTAMP_MILLIS"`
+	Headers   string `parquet:"name=headers, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Payload   string `parquet:"name=payload, type=BYTE_ARRAY"`
+}
+
+// parquetEncoder writes the parquet files with the snappy compressed columns id, keys, eventTime, headers and payload.
+type parquetEncoder struct {
+	buf    bytes.Buffer
+	writer *writer.ParquetWriter
+	// bytes is the size of the encoded values, the writer buffers the rows until the file is finished
+	bytes int
+}
+
+func newParquetEncoder() (*parquetEncoder, error) {
+	e := &parquetEncoder{}
+	w, err := writer.NewParquetWriterFromWriter(&e.buf, new(parquetRecord), 1)
+	if err != nil {
+		return nil, err
+	}
+	w.CompressionType = parquet.CompressionCodec_SNAPPY
+	e.writer = w
+	return e, nil
+}
+
+func (e *parquetEncoder) encode(msg isb.Message) error {
+	headers, err := encodeHeaders(msg.Headers)
+	if err != nil {
+		return err
+	}
+	record := parquetRecord{
+		ID:        msg.ID.String(),
+		Keys:      joinKeys(msg.Keys),
+		EventTime: msg.EventTime.UnixMilli(),
+		Headers:   headers,
+		Payload:   string(msg.Payload),
+	}
+	if err := e.writer.Write(record); err != nil {
+		return err
+	}
+	e.bytes += len(record.ID) + len(record.Keys) + 8 + len(record.Headers) + len(record.Payload)
+	return nil
+}
+
+func (e *parquetEncoder) size() int {
+	return e.bytes
+}
+
+func (e *parquetEncoder) finish() ([]byte, error) {
+	if err := e.writer.WriteStop(); err != nil {
+		return nil, err
+	}
+	return e.buf.Bytes(), nil
+}
@@ -0,0 +1,182 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package file
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"path"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/metrics"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+)
+
+// ToFile writes the messages to files. The messages are staged in the files of their partition directories, and the
+// files are committed together when one of them rolls, the messages are acked by the forwarder after the commit.
+type ToFile struct {
+	name            string
+	pipelineName    string
+	prefix          string
+	encoding        dfv1.FileEncoding
+	maxFileBytes    int64
+	rollInterval    time.Duration
+	eventTimeLayout string
+	partitionByKeys bool
+	store           Store
+	// parts are the staged files by the partition directories
+	parts map[string]*part
+	log   *zap.SugaredLogger
+}
+
+// part is a staged file.
+type part struct {
+	name      string
+	createdAt time.Time
+	encoder   encoder
+}
+
+// NewToFile returns ToFile type.
+func NewToFile(ctx context.Context, vertexInstance *dfv1.VertexInstance, fileSink *dfv1.FileSink, opts ...Option) (*ToFile, error) {
+	toFile := &ToFile{
+		name:            vertexInstance.Vertex.Spec.Name,
+		pipelineName:    vertexInstance.Vertex.Spec.PipelineName,
+		encoding:        fileSink.GetEncoding(),
+		maxFileBytes:    fileSink.GetMaxFileBytes(),
+		rollInterval:    fileSink.GetRollInterval(),
+		eventTimeLayout: fileSink.EventTimeLayout,
+		partitionByKeys: fileSink.PartitionByKeys,
+		parts:           make(map[string]*part),
+		log:             logging.FromContext(ctx).With("sinkType", "file").With("path", fileSink.Path),
+	}
+	if _, err := newEncoder(toFile.encoding); err != nil {
+		return nil, err
+	}
+	for _, o := range opts {
+		if err := o(toFile); err != nil {
+			return nil, err
+		}
+	}
+	if toFile.store == nil {
+		if fileSink.S3 != nil {
+			store, err := newS3Store(ctx, fileSink.S3)
+			if err != nil {
+				return nil, err
+			}
+			toFile.store = store
+			toFile.prefix = fileSink.Path
+		} else {
+			toFile.store = newLocalStore(fileSink.Path)
+		}
+	}
+	return toFile, nil
+}
+
+// GetName returns the name.
+func (tf *ToFile) GetName() string {
+	return tf.name
+}
+
+// GetPartitionIdx returns the partition index.
+// for sink it is always 0.
+func (tf *ToFile) GetPartitionIdx() int32 {
+	return 0
+}
+
+// Write stages the messages in the files of their partition directories.
+func (tf *ToFile) Write(_ context.Context, messages []isb.Message) ([]isb.Offset, []error) {
+	errs := make([]error, len(messages))
+	for i, msg := range messages {
+		dir := tf.partitionDir(msg)
+		p, ok := tf.parts[dir]
+		if !ok {
+			enc, err := newEncoder(tf.encoding)
+			if err != nil {
+				errs[i] = err
+				continue
+			}
+			now := time.Now()
+			p = &part{
+				name:      path.Join(tf.prefix, dir, fmt.Sprintf("part-%d-%s%s", now.UnixMilli(), uuid.NewString(), extension(tf.encoding))),
+				createdAt: now,
+				encoder:   enc,
+			}
+			tf.parts[dir] = p
+		}
+		if err := p.encoder.encode(msg); err != nil {
+			errs[i] = fmt.Errorf("failed to encode the message, %w", err)
+		}
+	}
+	return nil, errs
+}
+
+// partitionDir returns the partition directory of a message, by the event time and the keys.
+func (tf *ToFile) partitionDir(msg isb.Message) string {
+	dir := ""
+	if tf.eventTimeLayout != "" {
+		dir = msg.EventTime.UTC().Format(tf.eventTimeLayout)
+	}
+	if tf.partitionByKeys {
+		dir = path.Join(dir, "keys="+url.PathEscape(joinKeys(msg.Keys)))
+	}
+	return dir
+}
+
+// ShouldCommit returns true if one of the staged files reaches the max size or age.
+func (tf *ToFile) ShouldCommit() bool {
+	for _, p := range tf.parts {
+		if int64(p.encoder.size()) >= tf.maxFileBytes || time.Since(p.createdAt) >= tf.rollInterval {
+			return true
+		}
+	}
+	return false
+}
+
+// Commit writes all the staged files to the store. The files committed are removed from the staged files, so that
+// a retry only writes the rest, with the same names.
+func (tf *ToFile) Commit(ctx context.Context) error {
+	labels := map[string]string{metrics.LabelVertex: tf.name, metrics.LabelPipeline: tf.pipelineName}
+	for dir, p := range tf.parts {
+		data, err := p.encoder.finish()
+		if err != nil {
+			return fmt.Errorf("failed to encode file %q, %w", p.name, err)
+		}
+		if err := tf.store.Put(ctx, p.name, data); err != nil {
+			return err
+		}
+		delete(tf.parts, dir)
+		fileSinkCommittedFiles.With(labels).Inc()
+		fileSinkCommittedBytes.With(labels).Add(float64(len(data)))
+		tf.log.Debugw("Committed a file", zap.String("file", p.name), zap.Int("size", len(data)))
+	}
+	return nil
+}
+
+// Close discards the staged files, the messages in them are not acked and will be redelivered.
+func (tf *ToFile) Close() error {
+	if len(tf.parts) > 0 {
+		tf.log.Infow("Discarding the files not committed", zap.Int("count", len(tf.parts)))
+	}
+	tf.parts = make(map[string]*part)
+	return nil
+}
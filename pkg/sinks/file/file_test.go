@@ -0,0 +1,231 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package file
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xitongsys/parquet-go-source/buffer"
+	"github.com/xitongsys/parquet-go/reader"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+)
+
+var testEventTime = time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)
+
+func newTestVertexInstance() *dfv1.VertexInstance {
+	return &dfv1.VertexInstance{
+		Vertex: &dfv1.Vertex{Spec: dfv1.VertexSpec{
+			PipelineName:   "testPipeline",
+			AbstractVertex: dfv1.AbstractVertex{Name: "testVertex"},
+		}},
+	}
+}
+
+func newTestMessage(offset string, keys []string, payload string) isb.Message {
+	return isb.Message{
+		Header: isb.Header{
+			MessageInfo: isb.MessageInfo{EventTime: testEventTime},
+			ID:          isb.MessageID{VertexName: "in", Offset: offset},
+			Keys:        keys,
+			Headers:     map[string]string{"h": "v"},
+		},
+		Body: isb.Body{Payload: []byte(payload)},
+	}
+}
+
+// listFiles returns the relative paths of the files under the directory.
+func listFiles(t *testing.T, dir string) []string {
+	var files []string
+	err := filepath.Walk(dir, func(p string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		rel, _ := filepath.Rel(dir, p)
+		files = append(files, filepath.ToSlash(rel))
+		return nil
+	})
+	require.NoError(t, err)
+	return files
+}
+
+func TestToFile_WriteAndCommit(t *testing.T) {
+	dir := t.TempDir()
+	toFile, err := NewToFile(context.Background(), newTestVertexInstance(), &dfv1.FileSink{
+		Path:            dir,
+		EventTimeLayout: "2006/01/02",
+		PartitionByKeys: true,
+	})
+	require.NoError(t, err)
+
+	_, errs := toFile.Write(context.Background(), []isb.Message{
+		newTestMessage("1", []string{"a"}, `{"n":1}`),
+		newTestMessage("2", []string{"b/c"}, "plain text"),
+		newTestMessage("3", []string{"a"}, `{"n":3}`),
+	})
+	assert.Equal(t, []error{nil, nil, nil}, errs)
+	assert.False(t, toFile.ShouldCommit())
+	// nothing is visible before the commit
+	assert.Empty(t, listFiles(t, dir))
+
+	require.NoError(t, toFile.Commit(context.Background()))
+	files := listFiles(t, dir)
+	require.Len(t, files, 2)
+	for _, f := range files {
+		assert.True(t, strings.HasPrefix(f, "2024/05/06/keys=a/part-") || strings.HasPrefix(f, "2024/05/06/keys=b%2Fc/part-"), f)
+		assert.True(t, strings.HasSuffix(f, ".jsonl"), f)
+		if !strings.Contains(f, "keys=a") {
+			continue
+		}
+		content, err := os.ReadFile(filepath.Join(dir, f))
+		require.NoError(t, err)
+		scanner := bufio.NewScanner(bytes.NewReader(content))
+		var records []jsonlRecord
+		for scanner.Scan() {
+			var r jsonlRecord
+			require.NoError(t, json.Unmarshal(scanner.Bytes(), &r))
+			records = append(records, r)
+		}
+		require.Len(t, records, 2)
+		assert.Equal(t, "in-1-0", records[0].ID)
+		assert.Equal(t, []string{"a"}, records[0].Keys)
+		assert.True(t, testEventTime.Equal(records[0].EventTime))
+		assert.Equal(t, map[string]string{"h": "v"}, records[0].Headers)
+		assert.JSONEq(t, `{"n":1}`, string(records[0].Payload))
+	}
+	assert.Empty(t, toFile.parts)
+}
+
+func TestToFile_ShouldCommit(t *testing.T) {
+	toFile, err := NewToFile(context.Background(), newTestVertexInstance(), &dfv1.FileSink{Path: t.TempDir(), MaxFileBytes: 10})
+	require.NoError(t, err)
+	assert.False(t, toFile.ShouldCommit())
+	toFile.Write(context.Background(), []isb.Message{newTestMessage("1", nil, "a payload larger than 10 bytes")})
+	assert.True(t, toFile.ShouldCommit())
+
+	toFile, err = NewToFile(context.Background(), newTestVertexInstance(), &dfv1.FileSink{Path: t.TempDir(), RollInterval: &metav1.Duration{Duration: time.Millisecond}})
+	require.NoError(t, err)
+	toFile.Write(context.Background(), []isb.Message{newTestMessage("1", nil, "a")})
+	time.Sleep(2 * time.Millisecond)
+	assert.True(t, toFile.ShouldCommit())
+}
+
+// flakyStore fails the first put.
+type flakyStore struct {
+	failed bool
+	files  map[string][]byte
+}
+
+func (s *flakyStore) Put(_ context.Context, name string, data []byte) error {
+	if !s.failed {
+		s.failed = true
+		return fmt.Errorf("unavailable")
+	}
+	s.files[name] = data
+	return nil
+}
+
+func TestToFile_CommitRetry(t *testing.T) {
+	store := &flakyStore{files: map[string][]byte{}}
+	toFile, err := NewToFile(context.Background(), newTestVertexInstance(), &dfv1.FileSink{Path: "out", Encoding: dfv1.FileEncodingCSV}, WithStore(store))
+	require.NoError(t, err)
+	toFile.Write(context.Background(), []isb.Message{newTestMessage("1", []string{"k1", "k2"}, "x,y")})
+	name := toFile.parts[""].name
+
+	assert.Error(t, toFile.Commit(context.Background()))
+	assert.Len(t, toFile.parts, 1)
+	require.NoError(t, toFile.Commit(context.Background()))
+	assert.Empty(t, toFile.parts)
+
+	require.Contains(t, store.files, name)
+	records, err := csv.NewReader(bytes.NewReader(store.files[name])).ReadAll()
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{{"in-1-0", "k1:k2", "2024-05-06T07:08:09Z", `{"h":"v"}`, "x,y"}}, records)
+}
+
+func TestParquetEncoder(t *testing.T) {
+	enc, err := newParquetEncoder()
+	require.NoError(t, err)
+	require.NoError(t, enc.encode(newTestMessage("1", []string{"a"}, "p1")))
+	require.NoError(t, enc.encode(newTestMessage("2", []string{"b", "c"}, "p2")))
+	assert.Greater(t, enc.size(), 0)
+	data, err := enc.finish()
+	require.NoError(t, err)
+
+	file, err := buffer.NewBufferFile(data)
+	require.NoError(t, err)
+	pr, err := reader.NewParquetReader(file, new(parquetRecord), 1)
+	require.NoError(t, err)
+	defer pr.ReadStop()
+	require.Equal(t, int64(2), pr.GetNumRows())
+	records := make([]parquetRecord, 2)
+	require.NoError(t, pr.Read(&records))
+	assert.Equal(t, []parquetRecord{
+		{ID: "in-1-0", Keys: "a", EventTime: testEventTime.UnixMilli(), Headers: `{"h":"v"}`, Payload: "p1"},
+		{ID: "in-2-0", Keys: "b:c", EventTime: testEventTime.UnixMilli(), Headers: `{"h":"v"}`, Payload: "p2"},
+	}, records)
+
+	// the column names of the schema in the file
+	file, err = buffer.NewBufferFile(data)
+	require.NoError(t, err)
+	fileReader, err := reader.NewParquetReader(file, nil, 1)
+	require.NoError(t, err)
+	defer fileReader.ReadStop()
+	var names []string
+	for _, info := range fileReader.SchemaHandler.Infos[1:] {
+		names = append(names, info.ExName)
+	}
+	assert.Equal(t, []string{"id", "keys", "eventTime", "headers", "payload"}, names)
+}
+
+func TestS3Store_Put(t *testing.T) {
+	t.Setenv("AWS_ACCESS_KEY_ID", "test-key")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "test-secret")
+	var gotPath, gotAuth, gotBody string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.EscapedPath()
+		gotAuth = r.Header.Get("Authorization")
+		b, _ := io.ReadAll(r.Body)
+		gotBody = string(b)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	store, err := newS3Store(context.Background(), &dfv1.FileSinkS3{Bucket: "my-bucket", Region: "us-west-2", EndpointURL: server.URL})
+	require.NoError(t, err)
+	require.NoError(t, store.Put(context.Background(), "prefix/keys=a:b/part-1.jsonl", []byte("data")))
+	assert.Equal(t, "/my-bucket/prefix/keys%3Da%3Ab/part-1.jsonl", gotPath)
+	assert.True(t, strings.HasPrefix(gotAuth, "AWS4-HMAC-SHA256 Credential=test-key/"), gotAuth)
+	assert.Equal(t, "data", gotBody)
+}
@@ -0,0 +1,36 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package file
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/numaproj/numaflow/pkg/metrics"
+)
+
+var fileSinkCommittedFiles = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "file_sink",
+	Name:      "committed_files_total",
+	Help:      "Total number of files committed",
+}, []string{metrics.LabelVertex, metrics.LabelPipeline})
+
+var fileSinkCommittedBytes = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "file_sink",
+	Name:      "committed_bytes_total",
+	Help:      "Total number of bytes of the files committed",
+}, []string{metrics.LabelVertex, metrics.LabelPipeline})
@@ -0,0 +1,27 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package file
+
+type Option func(*ToFile) error
+
+// WithStore sets the store to commit the files to, instead of creating one from the sink spec.
+func WithStore(s Store) Option {
+	return func(o *ToFile) error {
+		o.store = s
+		return nil
+	}
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package file
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	s3client "github.com/numaproj/numaflow/pkg/shared/clients/s3"
+)
+
+// Store is where the files are committed to.
+type Store interface {
+	// Put durably writes a file, the file is either fully written or not visible at all.
+	Put(ctx context.Context, name string, data []byte) error
+}
+
+// localStore writes the files to a local directory.
+type localStore struct {
+	dir string
+}
+
+func newLocalStore(dir string) *localStore {
+	return &localStore{dir: dir}
+}
+
+// Put writes the file to a temporary file, syncs it, and renames it to the name of the file.
+func (s *localStore) Put(_ context.Context, name string, data []byte) error {
+	filePath := filepath.Join(s.dir, filepath.FromSlash(name))
+	dir := filepath.Dir(filePath)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("failed to create directory %q, %w", dir, err)
+	}
+	tmp, err := os.CreateTemp(dir, "."+filepath.Base(filePath)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary file, %w", err)
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("failed to write file %q, %w", tmp.Name(), err)
+	}
+	if err := tmp.Sync(); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("failed to sync file %q, %w", tmp.Name(), err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to close file %q, %w", tmp.Name(), err)
+	}
+	if err := os.Rename(tmp.Name(), filePath); err != nil {
+		return fmt.Errorf("failed to rename file %q, %w", tmp.Name(), err)
+	}
+	// sync the directory to persist the rename
+	d, err := os.Open(dir)
+	if err != nil {
+		return fmt.Errorf("failed to open directory %q, %w", dir, err)
+	}
+	defer func() { _ = d.Close() }()
+	return d.Sync()
+}
+
+// newS3Store returns a store uploading the files to a bucket of an S3 compatible object store.
+func newS3Store(ctx context.Context, s3 *dfv1.FileSinkS3) (Store, error) {
+	client, err := s3client.NewClient(ctx, s3.Region, s3.EndpointURL)
+	if err != nil {
+		return nil, err
+	}
+	return s3client.NewBucket(client, s3.Bucket, ""), nil
+}
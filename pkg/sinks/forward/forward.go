@@ -31,6 +31,7 @@ import (
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/numaproj/numaflow/pkg/isb"
 	"github.com/numaproj/numaflow/pkg/metrics"
+	"github.com/numaproj/numaflow/pkg/shared/deadletter"
 	"github.com/numaproj/numaflow/pkg/shared/idlehandler"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
 	"github.com/numaproj/numaflow/pkg/sinks/sinker"
@@ -59,6 +60,8 @@ type DataForward struct {
 	idleManager wmb.IdleManager
 	// wmbChecker checks if the idle watermark is valid.
 	wmbChecker wmb.WMBChecker
+	// pendingAcks are the offsets of the messages staged by a committing sink writer, which are acked once committed.
+	pendingAcks []isb.Offset
 	Shutdown
 }
 
@@ -130,6 +133,7 @@ func (df *DataForward) Start() <-chan error {
 				}
 				if ok {
 					log.Info("Shutting down...")
+					df.commitOnShutdown()
 					return
 				}
 			default:
@@ -213,6 +217,12 @@ func (df *DataForward) forwardAChunk(ctx context.Context) error {
 	// process only if we have any read messages. There is a natural looping here if there is an internal error while
 	// reading, and we are not able to proceed.
 	if len(readMessages) == 0 {
+		// the staged messages might need to be committed when they reach the max age.
+		if cw, ok := df.sinkWriter.(sinker.CommittingWriter); ok && cw.ShouldCommit() {
+			if err := df.commitAndAckPending(ctx, cw); err != nil {
+				return err
+			}
+		}
 		// When the read length is zero, the write length is definitely zero too,
 		// meaning there's no data to be published to the next vertex, and we consider this
 		// situation as idling.
@@ -270,8 +280,19 @@ func (df *DataForward) forwardAChunk(ctx context.Context) error {
 		writeMessages = append(writeMessages, m.Message)
 	}
 
+	sinkWriter := df.sinkWriter
+	// a transactional sink writer commits a checkpoint of the read offsets along with the messages.
+	if tw, ok := df.sinkWriter.(sinker.TransactionalWriter); ok {
+		writeMessages, sinkWriter, err = df.prepareTransactionalWrite(tw, dataMessages, readOffsets)
+		if err != nil {
+			df.opts.logger.Errorw("Failed to prepare the transactional write", zap.Error(err))
+			df.fromBufferPartition.NoAck(ctx, readOffsets)
+			return err
+		}
+	}
+
 	// write the messages to the sink
-	_, fallbackMessages, err := df.writeToSink(ctx, df.sinkWriter, writeMessages, false)
+	_, fallbackMessages, err := df.writeToSink(ctx, sinkWriter, writeMessages, false)
 	// error will not be nil only when we get ctx.Done()
 	if err != nil {
 		df.opts.logger.Errorw("failed to write to sink", zap.Error(err))
@@ -300,29 +321,135 @@ func (df *DataForward) forwardAChunk(ctx context.Context) error {
 
 	df.opts.logger.Debugw("Write to sink completed")
 
+	if cw, ok := df.sinkWriter.(sinker.CommittingWriter); ok {
+		// the messages staged by the sink writer are acked after they are committed.
+		df.pendingAcks = append(df.pendingAcks, readOffsets...)
+		if cw.ShouldCommit() {
+			if err = df.commitAndAckPending(ctx, cw); err != nil {
+				return err
+			}
+		}
+	} else if !df.ackAndRecord(ctx, readOffsets) {
+		return nil
+	}
+
+	if df.opts.cbPublisher != nil {
+		if err = df.opts.cbPublisher.SinkVertexCallback(ctx, writeMessages); err != nil {
+			df.opts.logger.Error("Failed to execute callback", zap.Error(err))
+		}
+	}
+	// ProcessingTimes of the entire forwardAChunk
+	metrics.ForwardAChunkProcessingTime.With(metricLabels).Observe(float64(time.Since(start).Microseconds()))
+	return nil
+}
+
+// ackAndRecord acks the offsets and records the metrics, it returns false if the ack fails.
+func (df *DataForward) ackAndRecord(ctx context.Context, offsets []isb.Offset) bool {
+	metricLabelsWithPartition := map[string]string{
+		metrics.LabelVertex:             df.vertexName,
+		metrics.LabelPipeline:           df.pipelineName,
+		metrics.LabelVertexType:         string(dfv1.VertexTypeSink),
+		metrics.LabelVertexReplicaIndex: strconv.Itoa(int(df.vertexReplica)),
+		metrics.LabelPartitionName:      df.fromBufferPartition.GetName(),
+	}
 	ackStart := time.Now()
-	err = df.ackFromBuffer(ctx, readOffsets)
+	err := df.ackFromBuffer(ctx, offsets)
 	// implicit return for posterity :-)
 	if err != nil {
 		df.opts.logger.Errorw("Failed to ack from buffer", zap.Error(err))
-		metrics.AckMessageError.With(metricLabelsWithPartition).Add(float64(len(readOffsets)))
-		return nil
+		metrics.AckMessageError.With(metricLabelsWithPartition).Add(float64(len(offsets)))
+		return false
 	}
 
 	// Ack processing time
 	metrics.AckProcessingTime.With(metricLabelsWithPartition).Observe(float64(time.Since(ackStart).Microseconds()))
-	metrics.AckMessagesCount.With(metricLabelsWithPartition).Add(float64(len(readOffsets)))
+	metrics.AckMessagesCount.With(metricLabelsWithPartition).Add(float64(len(offsets)))
+	return true
+}
 
-	if df.opts.cbPublisher != nil {
-		if err = df.opts.cbPublisher.SinkVertexCallback(ctx, writeMessages); err != nil {
-			df.opts.logger.Error("Failed to execute callback", zap.Error(err))
+// commitRetryBackoff is the backoff of retrying the commits, the delay stays at the Cap until the commit succeeds.
+var commitRetryBackoff = wait.Backoff{
+	Factor:   1.5,
+	Jitter:   0.1,
+	Steps:    math.MaxInt,
+	Duration: time.Millisecond * 100,
+	Cap:      time.Second * 10,
+}
+
+// commitAndAckPending commits the messages staged by the sink writer, and acks them once committed. The commit is
+// retried until it succeeds or the context is canceled.
+func (df *DataForward) commitAndAckPending(ctx context.Context, cw sinker.CommittingWriter) error {
+	attempt := 0
+	err := retryWithBackoff(ctx, commitRetryBackoff, func(ctx context.Context) (bool, error) {
+		attempt++
+		if err := cw.Commit(ctx); err != nil {
+			df.opts.logger.Errorw("Failed to commit the staged messages, retrying", zap.Error(err), zap.Int("attempt", attempt))
+			return false, nil
 		}
+		return true, nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to commit the staged messages, %w", err)
 	}
-	// ProcessingTimes of the entire forwardAChunk
-	metrics.ForwardAChunkProcessingTime.With(metricLabels).Observe(float64(time.Since(start).Microseconds()))
+	pendingAcks := df.pendingAcks
+	df.pendingAcks = nil
+	df.ackAndRecord(ctx, pendingAcks)
 	return nil
 }
 
+// commitOnShutdown tries to commit the staged messages before shutting down, the messages not committed are
+// redelivered after a restart.
+func (df *DataForward) commitOnShutdown() {
+	cw, ok := df.sinkWriter.(sinker.CommittingWriter)
+	if !ok || len(df.pendingAcks) == 0 {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+	defer cancel()
+	if err := df.commitAndAckPending(ctx, cw); err != nil {
+		df.opts.logger.Errorw("Failed to commit the staged messages on shutdown", zap.Error(err))
+	}
+}
+
+// checkpointWriter writes the messages with the checkpoint of the batch they belong to.
+type checkpointWriter struct {
+	sinker.TransactionalWriter
+	checkpoint int64
+}
+
+func (w checkpointWriter) Write(ctx context.Context, messages []isb.Message) ([]isb.Offset, []error) {
+	return w.WriteWithCheckpoint(ctx, messages, w.checkpoint)
+}
+
+// prepareTransactionalWrite skips the messages at or before the committed checkpoint, which were written before
+// a restart but not acked, and returns a sink writer committing the max sequence of the batch as the checkpoint.
+func (df *DataForward) prepareTransactionalWrite(tw sinker.TransactionalWriter, dataMessages []*isb.ReadMessage, readOffsets []isb.Offset) ([]isb.Message, sinker.SinkWriter, error) {
+	committed := tw.Checkpoint()
+	checkpoint := committed
+	for _, o := range readOffsets {
+		seq, err := o.Sequence()
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to get the sequence of offset %s, %w", o.String(), err)
+		}
+		checkpoint = max(checkpoint, seq)
+	}
+	writeMessages := make([]isb.Message, 0, len(dataMessages))
+	skipped := 0
+	for _, m := range dataMessages {
+		// the error has been checked above
+		seq, _ := m.ReadOffset.Sequence()
+		if seq <= committed {
+			skipped++
+			continue
+		}
+		writeMessages = append(writeMessages, m.Message)
+	}
+	if skipped > 0 {
+		df.opts.logger.Infow("Skipped the messages already written to the sink", zap.Int("count", skipped), zap.Int64("checkpoint", committed))
+	}
+	return writeMessages, checkpointWriter{TransactionalWriter: tw, checkpoint: checkpoint}, nil
+}
+
 // ackFromBuffer acknowledges an array of offsets back to fromBufferPartition and is a blocking call or until shutdown has been initiated.
 func (df *DataForward) ackFromBuffer(ctx context.Context, offsets []isb.Offset) error {
 	var ackRetryBackOff = wait.Backoff{
@@ -381,6 +508,10 @@ func (df *DataForward) writeToSink(ctx context.Context, sinkWriter sinker.SinkWr
 		writeCount       int
 		writeBytes       float64
 		fallbackMessages []isb.Message
+		lastWriteErr     error
+		// messages failed with non-retryable errors
+		nonRetryableMessages []isb.Message
+		nonRetryableErr      error
 	)
 	writeStart := time.Now()
 	// slice to store the successful offsets returned by the sink
@@ -393,7 +524,7 @@ func (df *DataForward) writeToSink(ctx context.Context, sinkWriter sinker.SinkWr
 	// The loop will continue trying to write messages until they are all processed
 	// or an unrecoverable error occurs.
 	for {
-		err = wait.ExponentialBackoffWithContext(ctx, backoffCond, func(_ context.Context) (done bool, err error) {
+		err = retryWithBackoff(ctx, backoffCond, func(_ context.Context) (done bool, err error) {
 			// Note: this is an unwanted memory allocation during a happy path. We want only minimal allocation
 			// since using failedMessages is an unlikely path.
 			var failedMessages []isb.Message
@@ -407,6 +538,13 @@ func (df *DataForward) writeToSink(ctx context.Context, sinkWriter sinker.SinkWr
 							return false, err
 						}
 					}
+					// the messages can never be written, no need to retry them
+					if errors.As(err, &sinker.NonRetryableWriteErr{}) {
+						nonRetryableMessages = append(nonRetryableMessages, msg)
+						nonRetryableErr = err
+						df.incrementErrorMetric(sinkWriter.GetName(), isFbSinkWriter)
+						continue
+					}
 					// if we are asked to write to fallback sink, check if the fallback sink is configured,
 					// and we are not already in the fallback sink write path.
 					if errors.Is(err, udsink.WriteToFallbackErr) && df.opts.fbSinkWriter != nil && !isFbSinkWriter {
@@ -425,6 +563,7 @@ func (df *DataForward) writeToSink(ctx context.Context, sinkWriter sinker.SinkWr
 					}
 
 					needRetry = true
+					lastWriteErr = err
 
 					// TODO(Retry-Sink) : Propagate the retry-count?
 					// we retry only failed message
@@ -467,20 +606,59 @@ func (df *DataForward) writeToSink(ctx context.Context, sinkWriter sinker.SinkWr
 		}
 		// Check what actions are required once the writing loop is completed
 		// Break if no further action is required
-		if !df.handlePostRetryFailures(&messagesToTry, failStrategy, &fallbackMessages, sinkWriter) {
+		retry, err := df.handlePostRetryFailures(ctx, &messagesToTry, failStrategy, &fallbackMessages, sinkWriter, backoffCond.Steps, lastWriteErr)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !retry {
 			break
 		}
 	}
+	if len(nonRetryableMessages) > 0 {
+		if err := df.handleNonRetryableFailures(ctx, nonRetryableMessages, failStrategy, &fallbackMessages, sinkWriter, isFbSinkWriter, nonRetryableErr); err != nil {
+			return nil, nil, err
+		}
+	}
 	// update the write metrics for sink
 	df.updateSinkWriteMetrics(writeCount, writeBytes, sinkWriter.GetName(), isFbSinkWriter, writeStart)
 
 	return writeOffsets, fallbackMessages, nil
 }
 
+// handleNonRetryableFailures deals with the messages failed with non-retryable errors, since retrying them never
+// succeeds. Unless the OnFailure strategy is drop, they are sent to the fallback sink or the dead-letter buffer,
+// preferring the one of the strategy if both are configured, otherwise they are dropped.
+func (df *DataForward) handleNonRetryableFailures(ctx context.Context, messages []isb.Message, failStrategy dfv1.OnFailureRetryStrategy, fallbackMessages *[]isb.Message,
+	sinkWriter sinker.SinkWriter, isFbSinkWriter bool, lastErr error) error {
+	df.opts.logger.Errorw("Messages failed with non-retryable errors in sink", zap.Int("count", len(messages)), zap.String("strategy", string(failStrategy)), zap.Error(lastErr))
+	canFallback := df.opts.fbSinkWriter != nil && !isFbSinkWriter
+	switch {
+	case failStrategy == dfv1.OnFailureDeadLetter && df.opts.deadLetterWriter != nil:
+		return df.writeToDeadLetter(ctx, messages, 0, lastErr)
+	case failStrategy != dfv1.OnFailureDrop && canFallback:
+		*fallbackMessages = append(*fallbackMessages, messages...)
+		return nil
+	case failStrategy != dfv1.OnFailureDrop && df.opts.deadLetterWriter != nil:
+		return df.writeToDeadLetter(ctx, messages, 0, lastErr)
+	}
+	for _, msg := range messages {
+		df.opts.logger.Warnw("Dropping the message failed with a non-retryable error in sink", zap.String("id", msg.ID.String()), zap.Error(lastErr))
+	}
+	metrics.DropMessagesCount.With(map[string]string{
+		metrics.LabelVertex:             df.vertexName,
+		metrics.LabelPipeline:           df.pipelineName,
+		metrics.LabelVertexType:         string(dfv1.VertexTypeSink),
+		metrics.LabelVertexReplicaIndex: strconv.Itoa(int(df.vertexReplica)),
+		metrics.LabelPartitionName:      sinkWriter.GetName(),
+		metrics.LabelReason:             "non-retryable error in the Sink",
+	}).Add(float64(len(messages)))
+	return nil
+}
+
 // handlePostRetryFailures deals with the scenarios after retries are exhausted.
 // It returns true if we need to continue retrying else returns false when no further writes are required
-func (df *DataForward) handlePostRetryFailures(messagesToTry *[]isb.Message, failStrategy dfv1.OnFailureRetryStrategy, fallbackMessages *[]isb.Message,
-	sinkWriter sinker.SinkWriter) bool {
+func (df *DataForward) handlePostRetryFailures(ctx context.Context, messagesToTry *[]isb.Message, failStrategy dfv1.OnFailureRetryStrategy, fallbackMessages *[]isb.Message,
+	sinkWriter sinker.SinkWriter, retries int, lastErr error) (bool, error) {
 
 	// Check if we still have messages left to be processed
 	if len(*messagesToTry) > 0 {
@@ -492,7 +670,7 @@ func (df *DataForward) handlePostRetryFailures(messagesToTry *[]isb.Message, fai
 		switch failStrategy {
 		case dfv1.OnFailureRetry:
 			// If on failure, we keep on retrying then lets continue the loop and try all again
-			return true
+			return true, nil
 		case dfv1.OnFailureFallback:
 			// If onFail we have to divert messages to fallback, lets add all failed messages to fallback slice
 			*fallbackMessages = append(*fallbackMessages, *messagesToTry...)
@@ -508,9 +686,43 @@ func (df *DataForward) handlePostRetryFailures(messagesToTry *[]isb.Message, fai
 				metrics.LabelPartitionName:      sinkWriter.GetName(),
 				metrics.LabelReason:             "retries exhausted in the Sink",
 			}).Add(float64(len(*messagesToTry)))
+		case dfv1.OnFailureDeadLetter:
+			// If no dead-letter buffer is available, there is nowhere to park the messages, so keep on retrying
+			if df.opts.deadLetterWriter == nil {
+				df.opts.logger.Error("Asked to write to the dead-letter buffer but no dead-letter buffer is configured, retrying the messages")
+				return true, nil
+			}
+			if err := df.writeToDeadLetter(ctx, *messagesToTry, retries, lastErr); err != nil {
+				return false, err
+			}
 		}
 	}
-	return false
+	return false, nil
+}
+
+// writeToDeadLetter writes the messages which failed to be written to the sink to the dead-letter buffer along
+// with the error metadata. It is a blocking call until all the messages are written, or a shutdown has been
+// initiated.
+func (df *DataForward) writeToDeadLetter(ctx context.Context, messages []isb.Message, retries int, lastErr error) error {
+	deadLetterMessages := make([]isb.Message, 0, len(messages))
+	for _, msg := range messages {
+		deadLetterMessages = append(deadLetterMessages, deadletter.NewMessage(msg, df.vertexName, df.fromBufferPartition.GetPartitionIdx(), retries, lastErr))
+	}
+	shouldStop := func() bool {
+		ok, _ := df.IsShuttingDown()
+		return ok
+	}
+	if err := deadletter.Write(ctx, df.opts.deadLetterWriter, deadLetterMessages, dfv1.DefaultRetryInterval, shouldStop); err != nil {
+		return fmt.Errorf("writeToDeadLetter failed, %w", err)
+	}
+	metrics.DeadLetterMessagesCount.With(map[string]string{
+		metrics.LabelVertex:             df.vertexName,
+		metrics.LabelPipeline:           df.pipelineName,
+		metrics.LabelVertexType:         string(dfv1.VertexTypeSink),
+		metrics.LabelVertexReplicaIndex: strconv.Itoa(int(df.vertexReplica)),
+		metrics.LabelPartitionName:      df.opts.deadLetterWriter.GetName(),
+	}).Add(float64(len(deadLetterMessages)))
+	return nil
 }
 
 // updateSinkWriteMetrics updates metrics related to data writes to a sink.
@@ -571,6 +783,40 @@ func errorArrayToMap(errs []error) map[string]int64 {
 	return result
 }
 
+// retryWithBackoff runs the condition until it is done, returns an error, or the steps of the backoff are exhausted.
+// Unlike wait.ExponentialBackoffWithContext, reaching the Cap of the backoff does not end the retries, the delay
+// stays at the Cap for the remaining steps. Exhausting the steps is not an error, the caller decides what to do
+// with the messages which are left based on the OnFailure strategy.
+func retryWithBackoff(ctx context.Context, backoff wait.Backoff, condition wait.ConditionWithContextFunc) error {
+	for ; backoff.Steps > 0; backoff.Steps-- {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if ok, err := condition(ctx); err != nil || ok {
+			return err
+		}
+		if backoff.Steps == 1 {
+			break
+		}
+		delay := backoff.Duration
+		if backoff.Factor > 0 {
+			backoff.Duration = time.Duration(float64(backoff.Duration) * backoff.Factor)
+		}
+		if backoff.Cap > 0 && backoff.Duration > backoff.Cap {
+			backoff.Duration = backoff.Cap
+		}
+		if backoff.Jitter > 0 {
+			delay = wait.Jitter(delay, backoff.Jitter)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+	return nil
+}
+
 // getBackOffConditions configures the retry backoff strategy based on whether its a fallbackSink or primary sink.
 func (df *DataForward) getBackOffConditions(isFallbackSink bool) (wait.Backoff, dfv1.OnFailureRetryStrategy) {
 	// If we want for isFallbackSink we will return an infinite retry which will keep retrying post exhaustion till it succeeds
@@ -28,6 +28,9 @@ import (
 	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/goleak"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/utils/ptr"
 
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/numaproj/numaflow/pkg/isb"
@@ -35,6 +38,7 @@ import (
 	"github.com/numaproj/numaflow/pkg/isb/testutils"
 	"github.com/numaproj/numaflow/pkg/metrics"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
+	"github.com/numaproj/numaflow/pkg/sinks/sinker"
 	"github.com/numaproj/numaflow/pkg/watermark/generic"
 	"github.com/numaproj/numaflow/pkg/watermark/publish"
 	"github.com/numaproj/numaflow/pkg/watermark/wmb"
@@ -356,6 +360,313 @@ func TestWriteToBuffer(t *testing.T) {
 	}
 }
 
+func TestWriteToSinkDeadLetter(t *testing.T) {
+	fromStep := simplebuffer.NewInMemoryBuffer("from", 10, 0)
+	sink := simplebuffer.NewInMemoryBuffer("to1", 1, 0, simplebuffer.WithBufferFullWritingStrategy(dfv1.RetryUntilSuccess))
+	dlq := simplebuffer.NewInMemoryBuffer("dlq", 10, 0)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	onFailure := dfv1.OnFailureDeadLetter
+	vertexInstance := &dfv1.VertexInstance{
+		Vertex: &dfv1.Vertex{Spec: dfv1.VertexSpec{
+			PipelineName: "testPipeline",
+			AbstractVertex: dfv1.AbstractVertex{
+				Name: "to1",
+				Sink: &dfv1.Sink{RetryStrategy: dfv1.RetryStrategy{
+					BackOff:   &dfv1.Backoff{Interval: &metav1.Duration{Duration: time.Millisecond}, Steps: ptr.To[uint32](2)},
+					OnFailure: &onFailure,
+				}},
+			},
+		}},
+		Replica: 0,
+	}
+	idleManager, _ := wmb.NewIdleManager(1, 1)
+	f, err := NewDataForward(vertexInstance, fromStep, sink, &testForwardFetcher{}, &testForwarderPublisher{}, idleManager, WithDeadLetterWriter(dlq))
+	assert.NoError(t, err)
+
+	// the sink can only take one message, the other one should be dead-lettered after the retries are exhausted.
+	writeMessages := testutils.BuildTestWriteMessages(2, testStartTime, nil, "testVertex")
+	_, fallbackMessages, err := f.writeToSink(ctx, sink, writeMessages, false)
+	assert.NoError(t, err)
+	assert.Empty(t, fallbackMessages)
+	assert.True(t, sink.IsFull())
+
+	readMessages, err := dlq.Read(ctx, 2)
+	assert.NoError(t, err)
+	assert.Len(t, readMessages, 1)
+	assert.Equal(t, writeMessages[1].Payload, readMessages[0].Payload)
+	assert.Equal(t, "to1", readMessages[0].Headers[dfv1.KeyMetaDeadLetterVertex])
+	assert.Equal(t, "2", readMessages[0].Headers[dfv1.KeyMetaDeadLetterRetries])
+	assert.NotEmpty(t, readMessages[0].Headers[dfv1.KeyMetaDeadLetterError])
+}
+
+// nonRetryableSink rejects the messages of the given payload with non-retryable errors.
+type nonRetryableSink struct {
+	*simplebuffer.InMemoryBuffer
+	rejectPayload string
+	rejected      int
+}
+
+func (s *nonRetryableSink) Write(ctx context.Context, messages []isb.Message) ([]isb.Offset, []error) {
+	var accepted []isb.Message
+	var acceptedIdx []int
+	errs := make([]error, len(messages))
+	for i, msg := range messages {
+		if string(msg.Payload) == s.rejectPayload {
+			s.rejected++
+			errs[i] = sinker.NonRetryableWriteErr{Message: "too large"}
+			continue
+		}
+		accepted = append(accepted, msg)
+		acceptedIdx = append(acceptedIdx, i)
+	}
+	offsets := make([]isb.Offset, len(messages))
+	_offsets, _errs := s.InMemoryBuffer.Write(ctx, accepted)
+	for i, idx := range acceptedIdx {
+		offsets[idx] = _offsets[i]
+		errs[idx] = _errs[i]
+	}
+	return offsets, errs
+}
+
+func TestWriteToSinkNonRetryable(t *testing.T) {
+	for _, tc := range []struct {
+		name         string
+		onFailure    dfv1.OnFailureRetryStrategy
+		fallback     bool
+		deadLetter   bool
+		wantFallback int
+		wantDLQ      int
+	}{
+		{name: "retry", onFailure: dfv1.OnFailureRetry},
+		{name: "retry with fallback", onFailure: dfv1.OnFailureRetry, fallback: true, wantFallback: 1},
+		{name: "retry with dead-letter", onFailure: dfv1.OnFailureRetry, deadLetter: true, wantDLQ: 1},
+		{name: "fallback", onFailure: dfv1.OnFailureFallback, fallback: true, wantFallback: 1},
+		{name: "dead-letter with fallback", onFailure: dfv1.OnFailureDeadLetter, fallback: true, deadLetter: true, wantDLQ: 1},
+		{name: "drop with fallback", onFailure: dfv1.OnFailureDrop, fallback: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			fromStep := simplebuffer.NewInMemoryBuffer("from", 10, 0)
+			sink := &nonRetryableSink{InMemoryBuffer: simplebuffer.NewInMemoryBuffer("to1", 10, 0)}
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+			defer cancel()
+
+			onFailure := tc.onFailure
+			vertexInstance := &dfv1.VertexInstance{
+				Vertex: &dfv1.Vertex{Spec: dfv1.VertexSpec{
+					PipelineName: "testPipeline",
+					AbstractVertex: dfv1.AbstractVertex{
+						Name: "to1",
+						Sink: &dfv1.Sink{RetryStrategy: dfv1.RetryStrategy{
+							BackOff:   &dfv1.Backoff{Interval: &metav1.Duration{Duration: time.Millisecond}, Steps: ptr.To[uint32](5)},
+							OnFailure: &onFailure,
+						}},
+					},
+				}},
+				Replica: 0,
+			}
+			var opts []Option
+			if tc.fallback {
+				opts = append(opts, WithFbSinkWriter(simplebuffer.NewInMemoryBuffer("fallback", 10, 0)))
+			}
+			dlq := simplebuffer.NewInMemoryBuffer("dlq", 10, 0)
+			if tc.deadLetter {
+				opts = append(opts, WithDeadLetterWriter(dlq))
+			}
+			idleManager, _ := wmb.NewIdleManager(1, 1)
+			f, err := NewDataForward(vertexInstance, fromStep, sink, &testForwardFetcher{}, &testForwarderPublisher{}, idleManager, opts...)
+			assert.NoError(t, err)
+
+			writeMessages := testutils.BuildTestWriteMessages(3, testStartTime, nil, "testVertex")
+			sink.rejectPayload = string(writeMessages[1].Payload)
+			_, fallbackMessages, err := f.writeToSink(ctx, sink, writeMessages, false)
+			assert.NoError(t, err)
+			// the rejected message is never retried
+			assert.Equal(t, 1, sink.rejected)
+			assert.Len(t, fallbackMessages, tc.wantFallback)
+			assert.Len(t, dlq.GetMessages(3), tc.wantDLQ)
+
+			readMessages, err := sink.Read(ctx, 3)
+			assert.NoError(t, err)
+			assert.Len(t, readMessages, 2)
+		})
+	}
+}
+
+// transactionalSink commits the checkpoint along with the messages written to the in-memory buffer.
+type transactionalSink struct {
+	*simplebuffer.InMemoryBuffer
+	checkpoint int64
+}
+
+func (s *transactionalSink) Checkpoint() int64 {
+	return s.checkpoint
+}
+
+func (s *transactionalSink) WriteWithCheckpoint(ctx context.Context, messages []isb.Message, checkpoint int64) ([]isb.Offset, []error) {
+	offsets, errs := s.InMemoryBuffer.Write(ctx, messages)
+	s.checkpoint = checkpoint
+	return offsets, errs
+}
+
+func TestForwardAChunkTransactional(t *testing.T) {
+	fromStep := simplebuffer.NewInMemoryBuffer("from", 10, 0)
+	sink := &transactionalSink{InMemoryBuffer: simplebuffer.NewInMemoryBuffer("to1", 10, 0), checkpoint: 1}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	vertexInstance := &dfv1.VertexInstance{
+		Vertex: &dfv1.Vertex{Spec: dfv1.VertexSpec{
+			PipelineName: "testPipeline",
+			AbstractVertex: dfv1.AbstractVertex{
+				Name: "to1",
+				Sink: &dfv1.Sink{},
+			},
+		}},
+		Replica: 0,
+	}
+	idleManager, _ := wmb.NewIdleManager(1, 1)
+	f, err := NewDataForward(vertexInstance, fromStep, sink, &testForwardFetcher{}, &testForwarderPublisher{}, idleManager, WithReadBatchSize(5))
+	assert.NoError(t, err)
+
+	writeMessages := testutils.BuildTestWriteMessages(5, testStartTime, nil, "testVertex")
+	_, errs := fromStep.Write(ctx, writeMessages)
+	for _, err := range errs {
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, f.forwardAChunk(ctx))
+
+	// the messages at or before the checkpoint were written before, they are acked without writing.
+	assert.Equal(t, int64(4), sink.Checkpoint())
+	readMessages, err := sink.Read(ctx, 5)
+	assert.NoError(t, err)
+	assert.Len(t, readMessages, 3)
+	assert.Equal(t, writeMessages[2].Payload, readMessages[0].Payload)
+	assert.True(t, fromStep.IsEmpty())
+}
+
+// committingSink stages the messages, and writes them to the in-memory buffer once the count reaches commitAt.
+// The first commitFailures commits fail.
+type committingSink struct {
+	*simplebuffer.InMemoryBuffer
+	staged         []isb.Message
+	commitAt       int
+	commitFailures int
+	commits        int
+}
+
+func (s *committingSink) Write(_ context.Context, messages []isb.Message) ([]isb.Offset, []error) {
+	s.staged = append(s.staged, messages...)
+	return nil, make([]error, len(messages))
+}
+
+func (s *committingSink) ShouldCommit() bool {
+	return len(s.staged) >= s.commitAt
+}
+
+func (s *committingSink) Commit(ctx context.Context) error {
+	s.commits++
+	if s.commits <= s.commitFailures {
+		return fmt.Errorf("commit failure %d", s.commits)
+	}
+	_, errs := s.InMemoryBuffer.Write(ctx, s.staged)
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	s.staged = nil
+	return nil
+}
+
+// ackCountingBuffer counts the offsets acked.
+type ackCountingBuffer struct {
+	*simplebuffer.InMemoryBuffer
+	acked int
+}
+
+func (b *ackCountingBuffer) Ack(ctx context.Context, offsets []isb.Offset) []error {
+	b.acked += len(offsets)
+	return b.InMemoryBuffer.Ack(ctx, offsets)
+}
+
+func TestForwardAChunkCommitting(t *testing.T) {
+	fromStep := &ackCountingBuffer{InMemoryBuffer: simplebuffer.NewInMemoryBuffer("from", 10, 0)}
+	sink := &committingSink{InMemoryBuffer: simplebuffer.NewInMemoryBuffer("to1", 10, 0), commitAt: 5}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	vertexInstance := &dfv1.VertexInstance{
+		Vertex: &dfv1.Vertex{Spec: dfv1.VertexSpec{
+			PipelineName: "testPipeline",
+			AbstractVertex: dfv1.AbstractVertex{
+				Name: "to1",
+				Sink: &dfv1.Sink{},
+			},
+		}},
+		Replica: 0,
+	}
+	idleManager, _ := wmb.NewIdleManager(1, 1)
+	f, err := NewDataForward(vertexInstance, fromStep, sink, &testForwardFetcher{}, &testForwarderPublisher{}, idleManager, WithReadBatchSize(3))
+	assert.NoError(t, err)
+
+	writeMessages := testutils.BuildTestWriteMessages(5, testStartTime, nil, "testVertex")
+	_, errs := fromStep.Write(ctx, writeMessages)
+	for _, err := range errs {
+		assert.NoError(t, err)
+	}
+
+	// the staged messages are not acked before they are committed.
+	assert.NoError(t, f.forwardAChunk(ctx))
+	assert.Equal(t, 0, fromStep.acked)
+	assert.Len(t, sink.staged, 3)
+
+	assert.NoError(t, f.forwardAChunk(ctx))
+	assert.Equal(t, 5, fromStep.acked)
+	assert.Empty(t, sink.staged)
+	readMessages, err := sink.Read(ctx, 5)
+	assert.NoError(t, err)
+	assert.Len(t, readMessages, 5)
+}
+
+func TestForwardAChunkCommittingRetry(t *testing.T) {
+	// the delay reaches the cap quickly, which must not end the retries.
+	defer func(b wait.Backoff) { commitRetryBackoff = b }(commitRetryBackoff)
+	commitRetryBackoff.Duration = time.Millisecond
+	commitRetryBackoff.Cap = time.Millisecond * 2
+
+	fromStep := &ackCountingBuffer{InMemoryBuffer: simplebuffer.NewInMemoryBuffer("from", 10, 0)}
+	sink := &committingSink{InMemoryBuffer: simplebuffer.NewInMemoryBuffer("to1", 10, 0), commitAt: 3, commitFailures: 20}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	vertexInstance := &dfv1.VertexInstance{
+		Vertex: &dfv1.Vertex{Spec: dfv1.VertexSpec{
+			PipelineName: "testPipeline",
+			AbstractVertex: dfv1.AbstractVertex{
+				Name: "to1",
+				Sink: &dfv1.Sink{},
+			},
+		}},
+		Replica: 0,
+	}
+	idleManager, _ := wmb.NewIdleManager(1, 1)
+	f, err := NewDataForward(vertexInstance, fromStep, sink, &testForwardFetcher{}, &testForwarderPublisher{}, idleManager, WithReadBatchSize(3))
+	assert.NoError(t, err)
+
+	writeMessages := testutils.BuildTestWriteMessages(3, testStartTime, nil, "testVertex")
+	_, errs := fromStep.Write(ctx, writeMessages)
+	for _, err := range errs {
+		assert.NoError(t, err)
+	}
+
+	assert.NoError(t, f.forwardAChunk(ctx))
+	assert.Equal(t, 21, sink.commits)
+	assert.Equal(t, 3, fromStep.acked)
+	assert.Empty(t, sink.staged)
+}
+
 func validateMetrics(batchSize int64) (err error) {
 	metadata := `
 		# HELP forwarder_data_read_total Total number of Data Messages Read
@@ -403,3 +714,52 @@ func metricsReset() {
 	metrics.WriteMessagesCount.Reset()
 	metrics.AckMessagesCount.Reset()
 }
+
+func Test_retryWithBackoff(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	t.Run("cap does not end the retries", func(t *testing.T) {
+		var attempts []time.Time
+		backoff := wait.Backoff{Duration: 10 * time.Millisecond, Steps: 5, Factor: 2, Cap: 20 * time.Millisecond}
+		err := retryWithBackoff(ctx, backoff, func(context.Context) (bool, error) {
+			attempts = append(attempts, time.Now())
+			return false, nil
+		})
+		// exhausting the steps is not an error
+		assert.NoError(t, err)
+		assert.Len(t, attempts, 5)
+		// the delays are 10ms, 20ms, 20ms, 20ms
+		assert.GreaterOrEqual(t, attempts[1].Sub(attempts[0]), 10*time.Millisecond)
+		assert.GreaterOrEqual(t, attempts[4].Sub(attempts[0]), 70*time.Millisecond)
+	})
+
+	t.Run("stop when done", func(t *testing.T) {
+		count := 0
+		err := retryWithBackoff(ctx, wait.Backoff{Duration: time.Millisecond, Steps: 10, Factor: 1.5, Jitter: 0.5}, func(context.Context) (bool, error) {
+			count++
+			return count == 3, nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 3, count)
+	})
+
+	t.Run("stop on error", func(t *testing.T) {
+		count := 0
+		err := retryWithBackoff(ctx, wait.Backoff{Duration: time.Millisecond, Steps: 10}, func(context.Context) (bool, error) {
+			count++
+			return false, fmt.Errorf("internal error")
+		})
+		assert.EqualError(t, err, "internal error")
+		assert.Equal(t, 1, count)
+	})
+
+	t.Run("stop on cancellation", func(t *testing.T) {
+		cctx, ccancel := context.WithCancel(ctx)
+		ccancel()
+		err := retryWithBackoff(cctx, wait.Backoff{Duration: time.Millisecond, Steps: 10}, func(context.Context) (bool, error) {
+			return false, nil
+		})
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
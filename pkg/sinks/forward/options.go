@@ -20,6 +20,7 @@ import (
 	"go.uber.org/zap"
 
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
 	"github.com/numaproj/numaflow/pkg/shared/callback"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
 	"github.com/numaproj/numaflow/pkg/sinks/sinker"
@@ -37,6 +38,8 @@ type options struct {
 	logger *zap.SugaredLogger
 	// cbPublisher is the callback publisher for the vertex.
 	cbPublisher *callback.Uploader
+	// deadLetterWriter is the writer for the dead-letter buffer of the vertex
+	deadLetterWriter isb.BufferWriter
 }
 
 type Option func(*options) error
@@ -88,3 +91,11 @@ func WithCallbackUploader(cp *callback.Uploader) Option {
 		return nil
 	}
 }
+
+// WithDeadLetterWriter sets the writer for the dead-letter buffer, used when the OnFailure strategy is deadletter
+func WithDeadLetterWriter(writer isb.BufferWriter) Option {
+	return func(o *options) error {
+		o.deadLetterWriter = writer
+		return nil
+	}
+}
@@ -0,0 +1,364 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	"go.uber.org/zap"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/metrics"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+	sharedutil "github.com/numaproj/numaflow/pkg/shared/util"
+	"github.com/numaproj/numaflow/pkg/sinks/sinker"
+)
+
+// maxErrorBodyBytes is the max number of bytes of a response body put in an error.
+const maxErrorBodyBytes = 256
+
+// ToHTTP sends the messages to an HTTP endpoint, one message per request, or in batches of the messages with the same
+// rendered URL and headers.
+type ToHTTP struct {
+	name         string
+	pipelineName string
+	method       string
+	url          *template.Template
+	headers      map[string]*template.Template
+	batch        bool
+	maxMessages  int
+	format       dfv1.HTTPSinkBatchFormat
+	concurrency  int
+	user         string
+	password     string
+	bearerToken  string
+	client       *http.Client
+	log          *zap.SugaredLogger
+}
+
+// messageData is the data to render the URL and header templates with.
+type messageData struct {
+	ID        string
+	Keys      []string
+	Headers   map[string]string
+	EventTime time.Time
+}
+
+// request is a request to send, with the indexes of its messages in the written messages.
+type request struct {
+	url     string
+	headers map[string]string
+	indexes []int
+}
+
+// batchResponse is the body of a 207 Multi-Status response of a batch request.
+type batchResponse struct {
+	Results []struct {
+		StatusCode int    `json:"statusCode"`
+		Error      string `json:"error,omitempty"`
+	} `json:"results"`
+}
+
+// NewToHTTP returns ToHTTP type.
+func NewToHTTP(ctx context.Context, vertexInstance *dfv1.VertexInstance, httpSink *dfv1.HTTPSink, opts ...Option) (*ToHTTP, error) {
+	toHTTP := &ToHTTP{
+		name:         vertexInstance.Vertex.Spec.Name,
+		pipelineName: vertexInstance.Vertex.Spec.PipelineName,
+		method:       httpSink.GetMethod(),
+		headers:      make(map[string]*template.Template),
+		concurrency:  httpSink.GetConcurrency(),
+		log:          logging.FromContext(ctx).With("sinkType", "http"),
+	}
+	var err error
+	if toHTTP.url, err = template.New("url").Option("missingkey=error").Parse(httpSink.URL); err != nil {
+		return nil, fmt.Errorf("failed to parse the url template, %w", err)
+	}
+	for k, v := range httpSink.Headers {
+		if toHTTP.headers[k], err = template.New(k).Option("missingkey=error").Parse(v); err != nil {
+			return nil, fmt.Errorf("failed to parse the template of header %q, %w", k, err)
+		}
+	}
+	if b := httpSink.Batch; b != nil {
+		toHTTP.batch = true
+		toHTTP.maxMessages = b.GetMaxMessages()
+		toHTTP.format = b.GetFormat()
+	}
+	if a := httpSink.BasicAuth; a != nil {
+		if toHTTP.user, err = sharedutil.GetSecretFromVolume(a.User); err != nil {
+			return nil, fmt.Errorf("failed to get the basic auth user, %w", err)
+		}
+		if toHTTP.password, err = sharedutil.GetSecretFromVolume(a.Password); err != nil {
+			return nil, fmt.Errorf("failed to get the basic auth password, %w", err)
+		}
+	}
+	if httpSink.BearerTokenSecret != nil {
+		if toHTTP.bearerToken, err = sharedutil.GetSecretFromVolume(httpSink.BearerTokenSecret); err != nil {
+			return nil, fmt.Errorf("failed to get the bearer token, %w", err)
+		}
+	}
+	var tlsConfig *tls.Config
+	if httpSink.TLS != nil {
+		if tlsConfig, err = sharedutil.GetTLSConfig(httpSink.TLS); err != nil {
+			return nil, fmt.Errorf("failed to get the tls config, %w", err)
+		}
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+	transport.MaxIdleConnsPerHost = toHTTP.concurrency
+	toHTTP.client = &http.Client{Transport: transport, Timeout: httpSink.GetTimeout()}
+	for _, o := range opts {
+		if err := o(toHTTP); err != nil {
+			return nil, err
+		}
+	}
+	return toHTTP, nil
+}
+
+// GetName returns the name.
+func (th *ToHTTP) GetName() string {
+	return th.name
+}
+
+// GetPartitionIdx returns the partition index.
+// for sink it is always 0.
+func (th *ToHTTP) GetPartitionIdx() int32 {
+	return 0
+}
+
+// Write sends the messages, the error of each message is mapped from the status code of its response.
+func (th *ToHTTP) Write(ctx context.Context, messages []isb.Message) ([]isb.Offset, []error) {
+	errs := make([]error, len(messages))
+	requests := th.buildRequests(messages, errs)
+	labels := map[string]string{metrics.LabelVertex: th.name, metrics.LabelPipeline: th.pipelineName}
+	sem := make(chan struct{}, th.concurrency)
+	var wg sync.WaitGroup
+	for _, r := range requests {
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(r request) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			// each request writes the errors of its own messages
+			reqErrs := th.send(ctx, messages, r)
+			for i, idx := range r.indexes {
+				errs[idx] = reqErrs[i]
+			}
+			httpSinkRequests.With(labels).Inc()
+		}(r)
+	}
+	wg.Wait()
+	return nil, errs
+}
+
+// buildRequests renders the URL and headers of the messages and groups them into requests. A message failing to
+// render gets a non-retryable error, rendering it again would fail the same way.
+func (th *ToHTTP) buildRequests(messages []isb.Message, errs []error) []request {
+	var requests []request
+	// open is the index in requests of the batch being filled, by the rendered URL and headers
+	open := make(map[string]int)
+	for i, msg := range messages {
+		url, headers, err := th.render(msg)
+		if err != nil {
+			errs[i] = sinker.NonRetryableWriteErr{Message: err.Error()}
+			continue
+		}
+		if !th.batch {
+			requests = append(requests, request{url: url, headers: headers, indexes: []int{i}})
+			continue
+		}
+		key := requestKey(url, headers)
+		if j, ok := open[key]; ok && len(requests[j].indexes) < th.maxMessages {
+			requests[j].indexes = append(requests[j].indexes, i)
+			continue
+		}
+		open[key] = len(requests)
+		requests = append(requests, request{url: url, headers: headers, indexes: []int{i}})
+	}
+	return requests
+}
+
+// render renders the URL and header templates with a message.
+func (th *ToHTTP) render(msg isb.Message) (string, map[string]string, error) {
+	data := messageData{
+		ID:        msg.ID.String(),
+		Keys:      msg.Keys,
+		Headers:   msg.Headers,
+		EventTime: msg.EventTime,
+	}
+	var buf strings.Builder
+	if err := th.url.Execute(&buf, data); err != nil {
+		return "", nil, fmt.Errorf("failed to render the url, %w", err)
+	}
+	url := buf.String()
+	headers := make(map[string]string, len(th.headers))
+	for k, t := range th.headers {
+		buf.Reset()
+		if err := t.Execute(&buf, data); err != nil {
+			return "", nil, fmt.Errorf("failed to render header %q, %w", k, err)
+		}
+		headers[k] = buf.String()
+	}
+	return url, headers, nil
+}
+
+// requestKey returns the key to group the messages with the same URL and headers by.
+func requestKey(url string, headers map[string]string) string {
+	names := make([]string, 0, len(headers))
+	for k := range headers {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	var b strings.Builder
+	b.WriteString(url)
+	for _, k := range names {
+		b.WriteString("\n")
+		b.WriteString(k)
+		b.WriteString(":")
+		b.WriteString(headers[k])
+	}
+	return b.String()
+}
+
+// send sends a request and returns the errors of its messages.
+func (th *ToHTTP) send(ctx context.Context, messages []isb.Message, r request) []error {
+	errs := make([]error, len(r.indexes))
+	fill := func(err error) []error {
+		for i := range errs {
+			errs[i] = err
+		}
+		return errs
+	}
+	body, contentType, err := th.body(messages, r.indexes)
+	if err != nil {
+		return fill(sinker.NonRetryableWriteErr{Message: err.Error()})
+	}
+	req, err := http.NewRequestWithContext(ctx, th.method, r.url, bytes.NewReader(body))
+	if err != nil {
+		return fill(sinker.NonRetryableWriteErr{Message: fmt.Sprintf("failed to create the request, %v", err)})
+	}
+	req.Header.Set("Content-Type", contentType)
+	for k, v := range r.headers {
+		req.Header.Set(k, v)
+	}
+	if th.user != "" || th.password != "" {
+		req.SetBasicAuth(th.user, th.password)
+	}
+	if th.bearerToken != "" {
+		req.Header.Set("Authorization", "Bearer "+th.bearerToken)
+	}
+	resp, err := th.client.Do(req)
+	if err != nil {
+		return fill(fmt.Errorf("failed to send the request, %w", err))
+	}
+	defer func() { _ = resp.Body.Close() }()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fill(fmt.Errorf("failed to read the response, %w", err))
+	}
+	if th.batch && resp.StatusCode == http.StatusMultiStatus {
+		var br batchResponse
+		if err := json.Unmarshal(respBody, &br); err != nil || len(br.Results) != len(errs) {
+			return fill(fmt.Errorf("invalid multi-status response of %d messages: %s", len(errs), truncate(respBody)))
+		}
+		for i, result := range br.Results {
+			errs[i] = statusError(result.StatusCode, []byte(result.Error))
+		}
+		return errs
+	}
+	return fill(statusError(resp.StatusCode, respBody))
+}
+
+// body returns the body of a request and its content type.
+func (th *ToHTTP) body(messages []isb.Message, indexes []int) ([]byte, string, error) {
+	if !th.batch {
+		return messages[indexes[0]].Payload, "application/octet-stream", nil
+	}
+	var buf bytes.Buffer
+	switch th.format {
+	case dfv1.HTTPSinkBatchFormatNDJSON:
+		for _, idx := range indexes {
+			payload := messages[idx].Payload
+			if bytes.ContainsAny(payload, "\r\n") {
+				return nil, "", fmt.Errorf("payload of message %s contains a new line", messages[idx].ID)
+			}
+			buf.Write(payload)
+			buf.WriteByte('\n')
+		}
+		return buf.Bytes(), "application/x-ndjson", nil
+	default:
+		items := make([]json.RawMessage, len(indexes))
+		for i, idx := range indexes {
+			payload := messages[idx].Payload
+			if json.Valid(payload) {
+				items[i] = payload
+				continue
+			}
+			s, err := json.Marshal(string(payload))
+			if err != nil {
+				return nil, "", err
+			}
+			items[i] = s
+		}
+		data, err := json.Marshal(items)
+		if err != nil {
+			return nil, "", err
+		}
+		return data, "application/json", nil
+	}
+}
+
+// statusError maps a status code to the error of a message. The 2xx are successes, the 408, 429 and 5xx are
+// retryable, the other 4xx are not.
+func statusError(code int, body []byte) error {
+	switch {
+	case code >= 200 && code < 300:
+		return nil
+	case code == http.StatusRequestTimeout || code == http.StatusTooManyRequests || code >= 500:
+		return fmt.Errorf("retryable status code %d: %s", code, truncate(body))
+	case code >= 400:
+		return sinker.NonRetryableWriteErr{Message: fmt.Sprintf("status code %d: %s", code, truncate(body))}
+	default:
+		return fmt.Errorf("unexpected status code %d: %s", code, truncate(body))
+	}
+}
+
+func truncate(body []byte) string {
+	if len(body) > maxErrorBodyBytes {
+		return string(body[:maxErrorBodyBytes]) + "..."
+	}
+	return string(body)
+}
+
+// Close closes the idle connections.
+func (th *ToHTTP) Close() error {
+	th.client.CloseIdleConnections()
+	return nil
+}
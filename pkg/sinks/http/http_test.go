@@ -0,0 +1,229 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/sinks/sinker"
+)
+
+func newTestVertexInstance() *dfv1.VertexInstance {
+	return &dfv1.VertexInstance{
+		Vertex: &dfv1.Vertex{Spec: dfv1.VertexSpec{
+			PipelineName:   "testPipeline",
+			AbstractVertex: dfv1.AbstractVertex{Name: "testVertex"},
+		}},
+	}
+}
+
+func newTestMessage(offset string, keys []string, payload string) isb.Message {
+	return isb.Message{
+		Header: isb.Header{
+			MessageInfo: isb.MessageInfo{EventTime: time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)},
+			ID:          isb.MessageID{VertexName: "in", Offset: offset},
+			Keys:        keys,
+			Headers:     map[string]string{"tenant": "t1"},
+		},
+		Body: isb.Body{Payload: []byte(payload)},
+	}
+}
+
+type received struct {
+	path    string
+	headers http.Header
+	body    string
+}
+
+// recorder records the requests and responds with the status code of the handler.
+type recorder struct {
+	sync.Mutex
+	requests []received
+}
+
+func (r *recorder) server(t *testing.T, status func(req *http.Request, body string) (int, string)) *httptest.Server {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, _ := io.ReadAll(req.Body)
+		r.Lock()
+		r.requests = append(r.requests, received{path: req.URL.Path, headers: req.Header, body: string(body)})
+		r.Unlock()
+		code, resp := status(req, string(body))
+		w.WriteHeader(code)
+		_, _ = w.Write([]byte(resp))
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func TestToHTTP_Single(t *testing.T) {
+	rec := &recorder{}
+	s := rec.server(t, func(req *http.Request, body string) (int, string) {
+		switch body {
+		case "bad":
+			return http.StatusBadRequest, "bad payload"
+		case "busy":
+			return http.StatusServiceUnavailable, "try later"
+		case "slow":
+			return http.StatusTooManyRequests, ""
+		}
+		return http.StatusOK, ""
+	})
+	toHTTP, err := NewToHTTP(context.Background(), newTestVertexInstance(), &dfv1.HTTPSink{
+		URL:     s.URL + "/events/{{ index .Keys 0 }}",
+		Headers: map[string]string{"X-Tenant": "{{ .Headers.tenant }}", "X-Id": "{{ .ID }}"},
+	})
+	require.NoError(t, err)
+	toHTTP.bearerToken = "token"
+	defer func() { _ = toHTTP.Close() }()
+
+	msgs := []isb.Message{
+		newTestMessage("0", []string{"a"}, "ok"),
+		newTestMessage("1", []string{"b"}, "bad"),
+		newTestMessage("2", []string{"c"}, "busy"),
+		newTestMessage("3", []string{"d"}, "slow"),
+		newTestMessage("4", nil, "no key"),
+	}
+	_, errs := toHTTP.Write(context.Background(), msgs)
+	require.Len(t, errs, 5)
+	assert.NoError(t, errs[0])
+	assert.True(t, errors.As(errs[1], &sinker.NonRetryableWriteErr{}))
+	assert.Contains(t, errs[1].Error(), "bad payload")
+	assert.Error(t, errs[2])
+	assert.False(t, errors.As(errs[2], &sinker.NonRetryableWriteErr{}))
+	assert.Error(t, errs[3])
+	assert.False(t, errors.As(errs[3], &sinker.NonRetryableWriteErr{}))
+	// the url of a message without keys can not be rendered
+	assert.True(t, errors.As(errs[4], &sinker.NonRetryableWriteErr{}))
+
+	require.Len(t, rec.requests, 4)
+	for _, r := range rec.requests {
+		if r.body == "ok" {
+			assert.Equal(t, "/events/a", r.path)
+			assert.Equal(t, "t1", r.headers.Get("X-Tenant"))
+			assert.Equal(t, "in-0-0", r.headers.Get("X-Id"))
+			assert.Equal(t, "Bearer token", r.headers.Get("Authorization"))
+			assert.Equal(t, "application/octet-stream", r.headers.Get("Content-Type"))
+		}
+	}
+}
+
+func TestToHTTP_Batch(t *testing.T) {
+	rec := &recorder{}
+	s := rec.server(t, func(req *http.Request, body string) (int, string) {
+		return http.StatusOK, ""
+	})
+	toHTTP, err := NewToHTTP(context.Background(), newTestVertexInstance(), &dfv1.HTTPSink{
+		URL:   s.URL + "/{{ index .Keys 0 }}",
+		Batch: &dfv1.HTTPSinkBatch{MaxMessages: 2},
+	})
+	require.NoError(t, err)
+	toHTTP.user, toHTTP.password = "user", "pass"
+
+	msgs := []isb.Message{
+		newTestMessage("0", []string{"a"}, `{"n":0}`),
+		newTestMessage("1", []string{"b"}, `{"n":1}`),
+		newTestMessage("2", []string{"a"}, "text"),
+		newTestMessage("3", []string{"a"}, `{"n":3}`),
+	}
+	_, errs := toHTTP.Write(context.Background(), msgs)
+	for _, err := range errs {
+		assert.NoError(t, err)
+	}
+	bodies := map[string][]string{}
+	for _, r := range rec.requests {
+		assert.Equal(t, "application/json", r.headers.Get("Content-Type"))
+		assert.NotEmpty(t, r.headers.Get("Authorization"))
+		bodies[r.path] = append(bodies[r.path], r.body)
+	}
+	assert.ElementsMatch(t, []string{`[{"n":0},"text"]`, `[{"n":3}]`}, bodies["/a"])
+	assert.Equal(t, []string{`[{"n":1}]`}, bodies["/b"])
+}
+
+func TestToHTTP_BatchNDJSON(t *testing.T) {
+	rec := &recorder{}
+	s := rec.server(t, func(req *http.Request, body string) (int, string) {
+		return http.StatusAccepted, ""
+	})
+	toHTTP, err := NewToHTTP(context.Background(), newTestVertexInstance(), &dfv1.HTTPSink{
+		URL:   s.URL,
+		Batch: &dfv1.HTTPSinkBatch{Format: dfv1.HTTPSinkBatchFormatNDJSON},
+	})
+	require.NoError(t, err)
+	_, errs := toHTTP.Write(context.Background(), []isb.Message{
+		newTestMessage("0", nil, `{"n":0}`),
+		newTestMessage("1", nil, `{"n":1}`),
+	})
+	for _, err := range errs {
+		assert.NoError(t, err)
+	}
+	require.Len(t, rec.requests, 1)
+	assert.Equal(t, "{\"n\":0}\n{\"n\":1}\n", rec.requests[0].body)
+	assert.Equal(t, "application/x-ndjson", rec.requests[0].headers.Get("Content-Type"))
+}
+
+func TestToHTTP_MultiStatus(t *testing.T) {
+	rec := &recorder{}
+	s := rec.server(t, func(req *http.Request, body string) (int, string) {
+		var payloads []json.RawMessage
+		_ = json.Unmarshal([]byte(body), &payloads)
+		if len(payloads) != 3 {
+			return http.StatusMultiStatus, `{"results":[]}`
+		}
+		return http.StatusMultiStatus, `{"results":[{"statusCode":201},{"statusCode":422,"error":"invalid"},{"statusCode":503}]}`
+	})
+	toHTTP, err := NewToHTTP(context.Background(), newTestVertexInstance(), &dfv1.HTTPSink{
+		URL:   s.URL,
+		Batch: &dfv1.HTTPSinkBatch{},
+	})
+	require.NoError(t, err)
+	msgs := []isb.Message{
+		newTestMessage("0", nil, `0`),
+		newTestMessage("1", nil, `1`),
+		newTestMessage("2", nil, `2`),
+	}
+	_, errs := toHTTP.Write(context.Background(), msgs)
+	assert.NoError(t, errs[0])
+	assert.True(t, errors.As(errs[1], &sinker.NonRetryableWriteErr{}))
+	assert.Contains(t, errs[1].Error(), "invalid")
+	assert.Error(t, errs[2])
+	assert.False(t, errors.As(errs[2], &sinker.NonRetryableWriteErr{}))
+
+	// a response not matching the messages is retried
+	_, errs = toHTTP.Write(context.Background(), msgs[:2])
+	for _, err := range errs {
+		assert.Error(t, err)
+		assert.False(t, errors.As(err, &sinker.NonRetryableWriteErr{}))
+	}
+}
+
+func TestToHTTP_InvalidTemplate(t *testing.T) {
+	_, err := NewToHTTP(context.Background(), newTestVertexInstance(), &dfv1.HTTPSink{URL: "http://example.com/{{ .Keys"})
+	assert.Error(t, err)
+}
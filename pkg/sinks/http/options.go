@@ -0,0 +1,29 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import "net/http"
+
+type Option func(*ToHTTP) error
+
+// WithClient sets the HTTP client to send the requests with, instead of creating one from the sink spec.
+func WithClient(c *http.Client) Option {
+	return func(o *ToHTTP) error {
+		o.client = c
+		return nil
+	}
+}
@@ -18,18 +18,24 @@ package kafka
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/IBM/sarama"
+	"github.com/nats-io/nats.go"
 	"go.uber.org/zap"
 
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/numaproj/numaflow/pkg/isb"
 	"github.com/numaproj/numaflow/pkg/metrics"
+	"github.com/numaproj/numaflow/pkg/shared/kvs"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
 	"github.com/numaproj/numaflow/pkg/shared/util"
+	"github.com/numaproj/numaflow/pkg/sinks/sinker"
 )
 
 // ToKafka produce the output to a kafka sinks.
@@ -42,10 +48,53 @@ type ToKafka struct {
 	setKey       bool
 	kafkaSink    *dfv1.KafkaSink
 	log          *zap.SugaredLogger
+	// partitioner of the records, the default partitioner is used if empty
+	partitioner     dfv1.KafkaPartitioner
+	partitionHeader string
+	forwardHeaders  bool
+	setTimestamp    bool
+	// max size of a record, 0 means no limit checked before producing
+	maxMessageBytes int
+	// exactlyOnce writes the records in transactions with a checkpoint of the read offsets
+	exactlyOnce bool
+	// transactionalID is also the consumer group the checkpoint is committed to, unique per inter-step buffer partition
+	transactionalID string
+	// checkpoint is the latest committed checkpoint, -1 if there's none
+	checkpoint int64
+	// checkpointStore mirrors the checkpoints committed to Kafka
+	checkpointStore kvs.KVStorer
+	// fetchGroupCheckpoint returns the checkpoint committed to the consumer group, -1 if there's none
+	fetchGroupCheckpoint func() (int64, error)
+}
+
+// recordMetadata is the metadata of a produced record.
+type recordMetadata struct {
+	// index of the message in the batch, used to identify if it succeeds or fails in the async return
+	index int
+	// partitionKey is hashed by the partitionKeyPartitioner
+	partitionKey string
+}
+
+// partitionKeyPartitioner hashes the partition key in the metadata of the records, instead of the Kafka key.
+type partitionKeyPartitioner struct {
+	hash sarama.Partitioner
+}
+
+func newPartitionKeyPartitioner(topic string) sarama.Partitioner {
+	return &partitionKeyPartitioner{hash: sarama.NewHashPartitioner(topic)}
+}
+
+func (p *partitionKeyPartitioner) Partition(message *sarama.ProducerMessage, numPartitions int32) (int32, error) {
+	md, _ := message.Metadata.(recordMetadata)
+	return p.hash.Partition(&sarama.ProducerMessage{Key: sarama.StringEncoder(md.partitionKey)}, numPartitions)
+}
+
+func (p *partitionKeyPartitioner) RequiresConsistency() bool {
+	return true
 }
 
 // NewToKafka returns ToKafka type.
-func NewToKafka(ctx context.Context, vertexInstance *dfv1.VertexInstance) (*ToKafka, error) {
+func NewToKafka(ctx context.Context, vertexInstance *dfv1.VertexInstance, opts ...Option) (*ToKafka, error) {
 
 	kafkaSink := vertexInstance.Vertex.Spec.Sink.Kafka
 	toKafka := new(ToKafka)
@@ -55,19 +104,40 @@ func NewToKafka(ctx context.Context, vertexInstance *dfv1.VertexInstance) (*ToKa
 	toKafka.topic = kafkaSink.Topic
 	toKafka.setKey = kafkaSink.SetKey
 	toKafka.kafkaSink = kafkaSink
+	toKafka.partitioner = kafkaSink.Partitioner
+	toKafka.partitionHeader = kafkaSink.PartitionHeader
+	toKafka.forwardHeaders = kafkaSink.ForwardHeaders
+	toKafka.setTimestamp = kafkaSink.SetTimestamp
+	toKafka.maxMessageBytes = int(kafkaSink.MaxMessageBytes)
+	toKafka.checkpoint = -1
+	toKafka.log = logging.FromContext(ctx).With("sinkType", "kafka").With("topic", kafkaSink.Topic)
 
-	producer, err := connect(kafkaSink)
+	for _, o := range opts {
+		if err := o(toKafka); err != nil {
+			return nil, err
+		}
+	}
+	if toKafka.exactlyOnce {
+		toKafka.fetchGroupCheckpoint = func() (int64, error) {
+			return fetchGroupCheckpoint(kafkaSink, toKafka.transactionalID)
+		}
+		if err := toKafka.loadCheckpoint(ctx); err != nil {
+			return nil, fmt.Errorf("failed to load the checkpoint, %w", err)
+		}
+		toKafka.log.Infow("Loaded the checkpoint", zap.String("transactionalID", toKafka.transactionalID), zap.Int64("checkpoint", toKafka.checkpoint))
+	}
+
+	producer, err := connect(kafkaSink, toKafka.transactionalID)
 	if err != nil {
 		return nil, err
 	}
 	toKafka.producer = producer
 	toKafka.connected = true
-
-	toKafka.log = logging.FromContext(ctx).With("sinkType", "kafka").With("topic", kafkaSink.Topic)
 	return toKafka, nil
 }
 
-func connect(kafkaSink *dfv1.KafkaSink) (sarama.AsyncProducer, error) {
+// newConfig returns the sarama config of the sink, with the producer options applied.
+func newConfig(kafkaSink *dfv1.KafkaSink) (*sarama.Config, error) {
 	config, err := util.GetSaramaConfigFromYAMLString(kafkaSink.Config)
 	if err != nil {
 		return nil, err
@@ -87,6 +157,19 @@ func connect(kafkaSink *dfv1.KafkaSink) (sarama.AsyncProducer, error) {
 			config.Net.SASL = *sasl
 		}
 	}
+	if err := applyProducerOptions(config, kafkaSink); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// connect creates the producer, it's transactional if the transactional ID is not empty.
+func connect(kafkaSink *dfv1.KafkaSink, transactionalID string) (sarama.AsyncProducer, error) {
+	config, err := newConfig(kafkaSink)
+	if err != nil {
+		return nil, err
+	}
+	config.Producer.Transaction.ID = transactionalID
 	config.Producer.Return.Successes = true
 	config.Producer.Return.Errors = true
 	producer, err := sarama.NewAsyncProducer(kafkaSink.Brokers, config)
@@ -96,6 +179,91 @@ func connect(kafkaSink *dfv1.KafkaSink) (sarama.AsyncProducer, error) {
 	return producer, nil
 }
 
+// fetchGroupCheckpoint returns the checkpoint committed to the consumer group in the transactions, -1 if there's none.
+func fetchGroupCheckpoint(kafkaSink *dfv1.KafkaSink, group string) (int64, error) {
+	config, err := newConfig(kafkaSink)
+	if err != nil {
+		return -1, err
+	}
+	admin, err := sarama.NewClusterAdmin(kafkaSink.Brokers, config)
+	if err != nil {
+		return -1, fmt.Errorf("failed to create kafka cluster admin, %w", err)
+	}
+	defer func() { _ = admin.Close() }()
+	resp, err := admin.ListConsumerGroupOffsets(group, map[string][]int32{kafkaSink.Topic: {0}})
+	if err != nil {
+		return -1, fmt.Errorf("failed to list the offsets of consumer group %q, %w", group, err)
+	}
+	block := resp.GetBlock(kafkaSink.Topic, 0)
+	if block == nil {
+		return -1, nil
+	}
+	if block.Err != sarama.ErrNoError {
+		return -1, fmt.Errorf("failed to get the offset of consumer group %q, %w", group, block.Err)
+	}
+	return block.Offset, nil
+}
+
+// loadCheckpoint loads the latest checkpoint, which is the larger one of the checkpoint committed to the consumer
+// group and the one in the checkpoint store, because the offsets of the consumer group might expire.
+func (tk *ToKafka) loadCheckpoint(ctx context.Context) error {
+	checkpoint, err := tk.fetchGroupCheckpoint()
+	if err != nil {
+		return err
+	}
+	value, err := tk.checkpointStore.GetValue(ctx, tk.transactionalID)
+	if err != nil && !errors.Is(err, nats.ErrKeyNotFound) {
+		return fmt.Errorf("failed to get the checkpoint from the store, %w", err)
+	}
+	if err == nil {
+		stored, err := strconv.ParseInt(string(value), 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid checkpoint %q in the store, %w", string(value), err)
+		}
+		checkpoint = max(checkpoint, stored)
+	}
+	tk.checkpoint = max(tk.checkpoint, checkpoint)
+	return nil
+}
+
+// applyProducerOptions sets the producer config with the options in the spec.
+func applyProducerOptions(config *sarama.Config, kafkaSink *dfv1.KafkaSink) error {
+	switch kafkaSink.Partitioner {
+	case "":
+	case dfv1.KafkaPartitionerKeyHash, dfv1.KafkaPartitionerHeader:
+		config.Producer.Partitioner = newPartitionKeyPartitioner
+	case dfv1.KafkaPartitionerRoundRobin:
+		config.Producer.Partitioner = sarama.NewRoundRobinPartitioner
+	default:
+		return fmt.Errorf("unsupported partitioner %q", kafkaSink.Partitioner)
+	}
+	if kafkaSink.Idempotent || kafkaSink.ExactlyOnce {
+		config.Producer.Idempotent = true
+		config.Producer.RequiredAcks = sarama.WaitForAll
+		config.Net.MaxOpenRequests = 1
+		if config.Producer.Retry.Max < 1 {
+			config.Producer.Retry.Max = 1
+		}
+		if !config.Version.IsAtLeast(sarama.V0_11_0_0) {
+			config.Version = sarama.V0_11_0_0
+		}
+	}
+	if c := kafkaSink.Compression; c != "" {
+		var codec sarama.CompressionCodec
+		if err := codec.UnmarshalText([]byte(c)); err != nil {
+			return fmt.Errorf("invalid compression %q, %w", c, err)
+		}
+		config.Producer.Compression = codec
+		if codec == sarama.CompressionZSTD && !config.Version.IsAtLeast(sarama.V2_1_0_0) {
+			config.Version = sarama.V2_1_0_0
+		}
+	}
+	if kafkaSink.MaxMessageBytes > 0 {
+		config.Producer.MaxMessageBytes = int(kafkaSink.MaxMessageBytes)
+	}
+	return nil
+}
+
 // GetName returns the name.
 func (tk *ToKafka) GetName() string {
 	return tk.name
@@ -107,45 +275,170 @@ func (tk *ToKafka) GetPartitionIdx() int32 {
 	return 0
 }
 
-// Write writes to the kafka topic.
-func (tk *ToKafka) Write(_ context.Context, messages []isb.Message) ([]isb.Offset, []error) {
-	errs := make([]error, len(messages))
+// Write writes to the kafka topic. In exactly-once mode the messages can only be written with a checkpoint, see
+// WriteWithCheckpoint.
+func (tk *ToKafka) Write(ctx context.Context, messages []isb.Message) ([]isb.Offset, []error) {
+	errs := newWriteErrs(len(messages))
+	if tk.exactlyOnce {
+		for i := range errs {
+			errs[i] = fmt.Errorf("exactly-once is enabled, use WriteWithCheckpoint to write the messages")
+		}
+		return nil, errs
+	}
+	if !tk.ensureConnected(errs) {
+		return nil, errs
+	}
+	tk.produce(tk.toRecords(messages, errs), errs)
+	return nil, errs
+}
+
+// Checkpoint returns the latest checkpoint committed in a transaction.
+func (tk *ToKafka) Checkpoint() int64 {
+	return tk.checkpoint
+}
+
+// WriteWithCheckpoint writes the messages in a transaction, along with the checkpoint committed as the offset of
+// the transactional consumer group. Either all the messages are written, or none of them.
+func (tk *ToKafka) WriteWithCheckpoint(ctx context.Context, messages []isb.Message, checkpoint int64) ([]isb.Offset, []error) {
+	errs := newWriteErrs(len(messages))
+	failAll := func(err error) ([]isb.Offset, []error) {
+		for i := range errs {
+			// keep the non-retryable errors, they are not retried with the others.
+			var nonRetryable sinker.NonRetryableWriteErr
+			if !errors.As(errs[i], &nonRetryable) {
+				errs[i] = err
+			}
+		}
+		return nil, errs
+	}
+	if !tk.connected {
+		if !tk.ensureConnected(errs) {
+			return nil, errs
+		}
+		// the transaction of the last attempt might have been committed even though it returned an error.
+		if err := tk.loadCheckpoint(ctx); err != nil {
+			_ = tk.closeProducer()
+			return failAll(fmt.Errorf("failed to load the checkpoint, %w", err))
+		}
+	}
+	if checkpoint <= tk.checkpoint {
+		tk.log.Infow("Messages already committed in a previous transaction", zap.Int64("checkpoint", checkpoint))
+		return nil, make([]error, len(messages))
+	}
+	if err := tk.producer.BeginTxn(); err != nil {
+		_ = tk.closeProducer()
+		return failAll(fmt.Errorf("failed to begin the transaction, %w", err))
+	}
+	records := tk.toRecords(messages, errs)
+	tk.produce(records, errs)
+	if !tk.connected {
+		// timed out, the producer has been closed and the transaction will be aborted by the broker.
+		return failAll(fmt.Errorf("transaction timed out"))
+	}
+	for _, record := range records {
+		if err := errs[record.Metadata.(recordMetadata).index]; err != nil {
+			if abortErr := tk.producer.AbortTxn(); abortErr != nil {
+				tk.log.Errorw("Failed to abort the transaction", zap.Error(abortErr))
+				_ = tk.closeProducer()
+			}
+			return failAll(fmt.Errorf("transaction aborted, %w", err))
+		}
+	}
+	offsets := map[string][]*sarama.PartitionOffsetMetadata{tk.topic: {{Partition: 0, Offset: checkpoint}}}
+	if err := tk.producer.AddOffsetsToTxn(offsets, tk.transactionalID); err != nil {
+		if abortErr := tk.producer.AbortTxn(); abortErr != nil {
+			tk.log.Errorw("Failed to abort the transaction", zap.Error(abortErr))
+			_ = tk.closeProducer()
+		}
+		return failAll(fmt.Errorf("failed to add the checkpoint to the transaction, %w", err))
+	}
+	if err := tk.producer.CommitTxn(); err != nil {
+		// the result of the commit is unknown, the checkpoint is reloaded after reconnecting.
+		_ = tk.producer.AbortTxn()
+		_ = tk.closeProducer()
+		return failAll(fmt.Errorf("failed to commit the transaction, %w", err))
+	}
+	tk.checkpoint = checkpoint
+	// mirror the checkpoint, the offsets of the consumer group expire if the pipeline is paused for too long.
+	if err := tk.checkpointStore.PutKV(ctx, tk.transactionalID, []byte(strconv.FormatInt(checkpoint, 10))); err != nil {
+		tk.log.Warnw("Failed to persist the checkpoint", zap.Int64("checkpoint", checkpoint), zap.Error(err))
+	}
+	return nil, errs
+}
+
+// newWriteErrs returns the errors of the messages to write, they are reset once the messages are written.
+func newWriteErrs(n int) []error {
+	errs := make([]error, n)
 	for i := 0; i < len(errs); i++ {
 		errs[i] = fmt.Errorf("unknown error")
 	}
-	if !tk.connected {
-		producer, err := connect(tk.kafkaSink)
-		if err != nil {
-			for i := 0; i < len(errs); i++ {
-				errs[i] = fmt.Errorf("failed to get kafka producer, %w", err)
+	return errs
+}
+
+// ensureConnected creates the producer if it's not connected, the errors of all the messages are set if it fails.
+func (tk *ToKafka) ensureConnected(errs []error) bool {
+	if tk.connected {
+		return true
+	}
+	producer, err := connect(tk.kafkaSink, tk.transactionalID)
+	if err != nil {
+		for i := 0; i < len(errs); i++ {
+			errs[i] = fmt.Errorf("failed to get kafka producer, %w", err)
+		}
+		return false
+	}
+	tk.producer = producer
+	tk.connected = true
+	return true
+}
+
+// closeProducer closes the producer, which is recreated by the next write.
+func (tk *ToKafka) closeProducer() error {
+	tk.connected = false
+	return tk.producer.Close()
+}
+
+// toRecords converts the messages to Kafka records, the oversized messages are rejected with non-retryable errors.
+func (tk *ToKafka) toRecords(messages []isb.Message, errs []error) []*sarama.ProducerMessage {
+	records := make([]*sarama.ProducerMessage, 0, len(messages))
+	for index, msg := range messages {
+		record := tk.toProducerMessage(index, msg)
+		// reject the oversized records right away, they can never be written.
+		if tk.maxMessageBytes > 0 {
+			if size := record.ByteSize(2); size > tk.maxMessageBytes {
+				errs[index] = sinker.NonRetryableWriteErr{Message: fmt.Sprintf("record size %d exceeds the max message bytes %d", size, tk.maxMessageBytes)}
+				kafkaSinkOversizedMessages.With(map[string]string{metrics.LabelVertex: tk.name, metrics.LabelPipeline: tk.pipelineName}).Inc()
+				continue
 			}
-			return nil, errs
 		}
-		tk.producer = producer
-		tk.connected = true
+		records = append(records, record)
 	}
+	return records
+}
+
+// produce sends the records and waits for the results, the errors of the records are set by their indexes.
+func (tk *ToKafka) produce(records []*sarama.ProducerMessage, errs []error) {
 	done := make(chan struct{})
 	timeout := time.After(5 * time.Second)
 	go func() {
 		sent := 0
 		for {
-			if sent == len(messages) {
+			if sent == len(records) {
 				close(done)
 				return
 			}
 			select {
 			case err := <-tk.producer.Errors():
-				idx := err.Msg.Metadata.(int)
-				errs[idx] = err.Err
+				idx := err.Msg.Metadata.(recordMetadata).index
+				errs[idx] = toWriteErr(err.Err)
 				sent++
 			case m := <-tk.producer.Successes():
-				idx := m.Metadata.(int)
+				idx := m.Metadata.(recordMetadata).index
 				errs[idx] = nil
 				sent++
 			case <-timeout:
 				// Need to close and recreate later because the successes and errors channels might be unclean
-				_ = tk.producer.Close()
-				tk.connected = false
+				_ = tk.closeProducer()
 				kafkaSinkWriteTimeouts.With(map[string]string{metrics.LabelVertex: tk.name, metrics.LabelPipeline: tk.pipelineName}).Inc()
 				close(done)
 				return
@@ -154,49 +447,85 @@ func (tk *ToKafka) Write(_ context.Context, messages []isb.Message) ([]isb.Offse
 		}
 	}()
 
-	for index, msg := range messages {
-		// insert keys in the header.
-		// since keys is an array, to decompose it, we need len and key at each index.
-		var headers []sarama.RecordHeader
-		// insert __key_len
-		keyLen := sarama.RecordHeader{
-			Key:   []byte("__key_len"),
-			Value: []byte(fmt.Sprintf("%d", len(msg.Keys))),
-		}
-		headers = append(headers, keyLen)
-
-		// keys is concatenated keys
-		var keys string
-		// write keys into header if length > 0
-		if len(msg.Keys) > 0 {
-			// all keys concatenated together to set kafka key field if need be
-			keys = strings.Join(msg.Keys, ":")
-
-			for idx, key := range msg.Keys {
-				headers = append(headers, sarama.RecordHeader{
-					Key:   []byte(fmt.Sprintf("__key_%d", idx)),
-					Value: []byte(key),
-				})
-			}
-		}
+	for _, record := range records {
+		tk.producer.Input() <- record
+	}
+	<-done
+}
 
-		var kafkaKey sarama.StringEncoder
-		// set Kafka Key if SetKey is set.
-		if tk.setKey {
-			kafkaKey = sarama.StringEncoder(keys)
+// toProducerMessage converts a message to a Kafka record.
+func (tk *ToKafka) toProducerMessage(index int, msg isb.Message) *sarama.ProducerMessage {
+	// insert keys in the header.
+	// since keys is an array, to decompose it, we need len and key at each index.
+	var headers []sarama.RecordHeader
+	// insert __key_len
+	keyLen := sarama.RecordHeader{
+		Key:   []byte("__key_len"),
+		Value: []byte(fmt.Sprintf("%d", len(msg.Keys))),
+	}
+	headers = append(headers, keyLen)
+
+	// keys is concatenated keys
+	var keys string
+	// write keys into header if length > 0
+	if len(msg.Keys) > 0 {
+		// all keys concatenated together to set kafka key field if need be
+		keys = strings.Join(msg.Keys, ":")
+
+		for idx, key := range msg.Keys {
+			headers = append(headers, sarama.RecordHeader{
+				Key:   []byte(fmt.Sprintf("__key_%d", idx)),
+				Value: []byte(key),
+			})
 		}
+	}
 
-		message := &sarama.ProducerMessage{
-			Key:      kafkaKey,
-			Topic:    tk.topic,
-			Value:    sarama.ByteEncoder(msg.Payload),
-			Headers:  headers,
-			Metadata: index, // Use metadata to identify if it succeeds or fails in the async return.
+	if tk.forwardHeaders {
+		names := make([]string, 0, len(msg.Headers))
+		for name := range msg.Headers {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			headers = append(headers, sarama.RecordHeader{Key: []byte(name), Value: []byte(msg.Headers[name])})
 		}
-		tk.producer.Input() <- message
+		headers = append(headers, sarama.RecordHeader{
+			Key:   []byte(dfv1.KeyMetaEventTime),
+			Value: []byte(strconv.FormatInt(msg.EventTime.UnixMilli(), 10)),
+		})
 	}
-	<-done
-	return nil, errs
+
+	metadata := recordMetadata{index: index}
+	switch tk.partitioner {
+	case dfv1.KafkaPartitionerKeyHash:
+		metadata.partitionKey = keys
+	case dfv1.KafkaPartitionerHeader:
+		metadata.partitionKey = msg.Headers[tk.partitionHeader]
+	}
+
+	record := &sarama.ProducerMessage{
+		Topic:    tk.topic,
+		Value:    sarama.ByteEncoder(msg.Payload),
+		Headers:  headers,
+		Metadata: metadata,
+	}
+	// set Kafka Key if SetKey is set, otherwise leave it null.
+	if tk.setKey {
+		record.Key = sarama.StringEncoder(keys)
+	}
+	if tk.setTimestamp {
+		record.Timestamp = msg.EventTime
+	}
+	return record
+}
+
+// toWriteErr converts the errors of the records which can never be written to non-retryable errors.
+func toWriteErr(err error) error {
+	var configErr sarama.ConfigurationError
+	if errors.Is(err, sarama.ErrMessageSizeTooLarge) || errors.As(err, &configErr) {
+		return sinker.NonRetryableWriteErr{Message: err.Error()}
+	}
+	return err
 }
 
 func (tk *ToKafka) Close() error {
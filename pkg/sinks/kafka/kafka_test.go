@@ -20,13 +20,18 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
+	"github.com/IBM/sarama"
 	mock "github.com/IBM/sarama/mocks"
 	"github.com/stretchr/testify/assert"
 
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/shared/kvs"
+	"github.com/numaproj/numaflow/pkg/shared/kvs/inmem"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
+	"github.com/numaproj/numaflow/pkg/sinks/sinker"
 )
 
 func TestWriteSuccessToKafka(t *testing.T) {
@@ -117,3 +122,211 @@ func TestWriteFailureToKafka(t *testing.T) {
 	assert.Equal(t, "test1", errs[1].Error())
 
 }
+
+func TestApplyProducerOptions(t *testing.T) {
+	config := sarama.NewConfig()
+	config.Version = sarama.V0_10_2_0
+	err := applyProducerOptions(config, &dfv1.KafkaSink{
+		Partitioner:     dfv1.KafkaPartitionerRoundRobin,
+		Idempotent:      true,
+		Compression:     "zstd",
+		MaxMessageBytes: 1024,
+	})
+	assert.NoError(t, err)
+	assert.True(t, config.Producer.Idempotent)
+	assert.Equal(t, sarama.WaitForAll, config.Producer.RequiredAcks)
+	assert.Equal(t, 1, config.Net.MaxOpenRequests)
+	assert.Equal(t, sarama.V2_1_0_0, config.Version)
+	assert.Equal(t, sarama.CompressionZSTD, config.Producer.Compression)
+	assert.Equal(t, 1024, config.Producer.MaxMessageBytes)
+	assert.NoError(t, config.Validate())
+
+	assert.Error(t, applyProducerOptions(sarama.NewConfig(), &dfv1.KafkaSink{Compression: "unknown"}))
+	assert.Error(t, applyProducerOptions(sarama.NewConfig(), &dfv1.KafkaSink{Partitioner: "unknown"}))
+}
+
+func TestToProducerMessage(t *testing.T) {
+	eventTime := time.UnixMilli(1663006726000)
+	msg := isb.Message{
+		Header: isb.Header{
+			MessageInfo: isb.MessageInfo{EventTime: eventTime},
+			Keys:        []string{"k1", "k2"},
+			Headers:     map[string]string{"tenant": "t1", "app": "a1"},
+		},
+		Body: isb.Body{Payload: []byte("hello")},
+	}
+
+	toKafka := &ToKafka{topic: "topic-1"}
+	record := toKafka.toProducerMessage(3, msg)
+	assert.Nil(t, record.Key)
+	assert.True(t, record.Timestamp.IsZero())
+	assert.Equal(t, recordMetadata{index: 3}, record.Metadata)
+	assert.Len(t, record.Headers, 3)
+
+	toKafka = &ToKafka{topic: "topic-1", setKey: true, forwardHeaders: true, setTimestamp: true, partitioner: dfv1.KafkaPartitionerKeyHash}
+	record = toKafka.toProducerMessage(0, msg)
+	assert.Equal(t, sarama.StringEncoder("k1:k2"), record.Key)
+	assert.Equal(t, eventTime, record.Timestamp)
+	assert.Equal(t, recordMetadata{index: 0, partitionKey: "k1:k2"}, record.Metadata)
+	assert.Equal(t, []sarama.RecordHeader{
+		{Key: []byte("__key_len"), Value: []byte("2")},
+		{Key: []byte("__key_0"), Value: []byte("k1")},
+		{Key: []byte("__key_1"), Value: []byte("k2")},
+		{Key: []byte("app"), Value: []byte("a1")},
+		{Key: []byte("tenant"), Value: []byte("t1")},
+		{Key: []byte(dfv1.KeyMetaEventTime), Value: []byte("1663006726000")},
+	}, record.Headers)
+
+	toKafka = &ToKafka{topic: "topic-1", partitioner: dfv1.KafkaPartitionerHeader, partitionHeader: "tenant"}
+	record = toKafka.toProducerMessage(0, msg)
+	assert.Equal(t, recordMetadata{index: 0, partitionKey: "t1"}, record.Metadata)
+}
+
+func TestPartitionKeyPartitioner(t *testing.T) {
+	p := newPartitionKeyPartitioner("topic-1")
+	assert.True(t, p.RequiresConsistency())
+	partition := func(key string) int32 {
+		pt, err := p.Partition(&sarama.ProducerMessage{Key: sarama.StringEncoder("ignored-" + key), Metadata: recordMetadata{partitionKey: key}}, 16)
+		assert.NoError(t, err)
+		return pt
+	}
+	expected, err := sarama.NewHashPartitioner("topic-1").Partition(&sarama.ProducerMessage{Key: sarama.StringEncoder("t1")}, 16)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, partition("t1"))
+	assert.Equal(t, partition("t2"), partition("t2"))
+}
+
+func TestWriteOversizedToKafka(t *testing.T) {
+	toKafka := new(ToKafka)
+	toKafka.name = "Test"
+	toKafka.topic = "topic-1"
+	toKafka.maxMessageBytes = 200
+	toKafka.log = logging.NewLogger()
+	conf := mock.NewTestConfig()
+	conf.Producer.Return.Successes = true
+	conf.Producer.Return.Errors = true
+	producer := mock.NewAsyncProducer(t, conf)
+	producer.ExpectInputAndSucceed()
+	producer.ExpectInputAndFail(sarama.ErrMessageSizeTooLarge)
+	toKafka.producer = producer
+	toKafka.connected = true
+	msgs := []isb.Message{
+		{Body: isb.Body{Payload: []byte("small")}},
+		{Body: isb.Body{Payload: make([]byte, 300)}},
+		{Body: isb.Body{Payload: []byte("rejected by the broker")}},
+	}
+	_, errs := toKafka.Write(context.Background(), msgs)
+	assert.NoError(t, errs[0])
+	assert.ErrorAs(t, errs[1], &sinker.NonRetryableWriteErr{})
+	assert.ErrorAs(t, errs[2], &sinker.NonRetryableWriteErr{})
+	assert.NoError(t, producer.Close())
+}
+
+func TestToWriteErr(t *testing.T) {
+	assert.ErrorAs(t, toWriteErr(sarama.ErrMessageSizeTooLarge), &sinker.NonRetryableWriteErr{})
+	assert.ErrorAs(t, toWriteErr(sarama.ConfigurationError("too large")), &sinker.NonRetryableWriteErr{})
+	err := toWriteErr(sarama.ErrNotLeaderForPartition)
+	assert.Equal(t, sarama.ErrNotLeaderForPartition, err)
+}
+
+// txnProducer records the offsets committed in the transactions.
+type txnProducer struct {
+	*mock.AsyncProducer
+	offsets map[string][]*sarama.PartitionOffsetMetadata
+	group   string
+	aborted int
+}
+
+func (p *txnProducer) AddOffsetsToTxn(offsets map[string][]*sarama.PartitionOffsetMetadata, groupId string) error {
+	p.offsets = offsets
+	p.group = groupId
+	return p.AsyncProducer.AddOffsetsToTxn(offsets, groupId)
+}
+
+func (p *txnProducer) AbortTxn() error {
+	p.aborted++
+	return p.AsyncProducer.AbortTxn()
+}
+
+func newTransactionalToKafka(t *testing.T) (*ToKafka, *txnProducer, kvs.KVStorer) {
+	conf := mock.NewTestConfig()
+	conf.Producer.Return.Successes = true
+	conf.Producer.Return.Errors = true
+	conf.Producer.Transaction.ID = "numaflow-p0"
+	assert.NoError(t, applyProducerOptions(conf, &dfv1.KafkaSink{ExactlyOnce: true}))
+	producer := &txnProducer{AsyncProducer: mock.NewAsyncProducer(t, conf)}
+	store, err := inmem.NewKVInMemKVStore(context.Background(), "ckpt")
+	assert.NoError(t, err)
+	toKafka := &ToKafka{
+		name:            "Test",
+		topic:           "topic-1",
+		log:             logging.NewLogger(),
+		producer:        producer,
+		connected:       true,
+		exactlyOnce:     true,
+		transactionalID: "numaflow-p0",
+		checkpoint:      -1,
+		checkpointStore: store,
+	}
+	return toKafka, producer, store
+}
+
+func TestWriteWithCheckpoint(t *testing.T) {
+	msgs := []isb.Message{
+		{Header: isb.Header{Keys: []string{"key1"}}, Body: isb.Body{Payload: []byte("welcome1")}},
+		{Header: isb.Header{Keys: []string{"key2"}}, Body: isb.Body{Payload: []byte("welcome2")}},
+	}
+
+	t.Run("commit", func(t *testing.T) {
+		toKafka, producer, store := newTransactionalToKafka(t)
+		producer.ExpectInputAndSucceed()
+		producer.ExpectInputAndSucceed()
+		_, errs := toKafka.WriteWithCheckpoint(context.Background(), msgs, 10)
+		assert.Equal(t, []error{nil, nil}, errs)
+		assert.Equal(t, int64(10), toKafka.Checkpoint())
+		assert.Equal(t, "numaflow-p0", producer.group)
+		assert.Equal(t, int64(10), producer.offsets["topic-1"][0].Offset)
+		value, err := store.GetValue(context.Background(), "numaflow-p0")
+		assert.NoError(t, err)
+		assert.Equal(t, "10", string(value))
+
+		// the batch committed already is not written again.
+		_, errs = toKafka.WriteWithCheckpoint(context.Background(), msgs, 10)
+		assert.Equal(t, []error{nil, nil}, errs)
+	})
+
+	t.Run("abort", func(t *testing.T) {
+		toKafka, producer, _ := newTransactionalToKafka(t)
+		producer.ExpectInputAndSucceed()
+		producer.ExpectInputAndFail(fmt.Errorf("test"))
+		_, errs := toKafka.WriteWithCheckpoint(context.Background(), msgs, 10)
+		for _, err := range errs {
+			assert.Error(t, err)
+		}
+		assert.Equal(t, 1, producer.aborted)
+		assert.Equal(t, int64(-1), toKafka.Checkpoint())
+		assert.Nil(t, producer.offsets)
+	})
+}
+
+func TestWriteExactlyOnce(t *testing.T) {
+	toKafka, producer, _ := newTransactionalToKafka(t)
+	msgs := []isb.Message{{Header: isb.Header{Keys: []string{"key1"}}, Body: isb.Body{Payload: []byte("welcome1")}}}
+	_, errs := toKafka.Write(context.Background(), msgs)
+	assert.Len(t, errs, 1)
+	assert.ErrorContains(t, errs[0], "use WriteWithCheckpoint")
+	assert.Equal(t, int64(-1), toKafka.Checkpoint())
+	assert.Nil(t, producer.offsets)
+}
+
+func TestLoadCheckpoint(t *testing.T) {
+	toKafka, _, store := newTransactionalToKafka(t)
+	toKafka.fetchGroupCheckpoint = func() (int64, error) { return 7, nil }
+	assert.NoError(t, store.PutKV(context.Background(), "numaflow-p0", []byte("12")))
+	assert.NoError(t, toKafka.loadCheckpoint(context.Background()))
+	assert.Equal(t, int64(12), toKafka.Checkpoint())
+
+	toKafka.fetchGroupCheckpoint = func() (int64, error) { return 15, nil }
+	assert.NoError(t, toKafka.loadCheckpoint(context.Background()))
+	assert.Equal(t, int64(15), toKafka.Checkpoint())
+}
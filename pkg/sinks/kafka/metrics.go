@@ -28,3 +28,9 @@ var kafkaSinkWriteTimeouts = promauto.NewCounterVec(prometheus.CounterOpts{
 	Name:      "write_timeout_total",
 	Help:      "Total number of write timeouts on NewToKafka",
 }, []string{metrics.LabelVertex, metrics.LabelPipeline})
+
+var kafkaSinkOversizedMessages = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "kafka_sink",
+	Name:      "oversized_messages_total",
+	Help:      "Total number of messages rejected for exceeding the max message bytes",
+}, []string{metrics.LabelVertex, metrics.LabelPipeline})
@@ -0,0 +1,39 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kafka
+
+import (
+	"fmt"
+
+	"github.com/numaproj/numaflow/pkg/shared/kvs"
+)
+
+type Option func(*ToKafka) error
+
+// WithExactlyOnce enables the transactional writes for the inter-step buffer partition, the checkpoints committed
+// in the transactions are mirrored to the store.
+func WithExactlyOnce(partitionName string, store kvs.KVStorer) Option {
+	return func(o *ToKafka) error {
+		if !o.kafkaSink.ExactlyOnce {
+			return fmt.Errorf("exactly-once is not enabled in the kafka sink")
+		}
+		o.exactlyOnce = true
+		o.transactionalID = fmt.Sprintf("numaflow-%s", partitionName)
+		o.checkpointStore = store
+		return nil
+	}
+}
@@ -17,10 +17,17 @@ limitations under the License.
 package logger
 
 import (
+	"bufio"
 	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"io"
 	"log"
+	"os"
 	"strings"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -31,18 +38,58 @@ import (
 
 // ToLog prints the output to a log sinks.
 type ToLog struct {
-	name         string
-	pipelineName string
-	logger       *zap.SugaredLogger
+	name            string
+	pipelineName    string
+	format          dfv1.LogFormat
+	payloadEncoding dfv1.LogPayloadEncoding
+	maxPayloadBytes int
+	everyN          int64
+	perSecond       int64
+	// count is the number of messages seen, for sampling every Nth message
+	count int64
+	// second and printedInSecond count the messages printed in the current second, for the rate limit
+	second          int64
+	printedInSecond int64
+	// out is where the json and payload formats are printed
+	out    io.Writer
+	logger *zap.SugaredLogger
+}
+
+// jsonLine is a message printed in the json format.
+type jsonLine struct {
+	Vertex    string            `json:"vertex"`
+	ID        string            `json:"id"`
+	Keys      []string          `json:"keys"`
+	EventTime time.Time         `json:"eventTime"`
+	Headers   map[string]string `json:"headers,omitempty"`
+	Payload   string            `json:"payload"`
+	Truncated bool              `json:"truncated,omitempty"`
 }
 
 // NewToLog returns ToLog type.
-func NewToLog(ctx context.Context, vertexInstance *dfv1.VertexInstance) (*ToLog, error) {
-	return &ToLog{
-		name:         vertexInstance.Vertex.Spec.Name,
-		pipelineName: vertexInstance.Vertex.Spec.PipelineName,
-		logger:       logging.FromContext(ctx),
-	}, nil
+func NewToLog(ctx context.Context, vertexInstance *dfv1.VertexInstance, logSink *dfv1.Log, opts ...Option) (*ToLog, error) {
+	if logSink == nil {
+		logSink = &dfv1.Log{}
+	}
+	toLog := &ToLog{
+		name:            vertexInstance.Vertex.Spec.Name,
+		pipelineName:    vertexInstance.Vertex.Spec.PipelineName,
+		format:          logSink.GetFormat(),
+		payloadEncoding: logSink.GetPayloadEncoding(),
+		maxPayloadBytes: int(logSink.MaxPayloadBytes),
+		out:             os.Stdout,
+		logger:          logging.FromContext(ctx),
+	}
+	if x := logSink.Sampling; x != nil {
+		toLog.everyN = int64(x.EveryN)
+		toLog.perSecond = int64(x.PerSecond)
+	}
+	for _, o := range opts {
+		if err := o(toLog); err != nil {
+			return nil, err
+		}
+	}
+	return toLog, nil
 }
 
 // GetName returns the name.
@@ -65,16 +112,87 @@ func (t *ToLog) IsFull() bool {
 // Write writes to the log.
 func (t *ToLog) Write(_ context.Context, messages []isb.Message) ([]isb.Offset, []error) {
 	prefix := "(" + t.GetName() + ")"
+	w := bufio.NewWriter(t.out)
 	for _, message := range messages {
-		var hStr strings.Builder
-		for k, v := range message.Headers {
-			hStr.WriteString(fmt.Sprintf("%s: %s, ", k, v))
+		if !t.sample() {
+			continue
+		}
+		payload, truncated := t.encodePayload(message.Payload)
+		switch t.format {
+		case dfv1.LogFormatJSON:
+			line, err := json.Marshal(jsonLine{
+				Vertex:    t.name,
+				ID:        message.ID.String(),
+				Keys:      message.Keys,
+				EventTime: message.EventTime,
+				Headers:   message.Headers,
+				Payload:   payload,
+				Truncated: truncated,
+			})
+			if err != nil {
+				t.logger.Errorw("Failed to marshal the message", zap.Error(err))
+				continue
+			}
+			_, _ = w.Write(line)
+			_ = w.WriteByte('\n')
+		case dfv1.LogFormatPayload:
+			_, _ = w.WriteString(payload)
+			_ = w.WriteByte('\n')
+		default:
+			var hStr strings.Builder
+			for k, v := range message.Headers {
+				hStr.WriteString(fmt.Sprintf("%s: %s, ", k, v))
+			}
+			if truncated {
+				payload += "...(truncated)"
+			}
+			log.Println(prefix, " Payload - ", payload, " Keys - ", message.Keys, " EventTime - ", message.EventTime.UnixMilli(), " Headers - ", hStr.String(), " ID - ", message.ID.String())
 		}
-		log.Println(prefix, " Payload - ", string(message.Payload), " Keys - ", message.Keys, " EventTime - ", message.EventTime.UnixMilli(), " Headers - ", hStr.String(), " ID - ", message.ID.String())
 	}
+	if err := w.Flush(); err != nil {
+		t.logger.Errorw("Failed to print the messages", zap.Error(err))
+	}
+	// the messages not printed are still written, printing is best effort
 	return nil, make([]error, len(messages))
 }
 
+// sample returns true if the next message should be printed.
+func (t *ToLog) sample() bool {
+	t.count++
+	if t.everyN > 1 && (t.count-1)%t.everyN != 0 {
+		return false
+	}
+	if t.perSecond > 0 {
+		now := time.Now().Unix()
+		if now != t.second {
+			t.second = now
+			t.printedInSecond = 0
+		}
+		if t.printedInSecond >= t.perSecond {
+			return false
+		}
+		t.printedInSecond++
+	}
+	return true
+}
+
+// encodePayload truncates and encodes a payload, it returns true if the payload is truncated.
+func (t *ToLog) encodePayload(payload []byte) (string, bool) {
+	truncated := false
+	if t.maxPayloadBytes > 0 && len(payload) > t.maxPayloadBytes {
+		payload = payload[:t.maxPayloadBytes]
+		truncated = true
+	}
+	switch t.payloadEncoding {
+	case dfv1.LogPayloadEncodingHex:
+		return hex.EncodeToString(payload), truncated
+	case dfv1.LogPayloadEncodingBase64:
+		return base64.StdEncoding.EncodeToString(payload), truncated
+	default:
+		return string(payload), truncated
+	}
+}
+
 func (t *ToLog) Close() error {
 	return nil
 }
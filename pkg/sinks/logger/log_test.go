@@ -17,13 +17,17 @@ limitations under the License.
 package logger
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
 	"github.com/numaproj/numaflow/pkg/isb/testutils"
 )
 
@@ -48,7 +52,7 @@ func TestToLog_Start(t *testing.T) {
 		Vertex:  vertex,
 		Replica: 0,
 	}
-	s, err := NewToLog(ctx, vertexInstance)
+	s, err := NewToLog(ctx, vertexInstance, vertex.Spec.Sink.Log)
 	assert.NoError(t, err)
 
 	// write some data
@@ -59,3 +63,77 @@ func TestToLog_Start(t *testing.T) {
 	_, errs = s.Write(ctx, writeMessages[5:20])
 	assert.Equal(t, make([]error, 15), errs)
 }
+
+func newTestToLog(t *testing.T, logSink *dfv1.Log) (*ToLog, *bytes.Buffer) {
+	vertexInstance := &dfv1.VertexInstance{
+		Vertex: &dfv1.Vertex{Spec: dfv1.VertexSpec{AbstractVertex: dfv1.AbstractVertex{Name: "sinks.logger"}}},
+	}
+	var buf bytes.Buffer
+	s, err := NewToLog(context.Background(), vertexInstance, logSink, WithWriter(&buf))
+	assert.NoError(t, err)
+	return s, &buf
+}
+
+func testMessages(payloads ...string) []isb.Message {
+	messages := make([]isb.Message, len(payloads))
+	for i, p := range payloads {
+		messages[i] = isb.Message{
+			Header: isb.Header{
+				MessageInfo: isb.MessageInfo{EventTime: time.UnixMilli(1636470000000).UTC()},
+				ID:          isb.MessageID{VertexName: "in", Offset: "0", Index: int32(i)},
+				Keys:        []string{"k"},
+				Headers:     map[string]string{"h": "v"},
+			},
+			Body: isb.Body{Payload: []byte(p)},
+		}
+	}
+	return messages
+}
+
+func TestToLog_JSON(t *testing.T) {
+	s, buf := newTestToLog(t, &dfv1.Log{Format: dfv1.LogFormatJSON, MaxPayloadBytes: 3})
+	_, errs := s.Write(context.Background(), testMessages("hello", "hi"))
+	assert.Equal(t, make([]error, 2), errs)
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	assert.Len(t, lines, 2)
+	var line jsonLine
+	assert.NoError(t, json.Unmarshal([]byte(lines[0]), &line))
+	assert.Equal(t, jsonLine{
+		Vertex:    "sinks.logger",
+		ID:        "in-0-0",
+		Keys:      []string{"k"},
+		EventTime: time.UnixMilli(1636470000000).UTC(),
+		Headers:   map[string]string{"h": "v"},
+		Payload:   "hel",
+		Truncated: true,
+	}, line)
+	line = jsonLine{}
+	assert.NoError(t, json.Unmarshal([]byte(lines[1]), &line))
+	assert.Equal(t, "hi", line.Payload)
+	assert.False(t, line.Truncated)
+}
+
+func TestToLog_PayloadEncodings(t *testing.T) {
+	s, buf := newTestToLog(t, &dfv1.Log{Format: dfv1.LogFormatPayload, PayloadEncoding: dfv1.LogPayloadEncodingHex})
+	s.Write(context.Background(), testMessages("\x00\xff"))
+	assert.Equal(t, "00ff\n", buf.String())
+
+	s, buf = newTestToLog(t, &dfv1.Log{Format: dfv1.LogFormatPayload, PayloadEncoding: dfv1.LogPayloadEncodingBase64})
+	s.Write(context.Background(), testMessages("hello"))
+	assert.Equal(t, "aGVsbG8=\n", buf.String())
+}
+
+func TestToLog_Sampling(t *testing.T) {
+	s, buf := newTestToLog(t, &dfv1.Log{Format: dfv1.LogFormatPayload, Sampling: &dfv1.LogSampling{EveryN: 3}})
+	_, errs := s.Write(context.Background(), testMessages("0", "1", "2", "3"))
+	assert.Equal(t, make([]error, 4), errs)
+	s.Write(context.Background(), testMessages("4", "5", "6"))
+	assert.Equal(t, "0\n3\n6\n", buf.String())
+
+	s, buf = newTestToLog(t, &dfv1.Log{Format: dfv1.LogFormatPayload, Sampling: &dfv1.LogSampling{PerSecond: 2}})
+	s.Write(context.Background(), testMessages("0", "1", "2", "3", "4", "5"))
+	// at most 2 messages per second, the write may cross a second boundary
+	printed := strings.Count(buf.String(), "\n")
+	assert.GreaterOrEqual(t, printed, 2)
+	assert.LessOrEqual(t, printed, 4)
+}
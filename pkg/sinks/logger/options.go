@@ -0,0 +1,29 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import "io"
+
+type Option func(*ToLog) error
+
+// WithWriter sets the writer to print the json and payload formats to, instead of stdout.
+func WithWriter(w io.Writer) Option {
+	return func(o *ToLog) error {
+		o.out = w
+		return nil
+	}
+}
@@ -18,15 +18,19 @@ package sinks
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"sync"
 
+	"github.com/nats-io/nats.go"
 	"go.uber.org/zap"
 
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/numaproj/numaflow/pkg/forwarder"
 	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/isb/claimcheck"
+	diskisb "github.com/numaproj/numaflow/pkg/isb/stores/disk"
 	jetstreamisb "github.com/numaproj/numaflow/pkg/isb/stores/jetstream"
 	redisisb "github.com/numaproj/numaflow/pkg/isb/stores/redis"
 	"github.com/numaproj/numaflow/pkg/isbsvc"
@@ -37,16 +41,22 @@ import (
 	"github.com/numaproj/numaflow/pkg/shared/callback"
 	jsclient "github.com/numaproj/numaflow/pkg/shared/clients/nats"
 	redisclient "github.com/numaproj/numaflow/pkg/shared/clients/redis"
+	"github.com/numaproj/numaflow/pkg/shared/kvs"
+	jskvs "github.com/numaproj/numaflow/pkg/shared/kvs/jetstream"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
 	sharedutil "github.com/numaproj/numaflow/pkg/shared/util"
 	"github.com/numaproj/numaflow/pkg/sinks/blackhole"
+	filesink "github.com/numaproj/numaflow/pkg/sinks/file"
 	sinkforward "github.com/numaproj/numaflow/pkg/sinks/forward"
+	httpsink "github.com/numaproj/numaflow/pkg/sinks/http"
 	kafkasink "github.com/numaproj/numaflow/pkg/sinks/kafka"
 	logsink "github.com/numaproj/numaflow/pkg/sinks/logger"
 	"github.com/numaproj/numaflow/pkg/sinks/sinker"
+	sqssink "github.com/numaproj/numaflow/pkg/sinks/sqs"
 	"github.com/numaproj/numaflow/pkg/sinks/udsink"
 	"github.com/numaproj/numaflow/pkg/watermark/fetch"
 	"github.com/numaproj/numaflow/pkg/watermark/generic"
+	diskwm "github.com/numaproj/numaflow/pkg/watermark/generic/disk"
 	"github.com/numaproj/numaflow/pkg/watermark/generic/jetstream"
 	"github.com/numaproj/numaflow/pkg/watermark/store"
 	"github.com/numaproj/numaflow/pkg/watermark/wmb"
@@ -67,6 +77,8 @@ func (u *SinkProcessor) Start(ctx context.Context) error {
 		idleManager        wmb.IdleManager
 		sinkHandlers       []*udsink.UDSgRPCBasedUDSink
 		fbSinkHandler      *udsink.UDSgRPCBasedUDSink
+		deadLetterWriter   isb.BufferWriter
+		checkpointStore    kvs.KVStorer
 		healthCheckers     = make([]metrics.HealthChecker, 0)
 		vertexName         = u.VertexInstance.Vertex.Spec.Name
 		pipelineName       = u.VertexInstance.Vertex.Spec.PipelineName
@@ -83,7 +95,10 @@ func (u *SinkProcessor) Start(ctx context.Context) error {
 
 	switch u.ISBSvcType {
 	case dfv1.ISBSvcTypeRedis:
-		redisClient := redisclient.NewInClusterRedisClient()
+		redisClient, err := redisclient.NewInClusterRedisClient()
+		if err != nil {
+			return fmt.Errorf("failed to create a redis client: %w", err)
+		}
 		var readOptions []redisclient.Option
 		if x := u.VertexInstance.Vertex.Spec.Limits; x != nil && x.ReadTimeout != nil {
 			readOptions = append(readOptions, redisclient.WithReadTimeOut(x.ReadTimeout.Duration))
@@ -96,6 +111,13 @@ func (u *SinkProcessor) Start(ctx context.Context) error {
 			reader := redisisb.NewBufferRead(ctx, redisClient, bufferPartition, fromGroup, consumer, int32(index), readOptions...)
 			readers = append(readers, reader)
 		}
+		deadLetterWriter, err = isbsvc.NewRedisDeadLetterWriter(ctx, u.VertexInstance.Vertex, redisClient)
+		if err != nil {
+			return err
+		}
+		if x := u.VertexInstance.Vertex.Spec.Sink.Kafka; x != nil && x.ExactlyOnce {
+			return fmt.Errorf("exactly-once kafka sink is not supported with redis isb service")
+		}
 	case dfv1.ISBSvcTypeJetStream:
 
 		natsClientPool, err = jsclient.NewClientPool(ctx, jsclient.WithClientPoolSize(2))
@@ -108,6 +130,9 @@ func (u *SinkProcessor) Start(ctx context.Context) error {
 		if x := u.VertexInstance.Vertex.Spec.Limits; x != nil && x.ReadTimeout != nil {
 			readOptions = append(readOptions, jetstreamisb.WithReadTimeOut(x.ReadTimeout.Duration))
 		}
+		if x := u.VertexInstance.Vertex.GetInputPriority(); x != nil {
+			readOptions = append(readOptions, jetstreamisb.WithReadPriorityLanes(x.GetWeights()))
+		}
 
 		// create reader for each partition. Each partition is a stream in jetstream
 		for index, bufferPartition := range u.VertexInstance.Vertex.OwnedBuffers() {
@@ -120,6 +145,19 @@ func (u *SinkProcessor) Start(ctx context.Context) error {
 			readers = append(readers, reader)
 		}
 
+		deadLetterWriter, err = isbsvc.NewJetStreamDeadLetterWriter(ctx, u.VertexInstance.Vertex, natsClientPool.NextAvailableClient())
+		if err != nil {
+			return fmt.Errorf("failed to create the dead-letter buffer writer: %w", err)
+		}
+
+		if x := u.VertexInstance.Vertex.Spec.Sink.Kafka; x != nil && x.ExactlyOnce {
+			checkpointStore, err = buildJetStreamCheckpointStore(ctx, u.VertexInstance, natsClientPool.NextAvailableClient())
+			if err != nil {
+				return fmt.Errorf("failed to create the checkpoint store: %w", err)
+			}
+			defer checkpointStore.Close()
+		}
+
 		if u.VertexInstance.Vertex.Spec.Watermark.Disabled {
 			// use default no op fetcher, publisher, idleManager
 		} else {
@@ -138,6 +176,51 @@ func (u *SinkProcessor) Start(ctx context.Context) error {
 				return fmt.Errorf("failed to to vertex watermark stores: %w", err)
 			}
 
+			// create watermark publisher using watermark stores
+			publishWatermark = jetstream.BuildPublishersFromStores(ctx, u.VertexInstance, sinkWmStores)
+			// sink vertex has only one toBuffer, so the length is 1
+			idleManager, _ = wmb.NewIdleManager(len(readers), 1)
+		}
+	case dfv1.ISBSvcTypeDisk:
+		var readOptions []diskisb.ReadOption
+		if x := u.VertexInstance.Vertex.Spec.Limits; x != nil && x.ReadTimeout != nil {
+			readOptions = append(readOptions, diskisb.WithReadTimeOut(x.ReadTimeout.Duration))
+		}
+		// create reader for each partition. Each partition is a buffer directory on the volume
+		for index, bufferPartition := range u.VertexInstance.Vertex.OwnedBuffers() {
+			consumer := fmt.Sprintf("%s-%v", u.VertexInstance.Vertex.Name, u.VertexInstance.Replica)
+			reader, err := diskisb.NewDiskBufferReader(ctx, dfv1.PathISBSvcDiskMount, bufferPartition, consumer, int32(index), readOptions...)
+			if err != nil {
+				return err
+			}
+			readers = append(readers, reader)
+		}
+
+		deadLetterWriter, err = isbsvc.NewDiskDeadLetterWriter(ctx, u.VertexInstance.Vertex)
+		if err != nil {
+			return fmt.Errorf("failed to create the dead-letter buffer writer: %w", err)
+		}
+
+		if x := u.VertexInstance.Vertex.Spec.Sink.Kafka; x != nil && x.ExactlyOnce {
+			return fmt.Errorf("exactly-once kafka sink is not supported with disk isb service")
+		}
+
+		if !u.VertexInstance.Vertex.Spec.Watermark.Disabled {
+			// build from vertex watermark stores
+			fromVertexWmStores, err = diskwm.BuildFromVertexWatermarkStores(ctx, u.VertexInstance, dfv1.PathISBSvcDiskMount)
+			if err != nil {
+				return fmt.Errorf("failed to from vertex watermark stores: %w", err)
+			}
+
+			// create watermark fetcher using watermark stores
+			fetchWatermark = fetch.NewEdgeFetcherSet(ctx, u.VertexInstance, fromVertexWmStores)
+
+			// create watermark stores
+			sinkWmStores, err = diskwm.BuildToVertexWatermarkStores(ctx, u.VertexInstance, dfv1.PathISBSvcDiskMount)
+			if err != nil {
+				return fmt.Errorf("failed to to vertex watermark stores: %w", err)
+			}
+
 			// create watermark publisher using watermark stores
 			publishWatermark = jetstream.BuildPublishersFromStores(ctx, u.VertexInstance, sinkWmStores)
 			// sink vertex has only one toBuffer, so the length is 1
@@ -147,6 +230,14 @@ func (u *SinkProcessor) Start(ctx context.Context) error {
 	default:
 		return fmt.Errorf("unrecognized isb svc type %q", u.ISBSvcType)
 	}
+
+	// store the oversized payloads out of the buffers if the claim check is configured
+	if err := claimcheck.Wrap(ctx, u.VertexInstance.Vertex, readers, nil, &deadLetterWriter); err != nil {
+		return err
+	}
+	if deadLetterWriter != nil {
+		defer func() { _ = deadLetterWriter.Close() }()
+	}
 	maxMessageSize := sharedutil.LookupEnvIntOr(dfv1.EnvGRPCMaxMessageSize, sdkclient.DefaultGRPCMaxMessageSize)
 	if udSink := u.VertexInstance.Vertex.Spec.Sink.UDSink; udSink != nil {
 		// Wait for server info to be ready
@@ -234,8 +325,13 @@ func (u *SinkProcessor) Start(ctx context.Context) error {
 			udsinkHandler = sinkHandlers[index]
 		}
 
-		// create the main sink writer
-		sinkWriter, err := u.createSinkWriter(ctx, &u.VertexInstance.Vertex.Spec.Sink.AbstractSink, udsinkHandler)
+		// create the main sink writer, the exactly-once kafka sink writer commits a checkpoint for each partition.
+		var sinkWriter sinker.SinkWriter
+		if checkpointStore != nil {
+			sinkWriter, err = kafkasink.NewToKafka(ctx, u.VertexInstance, kafkasink.WithExactlyOnce(readers[index].GetName(), checkpointStore))
+		} else {
+			sinkWriter, err = u.createSinkWriter(ctx, &u.VertexInstance.Vertex.Spec.Sink.AbstractSink, udsinkHandler)
+		}
 		if err != nil {
 			return fmt.Errorf("failed to find a sink, error: %w", err)
 		}
@@ -251,6 +347,10 @@ func (u *SinkProcessor) Start(ctx context.Context) error {
 			forwardOpts = append(forwardOpts, sinkforward.WithFbSinkWriter(fbSinkWriter))
 		}
 
+		if deadLetterWriter != nil {
+			forwardOpts = append(forwardOpts, sinkforward.WithDeadLetterWriter(deadLetterWriter))
+		}
+
 		// if the callback is enabled, create a callback publisher
 		cbEnabled := sharedutil.LookupEnvBoolOr(dfv1.EnvCallbackEnabled, false)
 		if cbEnabled {
@@ -324,12 +424,46 @@ func (u *SinkProcessor) Start(ctx context.Context) error {
 	return nil
 }
 
+// buildJetStreamCheckpointStore returns the KV store of the sink checkpoints, it's created if it doesn't exist yet.
+func buildJetStreamCheckpointStore(ctx context.Context, vertexInstance *dfv1.VertexInstance, client *jsclient.Client) (kvs.KVStorer, error) {
+	kvName := isbsvc.JetStreamCheckpointKVName(vertexInstance.Vertex.GetToBuckets()[0])
+	js, err := client.JetStreamContext()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the jetstream context, %w", err)
+	}
+	if _, err := js.KeyValue(kvName); err != nil {
+		if !errors.Is(err, nats.ErrBucketNotFound) && !errors.Is(err, nats.ErrStreamNotFound) {
+			return nil, fmt.Errorf("failed to query information of bucket %q, %w", kvName, err)
+		}
+		// use the same replicas as the buffers of the vertex.
+		streamInfo, err := js.StreamInfo(isbsvc.JetStreamName(vertexInstance.Vertex.OwnedBuffers()[0]))
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the stream information, %w", err)
+		}
+		if _, err := js.CreateKeyValue(&nats.KeyValueConfig{
+			Bucket:   kvName,
+			History:  1,
+			Storage:  nats.FileStorage,
+			Replicas: streamInfo.Config.Replicas,
+		}); err != nil && !errors.Is(err, nats.ErrStreamNameAlreadyInUse) {
+			return nil, fmt.Errorf("failed to create checkpoint KV %q, %w", kvName, err)
+		}
+	}
+	return jskvs.NewKVJetStreamKVStore(ctx, kvName, client)
+}
+
 // createSinkWriter creates a sink writer based on the sink spec
 func (u *SinkProcessor) createSinkWriter(ctx context.Context, abstractSink *dfv1.AbstractSink, sinkHandler udsink.SinkApplier) (sinker.SinkWriter, error) {
 	if x := abstractSink.Log; x != nil {
-		return logsink.NewToLog(ctx, u.VertexInstance)
+		return logsink.NewToLog(ctx, u.VertexInstance, x)
 	} else if x := abstractSink.Kafka; x != nil {
 		return kafkasink.NewToKafka(ctx, u.VertexInstance)
+	} else if x := abstractSink.Sqs; x != nil {
+		return sqssink.NewToSQS(ctx, u.VertexInstance, x)
+	} else if x := abstractSink.File; x != nil {
+		return filesink.NewToFile(ctx, u.VertexInstance, x)
+	} else if x := abstractSink.HTTP; x != nil {
+		return httpsink.NewToHTTP(ctx, u.VertexInstance, x)
 	} else if x := abstractSink.Blackhole; x != nil {
 		return blackhole.NewBlackhole(ctx, u.VertexInstance)
 	} else if x := abstractSink.UDSink; x != nil {
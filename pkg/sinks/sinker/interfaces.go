@@ -17,6 +17,8 @@ limitations under the License.
 package sinker
 
 import (
+	"context"
+
 	"github.com/numaproj/numaflow/pkg/isb"
 )
 
@@ -24,3 +26,24 @@ import (
 type SinkWriter interface {
 	isb.BufferWriter
 }
+
+// TransactionalWriter is a SinkWriter which writes the messages along with a checkpoint of the read offsets
+// atomically, so that the messages redelivered after a restart can be recognized and skipped.
+type TransactionalWriter interface {
+	SinkWriter
+	// Checkpoint returns the latest committed checkpoint, -1 if there's none.
+	Checkpoint() int64
+	// WriteWithCheckpoint writes the messages and commits the checkpoint in one transaction. Either all the
+	// messages are written and the checkpoint is committed, or none of them.
+	WriteWithCheckpoint(ctx context.Context, messages []isb.Message, checkpoint int64) ([]isb.Offset, []error)
+}
+
+// CommittingWriter is a SinkWriter which stages the written messages and commits them later, e.g. when a file rolls.
+// The messages are only acked after they are committed.
+type CommittingWriter interface {
+	SinkWriter
+	// ShouldCommit returns true if the staged messages need to be committed, e.g. they reach the max size or age.
+	ShouldCommit() bool
+	// Commit durably commits all the staged messages.
+	Commit(ctx context.Context) error
+}
@@ -0,0 +1,31 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqs
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/numaproj/numaflow/pkg/metrics"
+)
+
+// sqsSinkWriteErrors is used to indicate the number of messages failed to be sent to the queue
+var sqsSinkWriteErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "sqs_sink",
+	Name:      "write_error_total",
+	Help:      "Total number of messages failed to be sent to SQS",
+}, []string{metrics.LabelVertex, metrics.LabelPipeline})
@@ -0,0 +1,158 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqs
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	"go.uber.org/zap"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/metrics"
+	sqsclient "github.com/numaproj/numaflow/pkg/shared/clients/sqs"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+)
+
+// maxBatchSize is the maximum number of messages SQS allows in a single SendMessageBatch request.
+const maxBatchSize = 10
+
+// Client is the part of the SQS client used by the sink.
+type Client interface {
+	GetQueueUrl(ctx context.Context, params *sqs.GetQueueUrlInput, optFns ...func(*sqs.Options)) (*sqs.GetQueueUrlOutput, error)
+	SendMessageBatch(ctx context.Context, params *sqs.SendMessageBatchInput, optFns ...func(*sqs.Options)) (*sqs.SendMessageBatchOutput, error)
+}
+
+// ToSQS sends the messages to an SQS queue.
+type ToSQS struct {
+	name         string
+	pipelineName string
+	queueName    string
+	queueURL     string
+	client       Client
+	log          *zap.SugaredLogger
+}
+
+// NewToSQS returns ToSQS type.
+func NewToSQS(ctx context.Context, vertexInstance *dfv1.VertexInstance, sqsSink *dfv1.SqsSink, opts ...Option) (*ToSQS, error) {
+	toSQS := &ToSQS{
+		name:         vertexInstance.Vertex.Spec.Name,
+		pipelineName: vertexInstance.Vertex.Spec.PipelineName,
+		queueName:    sqsSink.QueueName,
+		log:          logging.FromContext(ctx).With("sinkType", "sqs").With("queue", sqsSink.QueueName),
+	}
+	for _, o := range opts {
+		if err := o(toSQS); err != nil {
+			return nil, err
+		}
+	}
+	if toSQS.client == nil {
+		client, err := sqsclient.NewClient(ctx, sqsSink.AWSRegion, sqsSink.EndpointURL)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create sqs client, %w", err)
+		}
+		toSQS.client = client
+	}
+	queueURL, err := sqsclient.GetQueueURL(ctx, toSQS.client, sqsSink.QueueName, sqsSink.QueueOwnerAWSAccountID)
+	if err != nil {
+		return nil, err
+	}
+	toSQS.queueURL = queueURL
+	return toSQS, nil
+}
+
+// GetName returns the name.
+func (ts *ToSQS) GetName() string {
+	return ts.name
+}
+
+// GetPartitionIdx returns the partition index.
+// for sink it is always 0.
+func (ts *ToSQS) GetPartitionIdx() int32 {
+	return 0
+}
+
+// Write sends the messages to the queue with SendMessageBatch, in batches of up to 10 messages. The failed entries
+// of a batch are mapped back to the errors of the corresponding messages.
+func (ts *ToSQS) Write(ctx context.Context, messages []isb.Message) ([]isb.Offset, []error) {
+	errs := make([]error, len(messages))
+	for start := 0; start < len(messages); start += maxBatchSize {
+		end := start + maxBatchSize
+		if end > len(messages) {
+			end = len(messages)
+		}
+		entries := make([]types.SendMessageBatchRequestEntry, 0, end-start)
+		for i := start; i < end; i++ {
+			entries = append(entries, types.SendMessageBatchRequestEntry{
+				// The entry id only needs to be unique within the batch, the index is used to map the results back.
+				Id:          aws.String(strconv.Itoa(i)),
+				MessageBody: aws.String(string(messages[i].Payload)),
+			})
+		}
+		output, err := ts.client.SendMessageBatch(ctx, &sqs.SendMessageBatchInput{
+			QueueUrl: aws.String(ts.queueURL),
+			Entries:  entries,
+		})
+		if err != nil {
+			for i := start; i < end; i++ {
+				errs[i] = fmt.Errorf("failed to send messages to sqs, %w", err)
+			}
+			sqsSinkWriteErrors.With(map[string]string{metrics.LabelVertex: ts.name, metrics.LabelPipeline: ts.pipelineName}).Add(float64(end - start))
+			continue
+		}
+		// Any entry which is not reported as successful is considered failed.
+		for i := start; i < end; i++ {
+			errs[i] = fmt.Errorf("no result returned for the message")
+		}
+		for _, s := range output.Successful {
+			if idx, ok := ts.entryIndex(s.Id, start, end); ok {
+				errs[idx] = nil
+			}
+		}
+		for _, f := range output.Failed {
+			if idx, ok := ts.entryIndex(f.Id, start, end); ok {
+				errs[idx] = fmt.Errorf("failed to send message to sqs, code: %s, sender fault: %t, message: %s", aws.ToString(f.Code), f.SenderFault, aws.ToString(f.Message))
+			}
+		}
+		for i := start; i < end; i++ {
+			if errs[i] != nil {
+				sqsSinkWriteErrors.With(map[string]string{metrics.LabelVertex: ts.name, metrics.LabelPipeline: ts.pipelineName}).Inc()
+			}
+		}
+	}
+	return nil, errs
+}
+
+// entryIndex converts the id of a batch result entry back to the index of the message.
+func (ts *ToSQS) entryIndex(id *string, start, end int) (int, bool) {
+	idx, err := strconv.Atoi(aws.ToString(id))
+	if err != nil || idx < start || idx >= end {
+		ts.log.Errorw("Unexpected entry id in the send response", zap.String("id", aws.ToString(id)))
+		return 0, false
+	}
+	return idx, true
+}
+
+func (ts *ToSQS) Close() error {
+	ts.log.Info("SQS sink closed")
+	return nil
+}
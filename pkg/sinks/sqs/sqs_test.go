@@ -0,0 +1,109 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqs
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+)
+
+// fakeClient is an in-memory stand-in of an SQS queue.
+type fakeClient struct {
+	batches  [][]types.SendMessageBatchRequestEntry
+	failBody map[string]bool
+	err      error
+}
+
+func (c *fakeClient) GetQueueUrl(_ context.Context, params *sqs.GetQueueUrlInput, _ ...func(*sqs.Options)) (*sqs.GetQueueUrlOutput, error) {
+	return &sqs.GetQueueUrlOutput{QueueUrl: aws.String("http://localhost:4566/000000000000/" + aws.ToString(params.QueueName))}, nil
+}
+
+func (c *fakeClient) SendMessageBatch(_ context.Context, params *sqs.SendMessageBatchInput, _ ...func(*sqs.Options)) (*sqs.SendMessageBatchOutput, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	c.batches = append(c.batches, params.Entries)
+	out := &sqs.SendMessageBatchOutput{}
+	for _, e := range params.Entries {
+		if c.failBody[aws.ToString(e.MessageBody)] {
+			out.Failed = append(out.Failed, types.BatchResultErrorEntry{Id: e.Id, Code: aws.String("InvalidMessageContents"), SenderFault: true})
+			continue
+		}
+		out.Successful = append(out.Successful, types.SendMessageBatchResultEntry{Id: e.Id, MessageId: aws.String("msg-" + aws.ToString(e.Id))})
+	}
+	return out, nil
+}
+
+func newTestSink(t *testing.T, client *fakeClient) *ToSQS {
+	vi := &dfv1.VertexInstance{
+		Vertex: &dfv1.Vertex{Spec: dfv1.VertexSpec{
+			PipelineName:   "test-pl",
+			AbstractVertex: dfv1.AbstractVertex{Name: "test-v"},
+		}},
+	}
+	ts, err := NewToSQS(context.Background(), vi, &dfv1.SqsSink{AWSRegion: "us-west-2", QueueName: "test-queue"}, WithClient(client))
+	require.NoError(t, err)
+	assert.Equal(t, "http://localhost:4566/000000000000/test-queue", ts.queueURL)
+	return ts
+}
+
+func testMessages(n int) []isb.Message {
+	msgs := make([]isb.Message, n)
+	for i := range msgs {
+		msgs[i] = isb.Message{Body: isb.Body{Payload: []byte(fmt.Sprintf("message-%d", i))}}
+	}
+	return msgs
+}
+
+func TestToSQS_Write(t *testing.T) {
+	client := &fakeClient{failBody: map[string]bool{"message-3": true, "message-12": true}}
+	ts := newTestSink(t, client)
+	defer func() { _ = ts.Close() }()
+
+	_, errs := ts.Write(context.Background(), testMessages(15))
+	require.Len(t, errs, 15)
+	require.Len(t, client.batches, 2)
+	assert.Len(t, client.batches[0], 10)
+	assert.Len(t, client.batches[1], 5)
+	for i, err := range errs {
+		if i == 3 || i == 12 {
+			assert.ErrorContains(t, err, "InvalidMessageContents")
+		} else {
+			assert.NoError(t, err, "message %d", i)
+		}
+	}
+}
+
+func TestToSQS_WriteRequestFailure(t *testing.T) {
+	client := &fakeClient{err: fmt.Errorf("connection refused")}
+	ts := newTestSink(t, client)
+
+	_, errs := ts.Write(context.Background(), testMessages(3))
+	for _, err := range errs {
+		assert.ErrorContains(t, err, "connection refused")
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"strconv"
 	"sync"
 	"time"
@@ -318,6 +319,9 @@ func (df *DataForward) forwardAChunk(ctx context.Context) error {
 			transformerWriteCount += len(m.WriteMessages)
 		}
 		metrics.SourceTransformerWriteMessagesCount.With(metricLabelsWithPartition).Add(float64(transformerWriteCount))
+		if df.opts.schemaType != "" {
+			df.countSchemaValidationFailures(readWriteMessagePairs)
+		}
 
 		df.opts.logger.Debugw("concurrent applyTransformer completed",
 			zap.Int("concurrency", df.opts.transformerConcurrency),
@@ -609,6 +613,26 @@ func (df *DataForward) writeToBuffer(ctx context.Context, toBufferPartition isb.
 	return writeOffsets, nil
 }
 
+// countSchemaValidationFailures counts the messages tagged as invalid by the schemaValidator builtin transformer, which
+// runs in a separate container, so the failures are counted here to be exposed by the vertex metrics server.
+func (df *DataForward) countSchemaValidationFailures(pairs []isb.ReadWriteMessagePair) {
+	failures := 0
+	for _, p := range pairs {
+		for _, m := range p.WriteMessages {
+			if slices.Contains(m.Tags, df.opts.schemaInvalidTag) {
+				failures++
+			}
+		}
+	}
+	if failures > 0 {
+		metrics.SchemaValidationFailures.With(map[string]string{
+			metrics.LabelVertex:     df.vertexName,
+			metrics.LabelPipeline:   df.pipelineName,
+			metrics.LabelSchemaType: df.opts.schemaType,
+		}).Add(float64(failures))
+	}
+}
+
 // applyTransformer applies the transformer and will block if there is any InternalErr. On the other hand, if this is a UserError
 // the skip flag is set. The ShutDown flag will only if there is an InternalErr and ForceStop has been invoked.
 // The UserError retry will be done on the applyTransformer.
@@ -623,7 +647,7 @@ func (df *DataForward) applyTransformer(ctx context.Context, messages []*isb.Rea
 // whereToStep executes the WhereTo interfaces and then updates the to step's writeToBuffers buffer.
 func (df *DataForward) whereToStep(writeMessage *isb.WriteMessage, messageToStep map[string][][]isb.Message) error {
 	// call WhereTo and drop it on errors
-	to, err := df.toWhichStepDecider.WhereTo(writeMessage.Keys, writeMessage.Tags, writeMessage.ID.String())
+	to, err := df.toWhichStepDecider.WhereTo(writeMessage.Keys, writeMessage.Tags, writeMessage.ID.String(), &writeMessage.Message)
 	if err != nil {
 		df.opts.logger.Errorw("failed in whereToStep", zap.Error(isb.MessageWriteErr{
 			Name:    df.reader.GetName(),
@@ -656,7 +680,7 @@ func (df *DataForward) whereToStep(writeMessage *isb.WriteMessage, messageToStep
 				Message: fmt.Sprintf("no such destination (%s)", t.ToVertexName),
 			}))
 		}
-		messageToStep[t.ToVertexName][t.ToVertexPartitionIdx] = append(messageToStep[t.ToVertexName][t.ToVertexPartitionIdx], writeMessage.Message)
+		messageToStep[t.ToVertexName][t.ToVertexPartitionIdx] = append(messageToStep[t.ToVertexName][t.ToVertexPartitionIdx], forwarder.WithPriorityLane(writeMessage.Message, t.PriorityLane))
 	}
 	return nil
 }
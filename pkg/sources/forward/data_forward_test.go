@@ -114,7 +114,7 @@ func (t *testForwardFetcher) ComputeHeadWatermark(int32) wmb.Watermark {
 type myForwardTest struct {
 }
 
-func (f myForwardTest) WhereTo(_ []string, _ []string, s string) ([]forwarder.VertexBuffer, error) {
+func (f myForwardTest) WhereTo(_ []string, _ []string, s string, _ *isb.Message) ([]forwarder.VertexBuffer, error) {
 	return []forwarder.VertexBuffer{{
 		ToVertexName:         "to1",
 		ToVertexPartitionIdx: 0,
@@ -844,7 +844,7 @@ func TestNewDataForward(t *testing.T) {
 type mySourceForwardTest struct {
 }
 
-func (f mySourceForwardTest) WhereTo(_ []string, _ []string, s string) ([]forwarder.VertexBuffer, error) {
+func (f mySourceForwardTest) WhereTo(_ []string, _ []string, s string, _ *isb.Message) ([]forwarder.VertexBuffer, error) {
 	return []forwarder.VertexBuffer{{
 		ToVertexName:         "to1",
 		ToVertexPartitionIdx: 0,
@@ -855,7 +855,7 @@ type mySourceForwardTestRoundRobin struct {
 	count int
 }
 
-func (f *mySourceForwardTestRoundRobin) WhereTo(_ []string, _ []string, s string) ([]forwarder.VertexBuffer, error) {
+func (f *mySourceForwardTestRoundRobin) WhereTo(_ []string, _ []string, s string, _ *isb.Message) ([]forwarder.VertexBuffer, error) {
 	var output = []forwarder.VertexBuffer{{
 		ToVertexName:         "to1",
 		ToVertexPartitionIdx: int32(f.count % 2),
@@ -1156,7 +1156,7 @@ func TestWriteToBuffer(t *testing.T) {
 type myForwardDropTest struct {
 }
 
-func (f myForwardDropTest) WhereTo(_ []string, _ []string, s string) ([]forwarder.VertexBuffer, error) {
+func (f myForwardDropTest) WhereTo(_ []string, _ []string, s string, _ *isb.Message) ([]forwarder.VertexBuffer, error) {
 	return []forwarder.VertexBuffer{}, nil
 }
 
@@ -1168,7 +1168,7 @@ type myForwardToAllTest struct {
 	count int
 }
 
-func (f *myForwardToAllTest) WhereTo(_ []string, _ []string, s string) ([]forwarder.VertexBuffer, error) {
+func (f *myForwardToAllTest) WhereTo(_ []string, _ []string, s string, _ *isb.Message) ([]forwarder.VertexBuffer, error) {
 	var output = []forwarder.VertexBuffer{{
 		ToVertexName:         "to1",
 		ToVertexPartitionIdx: int32(f.count % 2),
@@ -1188,7 +1188,7 @@ func (f *myForwardToAllTest) ApplyTransform(ctx context.Context, messages []*isb
 type myForwardInternalErrTest struct {
 }
 
-func (f myForwardInternalErrTest) WhereTo(_ []string, _ []string, s string) ([]forwarder.VertexBuffer, error) {
+func (f myForwardInternalErrTest) WhereTo(_ []string, _ []string, s string, _ *isb.Message) ([]forwarder.VertexBuffer, error) {
 	return []forwarder.VertexBuffer{{
 		ToVertexName:         "to1",
 		ToVertexPartitionIdx: 0,
@@ -1209,7 +1209,7 @@ func (f myForwardInternalErrTest) ApplyTransform(ctx context.Context, _ []*isb.R
 type myForwardApplyWhereToErrTest struct {
 }
 
-func (f myForwardApplyWhereToErrTest) WhereTo(_ []string, _ []string, s string) ([]forwarder.VertexBuffer, error) {
+func (f myForwardApplyWhereToErrTest) WhereTo(_ []string, _ []string, s string, _ *isb.Message) ([]forwarder.VertexBuffer, error) {
 	return []forwarder.VertexBuffer{{
 		ToVertexName:         "to1",
 		ToVertexPartitionIdx: 0,
@@ -1223,7 +1223,7 @@ func (f myForwardApplyWhereToErrTest) ApplyTransform(ctx context.Context, messag
 type myForwardApplyTransformerErrTest struct {
 }
 
-func (f myForwardApplyTransformerErrTest) WhereTo(_ []string, _ []string, s string) ([]forwarder.VertexBuffer, error) {
+func (f myForwardApplyTransformerErrTest) WhereTo(_ []string, _ []string, s string, _ *isb.Message) ([]forwarder.VertexBuffer, error) {
 	return []forwarder.VertexBuffer{{
 		ToVertexName:         "to1",
 		ToVertexPartitionIdx: 0,
@@ -1334,6 +1334,25 @@ func metricsReset() {
 	metrics.AckMessagesCount.Reset()
 }
 
+func TestCountSchemaValidationFailures(t *testing.T) {
+	df := &DataForward{
+		vertexName:   "in",
+		pipelineName: testPipelineName,
+		opts:         options{schemaType: "jsonSchema", schemaInvalidTag: "bad"},
+	}
+	labels := map[string]string{
+		metrics.LabelVertex:     "in",
+		metrics.LabelPipeline:   testPipelineName,
+		metrics.LabelSchemaType: "jsonSchema",
+	}
+	before := testutil.ToFloat64(metrics.SchemaValidationFailures.With(labels))
+	df.countSchemaValidationFailures([]isb.ReadWriteMessagePair{
+		{WriteMessages: []*isb.WriteMessage{{Tags: []string{"bad"}}, {Tags: []string{"good"}}}},
+		{WriteMessages: []*isb.WriteMessage{{}, {Tags: []string{"x", "bad"}}}},
+	})
+	assert.Equal(t, before+2, testutil.ToFloat64(metrics.SchemaValidationFailures.With(labels)))
+}
+
 // buildPublisherMap builds OTStore and publisher for each toBuffer
 func buildToVertexWatermarkStores(toBuffers map[string][]isb.BufferWriter) map[string]wmstore.WatermarkStore {
 	var ctx = context.Background()
@@ -41,6 +41,10 @@ type options struct {
 	logger *zap.SugaredLogger
 	// cbPublisher is the callback publisher for the vertex.
 	cbPublisher *callback.Uploader
+	// schemaType is the schema type of the schemaValidator builtin transformer, empty if it's not used.
+	schemaType string
+	// schemaInvalidTag is the tag added by the schemaValidator builtin transformer to the messages failing the validation.
+	schemaInvalidTag string
 }
 
 type Option func(*options) error
@@ -102,3 +106,13 @@ func WithCallbackUploader(cp *callback.Uploader) Option {
 		return nil
 	}
 }
+
+// WithSchemaValidation sets the schema type and the invalid tag of the schemaValidator builtin transformer, the
+// transformed messages with the tag are counted as the schema validation failures.
+func WithSchemaValidation(schemaType, invalidTag string) Option {
+	return func(o *options) error {
+		o.schemaType = schemaType
+		o.schemaInvalidTag = invalidTag
+		return nil
+	}
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generator
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+)
+
+// replayer reads the lines of a JSONL file to replay.
+type replayer struct {
+	spec   *dfv1.GeneratorReplay
+	file   *os.File
+	reader *bufio.Reader
+	// first is true until the first line of a replay is read
+	first bool
+	// shift is added to the event times read from the file, when the event times are rewritten
+	shift time.Duration
+	// lines is the number of lines read in the current replay
+	lines int
+	// finished is true after the file is replayed and it doesn't loop
+	finished bool
+}
+
+// replayLine is a line read from the file.
+type replayLine struct {
+	data      []byte
+	key       string
+	eventTime int64
+}
+
+func newReplayer(spec *dfv1.GeneratorReplay) *replayer {
+	return &replayer{spec: spec}
+}
+
+// next returns the next non-empty line of the file, it returns io.EOF after the end of the file if it doesn't loop.
+func (r *replayer) next(now time.Time) (*replayLine, error) {
+	for {
+		if r.finished {
+			return nil, io.EOF
+		}
+		if r.reader == nil {
+			f, err := os.Open(r.spec.Path)
+			if err != nil {
+				return nil, fmt.Errorf("failed to open the replay file, %w", err)
+			}
+			r.file = f
+			r.reader = bufio.NewReader(f)
+			r.first = true
+			r.lines = 0
+		}
+		data, err := r.reader.ReadBytes('\n')
+		if err != nil && err != io.EOF {
+			return nil, fmt.Errorf("failed to read the replay file, %w", err)
+		}
+		if err == io.EOF {
+			_ = r.file.Close()
+			r.file, r.reader = nil, nil
+			r.finished = !r.spec.Loop
+		}
+		if data = bytes.TrimSpace(data); len(data) > 0 {
+			r.lines++
+			return r.parse(data, now)
+		}
+		if err == io.EOF && r.lines == 0 {
+			r.finished = true
+			return nil, fmt.Errorf("the replay file %q is empty", r.spec.Path)
+		}
+	}
+}
+
+// parse reads the key and the event time of a line.
+func (r *replayer) parse(data []byte, now time.Time) (*replayLine, error) {
+	line := &replayLine{data: data, eventTime: now.UnixNano()}
+	if r.spec.KeyField == "" && r.spec.EventTimeField == "" {
+		return line, nil
+	}
+	var fields map[string]any
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, fmt.Errorf("failed to parse the line as a json object, %w", err)
+	}
+	if r.spec.KeyField != "" {
+		if v, ok := fields[r.spec.KeyField]; ok && v != nil {
+			line.key = fmt.Sprint(v)
+		}
+	}
+	if r.spec.EventTimeField != "" {
+		et, err := parseEventTime(fields[r.spec.EventTimeField])
+		if err != nil {
+			return nil, err
+		}
+		if r.spec.RewriteEventTime {
+			if r.first {
+				r.shift = now.Sub(et)
+			}
+			et = et.Add(r.shift)
+		}
+		line.eventTime = et.UnixNano()
+	}
+	r.first = false
+	return line, nil
+}
+
+// parseEventTime parses an event time in RFC3339 format or epoch milliseconds.
+func parseEventTime(v any) (time.Time, error) {
+	switch x := v.(type) {
+	case string:
+		t, err := time.Parse(time.RFC3339Nano, x)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("failed to parse the event time %q, %w", x, err)
+		}
+		return t, nil
+	case float64:
+		return time.UnixMilli(int64(x)), nil
+	default:
+		return time.Time{}, fmt.Errorf("invalid event time %v", v)
+	}
+}
@@ -19,14 +19,18 @@ limitations under the License.
 package generator
 
 import (
+	"bytes"
 	"context"
 	"crypto/rand"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io"
 	rand2 "math/rand"
+	"text/template"
 	"time"
 
+	"github.com/Masterminds/sprig/v3"
 	"go.uber.org/zap"
 
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
@@ -89,7 +93,27 @@ type memGen struct {
 	readTimeout    time.Duration                               // read timeout for the reader
 	vertexInstance *dfv1.VertexInstance                        // vertex instance
 	jitter         time.Duration
-	logger         *zap.SugaredLogger
+	// template is the optional template of the payloads
+	template *template.Template
+	// counter and keyCounters are the numbers of the messages generated, in total and by the key indexes
+	counter     int64
+	keyCounters []int64
+	// replayer replays a file instead of generating the payloads if it's not nil
+	replayer *replayer
+	// outOfOrderPercent of the messages have their event times delayed by outOfOrderDelay
+	outOfOrderPercent int
+	outOfOrderDelay   time.Duration
+	logger            *zap.SugaredLogger
+}
+
+// templateData is the data to render the payload template with.
+type templateData struct {
+	Key        string
+	KeyIndex   int32
+	Replica    int32
+	Counter    int64
+	KeyCounter int64
+	EventTime  time.Time
 }
 
 type Option func(*memGen) error
@@ -166,6 +190,22 @@ func NewMemGen(ctx context.Context, vertexInstance *dfv1.VertexInstance, opts ..
 		logger:         logger,
 	}
 
+	genSrc.keyCounters = make([]int64, keyCount)
+	if x := vertexInstance.Vertex.Spec.Source.Generator.Template; x != nil {
+		tmpl, err := template.New("payload").Funcs(sprig.TxtFuncMap()).Parse(*x)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse the payload template, %w", err)
+		}
+		genSrc.template = tmpl
+	}
+	if x := vertexInstance.Vertex.Spec.Source.Generator.Replay; x != nil {
+		genSrc.replayer = newReplayer(x)
+	}
+	if x := vertexInstance.Vertex.Spec.Source.Generator.OutOfOrder; x != nil {
+		genSrc.outOfOrderPercent = int(x.Percent)
+		genSrc.outOfOrderDelay = x.Delay.Duration
+	}
+
 	for _, o := range opts {
 		if err := o(genSrc); err != nil {
 			return nil, err
@@ -242,24 +282,26 @@ func (mg *memGen) newWorker(ctx context.Context, rate int) func(chan time.Time,
 			case <-ctx.Done():
 				return
 			case ts := <-tickChan:
+				if mg.replayer != nil {
+					if !mg.replay(ctx, ts, rate) {
+						return
+					}
+					continue
+				}
 				// we would generate all the keys in a round robin fashion
 				// even if there are multiple pods, all the pods will generate same keys in the same order.
 				// TODO: alternatively, we could also think about generating a subset of keys per pod.
 				t := ts.UnixNano()
 				for i := 0; i < rate; i++ {
 					for k := int32(0); k < mg.keyCount; k++ {
-						key := fmt.Sprintf("key-%d-%d", mg.vertexInstance.Replica, k)
-						d, err := mg.genFn(mg.msgSize, mg.value, t)
+						key := mg.key(k)
+						d, err := mg.generate(k, key, t)
 						if err != nil {
 							mg.logger.Errorw("Error while generating the record, skipping the record", zap.Error(err))
 							continue
 						}
-						r := record{data: d, offset: time.Now().UTC().UnixNano(), key: key, ts: t}
-						select {
-						case <-ctx.Done():
-							mg.logger.Info("Context.Done is called. returning from the inner function")
+						if !mg.emit(ctx, record{data: d, offset: time.Now().UTC().UnixNano(), key: key, ts: mg.delay(t)}) {
 							return
-						case mg.srcChan <- r:
 						}
 					}
 				}
@@ -268,6 +310,76 @@ func (mg *memGen) newWorker(ctx context.Context, rate int) func(chan time.Time,
 	}
 }
 
+// replay replays the next lines of the file for a tick, it returns false if the context is done.
+func (mg *memGen) replay(ctx context.Context, ts time.Time, rate int) bool {
+	for i := 0; i < rate; i++ {
+		line, err := mg.replayer.next(ts)
+		if err == io.EOF {
+			return true
+		}
+		if err != nil {
+			mg.logger.Errorw("Error while replaying the file, skipping the line", zap.Error(err))
+			continue
+		}
+		key := line.key
+		if key == "" {
+			k := int32(mg.counter % int64(mg.keyCount))
+			key = mg.key(k)
+		}
+		mg.counter++
+		if !mg.emit(ctx, record{data: line.data, offset: time.Now().UTC().UnixNano(), key: key, ts: mg.delay(line.eventTime)}) {
+			return false
+		}
+	}
+	return true
+}
+
+// key returns the key of a key index.
+func (mg *memGen) key(k int32) string {
+	return fmt.Sprintf("key-%d-%d", mg.vertexInstance.Replica, k)
+}
+
+// generate generates a payload, from the template if it's specified.
+func (mg *memGen) generate(keyIndex int32, key string, t int64) ([]byte, error) {
+	if mg.template == nil {
+		return mg.genFn(mg.msgSize, mg.value, t)
+	}
+	data := templateData{
+		Key:        key,
+		KeyIndex:   keyIndex,
+		Replica:    mg.vertexInstance.Replica,
+		Counter:    mg.counter,
+		KeyCounter: mg.keyCounters[keyIndex],
+		EventTime:  time.Unix(0, t),
+	}
+	mg.counter++
+	mg.keyCounters[keyIndex]++
+	var buf bytes.Buffer
+	if err := mg.template.Execute(&buf, data); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// delay delays an event time by the out of order delay, for the out of order percent of the messages.
+func (mg *memGen) delay(t int64) int64 {
+	if mg.outOfOrderPercent > 0 && rand2.Intn(100) < mg.outOfOrderPercent {
+		return t - mg.outOfOrderDelay.Nanoseconds()
+	}
+	return t
+}
+
+// emit sends a record to the source channel, it returns false if the context is done.
+func (mg *memGen) emit(ctx context.Context, r record) bool {
+	select {
+	case <-ctx.Done():
+		mg.logger.Info("Context.Done is called. returning from the inner function")
+		return false
+	case mg.srcChan <- r:
+		return true
+	}
+}
+
 // generator fires once per time unit and generates records and writes them to the channel
 func (mg *memGen) generator(ctx context.Context, rate int, timeunit time.Duration) {
 	// capping the rate to 10000 msgs/sec
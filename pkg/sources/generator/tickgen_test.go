@@ -19,6 +19,10 @@ package generator
 import (
 	"context"
 	"encoding/base64"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -109,3 +113,137 @@ func TestReadProvidedMessageBlob(t *testing.T) {
 		assert.Equal(t, testVal, msg.Body.Payload)
 	}
 }
+
+func newTestVertexInstance(generator *dfv1.GeneratorSource) *dfv1.VertexInstance {
+	return &dfv1.VertexInstance{
+		Vertex: &dfv1.Vertex{
+			ObjectMeta: v1.ObjectMeta{Name: "memGen"},
+			Spec: dfv1.VertexSpec{
+				PipelineName: "testPipeline",
+				AbstractVertex: dfv1.AbstractVertex{
+					Name:   "testVertex",
+					Source: &dfv1.Source{Generator: generator},
+				},
+			},
+		},
+		Hostname: "TestRead",
+		Replica:  0,
+	}
+}
+
+func TestReadTemplate(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	rpu := int64(2)
+	keyCount := int32(2)
+	tmpl := `{"key":"{{ .Key }}","n":{{ .Counter }},"kn":{{ .KeyCounter }},"id":"{{ uuidv4 | len }}"}`
+	mGen, err := NewMemGen(ctx, newTestVertexInstance(&dfv1.GeneratorSource{
+		RPU:      &rpu,
+		KeyCount: &keyCount,
+		Duration: &v1.Duration{Duration: 100 * time.Millisecond},
+		Template: &tmpl,
+	}), WithReadTimeout(3*time.Second))
+	assert.NoError(t, err)
+	messages, err := mGen.Read(ctx, 4)
+	assert.NoError(t, err)
+	assert.Len(t, messages, 4)
+	// the keys are generated in a round robin fashion
+	assert.Equal(t, `{"key":"key-0-0","n":0,"kn":0,"id":"36"}`, string(messages[0].Payload))
+	assert.Equal(t, `{"key":"key-0-1","n":1,"kn":0,"id":"36"}`, string(messages[1].Payload))
+	assert.Equal(t, `{"key":"key-0-0","n":2,"kn":1,"id":"36"}`, string(messages[2].Payload))
+	assert.Equal(t, `{"key":"key-0-1","n":3,"kn":1,"id":"36"}`, string(messages[3].Payload))
+}
+
+func TestReadOutOfOrder(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	mGen, err := NewMemGen(ctx, newTestVertexInstance(&dfv1.GeneratorSource{
+		Duration:   &v1.Duration{Duration: 100 * time.Millisecond},
+		OutOfOrder: &dfv1.GeneratorOutOfOrder{Percent: 100, Delay: v1.Duration{Duration: time.Hour}},
+	}), WithReadTimeout(3*time.Second))
+	assert.NoError(t, err)
+	messages, err := mGen.Read(ctx, 5)
+	assert.NoError(t, err)
+	assert.Len(t, messages, 5)
+	for _, msg := range messages {
+		assert.True(t, time.Since(msg.EventTime) >= time.Hour)
+	}
+}
+
+func writeReplayFile(t *testing.T, lines ...string) string {
+	p := filepath.Join(t.TempDir(), "replay.jsonl")
+	var content string
+	for _, l := range lines {
+		content += l + "\n"
+	}
+	assert.NoError(t, os.WriteFile(p, []byte(content), 0o644))
+	return p
+}
+
+func TestReplayer(t *testing.T) {
+	p := writeReplayFile(t,
+		`{"user":"a","ts":"2024-05-06T07:08:09Z"}`,
+		``,
+		`{"user":"b","ts":1714979290000}`,
+	)
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("loop with event time rewrite", func(t *testing.T) {
+		r := newReplayer(&dfv1.GeneratorReplay{Path: p, Loop: true, KeyField: "user", EventTimeField: "ts", RewriteEventTime: true})
+		for i := 0; i < 2; i++ {
+			start := now.Add(time.Duration(i) * time.Hour)
+			line, err := r.next(start)
+			assert.NoError(t, err)
+			assert.Equal(t, "a", line.key)
+			assert.Equal(t, start.UnixNano(), line.eventTime)
+			line, err = r.next(start.Add(time.Minute))
+			assert.NoError(t, err)
+			assert.Equal(t, `{"user":"b","ts":1714979290000}`, string(line.data))
+			// the interval between the lines is kept
+			assert.Equal(t, start.Add(time.Second).UnixNano(), line.eventTime)
+		}
+	})
+
+	t.Run("once", func(t *testing.T) {
+		r := newReplayer(&dfv1.GeneratorReplay{Path: p, EventTimeField: "ts"})
+		line, err := r.next(now)
+		assert.NoError(t, err)
+		assert.Equal(t, time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC).UnixNano(), line.eventTime)
+		_, err = r.next(now)
+		assert.NoError(t, err)
+		_, err = r.next(now)
+		assert.Equal(t, io.EOF, err)
+	})
+
+	t.Run("empty file", func(t *testing.T) {
+		r := newReplayer(&dfv1.GeneratorReplay{Path: writeReplayFile(t), Loop: true})
+		_, err := r.next(now)
+		assert.Error(t, err)
+		_, err = r.next(now)
+		assert.Equal(t, io.EOF, err)
+	})
+}
+
+func TestReadReplay(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var lines []string
+	for i := 0; i < 3; i++ {
+		lines = append(lines, fmt.Sprintf(`{"n":%d}`, i))
+	}
+	rpu := int64(10)
+	mGen, err := NewMemGen(ctx, newTestVertexInstance(&dfv1.GeneratorSource{
+		RPU:      &rpu,
+		Duration: &v1.Duration{Duration: 100 * time.Millisecond},
+		Replay:   &dfv1.GeneratorReplay{Path: writeReplayFile(t, lines...)},
+	}), WithReadTimeout(time.Second))
+	assert.NoError(t, err)
+	messages, err := mGen.Read(ctx, 5)
+	assert.NoError(t, err)
+	// the file is replayed only once
+	assert.Len(t, messages, 3)
+	for i, msg := range messages {
+		assert.Equal(t, lines[i], string(msg.Payload))
+		assert.Equal(t, []string{"key-0-0"}, msg.Keys)
+	}
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/tidwall/gjson"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+)
+
+// batchElement is the ID and event time extracted from an element of a batch request,
+// empty values mean they are not available in the element.
+type batchElement struct {
+	id        string
+	eventTime time.Time
+}
+
+// splitBatch splits the body of a batch request into the elements, the body could be a JSON array,
+// or newline-delimited JSON where the empty lines are ignored.
+func splitBatch(body []byte) ([][]byte, error) {
+	trimmed := bytes.TrimSpace(body)
+	if len(trimmed) == 0 {
+		return nil, fmt.Errorf("empty batch")
+	}
+	if trimmed[0] == '[' {
+		var elements []json.RawMessage
+		if err := json.Unmarshal(trimmed, &elements); err != nil {
+			return nil, fmt.Errorf("invalid JSON array, %w", err)
+		}
+		result := make([][]byte, 0, len(elements))
+		for _, e := range elements {
+			result = append(result, e)
+		}
+		return result, nil
+	}
+	var result [][]byte
+	scanner := bufio.NewScanner(bytes.NewReader(trimmed))
+	// no limit on the line size other than the body itself
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), len(trimmed)+1)
+	for line := 1; scanner.Scan(); line++ {
+		l := bytes.TrimSpace(scanner.Bytes())
+		if len(l) == 0 {
+			continue
+		}
+		if !json.Valid(l) {
+			return nil, fmt.Errorf("invalid JSON at line %d", line)
+		}
+		// the scanner reuses its buffer
+		result = append(result, bytes.Clone(l))
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read newline-delimited JSON, %w", err)
+	}
+	return result, nil
+}
+
+// parseBatchElement extracts the ID and event time from an element with the configured fields.
+func parseBatchElement(spec *dfv1.HTTPBatch, element []byte) (batchElement, error) {
+	var result batchElement
+	if spec == nil {
+		return result, nil
+	}
+	if spec.IDField != "" {
+		if v := gjson.GetBytes(element, spec.IDField); v.Exists() && v.Type != gjson.Null {
+			result.id = v.String()
+		}
+	}
+	if spec.EventTimeField != "" {
+		v := gjson.GetBytes(element, spec.EventTimeField)
+		switch v.Type {
+		case gjson.Number:
+			result.eventTime = time.UnixMilli(v.Int())
+		case gjson.String:
+			t, err := time.Parse(time.RFC3339Nano, v.Str)
+			if err != nil {
+				return result, fmt.Errorf("invalid event time %q, %w", v.Str, err)
+			}
+			result.eventTime = t
+		case gjson.Null:
+		default:
+			return result, fmt.Errorf("invalid event time %s, expecting milliseconds or a RFC3339 string", v.Raw)
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+)
+
+func TestSplitBatch(t *testing.T) {
+	t.Run("json array", func(t *testing.T) {
+		elements, err := splitBatch([]byte(` [{"a": 1}, "b", 3] `))
+		assert.NoError(t, err)
+		assert.Equal(t, [][]byte{[]byte(`{"a": 1}`), []byte(`"b"`), []byte(`3`)}, elements)
+	})
+
+	t.Run("ndjson", func(t *testing.T) {
+		elements, err := splitBatch([]byte("{\"a\": 1}\n\n  {\"a\": 2}\r\n\"c\"\n"))
+		assert.NoError(t, err)
+		assert.Equal(t, [][]byte{[]byte(`{"a": 1}`), []byte(`{"a": 2}`), []byte(`"c"`)}, elements)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := splitBatch([]byte("  \n "))
+		assert.Error(t, err)
+		_, err = splitBatch([]byte(`[{"a": 1}`))
+		assert.Error(t, err)
+		_, err = splitBatch([]byte("{\"a\": 1}\n{\"a\": "))
+		assert.ErrorContains(t, err, "line 2")
+	})
+}
+
+func TestParseBatchElement(t *testing.T) {
+	spec := &dfv1.HTTPBatch{IDField: "meta.id", EventTimeField: "ts"}
+
+	e, err := parseBatchElement(nil, []byte(`{"meta": {"id": "x"}}`))
+	assert.NoError(t, err)
+	assert.Equal(t, batchElement{}, e)
+
+	e, err = parseBatchElement(spec, []byte(`{"meta": {"id": "x"}, "ts": 1663006726000}`))
+	assert.NoError(t, err)
+	assert.Equal(t, "x", e.id)
+	assert.Equal(t, time.UnixMilli(1663006726000), e.eventTime)
+
+	e, err = parseBatchElement(spec, []byte(`{"meta": {"id": 12}, "ts": "2022-09-12T18:18:46Z"}`))
+	assert.NoError(t, err)
+	assert.Equal(t, "12", e.id)
+	assert.True(t, time.UnixMilli(1663006726000).Equal(e.eventTime))
+
+	e, err = parseBatchElement(spec, []byte(`"hello"`))
+	assert.NoError(t, err)
+	assert.Equal(t, batchElement{}, e)
+
+	_, err = parseBatchElement(spec, []byte(`{"ts": "yesterday"}`))
+	assert.Error(t, err)
+	_, err = parseBatchElement(spec, []byte(`{"ts": true}`))
+	assert.Error(t, err)
+}
@@ -19,12 +19,14 @@ package http
 import (
 	"context"
 	"crypto/tls"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
@@ -47,8 +49,14 @@ type httpSource struct {
 	readTimeout   time.Duration
 	bufferSize    int
 	messages      chan *isb.ReadMessage
-	logger        *zap.SugaredLogger
-	shutdown      func(context.Context) error
+	batch         *dfv1.HTTPBatch
+	ackAfterWrite bool
+	ackTimeout    time.Duration
+	// acks holds the channels of the requests waiting for the messages to be written, keyed by the read offset.
+	acks     map[string][]chan struct{}
+	acksLock sync.Mutex
+	logger   *zap.SugaredLogger
+	shutdown func(context.Context) error
 }
 
 type Option func(*httpSource) error
@@ -70,6 +78,7 @@ func WithBufferSize(s int) Option {
 
 // NewHttpSource creates a new http source reader.
 func NewHttpSource(ctx context.Context, vertexInstance *dfv1.VertexInstance, opts ...Option) (sourcer.SourceReader, error) {
+	httpSpec := vertexInstance.Vertex.Spec.Source.HTTP
 	h := &httpSource{
 		vertexName:    vertexInstance.Vertex.Spec.Name,
 		pipelineName:  vertexInstance.Vertex.Spec.PipelineName,
@@ -77,6 +86,10 @@ func NewHttpSource(ctx context.Context, vertexInstance *dfv1.VertexInstance, opt
 		ready:         atomic.Bool{},
 		bufferSize:    1000,            // default size
 		readTimeout:   1 * time.Second, // default timeout
+		batch:         httpSpec.Batch,
+		ackAfterWrite: httpSpec.AckAfterWrite,
+		ackTimeout:    httpSpec.GetAckTimeout(),
+		acks:          make(map[string][]chan struct{}),
 		logger:        logging.FromContext(ctx),
 	}
 
@@ -89,7 +102,7 @@ func NewHttpSource(ctx context.Context, vertexInstance *dfv1.VertexInstance, opt
 	h.messages = make(chan *isb.ReadMessage, h.bufferSize)
 
 	auth := ""
-	if x := vertexInstance.Vertex.Spec.Source.HTTP.Auth; x != nil && x.Token != nil {
+	if x := httpSpec.Auth; x != nil && x.Token != nil {
 		if s, err := sharedutil.GetSecretFromVolume(x.Token); err != nil {
 			return nil, fmt.Errorf("failed to get auth token, %w", err)
 		} else {
@@ -105,6 +118,19 @@ func NewHttpSource(ctx context.Context, vertexInstance *dfv1.VertexInstance, opt
 		h.ready.Store(false)
 	}()
 
+	// accept checks the authorization and the readiness of the source, it writes the error response if the request can't be accepted.
+	accept := func(w http.ResponseWriter, r *http.Request) bool {
+		if auth != "" && r.Header.Get("Authorization") != "Bearer "+auth {
+			http.Error(w, "request not authorized", http.StatusForbidden)
+			return false
+		}
+		if !h.ready.Load() {
+			http.Error(w, "http source not ready", http.StatusServiceUnavailable)
+			return false
+		}
+		return true
+	}
+
 	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
 		if !h.ready.Load() {
 			http.Error(w, "http source not ready", http.StatusServiceUnavailable)
@@ -113,12 +139,7 @@ func NewHttpSource(ctx context.Context, vertexInstance *dfv1.VertexInstance, opt
 		w.WriteHeader(http.StatusNoContent)
 	})
 	mux.HandleFunc("/vertices/"+vertexInstance.Vertex.Spec.Name, func(w http.ResponseWriter, r *http.Request) {
-		if auth != "" && r.Header.Get("Authorization") != "Bearer "+auth {
-			http.Error(w, "request not authorized", http.StatusForbidden)
-			return
-		}
-		if !h.ready.Load() {
-			http.Error(w, "http source not ready", http.StatusServiceUnavailable)
+		if !accept(w, r) {
 			return
 		}
 		msg, err := io.ReadAll(r.Body)
@@ -128,49 +149,78 @@ func NewHttpSource(ctx context.Context, vertexInstance *dfv1.VertexInstance, opt
 			return
 		}
 
-		id := r.Header.Get(dfv1.KeyMetaID)
+		id, eventTime, headers, err := parseRequestHeaders(r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
 		if id == "" {
 			id = uuid.New().String()
 		}
-		eventTime := time.Now()
-		if x := r.Header.Get(dfv1.KeyMetaEventTime); x != "" {
-			i, err := strconv.ParseInt(x, 10, 64)
+
+		results := h.ingest(r.Context(), []*isb.ReadMessage{h.newReadMessage(id, eventTime, headers, msg)})
+		switch results[0].Status {
+		case statusAccepted, statusWritten:
+			w.WriteHeader(http.StatusNoContent)
+		case statusTimeout:
+			http.Error(w, results[0].Error, http.StatusGatewayTimeout)
+		default:
+			http.Error(w, results[0].Error, http.StatusServiceUnavailable)
+		}
+	})
+	mux.HandleFunc("/vertices/"+vertexInstance.Vertex.Spec.Name+"/batch", func(w http.ResponseWriter, r *http.Request) {
+		if !accept(w, r) {
+			return
+		}
+		body, err := io.ReadAll(r.Body)
+		_ = r.Body.Close()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		id, eventTime, headers, err := parseRequestHeaders(r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		// the whole batch is rejected if any of the elements is invalid, so that nothing is partially ingested.
+		elements, err := splitBatch(body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		msgs := make([]*isb.ReadMessage, 0, len(elements))
+		for i, element := range elements {
+			m, err := parseBatchElement(h.batch, element)
 			if err != nil {
-				http.Error(w, err.Error(), http.StatusBadRequest)
+				http.Error(w, fmt.Sprintf("invalid element %d, %v", i, err), http.StatusBadRequest)
 				return
 			}
-			eventTime = time.UnixMilli(i)
-		}
-
-		// we don't need to consider event time in the header
-		r.Header.Del(dfv1.KeyMetaEventTime)
-		// TODO(security): Auth headers will be passed
-		// https://github.com/numaproj/numaflow/issues/1583
-		headers := make(map[string]string, len(r.Header))
-		for k, v := range r.Header {
-			// multi-value headers are joined with ","
-			headers[k] = strings.Join(v, ",")
-		}
-
-		m := &isb.ReadMessage{
-			Message: isb.Message{
-				Header: isb.Header{
-					MessageInfo: isb.MessageInfo{EventTime: eventTime},
-					ID: isb.MessageID{
-						VertexName: h.vertexName,
-						Offset:     id,
-						Index:      h.vertexReplica,
-					},
-					Headers: headers,
-				},
-				Body: isb.Body{
-					Payload: msg,
-				},
-			},
-			ReadOffset: isb.NewSimpleStringPartitionOffset(id, vertexInstance.Replica),
+			if m.id == "" {
+				if id != "" {
+					m.id = fmt.Sprintf("%s-%d", id, i)
+				} else {
+					m.id = uuid.New().String()
+				}
+			}
+			if m.eventTime.IsZero() {
+				m.eventTime = eventTime
+			}
+			msgs = append(msgs, h.newReadMessage(m.id, m.eventTime, headers, element))
 		}
-		h.messages <- m
-		w.WriteHeader(http.StatusNoContent)
+
+		results := h.ingest(r.Context(), msgs)
+		statusCode := http.StatusOK
+		for _, result := range results {
+			if result.Status != statusAccepted && result.Status != statusWritten {
+				statusCode = http.StatusMultiStatus
+				break
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		_ = json.NewEncoder(w).Encode(batchResponse{Results: results})
 	})
 	cer, err := sharedtls.GenerateX509KeyPair()
 	if err != nil {
@@ -193,6 +243,163 @@ func NewHttpSource(ctx context.Context, vertexInstance *dfv1.VertexInstance, opt
 	return h, nil
 }
 
+const (
+	// statusAccepted means the message is accepted by the source, but not written to the inter-step buffer yet.
+	statusAccepted = "accepted"
+	// statusWritten means the message is written to the inter-step buffer.
+	statusWritten = "written"
+	// statusTimeout means the message is not written to the inter-step buffer before the ack timeout.
+	statusTimeout = "timeout"
+	// statusFailed means the message is not accepted by the source.
+	statusFailed = "failed"
+)
+
+// messageResult is the ingestion result of a message.
+type messageResult struct {
+	ID     string `json:"id"`
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// batchResponse is the response body of the batch endpoint.
+type batchResponse struct {
+	Results []messageResult `json:"results"`
+}
+
+// parseRequestHeaders returns the message ID, the event time and the headers of the request.
+func parseRequestHeaders(r *http.Request) (string, time.Time, map[string]string, error) {
+	id := r.Header.Get(dfv1.KeyMetaID)
+	eventTime := time.Now()
+	if x := r.Header.Get(dfv1.KeyMetaEventTime); x != "" {
+		i, err := strconv.ParseInt(x, 10, 64)
+		if err != nil {
+			return "", time.Time{}, nil, err
+		}
+		eventTime = time.UnixMilli(i)
+	}
+
+	// we don't need to consider event time in the header
+	r.Header.Del(dfv1.KeyMetaEventTime)
+	// TODO(security): Auth headers will be passed
+	// https://github.com/numaproj/numaflow/issues/1583
+	headers := make(map[string]string, len(r.Header))
+	for k, v := range r.Header {
+		// multi-value headers are joined with ","
+		headers[k] = strings.Join(v, ",")
+	}
+	return id, eventTime, headers, nil
+}
+
+func (h *httpSource) newReadMessage(id string, eventTime time.Time, headers map[string]string, payload []byte) *isb.ReadMessage {
+	return &isb.ReadMessage{
+		Message: isb.Message{
+			Header: isb.Header{
+				MessageInfo: isb.MessageInfo{EventTime: eventTime},
+				ID: isb.MessageID{
+					VertexName: h.vertexName,
+					Offset:     id,
+					Index:      h.vertexReplica,
+				},
+				Headers: headers,
+			},
+			Body: isb.Body{
+				Payload: payload,
+			},
+		},
+		ReadOffset: isb.NewSimpleStringPartitionOffset(id, h.vertexReplica),
+	}
+}
+
+// ingest sends the messages to be read by the forwarder, and returns the result of each message.
+// If ackAfterWrite is enabled, it blocks until the messages are written to the inter-step buffer, or the ack timeout is reached.
+func (h *httpSource) ingest(ctx context.Context, msgs []*isb.ReadMessage) []messageResult {
+	results := make([]messageResult, len(msgs))
+	var waiters []chan struct{}
+	if h.ackAfterWrite {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, h.ackTimeout)
+		defer cancel()
+		waiters = make([]chan struct{}, len(msgs))
+	}
+
+	// failed returns the result of a message which is interrupted by the context.
+	failed := func(id string) messageResult {
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return messageResult{ID: id, Status: statusTimeout, Error: "timed out waiting for the message to be written"}
+		}
+		return messageResult{ID: id, Status: statusFailed, Error: ctx.Err().Error()}
+	}
+
+	sent := 0
+send:
+	for _, m := range msgs {
+		if h.ackAfterWrite {
+			// register before sending, the message could be written and acked before we start waiting.
+			waiters[sent] = h.registerAck(m.ReadOffset.String())
+		}
+		select {
+		case h.messages <- m:
+			results[sent] = messageResult{ID: m.ID.Offset, Status: statusAccepted}
+			sent++
+		case <-ctx.Done():
+			break send
+		}
+	}
+	for i := sent; i < len(msgs); i++ {
+		if h.ackAfterWrite && waiters[i] != nil {
+			h.deregisterAck(msgs[i].ReadOffset.String(), waiters[i])
+		}
+		results[i] = failed(msgs[i].ID.Offset)
+	}
+	if !h.ackAfterWrite {
+		return results
+	}
+
+	for i := 0; i < sent; i++ {
+		select {
+		case <-waiters[i]:
+			results[i].Status = statusWritten
+		case <-ctx.Done():
+			h.deregisterAck(msgs[i].ReadOffset.String(), waiters[i])
+			select {
+			case <-waiters[i]:
+				// acked right before the deregistration
+				results[i].Status = statusWritten
+			default:
+				results[i] = failed(msgs[i].ID.Offset)
+			}
+		}
+	}
+	return results
+}
+
+// registerAck returns a channel which is closed when the offset is acked.
+func (h *httpSource) registerAck(offset string) chan struct{} {
+	ch := make(chan struct{})
+	h.acksLock.Lock()
+	defer h.acksLock.Unlock()
+	h.acks[offset] = append(h.acks[offset], ch)
+	return ch
+}
+
+// deregisterAck removes a channel which is no longer waited for.
+func (h *httpSource) deregisterAck(offset string, ch chan struct{}) {
+	h.acksLock.Lock()
+	defer h.acksLock.Unlock()
+	waiters := h.acks[offset]
+	for i, w := range waiters {
+		if w == ch {
+			waiters = append(waiters[:i:i], waiters[i+1:]...)
+			break
+		}
+	}
+	if len(waiters) == 0 {
+		delete(h.acks, offset)
+	} else {
+		h.acks[offset] = waiters
+	}
+}
+
 // GetName returns the name of the source.
 func (h *httpSource) GetName() string {
 	return h.vertexName
@@ -225,6 +432,21 @@ func (h *httpSource) Pending(_ context.Context) (int64, error) {
 }
 
 func (h *httpSource) Ack(_ context.Context, offsets []isb.Offset) []error {
+	if h.ackAfterWrite {
+		h.acksLock.Lock()
+		for _, offset := range offsets {
+			key := offset.String()
+			if waiters := h.acks[key]; len(waiters) > 0 {
+				close(waiters[0])
+				if len(waiters) == 1 {
+					delete(h.acks, key)
+				} else {
+					h.acks[key] = waiters[1:]
+				}
+			}
+		}
+		h.acksLock.Unlock()
+	}
 	return make([]error, len(offsets))
 }
 
@@ -24,6 +24,8 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
 )
 
 func TestWithBufferSize(t *testing.T) {
@@ -67,3 +69,90 @@ func Test_NewHTTP(t *testing.T) {
 	assert.NotNil(t, h.(*httpSource).shutdown)
 	assert.True(t, h.(*httpSource).ready.Load())
 }
+
+func newTestSource(ackAfterWrite bool, bufferSize int) *httpSource {
+	return &httpSource{
+		vertexName:    "test-v",
+		ackAfterWrite: ackAfterWrite,
+		ackTimeout:    500 * time.Millisecond,
+		acks:          make(map[string][]chan struct{}),
+		readTimeout:   time.Second,
+		messages:      make(chan *isb.ReadMessage, bufferSize),
+		logger:        logging.NewLogger(),
+	}
+}
+
+func Test_ingest(t *testing.T) {
+	t.Run("without ack", func(t *testing.T) {
+		h := newTestSource(false, 10)
+		msgs := []*isb.ReadMessage{
+			h.newReadMessage("a", time.Now(), nil, []byte("1")),
+			h.newReadMessage("b", time.Now(), nil, []byte("2")),
+		}
+		results := h.ingest(context.Background(), msgs)
+		assert.Equal(t, []messageResult{{ID: "a", Status: statusAccepted}, {ID: "b", Status: statusAccepted}}, results)
+		assert.Len(t, h.messages, 2)
+	})
+
+	t.Run("request cancelled", func(t *testing.T) {
+		h := newTestSource(false, 1)
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		h.messages <- h.newReadMessage("x", time.Now(), nil, nil)
+		results := h.ingest(ctx, []*isb.ReadMessage{h.newReadMessage("a", time.Now(), nil, nil)})
+		assert.Equal(t, statusFailed, results[0].Status)
+	})
+
+	t.Run("ack after write", func(t *testing.T) {
+		h := newTestSource(true, 10)
+		msgs := []*isb.ReadMessage{
+			h.newReadMessage("a", time.Now(), nil, []byte("1")),
+			h.newReadMessage("b", time.Now(), nil, []byte("2")),
+		}
+		go func() {
+			read, err := h.Read(context.Background(), 1)
+			assert.NoError(t, err)
+			// only the first message is written
+			h.Ack(context.Background(), []isb.Offset{read[0].ReadOffset})
+		}()
+		results := h.ingest(context.Background(), msgs)
+		assert.Equal(t, messageResult{ID: "a", Status: statusWritten}, results[0])
+		assert.Equal(t, "b", results[1].ID)
+		assert.Equal(t, statusTimeout, results[1].Status)
+		assert.Empty(t, h.acks)
+	})
+
+	t.Run("buffer full", func(t *testing.T) {
+		h := newTestSource(true, 1)
+		msgs := []*isb.ReadMessage{
+			h.newReadMessage("a", time.Now(), nil, nil),
+			h.newReadMessage("b", time.Now(), nil, nil),
+		}
+		results := h.ingest(context.Background(), msgs)
+		assert.Equal(t, statusTimeout, results[0].Status)
+		assert.Equal(t, statusTimeout, results[1].Status)
+		assert.Empty(t, h.acks)
+	})
+}
+
+func Test_Ack_sameOffset(t *testing.T) {
+	h := newTestSource(true, 10)
+	offset := isb.NewSimpleStringPartitionOffset("a", 0)
+	ch1 := h.registerAck(offset.String())
+	ch2 := h.registerAck(offset.String())
+	h.Ack(context.Background(), []isb.Offset{offset})
+	assert.True(t, isClosed(ch1))
+	assert.False(t, isClosed(ch2))
+	h.Ack(context.Background(), []isb.Offset{offset})
+	assert.True(t, isClosed(ch2))
+	assert.Empty(t, h.acks)
+}
+
+func isClosed(ch chan struct{}) bool {
+	select {
+	case <-ch:
+		return true
+	default:
+		return false
+	}
+}
@@ -266,18 +266,78 @@ func (ns *jsSource) createOrUpdateConsumer(ctx context.Context) (jetstreamlib.Co
 	}
 
 	consumerName := fmt.Sprintf("numaflow-%s-%s-%s", ns.pipelineName, ns.vertexName, streamName)
-	consumer, err := stream.CreateOrUpdateConsumer(ctx, streamName, jetstreamlib.ConsumerConfig{
+	config := jetstreamlib.ConsumerConfig{
 		Durable:       consumerName,
 		Description:   "Numaflow JetStream consumer",
 		DeliverPolicy: jetstreamlib.DeliverAllPolicy,
 		AckPolicy:     jetstreamlib.AckExplicitPolicy,
-	})
+	}
+	if ns.jsSpec != nil {
+		if err := applyConsumerOptions(&config, ns.jsSpec); err != nil {
+			ns.natsConn.Close()
+			return nil, err
+		}
+	}
+	if config.Durable != "" {
+		// the deliver policy of an existing durable consumer can not be updated, keep it as is.
+		current, err := stream.Consumer(ctx, streamName, config.Durable)
+		switch {
+		case err == nil:
+			currentConfig := current.CachedInfo().Config
+			config.DeliverPolicy = currentConfig.DeliverPolicy
+			config.OptStartSeq = currentConfig.OptStartSeq
+			config.OptStartTime = currentConfig.OptStartTime
+		case !errors.Is(err, jetstreamlib.ErrConsumerNotFound):
+			return nil, fmt.Errorf("getting jetstream consumer %q of stream %q: %w", config.Durable, streamName, err)
+		}
+	}
+	consumer, err := stream.CreateOrUpdateConsumer(ctx, streamName, config)
 	if err != nil {
 		return nil, fmt.Errorf("creating jetstream consumer for stream %q: %w", streamName, err)
 	}
 	return consumer, nil
 }
 
+// applyConsumerOptions applies the consumer options of the source spec to the consumer config.
+func applyConsumerOptions(config *jetstreamlib.ConsumerConfig, spec *dfv1.JetStreamSource) error {
+	switch len(spec.FilterSubjects) {
+	case 0:
+	case 1:
+		// a single filter subject is also supported by the servers before 2.10
+		config.FilterSubject = spec.FilterSubjects[0]
+	default:
+		config.FilterSubjects = spec.FilterSubjects
+	}
+	switch spec.DeliverPolicy {
+	case "", dfv1.JetStreamDeliverAll:
+		config.DeliverPolicy = jetstreamlib.DeliverAllPolicy
+	case dfv1.JetStreamDeliverNew:
+		config.DeliverPolicy = jetstreamlib.DeliverNewPolicy
+	case dfv1.JetStreamDeliverByStartTime:
+		if spec.StartTime == nil {
+			return fmt.Errorf("startTime is required by the deliver policy %q", spec.DeliverPolicy)
+		}
+		config.DeliverPolicy = jetstreamlib.DeliverByStartTimePolicy
+		config.OptStartTime = &spec.StartTime.Time
+	case dfv1.JetStreamDeliverBySequence:
+		if spec.StartSequence == 0 {
+			return fmt.Errorf("startSequence is required by the deliver policy %q", spec.DeliverPolicy)
+		}
+		config.DeliverPolicy = jetstreamlib.DeliverByStartSequencePolicy
+		config.OptStartSeq = spec.StartSequence
+	default:
+		return fmt.Errorf("unsupported deliver policy %q", spec.DeliverPolicy)
+	}
+	if spec.MaxAckPending > 0 {
+		config.MaxAckPending = int(spec.MaxAckPending)
+	}
+	config.AckWait = spec.GetAckWait()
+	if spec.Ephemeral {
+		config.Durable = ""
+	}
+	return nil
+}
+
 func (ns *jsSource) registerConsumerHandler(ctx context.Context) (jetstreamlib.ConsumeContext, error) {
 	consumerInfo, err := ns.consumer.Info(ctx)
 	if err != nil {
@@ -309,6 +369,12 @@ func (ns *jsSource) registerConsumerHandler(ctx context.Context) (jetstreamlib.C
 		}
 
 		readOffset := newOffset(msg, metadata.Sequence.Stream, inProgressTickDuration, ns.logger)
+		var keys []string
+		if ns.jsSpec != nil && ns.jsSpec.KeyFromHeader != "" {
+			if v := headers[ns.jsSpec.KeyFromHeader]; v != "" {
+				keys = []string{v}
+			}
+		}
 
 		m := &isb.ReadMessage{
 			Message: isb.Message{
@@ -319,6 +385,7 @@ func (ns *jsSource) registerConsumerHandler(ctx context.Context) (jetstreamlib.C
 						Offset:     readOffset.String(),
 						Index:      readOffset.PartitionIdx(),
 					},
+					Keys:    keys,
 					Headers: headers,
 				},
 				Body: isb.Body{
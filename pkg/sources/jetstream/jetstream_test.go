@@ -2,11 +2,14 @@ package jetstream
 
 import (
 	"context"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/nats-io/nats.go"
 	"github.com/nats-io/nats.go/jetstream"
 	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/numaproj/numaflow/pkg/shared/clients/nats/test"
@@ -116,3 +119,135 @@ func TestJetstreamSourceServingEnabled(t *testing.T) {
 
 	assert.Len(t, messages, 10)
 }
+
+func TestJetstreamReadWithConsumerOptions(t *testing.T) {
+	ctx := context.Background()
+	s := test.RunJetStreamServer(t)
+	defer test.ShutdownJetStreamServer(t, s)
+
+	conn, err := nats.Connect(s.ClientURL())
+	assert.NoError(t, err)
+	defer conn.Close()
+
+	js, err := jetstream.New(conn)
+	assert.NoError(t, err)
+
+	_, err = js.CreateStream(ctx, jetstream.StreamConfig{
+		Name:     "test-stream",
+		Subjects: []string{"orders.*", "payments.*"},
+	})
+	assert.NoError(t, err)
+
+	// sequences 1 to 6, the orders are the odd ones
+	for i := 0; i < 3; i++ {
+		msg := nats.NewMsg("orders.created")
+		msg.Data = []byte(fmt.Sprintf("order-%d", i))
+		msg.Header.Set("tenant", fmt.Sprintf("t%d", i))
+		_, err := js.PublishMsg(ctx, msg)
+		assert.NoError(t, err)
+		_, err = js.Publish(ctx, "payments.created", []byte(fmt.Sprintf("payment-%d", i)))
+		assert.NoError(t, err)
+	}
+
+	newInstance := func(name string, spec *dfv1.JetStreamSource) *dfv1.VertexInstance {
+		spec.Stream = "test-stream"
+		spec.URL = s.ClientURL()
+		return &dfv1.VertexInstance{
+			Vertex: &dfv1.Vertex{
+				Spec: dfv1.VertexSpec{
+					PipelineName: "testPipeline",
+					AbstractVertex: dfv1.AbstractVertex{
+						Name:   name,
+						Source: &dfv1.Source{JetStream: spec},
+					},
+				},
+			},
+		}
+	}
+
+	t.Run("filter subjects and start sequence", func(t *testing.T) {
+		jsSource, err := New(ctx, newInstance("filtered", &dfv1.JetStreamSource{
+			FilterSubjects: []string{"orders.*"},
+			DeliverPolicy:  dfv1.JetStreamDeliverBySequence,
+			StartSequence:  3,
+			MaxAckPending:  10,
+			AckWait:        &metav1.Duration{Duration: 10 * time.Second},
+			KeyFromHeader:  "tenant",
+		}), WithReadTimeout(time.Second))
+		assert.NoError(t, err)
+		defer jsSource.Close()
+
+		messages, err := jsSource.Read(ctx, 10)
+		assert.NoError(t, err)
+		assert.Len(t, messages, 2)
+		for i, m := range messages {
+			assert.Equal(t, fmt.Sprintf("order-%d", i+1), string(m.Payload))
+			assert.Equal(t, []string{fmt.Sprintf("t%d", i+1)}, m.Keys)
+		}
+		info, err := js.Consumer(ctx, "test-stream", "numaflow-testPipeline-filtered-test-stream")
+		assert.NoError(t, err)
+		assert.Equal(t, 10, info.CachedInfo().Config.MaxAckPending)
+		assert.Equal(t, 10*time.Second, info.CachedInfo().Config.AckWait)
+	})
+
+	t.Run("existing consumer keeps its deliver policy", func(t *testing.T) {
+		jsSource, err := New(ctx, newInstance("restarted", &dfv1.JetStreamSource{
+			DeliverPolicy: dfv1.JetStreamDeliverBySequence,
+			StartSequence: 5,
+		}), WithReadTimeout(time.Second))
+		assert.NoError(t, err)
+		assert.NoError(t, jsSource.Close())
+
+		jsSource, err = New(ctx, newInstance("restarted", &dfv1.JetStreamSource{
+			DeliverPolicy: dfv1.JetStreamDeliverNew,
+			MaxAckPending: 20,
+		}), WithReadTimeout(time.Second))
+		assert.NoError(t, err)
+		defer jsSource.Close()
+
+		info, err := js.Consumer(ctx, "test-stream", "numaflow-testPipeline-restarted-test-stream")
+		assert.NoError(t, err)
+		assert.Equal(t, jetstream.DeliverByStartSequencePolicy, info.CachedInfo().Config.DeliverPolicy)
+		assert.Equal(t, uint64(5), info.CachedInfo().Config.OptStartSeq)
+		assert.Equal(t, 20, info.CachedInfo().Config.MaxAckPending)
+		messages, err := jsSource.Read(ctx, 10)
+		assert.NoError(t, err)
+		assert.Len(t, messages, 2)
+	})
+
+	t.Run("ephemeral consumer of new messages", func(t *testing.T) {
+		jsSource, err := New(ctx, newInstance("ephemeral", &dfv1.JetStreamSource{
+			DeliverPolicy: dfv1.JetStreamDeliverNew,
+			Ephemeral:     true,
+		}), WithReadTimeout(time.Second))
+		assert.NoError(t, err)
+		defer jsSource.Close()
+
+		_, err = js.Publish(ctx, "payments.created", []byte("new"))
+		assert.NoError(t, err)
+		messages, err := jsSource.Read(ctx, 10)
+		assert.NoError(t, err)
+		assert.Len(t, messages, 1)
+		assert.Equal(t, "new", string(messages[0].Payload))
+		_, err = js.Consumer(ctx, "test-stream", "numaflow-testPipeline-ephemeral-test-stream")
+		assert.ErrorIs(t, err, jetstream.ErrConsumerNotFound)
+	})
+}
+
+func TestApplyConsumerOptions(t *testing.T) {
+	config := jetstream.ConsumerConfig{Durable: "c"}
+	startTime := metav1.NewTime(time.Unix(1714979289, 0))
+	assert.NoError(t, applyConsumerOptions(&config, &dfv1.JetStreamSource{
+		FilterSubjects: []string{"a", "b"},
+		DeliverPolicy:  dfv1.JetStreamDeliverByStartTime,
+		StartTime:      &startTime,
+	}))
+	assert.Equal(t, []string{"a", "b"}, config.FilterSubjects)
+	assert.Empty(t, config.FilterSubject)
+	assert.Equal(t, jetstream.DeliverByStartTimePolicy, config.DeliverPolicy)
+	assert.Equal(t, startTime.Time, *config.OptStartTime)
+	assert.Equal(t, dfv1.DefaultJetStreamSourceAckWait, config.AckWait)
+	assert.Equal(t, "c", config.Durable)
+
+	assert.Error(t, applyConsumerOptions(&config, &dfv1.JetStreamSource{DeliverPolicy: dfv1.JetStreamDeliverBySequence}))
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package kafka
 
 import (
+	"fmt"
 	"sync"
 
 	"github.com/IBM/sarama"
@@ -32,7 +33,9 @@ type ConsumerHandler struct {
 	readyCloser  sync.Once
 	messages     chan *sarama.ConsumerMessage
 	sess         sarama.ConsumerGroupSession
-	logger       *zap.SugaredLogger
+	// setup is an optional hook run at the beginning of a new session, before the claims are consumed
+	setup  func(sarama.ConsumerGroupSession) error
+	logger *zap.SugaredLogger
 }
 
 // NewConsumerHandler creates new handler and initializes the channel for passing messages
@@ -53,6 +56,11 @@ func NewConsumerHandler(readChanSize int) *ConsumerHandler {
 
 // Setup is run at the beginning of a new session, before ConsumeClaim
 func (consumer *ConsumerHandler) Setup(sess sarama.ConsumerGroupSession) error {
+	if consumer.setup != nil {
+		if err := consumer.setup(sess); err != nil {
+			return fmt.Errorf("failed to setup the kafka consumer session, %w", err)
+		}
+	}
 	consumer.sess = sess
 	consumer.readyCloser.Do(func() {
 		close(consumer.ready)
@@ -50,6 +50,8 @@ type kafkaSource struct {
 	readTimeout   time.Duration       // read timeout for the from buffer
 	adminClient   sarama.ClusterAdmin // client used to calculate pending messages
 	saramaClient  sarama.Client       // sarama client
+	startTime     time.Time           // timestamp to start consuming from, for the partitions without committed offset
+	keyHeader     string              // name of the header used as the message key, record key is used if empty
 }
 
 // NewKafkaSource returns a kafkaSource reader based on Kafka Consumer Group.
@@ -79,6 +81,16 @@ func NewKafkaSource(ctx context.Context, vertexInstance *dfv1.VertexInstance, ha
 	if err != nil {
 		return nil, fmt.Errorf("error reading kafka source config, %w", err)
 	}
+	if p := source.StartPosition; p != nil {
+		if err := applyStartPosition(config, p); err != nil {
+			return nil, err
+		}
+		if p.Policy == dfv1.KafkaStartTimestamp {
+			ks.startTime = p.Timestamp.Time
+			ks.handler.setup = ks.markStartOffsets
+		}
+	}
+	ks.keyHeader = source.KeyHeader
 
 	if t := source.TLS; t != nil {
 		config.Net.TLS.Enable = true
@@ -130,6 +142,13 @@ func NewKafkaSource(ctx context.Context, vertexInstance *dfv1.VertexInstance, ha
 		ks.adminClient = adminClient
 	}
 
+	if source.EventTimeSource == dfv1.KafkaEventTimeLogAppendTime {
+		if err := ks.checkLogAppendTime(); err != nil {
+			_ = adminClient.Close()
+			return nil, err
+		}
+	}
+
 	go ks.startConsumer()
 	// wait for the consumer to setup.
 	<-ks.handler.ready
@@ -281,6 +300,86 @@ func configFromOpts(yamlConfig string) (*sarama.Config, error) {
 	return config, nil
 }
 
+// applyStartPosition sets the initial offset of the consumer group with the start position.
+func applyStartPosition(config *sarama.Config, p *dfv1.KafkaStartPosition) error {
+	switch p.Policy {
+	case dfv1.KafkaStartEarliest:
+		config.Consumer.Offsets.Initial = sarama.OffsetOldest
+	case dfv1.KafkaStartLatest:
+		config.Consumer.Offsets.Initial = sarama.OffsetNewest
+	case dfv1.KafkaStartTimestamp:
+		if p.Timestamp == nil {
+			return fmt.Errorf("timestamp is required by the start position policy %q", p.Policy)
+		}
+		// the partitions without any record after the timestamp start from the latest.
+		config.Consumer.Offsets.Initial = sarama.OffsetNewest
+	default:
+		return fmt.Errorf("unsupported start position policy %q", p.Policy)
+	}
+	return nil
+}
+
+// markStartOffsets marks the offsets of the start timestamp in the session, for the claimed partitions which don't have
+// a committed offset. It's run before the claims are consumed, so that consuming starts from the marked offsets.
+func (ks *kafkaSource) markStartOffsets(sess sarama.ConsumerGroupSession) error {
+	offsets, err := ks.startOffsets(sess.Claims()[ks.topic])
+	if err != nil {
+		return err
+	}
+	for partition, offset := range offsets {
+		ks.logger.Infow("Starting from the offset of the start timestamp", zap.Int32("partition", partition), zap.Int64("offset", offset), zap.Time("startTime", ks.startTime))
+		sess.MarkOffset(ks.topic, partition, offset, "")
+	}
+	return nil
+}
+
+// startOffsets returns the offsets of the start timestamp for the partitions which don't have a committed offset.
+// The partitions without any record after the start timestamp are not included.
+func (ks *kafkaSource) startOffsets(partitions []int32) (map[int32]int64, error) {
+	result := make(map[int32]int64)
+	if len(partitions) == 0 {
+		return result, nil
+	}
+	rep, err := ks.adminClient.ListConsumerGroupOffsets(ks.groupName, map[string][]int32{ks.topic: partitions})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list consumer group offsets, %w", err)
+	}
+	for _, partition := range partitions {
+		if block := rep.GetBlock(ks.topic, partition); block != nil && block.Offset >= 0 {
+			continue
+		}
+		offset, err := ks.saramaClient.GetOffset(ks.topic, partition, ks.startTime.UnixMilli())
+		if err != nil {
+			return nil, fmt.Errorf("failed to get offset of topic %q, partition %v at %v, %w", ks.topic, partition, ks.startTime, err)
+		}
+		if offset >= 0 {
+			result[partition] = offset
+		}
+	}
+	return result, nil
+}
+
+// checkLogAppendTime checks if the timestamps of the topic are the log append time.
+func (ks *kafkaSource) checkLogAppendTime() error {
+	entries, err := ks.adminClient.DescribeConfig(sarama.ConfigResource{
+		Type:        sarama.TopicResource,
+		Name:        ks.topic,
+		ConfigNames: []string{"message.timestamp.type"},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to describe the config of topic %q, %w", ks.topic, err)
+	}
+	for _, entry := range entries {
+		if entry.Name == "message.timestamp.type" {
+			if entry.Value != "LogAppendTime" {
+				return fmt.Errorf("event time source %q requires message.timestamp.type of topic %q to be LogAppendTime, got %q", dfv1.KafkaEventTimeLogAppendTime, ks.topic, entry.Value)
+			}
+			return nil
+		}
+	}
+	return fmt.Errorf("message.timestamp.type of topic %q is not found", ks.topic)
+}
+
 func (ks *kafkaSource) startConsumer() {
 	consumerGroup, err := sarama.NewConsumerGroupFromClient(ks.groupName, ks.saramaClient)
 	ks.logger.Infow("creating NewConsumerGroup", zap.String("topic", ks.topic), zap.String("consumerGroupName", ks.groupName), zap.Strings("brokers", ks.brokers))
@@ -341,6 +440,10 @@ func (ks *kafkaSource) toReadMessage(m *sarama.ConsumerMessage) *isb.ReadMessage
 	for _, header := range m.Headers {
 		headers[string(header.Key)] = string(header.Value)
 	}
+	key := string(m.Key)
+	if ks.keyHeader != "" {
+		key = headers[ks.keyHeader]
+	}
 
 	msg := isb.Message{
 		Header: isb.Header{
@@ -350,7 +453,7 @@ func (ks *kafkaSource) toReadMessage(m *sarama.ConsumerMessage) *isb.ReadMessage
 				Offset:     readOffset.String(),
 				Index:      readOffset.PartitionIdx(),
 			},
-			Keys:    []string{string(m.Key)},
+			Keys:    []string{key},
 			Headers: headers,
 		},
 		Body: body,
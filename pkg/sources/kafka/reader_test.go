@@ -24,6 +24,7 @@ import (
 
 	"github.com/IBM/sarama"
 	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
@@ -99,3 +100,154 @@ func TestKafkaSource_Read(t *testing.T) {
 	_ = client.Close()
 	broker.Close()
 }
+
+func TestApplyStartPosition(t *testing.T) {
+	config := sarama.NewConfig()
+	assert.NoError(t, applyStartPosition(config, &dfv1.KafkaStartPosition{Policy: dfv1.KafkaStartEarliest}))
+	assert.Equal(t, sarama.OffsetOldest, config.Consumer.Offsets.Initial)
+	assert.NoError(t, applyStartPosition(config, &dfv1.KafkaStartPosition{Policy: dfv1.KafkaStartLatest}))
+	assert.Equal(t, sarama.OffsetNewest, config.Consumer.Offsets.Initial)
+	config.Consumer.Offsets.Initial = sarama.OffsetOldest
+	assert.NoError(t, applyStartPosition(config, &dfv1.KafkaStartPosition{Policy: dfv1.KafkaStartTimestamp, Timestamp: &metav1.Time{Time: time.Now()}}))
+	assert.Equal(t, sarama.OffsetNewest, config.Consumer.Offsets.Initial)
+	assert.Error(t, applyStartPosition(config, &dfv1.KafkaStartPosition{Policy: dfv1.KafkaStartTimestamp}))
+	assert.Error(t, applyStartPosition(config, &dfv1.KafkaStartPosition{Policy: "unknown"}))
+}
+
+// fakeSession records the offsets marked in a consumer group session.
+type fakeSession struct {
+	sarama.ConsumerGroupSession
+	claims map[string][]int32
+	marked map[int32]int64
+}
+
+func (s *fakeSession) Claims() map[string][]int32 {
+	return s.claims
+}
+
+func (s *fakeSession) MarkOffset(_ string, partition int32, offset int64, _ string) {
+	s.marked[partition] = offset
+}
+
+func TestKafkaSource_markStartOffsets(t *testing.T) {
+	broker := sarama.NewMockBroker(t, 1)
+	defer broker.Close()
+
+	startTime := time.UnixMilli(1663006726000)
+	broker.SetHandlerByMap(map[string]sarama.MockResponse{
+		"MetadataRequest": sarama.NewMockMetadataResponse(t).
+			SetBroker(broker.Addr(), broker.BrokerID()).
+			SetLeader("testtopic", 0, broker.BrokerID()).
+			SetLeader("testtopic", 1, broker.BrokerID()).
+			SetLeader("testtopic", 2, broker.BrokerID()).
+			SetController(broker.BrokerID()),
+		"FindCoordinatorRequest": sarama.NewMockFindCoordinatorResponse(t).
+			SetCoordinator(sarama.CoordinatorGroup, "test-group", broker),
+		"OffsetFetchRequest": sarama.NewMockOffsetFetchResponse(t).
+			SetOffset("test-group", "testtopic", 0, 5, "", sarama.ErrNoError).
+			SetOffset("test-group", "testtopic", 1, -1, "", sarama.ErrNoError).
+			SetOffset("test-group", "testtopic", 2, -1, "", sarama.ErrNoError),
+		"OffsetRequest": sarama.NewMockOffsetResponse(t).
+			SetOffset("testtopic", 0, startTime.UnixMilli(), 3).
+			SetOffset("testtopic", 1, startTime.UnixMilli(), 42).
+			SetOffset("testtopic", 2, startTime.UnixMilli(), -1),
+	})
+
+	config := sarama.NewConfig()
+	config.Version = sarama.V2_0_0_0
+	client, err := sarama.NewClient([]string{broker.Addr()}, config)
+	assert.NoError(t, err)
+	adminClient, err := sarama.NewClusterAdminFromClient(client)
+	assert.NoError(t, err)
+	defer func() { _ = adminClient.Close() }()
+
+	ks := &kafkaSource{
+		topic:        "testtopic",
+		groupName:    "test-group",
+		startTime:    startTime,
+		logger:       logging.NewLogger(),
+		saramaClient: client,
+		adminClient:  adminClient,
+	}
+	handler := NewConsumerHandler(10)
+	handler.setup = ks.markStartOffsets
+
+	sess := &fakeSession{claims: map[string][]int32{"testtopic": {0, 1, 2}}, marked: map[int32]int64{}}
+	assert.NoError(t, handler.Setup(sess))
+	// partition 0 has a committed offset, partition 2 has no record after the start time.
+	assert.Equal(t, map[int32]int64{1: 42}, sess.marked)
+	assert.Equal(t, sess, handler.sess)
+}
+
+func TestKafkaSource_checkLogAppendTime(t *testing.T) {
+	for _, tc := range []struct {
+		name          string
+		timestampType string
+		wantErr       bool
+	}{
+		{name: "log append time", timestampType: "LogAppendTime", wantErr: false},
+		{name: "create time", timestampType: "CreateTime", wantErr: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			broker := sarama.NewMockBroker(t, 1)
+			defer broker.Close()
+			broker.SetHandlerByMap(map[string]sarama.MockResponse{
+				"MetadataRequest": sarama.NewMockMetadataResponse(t).
+					SetBroker(broker.Addr(), broker.BrokerID()).
+					SetLeader("testtopic", 0, broker.BrokerID()).
+					SetController(broker.BrokerID()),
+				"DescribeConfigsRequest": sarama.NewMockWrapper(&sarama.DescribeConfigsResponse{
+					Version: 2,
+					Resources: []*sarama.ResourceResponse{{
+						Type:    sarama.TopicResource,
+						Name:    "testtopic",
+						Configs: []*sarama.ConfigEntry{{Name: "message.timestamp.type", Value: tc.timestampType}},
+					}},
+				}),
+			})
+			config := sarama.NewConfig()
+			config.Version = sarama.V2_0_0_0
+			client, err := sarama.NewClient([]string{broker.Addr()}, config)
+			assert.NoError(t, err)
+			adminClient, err := sarama.NewClusterAdminFromClient(client)
+			assert.NoError(t, err)
+			defer func() { _ = adminClient.Close() }()
+
+			ks := &kafkaSource{topic: "testtopic", saramaClient: client, adminClient: adminClient}
+			err = ks.checkLogAppendTime()
+			if tc.wantErr {
+				assert.ErrorContains(t, err, "CreateTime")
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestKafkaSource_toReadMessage(t *testing.T) {
+	ts := time.UnixMilli(1663006726000)
+	msg := &sarama.ConsumerMessage{
+		Topic:     "testtopic",
+		Partition: 2,
+		Offset:    7,
+		Key:       []byte("record-key"),
+		Value:     []byte("value"),
+		Timestamp: ts,
+		Headers:   []*sarama.RecordHeader{{Key: []byte("tenant"), Value: []byte("t1")}},
+	}
+
+	ks := &kafkaSource{vertexName: "testVertex"}
+	m := ks.toReadMessage(msg)
+	assert.Equal(t, []string{"record-key"}, m.Keys)
+	assert.Equal(t, ts, m.EventTime)
+	assert.Equal(t, map[string]string{"tenant": "t1"}, m.Headers)
+	assert.Equal(t, "testtopic:7:2", m.ReadOffset.String())
+
+	ks.keyHeader = "tenant"
+	m = ks.toReadMessage(msg)
+	assert.Equal(t, []string{"t1"}, m.Keys)
+
+	ks.keyHeader = "missing"
+	m = ks.toReadMessage(msg)
+	assert.Equal(t, []string{""}, m.Keys)
+}
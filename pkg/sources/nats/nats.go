@@ -19,6 +19,8 @@ package nats
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
@@ -27,6 +29,7 @@ import (
 
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/metrics"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
 	sharedutil "github.com/numaproj/numaflow/pkg/shared/util"
 	"github.com/numaproj/numaflow/pkg/sources/sourcer"
@@ -43,6 +46,15 @@ type natsSource struct {
 	bufferSize    int
 	messages      chan *isb.ReadMessage
 	readTimeout   time.Duration
+	// keyFromHeader and eventTimeFromHeader are the names of the headers used as the key and the event time
+	keyFromHeader       string
+	eventTimeFromHeader string
+	// overflowSize is the number of messages buffered in addition to the buffer size, the messages are dropped
+	// instead of blocking when the buffer is full if it's greater than 0
+	overflowSize int
+	// slowConsumerDropped is the number of the messages dropped by the nats client that have been counted
+	slowConsumerLock    sync.Mutex
+	slowConsumerDropped int
 }
 
 func New(ctx context.Context, vertexInstance *dfv1.VertexInstance, opts ...Option) (sourcer.SourceReader, error) {
@@ -61,9 +73,12 @@ func New(ctx context.Context, vertexInstance *dfv1.VertexInstance, opts ...Optio
 		}
 	}
 
-	n.messages = make(chan *isb.ReadMessage, n.bufferSize)
-
 	source := vertexInstance.Vertex.Spec.Source.Nats
+	n.keyFromHeader = source.KeyFromHeader
+	n.eventTimeFromHeader = source.EventTimeFromHeader
+	n.overflowSize = int(source.OverflowSize)
+	n.messages = make(chan *isb.ReadMessage, n.bufferSize+n.overflowSize)
+
 	opt := []natslib.Option{
 		natslib.MaxReconnects(-1),
 		natslib.ReconnectWait(3 * time.Second),
@@ -73,6 +88,13 @@ func New(ctx context.Context, vertexInstance *dfv1.VertexInstance, opts ...Optio
 		natslib.ReconnectHandler(func(c *natslib.Conn) {
 			n.logger.Info("Nats reconnected")
 		}),
+		natslib.ErrorHandler(func(c *natslib.Conn, sub *natslib.Subscription, err error) {
+			if err == natslib.ErrSlowConsumer && sub != nil {
+				n.countSlowConsumerDropped(sub)
+				return
+			}
+			n.logger.Errorw("Nats async error", zap.Error(err))
+		}),
 	}
 	if source.TLS != nil {
 		if c, err := sharedutil.GetTLSConfig(source.TLS); err != nil {
@@ -120,26 +142,16 @@ func New(ctx context.Context, vertexInstance *dfv1.VertexInstance, opts ...Optio
 		n.natsConn = conn
 	}
 	if sub, err := n.natsConn.QueueSubscribe(source.Subject, source.Queue, func(msg *natslib.Msg) {
-		readOffset := isb.NewSimpleStringPartitionOffset(uuid.New().String(), vertexInstance.Replica)
-		m := &isb.ReadMessage{
-			Message: isb.Message{
-				Header: isb.Header{
-					// TODO: Be able to specify event time.
-					MessageInfo: isb.MessageInfo{EventTime: time.Now()},
-					ID: isb.MessageID{
-						VertexName: n.vertexName,
-						Offset:     readOffset.String(),
-						Index:      readOffset.PartitionIdx(),
-					},
-				},
-				Body: isb.Body{
-					Payload: msg.Data,
-				},
-			},
-			// TODO: Be able to specify an ID for dedup?
-			ReadOffset: readOffset,
+		m := n.toReadMessage(msg)
+		if n.overflowSize <= 0 {
+			n.messages <- m
+			return
+		}
+		select {
+		case n.messages <- m:
+		default:
+			natsSourceDroppedCount.With(map[string]string{metrics.LabelVertex: n.vertexName, metrics.LabelPipeline: n.pipelineName, labelReason: reasonOverflow}).Inc()
 		}
-		n.messages <- m
 	}); err != nil {
 		n.natsConn.Close()
 		return nil, fmt.Errorf("failed to QueueSubscribe nats messages, %w", err)
@@ -149,6 +161,74 @@ func New(ctx context.Context, vertexInstance *dfv1.VertexInstance, opts ...Optio
 	return n, nil
 }
 
+// toReadMessage converts a nats message to a read message, with the headers, and the key and the event time from
+// the headers if they're specified.
+func (ns *natsSource) toReadMessage(msg *natslib.Msg) *isb.ReadMessage {
+	readOffset := isb.NewSimpleStringPartitionOffset(uuid.New().String(), ns.vertexReplica)
+	headers := make(map[string]string, len(msg.Header))
+	for k := range msg.Header {
+		headers[k] = msg.Header.Get(k)
+	}
+	var keys []string
+	if ns.keyFromHeader != "" {
+		if v := msg.Header.Get(ns.keyFromHeader); v != "" {
+			keys = []string{v}
+		}
+	}
+	eventTime := time.Now()
+	if ns.eventTimeFromHeader != "" {
+		if v := msg.Header.Get(ns.eventTimeFromHeader); v != "" {
+			if t, err := parseEventTime(v); err != nil {
+				ns.logger.Debugw("Invalid event time header, using the current time", zap.String("value", v), zap.Error(err))
+			} else {
+				eventTime = t
+			}
+		}
+	}
+	return &isb.ReadMessage{
+		Message: isb.Message{
+			Header: isb.Header{
+				MessageInfo: isb.MessageInfo{EventTime: eventTime},
+				ID: isb.MessageID{
+					VertexName: ns.vertexName,
+					Offset:     readOffset.String(),
+					Index:      readOffset.PartitionIdx(),
+				},
+				Keys:    keys,
+				Headers: headers,
+			},
+			Body: isb.Body{
+				Payload: msg.Data,
+			},
+		},
+		// TODO: Be able to specify an ID for dedup?
+		ReadOffset: readOffset,
+	}
+}
+
+// parseEventTime parses an event time in RFC3339 format or epoch milliseconds.
+func parseEventTime(v string) (time.Time, error) {
+	if ms, err := strconv.ParseInt(v, 10, 64); err == nil {
+		return time.UnixMilli(ms), nil
+	}
+	return time.Parse(time.RFC3339Nano, v)
+}
+
+// countSlowConsumerDropped counts the messages dropped by the nats client since the last count.
+func (ns *natsSource) countSlowConsumerDropped(sub *natslib.Subscription) {
+	dropped, err := sub.Dropped()
+	if err != nil {
+		return
+	}
+	ns.slowConsumerLock.Lock()
+	defer ns.slowConsumerLock.Unlock()
+	if delta := dropped - ns.slowConsumerDropped; delta > 0 {
+		natsSourceDroppedCount.With(map[string]string{metrics.LabelVertex: ns.vertexName, metrics.LabelPipeline: ns.pipelineName, labelReason: reasonSlowConsumer}).Add(float64(delta))
+		ns.logger.Warnw("Nats messages dropped as a slow consumer", zap.Int("dropped", delta))
+	}
+	ns.slowConsumerDropped = dropped
+}
+
 type Option func(*natsSource) error
 
 // WithLogger is used to return logger information
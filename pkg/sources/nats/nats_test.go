@@ -23,10 +23,12 @@ import (
 	"time"
 
 	natslib "github.com/nats-io/nats.go"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/metrics"
 	natstest "github.com/numaproj/numaflow/pkg/shared/clients/nats/test"
 	"github.com/numaproj/numaflow/pkg/sources/sourcer"
 )
@@ -156,3 +158,82 @@ func Test_Multiple(t *testing.T) {
 		}
 	}
 }
+
+func Test_Headers(t *testing.T) {
+	server := natstest.RunNatsServer(t)
+	defer server.Shutdown()
+
+	url := server.ClientURL()
+	testSubject := "test-headers"
+	vi := testVertex(t, url, testSubject, "test-queue-headers", "test-host", 0)
+	vi.Vertex.Spec.Source.Nats.KeyFromHeader = "tenant"
+	vi.Vertex.Spec.Source.Nats.EventTimeFromHeader = "ts"
+	ns, err := newInstance(t, vi)
+	assert.NoError(t, err)
+	defer func() { _ = ns.Close() }()
+
+	nc, err := natslib.Connect(url)
+	assert.NoError(t, err)
+	defer nc.Close()
+	msg := natslib.NewMsg(testSubject)
+	msg.Data = []byte("with headers")
+	msg.Header.Set("tenant", "t1")
+	msg.Header.Set("ts", "1714979289000")
+	assert.NoError(t, nc.PublishMsg(msg))
+	msg = natslib.NewMsg(testSubject)
+	msg.Data = []byte("invalid event time")
+	msg.Header.Set("ts", "yesterday")
+	assert.NoError(t, nc.PublishMsg(msg))
+
+	var msgs []*isb.ReadMessage
+	timeout := time.After(30 * time.Second)
+	for len(msgs) < 2 {
+		select {
+		case <-timeout:
+			t.Fatalf("timeout waiting for messages, got %d", len(msgs))
+		default:
+			m, err := ns.Read(context.Background(), 2)
+			assert.NoError(t, err)
+			msgs = append(msgs, m...)
+		}
+	}
+	assert.Equal(t, []string{"t1"}, msgs[0].Keys)
+	assert.Equal(t, map[string]string{"tenant": "t1", "ts": "1714979289000"}, msgs[0].Headers)
+	assert.Equal(t, time.UnixMilli(1714979289000), msgs[0].EventTime)
+	assert.Nil(t, msgs[1].Keys)
+	assert.WithinDuration(t, time.Now(), msgs[1].EventTime, 30*time.Second)
+}
+
+func Test_Overflow(t *testing.T) {
+	server := natstest.RunNatsServer(t)
+	defer server.Shutdown()
+
+	url := server.ClientURL()
+	testSubject := "test-overflow"
+	vi := testVertex(t, url, testSubject, "test-queue-overflow", "test-host", 0)
+	vi.Vertex.Spec.Source.Nats.OverflowSize = 2
+	ns, err := New(context.Background(), vi, WithReadTimeout(time.Second), WithBufferSize(1))
+	assert.NoError(t, err)
+	defer func() { _ = ns.Close() }()
+
+	dropped := natsSourceDroppedCount.With(map[string]string{metrics.LabelVertex: "test-v", metrics.LabelPipeline: "", labelReason: reasonOverflow})
+	before := testutil.ToFloat64(dropped)
+	nc, err := natslib.Connect(url)
+	assert.NoError(t, err)
+	defer nc.Close()
+	for i := 0; i < 5; i++ {
+		assert.NoError(t, nc.Publish(testSubject, []byte(fmt.Sprint(i))))
+	}
+	assert.NoError(t, nc.Flush())
+
+	// the messages beyond the buffer and the overflow are dropped instead of blocking
+	assert.Eventually(t, func() bool {
+		return testutil.ToFloat64(dropped)-before == 2
+	}, 10*time.Second, 50*time.Millisecond)
+	msgs, err := ns.Read(context.Background(), 5)
+	assert.NoError(t, err)
+	assert.Len(t, msgs, 3)
+	for i, m := range msgs {
+		assert.Equal(t, fmt.Sprint(i), string(m.Payload))
+	}
+}
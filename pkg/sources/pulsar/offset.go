@@ -0,0 +1,70 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pulsar
+
+import (
+	"fmt"
+	"sync/atomic"
+
+	pulsarlib "github.com/apache/pulsar-client-go/pulsar"
+
+	"github.com/numaproj/numaflow/pkg/isb"
+)
+
+// offset represents a message id in a pulsar subscription.
+type offset struct {
+	id           pulsarlib.MessageID
+	partitionIdx int32
+	source       *pulsarSource
+	// done is set once the message is either acked or nacked, to keep the unacked count accurate.
+	done atomic.Bool
+}
+
+var _ isb.Offset = (*offset)(nil)
+
+func (o *offset) String() string {
+	return o.id.String()
+}
+
+// Sequence returns the entry id of the message. Entry ids are only ordered within a ledger of a topic partition.
+func (o *offset) Sequence() (int64, error) {
+	return o.id.EntryID(), nil
+}
+
+// AckIt acknowledges the message on the subscription.
+func (o *offset) AckIt() error {
+	if err := o.source.consumer.AckID(o.id); err != nil {
+		return fmt.Errorf("failed to ack pulsar message %q, %w", o.id.String(), err)
+	}
+	if o.done.CompareAndSwap(false, true) {
+		o.source.unacked.Add(-1)
+	}
+	return nil
+}
+
+// NoAck negatively acknowledges the message so that it gets redelivered.
+func (o *offset) NoAck() error {
+	o.source.consumer.NackID(o.id)
+	if o.done.CompareAndSwap(false, true) {
+		o.source.unacked.Add(-1)
+	}
+	return nil
+}
+
+func (o *offset) PartitionIdx() int32 {
+	return o.partitionIdx
+}
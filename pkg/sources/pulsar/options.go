@@ -0,0 +1,50 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pulsar
+
+import (
+	"time"
+
+	pulsarlib "github.com/apache/pulsar-client-go/pulsar"
+	"go.uber.org/zap"
+)
+
+type Option func(*pulsarSource) error
+
+// WithLogger is used to return logger information
+func WithLogger(l *zap.SugaredLogger) Option {
+	return func(o *pulsarSource) error {
+		o.logger = l
+		return nil
+	}
+}
+
+// WithReadTimeout sets the read timeout
+func WithReadTimeout(t time.Duration) Option {
+	return func(o *pulsarSource) error {
+		o.readTimeout = t
+		return nil
+	}
+}
+
+// WithClient sets the pulsar client used to subscribe to the topic, instead of creating one from the source spec.
+func WithClient(c pulsarlib.Client) Option {
+	return func(o *pulsarSource) error {
+		o.client = c
+		return nil
+	}
+}
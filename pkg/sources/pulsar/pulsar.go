@@ -0,0 +1,297 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pulsar
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	pulsarlib "github.com/apache/pulsar-client-go/pulsar"
+	"go.uber.org/zap"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/metrics"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+	sharedutil "github.com/numaproj/numaflow/pkg/shared/util"
+	"github.com/numaproj/numaflow/pkg/sources/sourcer"
+)
+
+type pulsarSource struct {
+	vertexName    string
+	pipelineName  string
+	vertexReplica int32
+	topic         string
+	subscription  string
+	adminURL      string
+	token         string
+	maxUnack      int64
+	// unacked is the number of messages which have been read but not yet acked or nacked.
+	unacked     atomic.Int64
+	client      pulsarlib.Client
+	consumer    pulsarlib.Consumer
+	httpClient  *http.Client
+	readTimeout time.Duration
+	logger      *zap.SugaredLogger
+}
+
+var _ sourcer.SourceReader = (*pulsarSource)(nil)
+
+// New creates a Pulsar source reader, which consumes the configured topic with a shared subscription.
+func New(ctx context.Context, vertexInstance *dfv1.VertexInstance, opts ...Option) (sourcer.SourceReader, error) {
+	source := vertexInstance.Vertex.Spec.Source.Pulsar
+	ps := &pulsarSource{
+		vertexName:    vertexInstance.Vertex.Spec.Name,
+		pipelineName:  vertexInstance.Vertex.Spec.PipelineName,
+		vertexReplica: vertexInstance.Replica,
+		topic:         source.Topic,
+		subscription:  source.SubscriptionName,
+		adminURL:      strings.TrimSuffix(source.AdminURL, "/"),
+		maxUnack:      int64(source.MaxUnack),
+		httpClient:    &http.Client{Timeout: 5 * time.Second},
+		readTimeout:   1 * time.Second, // default timeout
+		logger:        logging.FromContext(ctx),
+	}
+	for _, o := range opts {
+		if err := o(ps); err != nil {
+			return nil, err
+		}
+	}
+
+	if source.Auth != nil && source.Auth.Token != nil {
+		token, err := sharedutil.GetSecretFromVolume(source.Auth.Token)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get pulsar auth token, %w", err)
+		}
+		ps.token = token
+	}
+
+	if ps.client == nil {
+		clientOpts := pulsarlib.ClientOptions{
+			URL: source.ServerAddr,
+		}
+		if ps.token != "" {
+			clientOpts.Authentication = pulsarlib.NewAuthenticationToken(ps.token)
+		}
+		ps.logger.Infow("Connecting to pulsar service...", zap.String("serverAddr", source.ServerAddr))
+		client, err := pulsarlib.NewClient(clientOpts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create pulsar client, %w", err)
+		}
+		ps.client = client
+	}
+
+	consumer, err := ps.client.Subscribe(pulsarlib.ConsumerOptions{
+		Topic:            source.Topic,
+		Name:             source.ConsumerName,
+		SubscriptionName: source.SubscriptionName,
+		// Shared subscription allows the messages to be distributed across all the replicas of the vertex.
+		Type:                        pulsarlib.Shared,
+		SubscriptionInitialPosition: pulsarlib.SubscriptionPositionEarliest,
+	})
+	if err != nil {
+		ps.client.Close()
+		return nil, fmt.Errorf("failed to subscribe to pulsar topic %q, %w", source.Topic, err)
+	}
+	ps.consumer = consumer
+	ps.logger.Infow("Pulsar consumer ready", zap.String("topic", source.Topic), zap.String("subscription", source.SubscriptionName))
+	return ps, nil
+}
+
+// GetName returns the name of the source.
+func (ps *pulsarSource) GetName() string {
+	return ps.vertexName
+}
+
+// Partitions returns the partitions associated with this source. Messages of a shared subscription can come from any
+// partition of the topic, so the replica index is used as the partition for watermark propagation.
+func (ps *pulsarSource) Partitions(context.Context) []int32 {
+	return []int32{ps.vertexReplica}
+}
+
+// Read reads up to count messages from the subscription, it returns when either count messages are read or the read
+// timeout is reached. No messages are read if the number of unacknowledged messages has reached MaxUnack.
+func (ps *pulsarSource) Read(ctx context.Context, count int64) ([]*isb.ReadMessage, error) {
+	if ps.maxUnack > 0 {
+		if unacked := ps.unacked.Load(); unacked >= ps.maxUnack {
+			ps.logger.Debugw("Max unacked messages reached, skip reading", zap.Int64("unacked", unacked), zap.Int64("maxUnack", ps.maxUnack))
+			return nil, nil
+		} else if remaining := ps.maxUnack - unacked; count > remaining {
+			count = remaining
+		}
+	}
+	msgs := make([]*isb.ReadMessage, 0, count)
+	readCtx, cancel := context.WithTimeout(ctx, ps.readTimeout)
+	defer cancel()
+	for i := int64(0); i < count; i++ {
+		m, err := ps.consumer.Receive(readCtx)
+		if err != nil {
+			if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+				ps.logger.Debugw("Timed out waiting for messages to read.", zap.Duration("waited", ps.readTimeout), zap.Int("read", len(msgs)))
+				break
+			}
+			return msgs, fmt.Errorf("failed to receive pulsar message, %w", err)
+		}
+		ps.unacked.Add(1)
+		pulsarSourceReadCount.With(map[string]string{
+			metrics.LabelVertex:   ps.vertexName,
+			metrics.LabelPipeline: ps.pipelineName,
+		}).Inc()
+		msgs = append(msgs, ps.toReadMessage(m))
+	}
+	return msgs, nil
+}
+
+// Ack acknowledges the messages of the given offsets on the subscription.
+func (ps *pulsarSource) Ack(_ context.Context, offsets []isb.Offset) []error {
+	errs := make([]error, len(offsets))
+	for i, o := range offsets {
+		errs[i] = o.AckIt()
+	}
+	return errs
+}
+
+// Pending returns the backlog of the subscription, summed over all the partitions of the topic.
+func (ps *pulsarSource) Pending(ctx context.Context) (int64, error) {
+	if ps.adminURL == "" {
+		return isb.PendingNotAvailable, nil
+	}
+	partitions, err := ps.client.TopicPartitions(ps.topic)
+	if err != nil {
+		return isb.PendingNotAvailable, fmt.Errorf("failed to get partitions of topic %q, %w", ps.topic, err)
+	}
+	var total int64
+	for _, p := range partitions {
+		backlog, err := ps.subscriptionBacklog(ctx, p)
+		if err != nil {
+			return isb.PendingNotAvailable, err
+		}
+		total += backlog
+	}
+	pulsarPending.WithLabelValues(ps.vertexName, ps.pipelineName, ps.topic, ps.subscription).Set(float64(total))
+	return total, nil
+}
+
+// topicStats is the part of the Pulsar topic stats response we are interested in.
+type topicStats struct {
+	Subscriptions map[string]struct {
+		MsgBacklog int64 `json:"msgBacklog"`
+	} `json:"subscriptions"`
+}
+
+// subscriptionBacklog queries the admin API for the backlog of the subscription on a (non-partitioned) topic.
+func (ps *pulsarSource) subscriptionBacklog(ctx context.Context, topic string) (int64, error) {
+	path, err := topicAdminPath(topic)
+	if err != nil {
+		return 0, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, ps.adminURL+"/admin/v2/"+path+"/stats", nil)
+	if err != nil {
+		return 0, err
+	}
+	if ps.token != "" {
+		req.Header.Set("Authorization", "Bearer "+ps.token)
+	}
+	resp, err := ps.httpClient.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get stats of topic %q, %w", topic, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("failed to get stats of topic %q, status code %d", topic, resp.StatusCode)
+	}
+	var stats topicStats
+	if err := json.NewDecoder(resp.Body).Decode(&stats); err != nil {
+		return 0, fmt.Errorf("failed to decode stats of topic %q, %w", topic, err)
+	}
+	sub, ok := stats.Subscriptions[ps.subscription]
+	if !ok {
+		return 0, fmt.Errorf("subscription %q not found in stats of topic %q", ps.subscription, topic)
+	}
+	return sub.MsgBacklog, nil
+}
+
+// topicAdminPath converts a topic name to the path used by the admin API, e.g. "my-topic" to
+// "persistent/public/default/my-topic", and "non-persistent://t/n/my-topic" to "non-persistent/t/n/my-topic".
+func topicAdminPath(topic string) (string, error) {
+	domain := "persistent"
+	rest := topic
+	if idx := strings.Index(topic, "://"); idx >= 0 {
+		domain = topic[:idx]
+		rest = topic[idx+3:]
+	}
+	parts := strings.Split(rest, "/")
+	switch len(parts) {
+	case 1:
+		return strings.Join([]string{domain, "public", "default", parts[0]}, "/"), nil
+	case 3:
+		return strings.Join([]string{domain, parts[0], parts[1], parts[2]}, "/"), nil
+	default:
+		return "", fmt.Errorf("invalid pulsar topic name %q", topic)
+	}
+}
+
+func (ps *pulsarSource) Close() error {
+	ps.logger.Info("Closing pulsar source...")
+	ps.consumer.Close()
+	ps.client.Close()
+	ps.logger.Info("Pulsar source closed")
+	return nil
+}
+
+func (ps *pulsarSource) toReadMessage(m pulsarlib.Message) *isb.ReadMessage {
+	readOffset := &offset{
+		id:           m.ID(),
+		partitionIdx: ps.vertexReplica,
+		source:       ps,
+	}
+	// Event time is optional in Pulsar, fall back to the publish time if it is not set by the producer.
+	eventTime := m.EventTime()
+	if eventTime.IsZero() {
+		eventTime = m.PublishTime()
+	}
+	var keys []string
+	if k := m.Key(); k != "" {
+		keys = []string{k}
+	}
+	headers := make(map[string]string, len(m.Properties()))
+	for k, v := range m.Properties() {
+		headers[k] = v
+	}
+	return &isb.ReadMessage{
+		ReadOffset: readOffset,
+		Message: isb.Message{
+			Header: isb.Header{
+				MessageInfo: isb.MessageInfo{EventTime: eventTime},
+				ID: isb.MessageID{
+					VertexName: ps.vertexName,
+					Offset:     readOffset.String(),
+					Index:      readOffset.PartitionIdx(),
+				},
+				Keys:    keys,
+				Headers: headers,
+			},
+			Body: isb.Body{Payload: m.Payload()},
+		},
+	}
+}
@@ -0,0 +1,296 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pulsar
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	pulsarlib "github.com/apache/pulsar-client-go/pulsar"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+)
+
+// fakeMessage is an in-memory pulsar message.
+type fakeMessage struct {
+	pulsarlib.Message
+	id          pulsarlib.MessageID
+	key         string
+	payload     []byte
+	props       map[string]string
+	eventTime   time.Time
+	publishTime time.Time
+}
+
+func (m *fakeMessage) ID() pulsarlib.MessageID       { return m.id }
+func (m *fakeMessage) Key() string                   { return m.key }
+func (m *fakeMessage) Payload() []byte               { return m.payload }
+func (m *fakeMessage) Properties() map[string]string { return m.props }
+func (m *fakeMessage) EventTime() time.Time          { return m.eventTime }
+func (m *fakeMessage) PublishTime() time.Time        { return m.publishTime }
+
+// fakeConsumer is an in-process stand-in of a pulsar consumer on a shared subscription.
+type fakeConsumer struct {
+	pulsarlib.Consumer
+	messages chan pulsarlib.Message
+	mu       sync.Mutex
+	acked    []pulsarlib.MessageID
+	nacked   []pulsarlib.MessageID
+	closed   bool
+}
+
+func (c *fakeConsumer) Receive(ctx context.Context) (pulsarlib.Message, error) {
+	select {
+	case m := <-c.messages:
+		return m, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (c *fakeConsumer) AckID(id pulsarlib.MessageID) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.acked = append(c.acked, id)
+	return nil
+}
+
+func (c *fakeConsumer) NackID(id pulsarlib.MessageID) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.nacked = append(c.nacked, id)
+}
+
+func (c *fakeConsumer) Close() {
+	c.closed = true
+}
+
+// fakeClient is an in-process stand-in of a pulsar client.
+type fakeClient struct {
+	pulsarlib.Client
+	consumer   *fakeConsumer
+	partitions []string
+	options    pulsarlib.ConsumerOptions
+}
+
+func (c *fakeClient) Subscribe(opts pulsarlib.ConsumerOptions) (pulsarlib.Consumer, error) {
+	c.options = opts
+	return c.consumer, nil
+}
+
+func (c *fakeClient) TopicPartitions(string) ([]string, error) {
+	return c.partitions, nil
+}
+
+func (c *fakeClient) Close() {}
+
+func newFakeClient(partitions ...string) *fakeClient {
+	return &fakeClient{
+		consumer:   &fakeConsumer{messages: make(chan pulsarlib.Message, 100)},
+		partitions: partitions,
+	}
+}
+
+func testVertex(src *dfv1.PulsarSource) *dfv1.VertexInstance {
+	return &dfv1.VertexInstance{
+		Vertex: &dfv1.Vertex{
+			Spec: dfv1.VertexSpec{
+				PipelineName: "test-pl",
+				AbstractVertex: dfv1.AbstractVertex{
+					Name:   "test-v",
+					Source: &dfv1.Source{Pulsar: src},
+				},
+			},
+		},
+		Replica: 1,
+	}
+}
+
+func produce(c *fakeConsumer, n int) {
+	now := time.Now()
+	for i := 0; i < n; i++ {
+		m := &fakeMessage{
+			id:          pulsarlib.NewMessageID(1, int64(i), 0, 0),
+			payload:     []byte(fmt.Sprintf("message-%d", i)),
+			props:       map[string]string{"index": fmt.Sprint(i)},
+			publishTime: now,
+		}
+		if i%2 == 0 {
+			m.key = fmt.Sprintf("key-%d", i)
+			m.eventTime = now.Add(-time.Minute)
+		}
+		c.messages <- m
+	}
+}
+
+func TestPulsarSource_ReadAndAck(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	client := newFakeClient()
+	src := &dfv1.PulsarSource{
+		ServerAddr:       "pulsar://localhost:6650",
+		Topic:            "test-topic",
+		ConsumerName:     "test-consumer",
+		SubscriptionName: "test-sub",
+	}
+	ps, err := New(ctx, testVertex(src), WithClient(client), WithReadTimeout(100*time.Millisecond))
+	require.NoError(t, err)
+	assert.Equal(t, "test-v", ps.GetName())
+	assert.Equal(t, []int32{1}, ps.Partitions(ctx))
+	assert.Equal(t, pulsarlib.Shared, client.options.Type)
+	assert.Equal(t, "test-sub", client.options.SubscriptionName)
+
+	produce(client.consumer, 5)
+	msgs, err := ps.Read(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, msgs, 5)
+
+	assert.Equal(t, []byte("message-0"), msgs[0].Payload)
+	assert.Equal(t, []string{"key-0"}, msgs[0].Keys)
+	assert.Equal(t, "0", msgs[0].Headers["index"])
+	assert.True(t, msgs[0].EventTime.Before(msgs[1].EventTime), "event time should fall back to the publish time")
+	assert.Nil(t, msgs[1].Keys)
+	assert.Equal(t, int32(1), msgs[1].ReadOffset.PartitionIdx())
+	assert.Equal(t, msgs[1].ReadOffset.String(), msgs[1].ID.Offset)
+
+	offsets := make([]isb.Offset, 0, len(msgs))
+	for _, m := range msgs[:4] {
+		offsets = append(offsets, m.ReadOffset)
+	}
+	for _, err := range ps.Ack(ctx, offsets) {
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, msgs[4].ReadOffset.NoAck())
+	assert.Len(t, client.consumer.acked, 4)
+	assert.Len(t, client.consumer.nacked, 1)
+
+	assert.NoError(t, ps.Close())
+	assert.True(t, client.consumer.closed)
+}
+
+func TestPulsarSource_MaxUnack(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	client := newFakeClient()
+	src := &dfv1.PulsarSource{
+		Topic:            "test-topic",
+		SubscriptionName: "test-sub",
+		MaxUnack:         3,
+	}
+	ps, err := New(ctx, testVertex(src), WithClient(client), WithReadTimeout(100*time.Millisecond))
+	require.NoError(t, err)
+	defer func() { _ = ps.Close() }()
+
+	produce(client.consumer, 5)
+	msgs, err := ps.Read(ctx, 10)
+	require.NoError(t, err)
+	assert.Len(t, msgs, 3)
+
+	// the limit is reached, nothing should be read until messages are acked
+	more, err := ps.Read(ctx, 10)
+	require.NoError(t, err)
+	assert.Empty(t, more)
+
+	for _, err := range ps.Ack(ctx, []isb.Offset{msgs[0].ReadOffset, msgs[1].ReadOffset}) {
+		assert.NoError(t, err)
+	}
+	// acking the same offset again should not free up more room
+	assert.NoError(t, msgs[0].ReadOffset.AckIt())
+	more, err = ps.Read(ctx, 10)
+	require.NoError(t, err)
+	assert.Len(t, more, 2)
+}
+
+func TestPulsarSource_Pending(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	var gotAuth []string
+	admin := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = append(gotAuth, r.Header.Get("Authorization"))
+		switch r.URL.Path {
+		case "/admin/v2/persistent/public/default/test-topic-partition-0/stats":
+			_, _ = w.Write([]byte(`{"msgInCounter": 100, "subscriptions": {"test-sub": {"msgBacklog": 7}, "other": {"msgBacklog": 100}}}`))
+		case "/admin/v2/persistent/public/default/test-topic-partition-1/stats":
+			_, _ = w.Write([]byte(`{"subscriptions": {"test-sub": {"msgBacklog": 5}}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer admin.Close()
+
+	client := newFakeClient("persistent://public/default/test-topic-partition-0", "persistent://public/default/test-topic-partition-1")
+	src := &dfv1.PulsarSource{
+		Topic:            "test-topic",
+		SubscriptionName: "test-sub",
+		AdminURL:         admin.URL + "/",
+	}
+	ps, err := New(ctx, testVertex(src), WithClient(client))
+	require.NoError(t, err)
+	defer func() { _ = ps.Close() }()
+
+	pending, err := ps.Pending(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(12), pending)
+	assert.Equal(t, []string{"", ""}, gotAuth)
+
+	// unknown topic
+	client.partitions = []string{"persistent://public/default/unknown"}
+	pending, err = ps.Pending(ctx)
+	assert.Error(t, err)
+	assert.Equal(t, isb.PendingNotAvailable, pending)
+
+	// no admin url configured
+	ps.(*pulsarSource).adminURL = ""
+	pending, err = ps.Pending(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, isb.PendingNotAvailable, pending)
+}
+
+func Test_topicAdminPath(t *testing.T) {
+	tests := []struct {
+		topic   string
+		want    string
+		wantErr bool
+	}{
+		{topic: "my-topic", want: "persistent/public/default/my-topic"},
+		{topic: "persistent://t1/ns1/my-topic", want: "persistent/t1/ns1/my-topic"},
+		{topic: "non-persistent://t1/ns1/my-topic", want: "non-persistent/t1/ns1/my-topic"},
+		{topic: "t1/my-topic", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.topic, func(t *testing.T) {
+			got, err := topicAdminPath(tt.topic)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -29,6 +29,8 @@ import (
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/numaproj/numaflow/pkg/forwarder"
 	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/isb/claimcheck"
+	diskisb "github.com/numaproj/numaflow/pkg/isb/stores/disk"
 	jetstreamisb "github.com/numaproj/numaflow/pkg/isb/stores/jetstream"
 	redisisb "github.com/numaproj/numaflow/pkg/isb/stores/redis"
 	"github.com/numaproj/numaflow/pkg/isbsvc"
@@ -49,11 +51,15 @@ import (
 	jetstreamsrc "github.com/numaproj/numaflow/pkg/sources/jetstream"
 	"github.com/numaproj/numaflow/pkg/sources/kafka"
 	"github.com/numaproj/numaflow/pkg/sources/nats"
+	"github.com/numaproj/numaflow/pkg/sources/pulsar"
 	"github.com/numaproj/numaflow/pkg/sources/sourcer"
+	"github.com/numaproj/numaflow/pkg/sources/sqs"
 	"github.com/numaproj/numaflow/pkg/sources/transformer"
+	schemavalidator "github.com/numaproj/numaflow/pkg/sources/transformer/builtin/schema_validator"
 	"github.com/numaproj/numaflow/pkg/sources/udsource"
 	"github.com/numaproj/numaflow/pkg/watermark/fetch"
 	"github.com/numaproj/numaflow/pkg/watermark/generic"
+	diskwm "github.com/numaproj/numaflow/pkg/watermark/generic/disk"
 	"github.com/numaproj/numaflow/pkg/watermark/generic/jetstream"
 	"github.com/numaproj/numaflow/pkg/watermark/publish"
 	"github.com/numaproj/numaflow/pkg/watermark/store"
@@ -94,9 +100,14 @@ func (sp *SourceProcessor) Start(ctx context.Context) error {
 
 	switch sp.ISBSvcType {
 	case dfv1.ISBSvcTypeRedis:
+		redisClient, err := redisclient.NewInClusterRedisClient()
+		if err != nil {
+			return fmt.Errorf("failed to create a redis client: %w", err)
+		}
 		for _, e := range sp.VertexInstance.Vertex.Spec.ToEdges {
 			writeOpts := []redisclient.Option{
 				redisclient.WithBufferFullWritingStrategy(e.BufferFullWritingStrategy()),
+				redisclient.WithCompression(e.Compression),
 			}
 			if x := e.ToVertexLimits; x != nil && x.BufferMaxLength != nil {
 				writeOpts = append(writeOpts, redisclient.WithMaxLength(int64(*x.BufferMaxLength)))
@@ -109,7 +120,6 @@ func (sp *SourceProcessor) Start(ctx context.Context) error {
 			// create a writer for each partition.
 			for partitionIdx, partition := range partitionedBuffers {
 				group := partition + "-group"
-				redisClient := redisclient.NewInClusterRedisClient()
 				writer := redisisb.NewBufferWrite(ctx, redisClient, partition, group, int32(partitionIdx), writeOpts...)
 				bufferWriters = append(bufferWriters, writer)
 			}
@@ -127,6 +137,7 @@ func (sp *SourceProcessor) Start(ctx context.Context) error {
 		for _, e := range sp.VertexInstance.Vertex.Spec.ToEdges {
 			writeOpts := []jetstreamisb.WriteOption{
 				jetstreamisb.WithBufferFullWritingStrategy(e.BufferFullWritingStrategy()),
+				jetstreamisb.WithCompression(e.Compression),
 			}
 			if x := e.ToVertexLimits; x != nil && x.BufferMaxLength != nil {
 				writeOpts = append(writeOpts, jetstreamisb.WithMaxLength(int64(*x.BufferMaxLength)))
@@ -134,6 +145,9 @@ func (sp *SourceProcessor) Start(ctx context.Context) error {
 			if x := e.ToVertexLimits; x != nil && x.BufferUsageLimit != nil {
 				writeOpts = append(writeOpts, jetstreamisb.WithBufferUsageLimit(float64(*x.BufferUsageLimit)/100))
 			}
+			if x := e.Priority; x != nil {
+				writeOpts = append(writeOpts, jetstreamisb.WithPriorityLanes(int(x.Lanes)))
+			}
 			var bufferWriters []isb.BufferWriter
 			partitionedBuffers := dfv1.GenerateBufferNames(sp.VertexInstance.Vertex.Namespace, sp.VertexInstance.Vertex.Spec.PipelineName, e.To, e.GetToVertexPartitionCount())
 			// create a writer for each partition.
@@ -174,10 +188,66 @@ func (sp *SourceProcessor) Start(ctx context.Context) error {
 			}
 			idleManager, _ = wmb.NewIdleManager(1, len(writersMap))
 		}
+	case dfv1.ISBSvcTypeDisk:
+		for _, e := range sp.VertexInstance.Vertex.Spec.ToEdges {
+			writeOpts := []diskisb.WriteOption{
+				diskisb.WithBufferFullWritingStrategy(e.BufferFullWritingStrategy()),
+				diskisb.WithCompression(e.Compression),
+			}
+			if x := e.ToVertexLimits; x != nil && x.BufferMaxLength != nil {
+				writeOpts = append(writeOpts, diskisb.WithMaxLength(int64(*x.BufferMaxLength)))
+			}
+			if x := e.ToVertexLimits; x != nil && x.BufferUsageLimit != nil {
+				writeOpts = append(writeOpts, diskisb.WithBufferUsageLimit(float64(*x.BufferUsageLimit)/100))
+			}
+			var bufferWriters []isb.BufferWriter
+			partitionedBuffers := dfv1.GenerateBufferNames(sp.VertexInstance.Vertex.Namespace, sp.VertexInstance.Vertex.Spec.PipelineName, e.To, e.GetToVertexPartitionCount())
+			// create a writer for each partition.
+			for partitionIdx, partition := range partitionedBuffers {
+				writer, err := diskisb.NewDiskBufferWriter(ctx, dfv1.PathISBSvcDiskMount, partition, int32(partitionIdx), writeOpts...)
+				if err != nil {
+					return err
+				}
+				bufferWriters = append(bufferWriters, writer)
+			}
+			writersMap[e.To] = bufferWriters
+		}
+
+		// created watermark related components only if watermark is enabled
+		// otherwise no op will be used
+		if !sp.VertexInstance.Vertex.Spec.Watermark.Disabled {
+			var err error
+			// build watermark stores for from vertex
+			sourceWmStores, err = diskwm.BuildFromVertexWatermarkStores(ctx, sp.VertexInstance, dfv1.PathISBSvcDiskMount)
+			if err != nil {
+				return fmt.Errorf("failed to build watermark stores: %w", err)
+			}
+
+			// create watermark fetcher using watermark stores of from vertex
+			fetchWatermark = fetch.NewSourceFetcher(ctx, sourceWmStores[sp.VertexInstance.Vertex.Name], fetch.WithIsSource(true))
+
+			// build watermark stores for to-vertex
+			toVertexWatermarkStores, err = diskwm.BuildToVertexWatermarkStores(ctx, sp.VertexInstance, dfv1.PathISBSvcDiskMount)
+			if err != nil {
+				return err
+			}
+
+			// build watermark stores for sourceReader (we publish twice for sourceReader)
+			sourcePublisherStores, err = diskwm.BuildSourcePublisherStores(ctx, sp.VertexInstance, dfv1.PathISBSvcDiskMount)
+			if err != nil {
+				return err
+			}
+			idleManager, _ = wmb.NewIdleManager(1, len(writersMap))
+		}
 	default:
 		return fmt.Errorf("unrecognized isb svc type %q", sp.ISBSvcType)
 	}
 
+	// store the oversized payloads out of the buffers if the claim check is configured
+	if err := claimcheck.Wrap(ctx, sp.VertexInstance.Vertex, nil, writersMap, nil); err != nil {
+		return err
+	}
+
 	// Populate the shuffle function map
 	// we need to shuffle the messages, because we can have a reduce vertex immediately after a sourceReader vertex.
 	var toVertexPartitionMap = make(map[string]int)
@@ -264,6 +334,9 @@ func (sp *SourceProcessor) Start(ctx context.Context) error {
 
 		healthCheckers = append(healthCheckers, srcTransformerGRPCClient)
 		forwardOpts = append(forwardOpts, sourceforward.WithTransformer(srcTransformerGRPCClient))
+		if b := sp.VertexInstance.Vertex.Spec.Source.UDTransformer.Builtin; b != nil && b.Name == "schemaValidator" {
+			forwardOpts = append(forwardOpts, sourceforward.WithSchemaValidation(b.KWArgs["type"], schemavalidator.InvalidTag(b.KWArgs)))
+		}
 	}
 
 	sourceReader, err := sp.createSourceReader(ctx, udsGRPCClient)
@@ -361,17 +434,25 @@ func (sp *SourceProcessor) createSourceReader(ctx context.Context, udsGRPCClient
 		return jetstreamsrc.New(ctx, sp.VertexInstance, jetstreamsrc.WithReadTimeout(readTimeout))
 	} else if x := src.Serving; x != nil {
 		return jetstreamsrc.New(ctx, sp.VertexInstance, jetstreamsrc.WithReadTimeout(readTimeout), jetstreamsrc.WithServingEnabled())
+	} else if x := src.Pulsar; x != nil {
+		return pulsar.New(ctx, sp.VertexInstance, pulsar.WithReadTimeout(readTimeout))
+	} else if x := src.Sqs; x != nil {
+		return sqs.New(ctx, sp.VertexInstance, sqs.WithReadTimeout(readTimeout))
 	}
 	return nil, fmt.Errorf("invalid source spec")
 }
 
 func (sp *SourceProcessor) getSourceGoWhereDecider(shuffleFuncMap map[string]*shuffle.Shuffle) forwarder.GoWhere {
 	// create the conditional forwarder
-	conditionalForwarder := forwarder.GoWhere(func(keys []string, tags []string, msgId string) ([]forwarder.VertexBuffer, error) {
+	conditionalForwarder := forwarder.GoWhere(func(keys []string, tags []string, msgId string, msg *isb.Message) ([]forwarder.VertexBuffer, error) {
 		var result []forwarder.VertexBuffer
 
 		// Iterate through the edges
 		for _, edge := range sp.VertexInstance.Vertex.Spec.ToEdges {
+			// the messages without a transformer have no tags, but the expression of the edge still applies.
+			if !forwarder.MatchConditions(edge.Conditions, tags, msg) {
+				continue
+			}
 			// if the edge has more than one partition, shuffle the message
 			// else forward the message to the default partition
 			partitionIdx := isb.DefaultPartitionIdx
@@ -387,6 +468,7 @@ func (sp *SourceProcessor) getSourceGoWhereDecider(shuffleFuncMap map[string]*sh
 			result = append(result, forwarder.VertexBuffer{
 				ToVertexName:         edge.To,
 				ToVertexPartitionIdx: partitionIdx,
+				PriorityLane:         forwarder.PriorityLane(edge.Priority, tags, msg),
 			})
 		}
 
@@ -397,7 +479,7 @@ func (sp *SourceProcessor) getSourceGoWhereDecider(shuffleFuncMap map[string]*sh
 
 func (sp *SourceProcessor) getTransformerGoWhereDecider(shuffleFuncMap map[string]*shuffle.Shuffle) forwarder.GoWhere {
 	// create the conditional forwarder
-	conditionalForwarder := forwarder.GoWhere(func(keys []string, tags []string, msgId string) ([]forwarder.VertexBuffer, error) {
+	conditionalForwarder := forwarder.GoWhere(func(keys []string, tags []string, msgId string, msg *isb.Message) ([]forwarder.VertexBuffer, error) {
 		var result []forwarder.VertexBuffer
 
 		// Drop message if it contains the special tag
@@ -414,8 +496,8 @@ func (sp *SourceProcessor) getTransformerGoWhereDecider(shuffleFuncMap map[strin
 
 		// Iterate through the edges
 		for _, edge := range sp.VertexInstance.Vertex.Spec.ToEdges {
-			// Condition to proceed for forwarding message: No conditions on edge, or message tags match edge conditions
-			proceed := edge.Conditions == nil || edge.Conditions.Tags == nil || len(edge.Conditions.Tags.Values) == 0 || sharedutil.CompareSlice(edge.Conditions.Tags.GetOperator(), tags, edge.Conditions.Tags.Values)
+			// Condition to proceed for forwarding message: No conditions on edge, or message matches edge conditions
+			proceed := forwarder.MatchConditions(edge.Conditions, tags, msg)
 
 			if proceed {
 				// if the edge has more than one partition, shuffle the message
@@ -433,6 +515,7 @@ func (sp *SourceProcessor) getTransformerGoWhereDecider(shuffleFuncMap map[strin
 				result = append(result, forwarder.VertexBuffer{
 					ToVertexName:         edge.To,
 					ToVertexPartitionIdx: partitionIdx,
+					PriorityLane:         forwarder.PriorityLane(edge.Priority, tags, msg),
 				})
 			}
 		}
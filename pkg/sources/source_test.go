@@ -0,0 +1,68 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sources
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+)
+
+func TestSourceGoWhereDecider_Expression(t *testing.T) {
+	sp := &SourceProcessor{
+		VertexInstance: &dfv1.VertexInstance{
+			Vertex: &dfv1.Vertex{Spec: dfv1.VertexSpec{
+				PipelineName: "test-pl",
+				AbstractVertex: dfv1.AbstractVertex{
+					Name:   "in",
+					Source: &dfv1.Source{Generator: &dfv1.GeneratorSource{}},
+				},
+				ToEdges: []dfv1.CombinedEdge{
+					{Edge: dfv1.Edge{From: "in", To: "large", Conditions: &dfv1.ForwardConditions{Expression: `int(json(payload).amount) > 100`}}},
+					{Edge: dfv1.Edge{From: "in", To: "us", Conditions: &dfv1.ForwardConditions{Expression: `headers["x-region"] == "us"`}}},
+					{Edge: dfv1.Edge{From: "in", To: "all"}},
+				},
+			}},
+		},
+	}
+	goWhere := sp.getSourceGoWhereDecider(nil)
+	toVertices := func(msg *isb.Message) []string {
+		buffers, err := goWhere(nil, nil, "id", msg)
+		require.NoError(t, err)
+		var result []string
+		for _, b := range buffers {
+			result = append(result, b.ToVertexName)
+		}
+		return result
+	}
+
+	msg := &isb.Message{
+		Header: isb.Header{Headers: map[string]string{"x-region": "us"}},
+		Body:   isb.Body{Payload: []byte(`{"amount": 120}`)},
+	}
+	assert.Equal(t, []string{"large", "us", "all"}, toVertices(msg))
+
+	msg = &isb.Message{
+		Header: isb.Header{Headers: map[string]string{"x-region": "eu"}},
+		Body:   isb.Body{Payload: []byte(`{"amount": 10}`)},
+	}
+	assert.Equal(t, []string{"all"}, toVertices(msg))
+}
@@ -0,0 +1,38 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqs
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/numaproj/numaflow/pkg/metrics"
+)
+
+// sqsSourceReadCount is used to indicate the number of messages read
+var sqsSourceReadCount = promauto.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: "sqs_source",
+	Name:      "read_total",
+	Help:      "Total number of messages Read",
+}, []string{metrics.LabelVertex, metrics.LabelPipeline})
+
+// sqsPending is used to indicate the approximate number of messages pending in the queue
+var sqsPending = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Subsystem: "sqs_source",
+	Name:      "pending_total",
+	Help:      "Approximate number of messages pending",
+}, []string{metrics.LabelVertex, metrics.LabelPipeline, "queue"})
@@ -0,0 +1,71 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+
+	"github.com/numaproj/numaflow/pkg/isb"
+)
+
+// offset represents the receipt handle of a received SQS message.
+type offset struct {
+	receiptHandle string
+	partitionIdx  int32
+	source        *sqsSource
+}
+
+var _ isb.Offset = (*offset)(nil)
+
+func (o *offset) String() string {
+	return o.receiptHandle
+}
+
+// Sequence is not supported, SQS messages are not ordered.
+func (o *offset) Sequence() (int64, error) {
+	return 0, fmt.Errorf("sequence is not supported by sqs offset")
+}
+
+// AckIt deletes the message from the queue.
+func (o *offset) AckIt() error {
+	if errs := o.source.Ack(context.Background(), []isb.Offset{o}); errs[0] != nil {
+		return errs[0]
+	}
+	return nil
+}
+
+// NoAck resets the visibility timeout of the message to 0, so that it becomes visible to the consumers immediately
+// instead of waiting for the visibility timeout to expire.
+func (o *offset) NoAck() error {
+	_, err := o.source.client.ChangeMessageVisibility(context.Background(), &sqs.ChangeMessageVisibilityInput{
+		QueueUrl:          aws.String(o.source.queueURL),
+		ReceiptHandle:     aws.String(o.receiptHandle),
+		VisibilityTimeout: 0,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to change the visibility of sqs message, %w", err)
+	}
+	return nil
+}
+
+func (o *offset) PartitionIdx() int32 {
+	return o.partitionIdx
+}
@@ -0,0 +1,274 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqs
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	"go.uber.org/zap"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/metrics"
+	sqsclient "github.com/numaproj/numaflow/pkg/shared/clients/sqs"
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+	"github.com/numaproj/numaflow/pkg/sources/sourcer"
+)
+
+// maxBatchSize is the maximum number of messages SQS allows in a single receive or delete request.
+const maxBatchSize = 10
+
+// Client is the part of the SQS client used by the source.
+type Client interface {
+	GetQueueUrl(ctx context.Context, params *sqs.GetQueueUrlInput, optFns ...func(*sqs.Options)) (*sqs.GetQueueUrlOutput, error)
+	ReceiveMessage(ctx context.Context, params *sqs.ReceiveMessageInput, optFns ...func(*sqs.Options)) (*sqs.ReceiveMessageOutput, error)
+	DeleteMessageBatch(ctx context.Context, params *sqs.DeleteMessageBatchInput, optFns ...func(*sqs.Options)) (*sqs.DeleteMessageBatchOutput, error)
+	ChangeMessageVisibility(ctx context.Context, params *sqs.ChangeMessageVisibilityInput, optFns ...func(*sqs.Options)) (*sqs.ChangeMessageVisibilityOutput, error)
+	GetQueueAttributes(ctx context.Context, params *sqs.GetQueueAttributesInput, optFns ...func(*sqs.Options)) (*sqs.GetQueueAttributesOutput, error)
+}
+
+type sqsSource struct {
+	vertexName    string
+	pipelineName  string
+	vertexReplica int32
+	queueName     string
+	queueURL      string
+	spec          *dfv1.SqsSource
+	client        Client
+	readTimeout   time.Duration
+	logger        *zap.SugaredLogger
+}
+
+var _ sourcer.SourceReader = (*sqsSource)(nil)
+
+// New creates an SQS source reader.
+func New(ctx context.Context, vertexInstance *dfv1.VertexInstance, opts ...Option) (sourcer.SourceReader, error) {
+	source := vertexInstance.Vertex.Spec.Source.Sqs
+	ss := &sqsSource{
+		vertexName:    vertexInstance.Vertex.Spec.Name,
+		pipelineName:  vertexInstance.Vertex.Spec.PipelineName,
+		vertexReplica: vertexInstance.Replica,
+		queueName:     source.QueueName,
+		spec:          source,
+		readTimeout:   1 * time.Second, // default timeout
+		logger:        logging.FromContext(ctx),
+	}
+	for _, o := range opts {
+		if err := o(ss); err != nil {
+			return nil, err
+		}
+	}
+	ss.logger = ss.logger.With("sourceType", "sqs").With("queue", source.QueueName)
+
+	if ss.client == nil {
+		client, err := sqsclient.NewClient(ctx, source.AWSRegion, source.EndpointURL)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create sqs client, %w", err)
+		}
+		ss.client = client
+	}
+	queueURL, err := sqsclient.GetQueueURL(ctx, ss.client, source.QueueName, source.QueueOwnerAWSAccountID)
+	if err != nil {
+		return nil, err
+	}
+	ss.queueURL = queueURL
+	ss.logger.Infow("SQS source ready", zap.String("queueURL", queueURL))
+	return ss, nil
+}
+
+// GetName returns the name of the source.
+func (ss *sqsSource) GetName() string {
+	return ss.vertexName
+}
+
+// Partitions returns the partitions associated with this source. SQS queues are not partitioned, so the replica
+// index is used as the partition for watermark propagation.
+func (ss *sqsSource) Partitions(context.Context) []int32 {
+	return []int32{ss.vertexReplica}
+}
+
+// Read receives up to count messages from the queue. It keeps polling until either count messages are received, a
+// poll returns no messages, or the read timeout is reached. With WaitTimeSeconds set, each poll is a long poll, of at
+// most the read timeout. The polls are not cancelled by the read timeout, the messages received by a cancelled poll
+// would stay invisible until their visibility timeout expires.
+func (ss *sqsSource) Read(ctx context.Context, count int64) ([]*isb.ReadMessage, error) {
+	msgs := make([]*isb.ReadMessage, 0, count)
+	deadline := time.Now().Add(ss.readTimeout)
+	for int64(len(msgs)) < count {
+		if !time.Now().Before(deadline) {
+			ss.logger.Debugw("Timed out waiting for messages to read.", zap.Duration("waited", ss.readTimeout), zap.Int("read", len(msgs)))
+			break
+		}
+		batchSize := count - int64(len(msgs))
+		if batchSize > maxBatchSize {
+			batchSize = maxBatchSize
+		}
+		if x := ss.spec.MaxNumberOfMessages; x != nil && int64(*x) < batchSize {
+			batchSize = int64(*x)
+		}
+		output, err := ss.client.ReceiveMessage(ctx, ss.receiveInput(int32(batchSize)))
+		if err != nil {
+			return msgs, fmt.Errorf("failed to receive sqs messages, %w", err)
+		}
+		if len(output.Messages) == 0 {
+			break
+		}
+		for _, m := range output.Messages {
+			msgs = append(msgs, ss.toReadMessage(m))
+		}
+		sqsSourceReadCount.With(map[string]string{
+			metrics.LabelVertex:   ss.vertexName,
+			metrics.LabelPipeline: ss.pipelineName,
+		}).Add(float64(len(output.Messages)))
+	}
+	return msgs, nil
+}
+
+func (ss *sqsSource) receiveInput(batchSize int32) *sqs.ReceiveMessageInput {
+	input := &sqs.ReceiveMessageInput{
+		QueueUrl:            aws.String(ss.queueURL),
+		MaxNumberOfMessages: batchSize,
+		// SentTimestamp is always requested since it is used as the event time.
+		MessageSystemAttributeNames: []types.MessageSystemAttributeName{types.MessageSystemAttributeNameAll},
+		MessageAttributeNames:       []string{"All"},
+	}
+	if x := ss.spec.WaitTimeSeconds; x != nil {
+		input.WaitTimeSeconds = min(*x, int32(ss.readTimeout/time.Second))
+	}
+	if x := ss.spec.VisibilityTimeout; x != nil {
+		input.VisibilityTimeout = *x
+	}
+	if len(ss.spec.AttributeNames) > 0 {
+		input.MessageSystemAttributeNames = []types.MessageSystemAttributeName{types.MessageSystemAttributeNameSentTimestamp}
+		for _, name := range ss.spec.AttributeNames {
+			input.MessageSystemAttributeNames = append(input.MessageSystemAttributeNames, types.MessageSystemAttributeName(name))
+		}
+	}
+	if len(ss.spec.MessageAttributeNames) > 0 {
+		input.MessageAttributeNames = ss.spec.MessageAttributeNames
+	}
+	return input
+}
+
+// Ack deletes the messages of the given offsets from the queue, in batches of up to 10 messages.
+func (ss *sqsSource) Ack(ctx context.Context, offsets []isb.Offset) []error {
+	errs := make([]error, len(offsets))
+	for start := 0; start < len(offsets); start += maxBatchSize {
+		end := start + maxBatchSize
+		if end > len(offsets) {
+			end = len(offsets)
+		}
+		entries := make([]types.DeleteMessageBatchRequestEntry, 0, end-start)
+		for i := start; i < end; i++ {
+			entries = append(entries, types.DeleteMessageBatchRequestEntry{
+				Id:            aws.String(strconv.Itoa(i)),
+				ReceiptHandle: aws.String(offsets[i].String()),
+			})
+		}
+		output, err := ss.client.DeleteMessageBatch(ctx, &sqs.DeleteMessageBatchInput{
+			QueueUrl: aws.String(ss.queueURL),
+			Entries:  entries,
+		})
+		if err != nil {
+			for i := start; i < end; i++ {
+				errs[i] = fmt.Errorf("failed to delete sqs messages, %w", err)
+			}
+			continue
+		}
+		for _, f := range output.Failed {
+			idx, err := strconv.Atoi(aws.ToString(f.Id))
+			if err != nil || idx < start || idx >= end {
+				ss.logger.Errorw("Unexpected entry id in the delete response", zap.String("id", aws.ToString(f.Id)))
+				continue
+			}
+			errs[idx] = fmt.Errorf("failed to delete sqs message, code: %s, message: %s", aws.ToString(f.Code), aws.ToString(f.Message))
+		}
+	}
+	return errs
+}
+
+// Pending returns the approximate number of messages available for retrieval from the queue.
+func (ss *sqsSource) Pending(ctx context.Context) (int64, error) {
+	output, err := ss.client.GetQueueAttributes(ctx, &sqs.GetQueueAttributesInput{
+		QueueUrl:       aws.String(ss.queueURL),
+		AttributeNames: []types.QueueAttributeName{types.QueueAttributeNameApproximateNumberOfMessages},
+	})
+	if err != nil {
+		return isb.PendingNotAvailable, fmt.Errorf("failed to get attributes of sqs queue %q, %w", ss.queueName, err)
+	}
+	value, ok := output.Attributes[string(types.QueueAttributeNameApproximateNumberOfMessages)]
+	if !ok {
+		return isb.PendingNotAvailable, nil
+	}
+	pending, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return isb.PendingNotAvailable, fmt.Errorf("failed to parse the approximate number of messages %q, %w", value, err)
+	}
+	sqsPending.WithLabelValues(ss.vertexName, ss.pipelineName, ss.queueName).Set(float64(pending))
+	return pending, nil
+}
+
+func (ss *sqsSource) Close() error {
+	ss.logger.Info("SQS source closed")
+	return nil
+}
+
+func (ss *sqsSource) toReadMessage(m types.Message) *isb.ReadMessage {
+	readOffset := &offset{
+		receiptHandle: aws.ToString(m.ReceiptHandle),
+		partitionIdx:  ss.vertexReplica,
+		source:        ss,
+	}
+	// SentTimestamp is the epoch time in milliseconds when the message was sent to the queue.
+	eventTime := time.Now()
+	if ts, ok := m.Attributes[string(types.MessageSystemAttributeNameSentTimestamp)]; ok {
+		if millis, err := strconv.ParseInt(ts, 10, 64); err == nil {
+			eventTime = time.UnixMilli(millis)
+		}
+	}
+	headers := make(map[string]string, len(m.Attributes)+len(m.MessageAttributes))
+	for k, v := range m.Attributes {
+		headers[k] = v
+	}
+	for k, v := range m.MessageAttributes {
+		if v.StringValue != nil {
+			headers[k] = *v.StringValue
+		}
+	}
+	messageID := aws.ToString(m.MessageId)
+	return &isb.ReadMessage{
+		ReadOffset: readOffset,
+		Message: isb.Message{
+			Header: isb.Header{
+				MessageInfo: isb.MessageInfo{EventTime: eventTime},
+				ID: isb.MessageID{
+					VertexName: ss.vertexName,
+					Offset:     messageID,
+					Index:      readOffset.PartitionIdx(),
+				},
+				Headers: headers,
+			},
+			Body: isb.Body{Payload: []byte(aws.ToString(m.Body))},
+		},
+	}
+}
@@ -0,0 +1,208 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqs
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+)
+
+// fakeClient is an in-memory stand-in of an SQS queue.
+type fakeClient struct {
+	mu         sync.Mutex
+	messages   []types.Message
+	receives   []*sqs.ReceiveMessageInput
+	deleted    []string
+	visible    []string
+	failDelete map[string]bool
+}
+
+func (c *fakeClient) GetQueueUrl(_ context.Context, params *sqs.GetQueueUrlInput, _ ...func(*sqs.Options)) (*sqs.GetQueueUrlOutput, error) {
+	return &sqs.GetQueueUrlOutput{QueueUrl: aws.String("http://localhost:4566/000000000000/" + aws.ToString(params.QueueName))}, nil
+}
+
+func (c *fakeClient) ReceiveMessage(_ context.Context, params *sqs.ReceiveMessageInput, _ ...func(*sqs.Options)) (*sqs.ReceiveMessageOutput, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.receives = append(c.receives, params)
+	n := int(params.MaxNumberOfMessages)
+	if n > len(c.messages) {
+		n = len(c.messages)
+	}
+	out := c.messages[:n]
+	c.messages = c.messages[n:]
+	return &sqs.ReceiveMessageOutput{Messages: out}, nil
+}
+
+func (c *fakeClient) DeleteMessageBatch(_ context.Context, params *sqs.DeleteMessageBatchInput, _ ...func(*sqs.Options)) (*sqs.DeleteMessageBatchOutput, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	out := &sqs.DeleteMessageBatchOutput{}
+	for _, e := range params.Entries {
+		if c.failDelete[aws.ToString(e.ReceiptHandle)] {
+			out.Failed = append(out.Failed, types.BatchResultErrorEntry{Id: e.Id, Code: aws.String("ReceiptHandleIsInvalid")})
+			continue
+		}
+		c.deleted = append(c.deleted, aws.ToString(e.ReceiptHandle))
+		out.Successful = append(out.Successful, types.DeleteMessageBatchResultEntry{Id: e.Id})
+	}
+	return out, nil
+}
+
+func (c *fakeClient) ChangeMessageVisibility(_ context.Context, params *sqs.ChangeMessageVisibilityInput, _ ...func(*sqs.Options)) (*sqs.ChangeMessageVisibilityOutput, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if params.VisibilityTimeout == 0 {
+		c.visible = append(c.visible, aws.ToString(params.ReceiptHandle))
+	}
+	return &sqs.ChangeMessageVisibilityOutput{}, nil
+}
+
+func (c *fakeClient) GetQueueAttributes(_ context.Context, _ *sqs.GetQueueAttributesInput, _ ...func(*sqs.Options)) (*sqs.GetQueueAttributesOutput, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return &sqs.GetQueueAttributesOutput{Attributes: map[string]string{
+		string(types.QueueAttributeNameApproximateNumberOfMessages): strconv.Itoa(len(c.messages)),
+	}}, nil
+}
+
+func (c *fakeClient) produce(n int, sentAt time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for i := 0; i < n; i++ {
+		c.messages = append(c.messages, types.Message{
+			MessageId:     aws.String(fmt.Sprintf("id-%d", i)),
+			ReceiptHandle: aws.String(fmt.Sprintf("handle-%d", i)),
+			Body:          aws.String(fmt.Sprintf("message-%d", i)),
+			Attributes: map[string]string{
+				string(types.MessageSystemAttributeNameSentTimestamp): strconv.FormatInt(sentAt.UnixMilli(), 10),
+			},
+			MessageAttributes: map[string]types.MessageAttributeValue{
+				"index": {DataType: aws.String("String"), StringValue: aws.String(strconv.Itoa(i))},
+			},
+		})
+	}
+}
+
+func testVertex(src *dfv1.SqsSource) *dfv1.VertexInstance {
+	return &dfv1.VertexInstance{
+		Vertex: &dfv1.Vertex{
+			Spec: dfv1.VertexSpec{
+				PipelineName: "test-pl",
+				AbstractVertex: dfv1.AbstractVertex{
+					Name:   "test-v",
+					Source: &dfv1.Source{Sqs: src},
+				},
+			},
+		},
+		Replica: 2,
+	}
+}
+
+func TestSqsSource_ReadAndAck(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	client := &fakeClient{}
+	src := &dfv1.SqsSource{
+		AWSRegion:         "us-west-2",
+		QueueName:         "test-queue",
+		WaitTimeSeconds:   aws.Int32(20),
+		VisibilityTimeout: aws.Int32(30),
+	}
+	ss, err := New(ctx, testVertex(src), WithClient(client), WithReadTimeout(time.Second))
+	require.NoError(t, err)
+	defer func() { _ = ss.Close() }()
+	assert.Equal(t, "test-v", ss.GetName())
+	assert.Equal(t, []int32{2}, ss.Partitions(ctx))
+
+	sentAt := time.UnixMilli(time.Now().Add(-time.Minute).UnixMilli())
+	client.produce(15, sentAt)
+	pending, err := ss.Pending(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(15), pending)
+
+	msgs, err := ss.Read(ctx, 12)
+	require.NoError(t, err)
+	require.Len(t, msgs, 12)
+	// the read is split into polls of at most 10 messages
+	require.Len(t, client.receives, 2)
+	assert.Equal(t, int32(10), client.receives[0].MaxNumberOfMessages)
+	assert.Equal(t, int32(2), client.receives[1].MaxNumberOfMessages)
+	// the long poll doesn't outlast the read timeout
+	assert.Equal(t, int32(1), client.receives[0].WaitTimeSeconds)
+	assert.Equal(t, int32(30), client.receives[0].VisibilityTimeout)
+
+	assert.Equal(t, []byte("message-0"), msgs[0].Payload)
+	assert.Empty(t, msgs[0].Keys)
+	assert.Equal(t, "0", msgs[0].Headers["index"])
+	assert.Equal(t, sentAt, msgs[0].EventTime)
+	assert.Equal(t, "id-0", msgs[0].ID.Offset)
+	assert.Equal(t, int32(2), msgs[0].ReadOffset.PartitionIdx())
+
+	offsets := make([]isb.Offset, 0, 11)
+	for _, m := range msgs[:11] {
+		offsets = append(offsets, m.ReadOffset)
+	}
+	for _, err := range ss.Ack(ctx, offsets) {
+		assert.NoError(t, err)
+	}
+	assert.Len(t, client.deleted, 11)
+
+	assert.NoError(t, msgs[11].ReadOffset.NoAck())
+	assert.Equal(t, []string{"handle-11"}, client.visible)
+
+	// only 3 messages left, the read stops at the first empty poll
+	msgs, err = ss.Read(ctx, 10)
+	require.NoError(t, err)
+	assert.Len(t, msgs, 3)
+	msgs, err = ss.Read(ctx, 10)
+	require.NoError(t, err)
+	assert.Empty(t, msgs)
+}
+
+func TestSqsSource_AckFailure(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	client := &fakeClient{failDelete: map[string]bool{"handle-1": true}}
+	ss, err := New(ctx, testVertex(&dfv1.SqsSource{QueueName: "test-queue"}), WithClient(client))
+	require.NoError(t, err)
+
+	client.produce(3, time.Now())
+	msgs, err := ss.Read(ctx, 3)
+	require.NoError(t, err)
+	require.Len(t, msgs, 3)
+	errs := ss.Ack(ctx, []isb.Offset{msgs[0].ReadOffset, msgs[1].ReadOffset, msgs[2].ReadOffset})
+	assert.NoError(t, errs[0])
+	assert.ErrorContains(t, errs[1], "ReceiptHandleIsInvalid")
+	assert.NoError(t, errs[2])
+	assert.Equal(t, []string{"handle-0", "handle-2"}, client.deleted)
+}
@@ -36,6 +36,10 @@ func TestGetExecutors(t *testing.T) {
 				Name:   "filter",
 				KWArgs: map[string]string{"expression": `json(payload).a=="b"`},
 			},
+			{
+				Name:   "schemaValidator",
+				KWArgs: map[string]string{"type": "jsonSchema", "schema": `{"type": "object"}`},
+			},
 		}
 		for _, b := range builtins {
 			e, err := b.executor()
@@ -0,0 +1,222 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schemavalidator
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/hamba/avro/v2"
+	"github.com/numaproj/numaflow-go/pkg/sourcetransformer"
+	"github.com/xeipuuv/gojsonschema"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+)
+
+const (
+	schemaTypeJSONSchema = "jsonSchema"
+	schemaTypeAvro       = "avro"
+	schemaTypeProtobuf   = "protobuf"
+
+	defaultInvalidTag = "invalid"
+)
+
+// validateFunc returns an error if the payload doesn't match the schema.
+type validateFunc func(payload []byte) error
+
+type schemaValidator struct {
+	// schemaType is the type of the schema, one of jsonSchema, avro and protobuf.
+	schemaType string
+	validate   validateFunc
+	// invalidTag is the tag added to the messages which don't match the schema.
+	invalidTag string
+	// validTag is the tag added to the messages which match the schema, no tag is added if it's empty.
+	validTag string
+}
+
+// New returns a source transformer which validates the payload of each message against a schema. The messages which
+// don't match the schema are tagged with the "invalidTag" kwarg, so that they can be routed with edge conditions.
+func New(args map[string]string) (sourcetransformer.SourceTransformFunc, error) {
+	v, err := newSchemaValidator(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(ctx context.Context, keys []string, datum sourcetransformer.Datum) sourcetransformer.Messages {
+		log := logging.FromContext(ctx)
+		resultMsg, err := v.apply(datum.Value(), keys, datum)
+		if err != nil {
+			log.Debugf("Schema validation failed: %v", err)
+		}
+		return sourcetransformer.MessagesBuilder().Append(resultMsg)
+	}, nil
+}
+
+// InvalidTag returns the tag added to the messages which don't match the schema by a schema validator with the kwargs.
+// The messages with the tag are counted as the validation failures by the source vertex.
+func InvalidTag(args map[string]string) string {
+	if tag, existing := args["invalidTag"]; existing {
+		return tag
+	}
+	return defaultInvalidTag
+}
+
+func newSchemaValidator(args map[string]string) (*schemaValidator, error) {
+	schema, err := loadSchema(args)
+	if err != nil {
+		return nil, err
+	}
+	v := &schemaValidator{
+		schemaType: args["type"],
+		invalidTag: InvalidTag(args),
+		validTag:   args["validTag"],
+	}
+	if v.invalidTag == "" {
+		return nil, fmt.Errorf(`"invalidTag" can not be empty`)
+	}
+	switch v.schemaType {
+	case schemaTypeJSONSchema:
+		v.validate, err = newJSONSchemaValidator(schema)
+	case schemaTypeAvro:
+		v.validate, err = newAvroValidator(schema)
+	case schemaTypeProtobuf:
+		v.validate, err = newProtobufValidator(schema, args["messageType"])
+	case "":
+		return nil, fmt.Errorf(`missing "type"`)
+	default:
+		return nil, fmt.Errorf(`unsupported "type" %q, supported types are %q, %q and %q`, v.schemaType, schemaTypeJSONSchema, schemaTypeAvro, schemaTypeProtobuf)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s schema, %w", v.schemaType, err)
+	}
+	return v, nil
+}
+
+// loadSchema returns the schema either from the "schema" kwarg, or from the file at the "schemaFile" kwarg, which is
+// usually mounted from a ConfigMap.
+func loadSchema(args map[string]string) ([]byte, error) {
+	schema, hasSchema := args["schema"]
+	schemaFile, hasSchemaFile := args["schemaFile"]
+	switch {
+	case hasSchema && hasSchemaFile:
+		return nil, fmt.Errorf(`only one of "schema" and "schemaFile" can be specified`)
+	case hasSchema:
+		return []byte(schema), nil
+	case hasSchemaFile:
+		b, err := os.ReadFile(schemaFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read schema file %q, %w", schemaFile, err)
+		}
+		return b, nil
+	default:
+		return nil, fmt.Errorf(`missing "schema" or "schemaFile"`)
+	}
+}
+
+func (v *schemaValidator) apply(payload []byte, keys []string, datum sourcetransformer.Datum) (sourcetransformer.Message, error) {
+	msg := sourcetransformer.NewMessage(payload, datum.EventTime()).WithKeys(keys)
+	if err := v.validate(payload); err != nil {
+		return msg.WithTags([]string{v.invalidTag}), err
+	}
+	if v.validTag != "" {
+		return msg.WithTags([]string{v.validTag}), nil
+	}
+	return msg, nil
+}
+
+func newJSONSchemaValidator(schema []byte) (validateFunc, error) {
+	s, err := gojsonschema.NewSchema(gojsonschema.NewBytesLoader(schema))
+	if err != nil {
+		return nil, err
+	}
+	return func(payload []byte) error {
+		result, err := s.Validate(gojsonschema.NewBytesLoader(payload))
+		if err != nil {
+			return err
+		}
+		if !result.Valid() {
+			var errs []string
+			for _, e := range result.Errors() {
+				errs = append(errs, e.String())
+			}
+			return fmt.Errorf("%s", strings.Join(errs, "; "))
+		}
+		return nil
+	}, nil
+}
+
+func newAvroValidator(schema []byte) (validateFunc, error) {
+	s, err := avro.Parse(string(schema))
+	if err != nil {
+		return nil, err
+	}
+	return func(payload []byte) error {
+		var v any
+		return avro.Unmarshal(s, payload, &v)
+	}, nil
+}
+
+// newProtobufValidator returns a validator for the protobuf message type with the given full name. The schema is a
+// serialized FileDescriptorSet, e.g. generated with "protoc --include_imports --descriptor_set_out", it can be
+// base64 encoded when it's given inline.
+func newProtobufValidator(schema []byte, messageType string) (validateFunc, error) {
+	if messageType == "" {
+		return nil, fmt.Errorf(`missing "messageType"`)
+	}
+	fds := &descriptorpb.FileDescriptorSet{}
+	if err := proto.Unmarshal(schema, fds); err != nil || len(fds.GetFile()) == 0 {
+		decoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(schema)))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse the file descriptor set, it's neither serialized nor base64 encoded")
+		}
+		fds.Reset()
+		if err = proto.Unmarshal(decoded, fds); err != nil {
+			return nil, fmt.Errorf("failed to parse the file descriptor set, %w", err)
+		}
+	}
+	files, err := protodesc.NewFiles(fds)
+	if err != nil {
+		return nil, err
+	}
+	d, err := files.FindDescriptorByName(protoreflect.FullName(messageType))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find message type %q, %w", messageType, err)
+	}
+	md, ok := d.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%q is not a message type", messageType)
+	}
+	return func(payload []byte) error {
+		m := dynamicpb.NewMessage(md)
+		if err := proto.Unmarshal(payload, m); err != nil {
+			return err
+		}
+		// fields not defined in the message type are kept as unknown fields
+		if len(m.GetUnknown()) > 0 {
+			return fmt.Errorf("unknown fields in message %q", messageType)
+		}
+		return nil
+	}, nil
+}
@@ -0,0 +1,169 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schemavalidator
+
+import (
+	"context"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hamba/avro/v2"
+	"github.com/numaproj/numaflow-go/pkg/sourcetransformer"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var _keys = []string{"k"}
+
+type testDatum struct {
+	value     []byte
+	eventTime time.Time
+	watermark time.Time
+	headers   map[string]string
+}
+
+func (h *testDatum) Headers() map[string]string {
+	return h.headers
+}
+
+func (h *testDatum) Value() []byte {
+	return h.value
+}
+
+func (h *testDatum) EventTime() time.Time {
+	return h.eventTime
+}
+
+func (h *testDatum) Watermark() time.Time {
+	return h.watermark
+}
+
+const jsonSchema = `{
+  "type": "object",
+  "properties": {"id": {"type": "integer"}, "name": {"type": "string"}},
+  "required": ["id"]
+}`
+
+const avroSchema = `{
+  "type": "record",
+  "name": "User",
+  "fields": [{"name": "name", "type": "string"}, {"name": "age", "type": "int"}]
+}`
+
+func transform(t *testing.T, args map[string]string, payload []byte) sourcetransformer.Message {
+	t.Helper()
+	f, err := New(args)
+	assert.NoError(t, err)
+	eventTime := time.UnixMilli(1000)
+	messages := f(context.Background(), _keys, &testDatum{value: payload, eventTime: eventTime}).Items()
+	assert.Len(t, messages, 1)
+	assert.Equal(t, payload, messages[0].Value())
+	assert.Equal(t, _keys, messages[0].Keys())
+	assert.Equal(t, eventTime, messages[0].EventTime())
+	return messages[0]
+}
+
+func timestampDescriptorSet(t *testing.T) []byte {
+	t.Helper()
+	fds := &descriptorpb.FileDescriptorSet{
+		File: []*descriptorpb.FileDescriptorProto{protodesc.ToFileDescriptorProto(timestamppb.File_google_protobuf_timestamp_proto)},
+	}
+	b, err := proto.Marshal(fds)
+	assert.NoError(t, err)
+	return b
+}
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name string
+		args map[string]string
+		err  string
+	}{
+		{"missing type", map[string]string{"schema": jsonSchema}, `missing "type"`},
+		{"unsupported type", map[string]string{"type": "xml", "schema": "x"}, `unsupported "type"`},
+		{"missing schema", map[string]string{"type": "jsonSchema"}, `missing "schema" or "schemaFile"`},
+		{"both schema and file", map[string]string{"type": "jsonSchema", "schema": jsonSchema, "schemaFile": "/tmp/x"}, "only one of"},
+		{"missing schema file", map[string]string{"type": "jsonSchema", "schemaFile": "/non-existing/schema.json"}, "failed to read schema file"},
+		{"bad json schema", map[string]string{"type": "jsonSchema", "schema": `{"type": 1}`}, "invalid jsonSchema schema"},
+		{"bad avro schema", map[string]string{"type": "avro", "schema": `{"type": "unknown"}`}, "invalid avro schema"},
+		{"missing message type", map[string]string{"type": "protobuf", "schema": "x"}, `missing "messageType"`},
+		{"bad descriptor set", map[string]string{"type": "protobuf", "schema": "!!", "messageType": "a.B"}, "failed to parse the file descriptor set"},
+		{"empty invalid tag", map[string]string{"type": "jsonSchema", "schema": jsonSchema, "invalidTag": ""}, `"invalidTag" can not be empty`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := New(tt.args)
+			assert.ErrorContains(t, err, tt.err)
+		})
+	}
+}
+
+func TestJSONSchema(t *testing.T) {
+	args := map[string]string{"type": "jsonSchema", "schema": jsonSchema}
+	assert.Nil(t, transform(t, args, []byte(`{"id": 1, "name": "numa"}`)).Tags())
+	assert.Equal(t, []string{"invalid"}, transform(t, args, []byte(`{"name": "numa"}`)).Tags())
+	assert.Equal(t, []string{"invalid"}, transform(t, args, []byte(`not json`)).Tags())
+
+	assert.Equal(t, "invalid", InvalidTag(args))
+	args["invalidTag"] = "bad"
+	args["validTag"] = "good"
+	assert.Equal(t, "bad", InvalidTag(args))
+	assert.Equal(t, []string{"good"}, transform(t, args, []byte(`{"id": 1}`)).Tags())
+	assert.Equal(t, []string{"bad"}, transform(t, args, []byte(`{"id": "1"}`)).Tags())
+}
+
+func TestSchemaFile(t *testing.T) {
+	schemaFile := filepath.Join(t.TempDir(), "schema.json")
+	assert.NoError(t, os.WriteFile(schemaFile, []byte(jsonSchema), 0644))
+	args := map[string]string{"type": "jsonSchema", "schemaFile": schemaFile}
+	assert.Nil(t, transform(t, args, []byte(`{"id": 1}`)).Tags())
+	assert.Equal(t, []string{"invalid"}, transform(t, args, []byte(`{}`)).Tags())
+}
+
+func TestAvro(t *testing.T) {
+	args := map[string]string{"type": "avro", "schema": avroSchema}
+	valid, err := avro.Marshal(avro.MustParse(avroSchema), map[string]any{"name": "numa", "age": 3})
+	assert.NoError(t, err)
+	assert.Nil(t, transform(t, args, valid).Tags())
+	assert.Equal(t, []string{"invalid"}, transform(t, args, valid[:2]).Tags())
+}
+
+func TestProtobuf(t *testing.T) {
+	valid, err := proto.Marshal(timestamppb.New(time.Unix(100, 5)))
+	assert.NoError(t, err)
+	// the string field has a different wire type from the int64 seconds field, it's treated as an unknown field
+	unknown, err := proto.Marshal(wrapperspb.String("numa"))
+	assert.NoError(t, err)
+
+	fds := timestampDescriptorSet(t)
+	for _, schema := range []string{string(fds), base64.StdEncoding.EncodeToString(fds)} {
+		args := map[string]string{"type": "protobuf", "schema": schema, "messageType": "google.protobuf.Timestamp"}
+		assert.Nil(t, transform(t, args, valid).Tags())
+		assert.Equal(t, []string{"invalid"}, transform(t, args, unknown).Tags())
+		assert.Equal(t, []string{"invalid"}, transform(t, args, []byte{0xff, 0xff}).Tags())
+	}
+
+	_, err = New(map[string]string{"type": "protobuf", "schema": string(fds), "messageType": "google.protobuf.Duration"})
+	assert.ErrorContains(t, err, "failed to find message type")
+}
@@ -25,7 +25,12 @@ import (
 
 	"github.com/numaproj/numaflow/pkg/shared/logging"
 	"github.com/numaproj/numaflow/pkg/udf/builtin/cat"
+	"github.com/numaproj/numaflow/pkg/udf/builtin/codec"
 	"github.com/numaproj/numaflow/pkg/udf/builtin/filter"
+	"github.com/numaproj/numaflow/pkg/udf/builtin/flatmap"
+	"github.com/numaproj/numaflow/pkg/udf/builtin/keyextractor"
+	"github.com/numaproj/numaflow/pkg/udf/builtin/project"
+	"github.com/numaproj/numaflow/pkg/udf/builtin/template"
 )
 
 type Builtin struct {
@@ -46,13 +51,30 @@ func (b *Builtin) Start(ctx context.Context) error {
 	return nil
 }
 
+// Validate checks the name and the arguments of the builtin function.
+func (b *Builtin) Validate() error {
+	_, err := b.executor()
+	return err
+}
+
 func (b *Builtin) executor() (mapsdk.MapperFunc, error) {
-	// TODO: deal with args later
 	switch b.Name {
 	case "cat":
 		return cat.New(), nil
 	case "filter":
 		return filter.New(b.KWArgs)
+	case "project":
+		return project.New(b.Args)
+	case "keyExtractor":
+		return keyextractor.New(b.KWArgs)
+	case "template":
+		return template.New(b.KWArgs)
+	case "encode":
+		return codec.NewEncoder(b.KWArgs)
+	case "decode":
+		return codec.NewDecoder(b.KWArgs)
+	case "flatMap":
+		return flatmap.New(b.KWArgs)
 	default:
 		return nil, fmt.Errorf("unrecognized function %q", b.Name)
 	}
@@ -32,6 +32,29 @@ func TestGetExecutors(t *testing.T) {
 				Name:   "filter",
 				KWArgs: map[string]string{"expression": `json(payload).a=="b"`},
 			},
+			{
+				Name: "project",
+				Args: []string{"a", "b.c"},
+			},
+			{
+				Name:   "keyExtractor",
+				KWArgs: map[string]string{"path": "user.id"},
+			},
+			{
+				Name:   "template",
+				KWArgs: map[string]string{"template": `{{ .payload | upper }}`},
+			},
+			{
+				Name:   "encode",
+				KWArgs: map[string]string{"encoding": "base64"},
+			},
+			{
+				Name:   "decode",
+				KWArgs: map[string]string{"encoding": "gzip"},
+			},
+			{
+				Name: "flatMap",
+			},
 		}
 		for _, b := range builtins {
 			e, err := b.executor()
@@ -48,6 +71,20 @@ func TestGetExecutors(t *testing.T) {
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "unrecognized function")
 	})
+
+	t.Run("test bad args", func(t *testing.T) {
+		builtins := []Builtin{
+			{Name: "filter"},
+			{Name: "project"},
+			{Name: "keyExtractor"},
+			{Name: "template", KWArgs: map[string]string{"template": "{{ .payload"}},
+			{Name: "encode", KWArgs: map[string]string{"encoding": "zip"}},
+			{Name: "decode"},
+		}
+		for _, b := range builtins {
+			assert.Error(t, b.Validate(), b.Name)
+		}
+	})
 }
 
 func Test_Start(t *testing.T) {
@@ -0,0 +1,120 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package codec
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+
+	mapsdk "github.com/numaproj/numaflow-go/pkg/mapper"
+
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+)
+
+const (
+	encodingBase64 = "base64"
+	encodingGzip   = "gzip"
+)
+
+type codec struct {
+	encoding string
+}
+
+// NewEncoder returns a map function which encodes the payload with the "encoding" kwarg, "base64" or "gzip".
+func NewEncoder(args map[string]string) (mapsdk.MapperFunc, error) {
+	c, err := newCodec(args)
+	if err != nil {
+		return nil, err
+	}
+	return c.mapper("Encode", c.encode), nil
+}
+
+// NewDecoder returns a map function which decodes the payload with the "encoding" kwarg, "base64" or "gzip".
+func NewDecoder(args map[string]string) (mapsdk.MapperFunc, error) {
+	c, err := newCodec(args)
+	if err != nil {
+		return nil, err
+	}
+	return c.mapper("Decode", c.decode), nil
+}
+
+func newCodec(args map[string]string) (*codec, error) {
+	encoding, existing := args["encoding"]
+	if !existing {
+		return nil, fmt.Errorf(`missing "encoding"`)
+	}
+	switch encoding {
+	case encodingBase64, encodingGzip:
+	default:
+		return nil, fmt.Errorf("unsupported encoding %q, supported encodings are %q and %q", encoding, encodingBase64, encodingGzip)
+	}
+	return &codec{encoding: encoding}, nil
+}
+
+func (c *codec) mapper(name string, apply func([]byte) ([]byte, error)) mapsdk.MapperFunc {
+	return func(ctx context.Context, keys []string, datum mapsdk.Datum) mapsdk.Messages {
+		log := logging.FromContext(ctx)
+		resultMsg, err := apply(datum.Value())
+		if err != nil {
+			log.Errorf("%s map function apply got an error: %v", name, err)
+			return mapsdk.MessagesBuilder().Append(mapsdk.MessageToDrop())
+		}
+		return mapsdk.MessagesBuilder().Append(mapsdk.NewMessage(resultMsg).WithKeys(keys))
+	}
+}
+
+func (c *codec) encode(msg []byte) ([]byte, error) {
+	switch c.encoding {
+	case encodingBase64:
+		result := make([]byte, base64.StdEncoding.EncodedLen(len(msg)))
+		base64.StdEncoding.Encode(result, msg)
+		return result, nil
+	default:
+		var buf bytes.Buffer
+		w := gzip.NewWriter(&buf)
+		if _, err := w.Write(msg); err != nil {
+			return nil, err
+		}
+		if err := w.Close(); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	}
+}
+
+func (c *codec) decode(msg []byte) ([]byte, error) {
+	switch c.encoding {
+	case encodingBase64:
+		result := make([]byte, base64.StdEncoding.DecodedLen(len(msg)))
+		n, err := base64.StdEncoding.Decode(result, msg)
+		if err != nil {
+			return nil, err
+		}
+		return result[:n], nil
+	default:
+		r, err := gzip.NewReader(bytes.NewReader(msg))
+		if err != nil {
+			return nil, err
+		}
+		defer func() { _ = r.Close() }()
+		return io.ReadAll(r)
+	}
+}
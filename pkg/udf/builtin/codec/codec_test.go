@@ -0,0 +1,107 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package codec
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mapsdk "github.com/numaproj/numaflow-go/pkg/mapper"
+	"github.com/stretchr/testify/assert"
+)
+
+type testDatum struct {
+	value     []byte
+	eventTime time.Time
+	watermark time.Time
+	metadata  testDatumMetadata
+	headers   map[string]string
+}
+
+func (h *testDatum) Value() []byte {
+	return h.value
+}
+
+func (h *testDatum) EventTime() time.Time {
+	return h.eventTime
+}
+
+func (h *testDatum) Watermark() time.Time {
+	return h.watermark
+}
+
+func (h *testDatum) Headers() map[string]string {
+	return h.headers
+}
+
+type testDatumMetadata struct {
+	id           string
+	numDelivered uint64
+}
+
+func (t testDatumMetadata) ID() string {
+	return t.id
+}
+
+func (t testDatumMetadata) NumDelivered() uint64 {
+	return t.numDelivered
+}
+
+func TestNewCodec(t *testing.T) {
+	_, err := NewEncoder(map[string]string{})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "missing")
+	_, err = NewDecoder(map[string]string{"encoding": "zstd"})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "unsupported encoding")
+}
+
+func TestEncodeDecode(t *testing.T) {
+	for _, encoding := range []string{encodingBase64, encodingGzip} {
+		t.Run(encoding, func(t *testing.T) {
+			encoder, err := NewEncoder(map[string]string{"encoding": encoding})
+			assert.NoError(t, err)
+			decoder, err := NewDecoder(map[string]string{"encoding": encoding})
+			assert.NoError(t, err)
+
+			encoded := encoder(context.Background(), []string{"k"}, &testDatum{value: []byte("welcome to numaflow")})
+			assert.Equal(t, 1, len(encoded.Items()))
+			assert.NotEqual(t, "welcome to numaflow", string(encoded.Items()[0].Value()))
+			decoded := decoder(context.Background(), []string{"k"}, &testDatum{value: encoded.Items()[0].Value()})
+			assert.Equal(t, 1, len(decoded.Items()))
+			assert.Equal(t, "welcome to numaflow", string(decoded.Items()[0].Value()))
+			assert.Equal(t, []string{"k"}, decoded.Items()[0].Keys())
+		})
+	}
+
+	t.Run("base64 value", func(t *testing.T) {
+		encoder, err := NewEncoder(map[string]string{"encoding": encodingBase64})
+		assert.NoError(t, err)
+		result := encoder(context.Background(), nil, &testDatum{value: []byte("hello")})
+		assert.Equal(t, "aGVsbG8=", string(result.Items()[0].Value()))
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		for _, encoding := range []string{encodingBase64, encodingGzip} {
+			decoder, err := NewDecoder(map[string]string{"encoding": encoding})
+			assert.NoError(t, err)
+			result := decoder(context.Background(), nil, &testDatum{value: []byte("!not encoded!")})
+			assert.Equal(t, []string{mapsdk.DROP}, result.Items()[0].Tags())
+		}
+	})
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flatmap
+
+import (
+	"context"
+	"fmt"
+
+	mapsdk "github.com/numaproj/numaflow-go/pkg/mapper"
+	"github.com/tidwall/gjson"
+
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+)
+
+type flatMap struct {
+	// path is the dot-separated path of the JSON array to split, an empty path means the payload itself.
+	path string
+}
+
+// New returns a map function which splits a JSON array into one message per element. The array is the payload,
+// or the JSON field at the optional "path" kwarg. A string element becomes the payload without the quotes, other
+// elements are written as JSON.
+func New(args map[string]string) (mapsdk.MapperFunc, error) {
+	f := flatMap{
+		path: args["path"],
+	}
+
+	return func(ctx context.Context, keys []string, datum mapsdk.Datum) mapsdk.Messages {
+		log := logging.FromContext(ctx)
+		items, err := f.apply(datum.Value())
+		if err != nil {
+			log.Errorf("FlatMap map function apply got an error: %v", err)
+			return mapsdk.MessagesBuilder().Append(mapsdk.MessageToDrop())
+		}
+		messages := mapsdk.MessagesBuilder()
+		if len(items) == 0 {
+			return messages.Append(mapsdk.MessageToDrop())
+		}
+		for _, item := range items {
+			messages = messages.Append(mapsdk.NewMessage(item).WithKeys(keys))
+		}
+		return messages
+	}, nil
+}
+
+func (f flatMap) apply(msg []byte) ([][]byte, error) {
+	if !gjson.ValidBytes(msg) {
+		return nil, fmt.Errorf("payload is not a valid JSON")
+	}
+	v := gjson.ParseBytes(msg)
+	if f.path != "" {
+		v = v.Get(f.path)
+	}
+	if !v.IsArray() {
+		if f.path == "" {
+			return nil, fmt.Errorf("payload is not a JSON array")
+		}
+		return nil, fmt.Errorf("field %q is not a JSON array", f.path)
+	}
+	var result [][]byte
+	for _, item := range v.Array() {
+		if item.Type == gjson.String {
+			result = append(result, []byte(item.String()))
+		} else {
+			result = append(result, []byte(item.Raw))
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flatmap
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mapsdk "github.com/numaproj/numaflow-go/pkg/mapper"
+	"github.com/stretchr/testify/assert"
+)
+
+type testDatum struct {
+	value     []byte
+	eventTime time.Time
+	watermark time.Time
+	metadata  testDatumMetadata
+	headers   map[string]string
+}
+
+func (h *testDatum) Value() []byte {
+	return h.value
+}
+
+func (h *testDatum) EventTime() time.Time {
+	return h.eventTime
+}
+
+func (h *testDatum) Watermark() time.Time {
+	return h.watermark
+}
+
+func (h *testDatum) Headers() map[string]string {
+	return h.headers
+}
+
+type testDatumMetadata struct {
+	id           string
+	numDelivered uint64
+}
+
+func (t testDatumMetadata) ID() string {
+	return t.id
+}
+
+func (t testDatumMetadata) NumDelivered() uint64 {
+	return t.numDelivered
+}
+
+func TestNew(t *testing.T) {
+	t.Run("split payload", func(t *testing.T) {
+		handle, err := New(map[string]string{})
+		assert.NoError(t, err)
+		result := handle(context.Background(), []string{"k"}, &testDatum{value: []byte(`["a", 1, {"b": true}]`)})
+		assert.Equal(t, 3, len(result.Items()))
+		assert.Equal(t, "a", string(result.Items()[0].Value()))
+		assert.Equal(t, "1", string(result.Items()[1].Value()))
+		assert.JSONEq(t, `{"b": true}`, string(result.Items()[2].Value()))
+		for _, m := range result.Items() {
+			assert.Equal(t, []string{"k"}, m.Keys())
+		}
+	})
+
+	t.Run("split field", func(t *testing.T) {
+		handle, err := New(map[string]string{"path": "order.items"})
+		assert.NoError(t, err)
+		result := handle(context.Background(), nil, &testDatum{value: []byte(`{"order": {"items": [{"id": 1}, {"id": 2}]}}`)})
+		assert.Equal(t, 2, len(result.Items()))
+		assert.JSONEq(t, `{"id": 2}`, string(result.Items()[1].Value()))
+	})
+
+	t.Run("empty array", func(t *testing.T) {
+		handle, err := New(map[string]string{})
+		assert.NoError(t, err)
+		result := handle(context.Background(), nil, &testDatum{value: []byte(`[]`)})
+		assert.Equal(t, 1, len(result.Items()))
+		assert.Equal(t, []string{mapsdk.DROP}, result.Items()[0].Tags())
+	})
+
+	t.Run("not an array", func(t *testing.T) {
+		handle, err := New(map[string]string{"path": "order"})
+		assert.NoError(t, err)
+		for _, payload := range []string{`{"order": 1}`, `not a json`} {
+			result := handle(context.Background(), nil, &testDatum{value: []byte(payload)})
+			assert.Equal(t, 1, len(result.Items()))
+			assert.Equal(t, []string{mapsdk.DROP}, result.Items()[0].Tags())
+		}
+	})
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package keyextractor
+
+import (
+	"context"
+	"fmt"
+
+	mapsdk "github.com/numaproj/numaflow-go/pkg/mapper"
+	"github.com/tidwall/gjson"
+)
+
+type keyExtractor struct {
+	// path is the dot-separated path of the JSON field to extract the keys from.
+	path string
+}
+
+// New returns a map function which sets the keys of a message to the value of the JSON field at the "path"
+// kwarg. If the field is an array, each element is a key. If the field is missing, the original keys are kept.
+func New(args map[string]string) (mapsdk.MapperFunc, error) {
+	path, existing := args["path"]
+	if !existing || path == "" {
+		return nil, fmt.Errorf(`missing "path"`)
+	}
+	e := keyExtractor{
+		path: path,
+	}
+
+	return func(ctx context.Context, keys []string, datum mapsdk.Datum) mapsdk.Messages {
+		return mapsdk.MessagesBuilder().Append(mapsdk.NewMessage(datum.Value()).WithKeys(e.apply(datum.Value(), keys)))
+	}, nil
+}
+
+func (e keyExtractor) apply(msg []byte, keys []string) []string {
+	v := gjson.GetBytes(msg, e.path)
+	if !v.Exists() {
+		return keys
+	}
+	if v.IsArray() {
+		var result []string
+		for _, item := range v.Array() {
+			result = append(result, item.String())
+		}
+		return result
+	}
+	return []string{v.String()}
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package keyextractor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testDatum struct {
+	value     []byte
+	eventTime time.Time
+	watermark time.Time
+	metadata  testDatumMetadata
+	headers   map[string]string
+}
+
+func (h *testDatum) Value() []byte {
+	return h.value
+}
+
+func (h *testDatum) EventTime() time.Time {
+	return h.eventTime
+}
+
+func (h *testDatum) Watermark() time.Time {
+	return h.watermark
+}
+
+func (h *testDatum) Headers() map[string]string {
+	return h.headers
+}
+
+type testDatumMetadata struct {
+	id           string
+	numDelivered uint64
+}
+
+func (t testDatumMetadata) ID() string {
+	return t.id
+}
+
+func (t testDatumMetadata) NumDelivered() uint64 {
+	return t.numDelivered
+}
+
+func TestNew(t *testing.T) {
+	t.Run("missing path", func(t *testing.T) {
+		_, err := New(map[string]string{})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "missing")
+	})
+
+	handle, err := New(map[string]string{"path": "user.id"})
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		payload  string
+		wantKeys []string
+	}{
+		{name: "string field", payload: `{"user": {"id": "u1"}}`, wantKeys: []string{"u1"}},
+		{name: "number field", payload: `{"user": {"id": 12}}`, wantKeys: []string{"12"}},
+		{name: "array field", payload: `{"user": {"id": ["a", "b"]}}`, wantKeys: []string{"a", "b"}},
+		{name: "missing field", payload: `{"user": {}}`, wantKeys: []string{"original"}},
+		{name: "not a json", payload: `abc`, wantKeys: []string{"original"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := handle(context.Background(), []string{"original"}, &testDatum{value: []byte(tt.payload)})
+			assert.Equal(t, 1, len(result.Items()))
+			assert.Equal(t, tt.wantKeys, result.Items()[0].Keys())
+			assert.Equal(t, tt.payload, string(result.Items()[0].Value()))
+		})
+	}
+}
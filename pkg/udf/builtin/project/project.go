@@ -0,0 +1,88 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package project
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	mapsdk "github.com/numaproj/numaflow-go/pkg/mapper"
+	"github.com/tidwall/gjson"
+
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+)
+
+type project struct {
+	// fields are the dot-separated paths of the JSON fields to keep.
+	fields []string
+}
+
+// New returns a map function which projects a JSON payload to the fields given in the args, each arg is a
+// dot-separated path such as "user.name". The nesting of the fields is kept, and missing fields are skipped.
+func New(args []string) (mapsdk.MapperFunc, error) {
+	if len(args) == 0 {
+		return nil, fmt.Errorf(`missing the fields to project in "args"`)
+	}
+	for _, f := range args {
+		if f == "" || strings.HasPrefix(f, ".") || strings.HasSuffix(f, ".") || strings.Contains(f, "..") {
+			return nil, fmt.Errorf("invalid field %q", f)
+		}
+	}
+	p := project{
+		fields: args,
+	}
+
+	return func(ctx context.Context, keys []string, datum mapsdk.Datum) mapsdk.Messages {
+		log := logging.FromContext(ctx)
+		resultMsg, err := p.apply(datum.Value())
+		if err != nil {
+			log.Errorf("Project map function apply got an error: %v", err)
+			return mapsdk.MessagesBuilder().Append(mapsdk.MessageToDrop())
+		}
+		return mapsdk.MessagesBuilder().Append(mapsdk.NewMessage(resultMsg).WithKeys(keys))
+	}, nil
+}
+
+func (p project) apply(msg []byte) ([]byte, error) {
+	if !gjson.ValidBytes(msg) {
+		return nil, fmt.Errorf("payload is not a valid JSON")
+	}
+	result := make(map[string]interface{})
+	for _, f := range p.fields {
+		v := gjson.GetBytes(msg, f)
+		if !v.Exists() {
+			continue
+		}
+		setField(result, strings.Split(f, "."), json.RawMessage(v.Raw))
+	}
+	return json.Marshal(result)
+}
+
+// setField sets the value to the nested field of the path, creating the intermediate objects.
+func setField(obj map[string]interface{}, path []string, value json.RawMessage) {
+	for _, p := range path[:len(path)-1] {
+		next, ok := obj[p].(map[string]interface{})
+		if !ok {
+			next = make(map[string]interface{})
+			obj[p] = next
+		}
+		obj = next
+	}
+	obj[path[len(path)-1]] = value
+}
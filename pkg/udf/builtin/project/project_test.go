@@ -0,0 +1,96 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package project
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mapsdk "github.com/numaproj/numaflow-go/pkg/mapper"
+	"github.com/stretchr/testify/assert"
+)
+
+type testDatum struct {
+	value     []byte
+	eventTime time.Time
+	watermark time.Time
+	metadata  testDatumMetadata
+	headers   map[string]string
+}
+
+func (h *testDatum) Value() []byte {
+	return h.value
+}
+
+func (h *testDatum) EventTime() time.Time {
+	return h.eventTime
+}
+
+func (h *testDatum) Watermark() time.Time {
+	return h.watermark
+}
+
+func (h *testDatum) Headers() map[string]string {
+	return h.headers
+}
+
+type testDatumMetadata struct {
+	id           string
+	numDelivered uint64
+}
+
+func (t testDatumMetadata) ID() string {
+	return t.id
+}
+
+func (t testDatumMetadata) NumDelivered() uint64 {
+	return t.numDelivered
+}
+
+func TestNew(t *testing.T) {
+	t.Run("missing fields", func(t *testing.T) {
+		_, err := New(nil)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "missing")
+	})
+
+	t.Run("invalid field", func(t *testing.T) {
+		_, err := New([]string{"a..b"})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid field")
+	})
+
+	t.Run("project fields", func(t *testing.T) {
+		handle, err := New([]string{"id", "user.name", "user.missing"})
+		assert.NoError(t, err)
+		result := handle(context.Background(), []string{"k"}, &testDatum{
+			value: []byte(`{"id": 1, "user": {"name": "numa", "age": 3}, "items": ["a", "b"], "extra": true}`),
+		})
+		assert.Equal(t, 1, len(result.Items()))
+		assert.JSONEq(t, `{"id": 1, "user": {"name": "numa"}}`, string(result.Items()[0].Value()))
+		assert.Equal(t, []string{"k"}, result.Items()[0].Keys())
+	})
+
+	t.Run("invalid json", func(t *testing.T) {
+		handle, err := New([]string{"id"})
+		assert.NoError(t, err)
+		result := handle(context.Background(), []string{"k"}, &testDatum{value: []byte("not a json")})
+		assert.Equal(t, 1, len(result.Items()))
+		assert.Equal(t, []string{mapsdk.DROP}, result.Items()[0].Tags())
+	})
+}
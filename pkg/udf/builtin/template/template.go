@@ -0,0 +1,81 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"text/template"
+	"time"
+
+	"github.com/Masterminds/sprig/v3"
+	mapsdk "github.com/numaproj/numaflow-go/pkg/mapper"
+
+	"github.com/numaproj/numaflow/pkg/shared/logging"
+)
+
+type payloadTemplate struct {
+	tmpl *template.Template
+}
+
+// New returns a map function which renders the "template" kwarg, a Go text template with the sprig functions,
+// as the new payload. The template can access the message with ".payload" (string), ".json" (the payload
+// decoded as a JSON, or nil if it's not a JSON), ".keys", ".headers" and ".eventTime".
+func New(args map[string]string) (mapsdk.MapperFunc, error) {
+	text, existing := args["template"]
+	if !existing {
+		return nil, fmt.Errorf(`missing "template"`)
+	}
+	tmpl, err := template.New("payload").Funcs(sprig.TxtFuncMap()).Option("missingkey=zero").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid template, %w", err)
+	}
+	t := payloadTemplate{
+		tmpl: tmpl,
+	}
+
+	return func(ctx context.Context, keys []string, datum mapsdk.Datum) mapsdk.Messages {
+		log := logging.FromContext(ctx)
+		resultMsg, err := t.apply(keys, datum.Value(), datum.Headers(), datum.EventTime())
+		if err != nil {
+			log.Errorf("Template map function apply got an error: %v", err)
+			return mapsdk.MessagesBuilder().Append(mapsdk.MessageToDrop())
+		}
+		return mapsdk.MessagesBuilder().Append(mapsdk.NewMessage(resultMsg).WithKeys(keys))
+	}, nil
+}
+
+func (t payloadTemplate) apply(keys []string, msg []byte, headers map[string]string, eventTime time.Time) ([]byte, error) {
+	var obj interface{}
+	if err := json.Unmarshal(msg, &obj); err != nil {
+		obj = nil
+	}
+	data := map[string]interface{}{
+		"payload":   string(msg),
+		"json":      obj,
+		"keys":      keys,
+		"headers":   headers,
+		"eventTime": eventTime,
+	}
+	var buf bytes.Buffer
+	if err := t.tmpl.Execute(&buf, data); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mapsdk "github.com/numaproj/numaflow-go/pkg/mapper"
+	"github.com/stretchr/testify/assert"
+)
+
+type testDatum struct {
+	value     []byte
+	eventTime time.Time
+	watermark time.Time
+	metadata  testDatumMetadata
+	headers   map[string]string
+}
+
+func (h *testDatum) Value() []byte {
+	return h.value
+}
+
+func (h *testDatum) EventTime() time.Time {
+	return h.eventTime
+}
+
+func (h *testDatum) Watermark() time.Time {
+	return h.watermark
+}
+
+func (h *testDatum) Headers() map[string]string {
+	return h.headers
+}
+
+type testDatumMetadata struct {
+	id           string
+	numDelivered uint64
+}
+
+func (t testDatumMetadata) ID() string {
+	return t.id
+}
+
+func (t testDatumMetadata) NumDelivered() uint64 {
+	return t.numDelivered
+}
+
+func TestNew(t *testing.T) {
+	t.Run("missing template", func(t *testing.T) {
+		_, err := New(map[string]string{})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "missing")
+	})
+
+	t.Run("invalid template", func(t *testing.T) {
+		_, err := New(map[string]string{"template": "{{ .payload "})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid template")
+	})
+
+	t.Run("render", func(t *testing.T) {
+		handle, err := New(map[string]string{"template": `{"name": "{{ .json.name | upper }}", "key": "{{ index .keys 0 }}", "region": "{{ .headers.region }}", "year": {{ .eventTime.Year }}}`})
+		assert.NoError(t, err)
+		result := handle(context.Background(), []string{"k1"}, &testDatum{
+			value:     []byte(`{"name": "numa"}`),
+			eventTime: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			headers:   map[string]string{"region": "us"},
+		})
+		assert.Equal(t, 1, len(result.Items()))
+		assert.JSONEq(t, `{"name": "NUMA", "key": "k1", "region": "us", "year": 2024}`, string(result.Items()[0].Value()))
+		assert.Equal(t, []string{"k1"}, result.Items()[0].Keys())
+	})
+
+	t.Run("non json payload", func(t *testing.T) {
+		handle, err := New(map[string]string{"template": `{{ .payload | b64enc }}`})
+		assert.NoError(t, err)
+		result := handle(context.Background(), nil, &testDatum{value: []byte("hello")})
+		assert.Equal(t, "aGVsbG8=", string(result.Items()[0].Value()))
+	})
+
+	t.Run("render error", func(t *testing.T) {
+		handle, err := New(map[string]string{"template": `{{ fail "boom" }}`})
+		assert.NoError(t, err)
+		result := handle(context.Background(), nil, &testDatum{value: []byte("hello")})
+		assert.Equal(t, []string{mapsdk.DROP}, result.Items()[0].Tags())
+	})
+}
@@ -23,6 +23,7 @@ import (
 
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/numaproj/numaflow/pkg/isb"
+	diskisb "github.com/numaproj/numaflow/pkg/isb/stores/disk"
 	jetstreamisb "github.com/numaproj/numaflow/pkg/isb/stores/jetstream"
 	redisisb "github.com/numaproj/numaflow/pkg/isb/stores/redis"
 	"github.com/numaproj/numaflow/pkg/isbsvc"
@@ -30,9 +31,8 @@ import (
 	redisclient "github.com/numaproj/numaflow/pkg/shared/clients/redis"
 )
 
-func buildRedisBufferIO(ctx context.Context, vertexInstance *dfv1.VertexInstance) ([]isb.BufferReader, map[string][]isb.BufferWriter, error) {
+func buildRedisBufferIO(ctx context.Context, vertexInstance *dfv1.VertexInstance, redisClient *redisclient.RedisClient) ([]isb.BufferReader, map[string][]isb.BufferWriter, error) {
 	var readers []isb.BufferReader
-	redisClient := redisclient.NewInClusterRedisClient()
 	var readerOpts []redisclient.Option
 	if x := vertexInstance.Vertex.Spec.Limits; x != nil && x.ReadTimeout != nil {
 		readerOpts = append(readerOpts, redisclient.WithReadTimeOut(x.ReadTimeout.Duration))
@@ -74,6 +74,7 @@ func buildRedisBufferIO(ctx context.Context, vertexInstance *dfv1.VertexInstance
 
 		writeOpts := []redisclient.Option{
 			redisclient.WithBufferFullWritingStrategy(e.BufferFullWritingStrategy()),
+			redisclient.WithCompression(e.Compression),
 		}
 		if x := e.ToVertexLimits; x != nil && x.BufferMaxLength != nil {
 			writeOpts = append(writeOpts, redisclient.WithMaxLength(int64(*x.BufferMaxLength)))
@@ -101,6 +102,9 @@ func buildJetStreamBufferIO(ctx context.Context, vertexInstance *dfv1.VertexInst
 	if x := vertexInstance.Vertex.Spec.Limits; x != nil && x.ReadTimeout != nil {
 		readOptions = append(readOptions, jetstreamisb.WithReadTimeOut(x.ReadTimeout.Duration))
 	}
+	if x := vertexInstance.Vertex.GetInputPriority(); x != nil {
+		readOptions = append(readOptions, jetstreamisb.WithReadPriorityLanes(x.GetWeights()))
+	}
 
 	// create readers for owned buffer partitions.
 	// For reduce vertex, we only need to read from one buffer partition.
@@ -146,6 +150,7 @@ func buildJetStreamBufferIO(ctx context.Context, vertexInstance *dfv1.VertexInst
 	for _, e := range vertexInstance.Vertex.Spec.ToEdges {
 		writeOpts := []jetstreamisb.WriteOption{
 			jetstreamisb.WithBufferFullWritingStrategy(e.BufferFullWritingStrategy()),
+			jetstreamisb.WithCompression(e.Compression),
 		}
 		if x := e.ToVertexLimits; x != nil && x.BufferMaxLength != nil {
 			writeOpts = append(writeOpts, jetstreamisb.WithMaxLength(int64(*x.BufferMaxLength)))
@@ -153,6 +158,9 @@ func buildJetStreamBufferIO(ctx context.Context, vertexInstance *dfv1.VertexInst
 		if x := e.ToVertexLimits; x != nil && x.BufferUsageLimit != nil {
 			writeOpts = append(writeOpts, jetstreamisb.WithBufferUsageLimit(float64(*x.BufferUsageLimit)/100))
 		}
+		if x := e.Priority; x != nil {
+			writeOpts = append(writeOpts, jetstreamisb.WithPriorityLanes(int(x.Lanes)))
+		}
 
 		partitionedBuffers := dfv1.GenerateBufferNames(vertexInstance.Vertex.Namespace, vertexInstance.Vertex.Spec.PipelineName, e.To, e.GetToVertexPartitionCount())
 		var edgeBuffers []isb.BufferWriter
@@ -169,3 +177,71 @@ func buildJetStreamBufferIO(ctx context.Context, vertexInstance *dfv1.VertexInst
 	}
 	return readers, writers, nil
 }
+
+func buildDiskBufferIO(ctx context.Context, vertexInstance *dfv1.VertexInstance) ([]isb.BufferReader, map[string][]isb.BufferWriter, error) {
+	var readers []isb.BufferReader
+	var readOptions []diskisb.ReadOption
+	if x := vertexInstance.Vertex.Spec.Limits; x != nil && x.ReadTimeout != nil {
+		readOptions = append(readOptions, diskisb.WithReadTimeOut(x.ReadTimeout.Duration))
+	}
+	consumer := fmt.Sprintf("%s-%v", vertexInstance.Vertex.Name, vertexInstance.Replica)
+
+	// create readers for owned buffer partitions.
+	// For reduce vertex, we only need to read from one buffer partition.
+	if vertexInstance.Vertex.GetVertexType() == dfv1.VertexTypeReduceUDF {
+		var fromBufferPartition string
+		// find the buffer partition owned by this replica.
+		for _, b := range vertexInstance.Vertex.OwnedBuffers() {
+			if strings.HasSuffix(b, fmt.Sprintf("-%d", vertexInstance.Replica)) {
+				fromBufferPartition = b
+				break
+			}
+		}
+		if len(fromBufferPartition) == 0 {
+			return nil, nil, fmt.Errorf("can not find from buffer")
+		}
+		// since we read from one buffer partition, fromPartitionIdx is 0.
+		reader, err := diskisb.NewDiskBufferReader(ctx, dfv1.PathISBSvcDiskMount, fromBufferPartition, consumer, 0, readOptions...)
+		if err != nil {
+			return nil, nil, err
+		}
+		readers = append(readers, reader)
+	} else {
+		// for map vertex, we need to read from all buffer partitions. So create readers for all buffer partitions.
+		for index, bufferPartition := range vertexInstance.Vertex.OwnedBuffers() {
+			reader, err := diskisb.NewDiskBufferReader(ctx, dfv1.PathISBSvcDiskMount, bufferPartition, consumer, int32(index), readOptions...)
+			if err != nil {
+				return nil, nil, err
+			}
+			readers = append(readers, reader)
+		}
+	}
+
+	// create writers for toVertex's buffer partitions.
+	// we create a map of toVertex -> []BufferWriter(writer for each partition)
+	writers := make(map[string][]isb.BufferWriter)
+	for _, e := range vertexInstance.Vertex.Spec.ToEdges {
+		writeOpts := []diskisb.WriteOption{
+			diskisb.WithBufferFullWritingStrategy(e.BufferFullWritingStrategy()),
+			diskisb.WithCompression(e.Compression),
+		}
+		if x := e.ToVertexLimits; x != nil && x.BufferMaxLength != nil {
+			writeOpts = append(writeOpts, diskisb.WithMaxLength(int64(*x.BufferMaxLength)))
+		}
+		if x := e.ToVertexLimits; x != nil && x.BufferUsageLimit != nil {
+			writeOpts = append(writeOpts, diskisb.WithBufferUsageLimit(float64(*x.BufferUsageLimit)/100))
+		}
+
+		partitionedBuffers := dfv1.GenerateBufferNames(vertexInstance.Vertex.Namespace, vertexInstance.Vertex.Spec.PipelineName, e.To, e.GetToVertexPartitionCount())
+		var edgeBuffers []isb.BufferWriter
+		for partitionIdx, partition := range partitionedBuffers {
+			writer, err := diskisb.NewDiskBufferWriter(ctx, dfv1.PathISBSvcDiskMount, partition, int32(partitionIdx), writeOpts...)
+			if err != nil {
+				return nil, nil, err
+			}
+			edgeBuffers = append(edgeBuffers, writer)
+		}
+		writers[e.To] = edgeBuffers
+	}
+	return readers, writers, nil
+}
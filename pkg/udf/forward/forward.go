@@ -36,6 +36,7 @@ import (
 	"github.com/numaproj/numaflow/pkg/forwarder"
 	"github.com/numaproj/numaflow/pkg/isb"
 	"github.com/numaproj/numaflow/pkg/metrics"
+	"github.com/numaproj/numaflow/pkg/shared/deadletter"
 	"github.com/numaproj/numaflow/pkg/shared/idlehandler"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
 	"github.com/numaproj/numaflow/pkg/watermark/fetch"
@@ -281,6 +282,14 @@ func (isdf *InterStepDataForward) forwardAChunk(ctx context.Context) error {
 	// Check if map streaming mode is enabled, if the applier is not nil that means we have enabled the required mode
 	if isdf.opts.streamMapUdfApplier != nil {
 		writeOffsets, err = isdf.streamMessage(ctx, dataMessages)
+		if err != nil && isdf.opts.deadLetterWriter != nil {
+			// the messages streamed before a failure are written too, keep the offsets of every attempt.
+			err = isdf.retryOrDeadLetter(ctx, dataMessages, err, func(messages []*isb.ReadMessage) error {
+				offsets, e := isdf.streamMessage(ctx, messages)
+				writeOffsets = mergeWriteOffsets(writeOffsets, offsets)
+				return e
+			})
+		}
 		if err != nil {
 			isdf.opts.logger.Errorw("failed to streamMessage", zap.Error(err))
 			// As there's no partial failure, non-ack all the readOffsets
@@ -298,6 +307,17 @@ func (isdf *InterStepDataForward) forwardAChunk(ctx context.Context) error {
 		// ie Batch Map or unary map
 		// This will be a blocking call until the all the UDF results for the batch are received.
 		udfResults, err = isdf.applyUDF(ctx, dataMessages)
+		if err != nil && isdf.opts.deadLetterWriter != nil {
+			// the results are collected from the successful processing of the batch, or of the messages in it.
+			udfResults = nil
+			err = isdf.retryOrDeadLetter(ctx, dataMessages, err, func(messages []*isb.ReadMessage) error {
+				results, e := isdf.applyUDF(ctx, messages)
+				if e == nil {
+					udfResults = append(udfResults, results...)
+				}
+				return e
+			})
+		}
 		if err != nil {
 			isdf.opts.logger.Errorw("failed to applyUDF", zap.Error(err))
 			// As there's no partial failure, non-ack all the readOffsets
@@ -445,20 +465,16 @@ func (isdf *InterStepDataForward) streamMessage(ctx context.Context, dataMessage
 
 		// Determine where to step and write to buffers
 		if err := isdf.whereToStep(&writeMessage, messageToStep, dataMessages[0]); err != nil {
-			return nil, fmt.Errorf("failed at whereToStep, error: %w", err)
+			return writeOffsets, fmt.Errorf("failed at whereToStep, error: %w", err)
 		}
 
 		curWriteOffsets, err := isdf.writeToBuffers(ctx, messageToStep)
 		if err != nil {
-			return nil, fmt.Errorf("failed to write to toBuffers, error: %w", err)
+			return writeOffsets, fmt.Errorf("failed to write to toBuffers, error: %w", err)
 		}
 
 		// Merge current write offsets into the main writeOffsets map
-		for vertexName, toVertexBufferOffsets := range curWriteOffsets {
-			for index, offsets := range toVertexBufferOffsets {
-				writeOffsets[vertexName][index] = append(writeOffsets[vertexName][index], offsets...)
-			}
-		}
+		writeOffsets = mergeWriteOffsets(writeOffsets, curWriteOffsets)
 
 		// Clear messageToStep, as we have written the messages to the buffers
 		for toVertex := range isdf.toBuffers {
@@ -473,7 +489,7 @@ func (isdf *InterStepDataForward) streamMessage(ctx context.Context, dataMessage
 			isdf.opts.logger.Errorw("mapUDF.Apply, Stop called while stuck on an internal error", zap.Error(err))
 			metrics.PlatformError.With(metricLabels).Inc()
 		}
-		return nil, fmt.Errorf("failed to applyUDF, error: %w", err)
+		return writeOffsets, fmt.Errorf("failed to applyUDF, error: %w", err)
 	}
 
 	metrics.UDFProcessingTime.With(metricLabels).Observe(float64(time.Since(start).Microseconds()))
@@ -530,6 +546,22 @@ func (isdf *InterStepDataForward) ackFromBuffer(ctx context.Context, offsets []i
 	return ctxClosedErr
 }
 
+// mergeWriteOffsets appends the offsets written to each partition of the to buffers in src to the ones in dst.
+func mergeWriteOffsets(dst, src map[string][][]isb.Offset) map[string][][]isb.Offset {
+	if dst == nil {
+		return src
+	}
+	for toVertexName, toVertexBufferOffsets := range src {
+		if len(dst[toVertexName]) < len(toVertexBufferOffsets) {
+			dst[toVertexName] = append(dst[toVertexName], make([][]isb.Offset, len(toVertexBufferOffsets)-len(dst[toVertexName]))...)
+		}
+		for index, offsets := range toVertexBufferOffsets {
+			dst[toVertexName][index] = append(dst[toVertexName][index], offsets...)
+		}
+	}
+	return dst
+}
+
 // writeToBuffers is a blocking call until all the messages have be forwarded to all the toBuffers, or a shutdown
 // has been initiated while we are stuck looping on an InternalError.
 func (isdf *InterStepDataForward) writeToBuffers(
@@ -655,10 +687,64 @@ func (isdf *InterStepDataForward) applyUDF(ctx context.Context, readMessages []*
 	return writeMessages, nil
 }
 
+// retryOrDeadLetter retries the failed UDF processing of the data messages. If the batch still fails after the
+// retries are exhausted, the messages are processed one by one, and only the failing ones are written to the
+// dead-letter buffer. It returns nil if all the messages are either processed or dead-lettered, nothing is forwarded
+// for the dead-lettered messages and they will be acked.
+func (isdf *InterStepDataForward) retryOrDeadLetter(ctx context.Context, dataMessages []*isb.ReadMessage, udfErr error, process func([]*isb.ReadMessage) error) error {
+	for i := 0; i < isdf.opts.deadLetterMaxRetries; i++ {
+		select {
+		case <-ctx.Done():
+			return udfErr
+		case <-time.After(isdf.opts.deadLetterRetryInterval):
+		}
+		isdf.opts.logger.Warnw("Retrying the failed UDF processing", zap.Int("retry", i+1), zap.Error(udfErr))
+		if udfErr = process(dataMessages); udfErr == nil {
+			return nil
+		}
+	}
+	// the failure might be caused by the shutdown, do not dead-letter the messages in that case.
+	if ctx.Err() != nil {
+		return udfErr
+	}
+	var deadLetterMessages []isb.Message
+	if len(dataMessages) == 1 {
+		deadLetterMessages = append(deadLetterMessages, deadletter.NewMessage(dataMessages[0].Message, isdf.vertexName, isdf.fromBufferPartition.GetPartitionIdx(), isdf.opts.deadLetterMaxRetries, udfErr))
+	} else {
+		for _, m := range dataMessages {
+			if err := process([]*isb.ReadMessage{m}); err != nil {
+				if ctx.Err() != nil {
+					return err
+				}
+				deadLetterMessages = append(deadLetterMessages, deadletter.NewMessage(m.Message, isdf.vertexName, isdf.fromBufferPartition.GetPartitionIdx(), isdf.opts.deadLetterMaxRetries, err))
+			}
+		}
+	}
+	if len(deadLetterMessages) == 0 {
+		return nil
+	}
+	isdf.opts.logger.Errorw("Retries exhausted, writing the failed messages to the dead-letter buffer", zap.Int("count", len(deadLetterMessages)), zap.Int("batch", len(dataMessages)), zap.Error(udfErr))
+	shouldStop := func() bool {
+		ok, _ := isdf.IsShuttingDown()
+		return ok
+	}
+	if err := deadletter.Write(ctx, isdf.opts.deadLetterWriter, deadLetterMessages, isdf.opts.retryInterval, shouldStop); err != nil {
+		return err
+	}
+	metrics.DeadLetterMessagesCount.With(map[string]string{
+		metrics.LabelVertex:             isdf.vertexName,
+		metrics.LabelPipeline:           isdf.pipelineName,
+		metrics.LabelVertexType:         string(dfv1.VertexTypeMapUDF),
+		metrics.LabelVertexReplicaIndex: strconv.Itoa(int(isdf.vertexReplica)),
+		metrics.LabelPartitionName:      isdf.opts.deadLetterWriter.GetName(),
+	}).Add(float64(len(deadLetterMessages)))
+	return nil
+}
+
 // whereToStep executes the WhereTo interfaces and then updates the to step's writeToBuffers buffer.
 func (isdf *InterStepDataForward) whereToStep(writeMessage *isb.WriteMessage, messageToStep map[string][][]isb.Message, readMessage *isb.ReadMessage) error {
 	// call WhereTo and drop it on errors
-	to, err := isdf.fsd.WhereTo(writeMessage.Keys, writeMessage.Tags, writeMessage.ID.String())
+	to, err := isdf.fsd.WhereTo(writeMessage.Keys, writeMessage.Tags, writeMessage.ID.String(), &writeMessage.Message)
 	if err != nil {
 		isdf.opts.logger.Errorw("failed in whereToStep", zap.Error(isb.MessageWriteErr{Name: isdf.fromBufferPartition.GetName(), Header: readMessage.Header, Body: readMessage.Body, Message: fmt.Sprintf("WhereTo failed, %s", err)}))
 		// a shutdown can break the blocking loop caused due to InternalErr
@@ -674,7 +760,7 @@ func (isdf *InterStepDataForward) whereToStep(writeMessage *isb.WriteMessage, me
 		if _, ok := messageToStep[t.ToVertexName]; !ok {
 			isdf.opts.logger.Errorw("failed in whereToStep", zap.Error(isb.MessageWriteErr{Name: isdf.fromBufferPartition.GetName(), Header: readMessage.Header, Body: readMessage.Body, Message: fmt.Sprintf("no such destination (%s)", t.ToVertexName)}))
 		}
-		messageToStep[t.ToVertexName][t.ToVertexPartitionIdx] = append(messageToStep[t.ToVertexName][t.ToVertexPartitionIdx], writeMessage.Message)
+		messageToStep[t.ToVertexName][t.ToVertexPartitionIdx] = append(messageToStep[t.ToVertexName][t.ToVertexPartitionIdx], forwarder.WithPriorityLane(writeMessage.Message, t.PriorityLane))
 	}
 	return nil
 }
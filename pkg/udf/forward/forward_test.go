@@ -87,7 +87,7 @@ func (f myForwardTest) ApplyBatchMap(ctx context.Context, messages []*isb.ReadMe
 	return testutils.CopyUDFTestApplyBatchMap(ctx, "test-vertex", messages)
 }
 
-func (f myForwardTest) WhereTo(_ []string, _ []string, _ string) ([]forwarder.VertexBuffer, error) {
+func (f myForwardTest) WhereTo(_ []string, _ []string, _ string, _ *isb.Message) ([]forwarder.VertexBuffer, error) {
 	return []forwarder.VertexBuffer{{
 		ToVertexName:         "to1",
 		ToVertexPartitionIdx: 0,
@@ -1325,7 +1325,7 @@ func TestNewInterStepDataForwardIdleWatermark_Reset(t *testing.T) {
 type mySourceForwardTest struct {
 }
 
-func (f mySourceForwardTest) WhereTo(_ []string, _ []string, _ string) ([]forwarder.VertexBuffer, error) {
+func (f mySourceForwardTest) WhereTo(_ []string, _ []string, _ string, _ *isb.Message) ([]forwarder.VertexBuffer, error) {
 	return []forwarder.VertexBuffer{{
 		ToVertexName:         "to1",
 		ToVertexPartitionIdx: 0,
@@ -1336,7 +1336,7 @@ type mySourceForwardTestRoundRobin struct {
 	count int
 }
 
-func (f *mySourceForwardTestRoundRobin) WhereTo(_ []string, _ []string, _ string) ([]forwarder.VertexBuffer, error) {
+func (f *mySourceForwardTestRoundRobin) WhereTo(_ []string, _ []string, _ string, _ *isb.Message) ([]forwarder.VertexBuffer, error) {
 	var output = []forwarder.VertexBuffer{{
 		ToVertexName:         "to1",
 		ToVertexPartitionIdx: int32(f.count % 2),
@@ -1576,6 +1576,111 @@ func TestInterStepDataForwardMultiplePartition(t *testing.T) {
 }
 
 // TestWriteToBuffer tests two BufferFullWritingStrategies: 1. discarding the latest message and 2. retrying writing until context is cancelled.
+func TestInterStepDataForwardDeadLetter(t *testing.T) {
+	batchSize := int64(5)
+	fromStep := simplebuffer.NewInMemoryBuffer("from", 5*batchSize, 0)
+	to1 := simplebuffer.NewInMemoryBuffer("to1", 2*batchSize, 0)
+	dlq := simplebuffer.NewInMemoryBuffer("dlq", 2*batchSize, 0)
+	toSteps := map[string][]isb.BufferWriter{
+		"to1": {to1},
+	}
+	vertexInstance := &dfv1.VertexInstance{
+		Vertex: &dfv1.Vertex{Spec: dfv1.VertexSpec{
+			PipelineName:   "testPipeline",
+			AbstractVertex: dfv1.AbstractVertex{Name: "testVertex"},
+		}},
+		Replica: 0,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	writeMessages := testutils.BuildTestWriteMessages(batchSize, testStartTime, nil, "testVertex")
+	fetchWatermark, publishWatermark := generic.BuildNoOpWatermarkProgressorsFromBufferMap(toSteps)
+	idleManager, _ := wmb.NewIdleManager(1, len(toSteps))
+
+	f, err := NewInterStepDataForward(vertexInstance, fromStep, toSteps, myForwardApplyUDFErrTest{}, fetchWatermark, publishWatermark, idleManager,
+		WithReadBatchSize(batchSize), WithUDFMap(myForwardApplyUDFErrTest{}), WithDeadLetter(dlq, 2, time.Millisecond))
+	assert.NoError(t, err)
+
+	stopped := f.Start()
+	_, errs := fromStep.Write(ctx, writeMessages)
+	assert.Equal(t, make([]error, batchSize), errs)
+
+	// the failed messages should be written to the dead-letter buffer, and acked in the from buffer.
+	for !fromStep.IsEmpty() {
+		select {
+		case <-ctx.Done():
+			t.Fatal("expected the messages to be dead-lettered", ctx.Err())
+		default:
+			time.Sleep(1 * time.Millisecond)
+		}
+	}
+	assert.True(t, to1.IsEmpty())
+	readMessages, err := dlq.Read(ctx, batchSize)
+	assert.NoError(t, err)
+	assert.Len(t, readMessages, int(batchSize))
+	for i, m := range readMessages {
+		assert.Equal(t, writeMessages[i].Payload, m.Payload)
+		assert.Equal(t, "UDF error", m.Headers[dfv1.KeyMetaDeadLetterError])
+		assert.Equal(t, "testVertex", m.Headers[dfv1.KeyMetaDeadLetterVertex])
+		assert.Equal(t, "2", m.Headers[dfv1.KeyMetaDeadLetterRetries])
+	}
+
+	f.Stop()
+	<-stopped
+}
+
+func TestInterStepDataForwardDeadLetterPartialFailure(t *testing.T) {
+	batchSize := int64(5)
+	fromStep := simplebuffer.NewInMemoryBuffer("from", 5*batchSize, 0)
+	to1 := simplebuffer.NewInMemoryBuffer("to1", 2*batchSize, 0)
+	dlq := simplebuffer.NewInMemoryBuffer("dlq", 2*batchSize, 0)
+	toSteps := map[string][]isb.BufferWriter{
+		"to1": {to1},
+	}
+	vertexInstance := &dfv1.VertexInstance{
+		Vertex: &dfv1.Vertex{Spec: dfv1.VertexSpec{
+			PipelineName:   "testPipeline",
+			AbstractVertex: dfv1.AbstractVertex{Name: "testVertex"},
+		}},
+		Replica: 0,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	writeMessages := testutils.BuildTestWriteMessages(batchSize, testStartTime, nil, "testVertex")
+	fetchWatermark, publishWatermark := generic.BuildNoOpWatermarkProgressorsFromBufferMap(toSteps)
+	idleManager, _ := wmb.NewIdleManager(1, len(toSteps))
+
+	udf := myForwardPoisonTest{poison: string(writeMessages[2].Payload)}
+	f, err := NewInterStepDataForward(vertexInstance, fromStep, toSteps, udf, fetchWatermark, publishWatermark, idleManager,
+		WithReadBatchSize(batchSize), WithUDFMap(udf), WithDeadLetter(dlq, 2, time.Millisecond))
+	assert.NoError(t, err)
+
+	stopped := f.Start()
+	_, errs := fromStep.Write(ctx, writeMessages)
+	assert.Equal(t, make([]error, batchSize), errs)
+
+	// only the failing message is dead-lettered, the others are forwarded.
+	readMessages, err := dlq.Read(ctx, batchSize)
+	assert.NoError(t, err)
+	assert.Len(t, readMessages, 1)
+	assert.Equal(t, writeMessages[2].Payload, readMessages[0].Payload)
+	assert.Equal(t, "poison message", readMessages[0].Headers[dfv1.KeyMetaDeadLetterError])
+
+	forwarded, err := to1.Read(ctx, batchSize)
+	assert.NoError(t, err)
+	assert.Len(t, forwarded, int(batchSize)-1)
+	for _, m := range forwarded {
+		assert.NotEqual(t, writeMessages[2].Payload, m.Payload)
+	}
+
+	f.Stop()
+	<-stopped
+}
+
 func TestWriteToBuffer(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -1703,7 +1808,7 @@ func TestWriteToBuffer(t *testing.T) {
 type myForwardDropTest struct {
 }
 
-func (f myForwardDropTest) WhereTo(_ []string, _ []string, _ string) ([]forwarder.VertexBuffer, error) {
+func (f myForwardDropTest) WhereTo(_ []string, _ []string, _ string, _ *isb.Message) ([]forwarder.VertexBuffer, error) {
 	return []forwarder.VertexBuffer{}, nil
 }
 
@@ -1723,7 +1828,7 @@ type myForwardToAllTest struct {
 	count int
 }
 
-func (f *myForwardToAllTest) WhereTo(_ []string, _ []string, _ string) ([]forwarder.VertexBuffer, error) {
+func (f *myForwardToAllTest) WhereTo(_ []string, _ []string, _ string, _ *isb.Message) ([]forwarder.VertexBuffer, error) {
 	var output = []forwarder.VertexBuffer{{
 		ToVertexName:         "to1",
 		ToVertexPartitionIdx: int32(f.count % 2),
@@ -1751,7 +1856,7 @@ func (f *myForwardToAllTest) ApplyBatchMap(ctx context.Context, messages []*isb.
 type myForwardInternalErrTest struct {
 }
 
-func (f myForwardInternalErrTest) WhereTo(_ []string, _ []string, _ string) ([]forwarder.VertexBuffer, error) {
+func (f myForwardInternalErrTest) WhereTo(_ []string, _ []string, _ string, _ *isb.Message) ([]forwarder.VertexBuffer, error) {
 	return []forwarder.VertexBuffer{{
 		ToVertexName:         "to1",
 		ToVertexPartitionIdx: 0,
@@ -1795,7 +1900,7 @@ func (f myForwardInternalErrTest) ApplyMapStream(_ context.Context, _ *isb.ReadM
 type myForwardApplyWhereToErrTest struct {
 }
 
-func (f myForwardApplyWhereToErrTest) WhereTo(_ []string, _ []string, _ string) ([]forwarder.VertexBuffer, error) {
+func (f myForwardApplyWhereToErrTest) WhereTo(_ []string, _ []string, _ string, _ *isb.Message) ([]forwarder.VertexBuffer, error) {
 	return []forwarder.VertexBuffer{{
 		ToVertexName:         "to1",
 		ToVertexPartitionIdx: 0,
@@ -1817,7 +1922,7 @@ func (f myForwardApplyWhereToErrTest) ApplyBatchMap(ctx context.Context, message
 type myForwardApplyUDFErrTest struct {
 }
 
-func (f myForwardApplyUDFErrTest) WhereTo(_ []string, _ []string, _ string) ([]forwarder.VertexBuffer, error) {
+func (f myForwardApplyUDFErrTest) WhereTo(_ []string, _ []string, _ string, _ *isb.Message) ([]forwarder.VertexBuffer, error) {
 	return []forwarder.VertexBuffer{{
 		ToVertexName:         "to1",
 		ToVertexPartitionIdx: 0,
@@ -1837,6 +1942,21 @@ func (f myForwardApplyUDFErrTest) ApplyBatchMap(context.Context, []*isb.ReadMess
 	return nil, fmt.Errorf("UDF error")
 }
 
+// myForwardPoisonTest fails the batches containing the poison payload.
+type myForwardPoisonTest struct {
+	myForwardTest
+	poison string
+}
+
+func (f myForwardPoisonTest) ApplyMap(ctx context.Context, messages []*isb.ReadMessage) ([]isb.ReadWriteMessagePair, error) {
+	for _, m := range messages {
+		if string(m.Payload) == f.poison {
+			return nil, fmt.Errorf("poison message")
+		}
+	}
+	return testutils.CopyUDFTestApply(ctx, "", messages)
+}
+
 func validateMetrics(t *testing.T, batchSize int64) {
 	metadata := `
 		# HELP forwarder_data_read_total Total number of Data Messages Read
@@ -1916,3 +2036,27 @@ func buildPublisherMapAndOTStoreFromWmStores(toBuffers map[string][]isb.BufferWr
 	}
 	return publishers, otStores
 }
+
+func TestMergeWriteOffsets(t *testing.T) {
+	offset := func(o string) isb.Offset {
+		return isb.SimpleStringOffset(func() string { return o })
+	}
+	// the offsets of a failed attempt are kept when the retry writes to the other partitions.
+	writeOffsets := mergeWriteOffsets(nil, map[string][][]isb.Offset{"to1": {{offset("1")}, nil}})
+	writeOffsets = mergeWriteOffsets(writeOffsets, map[string][][]isb.Offset{"to1": {nil, {offset("2")}}, "to2": {{offset("3")}}})
+	writeOffsets = mergeWriteOffsets(writeOffsets, map[string][][]isb.Offset{"to1": {{offset("4")}, nil}})
+	assert.Equal(t, map[string][][]string{"to1": {{"1", "4"}, {"2"}}, "to2": {{"3"}}}, offsetStrings(writeOffsets))
+}
+
+func offsetStrings(writeOffsets map[string][][]isb.Offset) map[string][][]string {
+	result := make(map[string][][]string, len(writeOffsets))
+	for toVertexName, toVertexBufferOffsets := range writeOffsets {
+		result[toVertexName] = make([][]string, len(toVertexBufferOffsets))
+		for index, offsets := range toVertexBufferOffsets {
+			for _, o := range offsets {
+				result[toVertexName][index] = append(result[toVertexName][index], o.String())
+			}
+		}
+	}
+	return result
+}
@@ -23,6 +23,7 @@ import (
 	"go.uber.org/zap"
 
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
 	"github.com/numaproj/numaflow/pkg/shared/callback"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
 	"github.com/numaproj/numaflow/pkg/udf/forward/applier"
@@ -44,6 +45,12 @@ type options struct {
 	unaryMapUdfApplier applier.MapApplier
 	// streamMapUdfApplier is the UDF applier for a server streaming map mode
 	streamMapUdfApplier applier.MapStreamApplier
+	// deadLetterWriter is the writer of the dead-letter buffer, dead-lettering is disabled if it's nil
+	deadLetterWriter isb.BufferWriter
+	// deadLetterMaxRetries is the number of retries of a failed batch before the messages are dead-lettered
+	deadLetterMaxRetries int
+	// deadLetterRetryInterval is the interval between two retries of a failed batch
+	deadLetterRetryInterval time.Duration
 }
 
 type Option func(*options) error
@@ -91,6 +98,17 @@ func WithLogger(l *zap.SugaredLogger) Option {
 	}
 }
 
+// WithDeadLetter enables writing the messages to the dead-letter buffer after the UDF keeps failing
+// for maxRetries retries, instead of failing the forwarder.
+func WithDeadLetter(writer isb.BufferWriter, maxRetries int, retryInterval time.Duration) Option {
+	return func(o *options) error {
+		o.deadLetterWriter = writer
+		o.deadLetterMaxRetries = maxRetries
+		o.deadLetterRetryInterval = retryInterval
+		return nil
+	}
+}
+
 // WithCallbackUploader sets the callback uploader for the vertex
 func WithCallbackUploader(cp *callback.Uploader) Option {
 	return func(o *options) error {
@@ -30,12 +30,15 @@ import (
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/numaproj/numaflow/pkg/forwarder"
 	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/isb/claimcheck"
+	"github.com/numaproj/numaflow/pkg/isbsvc"
 	"github.com/numaproj/numaflow/pkg/metrics"
 	"github.com/numaproj/numaflow/pkg/sdkclient"
 	"github.com/numaproj/numaflow/pkg/sdkclient/mapper"
 	"github.com/numaproj/numaflow/pkg/sdkclient/serverinfo"
 	"github.com/numaproj/numaflow/pkg/shared/callback"
 	jsclient "github.com/numaproj/numaflow/pkg/shared/clients/nats"
+	redisclient "github.com/numaproj/numaflow/pkg/shared/clients/redis"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
 	sharedutil "github.com/numaproj/numaflow/pkg/shared/util"
 	"github.com/numaproj/numaflow/pkg/shuffle"
@@ -43,6 +46,7 @@ import (
 	"github.com/numaproj/numaflow/pkg/udf/rpc"
 	"github.com/numaproj/numaflow/pkg/watermark/fetch"
 	"github.com/numaproj/numaflow/pkg/watermark/generic"
+	diskwm "github.com/numaproj/numaflow/pkg/watermark/generic/disk"
 	"github.com/numaproj/numaflow/pkg/watermark/generic/jetstream"
 	"github.com/numaproj/numaflow/pkg/watermark/store"
 	"github.com/numaproj/numaflow/pkg/watermark/wmb"
@@ -66,6 +70,7 @@ func (u *MapUDFProcessor) Start(ctx context.Context) error {
 	var (
 		readers            []isb.BufferReader
 		writers            map[string][]isb.BufferWriter
+		deadLetterWriter   isb.BufferWriter
 		fromVertexWmStores map[string]store.WatermarkStore
 		toVertexWmStores   map[string]store.WatermarkStore
 		mapHandler         *rpc.GRPCBasedMap
@@ -84,7 +89,15 @@ func (u *MapUDFProcessor) Start(ctx context.Context) error {
 	// create readers and writers
 	switch u.ISBSvcType {
 	case dfv1.ISBSvcTypeRedis:
-		readers, writers, err = buildRedisBufferIO(ctx, u.VertexInstance)
+		redisClient, err := redisclient.NewInClusterRedisClient()
+		if err != nil {
+			return fmt.Errorf("failed to create a redis client: %w", err)
+		}
+		readers, writers, err = buildRedisBufferIO(ctx, u.VertexInstance, redisClient)
+		if err != nil {
+			return err
+		}
+		deadLetterWriter, err = isbsvc.NewRedisDeadLetterWriter(ctx, u.VertexInstance.Vertex, redisClient)
 		if err != nil {
 			return err
 		}
@@ -102,6 +115,10 @@ func (u *MapUDFProcessor) Start(ctx context.Context) error {
 		if err != nil {
 			return err
 		}
+		deadLetterWriter, err = isbsvc.NewJetStreamDeadLetterWriter(ctx, u.VertexInstance.Vertex, natsClientPool.NextAvailableClient())
+		if err != nil {
+			return fmt.Errorf("failed to create the dead-letter buffer writer: %w", err)
+		}
 
 		// created watermark related components only if watermark is enabled
 		// otherwise no op will be used
@@ -125,13 +142,58 @@ func (u *MapUDFProcessor) Start(ctx context.Context) error {
 			// create watermark publisher using watermark stores
 			publishWatermark = jetstream.BuildPublishersFromStores(ctx, u.VertexInstance, toVertexWmStores)
 
+			idleManager, _ = wmb.NewIdleManager(len(writers), len(writers))
+		}
+	case dfv1.ISBSvcTypeDisk:
+		readers, writers, err = buildDiskBufferIO(ctx, u.VertexInstance)
+		if err != nil {
+			return err
+		}
+		deadLetterWriter, err = isbsvc.NewDiskDeadLetterWriter(ctx, u.VertexInstance.Vertex)
+		if err != nil {
+			return fmt.Errorf("failed to create the dead-letter buffer writer: %w", err)
+		}
+
+		// created watermark related components only if watermark is enabled
+		// otherwise no op will be used
+		if !u.VertexInstance.Vertex.Spec.Watermark.Disabled {
+			// create from vertex watermark stores
+			fromVertexWmStores, err = diskwm.BuildFromVertexWatermarkStores(ctx, u.VertexInstance, dfv1.PathISBSvcDiskMount)
+			if err != nil {
+				return fmt.Errorf("failed to build watermark stores: %w", err)
+			}
+
+			// create watermark fetcher using watermark stores
+			fetchWatermark = fetch.NewEdgeFetcherSet(ctx, u.VertexInstance, fromVertexWmStores, fetch.WithVertexReplica(u.VertexInstance.Replica),
+				fetch.WithIsReduce(u.VertexInstance.Vertex.IsReduceUDF()), fetch.WithIsSource(u.VertexInstance.Vertex.IsASource()))
+
+			// create to vertex watermark stores
+			toVertexWmStores, err = diskwm.BuildToVertexWatermarkStores(ctx, u.VertexInstance, dfv1.PathISBSvcDiskMount)
+			if err != nil {
+				return err
+			}
+
+			// create watermark publisher using watermark stores
+			publishWatermark = jetstream.BuildPublishersFromStores(ctx, u.VertexInstance, toVertexWmStores)
+
 			idleManager, _ = wmb.NewIdleManager(len(writers), len(writers))
 		}
 	default:
 		return fmt.Errorf("unrecognized isbsvc type %q", u.ISBSvcType)
 	}
 
+	// store the oversized payloads out of the buffers if the claim check is configured
+	if err := claimcheck.Wrap(ctx, u.VertexInstance.Vertex, readers, writers, &deadLetterWriter); err != nil {
+		return err
+	}
+
 	opts := []forward.Option{forward.WithLogger(log)}
+	if deadLetterWriter != nil {
+		dl := u.VertexInstance.Vertex.Spec.DeadLetter
+		opts = append(opts, forward.WithDeadLetter(deadLetterWriter, dl.GetMaxRetries(), dl.GetRetryInterval()))
+		// the dead-letter writer is shared by the forwarders of all the partitions
+		defer func() { _ = deadLetterWriter.Close() }()
+	}
 	enableMapUdfStream := false
 	maxMessageSize := sharedutil.LookupEnvIntOr(dfv1.EnvGRPCMaxMessageSize, sdkclient.DefaultGRPCMaxMessageSize)
 
@@ -221,7 +283,7 @@ func (u *MapUDFProcessor) Start(ctx context.Context) error {
 		}
 
 		// create a conditional forwarder for each partition
-		conditionalForwarder := forwarder.GoWhere(func(keys []string, tags []string, msgId string) ([]forwarder.VertexBuffer, error) {
+		conditionalForwarder := forwarder.GoWhere(func(keys []string, tags []string, msgId string, msg *isb.Message) ([]forwarder.VertexBuffer, error) {
 			var result []forwarder.VertexBuffer
 
 			// Drop message if it contains the special tag
@@ -238,8 +300,8 @@ func (u *MapUDFProcessor) Start(ctx context.Context) error {
 
 			// Iterate through the edges
 			for _, edge := range u.VertexInstance.Vertex.Spec.ToEdges {
-				// Condition to proceed for forwarding message: No conditions on edge, or message tags match edge conditions
-				proceed := edge.Conditions == nil || edge.Conditions.Tags == nil || len(edge.Conditions.Tags.Values) == 0 || sharedutil.CompareSlice(edge.Conditions.Tags.GetOperator(), tags, edge.Conditions.Tags.Values)
+				// Condition to proceed for forwarding message: No conditions on edge, or message matches edge conditions
+				proceed := forwarder.MatchConditions(edge.Conditions, tags, msg)
 
 				if proceed {
 					// if the edge has more than one partition, shuffle the message
@@ -257,6 +319,7 @@ func (u *MapUDFProcessor) Start(ctx context.Context) error {
 					result = append(result, forwarder.VertexBuffer{
 						ToVertexName:         edge.To,
 						ToVertexPartitionIdx: partitionIdx,
+						PriorityLane:         forwarder.PriorityLane(edge.Priority, tags, msg),
 					})
 				}
 			}
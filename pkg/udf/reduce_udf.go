@@ -19,19 +19,23 @@ package udf
 import (
 	"context"
 	"fmt"
+	"path"
 	"slices"
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"go.uber.org/zap"
 
 	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
 	"github.com/numaproj/numaflow/pkg/forwarder"
 	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/isb/claimcheck"
 	"github.com/numaproj/numaflow/pkg/metrics"
 	"github.com/numaproj/numaflow/pkg/reduce"
 	"github.com/numaproj/numaflow/pkg/reduce/applier"
+	builtinreduce "github.com/numaproj/numaflow/pkg/reduce/applier/builtin"
 	"github.com/numaproj/numaflow/pkg/reduce/pbq"
 	alignedfs "github.com/numaproj/numaflow/pkg/reduce/pbq/wal/aligned/fs"
 	noopwal "github.com/numaproj/numaflow/pkg/reduce/pbq/wal/noop"
@@ -44,18 +48,22 @@ import (
 	"github.com/numaproj/numaflow/pkg/sdkclient/serverinfo"
 	"github.com/numaproj/numaflow/pkg/sdkclient/sessionreducer"
 	jsclient "github.com/numaproj/numaflow/pkg/shared/clients/nats"
+	redisclient "github.com/numaproj/numaflow/pkg/shared/clients/redis"
 	"github.com/numaproj/numaflow/pkg/shared/logging"
 	sharedutil "github.com/numaproj/numaflow/pkg/shared/util"
 	"github.com/numaproj/numaflow/pkg/shuffle"
 	"github.com/numaproj/numaflow/pkg/udf/rpc"
 	"github.com/numaproj/numaflow/pkg/watermark/fetch"
 	"github.com/numaproj/numaflow/pkg/watermark/generic"
+	diskwm "github.com/numaproj/numaflow/pkg/watermark/generic/disk"
 	"github.com/numaproj/numaflow/pkg/watermark/generic/jetstream"
 	"github.com/numaproj/numaflow/pkg/watermark/store"
 	"github.com/numaproj/numaflow/pkg/watermark/wmb"
 	"github.com/numaproj/numaflow/pkg/window"
 	"github.com/numaproj/numaflow/pkg/window/strategy/accumulate"
+	"github.com/numaproj/numaflow/pkg/window/strategy/count"
 	"github.com/numaproj/numaflow/pkg/window/strategy/fixed"
+	"github.com/numaproj/numaflow/pkg/window/strategy/global"
 	"github.com/numaproj/numaflow/pkg/window/strategy/session"
 	"github.com/numaproj/numaflow/pkg/window/strategy/sliding"
 )
@@ -95,7 +103,19 @@ func (u *ReduceUDFProcessor) Start(ctx context.Context) error {
 	maxMessageSize := sharedutil.LookupEnvIntOr(dfv1.EnvGRPCMaxMessageSize, sdkclient.DefaultGRPCMaxMessageSize)
 
 	// create udf handler and wait until it is ready
-	if windowType.Fixed != nil || windowType.Sliding != nil {
+	if fn := u.VertexInstance.Vertex.Spec.UDF.Builtin; fn != nil {
+		// builtin reduce functions run in-process, there's no udf container to wait for
+		if windowType.Fixed == nil && windowType.Sliding == nil {
+			return fmt.Errorf("builtin reduce function %q only supports fixed and sliding windows", fn.Name)
+		}
+		reduceHandler, err := builtinreduce.NewAlignedReduce(vertexName, vertexReplica, fn)
+		if err != nil {
+			return fmt.Errorf("failed to create builtin reduce function %q, %w", fn.Name, err)
+		}
+
+		udfApplier = reduceHandler
+		healthChecker = reduceHandler
+	} else if windowType.Fixed != nil || windowType.Sliding != nil {
 		var serverInfo *serverinfo.ServerInfo
 		var client reducer.Client
 		// if streaming is enabled, use the reduceStreaming address
@@ -134,7 +154,8 @@ func (u *ReduceUDFProcessor) Start(ctx context.Context) error {
 
 		udfApplier = reduceHandler
 		healthChecker = reduceHandler
-	} else if windowType.Session != nil {
+	} else if windowType.Session != nil || windowType.Count != nil || windowType.Global != nil {
+		// count and global windows are unaligned windows processed by the session reducer
 		// Wait for server info to be ready
 		serverInfo, err := serverinfo.SDKServerInfo(serverinfo.WithServerInfoFilePath(sdkclient.SessionReduceServerInfoFile))
 		if err != nil {
@@ -191,6 +212,13 @@ func (u *ReduceUDFProcessor) Start(ctx context.Context) error {
 		windower = session.NewWindower(windowType.Session.Timeout.Duration, u.VertexInstance)
 	} else if windowType.Accumulator != nil {
 		windower = accumulate.NewWindower(u.VertexInstance, windowType.Accumulator.Timeout.Duration)
+	} else if windowType.Count != nil {
+		windower = count.NewWindower(windowType.Count.Count, windowType.Count.Timeout.Duration, u.VertexInstance)
+	} else if windowType.Global != nil {
+		windower, err = global.NewWindower(windowType.Global.Trigger, u.VertexInstance)
+		if err != nil {
+			return fmt.Errorf("failed to create global windower, %w", err)
+		}
 	} else {
 		return fmt.Errorf("invalid window spec")
 	}
@@ -212,7 +240,11 @@ func (u *ReduceUDFProcessor) Start(ctx context.Context) error {
 	idleManager = wmb.NewNoOpIdleManager()
 	switch u.ISBSvcType {
 	case dfv1.ISBSvcTypeRedis:
-		readers, writers, err = buildRedisBufferIO(ctx, u.VertexInstance)
+		redisClient, err := redisclient.NewInClusterRedisClient()
+		if err != nil {
+			return fmt.Errorf("failed to create a redis client: %w", err)
+		}
+		readers, writers, err = buildRedisBufferIO(ctx, u.VertexInstance, redisClient)
 		if err != nil {
 			return err
 		}
@@ -251,12 +283,47 @@ func (u *ReduceUDFProcessor) Start(ctx context.Context) error {
 			// create watermark publisher using watermark stores
 			publishWatermark = jetstream.BuildPublishersFromStores(ctx, u.VertexInstance, toVertexWmStores)
 
+			idleManager, _ = wmb.NewIdleManager(1, len(writers))
+		}
+	case dfv1.ISBSvcTypeDisk:
+		readers, writers, err = buildDiskBufferIO(ctx, u.VertexInstance)
+		if err != nil {
+			return err
+		}
+
+		// created watermark related components only if watermark is enabled
+		// otherwise noop will used
+		if !u.VertexInstance.Vertex.Spec.Watermark.Disabled {
+			// create from vertex watermark stores
+			fromVertexWmStores, err = diskwm.BuildFromVertexWatermarkStores(ctx, u.VertexInstance, dfv1.PathISBSvcDiskMount)
+			if err != nil {
+				return fmt.Errorf("failed to build watermark stores: %w", err)
+			}
+
+			// create watermark fetcher using watermark stores
+			fetchWatermark = fetch.NewEdgeFetcherSet(ctx, u.VertexInstance, fromVertexWmStores, fetch.WithVertexReplica(u.VertexInstance.Replica),
+				fetch.WithIsReduce(u.VertexInstance.Vertex.IsReduceUDF()), fetch.WithIsSource(u.VertexInstance.Vertex.IsASource()))
+
+			// create to vertex watermark stores
+			toVertexWmStores, err = diskwm.BuildToVertexWatermarkStores(ctx, u.VertexInstance, dfv1.PathISBSvcDiskMount)
+			if err != nil {
+				return err
+			}
+
+			// create watermark publisher using watermark stores
+			publishWatermark = jetstream.BuildPublishersFromStores(ctx, u.VertexInstance, toVertexWmStores)
+
 			idleManager, _ = wmb.NewIdleManager(1, len(writers))
 		}
 	default:
 		return fmt.Errorf("unrecognized isbsvc type %q", u.ISBSvcType)
 	}
 
+	// store the oversized payloads out of the buffers if the claim check is configured
+	if err := claimcheck.Wrap(ctx, u.VertexInstance.Vertex, readers, writers, nil); err != nil {
+		return err
+	}
+
 	// Populate shuffle function map
 	shuffleFuncMap := make(map[string]*shuffle.Shuffle)
 	for _, edge := range u.VertexInstance.Vertex.Spec.ToEdges {
@@ -267,7 +334,7 @@ func (u *ReduceUDFProcessor) Start(ctx context.Context) error {
 	}
 
 	// create the conditional forwarder
-	conditionalForwarder := forwarder.GoWhere(func(keys []string, tags []string, msgId string) ([]forwarder.VertexBuffer, error) {
+	conditionalForwarder := forwarder.GoWhere(func(keys []string, tags []string, msgId string, msg *isb.Message) ([]forwarder.VertexBuffer, error) {
 		var result []forwarder.VertexBuffer
 
 		// Drop message if it contains the special tag
@@ -285,8 +352,8 @@ func (u *ReduceUDFProcessor) Start(ctx context.Context) error {
 		for _, edge := range u.VertexInstance.Vertex.Spec.ToEdges {
 			edgeKey := edge.From + ":" + edge.To
 
-			// Condition to proceed for forwarding message: No conditions on edge, or message tags match edge conditions
-			proceed := edge.Conditions == nil || edge.Conditions.Tags == nil || len(edge.Conditions.Tags.Values) == 0 || sharedutil.CompareSlice(edge.Conditions.Tags.GetOperator(), tags, edge.Conditions.Tags.Values)
+			// Condition to proceed for forwarding message: No conditions on edge, or message matches edge conditions
+			proceed := forwarder.MatchConditions(edge.Conditions, tags, msg)
 
 			if proceed {
 				// if the edge has more than one partition, shuffle the message
@@ -303,6 +370,7 @@ func (u *ReduceUDFProcessor) Start(ctx context.Context) error {
 				result = append(result, forwarder.VertexBuffer{
 					ToVertexName:         edge.To,
 					ToVertexPartitionIdx: partitionIdx,
+					PriorityLane:         forwarder.PriorityLane(edge.Priority, tags, msg),
 				})
 			}
 		}
@@ -359,11 +427,15 @@ func (u *ReduceUDFProcessor) Start(ctx context.Context) error {
 		opts = append(opts, reduce.WithAllowedLateness(allowedLateness.Duration))
 	}
 
+	if lateDataTag := u.VertexInstance.Vertex.Spec.UDF.GroupBy.LateDataTag; lateDataTag != "" {
+		opts = append(opts, reduce.WithLateDataTag(lateDataTag))
+	}
+
 	// create and start the compactor if the window type is unaligned
 	// the compactor will delete the persisted messages which belongs to the materialized window
 	// create a gc events tracker which tracks the gc events, will be used by the pnf
 	// to track the gc events and the compactor will delete the persisted messages based on the gc events
-	if windowType.Session != nil || windowType.Accumulator != nil {
+	if windower.Type() == window.Unaligned {
 		gcEventsTracker, err := unalignedfs.NewGCEventsWAL(ctx, pipelineName, vertexName, vertexReplica)
 		if err != nil {
 			return fmt.Errorf("failed to create gc events tracker, %w", err)
@@ -380,7 +452,11 @@ func (u *ReduceUDFProcessor) Start(ctx context.Context) error {
 
 		pnfOpts = append(pnfOpts, pnf.WithGCEventsTracker(gcEventsTracker), pnf.WithWindowType(window.Unaligned))
 
-		compactor, err := unalignedfs.NewCompactor(ctx, pipelineName, vertexName, vertexReplica, &window.SharedUnalignedPartition, dfv1.DefaultGCEventsWALEventsPath, dfv1.DefaultSegmentWALPath, dfv1.DefaultCompactWALPath)
+		var compactorOpts []unalignedfs.CompactorOption
+		if windowType.Count != nil || windowType.Global != nil {
+			compactorOpts = append(compactorOpts, unalignedfs.WithKeepUntrackedKeys())
+		}
+		compactor, err := unalignedfs.NewCompactor(ctx, pipelineName, vertexName, vertexReplica, &window.SharedUnalignedPartition, dfv1.DefaultGCEventsWALEventsPath, dfv1.DefaultSegmentWALPath, dfv1.DefaultCompactWALPath, compactorOpts...)
 		if err != nil {
 			return fmt.Errorf("failed to create compactor, %w", err)
 		}
@@ -397,10 +473,33 @@ func (u *ReduceUDFProcessor) Start(ctx context.Context) error {
 		}(compactor)
 	}
 
+	// global windows are closed when the vertex is shutting down because the pipeline is paused, which the pipeline
+	// controller tells through the pod annotations. The pnf has to outlive the SIGTERM so that the results of the
+	// closed windows are forwarded, it's cancelled once the pnf is shut down or when the flush takes too long.
+	pnfCtx := ctx
+	if _, ok := windower.(window.Flusher); ok {
+		var pnfCancel context.CancelFunc
+		pnfCtx, pnfCancel = context.WithCancel(context.WithoutCancel(ctx))
+		defer pnfCancel()
+		go func() {
+			<-ctx.Done()
+			select {
+			case <-pnfCtx.Done():
+			case <-time.After(dfv1.DefaultGlobalWindowFlushTimeout):
+				log.Warnw("Timed out flushing the windows on shutdown", zap.Duration("timeout", dfv1.DefaultGlobalWindowFlushTimeout))
+				pnfCancel()
+			}
+		}()
+		opts = append(opts, reduce.WithFlushOnShutdown(pnfCtx, func() bool {
+			pausing, _ := sharedutil.LookupPodAnnotation(path.Join(dfv1.PathPodInfoMount, "annotations"), dfv1.KeyPausing)
+			return pausing == "true"
+		}))
+	}
+
 	pnfShutdownCh := make(chan struct{})
 
 	// create the pnf
-	processAndForward := pnf.NewProcessAndForward(ctx, u.VertexInstance, udfApplier, writers, pbqManager, conditionalForwarder, publishWatermark, idleManager, windower, pnfShutdownCh, pnfOpts...)
+	processAndForward := pnf.NewProcessAndForward(pnfCtx, u.VertexInstance, udfApplier, writers, pbqManager, conditionalForwarder, publishWatermark, idleManager, windower, pnfShutdownCh, pnfOpts...)
 
 	// for reduce, we read only from one partition
 	dataForwarder, err := reduce.NewDataForward(ctx, u.VertexInstance, readers[0], writers, pbqManager, walManager, conditionalForwarder, fetchWatermark, publishWatermark, windower, idleManager, processAndForward, opts...)
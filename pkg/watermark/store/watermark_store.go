@@ -22,6 +22,7 @@ import (
 
 	jsclient "github.com/numaproj/numaflow/pkg/shared/clients/nats"
 	"github.com/numaproj/numaflow/pkg/shared/kvs"
+	diskkv "github.com/numaproj/numaflow/pkg/shared/kvs/disk"
 	"github.com/numaproj/numaflow/pkg/shared/kvs/inmem"
 	"github.com/numaproj/numaflow/pkg/shared/kvs/jetstream"
 	noopkv "github.com/numaproj/numaflow/pkg/shared/kvs/noop"
@@ -90,6 +91,26 @@ func BuildJetStreamWatermarkStore(ctx context.Context, bucket string, client *js
 	}, nil
 }
 
+// BuildDiskWatermarkStore returns a WatermarkStore instance backed up by the volume of the disk ISB Service,
+// the buckets are named the same as the JetStream ones.
+func BuildDiskWatermarkStore(ctx context.Context, bucket string, rootDir string) (WatermarkStore, error) {
+	hbKVName := JetStreamProcessorKVName(bucket)
+	hbStore, err := diskkv.NewKVDiskKVStore(ctx, rootDir, hbKVName)
+	if err != nil {
+		return nil, fmt.Errorf("failed at new disk HB KV store %q, %w", hbKVName, err)
+	}
+	otKVName := JetStreamOTKVName(bucket)
+	otStore, err := diskkv.NewKVDiskKVStore(ctx, rootDir, otKVName)
+	if err != nil {
+		hbStore.Close()
+		return nil, fmt.Errorf("failed at new disk OT KV store %q, %w", otKVName, err)
+	}
+	return &watermarkStore{
+		heartbeatStore:      hbStore,
+		offsetTimelineStore: otStore,
+	}, nil
+}
+
 func JetStreamProcessorKVName(bucketName string) string {
 	return fmt.Sprintf("%s_PROCESSORS", bucketName)
 }
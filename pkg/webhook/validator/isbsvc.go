@@ -72,6 +72,11 @@ func (v *isbsvcValidator) ValidateUpdate(_ context.Context) *admissionv1.Admissi
 		if oldRedisNative, newRedisNative := v.oldISBService.Spec.Redis.Native, v.newISBService.Spec.Redis.Native; oldRedisNative != nil && newRedisNative != nil && !equality.Semantic.DeepEqual(oldRedisNative.Persistence, newRedisNative.Persistence) {
 			return DeniedResponse("can not change persistence of Redis ISB Service")
 		}
+	case dfv1.ISBSvcTypeDisk:
+		// check the storage of ISB Service is not changed
+		if !equality.Semantic.DeepEqual(v.oldISBService.Spec.Disk.DiskStorage, v.newISBService.Spec.Disk.DiskStorage) {
+			return DeniedResponse("can not change storage of Disk ISB Service")
+		}
 	default:
 	}
 	return AllowedResponse()
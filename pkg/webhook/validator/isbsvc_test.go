@@ -155,6 +155,20 @@ func TestValidateISBServiceUpdate(t *testing.T) {
 					Redis: &dfv1.RedisBufferService{Native: nil},
 				},
 			}, want: false},
+		{name: "changing ISB Service type is not allowed - disk to jetstream", old: fakeDiskISBSvc(), new: fakeJetStreamISBSvc(), want: false},
+		{name: "changing segment size is allowed - disk", old: fakeDiskISBSvc(),
+			new: func() *dfv1.InterStepBufferService {
+				isbs := fakeDiskISBSvc()
+				q := apiresource.MustParse("16Mi")
+				isbs.Spec.Disk.MaxSegmentSize = &q
+				return isbs
+			}(), want: true},
+		{name: "changing storage is not allowed - disk", old: fakeDiskISBSvc(),
+			new: func() *dfv1.InterStepBufferService {
+				isbs := fakeDiskISBSvc()
+				isbs.Spec.Disk.HostPath.Path = "/mnt/other"
+				return isbs
+			}(), want: false},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
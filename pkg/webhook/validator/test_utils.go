@@ -53,6 +53,22 @@ func fakeJetStreamISBSvc() *dfv1.InterStepBufferService {
 	}
 }
 
+func fakeDiskISBSvc() *dfv1.InterStepBufferService {
+	return &dfv1.InterStepBufferService{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNamespace,
+			Name:      dfv1.DefaultISBSvcName,
+		},
+		Spec: dfv1.InterStepBufferServiceSpec{
+			Disk: &dfv1.DiskBufferService{
+				DiskStorage: dfv1.DiskStorage{
+					HostPath: &corev1.HostPathVolumeSource{Path: "/mnt/isbsvc"},
+				},
+			},
+		},
+	}
+}
+
 func fakePipeline() *dfv1.Pipeline {
 	return &dfv1.Pipeline{
 		ObjectMeta: metav1.ObjectMeta{
@@ -0,0 +1,80 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package count implements count windows. A count window is tracked per key, and it is closed once it has
+// received the configured number of messages, or once the watermark has passed the start of the window by
+// the configured timeout, whichever happens first.
+package count
+
+import (
+	"time"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/window"
+	"github.com/numaproj/numaflow/pkg/window/strategy/keyed"
+)
+
+// Windower is an implementation of TimedWindower for count windows, there is at most one active count window
+// per key.
+type Windower struct {
+	*keyed.Windows
+
+	// count is the number of messages after which the window of a key is closed.
+	count uint32
+	// timeout is the duration since the start of a window after which it is closed, even if it has not
+	// received count messages.
+	timeout time.Duration
+}
+
+// NewWindower returns a new count Windower.
+func NewWindower(count uint32, timeout time.Duration, vertexInstance *dfv1.VertexInstance) window.TimedWindower {
+	return &Windower{
+		Windows: keyed.NewWindows(vertexInstance),
+		count:   count,
+		timeout: timeout,
+	}
+}
+
+var _ window.TimedWindower = (*Windower)(nil)
+
+// Strategy returns the window strategy.
+func (w *Windower) Strategy() window.Strategy {
+	return window.Count
+}
+
+// Type returns the window type. Count windows are unaligned since the bounds of the windows are per key.
+func (w *Windower) Type() window.Type {
+	return window.Unaligned
+}
+
+// AssignWindows assigns the message to the active window of its key, and closes the window once the message is
+// the count-th message of the window.
+func (w *Windower) AssignWindows(message *isb.ReadMessage) []*window.TimedWindowRequest {
+	return w.Assign(message, func(aw *keyed.ActiveWindow) bool {
+		return aw.Count >= w.count
+	})
+}
+
+// CloseWindows closes the windows which have timed out, i.e., the watermark has passed the start of the window
+// by the timeout.
+func (w *Windower) CloseWindows(currentTime time.Time) []*window.TimedWindowRequest {
+	requests := w.Close(func(aw *keyed.ActiveWindow) bool {
+		return !currentTime.Before(aw.Window.StartTime().Add(w.timeout))
+	})
+	w.Advance(currentTime)
+	return requests
+}
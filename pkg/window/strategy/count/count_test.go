@@ -0,0 +1,130 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package count
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/window"
+)
+
+var keyedVertex = &dfv1.VertexInstance{
+	Vertex: &dfv1.Vertex{Spec: dfv1.VertexSpec{
+		PipelineName: "test-pl",
+		AbstractVertex: dfv1.AbstractVertex{
+			Name: "testVertex",
+			UDF:  &dfv1.UDF{GroupBy: &dfv1.GroupBy{Keyed: true}},
+		},
+	}},
+	Hostname: "test-host",
+	Replica:  0,
+}
+
+func buildReadMessage(eventTime time.Time, keys []string) *isb.ReadMessage {
+	return &isb.ReadMessage{
+		Message: isb.Message{
+			Header: isb.Header{
+				MessageInfo: isb.MessageInfo{
+					EventTime: eventTime,
+				},
+				Keys: keys,
+			},
+		},
+	}
+}
+
+func TestWindower_AssignWindows(t *testing.T) {
+	baseTime := time.UnixMilli(60000)
+	windower := NewWindower(3, time.Minute, keyedVertex)
+	assert.Equal(t, window.Count, windower.Strategy())
+	assert.Equal(t, window.Unaligned, windower.Type())
+
+	requests := windower.AssignWindows(buildReadMessage(baseTime, []string{"key-1"}))
+	assert.Len(t, requests, 1)
+	assert.Equal(t, window.Open, requests[0].Operation)
+	assert.Equal(t, baseTime, requests[0].Windows[0].StartTime())
+	assert.Equal(t, baseTime.Add(time.Millisecond), requests[0].Windows[0].EndTime())
+
+	// an older event time is appended to the window
+	requests = windower.AssignWindows(buildReadMessage(baseTime.Add(-time.Second), []string{"key-1"}))
+	assert.Len(t, requests, 1)
+	assert.Equal(t, window.Append, requests[0].Operation)
+
+	// a different key gets its own window
+	requests = windower.AssignWindows(buildReadMessage(baseTime, []string{"key-2"}))
+	assert.Len(t, requests, 1)
+	assert.Equal(t, window.Open, requests[0].Operation)
+
+	// the third message of the key expands the window and closes it
+	requests = windower.AssignWindows(buildReadMessage(baseTime.Add(time.Second), []string{"key-1"}))
+	assert.Len(t, requests, 2)
+	assert.Equal(t, window.Expand, requests[0].Operation)
+	assert.Len(t, requests[0].Windows, 2)
+	assert.Equal(t, baseTime.Add(time.Millisecond), requests[0].Windows[0].EndTime())
+	assert.Equal(t, baseTime.Add(time.Second+time.Millisecond), requests[0].Windows[1].EndTime())
+	assert.Equal(t, window.Close, requests[1].Operation)
+	assert.Nil(t, requests[1].ReadMessage)
+	assert.Equal(t, requests[0].Windows[1].ID(), requests[1].Windows[0].ID())
+
+	// the oldest window is the active window of key-2
+	assert.Equal(t, baseTime.Add(time.Millisecond), windower.OldestWindowEndTime())
+
+	// a message of the key before the end of the closed window opens a new window starting at that end
+	requests = windower.AssignWindows(buildReadMessage(baseTime, []string{"key-1"}))
+	assert.Len(t, requests, 1)
+	assert.Equal(t, window.Open, requests[0].Operation)
+	assert.Equal(t, baseTime.Add(time.Second+time.Millisecond), requests[0].Windows[0].StartTime())
+	assert.Equal(t, baseTime.Add(time.Second+2*time.Millisecond), requests[0].Windows[0].EndTime())
+
+	// a later message expands it
+	requests = windower.AssignWindows(buildReadMessage(baseTime.Add(2*time.Second), []string{"key-1"}))
+	assert.Len(t, requests, 1)
+	assert.Equal(t, window.Expand, requests[0].Operation)
+	assert.Equal(t, baseTime.Add(time.Second+time.Millisecond), requests[0].Windows[1].StartTime())
+	assert.Equal(t, baseTime.Add(2*time.Second+time.Millisecond), requests[0].Windows[1].EndTime())
+}
+
+func TestWindower_CloseWindows(t *testing.T) {
+	baseTime := time.UnixMilli(60000)
+	windower := NewWindower(10, time.Minute, keyedVertex)
+	assert.Equal(t, time.UnixMilli(-1), windower.OldestWindowEndTime())
+
+	windower.AssignWindows(buildReadMessage(baseTime, []string{"key-1"}))
+	windower.AssignWindows(buildReadMessage(baseTime.Add(30*time.Second), []string{"key-2"}))
+
+	// nothing has timed out yet
+	assert.Empty(t, windower.CloseWindows(baseTime.Add(59*time.Second)))
+
+	requests := windower.CloseWindows(baseTime.Add(time.Minute))
+	assert.Len(t, requests, 1)
+	assert.Equal(t, window.Close, requests[0].Operation)
+	assert.Equal(t, []string{"key-1"}, requests[0].Windows[0].Keys())
+
+	// the closed window is tracked until it's deleted
+	assert.Equal(t, baseTime.Add(time.Millisecond), windower.OldestWindowEndTime())
+	windower.DeleteClosedWindow(window.NewUnalignedTimedWindow(baseTime, baseTime.Add(time.Millisecond), "slot-0", []string{"key-1"}))
+	assert.Equal(t, baseTime.Add(30*time.Second+time.Millisecond), windower.OldestWindowEndTime())
+
+	requests = windower.CloseWindows(baseTime.Add(90 * time.Second))
+	assert.Len(t, requests, 1)
+	assert.Equal(t, []string{"key-2"}, requests[0].Windows[0].Keys())
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package global implements global windows. A global window is tracked per key and it is never closed by the
+// watermark, it is closed for a key when a message of the key matches the trigger expression, and for all the
+// keys when the vertex is shutting down (e.g., the pipeline is paused).
+package global
+
+import (
+	"fmt"
+	"time"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/shared/expr"
+	"github.com/numaproj/numaflow/pkg/window"
+	"github.com/numaproj/numaflow/pkg/window/strategy/keyed"
+)
+
+// Windower is an implementation of TimedWindower for global windows, there is at most one active global window
+// per key.
+type Windower struct {
+	*keyed.Windows
+
+	// trigger is the expression which closes the window of the message's key when it evaluates to true,
+	// the windows are only closed when flushed if it's empty.
+	trigger string
+}
+
+// NewWindower returns a new global Windower, it returns an error if the trigger expression is invalid.
+func NewWindower(trigger string, vertexInstance *dfv1.VertexInstance) (window.TimedWindower, error) {
+	if trigger != "" {
+		if _, err := expr.CompileMessageBool(trigger); err != nil {
+			return nil, fmt.Errorf("invalid trigger, %w", err)
+		}
+	}
+	return &Windower{
+		Windows: keyed.NewWindows(vertexInstance),
+		trigger: trigger,
+	}, nil
+}
+
+var (
+	_ window.TimedWindower = (*Windower)(nil)
+	_ window.Flusher       = (*Windower)(nil)
+)
+
+// Strategy returns the window strategy.
+func (w *Windower) Strategy() window.Strategy {
+	return window.Global
+}
+
+// Type returns the window type. Global windows are unaligned since the bounds of the windows are per key.
+func (w *Windower) Type() window.Type {
+	return window.Unaligned
+}
+
+// AssignWindows assigns the message to the active window of its key, and closes the window if the message matches
+// the trigger. A message for which the trigger can't be evaluated doesn't close the window.
+func (w *Windower) AssignWindows(message *isb.ReadMessage) []*window.TimedWindowRequest {
+	return w.Assign(message, func(_ *keyed.ActiveWindow) bool {
+		if w.trigger == "" {
+			return false
+		}
+		triggered, err := expr.EvalMessageBool(w.trigger, message.Payload, message.Keys, message.Headers)
+		return err == nil && triggered
+	})
+}
+
+// CloseWindows doesn't close any window since global windows are not closed by the watermark, it only stops
+// tracking the end time of the closed windows behind the watermark.
+func (w *Windower) CloseWindows(currentTime time.Time) []*window.TimedWindowRequest {
+	w.Advance(currentTime)
+	return nil
+}
+
+// FlushWindows closes all the active windows.
+func (w *Windower) FlushWindows() []*window.TimedWindowRequest {
+	return w.Close(func(_ *keyed.ActiveWindow) bool {
+		return true
+	})
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package global
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/window"
+)
+
+var keyedVertex = &dfv1.VertexInstance{
+	Vertex: &dfv1.Vertex{Spec: dfv1.VertexSpec{
+		PipelineName: "test-pl",
+		AbstractVertex: dfv1.AbstractVertex{
+			Name: "testVertex",
+			UDF:  &dfv1.UDF{GroupBy: &dfv1.GroupBy{Keyed: true}},
+		},
+	}},
+	Hostname: "test-host",
+	Replica:  0,
+}
+
+func buildReadMessage(eventTime time.Time, keys []string, payload string) *isb.ReadMessage {
+	return &isb.ReadMessage{
+		Message: isb.Message{
+			Header: isb.Header{
+				MessageInfo: isb.MessageInfo{
+					EventTime: eventTime,
+				},
+				Keys: keys,
+			},
+			Body: isb.Body{Payload: []byte(payload)},
+		},
+	}
+}
+
+func TestNewWindower(t *testing.T) {
+	_, err := NewWindower(`json(payload).done ==`, keyedVertex)
+	assert.Error(t, err)
+
+	windower, err := NewWindower("", keyedVertex)
+	require.NoError(t, err)
+	assert.Equal(t, window.Global, windower.Strategy())
+	assert.Equal(t, window.Unaligned, windower.Type())
+}
+
+func TestWindower_AssignWindows(t *testing.T) {
+	baseTime := time.UnixMilli(60000)
+	windower, err := NewWindower(`json(payload).done == true`, keyedVertex)
+	require.NoError(t, err)
+
+	requests := windower.AssignWindows(buildReadMessage(baseTime, []string{"key-1"}, `{"done": false}`))
+	assert.Len(t, requests, 1)
+	assert.Equal(t, window.Open, requests[0].Operation)
+
+	// a payload the trigger can't be evaluated on doesn't close the window
+	requests = windower.AssignWindows(buildReadMessage(baseTime, []string{"key-1"}, `not json`))
+	assert.Len(t, requests, 1)
+	assert.Equal(t, window.Append, requests[0].Operation)
+
+	// the watermark never closes global windows
+	assert.Empty(t, windower.CloseWindows(baseTime.Add(time.Hour)))
+
+	requests = windower.AssignWindows(buildReadMessage(baseTime.Add(time.Second), []string{"key-1"}, `{"done": true}`))
+	assert.Len(t, requests, 2)
+	assert.Equal(t, window.Expand, requests[0].Operation)
+	assert.Equal(t, window.Close, requests[1].Operation)
+	assert.Equal(t, baseTime, requests[1].Windows[0].StartTime())
+	assert.Equal(t, baseTime.Add(time.Second+time.Millisecond), requests[1].Windows[0].EndTime())
+
+	// a message of the key before the end of the closed window opens a new window starting at that end
+	requests = windower.AssignWindows(buildReadMessage(baseTime.Add(time.Second), []string{"key-1"}, `{}`))
+	assert.Len(t, requests, 1)
+	assert.Equal(t, window.Open, requests[0].Operation)
+	assert.Equal(t, baseTime.Add(time.Second+time.Millisecond), requests[0].Windows[0].StartTime())
+
+	// a trigger closes it
+	requests = windower.AssignWindows(buildReadMessage(baseTime.Add(2*time.Second), []string{"key-1"}, `{"done": true}`))
+	assert.Len(t, requests, 2)
+	assert.Equal(t, window.Expand, requests[0].Operation)
+	assert.Equal(t, window.Close, requests[1].Operation)
+
+	// a trigger on the first message of a window opens and closes it
+	requests = windower.AssignWindows(buildReadMessage(baseTime.Add(3*time.Second), []string{"key-1"}, `{"done": true}`))
+	assert.Len(t, requests, 2)
+	assert.Equal(t, window.Open, requests[0].Operation)
+	assert.Equal(t, window.Close, requests[1].Operation)
+}
+
+func TestWindower_FlushWindows(t *testing.T) {
+	baseTime := time.UnixMilli(60000)
+	windower, err := NewWindower("", keyedVertex)
+	require.NoError(t, err)
+	assert.Equal(t, time.UnixMilli(-1), windower.OldestWindowEndTime())
+
+	windower.AssignWindows(buildReadMessage(baseTime, []string{"key-1"}, ""))
+	windower.AssignWindows(buildReadMessage(baseTime.Add(time.Second), []string{"key-2"}, ""))
+	assert.Equal(t, baseTime.Add(time.Millisecond), windower.OldestWindowEndTime())
+
+	requests := windower.(window.Flusher).FlushWindows()
+	assert.Len(t, requests, 2)
+	for _, request := range requests {
+		assert.Equal(t, window.Close, request.Operation)
+	}
+	assert.Empty(t, windower.(window.Flusher).FlushWindows())
+
+	// the closed windows are tracked until they are deleted
+	assert.Equal(t, baseTime.Add(time.Millisecond), windower.OldestWindowEndTime())
+	for _, request := range requests {
+		windower.DeleteClosedWindow(request.Windows[0])
+	}
+	assert.Equal(t, time.UnixMilli(-1), windower.OldestWindowEndTime())
+}
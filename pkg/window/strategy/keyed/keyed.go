@@ -0,0 +1,198 @@
+/*
+Copyright 2022 The Numaproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package keyed implements the bookkeeping of the unaligned windows which have at most one active window per key,
+// and close the window of each key independently, e.g., count and global windows. The bounds of a window are
+// [event time of the first message, max event time of its messages + 1ms), the window is expanded as messages with
+// later event times are assigned to it. The end time of a closed window is what the WAL compactor uses to delete the
+// persisted messages of the window.
+package keyed
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	dfv1 "github.com/numaproj/numaflow/pkg/apis/numaflow/v1alpha1"
+	"github.com/numaproj/numaflow/pkg/isb"
+	"github.com/numaproj/numaflow/pkg/metrics"
+	"github.com/numaproj/numaflow/pkg/window"
+)
+
+// ActiveWindow is the active window of a key and the number of messages assigned to it.
+type ActiveWindow struct {
+	Window window.TimedWindow
+	Count  uint32
+}
+
+// Windows tracks the active and closed windows of the keys. It implements the methods of TimedWindower which are
+// common to the keyed window strategies, the strategies decide when the window of a key is closed.
+type Windows struct {
+	vertexName    string
+	pipelineName  string
+	vertexReplica int32
+
+	// activeWindows is a map of the combined keys to the active window of the key.
+	activeWindows map[string]*ActiveWindow
+
+	// closedWindows is a list of closed windows which are yet to be GCed.
+	closedWindows *window.SortedWindowListByEndTime
+
+	// lastClosedEndTimes tracks the end time of the last closed window of every key. The next window of the key
+	// starts no earlier than it, so that the windows of a key don't overlap.
+	lastClosedEndTimes map[string]time.Time
+	mu                 sync.RWMutex
+}
+
+// NewWindows returns a new Windows of the vertex instance.
+func NewWindows(vertexInstance *dfv1.VertexInstance) *Windows {
+	return &Windows{
+		vertexName:         vertexInstance.Vertex.Name,
+		pipelineName:       vertexInstance.Vertex.Spec.PipelineName,
+		vertexReplica:      vertexInstance.Replica,
+		activeWindows:      make(map[string]*ActiveWindow),
+		closedWindows:      window.NewSortedWindowListByEndTime(),
+		lastClosedEndTimes: make(map[string]time.Time),
+	}
+}
+
+// Assign assigns the message to the active window of its key, it opens a new window if the key has no active
+// window, and expands the window if the message's event time is after the end of the window. The window is closed
+// after the message is assigned if shouldClose returns true. Whether the message is late is decided by the
+// forwarder based on the watermark, a message before the end of the last closed window of its key is assigned to
+// a new window which starts at that end.
+func (w *Windows) Assign(message *isb.ReadMessage, shouldClose func(aw *ActiveWindow) bool) []*window.TimedWindowRequest {
+	combinedKey := strings.Join(message.Keys, dfv1.KeysDelimitter)
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	var requests []*window.TimedWindowRequest
+	aw, ok := w.activeWindows[combinedKey]
+	if !ok {
+		start := message.EventTime
+		if end, ok := w.lastClosedEndTimes[combinedKey]; ok && start.Before(end) {
+			start = end
+		}
+		aw = &ActiveWindow{Window: newWindow(start, start.Add(time.Millisecond), message.Keys)}
+		w.activeWindows[combinedKey] = aw
+		requests = append(requests, createWindowOperation(message, window.Open, aw.Window))
+	} else if end := message.EventTime.Add(time.Millisecond); end.After(aw.Window.EndTime()) {
+		oldWindow := aw.Window
+		aw.Window = newWindow(oldWindow.StartTime(), end, message.Keys)
+		requests = append(requests, createWindowOperation(message, window.Expand, oldWindow, aw.Window))
+	} else {
+		requests = append(requests, createWindowOperation(message, window.Append, aw.Window))
+	}
+
+	aw.Count++
+	if shouldClose(aw) {
+		requests = append(requests, w.closeWindow(combinedKey, aw))
+	}
+	return requests
+}
+
+// Close closes the active windows for which shouldClose returns true.
+func (w *Windows) Close(shouldClose func(aw *ActiveWindow) bool) []*window.TimedWindowRequest {
+	var requests []*window.TimedWindowRequest
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for key, aw := range w.activeWindows {
+		if shouldClose(aw) {
+			requests = append(requests, w.closeWindow(key, aw))
+		}
+	}
+	return requests
+}
+
+// closeWindow closes the active window of the key and returns the close operation. It should be called with
+// the write lock held.
+func (w *Windows) closeWindow(combinedKey string, aw *ActiveWindow) *window.TimedWindowRequest {
+	delete(w.activeWindows, combinedKey)
+	w.closedWindows.Insert(aw.Window)
+	w.lastClosedEndTimes[combinedKey] = aw.Window.EndTime()
+	return createWindowOperation(nil, window.Close, aw.Window)
+}
+
+// Advance stops tracking the end time of the closed windows behind the watermark, since the messages before the
+// watermark are handled as late by the forwarder, and updates the window metrics.
+func (w *Windows) Advance(currentTime time.Time) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for key, end := range w.lastClosedEndTimes {
+		if !end.After(currentTime) {
+			delete(w.lastClosedEndTimes, key)
+		}
+	}
+
+	labels := map[string]string{
+		metrics.LabelVertex:             w.vertexName,
+		metrics.LabelPipeline:           w.pipelineName,
+		metrics.LabelVertexReplicaIndex: strconv.Itoa(int(w.vertexReplica)),
+	}
+	metrics.ActiveWindowsCount.With(labels).Set(float64(len(w.activeWindows)))
+	metrics.ClosedWindowsCount.With(labels).Set(float64(w.closedWindows.Len()))
+}
+
+// InsertWindow inserts a window to the list of active windows.
+func (w *Windows) InsertWindow(_ window.TimedWindow) {
+	// Not Applicable for UnAligned
+}
+
+// NextWindowToBeClosed returns the shared partition of unaligned windows, keyed windows are closed per key.
+func (w *Windows) NextWindowToBeClosed() window.TimedWindow {
+	return window.NewUnalignedTimedWindow(window.SharedUnalignedPartition.Start, window.SharedUnalignedPartition.End, window.SharedUnalignedPartition.Slot, nil)
+}
+
+// DeleteClosedWindow deletes the window from the closed windows list.
+func (w *Windows) DeleteClosedWindow(tw window.TimedWindow) {
+	w.closedWindows.Delete(tw)
+}
+
+// OldestWindowEndTime returns the end time of the oldest window among both active and closed windows.
+// If there are no windows, it returns -1.
+func (w *Windows) OldestWindowEndTime() time.Time {
+	var minEndTime = time.UnixMilli(-1)
+	if win := w.closedWindows.Front(); win != nil {
+		minEndTime = win.EndTime()
+	}
+
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	for _, aw := range w.activeWindows {
+		if minEndTime.UnixMilli() == -1 || aw.Window.EndTime().Before(minEndTime) {
+			minEndTime = aw.Window.EndTime()
+		}
+	}
+	return minEndTime
+}
+
+func newWindow(start time.Time, end time.Time, keys []string) window.TimedWindow {
+	return window.NewUnalignedTimedWindow(start, end, window.SharedUnalignedPartition.Slot, keys)
+}
+
+func createWindowOperation(message *isb.ReadMessage, event window.Operation, windows ...window.TimedWindow) *window.TimedWindowRequest {
+	return &window.TimedWindowRequest{
+		ReadMessage: message,
+		Operation:   event,
+		Windows:     windows,
+		ID:          &window.SharedUnalignedPartition,
+	}
+}
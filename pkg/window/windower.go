@@ -50,6 +50,13 @@ type TimedWindower interface {
 	OldestWindowEndTime() time.Time
 }
 
+// Flusher is implemented by the TimedWindowers whose windows are not closed by the watermark, they have to be
+// closed when the vertex is shutting down (e.g., the pipeline is paused) so that the results are not held back.
+type Flusher interface {
+	// FlushWindows closes all the active windows.
+	FlushWindows() []*TimedWindowRequest
+}
+
 // TimedWindow represents a time-based window.
 type TimedWindow interface {
 	// StartTime returns the start time of the window.
@@ -323,6 +330,8 @@ const (
 	Sliding
 	Session
 	Accumulator
+	Count
+	Global
 )
 
 func (s Strategy) String() string {
@@ -335,6 +344,10 @@ func (s Strategy) String() string {
 		return "Session"
 	case Accumulator:
 		return "Accumulator"
+	case Count:
+		return "Count"
+	case Global:
+		return "Global"
 	default:
 		return "Unknown"
 	}
@@ -558,10 +558,13 @@ pub(crate) mod sink {
         fn default() -> Self {
             let default_retry_strategy = RetryStrategy {
                 backoff: Option::from(Box::from(Backoff {
+                    cap: None,
+                    factor: None,
                     interval: Option::from(kube::core::Duration::from(
                         std::time::Duration::from_millis(DEFAULT_SINK_RETRY_INTERVAL_IN_MS as u64),
                     )),
                     steps: Option::from(DEFAULT_MAX_SINK_RETRY_ATTEMPTS as i64),
+                    jitter: None,
                 })),
                 on_failure: Option::from(DEFAULT_SINK_RETRY_ON_FAIL_STRATEGY.to_string()),
             };
@@ -790,10 +793,13 @@ mod sink_tests {
     fn test_default_retry_config() {
         let default_retry_strategy = RetryStrategy {
             backoff: Option::from(Box::from(Backoff {
+                cap: None,
+                factor: None,
                 interval: Option::from(kube::core::Duration::from(
                     std::time::Duration::from_millis(1u64),
                 )),
                 steps: Option::from(u16::MAX as i64),
+                jitter: None,
             })),
             on_failure: Option::from(OnFailureStrategy::Retry.to_string()),
         };
@@ -894,6 +900,7 @@ mod sink_tests {
         // Test case 1: Valid configuration
         let valid_sqs_sink = Box::new(SqsSink {
             aws_region: "us-west-2".to_string(),
+            endpoint_url: None,
             queue_name: "test-queue".to_string(),
             queue_owner_aws_account_id: "123456789012".to_string(),
         });
@@ -911,6 +918,7 @@ mod sink_tests {
         // Test case 2: Missing required fields
         let invalid_sqs_sink = Box::new(SqsSink {
             aws_region: "".to_string(),
+            endpoint_url: None,
             queue_name: "test-queue".to_string(),
             queue_owner_aws_account_id: "123456789012".to_string(),
         });
@@ -941,13 +949,18 @@ mod sink_tests {
                 serve: None,
                 sqs: Some(Box::new(SqsSink {
                     aws_region: "us-west-2".to_string(),
+                    endpoint_url: None,
                     queue_name: "fallback-queue".to_string(),
                     queue_owner_aws_account_id: "123456789012".to_string(),
                 })),
                 kafka: None,
+                file: None,
+                http: None,
             })),
             retry_strategy: None,
             kafka: None,
+            file: None,
+            http: None,
         };
 
         let result = SinkType::fallback_sinktype(&sink);
@@ -971,6 +984,8 @@ mod sink_tests {
             fallback: None,
             retry_strategy: None,
             kafka: None,
+            file: None,
+            http: None,
         };
         let result = SinkType::fallback_sinktype(&sink_without_fallback);
         assert!(result.is_err());
@@ -993,13 +1008,18 @@ mod sink_tests {
                 serve: None,
                 sqs: Some(Box::new(SqsSink {
                     aws_region: "".to_string(),
+                    endpoint_url: None,
                     queue_name: "fallback-queue".to_string(),
                     queue_owner_aws_account_id: "123456789012".to_string(),
                 })),
                 kafka: None,
+                file: None,
+                http: None,
             })),
             retry_strategy: None,
             kafka: None,
+            file: None,
+            http: None,
         };
         let result = SinkType::fallback_sinktype(&sink_missing_region);
         assert!(result.is_err());
@@ -1022,9 +1042,13 @@ mod sink_tests {
                 serve: None,
                 sqs: None,
                 kafka: None,
+                file: None,
+                http: None,
             })),
             retry_strategy: None,
             kafka: None,
+            file: None,
+            http: None,
         };
         let result = SinkType::fallback_sinktype(&sink_empty_fallback);
         assert!(result.is_err());
@@ -1104,6 +1128,7 @@ mod jetstream_tests {
         setup_secret(secret_name, pass_key, "test-pass");
 
         let jetstream_source = JetStreamSource {
+            ack_wait: None,
             auth: Some(Box::new(numaflow_models::models::NatsAuth {
                 basic: Some(Box::new(BasicAuth {
                     user: Some(SecretKeySelector {
@@ -1120,6 +1145,13 @@ mod jetstream_tests {
                 nkey: None,
                 token: None,
             })),
+            deliver_policy: None,
+            ephemeral: None,
+            filter_subjects: None,
+            key_from_header: None,
+            max_ack_pending: None,
+            start_sequence: None,
+            start_time: None,
             stream: "test-stream".to_string(),
             tls: None,
             url: "nats://localhost:4222".to_string(),
@@ -1151,7 +1183,15 @@ mod jetstream_tests {
         setup_secret(key_name, "key", "test-key");
 
         let jetstream_source = JetStreamSource {
+            ack_wait: None,
             auth: None,
+            deliver_policy: None,
+            ephemeral: None,
+            filter_subjects: None,
+            key_from_header: None,
+            max_ack_pending: None,
+            start_sequence: None,
+            start_time: None,
             stream: "test-stream".to_string(),
             tls: Some(Box::new(Tls {
                 ca_cert_secret: Some(SecretKeySelector {
@@ -1200,11 +1240,19 @@ mod jetstream_tests {
     #[test]
     fn test_try_from_jetstream_source_with_invalid_auth() {
         let jetstream_source = JetStreamSource {
+            ack_wait: None,
             auth: Some(Box::new(numaflow_models::models::NatsAuth {
                 basic: None,
                 nkey: None,
                 token: None,
             })),
+            deliver_policy: None,
+            ephemeral: None,
+            filter_subjects: None,
+            key_from_header: None,
+            max_ack_pending: None,
+            start_sequence: None,
+            start_time: None,
             stream: "test-stream".to_string(),
             tls: None,
             url: "nats://localhost:4222".to_string(),
@@ -265,6 +265,7 @@ impl TryFrom<Message> for BytesMut {
                 id: Some(message.id.into()),
                 keys: message.keys.to_vec(),
                 headers: message.headers,
+                compression: numaflow_pb::objects::isb::Compression::None.into(),
             }),
             body: Some(numaflow_pb::objects::isb::Body {
                 payload: message.value.to_vec(),
@@ -345,6 +346,7 @@ mod tests {
                 id: Some(message.id.into()),
                 keys: message.keys.to_vec(),
                 headers: message.headers,
+                compression: numaflow_pb::objects::isb::Compression::None.into(),
             }),
             body: Some(Body {
                 payload: message.value.clone().into(),
@@ -1232,10 +1232,13 @@ mod tests {
                         streams: vertex1_streams.clone(),
                         ..Default::default()
                     },
-                    conditions: Some(Box::new(ForwardConditions::new(TagConditions {
-                        operator: Some("and".to_string()),
-                        values: vec!["tag1".to_string(), "tag2".to_string()],
-                    }))),
+                    conditions: Some(Box::new(ForwardConditions {
+                        expression: None,
+                        tags: Some(Box::new(TagConditions {
+                            operator: Some("and".to_string()),
+                            values: vec!["tag1".to_string(), "tag2".to_string()],
+                        })),
+                    })),
                 },
                 ToVertexConfig {
                     name: "vertex2",
@@ -1244,10 +1247,13 @@ mod tests {
                         streams: vertex2_streams.clone(),
                         ..Default::default()
                     },
-                    conditions: Some(Box::new(ForwardConditions::new(TagConditions {
-                        operator: Some("or".to_string()),
-                        values: vec!["tag2".to_string()],
-                    }))),
+                    conditions: Some(Box::new(ForwardConditions {
+                        expression: None,
+                        tags: Some(Box::new(TagConditions {
+                            operator: Some("or".to_string()),
+                            values: vec!["tag2".to_string()],
+                        })),
+                    })),
                 },
                 ToVertexConfig {
                     name: "vertex3",
@@ -1256,10 +1262,13 @@ mod tests {
                         streams: vertex3_streams.clone(),
                         ..Default::default()
                     },
-                    conditions: Some(Box::new(ForwardConditions::new(TagConditions {
-                        operator: Some("not".to_string()),
-                        values: vec!["tag1".to_string()],
-                    }))),
+                    conditions: Some(Box::new(ForwardConditions {
+                        expression: None,
+                        tags: Some(Box::new(TagConditions {
+                            operator: Some("not".to_string()),
+                            values: vec!["tag1".to_string()],
+                        })),
+                    })),
                 },
             ],
             context.clone(),
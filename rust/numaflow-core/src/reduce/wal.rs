@@ -40,6 +40,7 @@ impl TryFrom<WalMessage> for Bytes {
                     id: Some(message.id.into()),
                     keys: message.keys.to_vec(),
                     headers: message.headers,
+                    compression: numaflow_pb::objects::isb::Compression::None.into(),
                 }),
                 body: Some(numaflow_pb::objects::isb::Body {
                     payload: message.value.to_vec(),
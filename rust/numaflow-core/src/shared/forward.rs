@@ -11,10 +11,12 @@ pub(crate) fn should_forward(
     conditions: Option<Box<ForwardConditions>>,
 ) -> bool {
     conditions.is_none_or(|conditions| {
-        conditions.tags.operator.as_ref().is_none_or(|operator| {
-            tags.as_ref().is_none_or(|tags| {
-                !conditions.tags.values.is_empty()
-                    && check_operator_condition(operator, &conditions.tags.values, tags)
+        conditions.tags.as_ref().is_none_or(|tag_conditions| {
+            tag_conditions.operator.as_ref().is_none_or(|operator| {
+                tags.as_ref().is_none_or(|tags| {
+                    !tag_conditions.values.is_empty()
+                        && check_operator_condition(operator, &tag_conditions.values, tags)
+                })
             })
         })
     })
@@ -74,7 +76,10 @@ mod tests {
 
     #[tokio::test]
     async fn test_evaluate_write_condition_no_tags() {
-        let conditions = ForwardConditions::new(TagConditions::new(vec!["tag1".to_string()]));
+        let conditions = ForwardConditions {
+            expression: None,
+            tags: Some(Box::new(TagConditions::new(vec!["tag1".to_string()]))),
+        };
         let result = should_forward(None, Some(Box::new(conditions)));
         assert!(result);
     }
@@ -83,7 +88,10 @@ mod tests {
     async fn test_evaluate_write_condition_and_operator() {
         let mut tag_conditions = TagConditions::new(vec!["tag1".to_string(), "tag2".to_string()]);
         tag_conditions.operator = Some("and".to_string());
-        let conditions = ForwardConditions::new(tag_conditions);
+        let conditions = ForwardConditions {
+            expression: None,
+            tags: Some(Box::new(tag_conditions)),
+        };
         let tags = Some(Arc::from(vec!["tag1".to_string(), "tag2".to_string()]));
         let result = should_forward(tags, Some(Box::new(conditions)));
         assert!(result);
@@ -93,7 +101,10 @@ mod tests {
     async fn test_evaluate_write_condition_or_operator() {
         let mut tag_conditions = TagConditions::new(vec!["tag1".to_string()]);
         tag_conditions.operator = Some("or".to_string());
-        let conditions = ForwardConditions::new(tag_conditions);
+        let conditions = ForwardConditions {
+            expression: None,
+            tags: Some(Box::new(tag_conditions)),
+        };
         let tags = Some(Arc::from(vec!["tag2".to_string(), "tag1".to_string()]));
         let result = should_forward(tags, Some(Box::new(conditions)));
         assert!(result);
@@ -103,7 +114,10 @@ mod tests {
     async fn test_evaluate_write_condition_not_operator() {
         let mut tag_conditions = TagConditions::new(vec!["tag1".to_string()]);
         tag_conditions.operator = Some("not".to_string());
-        let conditions = ForwardConditions::new(tag_conditions);
+        let conditions = ForwardConditions {
+            expression: None,
+            tags: Some(Box::new(tag_conditions)),
+        };
         let tags = Some(Arc::from(vec!["tag2".to_string()]));
         let result = should_forward(tags, Some(Box::new(conditions)));
         assert!(result);
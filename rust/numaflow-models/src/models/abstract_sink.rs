@@ -20,6 +20,10 @@ limitations under the License.
 pub struct AbstractSink {
     #[serde(rename = "blackhole", skip_serializing_if = "Option::is_none")]
     pub blackhole: Option<Box<crate::models::Blackhole>>,
+    #[serde(rename = "file", skip_serializing_if = "Option::is_none")]
+    pub file: Option<Box<crate::models::FileSink>>,
+    #[serde(rename = "http", skip_serializing_if = "Option::is_none")]
+    pub http: Option<Box<crate::models::HttpSink>>,
     #[serde(rename = "kafka", skip_serializing_if = "Option::is_none")]
     pub kafka: Option<Box<crate::models::KafkaSink>>,
     #[serde(rename = "log", skip_serializing_if = "Option::is_none")]
@@ -36,6 +40,8 @@ impl AbstractSink {
     pub fn new() -> AbstractSink {
         AbstractSink {
             blackhole: None,
+            file: None,
+            http: None,
             kafka: None,
             log: None,
             serve: None,
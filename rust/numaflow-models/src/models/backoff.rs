@@ -20,8 +20,16 @@ limitations under the License.
 
 #[derive(Clone, Debug, PartialEq, Serialize, Deserialize)]
 pub struct Backoff {
+    #[serde(rename = "cap", skip_serializing_if = "Option::is_none")]
+    pub cap: Option<kube::core::Duration>,
+    /// Factor is multiplied to the delay after each retry, a value of 1 (the default) means a fixed interval. Must be greater than or equal to 1.
+    #[serde(rename = "factor", skip_serializing_if = "Option::is_none")]
+    pub factor: Option<f64>,
     #[serde(rename = "interval", skip_serializing_if = "Option::is_none")]
     pub interval: Option<kube::core::Duration>,
+    /// Jitter adds a random amount of up to Jitter*delay to each delay, in order to spread out the retries of the replicas. Must be in the range of [0, 1].
+    #[serde(rename = "jitter", skip_serializing_if = "Option::is_none")]
+    pub jitter: Option<f64>,
     /// Steps defines the number of times to try writing to a sink including retries
     #[serde(rename = "steps", skip_serializing_if = "Option::is_none")]
     pub steps: Option<i64>,
@@ -31,7 +39,10 @@ impl Backoff {
     /// Backoff defines parameters used to systematically configure the retry strategy.
     pub fn new() -> Backoff {
         Backoff {
+            cap: None,
+            factor: None,
             interval: None,
+            jitter: None,
             steps: None,
         }
     }
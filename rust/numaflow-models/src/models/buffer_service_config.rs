@@ -18,6 +18,8 @@ limitations under the License.
 
 #[derive(Clone, Debug, PartialEq, Serialize, Deserialize)]
 pub struct BufferServiceConfig {
+    #[serde(rename = "disk", skip_serializing_if = "Option::is_none")]
+    pub disk: Option<Box<crate::models::DiskConfig>>,
     #[serde(rename = "jetstream", skip_serializing_if = "Option::is_none")]
     pub jetstream: Option<Box<crate::models::JetStreamConfig>>,
     #[serde(rename = "redis", skip_serializing_if = "Option::is_none")]
@@ -27,6 +29,7 @@ pub struct BufferServiceConfig {
 impl BufferServiceConfig {
     pub fn new() -> BufferServiceConfig {
         BufferServiceConfig {
+            disk: None,
             jetstream: None,
             redis: None,
         }
@@ -20,6 +20,8 @@ limitations under the License.
 
 #[derive(Clone, Debug, PartialEq, Serialize, Deserialize)]
 pub struct CombinedEdge {
+    #[serde(rename = "compression", skip_serializing_if = "Option::is_none")]
+    pub compression: Option<Box<crate::models::Compression>>,
     #[serde(rename = "conditions", skip_serializing_if = "Option::is_none")]
     pub conditions: Option<Box<crate::models::ForwardConditions>>,
     #[serde(rename = "from")]
@@ -38,6 +40,8 @@ pub struct CombinedEdge {
     /// OnFull specifies the behaviour for the write actions when the inter step buffer is full. There are currently two options, retryUntilSuccess and discardLatest. if not provided, the default value is set to \"retryUntilSuccess\"
     #[serde(rename = "onFull", skip_serializing_if = "Option::is_none")]
     pub on_full: Option<String>,
+    #[serde(rename = "priority", skip_serializing_if = "Option::is_none")]
+    pub priority: Option<Box<crate::models::EdgePriority>>,
     #[serde(rename = "to")]
     pub to: String,
     #[serde(rename = "toVertexLimits", skip_serializing_if = "Option::is_none")]
@@ -62,12 +66,14 @@ impl CombinedEdge {
         to_vertex_type: String,
     ) -> CombinedEdge {
         CombinedEdge {
+            compression: None,
             conditions: None,
             from,
             from_vertex_limits: None,
             from_vertex_partition_count: None,
             from_vertex_type,
             on_full: None,
+            priority: None,
             to,
             to_vertex_limits: None,
             to_vertex_partition_count: None,
@@ -18,6 +18,8 @@ limitations under the License.
 
 #[derive(Clone, Debug, PartialEq, Serialize, Deserialize)]
 pub struct Edge {
+    #[serde(rename = "compression", skip_serializing_if = "Option::is_none")]
+    pub compression: Option<Box<crate::models::Compression>>,
     #[serde(rename = "conditions", skip_serializing_if = "Option::is_none")]
     pub conditions: Option<Box<crate::models::ForwardConditions>>,
     #[serde(rename = "from")]
@@ -25,6 +27,8 @@ pub struct Edge {
     /// OnFull specifies the behaviour for the write actions when the inter step buffer is full. There are currently two options, retryUntilSuccess and discardLatest. if not provided, the default value is set to \"retryUntilSuccess\"
     #[serde(rename = "onFull", skip_serializing_if = "Option::is_none")]
     pub on_full: Option<String>,
+    #[serde(rename = "priority", skip_serializing_if = "Option::is_none")]
+    pub priority: Option<Box<crate::models::EdgePriority>>,
     #[serde(rename = "to")]
     pub to: String,
 }
@@ -32,9 +36,11 @@ pub struct Edge {
 impl Edge {
     pub fn new(from: String, to: String) -> Edge {
         Edge {
+            compression: None,
             conditions: None,
             from,
             on_full: None,
+            priority: None,
             to,
         }
     }
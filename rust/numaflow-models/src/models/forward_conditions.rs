@@ -18,14 +18,18 @@ limitations under the License.
 
 #[derive(Clone, Debug, PartialEq, Serialize, Deserialize)]
 pub struct ForwardConditions {
-    #[serde(rename = "tags")]
-    pub tags: Box<crate::models::TagConditions>,
+    /// Expression is a boolean expression evaluated against the \"payload\" (string), \"keys\" ([]string) and \"headers\" (map[string]string) of a message, the message is forwarded through the edge if it returns true. For example, `json(payload).amount > 100 && headers[\"x-region\"] == \"us\"`. If both Tags and Expression are specified, a message needs to satisfy both of them.
+    #[serde(rename = "expression", skip_serializing_if = "Option::is_none")]
+    pub expression: Option<String>,
+    #[serde(rename = "tags", skip_serializing_if = "Option::is_none")]
+    pub tags: Option<Box<crate::models::TagConditions>>,
 }
 
 impl ForwardConditions {
-    pub fn new(tags: crate::models::TagConditions) -> ForwardConditions {
+    pub fn new() -> ForwardConditions {
         ForwardConditions {
-            tags: Box::new(tags),
+            expression: None,
+            tags: None,
         }
     }
 }
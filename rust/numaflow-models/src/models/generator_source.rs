@@ -28,8 +28,15 @@ pub struct GeneratorSource {
     /// Size of each generated message
     #[serde(rename = "msgSize", skip_serializing_if = "Option::is_none")]
     pub msg_size: Option<i32>,
+    #[serde(rename = "outOfOrder", skip_serializing_if = "Option::is_none")]
+    pub out_of_order: Option<Box<crate::models::GeneratorOutOfOrder>>,
+    #[serde(rename = "replay", skip_serializing_if = "Option::is_none")]
+    pub replay: Option<Box<crate::models::GeneratorReplay>>,
     #[serde(rename = "rpu", skip_serializing_if = "Option::is_none")]
     pub rpu: Option<i64>,
+    /// Template is an optional Go template of the payload, with the sprig functions, e.g. randInt and uuidv4. The fields are .Key, .KeyIndex, .Replica, .Counter (incremented for each message), .KeyCounter (incremented for each message of the key) and .EventTime. if present, the Value, MsgSize and ValueBlob fields will be ignored.
+    #[serde(rename = "template", skip_serializing_if = "Option::is_none")]
+    pub template: Option<String>,
     /// Value is an optional uint64 value to be written in to the payload
     #[serde(rename = "value", skip_serializing_if = "Option::is_none")]
     pub value: Option<i64>,
@@ -45,7 +52,10 @@ impl GeneratorSource {
             jitter: None,
             key_count: None,
             msg_size: None,
+            out_of_order: None,
+            replay: None,
             rpu: None,
+            template: None,
             value: None,
             value_blob: None,
         }
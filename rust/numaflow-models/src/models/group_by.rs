@@ -24,6 +24,9 @@ pub struct GroupBy {
     pub allowed_lateness: Option<kube::core::Duration>,
     #[serde(rename = "keyed", skip_serializing_if = "Option::is_none")]
     pub keyed: Option<bool>,
+    /// LateDataTag is the tag the late messages, which are behind (Watermark - AllowedLateness), are forwarded with instead of being dropped. Only the outgoing edges whose conditions match the tag, and the ones without any conditions, receive them. The window the message would have been assigned to is attached as headers.
+    #[serde(rename = "lateDataTag", skip_serializing_if = "Option::is_none")]
+    pub late_data_tag: Option<String>,
     #[serde(rename = "storage", skip_serializing_if = "Option::is_none")]
     pub storage: Option<Box<crate::models::PbqStorage>>,
     #[serde(rename = "window")]
@@ -36,6 +39,7 @@ impl GroupBy {
         GroupBy {
             allowed_lateness: None,
             keyed: None,
+            late_data_tag: None,
             storage: None,
             window: Box::new(window),
         }
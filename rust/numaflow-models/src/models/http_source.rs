@@ -18,8 +18,15 @@ limitations under the License.
 
 #[derive(Clone, Debug, PartialEq, Serialize, Deserialize)]
 pub struct HttpSource {
+    /// Whether to hold the HTTP response until the messages are written to the inter-step buffer. When enabled, the batch endpoint reports the status of each message.
+    #[serde(rename = "ackAfterWrite", skip_serializing_if = "Option::is_none")]
+    pub ack_after_write: Option<bool>,
+    #[serde(rename = "ackTimeout", skip_serializing_if = "Option::is_none")]
+    pub ack_timeout: Option<kube::core::Duration>,
     #[serde(rename = "auth", skip_serializing_if = "Option::is_none")]
     pub auth: Option<Box<crate::models::Authorization>>,
+    #[serde(rename = "batch", skip_serializing_if = "Option::is_none")]
+    pub batch: Option<Box<crate::models::HttpBatch>>,
     /// Whether to create a ClusterIP Service
     #[serde(rename = "service", skip_serializing_if = "Option::is_none")]
     pub service: Option<bool>,
@@ -28,7 +35,10 @@ pub struct HttpSource {
 impl HttpSource {
     pub fn new() -> HttpSource {
         HttpSource {
+            ack_after_write: None,
+            ack_timeout: None,
             auth: None,
+            batch: None,
             service: None,
         }
     }
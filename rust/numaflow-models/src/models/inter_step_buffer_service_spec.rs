@@ -18,6 +18,8 @@ limitations under the License.
 
 #[derive(Clone, Debug, PartialEq, Serialize, Deserialize)]
 pub struct InterStepBufferServiceSpec {
+    #[serde(rename = "disk", skip_serializing_if = "Option::is_none")]
+    pub disk: Option<Box<crate::models::DiskBufferService>>,
     #[serde(rename = "jetstream", skip_serializing_if = "Option::is_none")]
     pub jetstream: Option<Box<crate::models::JetStreamBufferService>>,
     #[serde(rename = "redis", skip_serializing_if = "Option::is_none")]
@@ -27,6 +29,7 @@ pub struct InterStepBufferServiceSpec {
 impl InterStepBufferServiceSpec {
     pub fn new() -> InterStepBufferServiceSpec {
         InterStepBufferServiceSpec {
+            disk: None,
             jetstream: None,
             redis: None,
         }
@@ -18,8 +18,30 @@ limitations under the License.
 
 #[derive(Clone, Debug, PartialEq, Serialize, Deserialize)]
 pub struct JetStreamSource {
+    #[serde(rename = "ackWait", skip_serializing_if = "Option::is_none")]
+    pub ack_wait: Option<kube::core::Duration>,
     #[serde(rename = "auth", skip_serializing_if = "Option::is_none")]
     pub auth: Option<Box<crate::models::NatsAuth>>,
+    /// DeliverPolicy is where the consumer starts, \"all\", \"new\", \"byStartTime\" or \"bySequence\", defaults to \"all\". It's only applied when the consumer is created, the deliver policy of an existing durable consumer can not be changed.
+    #[serde(rename = "deliverPolicy", skip_serializing_if = "Option::is_none")]
+    pub deliver_policy: Option<String>,
+    /// Ephemeral uses an ephemeral consumer of each replica instead of the durable consumer of the vertex, it's removed by the server after the replica is gone. Each replica of the vertex receives all the messages with an ephemeral consumer.
+    #[serde(rename = "ephemeral", skip_serializing_if = "Option::is_none")]
+    pub ephemeral: Option<bool>,
+    /// FilterSubjects only consumes the messages of the subjects of the stream, all the messages are consumed if it's empty.
+    #[serde(rename = "filterSubjects", skip_serializing_if = "Option::is_none")]
+    pub filter_subjects: Option<Vec<String>>,
+    /// KeyFromHeader is the name of the message header whose value is used as the message key.
+    #[serde(rename = "keyFromHeader", skip_serializing_if = "Option::is_none")]
+    pub key_from_header: Option<String>,
+    /// MaxAckPending is the max number of messages delivered but not acknowledged, the default of the server is used if it's 0.
+    #[serde(rename = "maxAckPending", skip_serializing_if = "Option::is_none")]
+    pub max_ack_pending: Option<i64>,
+    /// StartSequence is required by the \"bySequence\" deliver policy.
+    #[serde(rename = "startSequence", skip_serializing_if = "Option::is_none")]
+    pub start_sequence: Option<i64>,
+    #[serde(rename = "startTime", skip_serializing_if = "Option::is_none")]
+    pub start_time: Option<k8s_openapi::apimachinery::pkg::apis::meta::v1::Time>,
     /// Stream represents the name of the stream.
     #[serde(rename = "stream")]
     pub stream: String,
@@ -33,7 +55,15 @@ pub struct JetStreamSource {
 impl JetStreamSource {
     pub fn new(stream: String, url: String) -> JetStreamSource {
         JetStreamSource {
+            ack_wait: None,
             auth: None,
+            deliver_policy: None,
+            ephemeral: None,
+            filter_subjects: None,
+            key_from_header: None,
+            max_ack_pending: None,
+            start_sequence: None,
+            start_time: None,
             stream,
             tls: None,
             url,
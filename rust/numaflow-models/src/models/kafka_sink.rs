@@ -20,13 +20,37 @@ limitations under the License.
 pub struct KafkaSink {
     #[serde(rename = "brokers", skip_serializing_if = "Option::is_none")]
     pub brokers: Option<Vec<String>>,
+    /// Compression codec of the producer, \"none\", \"gzip\", \"snappy\", \"lz4\" or \"zstd\". It overrides \"producer.compression\" in the config.
+    #[serde(rename = "compression", skip_serializing_if = "Option::is_none")]
+    pub compression: Option<String>,
     #[serde(rename = "config", skip_serializing_if = "Option::is_none")]
     pub config: Option<String>,
+    /// ExactlyOnce writes the messages in Kafka transactions, along with a checkpoint of the inter-step buffer offsets, so that the messages redelivered after a crash are not written again. It implies Idempotent. It requires the JetStream inter-step buffer service, and the vertex can not scale beyond 1 replica. The consumers of the topic need to use \"isolation.level=read_committed\" to only read the committed records. It's only supported by the main sink, not the fallback sink.
+    #[serde(rename = "exactlyOnce", skip_serializing_if = "Option::is_none")]
+    pub exactly_once: Option<bool>,
+    /// ForwardHeaders forwards the headers of the messages as Kafka record headers, along with the event time in the \"x-numaflow-event-time\" header.
+    #[serde(rename = "forwardHeaders", skip_serializing_if = "Option::is_none")]
+    pub forward_headers: Option<bool>,
+    /// Idempotent enables the idempotent producer, so that the retries of the producer don't introduce duplicates.
+    #[serde(rename = "idempotent", skip_serializing_if = "Option::is_none")]
+    pub idempotent: Option<bool>,
+    /// MaxMessageBytes is the max size of a record, including the key, value and headers. The records exceeding it are rejected as non-retryable errors, instead of being retried. It overrides \"producer.maxMessageBytes\" in the config, which defaults to 1000000.
+    #[serde(rename = "maxMessageBytes", skip_serializing_if = "Option::is_none")]
+    pub max_message_bytes: Option<i32>,
+    /// PartitionHeader is the name of the message header used by the \"header\" partitioner.
+    #[serde(rename = "partitionHeader", skip_serializing_if = "Option::is_none")]
+    pub partition_header: Option<String>,
+    /// Partitioner specifies how the records are assigned to the partitions of the topic. \"keyHash\" hashes the keys of the messages, regardless of SetKey. \"header\" hashes the value of the header specified by PartitionHeader. \"roundRobin\" distributes the records evenly. If not provided, the records are hashed by the Kafka key if SetKey is true, otherwise randomly assigned.
+    #[serde(rename = "partitioner", skip_serializing_if = "Option::is_none")]
+    pub partitioner: Option<String>,
     #[serde(rename = "sasl", skip_serializing_if = "Option::is_none")]
     pub sasl: Option<Box<crate::models::Sasl>>,
     /// SetKey sets the Kafka key to the keys passed in the Message. When the key is null (default), the record is sent randomly to one of the available partitions of the topic. If a key exists, Kafka hashes the key, and the result is used to map the message to a specific partition. This ensures that messages with the same key end up in the same partition.
     #[serde(rename = "setKey", skip_serializing_if = "Option::is_none")]
     pub set_key: Option<bool>,
+    /// SetTimestamp sets the timestamp of the records to the event time of the messages.
+    #[serde(rename = "setTimestamp", skip_serializing_if = "Option::is_none")]
+    pub set_timestamp: Option<bool>,
     #[serde(rename = "tls", skip_serializing_if = "Option::is_none")]
     pub tls: Option<Box<crate::models::Tls>>,
     #[serde(rename = "topic")]
@@ -37,9 +61,17 @@ impl KafkaSink {
     pub fn new(topic: String) -> KafkaSink {
         KafkaSink {
             brokers: None,
+            compression: None,
             config: None,
+            exactly_once: None,
+            forward_headers: None,
+            idempotent: None,
+            max_message_bytes: None,
+            partition_header: None,
+            partitioner: None,
             sasl: None,
             set_key: None,
+            set_timestamp: None,
             tls: None,
             topic,
         }
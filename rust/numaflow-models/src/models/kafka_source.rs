@@ -24,10 +24,18 @@ pub struct KafkaSource {
     pub config: Option<String>,
     #[serde(rename = "consumerGroup", skip_serializing_if = "Option::is_none")]
     pub consumer_group: Option<String>,
+    /// EventTimeSource specifies where the event time of the messages comes from, \"recordTimestamp\" or \"logAppendTime\". \"recordTimestamp\" uses the timestamp of the records, whatever the timestamp type of the topic is. \"logAppendTime\" requires the topic to be configured with \"message.timestamp.type=LogAppendTime\", so that the event time is the time the broker appended the record, the source fails to start otherwise. Defaults to \"recordTimestamp\".
+    #[serde(rename = "eventTimeSource", skip_serializing_if = "Option::is_none")]
+    pub event_time_source: Option<String>,
     #[serde(rename = "kafkaVersion", skip_serializing_if = "Option::is_none")]
     pub kafka_version: Option<String>,
+    /// KeyHeader is the name of the record header whose value is used as the message key. If not provided, the record key is used.
+    #[serde(rename = "keyHeader", skip_serializing_if = "Option::is_none")]
+    pub key_header: Option<String>,
     #[serde(rename = "sasl", skip_serializing_if = "Option::is_none")]
     pub sasl: Option<Box<crate::models::Sasl>>,
+    #[serde(rename = "startPosition", skip_serializing_if = "Option::is_none")]
+    pub start_position: Option<Box<crate::models::KafkaStartPosition>>,
     #[serde(rename = "tls", skip_serializing_if = "Option::is_none")]
     pub tls: Option<Box<crate::models::Tls>>,
     #[serde(rename = "topic")]
@@ -40,8 +48,11 @@ impl KafkaSource {
             brokers: None,
             config: None,
             consumer_group: None,
+            event_time_source: None,
             kafka_version: None,
+            key_header: None,
             sasl: None,
+            start_position: None,
             tls: None,
             topic,
         }
@@ -17,10 +17,27 @@ limitations under the License.
 // Code generated by Openapi Generator. DO NOT EDIT.
 
 #[derive(Clone, Debug, PartialEq, Serialize, Deserialize)]
-pub struct Log {}
+pub struct Log {
+    /// Format of the output, text, json or payload, defaults to text. The json and payload formats are printed to stdout without any prefix, so that they can be consumed by log collectors.
+    #[serde(rename = "format", skip_serializing_if = "Option::is_none")]
+    pub format: Option<String>,
+    /// MaxPayloadBytes truncates the payloads longer than it before they are encoded, 0 means no limit.
+    #[serde(rename = "maxPayloadBytes", skip_serializing_if = "Option::is_none")]
+    pub max_payload_bytes: Option<i32>,
+    /// PayloadEncoding is the encoding of the payloads, text, hex or base64 for binary data, defaults to text.
+    #[serde(rename = "payloadEncoding", skip_serializing_if = "Option::is_none")]
+    pub payload_encoding: Option<String>,
+    #[serde(rename = "sampling", skip_serializing_if = "Option::is_none")]
+    pub sampling: Option<Box<crate::models::LogSampling>>,
+}
 
 impl Log {
     pub fn new() -> Log {
-        Log {}
+        Log {
+            format: None,
+            max_payload_bytes: None,
+            payload_encoding: None,
+            sampling: None,
+        }
     }
 }
@@ -16,24 +16,50 @@ pub mod blackhole;
 pub use self::blackhole::Blackhole;
 pub mod buffer_service_config;
 pub use self::buffer_service_config::BufferServiceConfig;
+pub mod claim_check;
+pub use self::claim_check::ClaimCheck;
+pub mod claim_check_s3;
+pub use self::claim_check_s3::ClaimCheckS3;
 pub mod combined_edge;
 pub use self::combined_edge::CombinedEdge;
+pub mod compression;
+pub use self::compression::Compression;
 pub mod container;
 pub use self::container::Container;
 pub mod container_builder;
 pub use self::container_builder::ContainerBuilder;
 pub mod container_template;
 pub use self::container_template::ContainerTemplate;
+pub mod count_window;
+pub use self::count_window::CountWindow;
 pub mod daemon_template;
 pub use self::daemon_template::DaemonTemplate;
+pub mod dead_letter;
+pub use self::dead_letter::DeadLetter;
+pub mod disk_buffer_service;
+pub use self::disk_buffer_service::DiskBufferService;
+pub mod disk_config;
+pub use self::disk_config::DiskConfig;
+pub mod disk_storage;
+pub use self::disk_storage::DiskStorage;
 pub mod edge;
 pub use self::edge::Edge;
+pub mod edge_priority;
+pub use self::edge_priority::EdgePriority;
+pub mod file_sink;
+pub use self::file_sink::FileSink;
+pub mod file_sink_s3;
+pub use self::file_sink_s3::FileSinkS3;
 pub mod fixed_window;
 pub use self::fixed_window::FixedWindow;
 pub mod forward_conditions;
 pub use self::forward_conditions::ForwardConditions;
 pub mod function;
 pub use self::function::Function;
+pub mod generator_out_of_order;
+pub use self::generator_out_of_order::GeneratorOutOfOrder;
+pub mod generator_replay;
+pub use self::generator_replay::GeneratorReplay;
 pub mod generator_source;
 pub use self::generator_source::GeneratorSource;
 pub mod get_container_req;
@@ -58,14 +84,24 @@ pub mod get_side_input_deployment_req;
 pub use self::get_side_input_deployment_req::GetSideInputDeploymentReq;
 pub mod get_vertex_pod_spec_req;
 pub use self::get_vertex_pod_spec_req::GetVertexPodSpecReq;
+pub mod global_window;
+pub use self::global_window::GlobalWindow;
 pub mod group_by;
 pub use self::group_by::GroupBy;
 pub mod gssapi;
 pub use self::gssapi::Gssapi;
+pub mod http_batch;
+pub use self::http_batch::HttpBatch;
+pub mod http_sink;
+pub use self::http_sink::HttpSink;
+pub mod http_sink_batch;
+pub use self::http_sink_batch::HttpSinkBatch;
 pub mod http_source;
 pub use self::http_source::HttpSource;
 pub mod idle_source;
 pub use self::idle_source::IdleSource;
+pub mod inter_step_buffer;
+pub use self::inter_step_buffer::InterStepBuffer;
 pub mod inter_step_buffer_service;
 pub use self::inter_step_buffer_service::InterStepBufferService;
 pub mod inter_step_buffer_service_list;
@@ -86,10 +122,14 @@ pub mod kafka_sink;
 pub use self::kafka_sink::KafkaSink;
 pub mod kafka_source;
 pub use self::kafka_source::KafkaSource;
+pub mod kafka_start_position;
+pub use self::kafka_start_position::KafkaStartPosition;
 pub mod lifecycle;
 pub use self::lifecycle::Lifecycle;
 pub mod log;
 pub use self::log::Log;
+pub mod log_sampling;
+pub use self::log_sampling::LogSampling;
 pub mod metadata;
 pub use self::metadata::Metadata;
 pub mod mono_vertex;
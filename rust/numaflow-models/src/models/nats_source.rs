@@ -20,6 +20,18 @@ limitations under the License.
 pub struct NatsSource {
     #[serde(rename = "auth", skip_serializing_if = "Option::is_none")]
     pub auth: Option<Box<crate::models::NatsAuth>>,
+    /// EventTimeFromHeader is the name of the message header whose value is used as the event time, in RFC3339 format or epoch milliseconds. The time the message is received is used if it's not specified, or the header is missing or invalid.
+    #[serde(
+        rename = "eventTimeFromHeader",
+        skip_serializing_if = "Option::is_none"
+    )]
+    pub event_time_from_header: Option<String>,
+    /// KeyFromHeader is the name of the message header whose value is used as the message key.
+    #[serde(rename = "keyFromHeader", skip_serializing_if = "Option::is_none")]
+    pub key_from_header: Option<String>,
+    /// OverflowSize is the max number of messages buffered locally, in addition to the read buffer, when the inter-step buffer is under back pressure. The messages received when the overflow is full are dropped, and counted in the nats_source_dropped_total metric. If it's not specified, receiving blocks under back pressure, and the messages exceeding the pending limits of the NATS client are dropped as a slow consumer, which are counted in the same metric.
+    #[serde(rename = "overflowSize", skip_serializing_if = "Option::is_none")]
+    pub overflow_size: Option<i32>,
     /// Queue is used for queue subscription.
     #[serde(rename = "queue")]
     pub queue: String,
@@ -37,6 +49,9 @@ impl NatsSource {
     pub fn new(queue: String, subject: String, url: String) -> NatsSource {
         NatsSource {
             auth: None,
+            event_time_from_header: None,
+            key_from_header: None,
+            overflow_size: None,
             queue,
             subject,
             tls: None,
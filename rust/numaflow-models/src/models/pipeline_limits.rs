@@ -24,6 +24,8 @@ pub struct PipelineLimits {
     /// BufferUsageLimit is used to define the percentage of the buffer usage limit, a valid value should be less than 100, for example, 85. Only applies to UDF and Source vertices as only they do buffer write. It will be overridden by the settings in vertex limits.
     #[serde(rename = "bufferUsageLimit", skip_serializing_if = "Option::is_none")]
     pub buffer_usage_limit: Option<i64>,
+    #[serde(rename = "claimCheck", skip_serializing_if = "Option::is_none")]
+    pub claim_check: Option<Box<crate::models::ClaimCheck>>,
     /// Read batch size for all the vertices in the pipeline, can be overridden by the vertex's limit settings.
     #[serde(rename = "readBatchSize", skip_serializing_if = "Option::is_none")]
     pub read_batch_size: Option<i64>,
@@ -36,6 +38,7 @@ impl PipelineLimits {
         PipelineLimits {
             buffer_max_length: None,
             buffer_usage_limit: None,
+            claim_check: None,
             read_batch_size: None,
             read_timeout: None,
         }
@@ -18,6 +18,9 @@ limitations under the License.
 
 #[derive(Clone, Debug, PartialEq, Serialize, Deserialize)]
 pub struct PulsarSource {
+    /// AdminURL is the URL of the Pulsar web service (e.g. http://pulsar-broker:8080), it is used to query the subscription backlog to calculate pending messages. If not specified, pending messages are not available.
+    #[serde(rename = "adminUrl", skip_serializing_if = "Option::is_none")]
+    pub admin_url: Option<String>,
     #[serde(rename = "auth", skip_serializing_if = "Option::is_none")]
     pub auth: Option<Box<crate::models::PulsarAuth>>,
     #[serde(rename = "consumerName")]
@@ -41,6 +44,7 @@ impl PulsarSource {
         topic: String,
     ) -> PulsarSource {
         PulsarSource {
+            admin_url: None,
             auth: None,
             consumer_name,
             max_unack: None,
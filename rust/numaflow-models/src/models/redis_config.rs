@@ -18,6 +18,9 @@ limitations under the License.
 
 #[derive(Clone, Debug, PartialEq, Serialize, Deserialize)]
 pub struct RedisConfig {
+    /// Cluster indicates the Redis URL is the address of a Redis Cluster, it is implied if the URL has multiple comma separated addresses. Can not be used together with Sentinel.
+    #[serde(rename = "cluster", skip_serializing_if = "Option::is_none")]
+    pub cluster: Option<bool>,
     /// Only required when Sentinel is used
     #[serde(rename = "masterName", skip_serializing_if = "Option::is_none")]
     pub master_name: Option<String>,
@@ -28,10 +31,15 @@ pub struct RedisConfig {
     /// Sentinel URL, will be ignored if Redis URL is provided
     #[serde(rename = "sentinelUrl", skip_serializing_if = "Option::is_none")]
     pub sentinel_url: Option<String>,
+    /// Sentinel ACL user, the default user is used if it is not provided
+    #[serde(rename = "sentinelUser", skip_serializing_if = "Option::is_none")]
+    pub sentinel_user: Option<String>,
+    #[serde(rename = "tls", skip_serializing_if = "Option::is_none")]
+    pub tls: Option<Box<crate::models::Tls>>,
     /// Redis URL
     #[serde(rename = "url", skip_serializing_if = "Option::is_none")]
     pub url: Option<String>,
-    /// Redis user
+    /// Redis ACL user, the default user is used if it is not provided
     #[serde(rename = "user", skip_serializing_if = "Option::is_none")]
     pub user: Option<String>,
 }
@@ -39,10 +47,13 @@ pub struct RedisConfig {
 impl RedisConfig {
     pub fn new() -> RedisConfig {
         RedisConfig {
+            cluster: None,
             master_name: None,
             password: None,
             sentinel_password: None,
             sentinel_url: None,
+            sentinel_user: None,
+            tls: None,
             url: None,
             user: None,
         }
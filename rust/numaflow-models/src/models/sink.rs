@@ -22,6 +22,10 @@ pub struct Sink {
     pub blackhole: Option<Box<crate::models::Blackhole>>,
     #[serde(rename = "fallback", skip_serializing_if = "Option::is_none")]
     pub fallback: Option<Box<crate::models::AbstractSink>>,
+    #[serde(rename = "file", skip_serializing_if = "Option::is_none")]
+    pub file: Option<Box<crate::models::FileSink>>,
+    #[serde(rename = "http", skip_serializing_if = "Option::is_none")]
+    pub http: Option<Box<crate::models::HttpSink>>,
     #[serde(rename = "kafka", skip_serializing_if = "Option::is_none")]
     pub kafka: Option<Box<crate::models::KafkaSink>>,
     #[serde(rename = "log", skip_serializing_if = "Option::is_none")]
@@ -41,6 +45,8 @@ impl Sink {
         Sink {
             blackhole: None,
             fallback: None,
+            file: None,
+            http: None,
             kafka: None,
             log: None,
             retry_strategy: None,
@@ -21,6 +21,9 @@ pub struct SqsSink {
     /// AWSRegion is the AWS Region where the SQS queue is located
     #[serde(rename = "awsRegion")]
     pub aws_region: String,
+    /// EndpointURL is the custom endpoint URL for the AWS SQS API. This is useful for testing with localstack or when using VPC endpoints.
+    #[serde(rename = "endpointUrl", skip_serializing_if = "Option::is_none")]
+    pub endpoint_url: Option<String>,
     /// QueueName is the name of the SQS queue
     #[serde(rename = "queueName")]
     pub queue_name: String,
@@ -37,6 +40,7 @@ impl SqsSink {
     ) -> SqsSink {
         SqsSink {
             aws_region,
+            endpoint_url: None,
             queue_name,
             queue_owner_aws_account_id,
         }
@@ -26,8 +26,12 @@ pub struct VertexSpec {
         skip_serializing_if = "Option::is_none"
     )]
     pub automount_service_account_token: Option<bool>,
+    #[serde(rename = "claimCheck", skip_serializing_if = "Option::is_none")]
+    pub claim_check: Option<Box<crate::models::ClaimCheck>>,
     #[serde(rename = "containerTemplate", skip_serializing_if = "Option::is_none")]
     pub container_template: Option<Box<crate::models::ContainerTemplate>>,
+    #[serde(rename = "deadLetter", skip_serializing_if = "Option::is_none")]
+    pub dead_letter: Option<Box<crate::models::DeadLetter>>,
     #[serde(rename = "dnsConfig", skip_serializing_if = "Option::is_none")]
     pub dns_config: Option<k8s_openapi::api::core::v1::PodDNSConfig>,
     /// Set DNS policy for the pod. Defaults to \"ClusterFirst\". Valid values are 'ClusterFirstWithHostNet', 'ClusterFirst', 'Default' or 'None'. DNS parameters given in DNSConfig will be merged with the policy selected with DNSPolicy. To have DNS options set along with hostNetwork, you have to specify DNS policy explicitly to 'ClusterFirstWithHostNet'.
@@ -123,7 +127,9 @@ impl VertexSpec {
         VertexSpec {
             affinity: None,
             automount_service_account_token: None,
+            claim_check: None,
             container_template: None,
+            dead_letter: None,
             dns_config: None,
             dns_policy: None,
             from_edges: None,
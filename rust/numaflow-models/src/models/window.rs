@@ -22,8 +22,12 @@ limitations under the License.
 pub struct Window {
     #[serde(rename = "accumulator", skip_serializing_if = "Option::is_none")]
     pub accumulator: Option<Box<crate::models::AccumulatorWindow>>,
+    #[serde(rename = "count", skip_serializing_if = "Option::is_none")]
+    pub count: Option<Box<crate::models::CountWindow>>,
     #[serde(rename = "fixed", skip_serializing_if = "Option::is_none")]
     pub fixed: Option<Box<crate::models::FixedWindow>>,
+    #[serde(rename = "global", skip_serializing_if = "Option::is_none")]
+    pub global: Option<Box<crate::models::GlobalWindow>>,
     #[serde(rename = "session", skip_serializing_if = "Option::is_none")]
     pub session: Option<Box<crate::models::SessionWindow>>,
     #[serde(rename = "sliding", skip_serializing_if = "Option::is_none")]
@@ -35,7 +39,9 @@ impl Window {
     pub fn new() -> Window {
         Window {
             accumulator: None,
+            count: None,
             fixed: None,
+            global: None,
             session: None,
             sliding: None,
         }
@@ -37,6 +37,9 @@ pub struct Header {
         ::prost::alloc::string::String,
         ::prost::alloc::string::String,
     >,
+    /// Compression is the codec of the payload, messages written before it was introduced are uncompressed.
+    #[prost(enumeration = "Compression", tag = "6")]
+    pub compression: i32,
 }
 /// MessageID is the message ID of the message which is used for exactly-once-semantics.
 #[derive(Clone, PartialEq, ::prost::Message)]
@@ -92,6 +95,43 @@ pub struct WriteMessage {
     #[prost(string, repeated, tag = "2")]
     pub tags: ::prost::alloc::vec::Vec<::prost::alloc::string::String>,
 }
+/// Compression is the codec used to compress the payload of the message.
+#[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, PartialOrd, Ord, ::prost::Enumeration)]
+#[repr(i32)]
+pub enum Compression {
+    /// Uncompressed payload
+    None = 0,
+    /// Snappy block format
+    Snappy = 1,
+    /// Zstandard frame
+    Zstd = 2,
+    /// Gzip stream
+    Gzip = 3,
+}
+impl Compression {
+    /// String value of the enum field names used in the ProtoBuf definition.
+    ///
+    /// The values are not transformed in any way and thus are considered stable
+    /// (if the ProtoBuf definition does not change) and safe for programmatic use.
+    pub fn as_str_name(&self) -> &'static str {
+        match self {
+            Self::None => "NONE",
+            Self::Snappy => "SNAPPY",
+            Self::Zstd => "ZSTD",
+            Self::Gzip => "GZIP",
+        }
+    }
+    /// Creates an enum from field names used in the ProtoBuf definition.
+    pub fn from_str_name(value: &str) -> ::core::option::Option<Self> {
+        match value {
+            "NONE" => Some(Self::None),
+            "SNAPPY" => Some(Self::Snappy),
+            "ZSTD" => Some(Self::Zstd),
+            "GZIP" => Some(Self::Gzip),
+            _ => None,
+        }
+    }
+}
 /// MessageKind represents the message type of the payload.
 #[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, PartialOrd, Ord, ::prost::Enumeration)]
 #[repr(i32)]
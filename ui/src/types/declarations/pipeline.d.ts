@@ -23,6 +23,7 @@ export interface BufferInfo {
   bufferUsage: number;
   bufferUsageLimit: number;
   bufferLength: number;
+  priorityPendingCounts?: { [lane: string]: number };
 }
 
 export interface GraphData {